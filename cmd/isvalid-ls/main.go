@@ -0,0 +1,31 @@
+// isvalid-ls is a language server for the "is" struct tag. It accepts the same
+// flags, and reads the same config file, as the isvalid tool and communicates
+// with the client over stdin and stdout using the Language Server Protocol.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/frk/isvalid/internal/command"
+)
+
+func main() {
+	conf := command.DefaultConfig
+	conf.ParseFlags()
+	if err := conf.ParseFile(); err != nil {
+		fmt.Fprintf(os.Stderr, "isvalid-ls: failed parsing config file ...\n - %v\n", err)
+		os.Exit(2)
+	}
+
+	cmd, err := command.New(conf)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "isvalid-ls: failed to initialize the command ...\n - %v\n", err)
+		os.Exit(2)
+	}
+
+	if err := cmd.ServeLSP(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "isvalid-ls: an error occurred ...\n - %v\n", err)
+		os.Exit(2)
+	}
+}
@@ -36,6 +36,16 @@ type ErrorConstructor interface {
 	// The rule parameter holds the name of the validation rule which the
 	// failed value did not pass. The args parameter holds the rule's
 	// arguments specified by the `is` tag.
+	//
+	// For the group rules "oneof", "anyof", and "allornone" the key parameter
+	// will hold the keys of all of the group's fields joined by a comma, the
+	// val parameter holds the number of those fields that are set, and the
+	// args parameter holds the values of the group's fields.
+	//
+	// For the struct-level rules, declared with the "isvalid:rule" directive
+	// in the validator type's documentation, the key parameter will hold the
+	// key of the field joined by a comma with the keys of the fields that are
+	// referenced by the rules' options, e.g. "StartDate,EndDate".
 	Error(key string, val interface{}, rule string, args ...interface{}) error
 }
 
@@ -57,6 +67,16 @@ type ErrorAggregator interface {
 	// The rule parameter holds the name of the validation rule which the
 	// failed value did not pass. The args parameter holds the rule's
 	// arguments specified by the `is` tag.
+	//
+	// For the group rules "oneof", "anyof", and "allornone" the key parameter
+	// will hold the keys of all of the group's fields joined by a comma, the
+	// val parameter holds the number of those fields that are set, and the
+	// args parameter holds the values of the group's fields.
+	//
+	// For the struct-level rules, declared with the "isvalid:rule" directive
+	// in the validator type's documentation, the key parameter will hold the
+	// key of the field joined by a comma with the keys of the fields that are
+	// referenced by the rules' options, e.g. "StartDate,EndDate".
 	Error(key string, val interface{}, rule string, args ...interface{})
 	// The Out method will be invoked by the generated validation code at
 	// the end to yield the error value it returns.
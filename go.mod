@@ -1,11 +1,18 @@
 module github.com/frk/isvalid
 
-go 1.14
+go 1.22.0
 
 require (
+	github.com/BurntSushi/toml v0.3.1
 	github.com/frk/ast v0.0.5-0.20201210051705-327961897a90
 	github.com/frk/compare v0.0.6
 	github.com/frk/tagutil v0.0.1
 	golang.org/x/text v0.3.0
-	golang.org/x/tools v0.0.0-20200921181913-ccabf82fa1ae
+	golang.org/x/tools v0.26.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
 )
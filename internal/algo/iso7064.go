@@ -0,0 +1,39 @@
+package algo
+
+// Mod97_10 validates the given string v using the ISO 7064 MOD 97-10
+// algorithm, the last two digits of v are assumed to be the check digits.
+// - https://en.wikipedia.org/wiki/ISO/IEC_7064
+//
+// The string v is assumed to contain only digits.
+func Mod97_10(v string) bool {
+	if len(v) < 3 {
+		return false
+	}
+
+	var r int
+	for i := 0; i < len(v); i++ {
+		r = (r*10 + int(v[i]-'0')) % 97
+	}
+	return r == 1
+}
+
+// Mod11_10 validates the given string v using the ISO 7064 MOD 11,10 hybrid
+// algorithm, the last digit of v is assumed to be the check digit.
+// - https://en.wikipedia.org/wiki/ISO/IEC_7064
+//
+// The string v is assumed to contain only digits.
+func Mod11_10(v string) bool {
+	if len(v) < 2 {
+		return false
+	}
+
+	p := 10
+	for i := 0; i < len(v)-1; i++ {
+		s := (int(v[i]-'0') + p) % 10
+		if s == 0 {
+			s = 10
+		}
+		p = (s * 2) % 11
+	}
+	return (11-p)%10 == int(v[len(v)-1]-'0')
+}
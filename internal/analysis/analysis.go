@@ -32,13 +32,13 @@ type Config struct {
 
 // AddRuleFunc is used to register a custom RuleFunc with the Config. The
 // custom function MUST have at least one parameter item and, it MUST have
-// exactly one result item which MUST be of type bool.
-func (c *Config) AddRuleFunc(ruleName string, typ *types.Func) error {
-	if name := strings.ToLower(ruleName); name == "isvalid" || name == "-isvalid" || name == "enum" {
-		return &anError{Code: errRuleNameReserved, r: &Rule{Name: ruleName}}
+// exactly one result item which MUST be of type bool. The RuleConfig's Name
+// is the name of the rule, its other fields are optional.
+func (c *Config) AddRuleFunc(conf RuleConfig, typ *types.Func) error {
+	if name := strings.ToLower(conf.Name); name == "isvalid" || name == "-isvalid" || name == "enum" {
+		return &anError{Code: errRuleNameReserved, r: &Rule{Name: conf.Name}}
 	}
 
-	conf := RuleConfig{Name: ruleName}
 	rt, err := conf.RuleTypeFunc(typ, true)
 	if err != nil {
 		return err
@@ -47,7 +47,7 @@ func (c *Config) AddRuleFunc(ruleName string, typ *types.Func) error {
 	if c.customTypeMap == nil {
 		c.customTypeMap = make(map[string]RuleType)
 	}
-	c.customTypeMap[ruleName] = rt
+	c.customTypeMap[conf.Name] = rt
 	return nil
 }
 
@@ -67,6 +67,7 @@ func (c Config) Analyze(ast search.AST, match *search.Match, info *Info) (*Valid
 	a.pkgPath = match.Named.Obj().Pkg().Path()
 	a.keys = make(map[string]uint)
 	a.fieldVarMap = make(map[*StructField]fieldVar)
+	a.structRules = match.Rules
 
 	a.info = info
 	a.info.FileSet = match.Fset
@@ -76,22 +77,27 @@ func (c Config) Analyze(ast search.AST, match *search.Match, info *Info) (*Valid
 	a.info.SelectorMap = make(map[string]StructFieldSelector)
 	a.info.EnumMap = make(map[string][]Const)
 
+	a.info.RuleTypeMap = c.RuleTypeMap()
+
 	a.fieldKey = fieldKeyFunc(c)
 	vs, err := analyzeValidatorStruct(a, structType)
 	if err != nil {
 		return nil, err
 	}
+	return vs, nil
+}
 
-	// merge the rule func maps into one for the generator to use
-	a.info.RuleTypeMap = make(map[string]RuleType)
+// RuleTypeMap returns a map of all the RuleTypes known to the Config, i.e. the
+// builtin ones merged together with the custom ones registered with AddRuleFunc.
+func (c Config) RuleTypeMap() map[string]RuleType {
+	m := make(map[string]RuleType)
 	for k, v := range defaultRuleTypeMap {
-		a.info.RuleTypeMap[k] = v
+		m[k] = v
 	}
 	for k, v := range c.customTypeMap {
-		a.info.RuleTypeMap[k] = v
+		m[k] = v
 	}
-
-	return vs, nil
+	return m
 }
 
 // Info holds information related to the analysis and inteded to be used by the generator.
@@ -140,6 +146,12 @@ type analysis struct {
 	// fieldVarMap maintains a map of StructField pointers to the fields'
 	// related go/types specific information. Intended for error reporting.
 	fieldVarMap map[*StructField]fieldVar
+	// The validator struct's blank fields that have an "is" tag, the
+	// tags are expected to contain the validator's group rules.
+	blankFields []*StructField
+	// The struct-level rules as found in the documentation
+	// of the type under analysis by search.Search.
+	structRules []string
 }
 
 // used for error reporting only
@@ -168,10 +180,8 @@ func analyzeValidatorStruct(a *analysis, structType *types.Struct) (*ValidatorSt
 	if name := lookupAfterValidate(a.named); len(name) > 0 {
 		a.validator.AfterValidate = &MethodInfo{Name: name}
 	}
-
-	typName := strings.ToLower(a.validator.TypeName)
-	if !strings.HasSuffix(typName, "validator") {
-		panic(a.validator.TypeName + " struct type has unsupported name suffix.") // this shouldn't happen
+	for i := 0; i < a.named.TypeParams().Len(); i++ {
+		a.validator.TypeParams = append(a.validator.TypeParams, a.named.TypeParams().At(i).Obj().Name())
 	}
 
 	// 1. analyze all fields
@@ -187,7 +197,17 @@ func analyzeValidatorStruct(a *analysis, structType *types.Struct) (*ValidatorSt
 		return nil, err
 	}
 
-	// 3. ensure that if a rule with context exists, that also a ContextOptionField exists
+	// 3. check the group rules declared in the tags of blank fields
+	if err := checkGroupRules(a, a.blankFields); err != nil {
+		return nil, err
+	}
+
+	// 4. analyze the struct-level rules declared in the type's documentation
+	if err := analyzeStructRules(a, a.structRules); err != nil {
+		return nil, err
+	}
+
+	// 5. ensure that if a rule with context exists, that also a ContextOptionField exists
 	if a.needsContext != nil && a.validator.ContextOption == nil {
 		return nil, &anError{Code: errContextOptionFieldRequired, a: a,
 			f: a.needsContext.field, r: a.needsContext.rule}
@@ -210,8 +230,17 @@ func analyzeStructFields(a *analysis, structType *types.Struct, selector []*Stru
 			continue
 		}
 
-		// Skip fields with blank name.
+		// Skip fields with blank name. The tags of the validator
+		// struct's blank fields are retained for the group rules.
 		if fvar.Name() == "_" {
+			if len(istag) > 0 && istag != "-" && len(selector) == 0 {
+				f := &StructField{Name: fvar.Name(), Tag: tag}
+				a.fieldVarMap[f] = fieldVar{v: fvar, tag: ftag}
+				if f.RuleTag, err = parseRuleTag(ftag); err != nil {
+					return nil, &anError{Code: errRuleTagSyntax, a: a, f: f, err: err}
+				}
+				a.blankFields = append(a.blankFields, f)
+			}
 			continue
 		}
 
@@ -227,10 +256,12 @@ func analyzeStructFields(a *analysis, structType *types.Struct, selector []*Stru
 		f.Name = fvar.Name()
 		f.IsEmbedded = fvar.Embedded()
 		f.IsExported = fvar.Exported()
-		f.RuleTag, _ = parseRuleTag(ftag)
 
 		// map field to fvar for error reporting
 		a.fieldVarMap[f] = fieldVar{v: fvar, tag: ftag}
+		if f.RuleTag, err = parseRuleTag(ftag); err != nil {
+			return nil, &anError{Code: errRuleTagSyntax, a: a, f: f, err: err}
+		}
 
 		// resolve field key for selector & make sure that it is unique
 		fsel := append(selector, f)
@@ -322,6 +353,7 @@ func analyzeType(a *analysis, t types.Type, selector []*StructField) (typ Type,
 		typ.IsImported = isImportedType(a, named)
 		typ.IsExported = named.Obj().Exported()
 		typ.CanIsValid = canIsValid(t)
+		typ.TypeArgs = analyzeTypeArgs(a, named)
 		t = named.Underlying()
 	}
 
@@ -364,6 +396,10 @@ func analyzeType(a *analysis, t types.Type, selector []*StructField) (typ Type,
 	case *types.Interface:
 		typ.IsEmptyInterface = T.NumMethods() == 0
 		typ.CanIsValid = canIsValid(t)
+	case *types.TypeParam:
+		typ.Name = T.Obj().Name()
+		typ.CanIsValid = canIsValid(t)
+		typ.Constraint = analyzeConstraint(T)
 	case *types.Struct:
 		fields, err := analyzeStructFields(a, T, selector, !typ.IsImported)
 		if err != nil {
@@ -384,6 +420,9 @@ func analyzeType0(t types.Type) (typ Type) {
 		typ.PkgName = pkg.Name()
 		typ.PkgLocal = pkg.Name()
 		typ.IsExported = named.Obj().Exported()
+		for i := 0; i < named.TypeArgs().Len(); i++ {
+			typ.TypeArgs = append(typ.TypeArgs, analyzeType0(named.TypeArgs().At(i)))
+		}
 		t = named.Underlying()
 	}
 
@@ -410,6 +449,10 @@ func analyzeType0(t types.Type) (typ Type) {
 		typ.Elem = &elem
 	case *types.Interface:
 		typ.IsEmptyInterface = T.NumMethods() == 0
+	case *types.TypeParam:
+		typ.Name = T.Obj().Name()
+		typ.CanIsValid = canIsValid(t)
+		typ.Constraint = analyzeConstraint(T)
 	case *types.Struct, *types.Chan:
 		// TODO probably return an error
 	}
@@ -417,6 +460,84 @@ func analyzeType0(t types.Type) (typ Type) {
 	return typ
 }
 
+// analyzeTypeArgs analyzes the type arguments of the given named type, if
+// it is an instantiated generic type. The type arguments' fields, if any,
+// are not analyzed, that is done by analyzeType as part of the named
+// type's underlying struct type which is already instantiated.
+func analyzeTypeArgs(a *analysis, named *types.Named) (targs []Type) {
+	for i := 0; i < named.TypeArgs().Len(); i++ {
+		targ := analyzeType0(named.TypeArgs().At(i))
+		setIsImported(a, &targ)
+		targs = append(targs, targ)
+	}
+	return targs
+}
+
+// analyzeConstraint analyzes the constraint of the given type parameter.
+func analyzeConstraint(tp *types.TypeParam) *Constraint {
+	c := new(Constraint)
+	if iface, ok := tp.Constraint().Underlying().(*types.Interface); ok {
+		c.IsComparable = iface.IsComparable()
+		c.Terms, _ = analyzeConstraintTerms(iface)
+	}
+	return c
+}
+
+// analyzeConstraintTerms returns the terms of the type set of the given constraint
+// interface. The returned bool will be false if the interface's type set is not
+// restricted by any terms, e.g. "any", "comparable", or a method-only interface.
+func analyzeConstraintTerms(iface *types.Interface) (terms []ConstraintTerm, ok bool) {
+	for i := 0; i < iface.NumEmbeddeds(); i++ {
+		var eterms []ConstraintTerm
+		switch T := iface.EmbeddedType(i).(type) {
+		case *types.Union:
+			for j := 0; j < T.Len(); j++ {
+				// a union's term can be a constraint
+				// itself, e.g. constraints.Ordered
+				term := T.Term(j)
+				if u, ok := term.Type().Underlying().(*types.Interface); ok {
+					uterms, _ := analyzeConstraintTerms(u)
+					eterms = append(eterms, uterms...)
+					continue
+				}
+				eterms = append(eterms, ConstraintTerm{Tilde: term.Tilde(), Type: analyzeType0(term.Type())})
+			}
+		default:
+			if u, ok := T.Underlying().(*types.Interface); ok {
+				var restricted bool
+				if eterms, restricted = analyzeConstraintTerms(u); !restricted {
+					continue
+				}
+			} else {
+				eterms = []ConstraintTerm{{Type: analyzeType0(T)}}
+			}
+		}
+
+		// the type set of the interface is the
+		// intersection of its embedded elements
+		if !ok {
+			terms, ok = eterms, true
+		} else {
+			terms = intersectConstraintTerms(terms, eterms)
+		}
+	}
+	return terms, ok
+}
+
+// intersectConstraintTerms returns the intersection of the two lists of terms.
+func intersectConstraintTerms(xs, ys []ConstraintTerm) (terms []ConstraintTerm) {
+	for _, x := range xs {
+		for _, y := range ys {
+			if (&Constraint{Terms: []ConstraintTerm{x}}).includesTerm(y) {
+				terms = append(terms, y)
+			} else if (&Constraint{Terms: []ConstraintTerm{y}}).includesTerm(x) {
+				terms = append(terms, x)
+			}
+		}
+	}
+	return terms
+}
+
 // analyzeTypeKind returns the TypeKind for the given types.Type.
 func analyzeTypeKind(typ types.Type) TypeKind {
 	switch x := typ.(type) {
@@ -440,6 +561,8 @@ func analyzeTypeKind(typ types.Type) TypeKind {
 		return TypeKindStruct
 	case *types.Named:
 		return analyzeTypeKind(x.Underlying())
+	case *types.TypeParam:
+		return TypeKindTypeParam
 	}
 	return 0 // unsupported / unknown
 }
@@ -467,49 +590,123 @@ func typeCheckRules(a *analysis, fields []*StructField) error {
 		return nil
 	}
 
-	// tagcheck checks the given tag's Rules and, if the tag has a Key or Elem then
-	// tagcheck will recursively invoke itself with those Key/Elem instances of *TagNode.
-	var tagcheck func(a *analysis, tag *TagNode, typ Type, f *StructField) error
-	tagcheck = func(a *analysis, tag *TagNode, typ Type, f *StructField) error {
-
-		// First handle the "isvalid" rule. The rule does not have to be specified explicitly,
-		// instead it will be applied automatically if a type implements the "IsValid() bool" method.
-		// The explicit "-isvalid" rule can be used to disable the automatic "isvalid" rule.
-		canisvalid := typ.PtrBase().CanIsValid
-		hasisvalid := false
-		omitisvalid := false
-		for _, r := range tag.Rules {
-			if r.Name == "isvalid" {
-				hasisvalid = true
-			} else if r.Name == "-isvalid" {
-				omitisvalid = true
+	for _, f := range fields {
+		if f.RuleTag != nil {
+			if err := tagcheck(a, f.RuleTag, f.Type, f, false); err != nil {
+				return err
 			}
 		}
-		if !canisvalid && hasisvalid {
-			// can't invoke IsValid() method; TODO should return error
-		} else if canisvalid && !hasisvalid && !omitisvalid {
-			tag.Rules = append(tag.Rules, &Rule{Name: "isvalid"})
-		}
-
-		// handle the rest
-		for _, r := range tag.Rules {
-			// Ensure that the Value of a RuleOption of type OptionTypeField
-			// references a valid field key which will be indicated by
-			// a presence of a selector in the SelectorMap.
-			for _, opt := range r.Options {
-				if opt.Type == OptionTypeField {
-					if _, ok := a.info.SelectorMap[opt.Value]; !ok {
-						return &anError{Code: errRuleOptionFieldUnknown,
-							a: a, f: f, r: r, opt: opt}
-					}
+		if err := typwalk(a, f.Type); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tagcheck checks the given tag's Rules and, if the tag has a Key or Elem then
+// tagcheck will recursively invoke itself with those Key/Elem instances of *TagNode.
+//
+// The inner rules of quantifier rules, and the struct-level rules of the validator,
+// are checked by tagcheck as well, for those the "isvalid" rule is not applied
+// automatically, which is indicated by the inner argument.
+func tagcheck(a *analysis, tag *TagNode, typ Type, f *StructField, inner bool) error {
+
+	// First handle the "isvalid" rule. The rule does not have to be specified explicitly,
+	// instead it will be applied automatically if a type implements the "IsValid() bool" method.
+	// The explicit "-isvalid" rule can be used to disable the automatic "isvalid" rule.
+	canisvalid := typ.PtrBase().CanIsValid && !inner
+	hasisvalid := false
+	omitisvalid := false
+	for _, r := range tag.Rules {
+		if r.Name == "isvalid" {
+			hasisvalid = true
+		} else if r.Name == "-isvalid" {
+			omitisvalid = true
+		}
+	}
+	if !canisvalid && hasisvalid {
+		// can't invoke IsValid() method; TODO should return error
+	} else if canisvalid && !hasisvalid && !omitisvalid {
+		tag.Rules = append(tag.Rules, &Rule{Name: "isvalid"})
+	}
+
+	// handle the rest
+	for _, r := range tag.Rules {
+		// Ensure that the Value of a RuleOption of type OptionTypeField
+		// references a valid field key which will be indicated by
+		// a presence of a selector in the SelectorMap.
+		for _, opt := range r.Options {
+			if opt.Type == OptionTypeField {
+				if _, ok := a.info.SelectorMap[opt.Value]; !ok {
+					return &anError{Code: errRuleOptionFieldUnknown,
+						a: a, f: f, r: r, opt: opt}
 				}
 			}
+		}
 
-			if len(r.Context) > 0 && a.needsContext == nil {
-				a.needsContext = &needsContext{f, r}
+		if len(r.Context) > 0 && a.needsContext == nil {
+			a.needsContext = &needsContext{f, r}
+		}
+
+		// Ensure a RuleType for the specified rule exists.
+		rt, ok := a.conf.customTypeMap[r.Name]
+		if !ok {
+			rt, ok = defaultRuleTypeMap[r.Name]
+			if !ok {
+				return &anError{Code: errRuleUnknown, a: a, f: f, r: r}
+			}
+		}
+
+		// The "required" rule's code compares the value against the zero
+		// value of its type, which can't be done with a type parameter.
+		if r.Name == "required" && typ.Kind == TypeKindTypeParam {
+			return &anError{Code: errRuleFieldTypeParam, a: a, f: f, r: r}
+		}
+
+		if err := rt.checkRule(a, r, typ, f); err != nil {
+			return err
+		}
+
+		// Check the inner rules against the type of the elements.
+		if qt, ok := rt.(RuleTypeQuant); ok {
+			elem, _ := qt.ElemType(r, *typ.PtrBase().Elem)
+			if err := tagcheck(a, r.Inner, elem, f, true); err != nil {
+				return err
 			}
+		}
+	}
+
+	// descend if key/elem are present
+	if tag.Key != nil {
+		typ = typ.PtrBase()
+		if typ.Kind != TypeKindMap {
+			return &anError{Code: errRuleKey, a: a, f: f}
+		}
+		if err := tagcheck(a, tag.Key, *typ.Key, f, inner); err != nil {
+			return err
+		}
+	}
+	if tag.Elem != nil {
+		typ = typ.PtrBase()
+		if typ.Kind != TypeKindArray && typ.Kind != TypeKindSlice && typ.Kind != TypeKindMap {
+			return &anError{Code: errRuleElem, a: a, f: f}
+		}
+		if err := tagcheck(a, tag.Elem, *typ.Elem, f, inner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-			// Ensure a RuleType for the specified rule exists.
+// checkGroupRules checks the rules declared in the tags of the given blank
+// fields and adds them to the ValidatorStruct's GroupRules.
+func checkGroupRules(a *analysis, blanks []*StructField) error {
+	for _, f := range blanks {
+		if f.RuleTag.Key != nil || f.RuleTag.Elem != nil {
+			return &anError{Code: errRuleBlankNonGroup, a: a, f: f}
+		}
+
+		for _, r := range f.RuleTag.Rules {
 			rt, ok := a.conf.customTypeMap[r.Name]
 			if !ok {
 				rt, ok = defaultRuleTypeMap[r.Name]
@@ -517,47 +714,74 @@ func typeCheckRules(a *analysis, fields []*StructField) error {
 					return &anError{Code: errRuleUnknown, a: a, f: f, r: r}
 				}
 			}
-
-			if err := rt.checkRule(a, r, typ, f); err != nil {
-				return err
+			if _, ok := rt.(RuleTypeGroup); !ok {
+				return &anError{Code: errRuleBlankNonGroup, a: a, f: f, r: r}
 			}
-		}
 
-		// descend if key/elem are present
-		if tag.Key != nil {
-			typ = typ.PtrBase()
-			if typ.Kind != TypeKindMap {
-				return &anError{Code: errRuleKey, a: a, f: f}
-			}
-			if err := tagcheck(a, tag.Key, *typ.Key, f); err != nil {
-				return err
-			}
-		}
-		if tag.Elem != nil {
-			typ = typ.PtrBase()
-			if typ.Kind != TypeKindArray && typ.Kind != TypeKindSlice && typ.Kind != TypeKindMap {
-				return &anError{Code: errRuleElem, a: a, f: f}
+			if len(r.Context) > 0 && a.needsContext == nil {
+				a.needsContext = &needsContext{f, r}
 			}
-			if err := tagcheck(a, tag.Elem, *typ.Elem, f); err != nil {
+			if err := rt.checkRule(a, r, f.Type, f); err != nil {
 				return err
 			}
+			a.validator.GroupRules = append(a.validator.GroupRules, r)
 		}
-		return nil
 	}
+	return nil
+}
 
-	for _, f := range fields {
-		if f.RuleTag != nil {
-			if err := tagcheck(a, f.RuleTag, f.Type, f); err != nil {
-				return err
-			}
+// analyzeStructRules analyzes the given "isvalid:rule" directives of the validator
+// struct type, each of which is expected to hold a field key followed by the
+// rules, in the format of the "is" tag, that are to be applied to that field.
+func analyzeStructRules(a *analysis, directives []string) error {
+	for _, text := range directives {
+		key, tag, _ := strings.Cut(text, " ")
+		sel, ok := a.info.SelectorMap[key]
+		if !ok {
+			return &anError{Code: errStructRuleFieldUnknown, a: a, f: &StructField{Name: key}}
 		}
-		if err := typwalk(a, f.Type); err != nil {
+
+		// copy the field, the directive's rules are checked,
+		// and later generated, separately from the field's own rules
+		f := *sel.Last()
+		ftag := "is:" + strconv.Quote(strings.TrimSpace(tag))
+		f.Tag = tagutil.New(ftag)
+		var err error
+		f.RuleTag, err = parseRuleTag(ftag)
+		if fv, ok := a.fieldVarMap[sel.Last()]; ok {
+			a.fieldVarMap[&f] = fieldVar{v: fv.v, tag: ftag}
+		}
+
+		if err != nil || len(f.RuleTag.Rules) == 0 || f.RuleTag.Key != nil || f.RuleTag.Elem != nil {
+			return &anError{Code: errStructRuleTag, a: a, f: &f}
+		}
+		if err := tagcheck(a, f.RuleTag, f.Type, &f, true); err != nil {
 			return err
 		}
+
+		keys := fieldOptionKeys([]string{f.Key}, f.RuleTag.Rules)
+		a.validator.StructRules = append(a.validator.StructRules,
+			&StructRule{Key: strings.Join(keys, ","), Field: &f})
 	}
 	return nil
 }
 
+// fieldOptionKeys appends to keys the field keys referenced by the options
+// of the given rules, including those of the rules' inner rules.
+func fieldOptionKeys(keys []string, rules []*Rule) []string {
+	for _, r := range rules {
+		for _, opt := range r.Options {
+			if opt.Type == OptionTypeField {
+				keys = append(keys, opt.Value)
+			}
+		}
+		if r.Inner != nil {
+			keys = fieldOptionKeys(keys, r.Inner.Rules)
+		}
+	}
+	return keys
+}
+
 // canConvert reports whether src type can be converted to dst type. Note that
 // this does not handle unnamed struct, interface, func, and channel types.
 func canConvert(dst, src Type) bool {
@@ -609,6 +833,14 @@ func canConvert(dst, src Type) bool {
 // canConvertRuleOption reports whether or not the src RuleOption's literal
 // value can be converted to the type represented by dst.
 func canConvertRuleOption(a *analysis, dst Type, src *RuleOption) bool {
+	// dst is *isvalid.Dec, accept numeric options and fields of numeric types
+	if dst.IsDec() {
+		if src.Type == OptionTypeField {
+			return a.info.SelectorMap[src.Value].Last().Type.Kind.IsNumeric()
+		}
+		return src.Type == OptionTypeInt || src.Type == OptionTypeFloat
+	}
+
 	if src.Type == OptionTypeField {
 		field := a.info.SelectorMap[src.Value].Last()
 		// can use the addr, accept
@@ -618,6 +850,35 @@ func canConvertRuleOption(a *analysis, dst Type, src *RuleOption) bool {
 		return canConvert(dst, field.Type)
 	}
 
+	// src is "@now", accept only if dst is time.Time
+	if src.Type == OptionTypeNow {
+		return dst.IsTime()
+	}
+
+	// src is a duration and dst is time.Duration, accept
+	if src.Type == OptionTypeDuration && dst.IsDuration() {
+		return true
+	}
+
+	// dst is a type parameter, accept only if the option can be
+	// converted to every one of the types in the constraint's type set
+	if dst.Kind == TypeKindTypeParam {
+		if src.Type == OptionTypeUnknown || dst.Constraint == nil || len(dst.Constraint.Terms) == 0 {
+			return false
+		}
+		for _, term := range dst.Constraint.Terms {
+			// the option is used as an untyped constant, a string
+			// term will not accept a constant that is not a string
+			if term.Type.Kind == TypeKindString && src.Type != OptionTypeString {
+				return false
+			}
+			if !canConvertRuleOption(a, term.Type, src) {
+				return false
+			}
+		}
+		return true
+	}
+
 	// dst is interface{} or string, accept
 	if dst.IsEmptyInterface || dst.Kind == TypeKindString {
 		return true
@@ -663,6 +924,22 @@ func isImportedType(a *analysis, named *types.Named) bool {
 	return named != nil && named.Obj().Pkg().Path() != a.pkgPath
 }
 
+// setIsImported sets the IsImported field of the given type, and of the types
+// that it's composed of, based on the package in which the target of the
+// analysis is declared.
+func setIsImported(a *analysis, t *Type) {
+	t.IsImported = len(t.Name) > 0 && len(t.PkgPath) > 0 && t.PkgPath != a.pkgPath
+	if t.Key != nil {
+		setIsImported(a, t.Key)
+	}
+	if t.Elem != nil {
+		setIsImported(a, t.Elem)
+	}
+	for i := range t.TypeArgs {
+		setIsImported(a, &t.TypeArgs[i])
+	}
+}
+
 // makeFieldKey constructs a unique field key for the given selector.
 func makeFieldKey(a *analysis, selector []*StructField) (key string) {
 	key = a.fieldKey(selector)
@@ -17,7 +17,7 @@ var testast search.AST
 var testpkg search.Package
 
 func TestMain(m *testing.M) {
-	pkgs, err := search.Search("../testdata/analysis", false, nil, &testast)
+	pkgs, err := search.Search("../testdata/analysis", false, nil, nil, &testast)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -33,6 +33,7 @@ func TestAddRuleFunc(t *testing.T) {
 		rulename string
 		pkgpath  string
 		funcname string
+		ruleconf RuleConfig
 		err      error
 		want     Config
 		printerr bool
@@ -104,6 +105,69 @@ func TestAddRuleFunc(t *testing.T) {
 				typ: &types.Func{},
 			},
 		}},
+	}, {
+		rulename: "myrule",
+		pkgpath:  "github.com/frk/isvalid/internal/testdata/mypkg", funcname: "MyRule3",
+		ruleconf: RuleConfig{
+			OptMin: intptr(0),
+			OptMax: intptr(4),
+			Opts: [][]RuleOptionConfig{
+				{{Key: nil, Value: "1"}},
+				{{Key: nil, Value: "0.5"}, {Key: strptr("half"), Value: "0.5"}},
+				{{Key: nil, Value: "foo"}},
+				{{Key: nil, Value: "true"}},
+			},
+			Err: ErrMesgConfig{Text: "must be my rule", WithOpts: true},
+		},
+		want: Config{customTypeMap: map[string]RuleType{
+			"myrule": RuleTypeFunc{
+				FuncName:     "MyRule3",
+				PkgPath:      "github.com/frk/isvalid/internal/testdata/mypkg",
+				FieldArgType: Type{Kind: TypeKindInt64},
+				OptionArgTypes: []Type{
+					{Kind: TypeKindInt},
+					{Kind: TypeKindFloat64},
+					{Kind: TypeKindString},
+					{Kind: TypeKindBool},
+				},
+				OptionValues: []map[interface{}]*RuleOption{
+					{nil: {Value: "1", Type: OptionTypeInt}},
+					{nil: {Value: "0.5", Type: OptionTypeFloat}, "half": {Value: "0.5", Type: OptionTypeFloat}},
+					{nil: {Value: "foo", Type: OptionTypeString}},
+					{nil: {Value: "true", Type: OptionTypeBool}},
+				},
+				Err:    ErrMesgConfig{Text: "must be my rule", WithOpts: true},
+				acount: &ruleOptCount{0, 4},
+				typ:    &types.Func{},
+			},
+		}},
+	}, {
+		rulename: "myrule",
+		pkgpath:  "github.com/frk/isvalid/internal/testdata/mypkg", funcname: "MyRule3",
+		ruleconf: RuleConfig{Opts: [][]RuleOptionConfig{{{Key: nil, Value: "1"}}}},
+		err:      &anError{Code: errRuleFuncOptMap, fn: &types.Func{}},
+	}, {
+		rulename: "myrule",
+		pkgpath:  "github.com/frk/isvalid/internal/testdata/mypkg", funcname: "MyBadRule4",
+		err: &anError{Code: errRuleFuncTypeParam, fn: &types.Func{}},
+	}, {
+		rulename: "myrule",
+		pkgpath:  "github.com/frk/isvalid/internal/testdata/mypkg", funcname: "MyUnique",
+		want: Config{customTypeMap: map[string]RuleType{
+			"myrule": RuleTypeFunc{
+				FuncName: "MyUnique",
+				PkgPath:  "github.com/frk/isvalid/internal/testdata/mypkg",
+				FieldArgType: Type{Name: "S", Kind: TypeKindTypeParam, Constraint: &Constraint{
+					Terms: []ConstraintTerm{{Tilde: true, Type: Type{Kind: TypeKindSlice,
+						Elem: &Type{Name: "E", Kind: TypeKindTypeParam, Constraint: &Constraint{IsComparable: true}}}}},
+				}},
+				TypeParams: []Type{{Name: "S", Kind: TypeKindTypeParam, Constraint: &Constraint{
+					Terms: []ConstraintTerm{{Tilde: true, Type: Type{Kind: TypeKindSlice,
+						Elem: &Type{Name: "E", Kind: TypeKindTypeParam, Constraint: &Constraint{IsComparable: true}}}}},
+				}}, {Name: "E", Kind: TypeKindTypeParam, Constraint: &Constraint{IsComparable: true}}},
+				typ: &types.Func{},
+			},
+		}},
 	}}
 
 	compare := compare.Config{ObserveFieldTag: "cmp"}
@@ -115,8 +179,11 @@ func TestAddRuleFunc(t *testing.T) {
 				t.Fatal(err)
 			}
 
+			rc := tt.ruleconf
+			rc.Name = tt.rulename
+
 			var conf Config
-			err = conf.AddRuleFunc(tt.rulename, fn)
+			err = conf.AddRuleFunc(rc, fn)
 			if e := compare.Compare(err, tt.err); e != nil {
 				t.Errorf("Error: %v", e)
 			}
@@ -212,9 +279,9 @@ func TestAnalysisRun(t *testing.T) {
 		name: "AnalysisTestBAD_TypeKindStringURLValidator",
 		err:  &anError{Code: errRuleFuncFieldType, a: &analysis{}, f: &StructField{}, r: &Rule{}},
 	}, {
-		name: "AnalysisTestBAD_RuleOptionNumPANValidator",
-		err: &anError{Code: errRuleOptionCount, a: &analysis{}, f: &StructField{},
-			r: &Rule{Options: []*RuleOption{{Value: "foo", Type: OptionTypeString}}},
+		name: "AnalysisTestBAD_RuleOptionValueCardNetworkPANValidator",
+		err: &anError{Code: errRuleOptionValueCardNetwork, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "foo", Type: OptionTypeString},
 		},
 	}, {
 		name: "AnalysisTestBAD_TypeKindStringPANValidator",
@@ -951,6 +1018,204 @@ func TestAnalysisRun(t *testing.T) {
 	}, {
 		name: "AnalysisTestBAD_RuleOptionCountSubfieldValidator",
 		err:  &anError{Code: errRuleOptionCount, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFuncTypeArgValidator",
+		err:  &anError{Code: errRuleFuncTypeArg, a: &analysis{}, f: &StructField{}, r: &Rule{}, fn: &types.Func{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFuncTypeArg2Validator",
+		err:  &anError{Code: errRuleFuncTypeArg, a: &analysis{}, f: &StructField{}, r: &Rule{}, fn: &types.Func{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFuncTypeArg3Validator",
+		err:  &anError{Code: errRuleFuncTypeArg, a: &analysis{}, f: &StructField{}, r: &Rule{}, fn: &types.Func{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFuncOptionTypeTypeParamValidator",
+		err: &anError{Code: errRuleFuncOptionType, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "1", Type: OptionTypeInt},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleFieldTypeParamValidator",
+		err:  &anError{Code: errRuleFieldTypeParam, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFieldNonTimeValidator",
+		err:  &anError{Code: errRuleFieldNonTime, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFieldNonTime2Validator",
+		err:  &anError{Code: errRuleFieldNonTime, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionTypeTimeValidator",
+		err: &anError{Code: errRuleOptionTypeTime, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "2006", Type: OptionTypeInt},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionTypeTime2Validator",
+		err: &anError{Code: errRuleOptionTypeTime, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "G", Type: OptionTypeField},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionTypeTime3Validator",
+		err: &anError{Code: errRuleOptionTypeTime, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "now", Type: OptionTypeNow},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueDurationValidator",
+		err: &anError{Code: errRuleOptionValueDuration, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "-1h", Type: OptionTypeDuration},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueDuration2Validator",
+		err: &anError{Code: errRuleOptionValueDuration, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "G", Type: OptionTypeField},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueWeekdayValidator",
+		err: &anError{Code: errRuleOptionValueWeekday, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "fun", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueWeekday2Validator",
+		err: &anError{Code: errRuleOptionValueConflict, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "Monday", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueHoursValidator",
+		err: &anError{Code: errRuleOptionValueHours, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "9", Type: OptionTypeInt},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueHours2Validator",
+		err: &anError{Code: errRuleOptionValueHours, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "9", Type: OptionTypeInt},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueTimeZoneValidator",
+		err: &anError{Code: errRuleOptionValueTimeZone, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "Mars/Olympus", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueTimeZone2Validator",
+		err: &anError{Code: errRuleOptionValueTimeZone, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "+25:00", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleFieldNonUniqueValidator",
+		err:  &anError{Code: errRuleFieldNonUnique, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleFieldNonUnique2Validator",
+		err:  &anError{Code: errRuleFieldNonUnique, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueUniqueKeyValidator",
+		err: &anError{Code: errRuleOptionValueUniqueKey, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "Name", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleFieldNonCollectionValidator",
+		err:  &anError{Code: errRuleFieldNonCollection, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleInnerRulesValidator",
+		err:  &anError{Code: errRuleInnerRules, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleInnerRules2Validator",
+		err:  &anError{Code: errRuleInnerRules, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleInnerRules3Validator",
+		err:  &anError{Code: errRuleInnerRules, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleTagGroupUnclosedValidator",
+		err:  &anError{Code: errRuleTagSyntax, a: &analysis{}, f: &StructField{}},
+	}, {
+		name: "AnalysisTestBAD_RuleOptionValueElemFieldValidator",
+		err: &anError{Code: errRuleOptionValueElemField, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "Name", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleQuantOptionTypeValidator",
+		err: &anError{Code: errRuleBasicOptionTypeUint, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "foo", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleGroupNonBlankValidator",
+		err:  &anError{Code: errRuleGroupNonBlank, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleGroupFieldTypeValidator",
+		err: &anError{Code: errRuleGroupFieldType, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "F2", Type: OptionTypeField},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleGroupOptionFieldUnknownValidator",
+		err: &anError{Code: errRuleOptionFieldUnknown, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "F3", Type: OptionTypeField},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleBlankNonGroupValidator",
+		err:  &anError{Code: errRuleBlankNonGroup, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_StructRuleFieldUnknownValidator",
+		err:  &anError{Code: errStructRuleFieldUnknown, a: &analysis{}, f: &StructField{}},
+	}, {
+		name: "AnalysisTestBAD_StructRuleTagValidator",
+		err:  &anError{Code: errStructRuleTag, a: &analysis{}, f: &StructField{}},
+	}, {
+		name: "AnalysisTestBAD_StructRuleTag2Validator",
+		err:  &anError{Code: errStructRuleTag, a: &analysis{}, f: &StructField{}},
+	}, {
+		name: "AnalysisTestBAD_StructRuleOptionFieldUnknownValidator",
+		err: &anError{Code: errRuleOptionFieldUnknown, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "F3", Type: OptionTypeField},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleNumberStringOptionTypeValidator",
+		err: &anError{Code: errRuleBasicOptionType, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "abc", Type: OptionTypeString},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleDecimalScaleFieldValidator",
+		err:  &anError{Code: errRuleFieldNonDecimal, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleDecimalPrecisionFieldValidator",
+		err:  &anError{Code: errRuleFieldNonDecimal, a: &analysis{}, f: &StructField{}, r: &Rule{}},
+	}, {
+		name: "AnalysisTestBAD_RuleDecimalScaleOptionTypeValidator",
+		err: &anError{Code: errRuleBasicOptionTypeUint, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "-1", Type: OptionTypeInt},
+		},
+	}, {
+		name: "AnalysisTestBAD_RuleDecimalOptionFieldTypeValidator",
+		err: &anError{Code: errRuleBasicOptionType, a: &analysis{}, f: &StructField{}, r: &Rule{},
+			opt: &RuleOption{Value: "S", Type: OptionTypeField},
+		},
+	}, {
+		name: "AnalysisTestOK_GenericValidator",
+		want: &ValidatorStruct{
+			TypeName:   "AnalysisTestOK_GenericValidator",
+			TypeParams: []string{"T"},
+			Fields: []*StructField{{
+				Name: "F1", Key: "F1",
+				Tag: tagutil.Tag{"is": []string{"myoneof:&F2"}},
+				Type: Type{Name: "T", Kind: TypeKindTypeParam, Constraint: &Constraint{
+					IsComparable: true,
+					Terms: []ConstraintTerm{
+						{Tilde: true, Type: Type{Kind: TypeKindInt}},
+						{Tilde: true, Type: Type{Kind: TypeKindString}},
+					},
+				}},
+				IsExported: true,
+				RuleTag: &TagNode{Rules: []*Rule{{
+					Name:    "myoneof",
+					Options: []*RuleOption{{Value: "F2", Type: OptionTypeField}},
+				}}},
+			}, {
+				Name: "F2", Key: "F2",
+				Type: Type{Name: "T", Kind: TypeKindTypeParam, Constraint: &Constraint{
+					IsComparable: true,
+					Terms: []ConstraintTerm{
+						{Tilde: true, Type: Type{Kind: TypeKindInt}},
+						{Tilde: true, Type: Type{Kind: TypeKindString}},
+					},
+				}},
+				IsExported: true,
+				RuleTag:    &TagNode{},
+			}},
+		},
 	}, {
 		name: "AnalysisTestOK_ErrorConstructorValidator",
 		want: &ValidatorStruct{
@@ -1932,6 +2197,17 @@ func TestAnalysisRun(t *testing.T) {
 		},
 	}
 
+	// generic rule funcs
+	for name, funcname := range map[string]string{"myrange": "MyRange", "myoneof": "MyOneOf"} {
+		fn, err := search.FindFunc("github.com/frk/isvalid/internal/testdata/mypkg", funcname, testast)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := anConf.AddRuleFunc(RuleConfig{Name: name}, fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			match := getMatch(tt.name, t)
@@ -1997,3 +2273,7 @@ func getMatch(name string, t *testing.T) *search.Match {
 	t.Fatal(name, " not found")
 	return nil
 }
+
+func intptr(i int) *int { return &i }
+
+func strptr(s string) *string { return &s }
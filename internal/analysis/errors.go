@@ -73,6 +73,10 @@ func (e *anError) FieldTagRaw(name string) string {
 	return "``"
 }
 
+func (e *anError) FieldIsString() bool {
+	return e.f.Type.PtrBase().Kind == TypeKindString
+}
+
 func (e *anError) FieldNameAndType() string {
 	return e.f.Name + " " + e.FieldType()
 }
@@ -103,6 +107,10 @@ func (e *anError) RuleOptionValue() string {
 		return `"` + e.opt.Value + `"`
 	case OptionTypeField:
 		return "&" + e.opt.Value
+	case OptionTypeNow:
+		return "@now"
+	case OptionTypeDuration:
+		return e.opt.Value
 	}
 
 	panic("shouldn't reach")
@@ -309,6 +317,28 @@ const (
 	errRuleEnumTypeNoConst
 	errRuleKey
 	errRuleElem
+	errRuleFuncTypeParam
+	errRuleFuncTypeArg
+	errRuleFieldTypeParam
+	errRuleFieldNonTime
+	errRuleOptionTypeTime
+	errRuleOptionValueDuration
+	errRuleOptionValueWeekday
+	errRuleOptionValueHours
+	errRuleOptionValueTimeZone
+	errRuleFieldNonUnique
+	errRuleOptionValueUniqueKey
+	errRuleFieldNonCollection
+	errRuleInnerRules
+	errRuleOptionValueElemField
+	errRuleGroupNonBlank
+	errRuleGroupFieldType
+	errRuleBlankNonGroup
+	errStructRuleFieldUnknown
+	errStructRuleTag
+	errRuleFieldNonDecimal
+	errRuleTagSyntax
+	errRuleOptionValueCardNetwork
 )
 
 var error_template_string = `
@@ -383,6 +413,12 @@ var error_template_string = `
   > The option to rule "{{R .RuleName}}" must be a valid Alpha-2 or Alpha-3 country code.
 {{ end }}
 
+{{ define "` + errRuleOptionValueCardNetwork.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}".
+  > The options to rule "{{R .RuleName}}" must be the names of the supported payment card networks.
+{{ end }}
+
 {{ define "` + errRuleOptionValueLanguageTag.name() + `" -}}
 {{R "ERROR:"}} {{.FileAndLine}}: 
   Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}".
@@ -436,6 +472,10 @@ var error_template_string = `
 {{R "ERROR:"}} {{.FileAndLine}}: 
   Cannot use rule "{{R .RuleName}}" with field {{R .FieldNameAndType}}.
   > The rule "{{R .RuleName}}" must be used with a field of one of the numeric types.
+{{- if .FieldIsString }}
+  > The rule "{{R .RuleName}}" can be used with a string field only together with one of the rules` +
+	` {{R "int"}}, {{R "uint"}}, {{R "float"}}, or {{R "decimal"}}, e.g. {{R "int,gt:0"}}.
+{{- end }}
 {{ end }}
 
 {{ define "` + errContextOptionFieldRequired.name() + `" -}}
@@ -521,6 +561,147 @@ var error_template_string = `
   Cannot use elem-rule in tag {{R (.FieldTagRaw "is")}} with field {{R .FieldName}} of type {{R .FieldType}}.
   > An elem-rule must have a corresponding array/slice/map element in the field's type.
 {{ end }}
+
+{{ define "` + errRuleFuncTypeParam.name() + `" -}}
+{{R "ERROR:"}} Cannot use function {{R .FuncNameQualified}} of type {{R .FuncType}} as custom rule function.
+  > The type arguments of a generic rule function must be inferable from its {{R "first"}} parameter's type` +
+	` (the one spot occupied by the rule's field).
+{{ end }}
+
+{{ define "` + errRuleFuncTypeArg.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldName}} of type {{R .FieldType}}.
+  > The generic function {{R .FuncNameQualified}} of type {{R .FuncType}} cannot be instantiated` +
+	` with the field's type, or the type does not satisfy the function's type parameter {{R "constraints"}}.
+{{ end }}
+
+{{ define "` + errRuleFieldTypeParam.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldName}} of type {{R .FieldType}}.
+  > Rule "{{R .RuleName}}" cannot be used with fields whose type is a {{R "type parameter"}}.
+{{ end }}
+
+{{ define "` + errRuleFieldNonTime.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldNameAndType}}.
+  > The rule "{{R .RuleName}}" must be used with a field of type {{R "time.Time"}}` +
+	`{{if eq .RuleName "within"}}, or of type {{R "time.Duration"}}{{end}}.
+{{ end }}
+
+{{ define "` + errRuleOptionTypeTime.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use {{R .RuleOptionValue}} of type {{R .RuleOptionType}} as the {{R .RuleOptionPos}}` +
+	` option to the "{{R .RuleName}}" rule of field {{R .FieldNameAndType}}.
+  > The {{R .RuleOptionPos}} option of the "{{R .RuleName}}" rule must be either {{R "@now"}}` +
+	` or a reference to a field of type {{R "time.Time"}}, and it can be used only with {{R "time.Time"}} fields.
+{{ end }}
+
+{{ define "` + errRuleOptionValueDuration.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}".
+  > The option to rule "{{R .RuleName}}" must be a {{R "non-negative"}} duration, e.g. {{R "90m"}} or {{R "24h"}},` +
+	` or a reference to a field of type {{R "time.Duration"}}.
+{{ end }}
+
+{{ define "` + errRuleOptionValueWeekday.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}".
+  > The options to rule "{{R .RuleName}}" must be names of the days of the week, e.g. {{R "mon"}} or {{R "monday"}}.
+{{ end }}
+
+{{ define "` + errRuleOptionValueHours.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use options "{{R .RuleOptions}}" with rule "{{R .RuleName}}".
+  > The rule "{{R .RuleName}}" must have either {{R "no options"}} or {{R "two options"}}, the opening and` +
+	` the closing hour, that are integers between {{R "0"}} and {{R "24"}} with the opening hour being the lesser of the two.
+{{ end }}
+
+{{ define "` + errRuleOptionValueTimeZone.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}".
+  > The time zone options to rule "{{R .RuleName}}" must be either {{R "Z"}}, {{R "UTC"}},` +
+	` a UTC offset, e.g. {{R "+02:00"}}, a zone abbreviation, e.g. {{R "CET"}}, or an IANA time zone name,` +
+	` e.g. {{R "Europe/Berlin"}}.
+{{ end }}
+
+{{ define "` + errRuleFieldNonUnique.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldNameAndType}}.
+  > The rule "{{R .RuleName}}" can be used only with fields of type {{R "slice"}}, {{R "array"}},` +
+	` or {{R "map"}} whose elements are {{R "comparable"}}.
+{{ end }}
+
+{{ define "` + errRuleOptionValueUniqueKey.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}" of field {{R .FieldNameAndType}}.
+  > The option to rule "{{R .RuleName}}" must be the name of a {{R "comparable"}} field` +
+	` of the struct type that is the element type of the field.
+{{ end }}
+
+{{ define "` + errRuleFieldNonCollection.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldNameAndType}}.
+  > The rule "{{R .RuleName}}" can be used only with fields of type {{R "slice"}}, {{R "array"}}, or {{R "map"}}.
+{{ end }}
+
+{{ define "` + errRuleInnerRules.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" in tag {{R (.FieldTagRaw "is")}} of field {{R .FieldNameAndType}}.
+  > Only the quantifier rules {{R "any"}}, {{R "all"}}, {{R "none"}}, {{R "atleast"}}, {{R "atmost"}},` +
+	` and {{R "exactly"}} can, and must, be followed by a non-empty set of rules enclosed in parentheses,` +
+	` e.g. {{R "any(email)"}}, the enclosed rules cannot include key or elem rules, nor quantifier or "unique" rules.
+{{ end }}
+
+{{ define "` + errRuleOptionValueElemField.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use value {{R .RuleOptionValue}} as option for rule "{{R .RuleName}}" of field {{R .FieldNameAndType}}.
+  > The option must be the name of a field of the struct type that is the element type of the field.
+{{ end }}
+
+{{ define "` + errRuleGroupNonBlank.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldNameAndType}}.
+  > The group rule "{{R .RuleName}}" must be declared in the tag of a blank field,` +
+	` e.g. a field named {{R "_"}} with the tag {{R (raw "is:\"oneof:A:B\"")}}.
+{{ end }}
+
+{{ define "` + errRuleGroupFieldType.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use field {{R .RuleOptionFieldKey}} of type {{R .RuleOptionType}} with rule "{{R .RuleName}}".
+  > The fields of a group rule must be of a type whose value can be compared against its zero value.
+{{ end }}
+
+{{ define "` + errRuleBlankNonGroup.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use tag {{R (.FieldTagRaw "is")}} with blank field.
+  > The tag of a blank field can contain only the group rules {{R "oneof"}}, {{R "anyof"}}, and {{R "allornone"}}.
+{{ end }}
+
+{{ define "` + errStructRuleFieldUnknown.name() + `" -}}
+{{R "ERROR:"}} {{.VtorFileAndLine}}: 
+  Cannot use "isvalid:rule" directive of type {{R .VtorName}} with key {{R .FieldName}}.
+  > The key {{R .FieldName}} does not match the key of any field in {{R .VtorName}}.
+  > The directive must be of the form {{R "isvalid:rule <field-key> <rules>"}}, e.g. {{R "isvalid:rule StartDate lt:&EndDate"}}.
+{{ end }}
+
+{{ define "` + errStructRuleTag.name() + `" -}}
+{{R "ERROR:"}} {{.VtorFileAndLine}}: 
+  Cannot use {{R (.FieldTagRaw "is")}} as the rules of an "isvalid:rule" directive of type {{R .VtorName}}.
+  > The rules of the directive must not be empty and they cannot include key or elem rules.
+{{ end }}
+
+{{ define "` + errRuleFieldNonDecimal.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot use rule "{{R .RuleName}}" with field {{R .FieldNameAndType}}.
+  > The rule "{{R .RuleName}}" must be used with a string field together with the rule {{R "decimal"}},` +
+	` e.g. {{R "decimal,scale:2"}}.
+{{ end }}
+
+{{ define "` + errRuleTagSyntax.name() + `" -}}
+{{R "ERROR:"}} {{.FileAndLine}}: 
+  Cannot parse tag {{R (.FieldTagRaw "is")}} of field {{R .FieldNameAndType}}.
+  > {{.Err}}.
+{{ end }}
 ` // `
 
 var error_templates = template.Must(template.New("t").Funcs(template.FuncMap{
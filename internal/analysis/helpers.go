@@ -146,6 +146,11 @@ func canIsValid(typ types.Type) bool {
 		mm = named
 	} else if iface, ok := typ.(*types.Interface); ok {
 		mm = iface
+	} else if tp, ok := typ.(*types.TypeParam); ok {
+		// the method set of a type parameter is that of its constraint
+		if mm, ok = tp.Constraint().Underlying().(*types.Interface); !ok {
+			return false
+		}
 	} else {
 		return false
 	}
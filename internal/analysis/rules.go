@@ -6,8 +6,11 @@ import (
 	"encoding/json"
 	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/frk/isvalid/internal/search"
+	"github.com/frk/isvalid/internal/tables"
 )
 
 const (
@@ -72,6 +75,79 @@ var defaultRuleTypeMap = map[string]RuleType{
 	},
 	"len":       RuleTypeBasic{check: isValidRuleLen, optmin: 1, optmax: 2},
 	"runecount": RuleTypeBasic{check: isValidRuleRuneCount, optmin: 1, optmax: 2},
+	"unique": RuleTypeBasic{
+		Err:   ErrMesgConfig{Text: "must contain unique elements"},
+		check: isValidRuleUnique, optmin: 0, optmax: 1,
+	},
+
+	// decimal rules
+	"scale": RuleTypeBasic{
+		Err:   ErrMesgConfig{Text: "must not have more digits after the decimal point than", WithOpts: true},
+		check: isValidRuleDecimalDigits, optmin: 1, optmax: 1,
+	},
+	"precision": RuleTypeBasic{
+		Err:   ErrMesgConfig{Text: "must not have more digits than", WithOpts: true},
+		check: isValidRuleDecimalDigits, optmin: 1, optmax: 1,
+	},
+
+	// time rules
+	"before": RuleTypeTime{
+		Err:   ErrMesgConfig{Text: "must be before", WithOpts: true},
+		check: isValidRuleTimeComparison, optmin: 1, optmax: 1,
+	},
+	"after": RuleTypeTime{
+		Err:   ErrMesgConfig{Text: "must be after", WithOpts: true},
+		check: isValidRuleTimeComparison, optmin: 1, optmax: 1,
+	},
+	"future": RuleTypeTime{
+		Err: ErrMesgConfig{Text: "must be in the future"},
+	},
+	"past": RuleTypeTime{
+		Err: ErrMesgConfig{Text: "must be in the past"},
+	},
+	"within": RuleTypeTime{
+		Err:   ErrMesgConfig{Text: "must be within", OptSep: " of ", WithOpts: true},
+		check: isValidRuleWithin, optmin: 1, optmax: 2,
+	},
+	"weekday": RuleTypeTime{
+		Err:   ErrMesgConfig{Text: "must fall on", OptSep: " or ", WithOpts: true},
+		check: isValidRuleWeekday, optmin: 0, optmax: -1,
+	},
+	"businesshours": RuleTypeTime{
+		Err:   ErrMesgConfig{Text: "must be within business hours"},
+		check: isValidRuleBusinessHours, optmin: 0, optmax: 2,
+	},
+
+	// quantifier rules
+	"any": RuleTypeQuant{
+		Err: ErrMesgConfig{Text: "must contain at least one"},
+	},
+	"all": RuleTypeQuant{
+		Err: ErrMesgConfig{Text: "must contain only"},
+	},
+	"none": RuleTypeQuant{
+		Err: ErrMesgConfig{Text: "must not contain any"},
+	},
+	"atleast": RuleTypeQuant{
+		Err: ErrMesgConfig{Text: "must contain at least"}, Counted: true,
+	},
+	"atmost": RuleTypeQuant{
+		Err: ErrMesgConfig{Text: "must contain at most"}, Counted: true,
+	},
+	"exactly": RuleTypeQuant{
+		Err: ErrMesgConfig{Text: "must contain exactly"}, Counted: true,
+	},
+
+	// group rules
+	"oneof": RuleTypeGroup{
+		Err: ErrMesgConfig{Text: "exactly one of"},
+	},
+	"anyof": RuleTypeGroup{
+		Err: ErrMesgConfig{Text: "at least one of"},
+	},
+	"allornone": RuleTypeGroup{
+		Err: ErrMesgConfig{Text: "either all or none of"},
+	},
 
 	// speciél
 	"-isvalid": RuleTypeNop{},
@@ -114,22 +190,41 @@ var defaultRuleTypeMap = map[string]RuleType{
 	},
 }
 
+// RuleConfig is the configuration of a function based rule, it is decoded
+// from the json of a rule function's "isvalid:rule" directive, or from
+// a custom rule's entry in the config file.
 type RuleConfig struct {
-	Name   string `json:"name"`
-	OptMin *int   `json:"opt_min"`
-	OptMax *int   `json:"opt_max"`
-	Opts   [][]struct {
-		Key   *string `json:"key"`
-		Value string  `json:"value"`
-	} `json:"opts"`
-	Err ErrMesgConfig   `json:"err"`
+	// The name of the rule, as used in the "is" tag.
+	Name string `json:"name"`
+	// If set, the minimum and the maximum number of options the rule
+	// accepts, if not set the numbers are inferred from the function.
+	OptMin *int `json:"opt_min"`
+	OptMax *int `json:"opt_max"`
+	// The predefined values of each of the function's options, mapped
+	// by key. The value of the entry with the null key is used as the
+	// default value when the option is omitted from the "is" tag.
+	Opts [][]RuleOptionConfig `json:"opts"`
+	// The configuration of the error message.
+	Err ErrMesgConfig `json:"err"`
+	// If set, the function will be invoked once for each of the rule's
+	// options and the results will be joined with the logical operator.
 	LOp LogicalOperator `json:"log_op"`
 }
 
+// RuleOptionConfig is a single key-value pair of a rule option's predefined values.
+type RuleOptionConfig struct {
+	Key   *string `json:"key"`
+	Value string  `json:"value"`
+}
+
+// ErrMesgConfig is the configuration of a rule's error message.
 type ErrMesgConfig struct {
-	Text     string `json:"text"`
-	OptSep   string `json:"opt_sep"`
-	WithOpts bool   `json:"with_opts"`
+	// The text of the error message.
+	Text string `json:"text"`
+	// The separator used to join the options in the error message.
+	OptSep string `json:"opt_sep"`
+	// If set, the rule's options will be included in the error message.
+	WithOpts bool `json:"with_opts"`
 }
 
 func (conf RuleConfig) RuleTypeFunc(fn *types.Func, isCustom bool) (RuleTypeFunc, error) {
@@ -150,6 +245,12 @@ func (conf RuleConfig) RuleTypeFunc(fn *types.Func, isCustom bool) (RuleTypeFunc
 	for i := 1; i < p.Len(); i++ {
 		rt.OptionArgTypes = append(rt.OptionArgTypes, analyzeType0(p.At(i).Type()))
 	}
+	for i := 0; i < sig.TypeParams().Len(); i++ {
+		rt.TypeParams = append(rt.TypeParams, analyzeType0(sig.TypeParams().At(i)))
+	}
+	if len(rt.TypeParams) > 0 && !rt.canInferTypeArgs() {
+		return RuleTypeFunc{}, &anError{Code: errRuleFuncTypeParam, fn: fn}
+	}
 	rt.Err = conf.Err
 	rt.LOp = conf.LOp
 	rt.typ = fn
@@ -168,7 +269,7 @@ func (conf RuleConfig) RuleTypeFunc(fn *types.Func, isCustom bool) (RuleTypeFunc
 		// If opts were provided, they must match the number
 		// of opts that can be passed to the function.
 		got, want := len(conf.Opts), len(rt.OptionArgTypes)
-		if got != want && (rt.IsVariadic && got != want-1) {
+		if got != want && !(rt.IsVariadic && got == want-1) {
 			return RuleTypeFunc{}, &anError{Code: errRuleFuncOptMap, fn: fn}
 		}
 
@@ -197,12 +298,16 @@ func (conf RuleConfig) RuleTypeFunc(fn *types.Func, isCustom bool) (RuleTypeFunc
 			rt.check = isValidCountryCode
 		case "zip":
 			rt.check = isValidCountryCode
+		case "pan":
+			rt.check = isValidCardNetwork
 		case "uuid":
 			rt.check = isValidRuleUUID
 		case "ip":
 			rt.check = isValidRuleIP
 		case "mac":
 			rt.check = isValidRuleMAC
+		case "iso8601", "rfc3339", "datetime":
+			rt.check = isValidRuleTimeZone
 		case "re":
 			rt.UseRawString = true
 			rt.check = isValidRuleRegexp
@@ -212,6 +317,13 @@ func (conf RuleConfig) RuleTypeFunc(fn *types.Func, isCustom bool) (RuleTypeFunc
 	return rt, nil
 }
 
+// IsBuiltinRule reports whether or not name is the name of a builtin rule. The
+// result is reliable only if LoadRuleTypeFunc has already been invoked.
+func IsBuiltinRule(name string) bool {
+	_, ok := defaultRuleTypeMap[name]
+	return ok
+}
+
 // LoadRuleTypeFunc loads info for pre-defined function rule types. LoadRuleTypeFunc
 // should be invoked only once and before starting the first analysis.
 func LoadRuleTypeFunc(ast search.AST) {
@@ -266,13 +378,13 @@ func isValidRuleValueComparison(a *analysis, r *Rule, t Type, f *StructField) er
 
 // check that the StructField and the RuleOptions represent a valid "number comparison" rule.
 func isValidRuleNumberComparison(a *analysis, r *Rule, t Type, f *StructField) error {
-	// the field's type must be numeric
-	if err := typeIsNumeric(a, r, t, f); err != nil {
+	// the field's type must be numeric, or parsed as such
+	typ, err := numberType(a, r, t, f)
+	if err != nil {
 		return err
 	}
 
 	// rule option must be comparable to the field's type
-	typ := t.PtrBase()
 	for _, opt := range r.Options {
 		if !canConvertRuleOption(a, typ, opt) {
 			return &anError{Code: errRuleBasicOptionType, a: a, f: f, r: r, opt: opt}
@@ -371,10 +483,64 @@ func isValidRuleRuneCount(a *analysis, r *Rule, t Type, f *StructField) error {
 	return nil
 }
 
+// check that the StructField and the RuleOptions represent a valid "scale" or "precision" rule.
+func isValidRuleDecimalDigits(a *analysis, r *Rule, t Type, f *StructField) error {
+	// the digits can be counted only in a string that's parsed as a decimal
+	nr, _ := f.RuleTag.find(r).NumberRule()
+	if t.PtrBase().Kind != TypeKindString || nr == nil || nr.Name != "decimal" {
+		return &anError{Code: errRuleFieldNonDecimal, a: a, f: f, r: r}
+	}
+
+	// rule option must be comparable to a positive integer
+	opt := r.Options[0]
+	if !canConvertRuleOption(a, typeUint, opt) {
+		return &anError{Code: errRuleBasicOptionTypeUint, a: a, f: f, r: r, opt: opt}
+	}
+	return nil
+}
+
+// check that the StructField and the RuleOptions represent a valid "unique" rule.
+func isValidRuleUnique(a *analysis, r *Rule, t Type, f *StructField) error {
+	// associated field's type must have elements
+	t = t.PtrBase()
+	if !hasTypeKind(t, TypeKindArray, TypeKindSlice, TypeKindMap) {
+		return &anError{Code: errRuleFieldNonUnique, a: a, f: f, r: r}
+	}
+
+	// without an option the elements themselves are compared
+	if len(r.Options) == 0 {
+		if !t.Elem.IsComparable() {
+			return &anError{Code: errRuleFieldNonUnique, a: a, f: f, r: r}
+		}
+		return nil
+	}
+
+	// with an option the elements must be structs that have
+	// a comparable field with the name specified by the option
+	opt := r.Options[0]
+	if opt.Type != OptionTypeString {
+		return &anError{Code: errRuleOptionValueUniqueKey, a: a, f: f, r: r, opt: opt}
+	}
+	elem := t.Elem.PtrBase()
+	if elem.Kind != TypeKindStruct {
+		return &anError{Code: errRuleOptionValueUniqueKey, a: a, f: f, r: r, opt: opt}
+	}
+	for _, sf := range elem.Fields {
+		if sf.Name == opt.Value {
+			if !sf.Type.IsComparable() {
+				return &anError{Code: errRuleOptionValueUniqueKey, a: a, f: f, r: r, opt: opt}
+			}
+			return nil
+		}
+	}
+	return &anError{Code: errRuleOptionValueUniqueKey, a: a, f: f, r: r, opt: opt}
+}
+
 // check that the StructField and the RuleOptions represent a valid "rng" rule.
 func isValidRuleRng(a *analysis, r *Rule, t Type, f *StructField) error {
-	// the field's type must be numeric
-	if err := typeIsNumeric(a, r, t, f); err != nil {
+	// the field's type must be numeric, or parsed as such
+	typ, err := numberType(a, r, t, f)
+	if err != nil {
 		return err
 	}
 
@@ -404,7 +570,6 @@ func isValidRuleRng(a *analysis, r *Rule, t Type, f *StructField) error {
 	}
 
 	// rule options must be comparable to the field's type
-	typ := t.PtrBase()
 	for _, opt := range r.Options {
 		if !canConvertRuleOption(a, typ, opt) {
 			return &anError{Code: errRuleFuncOptionType, a: a, f: f, r: r, opt: opt}
@@ -414,6 +579,127 @@ func isValidRuleRng(a *analysis, r *Rule, t Type, f *StructField) error {
 	return nil
 }
 
+// check that the rule's options are either references to time.Time fields or "@now".
+func isValidRuleTimeComparison(a *analysis, r *Rule, t Type, f *StructField) error {
+	for _, opt := range r.Options {
+		if !isTimeOption(a, opt) {
+			return &anError{Code: errRuleOptionTypeTime, a: a, f: f, r: r, opt: opt}
+		}
+	}
+	return nil
+}
+
+// check that the StructField and the RuleOptions represent a valid "within" rule.
+func isValidRuleWithin(a *analysis, r *Rule, t Type, f *StructField) error {
+	// the 1st option must be a non-negative duration
+	switch opt := r.Options[0]; opt.Type {
+	case OptionTypeDuration:
+		d, err := time.ParseDuration(opt.Value)
+		if err != nil || d < 0 {
+			return &anError{Code: errRuleOptionValueDuration, a: a, f: f, r: r, opt: opt, err: err}
+		}
+	case OptionTypeField:
+		if !a.info.SelectorMap[opt.Value].Last().Type.IsDuration() {
+			return &anError{Code: errRuleOptionValueDuration, a: a, f: f, r: r, opt: opt}
+		}
+	default:
+		return &anError{Code: errRuleOptionValueDuration, a: a, f: f, r: r, opt: opt}
+	}
+
+	// a time.Duration field is compared against the duration alone
+	if t.PtrBase().IsDuration() {
+		if len(r.Options) > 1 {
+			return &anError{Code: errRuleOptionTypeTime, a: a, f: f, r: r, opt: r.Options[1]}
+		}
+		return nil
+	}
+
+	// a time.Time field is compared against the 2nd option, or now if omitted
+	if len(r.Options) == 1 {
+		r.Options = append(r.Options, &RuleOption{Value: "now", Type: OptionTypeNow})
+	}
+	if opt := r.Options[1]; !isTimeOption(a, opt) {
+		return &anError{Code: errRuleOptionTypeTime, a: a, f: f, r: r, opt: opt}
+	}
+	return nil
+}
+
+// isTimeOption reports whether or not the option is "@now"
+// or whether it references a field of type time.Time.
+func isTimeOption(a *analysis, opt *RuleOption) bool {
+	switch opt.Type {
+	case OptionTypeNow:
+		return true
+	case OptionTypeField:
+		return a.info.SelectorMap[opt.Value].Last().Type.IsTime()
+	}
+	return false
+}
+
+// weekdays maps the accepted, lower cased, options of
+// the "weekday" rule to the names of the time.Weekday constants.
+var weekdays = map[string]string{
+	"sun": "Sunday", "sunday": "Sunday",
+	"mon": "Monday", "monday": "Monday",
+	"tue": "Tuesday", "tuesday": "Tuesday",
+	"wed": "Wednesday", "wednesday": "Wednesday",
+	"thu": "Thursday", "thursday": "Thursday",
+	"fri": "Friday", "friday": "Friday",
+	"sat": "Saturday", "saturday": "Saturday",
+}
+
+// check that the rule's options are names of unique days of the week. If
+// the rule has no options it is updated to accept Monday through Friday.
+func isValidRuleWeekday(a *analysis, r *Rule, t Type, f *StructField) error {
+	if len(r.Options) == 0 {
+		for _, day := range []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"} {
+			r.Options = append(r.Options, &RuleOption{Value: day, Type: OptionTypeString})
+		}
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, opt := range r.Options {
+		day, ok := weekdays[strings.ToLower(opt.Value)]
+		if !ok || opt.Type != OptionTypeString {
+			return &anError{Code: errRuleOptionValueWeekday, a: a, f: f, r: r, opt: opt}
+		}
+		if seen[day] {
+			return &anError{Code: errRuleOptionValueConflict, a: a, f: f, r: r, opt: opt}
+		}
+		seen[day] = true
+		opt.Value = day
+	}
+	return nil
+}
+
+// check that the rule's options represent the opening and closing hours of
+// a business day. If the rule has no options it is updated to use 9 and 17.
+func isValidRuleBusinessHours(a *analysis, r *Rule, t Type, f *StructField) error {
+	if len(r.Options) == 0 {
+		r.Options = append(r.Options,
+			&RuleOption{Value: "9", Type: OptionTypeInt},
+			&RuleOption{Value: "17", Type: OptionTypeInt})
+		return nil
+	}
+	if len(r.Options) != 2 {
+		return &anError{Code: errRuleOptionValueHours, a: a, f: f, r: r, opt: r.Options[0]}
+	}
+
+	var hours [2]int
+	for i, opt := range r.Options {
+		h, err := strconv.Atoi(opt.Value)
+		if opt.Type != OptionTypeInt || err != nil || h < 0 || h > 24 {
+			return &anError{Code: errRuleOptionValueHours, a: a, f: f, r: r, opt: opt}
+		}
+		hours[i] = h
+	}
+	if hours[0] >= hours[1] {
+		return &anError{Code: errRuleOptionValueHours, a: a, f: f, r: r, opt: r.Options[1]}
+	}
+	return nil
+}
+
 var rxUUIDVer = regexp.MustCompile(`^(?:v?[1-5])$`)
 
 // check that the RuleOptions are valid UUID versions.
@@ -463,6 +749,34 @@ func isValidRuleMAC(a *analysis, r *Rule, t Type, f *StructField) error {
 	return nil
 }
 
+var rxUTCOffset = regexp.MustCompile(`^[+-](?:[01][0-9]|2[0-3])(?::?[0-5][0-9])?$`)
+var rxZoneAbbr = regexp.MustCompile(`^[A-Z]{3,5}$`)
+
+// checks that the rule's options are valid time zones. The first option
+// of the "datetime" rule is the layout and therefore it is not checked.
+func isValidRuleTimeZone(a *analysis, r *Rule, t Type, f *StructField) error {
+	opts := r.Options
+	if r.Name == "datetime" && len(opts) > 0 {
+		opts = opts[1:]
+	}
+	for _, opt := range opts {
+		if opt.Type == OptionTypeField {
+			continue
+		}
+		if opt.Type != OptionTypeString {
+			return &anError{Code: errRuleFuncOptionType, a: a, f: f, r: r, opt: opt}
+		}
+		if strings.EqualFold(opt.Value, "Z") || strings.EqualFold(opt.Value, "UTC") ||
+			rxUTCOffset.MatchString(opt.Value) || rxZoneAbbr.MatchString(opt.Value) {
+			continue
+		}
+		if _, err := time.LoadLocation(opt.Value); err != nil || len(opt.Value) == 0 {
+			return &anError{Code: errRuleOptionValueTimeZone, a: a, f: f, r: r, opt: opt}
+		}
+	}
+	return nil
+}
+
 var rxCountryCode2 = regexp.MustCompile(`^(?i:a(?:d|e|f|g|i|l|m|o|q|r|s|t|u|w|x|z)|b(?:a|b|d|e|f|g|h|i|j|l|m|n|o|q|r|s|t|v|w|y|z)|c(?:a|c|d|f|g|h|i|k|l|m|n|o|r|u|v|w|x|y|z)|d(?:e|j|k|m|o|z)|e(?:c|e|g|h|r|s|t)|f(?:i|j|k|m|o|r)|g(?:a|b|d|e|f|g|h|i|l|m|n|p|q|r|s|t|u|w|y)|h(?:k|m|n|r|t|u)|i(?:d|e|l|m|n|o|q|r|s|t)|j(?:e|m|o|p)|k(?:e|g|h|i|m|n|p|r|w|y|z)|l(?:a|b|c|i|k|r|s|t|u|v|y)|m(?:a|c|d|e|f|g|h|k|l|m|n|o|p|q|r|s|t|u|v|w|x|y|z)|n(?:a|c|e|f|g|i|l|o|p|r|u|z)|om|p(?:a|e|f|g|h|k|l|m|n|r|s|t|w|y)|qa|r(?:e|o|s|u|w)|s(?:a|b|c|d|e|g|h|i|j|k|l|m|n|o|r|s|t|v|x|y|z)|t(?:c|d|f|g|h|j|k|l|m|n|o|r|t|v|w|z)|u(?:a|g|m|s|y|z)|v(?:a|c|e|g|i|n|u)|w(?:f|s)|y(?:e|t)|z(?:a|m|w))$`)
 var rxCountryCode3 = regexp.MustCompile(`^(?i:a(?:bw|fg|go|ia|la|lb|nd|re|rg|rm|sm|ta|tf|tg|us|ut|ze)|b(?:di|el|en|es|fa|gd|gr|hr|hs|ih|lm|lr|lz|mu|ol|ra|rb|rn|tn|vt|wa)|c(?:af|an|ck|he|hl|hn|iv|mr|od|og|ok|ol|om|pv|ri|ub|uw|xr|ym|yp|ze)|d(?:eu|ji|ma|nk|om|za)|e(?:cu|gy|ri|sh|sp|st|th)|f(?:in|ji|lk|ra|ro|sm)|g(?:ab|br|eo|gy|ha|ib|in|lp|mb|nb|nq|rc|rd|rl|tm|uf|um|uy)|h(?:kg|md|nd|rv|ti|un)|i(?:dn|mn|nd|ot|rl|rn|rq|sl|sr|ta)|j(?:am|ey|or|pn)|k(?:az|en|gz|hm|ir|na|or|wt)|l(?:ao|bn|br|by|ca|ie|ka|so|tu|ux|va)|m(?:ac|af|ar|co|da|dg|dv|ex|hl|kd|li|lt|mr|ne|ng|np|oz|rt|sr|tq|us|wi|ys|yt)|n(?:am|cl|er|fk|ga|ic|iu|ld|or|pl|ru|zl)|omn|p(?:ak|an|cn|er|hl|lw|ng|ol|ri|rk|rt|ry|se|yf)|qat|r(?:eu|ou|us|wa)|s(?:au|dn|en|gp|gs|hn|jm|lb|le|lv|mr|om|pm|rb|sd|tp|ur|vk|vn|we|wz|xm|yc|yr)|t(?:ca|cd|go|ha|jk|kl|km|ls|on|to|un|ur|uv|wn|za)|u(?:ga|kr|mi|ry|sa|zb)|v(?:at|ct|en|gb|ir|nm|ut)|w(?:lf|sm)|yem|z(?:af|mb|we)|)$`)
 
@@ -481,6 +795,29 @@ func isValidCountryCode(a *analysis, r *Rule, t Type, f *StructField) error {
 	return nil
 }
 
+// check that the rule's option values are the names of supported payment card networks.
+func isValidCardNetwork(a *analysis, r *Rule, t Type, f *StructField) error {
+	for _, opt := range r.Options {
+		if opt.Type == OptionTypeString {
+			if !isCardNetwork(opt.Value) {
+				return &anError{Code: errRuleOptionValueCardNetwork, a: a, f: f, r: r, opt: opt}
+			}
+		} else if opt.Type != OptionTypeField {
+			return &anError{Code: errRuleFuncOptionType, a: a, f: f, r: r, opt: opt}
+		}
+	}
+	return nil
+}
+
+func isCardNetwork(name string) bool {
+	for _, n := range tables.CardNetworks {
+		if strings.EqualFold(n.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
 // supported language tags
 // TODO(mkopriva): expand!
 var rxLanguageTag = regexp.MustCompile(`^(?i:be|bg|cnr|cs|en|mk|pl|ru|sh|sk|sl|sr|uk|wen)$`)
@@ -499,6 +836,24 @@ func isValidLanguageTag(a *analysis, r *Rule, t Type, f *StructField) error {
 	return nil
 }
 
+// numberType returns the type against which the options of the numeric rule r
+// are checked. That is the field's type if it's numeric or, if the field is a
+// string and r is accompanied by one of the rules "int", "uint", "float", or
+// "decimal", the type into which the generated code parses the string.
+func numberType(a *analysis, r *Rule, t Type, f *StructField) (Type, error) {
+	t = t.PtrBase()
+	if t.Kind == TypeKindString {
+		if nr, typ := f.RuleTag.find(r).NumberRule(); nr != nil {
+			return typ, nil
+		}
+		return t, &anError{Code: errRuleFieldNonNumeric, a: a, f: f, r: r}
+	}
+	if err := typeIsNumeric(a, r, t, f); err != nil {
+		return t, err
+	}
+	return t, nil
+}
+
 // checks that the StructField's type is one of the int/uint/float types.
 func typeIsNumeric(a *analysis, r *Rule, t Type, f *StructField) error {
 	t = t.PtrBase()
@@ -1,6 +1,7 @@
 package analysis
 
 import (
+	"errors"
 	"reflect"
 	"regexp"
 
@@ -56,20 +57,77 @@ func (tn *TagNode) ContainsRules() bool {
 	return false
 }
 
+// numberRuleTypes maps the names of the rules that check whether a string
+// represents a number to the types into which such a string is parsed.
+var numberRuleTypes = map[string]Type{
+	"int":     {Kind: TypeKindInt64},
+	"uint":    {Kind: TypeKindUint64},
+	"float":   {Kind: TypeKindFloat64},
+	"decimal": {Kind: TypeKindPtr, Elem: &Type{Kind: TypeKindStruct, Name: "Dec", PkgPath: "github.com/frk/isvalid"}},
+}
+
+// NumberRule returns the rule of the TagNode that checks whether a string represents
+// a number, i.e. "int", "uint", "float", or "decimal", and the type into which the
+// string is parsed when it needs to be compared by one of the numeric rules,
+// e.g. "gt" or "rng". If the TagNode has no such rule, nil is returned.
+func (tn *TagNode) NumberRule() (*Rule, Type) {
+	if tn != nil {
+		for _, r := range tn.Rules {
+			if typ, ok := numberRuleTypes[r.Name]; ok {
+				return r, typ
+			}
+		}
+	}
+	return nil, Type{}
+}
+
+// find returns the TagNode, from the hierarchy of tn, that contains the
+// Rule r, including the inner rules of quantifier rules, or nil.
+func (tn *TagNode) find(r *Rule) *TagNode {
+	if tn == nil {
+		return nil
+	}
+	for _, x := range tn.Rules {
+		if x == r {
+			return tn
+		}
+		if n := x.Inner.find(r); n != nil {
+			return n
+		}
+	}
+	if n := tn.Key.find(r); n != nil {
+		return n
+	}
+	return tn.Elem.find(r)
+}
+
+// errTagGroupUnclosed is returned by parseRuleTag if a rule's opening
+// parenthesis has no matching closing parenthesis.
+var errTagGroupUnclosed = errors.New("missing closing parenthesis")
+
+// ParseRuleTag parses the "is" key of the given struct tag and returns a node
+// that represents its value as a binary tree, see parseRuleTag for the format.
+func ParseRuleTag(tag string) (*TagNode, error) {
+	return parseRuleTag(tag)
+}
+
 var rxBool = regexp.MustCompile(`^(?:false|true)$`)
+var rxDuration = regexp.MustCompile(`^-?(?:[0-9]+(?:\.[0-9]*)?(?:ns|us|µs|ms|s|m|h))+$`)
 
 // parseRuleTag parses the given tag and returns a node that represents the
 // tag as a binary tree. Following is an *incomplete* attempt to describe the
 // expected format of the "rule" tag in EBNF:
 //
 //      node      = rule | [ "[" [ node ] "]" ] [ ( node | rule "," node ) ] .
-//      rule      = rule_name [ { ":" rule_opt } ] { "," rule } .
+//      rule      = rule_name [ { ":" rule_opt } ] [ "(" node ")" ] { "," rule } .
 //      rule_name = identifier .
-//      rule_opt  = | boolean_lit | integer_lit | float_lit | string_lit | quoted_string_lit | field_reference | context_property .
+//      rule_opt  = | boolean_lit | integer_lit | float_lit | duration_lit | string_lit | quoted_string_lit | field_reference | now | context_property .
 //
 //      boolean_lit       = "true" | "false" .
 //      integer_lit       = "0" | [ "-" ] "1"…"9" { "0"…"9" } .
 //      float_lit         = [ "-" ] ( "0" | "1"…"9" { "0"…"9" } ) "." "0"…"9" { "0"…"9" } .
+//      duration_lit      = [ "-" ] duration_elem { duration_elem } .
+//      duration_elem     = "0"…"9" { "0"…"9" } [ "." { "0"…"9" } ] ( "ns" | "us" | "µs" | "ms" | "s" | "m" | "h" ) .
 //      string_lit        = .
 //      quoted_string_lit = `"` `"` .
 //
@@ -77,7 +135,8 @@ var rxBool = regexp.MustCompile(`^(?:false|true)$`)
 //      field_key           = identifier { field_key_separator identifier } .
 //      field_key_separator = "." | (* optionally specified by the user *)
 //
-//      context_property  = "@" identifier .
+//      now               = "@now" .
+//      context_property  = "@" identifier . (* except "now" *)
 //
 //      identifier        = letter { letter } .
 //      letter            = "A"…"Z" | "a"…"z" | "_" .
@@ -90,7 +149,47 @@ func parseRuleTag(tag string) (*TagNode, error) {
 
 	// parser is invoked recursively to parse tags enclosed in square brackets.
 	var parser func(tag string) (*TagNode, error)
+
+	// group parses the rules enclosed in parentheses, at the start of tag,
+	// as the inner rules of r and returns the rest of the tag.
+	group := func(r *Rule, tag string) (string, error) {
+		// scan up to the *matching* closing parenthesis
+		i, n := 1, 0
+		for i < len(tag) && (tag[i] != ')' || n > 0) {
+			switch tag[i] {
+			case '(':
+				n++
+			case ')':
+				n--
+			case '"':
+				// scan quoted string, ignoring parentheses inside quotes
+				for i++; i < len(tag) && tag[i] != '"'; i++ {
+					if tag[i] == '\\' {
+						i++
+					}
+				}
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return "", errTagGroupUnclosed
+		}
+
+		inner, err := parser(tag[1:i])
+		if err != nil {
+			return "", err
+		}
+		r.Inner = inner
+
+		// drop the closing parenthesis and the rule separator
+		if tag = tag[i+1:]; len(tag) > 0 && tag[0] == ',' {
+			tag = tag[1:]
+		}
+		return tag, nil
+	}
+
 	parser = func(tag string) (*TagNode, error) {
+		var err error
 		tn := &TagNode{}
 		for tag != "" {
 			// skip leading space
@@ -157,9 +256,10 @@ func parseRuleTag(tag string) (*TagNode, error) {
 				return tn, nil
 			}
 
-			// scan to the end of a rule's name
+			// scan to the end of a rule's name, only the names
+			// of quantifier rules can be followed by parentheses
 			i = 0
-			for i < len(tag) && tag[i] != ',' && tag[i] != ':' {
+			for i < len(tag) && tag[i] != ',' && tag[i] != ':' && (tag[i] != '(' || !isQuantRule(tag[:i])) {
 				i++
 			}
 
@@ -171,6 +271,7 @@ func parseRuleTag(tag string) (*TagNode, error) {
 
 			r := &Rule{Name: tag[:i]}
 			tn.Rules = append(tn.Rules, r)
+			quant := isQuantRule(r.Name)
 
 			// this rule's done; next or exit
 			if tag = tag[i:]; tag == "" {
@@ -178,6 +279,11 @@ func parseRuleTag(tag string) (*TagNode, error) {
 			} else if tag[0] == ',' {
 				tag = tag[1:]
 				continue
+			} else if tag[0] == '(' {
+				if tag, err = group(r, tag); err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// scan the rule's options
@@ -211,6 +317,14 @@ func parseRuleTag(tag string) (*TagNode, error) {
 						continue
 					}
 
+					// inner rules?
+					if len(tag) > 0 && tag[0] == '(' && quant {
+						if tag, err = group(r, tag); err != nil {
+							return nil, err
+						}
+						break
+					}
+
 					// drop rule separator
 					if len(tag) > 0 && tag[0] == ',' {
 						tag = tag[1:]
@@ -222,12 +336,15 @@ func parseRuleTag(tag string) (*TagNode, error) {
 
 				// scan to the end of a rule's option
 				i := 0
-				for i < len(tag) && tag[i] != ':' && tag[i] != ',' {
+				for i < len(tag) && tag[i] != ':' && tag[i] != ',' && (tag[i] != '(' || !quant) {
 					i++
 				}
 
 				optstr := tag[:i]
-				if len(optstr) > 0 && optstr[0] == '@' {
+				if optstr == "@now" {
+					opt := &RuleOption{Value: "now", Type: OptionTypeNow}
+					r.Options = append(r.Options, opt)
+				} else if len(optstr) > 0 && optstr[0] == '@' {
 					r.Context = optstr[1:]
 				} else {
 					opt := parseRuleTagOption(optstr)
@@ -240,6 +357,11 @@ func parseRuleTag(tag string) (*TagNode, error) {
 				} else if tag[0] == ',' {
 					tag = tag[1:]
 					break
+				} else if tag[0] == '(' && quant {
+					if tag, err = group(r, tag); err != nil {
+						return nil, err
+					}
+					break
 				}
 			}
 		}
@@ -249,6 +371,12 @@ func parseRuleTag(tag string) (*TagNode, error) {
 	return parser(val)
 }
 
+// isQuantRule reports whether or not name is the name of a quantifier rule.
+func isQuantRule(name string) bool {
+	_, ok := defaultRuleTypeMap[name].(RuleTypeQuant)
+	return ok
+}
+
 // parseRuleTagOption parses the given as a RuleOption and returns the result.
 func parseRuleTagOption(val string) (opt *RuleOption) {
 	opt = &RuleOption{}
@@ -265,6 +393,8 @@ func parseRuleTagOption(val string) (opt *RuleOption) {
 				opt.Type = OptionTypeFloat
 			case rxBool.MatchString(val):
 				opt.Type = OptionTypeBool
+			case rxDuration.MatchString(val):
+				opt.Type = OptionTypeDuration
 			case val != `nil`:
 				opt.Type = OptionTypeString
 			}
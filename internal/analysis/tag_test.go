@@ -42,6 +42,64 @@ func TestParseRuleTag(t *testing.T) {
 			{Value: "true", Type: OptionTypeBool},
 			{Value: "0.0064", Type: OptionTypeFloat},
 		}}}},
+	}, {
+		// single rule with duration options
+		tag: `is:"rule:24h:-1h30m:1.5s:m"`,
+		want: &TagNode{Rules: []*Rule{{Name: "rule", Options: []*RuleOption{
+			{Value: "24h", Type: OptionTypeDuration},
+			{Value: "-1h30m", Type: OptionTypeDuration},
+			{Value: "1.5s", Type: OptionTypeDuration},
+			{Value: "m", Type: OptionTypeString},
+		}}}},
+	}, {
+		// single rule with the "now" option and a context
+		tag: `is:"rule:@now:@ctx"`,
+		want: &TagNode{Rules: []*Rule{{Name: "rule", Context: "ctx", Options: []*RuleOption{
+			{Value: "now", Type: OptionTypeNow},
+		}}}},
+	}, {
+		// rule with inner rules
+		tag: `is:"any(email)"`,
+		want: &TagNode{Rules: []*Rule{{Name: "any", Inner: &TagNode{
+			Rules: []*Rule{{Name: "email"}},
+		}}}},
+	}, {
+		// rule with options and inner rules followed by another rule
+		tag: `is:"atleast:2:Name(required,len:1:5),notnil"`,
+		want: &TagNode{Rules: []*Rule{{Name: "atleast", Options: []*RuleOption{
+			{Value: "2", Type: OptionTypeInt},
+			{Value: "Name", Type: OptionTypeString},
+		}, Inner: &TagNode{Rules: []*Rule{
+			{Name: "required"},
+			{Name: "len", Options: []*RuleOption{
+				{Value: "1", Type: OptionTypeInt},
+				{Value: "5", Type: OptionTypeInt},
+			}},
+		}}}, {Name: "notnil"}}},
+	}, {
+		// parentheses following other rules are not inner rules
+		tag: `is:"re:^(a|b)$,foo(bar)"`,
+		want: &TagNode{Rules: []*Rule{{Name: "re", Options: []*RuleOption{
+			{Value: "^(a|b)$", Type: OptionTypeString},
+		}}, {Name: "foo(bar)"}}},
+	}, {
+		// rule with quoted option and nested inner rules
+		tag: `is:"exactly:\"1\"(none(eq:\"a)b\")),[]required"`,
+		want: &TagNode{Rules: []*Rule{{Name: "exactly", Options: []*RuleOption{
+			{Value: "1", Type: OptionTypeString},
+		}, Inner: &TagNode{Rules: []*Rule{{Name: "none", Inner: &TagNode{
+			Rules: []*Rule{{Name: "eq", Options: []*RuleOption{
+				{Value: "a)b", Type: OptionTypeString},
+			}}},
+		}}}}}}, Elem: &TagNode{Rules: []*Rule{{Name: "required"}}}},
+	}, {
+		// inner rules with no closing parenthesis
+		tag: `is:"atleast:1(required"`,
+		err: errTagGroupUnclosed,
+	}, {
+		// nested inner rules with no closing parenthesis
+		tag: `is:"any(none(email)"`,
+		err: errTagGroupUnclosed,
 	}, {
 		// single rule with empty option
 		tag: `is:"rule:"`,
@@ -1,6 +1,8 @@
 package analysis
 
 import (
+	"encoding/json"
+	"fmt"
 	"go/types"
 	"strconv"
 	"strings"
@@ -25,6 +27,28 @@ type (
 		BeforeValidate *MethodInfo
 		// Info on the validator type's method named "aftervalidate" (case insensitive), or nil.
 		AfterValidate *MethodInfo
+		// The names of the validator struct type's type parameters, if any.
+		TypeParams []string
+		// The group rules declared in the "is" tags of the validator
+		// struct's blank fields, e.g. `_ struct{} is:"oneof:Email:Phone"`.
+		GroupRules []*Rule
+		// The struct-level rules declared with "isvalid:rule" directives
+		// in the validator struct type's documentation.
+		StructRules []*StructRule
+	}
+
+	// StructRule represents the rules declared for a field with an "isvalid:rule"
+	// directive in the validator struct type's documentation, e.g.
+	// "isvalid:rule StartDate lt:&EndDate". The rules of a StructRule are
+	// checked after all of the validator's fields have been validated.
+	StructRule struct {
+		// The synthetic key with which errors are reported to the error
+		// handler, i.e. the key of the field together with the keys of the
+		// fields referenced by the rules' options, joined by a comma.
+		Key string
+		// A copy of the field to which the rules are applied, the copy's
+		// RuleTag holds the rules as parsed from the directive.
+		Field *StructField
 	}
 
 	// StructField describes a single struct field in a ValidatorStruct or
@@ -87,6 +111,30 @@ type (
 		Elem *Type
 		// If kind is struct, Fields will hold the list of the struct's fields.
 		Fields []*StructField
+		// If the type is an instantiated generic type, TypeArgs will
+		// hold the info on the type arguments of the instantiation.
+		TypeArgs []Type
+		// If kind is typeparam, Constraint will hold the
+		// info on the type parameter's constraint.
+		Constraint *Constraint
+	}
+
+	// Constraint represents the constraint of a type parameter.
+	Constraint struct {
+		// Indicates that the type argument must be comparable.
+		IsComparable bool
+		// The terms of the constraint's type set. If empty, the type
+		// set is not restricted by terms, e.g. "any" or "comparable".
+		Terms []ConstraintTerm
+	}
+
+	// ConstraintTerm represents a single term of a constraint's type set.
+	ConstraintTerm struct {
+		// Indicates that the term is of the "~T" form, i.e. that
+		// the term stands for all types whose underlying type is T.
+		Tilde bool
+		// The term's type.
+		Type Type
 	}
 
 	// Const represents the identifier of a declared constant.
@@ -128,6 +176,10 @@ type (
 		Options []*RuleOption
 		// The context property of the rule.
 		Context string
+		// The rules enclosed in the parentheses that follow a quantifier
+		// rule, e.g. "any(email)", these are applied to each element of
+		// the field and the quantifier rule counts the elements that pass.
+		Inner *TagNode
 	}
 
 	// RuleOption represents a rule option as parsed from a "rule" tag.
@@ -174,6 +226,34 @@ type (
 		optmin, optmax int
 	}
 
+	// RuleTypeTime is mapped to Rules that should produce code that
+	// validates a time.Time, or a time.Duration, value by invoking
+	// the methods of the value and by using the "time" package.
+	RuleTypeTime struct {
+		Err ErrMesgConfig
+		// check is a plugin used by the checkRule method.
+		check func(a *analysis, r *Rule, t Type, f *StructField) error
+		// option count requirements, used by the optCount method.
+		optmin, optmax int
+	}
+
+	// RuleTypeQuant is mapped to Rules that should produce code that
+	// validates a slice, array, or map value by counting the elements
+	// that pass the Rule's inner rules.
+	RuleTypeQuant struct {
+		Err ErrMesgConfig
+		// If set, the Rule's first option is the number of elements
+		// against which the count of the passing elements is compared.
+		Counted bool
+	}
+
+	// RuleTypeGroup is mapped to Rules that should produce code that
+	// validates a group of fields by counting those that are set, i.e.
+	// those whose values are not the zero values of their types.
+	RuleTypeGroup struct {
+		Err ErrMesgConfig
+	}
+
 	// RuleTypeFunc is mapped to Rules that should produce code that
 	// validates a value by invoking a function.
 	RuleTypeFunc struct {
@@ -192,6 +272,11 @@ type (
 		OptionValues []map[interface{}]*RuleOption
 		// Indicates whether or not the function's signature is variadic.
 		IsVariadic bool
+		// If the function is generic, TypeParams will hold the info on
+		// the function's type parameters. The types of a generic function's
+		// arguments are resolved, for each field the function is used with,
+		// by the Instantiate method.
+		TypeParams []Type
 		// NOTE(mkopriva): Although currently not enforced, this field is
 		// intended to be used only with binary functions, i.e. functions
 		// that take exactly two arguments, no more, no less.
@@ -315,6 +400,126 @@ func (rt RuleTypeBasic) checkRule(a *analysis, r *Rule, t Type, f *StructField)
 	return nil
 }
 
+// Returns a count based on RuleTypeTime's optmin & optmax values.
+func (rt RuleTypeTime) optCount() ruleOptCount {
+	return ruleOptCount{min: rt.optmin, max: rt.optmax}
+}
+
+func (rt RuleTypeTime) ErrConf() ErrMesgConfig { return rt.Err }
+
+// checkRule checks that the field's type is one of the time types and then
+// invokes the function of RuleTypeTime's check field, if set.
+func (rt RuleTypeTime) checkRule(a *analysis, r *Rule, t Type, f *StructField) error {
+	if ok := rt.optCount().check(len(r.Options)); !ok {
+		return &anError{Code: errRuleOptionCount, a: a, f: f, r: r}
+	}
+	if typ := t.PtrBase(); !typ.IsTime() && !(r.Name == "within" && typ.IsDuration()) {
+		return &anError{Code: errRuleFieldNonTime, a: a, f: f, r: r}
+	}
+	if rt.check != nil {
+		return rt.check(a, r, t, f)
+	}
+	return nil
+}
+
+// Accepts an optional field name, preceded by the number of elements if counted.
+func (rt RuleTypeQuant) optCount() ruleOptCount {
+	if rt.Counted {
+		return ruleOptCount{1, 2}
+	}
+	return ruleOptCount{0, 1}
+}
+
+func (rt RuleTypeQuant) ErrConf() ErrMesgConfig { return rt.Err }
+
+// checkRule checks that the field's type is a collection, that the Rule has
+// inner rules, and that the Rule's options are valid. The inner rules themselves
+// are checked against the element type by the caller.
+func (rt RuleTypeQuant) checkRule(a *analysis, r *Rule, t Type, f *StructField) error {
+	if ok := rt.optCount().check(len(r.Options)); !ok {
+		return &anError{Code: errRuleOptionCount, a: a, f: f, r: r}
+	}
+	typ := t.PtrBase()
+	if !hasTypeKind(typ, TypeKindArray, TypeKindSlice, TypeKindMap) {
+		return &anError{Code: errRuleFieldNonCollection, a: a, f: f, r: r}
+	}
+	if r.Inner == nil || len(r.Inner.Rules) == 0 || r.Inner.Key != nil || r.Inner.Elem != nil {
+		return &anError{Code: errRuleInnerRules, a: a, f: f, r: r}
+	}
+	for _, ir := range r.Inner.Rules {
+		// rules that apply to collections can't be nested
+		if _, ok := defaultRuleTypeMap[ir.Name].(RuleTypeQuant); ok || ir.Name == "unique" {
+			return &anError{Code: errRuleInnerRules, a: a, f: f, r: ir}
+		}
+	}
+
+	opts := r.Options
+	if rt.Counted {
+		if !canConvertRuleOption(a, typeUint, opts[0]) {
+			return &anError{Code: errRuleBasicOptionTypeUint, a: a, f: f, r: r, opt: opts[0]}
+		}
+		opts = opts[1:]
+	}
+	if len(opts) > 0 {
+		if _, ok := rt.ElemType(r, *typ.Elem); !ok {
+			return &anError{Code: errRuleOptionValueElemField, a: a, f: f, r: r, opt: opts[0]}
+		}
+	}
+	return nil
+}
+
+// ElemType returns the type of the values that the inner rules of r are
+// applied to, i.e. either the element type elem, or the type of elem's
+// field named by the Rule's last option. If elem has no such field
+// ElemType returns false.
+func (rt RuleTypeQuant) ElemType(r *Rule, elem Type) (Type, bool) {
+	if n := len(r.Options); n == 0 || (n == 1 && rt.Counted) {
+		return elem, true
+	}
+
+	opt := r.Options[len(r.Options)-1]
+	if opt.Type != OptionTypeString {
+		return elem, false
+	}
+	for _, sf := range elem.PtrBase().Fields {
+		if sf.Name == opt.Value {
+			return sf.Type, true
+		}
+	}
+	return elem, false
+}
+
+// Accepts two or more field keys.
+func (RuleTypeGroup) optCount() ruleOptCount {
+	return ruleOptCount{2, -1}
+}
+
+func (rt RuleTypeGroup) ErrConf() ErrMesgConfig { return rt.Err }
+
+// checkRule checks that the Rule was declared in the tag of a blank field and
+// that each of the Rule's options is the key of a field whose value can be
+// compared against the zero value of its type. The options are converted
+// to options of type OptionTypeField.
+func (rt RuleTypeGroup) checkRule(a *analysis, r *Rule, t Type, f *StructField) error {
+	if f.Name != "_" {
+		return &anError{Code: errRuleGroupNonBlank, a: a, f: f, r: r}
+	}
+	if ok := rt.optCount().check(len(r.Options)); !ok {
+		return &anError{Code: errRuleOptionCount, a: a, f: f, r: r}
+	}
+	for _, opt := range r.Options {
+		opt.Type = OptionTypeField
+		sel, ok := a.info.SelectorMap[opt.Value]
+		if !ok {
+			return &anError{Code: errRuleOptionFieldUnknown, a: a, f: f, r: r, opt: opt}
+		}
+		if !sel.Last().Type.HasZeroCheck() {
+			return &anError{Code: errRuleGroupFieldType, a: a, f: f, r: r, opt: opt}
+		}
+	}
+	return nil
+}
+
 // Returns a count based on RuleTypeFunc's properties.
 func (rt RuleTypeFunc) optCount() ruleOptCount {
 	if rt.acount != nil {
@@ -340,6 +545,15 @@ func (rt RuleTypeFunc) checkRule(a *analysis, r *Rule, t Type, f *StructField) e
 		return &anError{Code: errRuleOptionCount, a: a, f: f, r: r}
 	}
 
+	// generic func cannot be instantiated with field type, fail
+	if len(rt.TypeParams) > 0 {
+		irt, ok := rt.Instantiate(t)
+		if !ok {
+			return &anError{Code: errRuleFuncTypeArg, a: a, f: f, r: r, fn: rt.typ}
+		}
+		rt = irt
+	}
+
 	// field type cannot be converted to func arg type, fail
 	fldType, argType := t.PtrBase(), rt.FieldArgType
 	if rt.IsVariadic && len(rt.OptionArgTypes) == 0 {
@@ -428,6 +642,78 @@ func (rt RuleTypeFunc) adjustRule(r *Rule) {
 	}
 }
 
+// Instantiate returns a copy of rt with its type parameters substituted by the
+// type arguments inferred from t, the type of the field to be validated by the
+// function. If the type arguments cannot be inferred, or if they don't satisfy
+// the constraints of the type parameters, the returned bool will be false.
+// If rt is not generic, Instantiate returns rt as is.
+func (rt RuleTypeFunc) Instantiate(t Type) (RuleTypeFunc, bool) {
+	if len(rt.TypeParams) == 0 {
+		return rt, true
+	}
+
+	argType := rt.FieldArgType
+	if rt.IsVariadic && len(rt.OptionArgTypes) == 0 {
+		argType = *argType.Elem
+	}
+
+	targs := make(map[string]Type)
+	if !unifyTypes(argType, t.PtrBase(), targs) {
+		return rt, false
+	}
+
+	// infer the rest from the core types of the constraints,
+	// e.g. E in "func F[S ~[]E, E any](s S) bool"
+	for n := -1; n != len(targs); {
+		n = len(targs)
+		for _, tp := range rt.TypeParams {
+			targ, ok := targs[tp.Name]
+			if ok && tp.Constraint != nil && len(tp.Constraint.Terms) == 1 {
+				unifyTypes(tp.Constraint.Terms[0].Type, targ.underlying(), targs)
+			}
+		}
+	}
+
+	for _, tp := range rt.TypeParams {
+		targ, ok := targs[tp.Name]
+		if !ok || !tp.Constraint.subst(targs).SatisfiedBy(targ) {
+			return rt, false
+		}
+	}
+
+	optypes := make([]Type, len(rt.OptionArgTypes))
+	for i, optype := range rt.OptionArgTypes {
+		optypes[i] = optype.subst(targs)
+	}
+	rt.FieldArgType = rt.FieldArgType.subst(targs)
+	rt.OptionArgTypes = optypes
+	rt.TypeParams = nil
+	return rt, true
+}
+
+// canInferTypeArgs reports whether or not the type arguments for all of the
+// function's type parameters can be inferred from the type of the field that's
+// to be validated, either directly or from the core type of a constraint.
+func (rt RuleTypeFunc) canInferTypeArgs() bool {
+	known := make(map[string]bool)
+	rt.FieldArgType.typeParamNames(known)
+	for n := -1; n != len(known); {
+		n = len(known)
+		for _, tp := range rt.TypeParams {
+			if known[tp.Name] && tp.Constraint != nil && len(tp.Constraint.Terms) == 1 {
+				tp.Constraint.Terms[0].Type.typeParamNames(known)
+			}
+		}
+	}
+
+	for _, tp := range rt.TypeParams {
+		if !known[tp.Name] {
+			return false
+		}
+	}
+	return true
+}
+
 // PkgName returns the name of the package to which the function belongs.
 func (rt *RuleTypeFunc) PkgName() string {
 	if len(rt.PkgPath) > 0 {
@@ -480,6 +766,13 @@ type ruleOptCount struct {
 	min, max int
 }
 
+// OptionCount returns the minimum and maximum number of options that
+// a Rule of the given RuleType accepts. A max of -1 indicates no limit.
+func OptionCount(rt RuleType) (min, max int) {
+	c := rt.optCount()
+	return c.min, c.max
+}
+
 func (c ruleOptCount) check(num int) bool {
 	if num < c.min || (num > c.max && c.max != -1) {
 		return false
@@ -531,10 +824,18 @@ func (t Type) PtrBase() Type {
 // String retruns a string representation of the t Type.
 func (t Type) String() string {
 	if len(t.Name) > 0 {
+		name := t.Name
 		if t.IsImported {
-			return t.PkgName + "." + t.Name
+			name = t.PkgName + "." + t.Name
 		}
-		return t.Name
+		if len(t.TypeArgs) > 0 {
+			args := make([]string, len(t.TypeArgs))
+			for i, arg := range t.TypeArgs {
+				args[i] = arg.String()
+			}
+			name += "[" + strings.Join(args, ", ") + "]"
+		}
+		return name
 	}
 
 	if t.IsByte {
@@ -580,7 +881,15 @@ func (t Type) Equals(u Type) bool {
 	}
 
 	if len(t.Name) > 0 || len(u.Name) > 0 {
-		return t.Name == u.Name && t.PkgPath == u.PkgPath
+		if t.Name != u.Name || t.PkgPath != u.PkgPath || len(t.TypeArgs) != len(u.TypeArgs) {
+			return false
+		}
+		for i := range t.TypeArgs {
+			if !t.TypeArgs[i].Equals(u.TypeArgs[i]) {
+				return false
+			}
+		}
+		return true
 	}
 	if t.Kind.IsBasic() {
 		return t.Kind == u.Kind
@@ -611,11 +920,241 @@ func (t Type) NeedsConversion(u Type) bool {
 	return true
 }
 
+// IsTime reports whether or not the type t is the time.Time type.
+func (t Type) IsTime() bool {
+	return t.Name == "Time" && t.PkgPath == "time"
+}
+
+// IsDec reports whether or not the type t is the *isvalid.Dec type.
+func (t Type) IsDec() bool {
+	return t.Kind == TypeKindPtr && t.Elem != nil &&
+		t.Elem.Name == "Dec" && t.Elem.PkgPath == "github.com/frk/isvalid"
+}
+
+// HasZeroCheck reports whether or not a value of type t can be compared
+// against the zero value of its type by the generated code, i.e. whether
+// or not the "required" rule can produce code for the type t.
+func (t Type) HasZeroCheck() bool {
+	switch t.Kind {
+	case TypeKindString, TypeKindMap, TypeKindSlice, TypeKindBool,
+		TypeKindPtr, TypeKindInterface:
+		return true
+	case TypeKindStruct:
+		return t.IsTime()
+	}
+	return t.Kind.IsNumeric() && t.Kind != TypeKindUintptr
+}
+
+// IsDuration reports whether or not the type t is the time.Duration type.
+func (t Type) IsDuration() bool {
+	return t.Name == "Duration" && t.PkgPath == "time"
+}
+
 // Reports whether or not the type t represents a pointer type of u.
 func (t Type) PtrOf(u Type) bool {
 	return t.Kind == TypeKindPtr && t.Elem.Equals(u)
 }
 
+// IsComparable reports whether or not values of type t can be compared using
+// the == and != operators. Note that struct fields that were omitted from the
+// analysis are not taken into account.
+func (t Type) IsComparable() bool {
+	switch t.Kind {
+	case TypeKindSlice, TypeKindMap, TypeKindFunc:
+		return false
+	case TypeKindArray:
+		return t.Elem.IsComparable()
+	case TypeKindStruct:
+		for _, f := range t.Fields {
+			if !f.Type.IsComparable() {
+				return false
+			}
+		}
+	case TypeKindTypeParam:
+		if t.Constraint == nil {
+			return false
+		}
+		if t.Constraint.IsComparable {
+			return true
+		}
+		for _, term := range t.Constraint.Terms {
+			if !term.Type.IsComparable() {
+				return false
+			}
+		}
+		return len(t.Constraint.Terms) > 0
+	}
+	return true
+}
+
+// underlying returns the underlying type of t. The underlying type of
+// a type parameter is, for the purposes of the analysis, the type itself.
+func (t Type) underlying() Type {
+	if t.Kind != TypeKindTypeParam {
+		t.Name, t.PkgPath, t.PkgName, t.PkgLocal = "", "", "", ""
+		t.IsImported, t.IsExported = false, false
+		t.TypeArgs = nil
+	}
+	return t
+}
+
+// subst returns a copy of t with the type parameters that are present in
+// t's type hierarchy replaced by their corresponding type arguments.
+func (t Type) subst(targs map[string]Type) Type {
+	if t.Kind == TypeKindTypeParam {
+		if targ, ok := targs[t.Name]; ok {
+			return targ
+		}
+		return t
+	}
+	if t.Key != nil {
+		key := t.Key.subst(targs)
+		t.Key = &key
+	}
+	if t.Elem != nil {
+		elem := t.Elem.subst(targs)
+		t.Elem = &elem
+	}
+	if len(t.TypeArgs) > 0 {
+		args := make([]Type, len(t.TypeArgs))
+		for i, arg := range t.TypeArgs {
+			args[i] = arg.subst(targs)
+		}
+		t.TypeArgs = args
+	}
+	return t
+}
+
+// typeParamNames adds the names of the type parameters that
+// are present in t's type hierarchy to the given map.
+func (t Type) typeParamNames(names map[string]bool) {
+	if t.Kind == TypeKindTypeParam {
+		names[t.Name] = true
+	}
+	if t.Key != nil {
+		t.Key.typeParamNames(names)
+	}
+	if t.Elem != nil {
+		t.Elem.typeParamNames(names)
+	}
+	for _, arg := range t.TypeArgs {
+		arg.typeParamNames(names)
+	}
+}
+
+// unifyTypes unifies the param type, which may contain type parameters, with the
+// arg type and adds the type arguments inferred from that to the targs map. The
+// result reports whether or not the unification was successful.
+func unifyTypes(param, arg Type, targs map[string]Type) bool {
+	if param.Kind == TypeKindTypeParam {
+		if targ, ok := targs[param.Name]; ok {
+			return targ.Equals(arg)
+		}
+		targs[param.Name] = arg
+		return true
+	}
+
+	if len(param.Name) > 0 {
+		if param.Name != arg.Name || param.PkgPath != arg.PkgPath || len(param.TypeArgs) != len(arg.TypeArgs) {
+			return false
+		}
+		for i := range param.TypeArgs {
+			if !unifyTypes(param.TypeArgs[i], arg.TypeArgs[i], targs) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if param.IsEmptyInterface {
+		return true
+	}
+	if param.Kind != arg.Kind {
+		return false
+	}
+
+	switch param.Kind {
+	case TypeKindArray:
+		return param.ArrayLen == arg.ArrayLen && unifyTypes(*param.Elem, *arg.Elem, targs)
+	case TypeKindMap:
+		return unifyTypes(*param.Key, *arg.Key, targs) && unifyTypes(*param.Elem, *arg.Elem, targs)
+	case TypeKindSlice, TypeKindPtr:
+		return unifyTypes(*param.Elem, *arg.Elem, targs)
+	}
+	return param.Kind.IsBasic()
+}
+
+// SatisfiedBy reports whether or not the type t satisfies the constraint c.
+// If t is itself a type parameter then c is satisfied only if the constraint
+// of t implies c. Note that the methods of the constraint are not considered.
+func (c *Constraint) SatisfiedBy(t Type) bool {
+	if c == nil {
+		return true
+	}
+	if c.IsComparable && !t.IsComparable() {
+		return false
+	}
+	if len(c.Terms) == 0 {
+		return true
+	}
+
+	if t.Kind == TypeKindTypeParam {
+		if t.Constraint == nil || len(t.Constraint.Terms) == 0 {
+			return false
+		}
+		for _, term := range t.Constraint.Terms {
+			if !c.includesTerm(term) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, term := range c.Terms {
+		if term.includes(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// includesTerm reports whether or not the type set of the given
+// term is a subset of the type set of the constraint c.
+func (c *Constraint) includesTerm(x ConstraintTerm) bool {
+	for _, term := range c.Terms {
+		if term.Tilde && x.Type.underlying().Equals(term.Type) {
+			return true
+		}
+		if !term.Tilde && !x.Tilde && x.Type.Equals(term.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// subst returns a copy of c with the type parameters that are present in
+// the constraint's terms replaced by their corresponding type arguments.
+func (c *Constraint) subst(targs map[string]Type) *Constraint {
+	if c == nil || len(c.Terms) == 0 {
+		return c
+	}
+
+	cc := &Constraint{IsComparable: c.IsComparable}
+	for _, term := range c.Terms {
+		term.Type = term.Type.subst(targs)
+		cc.Terms = append(cc.Terms, term)
+	}
+	return cc
+}
+
+// includes reports whether or not the type t is a member of the term's type set.
+func (x ConstraintTerm) includes(t Type) bool {
+	if x.Tilde {
+		return t.underlying().Equals(x.Type)
+	}
+	return t.Equals(x.Type)
+}
+
 // Last returns the last element of s, if s has 0 elements Last will panic.
 func (s StructFieldSelector) Last() *StructField {
 	return s[len(s)-1]
@@ -636,15 +1175,19 @@ const (
 	OptionTypeFloat
 	OptionTypeString
 	OptionTypeField
+	OptionTypeNow
+	OptionTypeDuration
 )
 
 var optTypes = [...]string{
-	OptionTypeUnknown: "<unknown>",
-	OptionTypeBool:    "bool",
-	OptionTypeInt:     "int",
-	OptionTypeFloat:   "float",
-	OptionTypeString:  "string",
-	OptionTypeField:   "<field>",
+	OptionTypeUnknown:  "<unknown>",
+	OptionTypeBool:     "bool",
+	OptionTypeInt:      "int",
+	OptionTypeFloat:    "float",
+	OptionTypeString:   "string",
+	OptionTypeField:    "<field>",
+	OptionTypeNow:      "<now>",
+	OptionTypeDuration: "duration",
 }
 
 func (t OptionType) String() string {
@@ -670,6 +1213,35 @@ const (
 	LogicalOr                  // !x && !x && !x....
 )
 
+// UnmarshalJSON implements the json.Unmarshaler interface. In addition
+// to the numeric value the operator can be specified by one of the
+// strings "not", "and", or "or".
+func (op *LogicalOperator) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var u uint
+		if err := json.Unmarshal(data, &u); err != nil {
+			return fmt.Errorf("bad logical operator: %s", data)
+		}
+		*op = LogicalOperator(u)
+		return nil
+	}
+
+	switch strings.ToLower(s) {
+	case "":
+		*op = 0
+	case "not":
+		*op = LogicalNot
+	case "and":
+		*op = LogicalAnd
+	case "or":
+		*op = LogicalOr
+	default:
+		return fmt.Errorf("bad logical operator: %q", s)
+	}
+	return nil
+}
+
 // TypeKind indicates the specific kind of a Go type.
 type TypeKind uint
 
@@ -713,6 +1285,7 @@ const (
 	TypeKindStruct    // try to validate the individual fields
 	TypeKindChan      // don't validate
 	TypeKindFunc      // don't validate
+	TypeKindTypeParam // try to validate with generic functions
 
 	// alisases (basic)
 	TypeKindByte = TypeKindUint8
@@ -780,6 +1353,7 @@ var typeKinds = [...]string{
 	TypeKindStruct:    "struct",
 	TypeKindChan:      "chan",
 	TypeKindFunc:      "func",
+	TypeKindTypeParam: "typeparam",
 }
 
 // typeKinds indexed by types.BasicKind.
@@ -0,0 +1,334 @@
+// Package bcp47 implements the validation and canonicalization of BCP 47
+// (RFC 5646) language tags against the IANA Language Subtag Registry.
+package bcp47
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/frk/isvalid/internal/tables"
+	"github.com/frk/isvalid/l10n/country"
+)
+
+type SubtagType uint8
+
+const (
+	_ SubtagType = iota
+	Language
+	Extlang
+	Script
+	Region
+	Variant
+	Grandfathered
+	Redundant
+)
+
+// Subtag represents a record of the IANA Language Subtag Registry.
+type Subtag struct {
+	// The type of the record.
+	Type SubtagType
+	// The subtag, or the tag in case of grandfathered and redundant records.
+	Subtag string
+	// The prefix of an extlang subtag, i.e. its macrolanguage.
+	Prefix string
+	// The preferred value of a deprecated subtag, or an empty string.
+	Preferred string
+}
+
+// NOTE(mkopriva): The registry table omits the non-deprecated language
+// subtags that are ISO 639 codes and the non-deprecated region subtags that
+// are ISO 3166-1 alpha-2 codes, those are looked up in the tables.ISO639_*
+// and country.ISO31661A_2 maps instead.
+
+var registry = make(map[SubtagType]map[string]Subtag)
+
+func init() {
+	for _, st := range subtags {
+		m, ok := registry[st.Type]
+		if !ok {
+			m = make(map[string]Subtag)
+			registry[st.Type] = m
+		}
+		m[st.Subtag] = st
+	}
+}
+
+// lookup returns the registry record of the given subtag and whether or not
+// the subtag is registered. The subtag is expected to be in lower case.
+func lookup(typ SubtagType, subtag string) (Subtag, bool) {
+	if st, ok := registry[typ][subtag]; ok {
+		return st, true
+	}
+	switch typ {
+	case Language:
+		if _, ok := tables.ISO639_1[subtag]; ok {
+			return Subtag{Type: typ, Subtag: subtag}, true
+		}
+		if _, ok := tables.ISO639_2[subtag]; ok {
+			return Subtag{Type: typ, Subtag: subtag}, true
+		}
+		if _, ok := tables.ISO639_3[subtag]; ok {
+			return Subtag{Type: typ, Subtag: subtag}, true
+		}
+		if _, ok := tables.ISO639_5[subtag]; ok {
+			return Subtag{Type: typ, Subtag: subtag}, true
+		}
+	case Region:
+		if _, ok := country.ISO31661A_2[strings.ToUpper(subtag)]; ok {
+			return Subtag{Type: typ, Subtag: subtag}, true
+		}
+	}
+	return Subtag{}, false
+}
+
+// Tag holds the subtags of a language tag.
+type Tag struct {
+	// The grandfathered tag, in which case the
+	// rest of the fields of the Tag are left empty.
+	Whole      string
+	Language   string
+	Extlangs   []string
+	Script     string
+	Region     string
+	Variants   []string
+	Extensions []string // each extension with its singleton, e.g. "u-ca-gregory"
+	PrivateUse string   // including the "x" singleton, e.g. "x-foo"
+}
+
+// Parse parses the well-formed language tag s into a Tag. The subtags are
+// converted to lower case. Parse reports false if s is not well-formed.
+func Parse(s string) (t Tag, ok bool) {
+	if len(s) == 0 {
+		return t, false
+	}
+	s = strings.ToLower(s)
+	if _, ok := registry[Grandfathered][s]; ok {
+		t.Whole = s
+		return t, true
+	}
+
+	parts := strings.Split(s, "-")
+	for _, p := range parts {
+		if len(p) == 0 || len(p) > 8 || !isalnum(p) {
+			return t, false
+		}
+	}
+
+	// private use tag
+	if parts[0] == "x" {
+		if len(parts) < 2 {
+			return t, false
+		}
+		t.PrivateUse = s
+		return t, true
+	}
+
+	i := 0
+	if p := parts[i]; !isalpha(p) || len(p) < 2 {
+		return t, false
+	}
+	t.Language, i = parts[i], i+1
+
+	// up to three extlangs after a 2-3 letter language
+	for len(t.Language) <= 3 && i < len(parts) && len(t.Extlangs) < 3 && len(parts[i]) == 3 && isalpha(parts[i]) {
+		t.Extlangs, i = append(t.Extlangs, parts[i]), i+1
+	}
+	if i < len(parts) && len(parts[i]) == 4 && isalpha(parts[i]) {
+		t.Script, i = parts[i], i+1
+	}
+	if i < len(parts) && ((len(parts[i]) == 2 && isalpha(parts[i])) || (len(parts[i]) == 3 && isdigit(parts[i]))) {
+		t.Region, i = parts[i], i+1
+	}
+	for i < len(parts) && (len(parts[i]) >= 5 || (len(parts[i]) == 4 && isdigit(parts[i][:1]))) {
+		t.Variants, i = append(t.Variants, parts[i]), i+1
+	}
+
+	// extensions
+	for i < len(parts) && len(parts[i]) == 1 && parts[i] != "x" {
+		j := i + 1
+		for j < len(parts) && len(parts[j]) >= 2 {
+			j++
+		}
+		if j == i+1 { // empty extension
+			return t, false
+		}
+		t.Extensions, i = append(t.Extensions, strings.Join(parts[i:j], "-")), j
+	}
+
+	// private use
+	if i < len(parts) && parts[i] == "x" {
+		if i == len(parts)-1 {
+			return t, false
+		}
+		t.PrivateUse, i = strings.Join(parts[i:], "-"), len(parts)
+	}
+	return t, i == len(parts)
+}
+
+// Valid reports whether or not s is a valid language tag, that is, whether
+// or not s is well-formed and all of its language, extlang, script, region,
+// and variant subtags, and all of its extension singletons, are registered,
+// without any duplicate variants and singletons. Valid is case-insensitive.
+func Valid(s string) bool {
+	t, ok := Parse(s)
+	if !ok {
+		return false
+	}
+	if len(t.Whole) > 0 || len(t.Language) == 0 {
+		return true
+	}
+
+	if _, ok := lookup(Language, t.Language); !ok {
+		return false
+	}
+	for i, e := range t.Extlangs {
+		// only a single extlang is permitted by the registry's prefixes
+		st, ok := lookup(Extlang, e)
+		if !ok || i > 0 || st.Prefix != t.Language {
+			return false
+		}
+	}
+	if len(t.Script) > 0 {
+		if _, ok := lookup(Script, t.Script); !ok {
+			return false
+		}
+	}
+	if len(t.Region) > 0 {
+		if _, ok := lookup(Region, t.Region); !ok {
+			return false
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, v := range t.Variants {
+		if _, ok := lookup(Variant, v); !ok || seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+	for _, e := range t.Extensions {
+		if !extensions[e[:1]] || seen[e[:1]] {
+			return false
+		}
+		seen[e[:1]] = true
+	}
+	return true
+}
+
+// Canonical returns the canonical form of the valid language tag s as
+// specified by RFC 5646 section 4.5, i.e. grandfathered and redundant tags,
+// and deprecated subtags, are replaced with their preferred values, extlang
+// subtags are replaced with their primary language equivalents, and extensions
+// are ordered by their singletons. The subtags are formatted according to the
+// registry's conventions, e.g. "zh-Hant-TW". Canonical reports false if s is
+// not a valid language tag.
+func Canonical(s string) (string, bool) {
+	if !Valid(s) {
+		return "", false
+	}
+
+	s = strings.ToLower(s)
+	for _, typ := range []SubtagType{Grandfathered, Redundant} {
+		if st, ok := registry[typ][s]; ok {
+			if len(st.Preferred) == 0 {
+				return format(s), true
+			}
+			s = st.Preferred
+		}
+	}
+
+	// a redundant tag followed by other subtags, e.g. "sgn-br-x-foo"
+	var prefix Subtag
+	for _, r := range registry[Redundant] {
+		if len(r.Preferred) > 0 && strings.HasPrefix(s, r.Subtag+"-") && len(r.Subtag) > len(prefix.Subtag) {
+			prefix = r
+		}
+	}
+	if len(prefix.Subtag) > 0 {
+		s = prefix.Preferred + s[len(prefix.Subtag):]
+	}
+
+	t, _ := Parse(s)
+	if len(t.Extlangs) > 0 {
+		t.Language, t.Extlangs = t.Extlangs[0], nil
+	}
+	if st, ok := lookup(Language, t.Language); ok && len(st.Preferred) > 0 {
+		t.Language = st.Preferred
+	}
+	if st, ok := lookup(Script, t.Script); ok && len(st.Preferred) > 0 {
+		t.Script = st.Preferred
+	}
+	if st, ok := lookup(Region, t.Region); ok && len(st.Preferred) > 0 {
+		t.Region = st.Preferred
+	}
+	for i, v := range t.Variants {
+		if st, ok := lookup(Variant, v); ok && len(st.Preferred) > 0 {
+			t.Variants[i] = st.Preferred
+		}
+	}
+	sort.Strings(t.Extensions)
+
+	parts := []string{t.Language}
+	for _, p := range []string{t.Script, t.Region} {
+		if len(p) > 0 {
+			parts = append(parts, p)
+		}
+	}
+	parts = append(parts, t.Variants...)
+	parts = append(parts, t.Extensions...)
+	if len(t.PrivateUse) > 0 {
+		parts = append(parts, t.PrivateUse)
+	}
+	if len(t.Language) == 0 {
+		parts = parts[1:]
+	}
+	return format(strings.Join(parts, "-")), true
+}
+
+// format formats the subtags of the lower-cased tag s according to the
+// registry's conventions: 4-letter subtags are title-cased and 2-letter
+// subtags are upper-cased, except for the first subtag and for subtags
+// that follow a singleton.
+func format(s string) string {
+	parts := strings.Split(s, "-")
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i-1]) == 1 {
+			// everything after a singleton is left as is
+			break
+		}
+		switch p := parts[i]; {
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p)
+		case len(p) == 4 && isalpha(p):
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+func isalpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+func isdigit(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isalnum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < 'a' || s[i] > 'z') && (s[i] < '0' || s[i] > '9') {
+			return false
+		}
+	}
+	return true
+}
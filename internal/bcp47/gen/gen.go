@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	GO "github.com/frk/ast/golang"
+	"github.com/frk/isvalid/internal/tables"
+	"github.com/frk/isvalid/l10n/country"
+)
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Println("usage: go run gen.go <language-subtag-registry> <language-tag-extensions-registry>")
+		return
+	}
+
+	subtagsDate, subtags, err := readRecords(os.Args[1])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+	extensionsDate, extensions, err := readRecords(os.Args[2])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	file := buildTableFile(subtags, extensions)
+	file.Preamble = GO.LineComment{" Code generated by gen.go; DO NOT EDIT.\n" +
+		"// Language Subtag Registry File-Date: " + subtagsDate + "\n" +
+		"// Language Tag Extensions Registry File-Date: " + extensionsDate}
+	if err := writeTableFile(file); err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+}
+
+// record is a record of a registry in the record-jar format, i.e. a
+// set of "Field-Name: value" lines, the records are separated by "%%".
+type record map[string][]string
+
+func (r record) get(name string) string {
+	if v := r[name]; len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// readRecords reads the records of the registry file at the given path,
+// and the File-Date of the registry, i.e. the file's first line.
+func readRecords(path string) (date string, recs []record, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	var rec record
+	var last string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if line == "%%" {
+			if rec != nil {
+				recs = append(recs, rec)
+			}
+			rec = make(record)
+			continue
+		}
+		if rec == nil {
+			if v, ok := strings.CutPrefix(line, "File-Date:"); ok {
+				date = strings.TrimSpace(v)
+			}
+			continue
+		}
+
+		// continuation of the previous field's value
+		if len(line) > 0 && (line[0] == ' ' || line[0] == '\t') {
+			if v := rec[last]; len(v) > 0 {
+				v[len(v)-1] += " " + strings.TrimSpace(line)
+			}
+			continue
+		}
+		if i := strings.IndexByte(line, ':'); i > -1 {
+			last = strings.TrimSpace(line[:i])
+			rec[last] = append(rec[last], strings.TrimSpace(line[i+1:]))
+		}
+	}
+	if rec != nil {
+		recs = append(recs, rec)
+	}
+	if err := s.Err(); err != nil {
+		return "", nil, err
+	}
+	if date == "" {
+		return "", nil, fmt.Errorf("%s: no File-Date", path)
+	}
+	return date, recs, nil
+}
+
+type subtag struct {
+	typ       string
+	subtag    string
+	prefix    string
+	preferred string
+}
+
+var subtagTypes = map[string]string{
+	"language":      "Language",
+	"extlang":       "Extlang",
+	"script":        "Script",
+	"region":        "Region",
+	"variant":       "Variant",
+	"grandfathered": "Grandfathered",
+	"redundant":     "Redundant",
+}
+
+var typeOrder = map[string]int{
+	"Language":      1,
+	"Extlang":       2,
+	"Script":        3,
+	"Region":        4,
+	"Variant":       5,
+	"Grandfathered": 6,
+	"Redundant":     7,
+}
+
+func getSubtags(recs []record) (subtags []subtag) {
+	for _, rec := range recs {
+		typ, ok := subtagTypes[rec.get("Type")]
+		if !ok {
+			continue
+		}
+
+		st := subtag{typ: typ}
+		st.preferred = strings.ToLower(rec.get("Preferred-Value"))
+		if typ == "Extlang" {
+			st.prefix = strings.ToLower(rec.get("Prefix"))
+		}
+
+		name := rec.get("Subtag")
+		if typ == "Grandfathered" || typ == "Redundant" {
+			name = rec.get("Tag")
+		}
+		for _, name := range expandRange(strings.ToLower(name)) {
+			// the ISO 639 and ISO 3166-1 alpha-2 codes are
+			// looked up in the corresponding isvalid tables
+			if len(st.preferred) == 0 && len(rec.get("Deprecated")) == 0 {
+				if _, ok := tables.ISO639_1[name]; ok && typ == "Language" {
+					continue
+				}
+				if _, ok := tables.ISO639_2[name]; ok && typ == "Language" {
+					continue
+				}
+				if _, ok := tables.ISO639_3[name]; ok && typ == "Language" {
+					continue
+				}
+				if _, ok := tables.ISO639_5[name]; ok && typ == "Language" {
+					continue
+				}
+				if _, ok := country.ISO31661A_2[strings.ToUpper(name)]; ok && typ == "Region" {
+					continue
+				}
+			}
+
+			st.subtag = name
+			subtags = append(subtags, st)
+		}
+	}
+
+	sort.SliceStable(subtags, func(i, j int) bool {
+		if subtags[i].typ != subtags[j].typ {
+			return typeOrder[subtags[i].typ] < typeOrder[subtags[j].typ]
+		}
+		return subtags[i].subtag < subtags[j].subtag
+	})
+	return subtags
+}
+
+// expandRange expands a range of subtags, e.g. "qaa..qtz", into the
+// individual subtags of the range. Other subtags are returned as is.
+func expandRange(s string) []string {
+	i := strings.Index(s, "..")
+	if i < 0 {
+		return []string{s}
+	}
+
+	from, to := []byte(s[:i]), s[i+2:]
+	var list []string
+	for {
+		list = append(list, string(from))
+		if string(from) >= to {
+			break
+		}
+
+		// increment from the last character
+		for j := len(from) - 1; j >= 0; j-- {
+			if from[j] != 'z' && from[j] != '9' {
+				from[j]++
+				break
+			}
+			if from[j] == 'z' {
+				from[j] = 'a'
+			} else {
+				from[j] = '0'
+			}
+		}
+	}
+	return list
+}
+
+func buildTableFile(subtags, extensions []record) *GO.File {
+	file := new(GO.File)
+	file.PkgName = "bcp47"
+	file.Decls = append(file.Decls, buildSubtagSlice(getSubtags(subtags)))
+	file.Decls = append(file.Decls, buildExtensionMap(extensions))
+	return file
+}
+
+func buildSubtagSlice(subtags []subtag) (decl GO.VarDecl) {
+	slice := GO.SliceLit{Type: GO.SliceType{GO.Ident{"Subtag"}}}
+	elems := GO.ExprList{}
+	for _, st := range subtags {
+		fields := []GO.FieldElement{
+			{Field: "Type", Value: GO.Ident{st.typ}},
+			{Field: "Subtag", Value: GO.StringLit(st.subtag)},
+		}
+		if len(st.prefix) > 0 {
+			fields = append(fields, GO.FieldElement{Field: "Prefix", Value: GO.StringLit(st.prefix)})
+		}
+		if len(st.preferred) > 0 {
+			fields = append(fields, GO.FieldElement{Field: "Preferred", Value: GO.StringLit(st.preferred)})
+		}
+		elems = append(elems, GO.StructLit{Elems: fields, Compact: true})
+	}
+	slice.Elems = elems
+	decl.Spec = GO.ValueSpec{Names: GO.Ident{"subtags"}, Values: slice}
+	return decl
+}
+
+func buildExtensionMap(recs []record) (decl GO.VarDecl) {
+	var ids []string
+	for _, rec := range recs {
+		if id := rec.get("Identifier"); len(id) > 0 {
+			ids = append(ids, strings.ToLower(id))
+		}
+	}
+	sort.Strings(ids)
+
+	m := GO.MapLit{Type: GO.MapType{Key: GO.Ident{"string"}, Value: GO.Ident{"bool"}}, Compact: true}
+	for _, id := range ids {
+		m.Elems = append(m.Elems, GO.KeyElement{Key: GO.StringLit(id), Value: GO.Ident{"true"}})
+	}
+	decl.Spec = GO.ValueSpec{Names: GO.Ident{"extensions"}, Values: m}
+	return decl
+}
+
+func writeTableFile(file *GO.File) (err error) {
+	buf := &bytes.Buffer{}
+	if err := GO.Write(file, buf); err != nil {
+		return err
+	}
+
+	path := "../tables.go"
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	// make it look pretty
+	bs, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	buf = bytes.NewBuffer(bs)
+	if _, err := io.Copy(f, buf); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
@@ -0,0 +1,1120 @@
+package bcp47
+
+var subtags = []Subtag{
+	{Type: Language, Subtag: "in", Preferred: "id"},
+	{Type: Language, Subtag: "iw", Preferred: "he"},
+	{Type: Language, Subtag: "ji", Preferred: "yi"},
+	{Type: Language, Subtag: "jw", Preferred: "jv"},
+	{Type: Language, Subtag: "mo", Preferred: "ro"},
+	{Type: Language, Subtag: "qaa"},
+	{Type: Language, Subtag: "qab"},
+	{Type: Language, Subtag: "qac"},
+	{Type: Language, Subtag: "qad"},
+	{Type: Language, Subtag: "qae"},
+	{Type: Language, Subtag: "qaf"},
+	{Type: Language, Subtag: "qag"},
+	{Type: Language, Subtag: "qah"},
+	{Type: Language, Subtag: "qai"},
+	{Type: Language, Subtag: "qaj"},
+	{Type: Language, Subtag: "qak"},
+	{Type: Language, Subtag: "qal"},
+	{Type: Language, Subtag: "qam"},
+	{Type: Language, Subtag: "qan"},
+	{Type: Language, Subtag: "qao"},
+	{Type: Language, Subtag: "qap"},
+	{Type: Language, Subtag: "qaq"},
+	{Type: Language, Subtag: "qar"},
+	{Type: Language, Subtag: "qas"},
+	{Type: Language, Subtag: "qat"},
+	{Type: Language, Subtag: "qau"},
+	{Type: Language, Subtag: "qav"},
+	{Type: Language, Subtag: "qaw"},
+	{Type: Language, Subtag: "qax"},
+	{Type: Language, Subtag: "qay"},
+	{Type: Language, Subtag: "qaz"},
+	{Type: Language, Subtag: "qba"},
+	{Type: Language, Subtag: "qbb"},
+	{Type: Language, Subtag: "qbc"},
+	{Type: Language, Subtag: "qbd"},
+	{Type: Language, Subtag: "qbe"},
+	{Type: Language, Subtag: "qbf"},
+	{Type: Language, Subtag: "qbg"},
+	{Type: Language, Subtag: "qbh"},
+	{Type: Language, Subtag: "qbi"},
+	{Type: Language, Subtag: "qbj"},
+	{Type: Language, Subtag: "qbk"},
+	{Type: Language, Subtag: "qbl"},
+	{Type: Language, Subtag: "qbm"},
+	{Type: Language, Subtag: "qbn"},
+	{Type: Language, Subtag: "qbo"},
+	{Type: Language, Subtag: "qbp"},
+	{Type: Language, Subtag: "qbq"},
+	{Type: Language, Subtag: "qbr"},
+	{Type: Language, Subtag: "qbs"},
+	{Type: Language, Subtag: "qbt"},
+	{Type: Language, Subtag: "qbu"},
+	{Type: Language, Subtag: "qbv"},
+	{Type: Language, Subtag: "qbw"},
+	{Type: Language, Subtag: "qbx"},
+	{Type: Language, Subtag: "qby"},
+	{Type: Language, Subtag: "qbz"},
+	{Type: Language, Subtag: "qca"},
+	{Type: Language, Subtag: "qcb"},
+	{Type: Language, Subtag: "qcc"},
+	{Type: Language, Subtag: "qcd"},
+	{Type: Language, Subtag: "qce"},
+	{Type: Language, Subtag: "qcf"},
+	{Type: Language, Subtag: "qcg"},
+	{Type: Language, Subtag: "qch"},
+	{Type: Language, Subtag: "qci"},
+	{Type: Language, Subtag: "qcj"},
+	{Type: Language, Subtag: "qck"},
+	{Type: Language, Subtag: "qcl"},
+	{Type: Language, Subtag: "qcm"},
+	{Type: Language, Subtag: "qcn"},
+	{Type: Language, Subtag: "qco"},
+	{Type: Language, Subtag: "qcp"},
+	{Type: Language, Subtag: "qcq"},
+	{Type: Language, Subtag: "qcr"},
+	{Type: Language, Subtag: "qcs"},
+	{Type: Language, Subtag: "qct"},
+	{Type: Language, Subtag: "qcu"},
+	{Type: Language, Subtag: "qcv"},
+	{Type: Language, Subtag: "qcw"},
+	{Type: Language, Subtag: "qcx"},
+	{Type: Language, Subtag: "qcy"},
+	{Type: Language, Subtag: "qcz"},
+	{Type: Language, Subtag: "qda"},
+	{Type: Language, Subtag: "qdb"},
+	{Type: Language, Subtag: "qdc"},
+	{Type: Language, Subtag: "qdd"},
+	{Type: Language, Subtag: "qde"},
+	{Type: Language, Subtag: "qdf"},
+	{Type: Language, Subtag: "qdg"},
+	{Type: Language, Subtag: "qdh"},
+	{Type: Language, Subtag: "qdi"},
+	{Type: Language, Subtag: "qdj"},
+	{Type: Language, Subtag: "qdk"},
+	{Type: Language, Subtag: "qdl"},
+	{Type: Language, Subtag: "qdm"},
+	{Type: Language, Subtag: "qdn"},
+	{Type: Language, Subtag: "qdo"},
+	{Type: Language, Subtag: "qdp"},
+	{Type: Language, Subtag: "qdq"},
+	{Type: Language, Subtag: "qdr"},
+	{Type: Language, Subtag: "qds"},
+	{Type: Language, Subtag: "qdt"},
+	{Type: Language, Subtag: "qdu"},
+	{Type: Language, Subtag: "qdv"},
+	{Type: Language, Subtag: "qdw"},
+	{Type: Language, Subtag: "qdx"},
+	{Type: Language, Subtag: "qdy"},
+	{Type: Language, Subtag: "qdz"},
+	{Type: Language, Subtag: "qea"},
+	{Type: Language, Subtag: "qeb"},
+	{Type: Language, Subtag: "qec"},
+	{Type: Language, Subtag: "qed"},
+	{Type: Language, Subtag: "qee"},
+	{Type: Language, Subtag: "qef"},
+	{Type: Language, Subtag: "qeg"},
+	{Type: Language, Subtag: "qeh"},
+	{Type: Language, Subtag: "qei"},
+	{Type: Language, Subtag: "qej"},
+	{Type: Language, Subtag: "qek"},
+	{Type: Language, Subtag: "qel"},
+	{Type: Language, Subtag: "qem"},
+	{Type: Language, Subtag: "qen"},
+	{Type: Language, Subtag: "qeo"},
+	{Type: Language, Subtag: "qep"},
+	{Type: Language, Subtag: "qeq"},
+	{Type: Language, Subtag: "qer"},
+	{Type: Language, Subtag: "qes"},
+	{Type: Language, Subtag: "qet"},
+	{Type: Language, Subtag: "qeu"},
+	{Type: Language, Subtag: "qev"},
+	{Type: Language, Subtag: "qew"},
+	{Type: Language, Subtag: "qex"},
+	{Type: Language, Subtag: "qey"},
+	{Type: Language, Subtag: "qez"},
+	{Type: Language, Subtag: "qfa"},
+	{Type: Language, Subtag: "qfb"},
+	{Type: Language, Subtag: "qfc"},
+	{Type: Language, Subtag: "qfd"},
+	{Type: Language, Subtag: "qfe"},
+	{Type: Language, Subtag: "qff"},
+	{Type: Language, Subtag: "qfg"},
+	{Type: Language, Subtag: "qfh"},
+	{Type: Language, Subtag: "qfi"},
+	{Type: Language, Subtag: "qfj"},
+	{Type: Language, Subtag: "qfk"},
+	{Type: Language, Subtag: "qfl"},
+	{Type: Language, Subtag: "qfm"},
+	{Type: Language, Subtag: "qfn"},
+	{Type: Language, Subtag: "qfo"},
+	{Type: Language, Subtag: "qfp"},
+	{Type: Language, Subtag: "qfq"},
+	{Type: Language, Subtag: "qfr"},
+	{Type: Language, Subtag: "qfs"},
+	{Type: Language, Subtag: "qft"},
+	{Type: Language, Subtag: "qfu"},
+	{Type: Language, Subtag: "qfv"},
+	{Type: Language, Subtag: "qfw"},
+	{Type: Language, Subtag: "qfx"},
+	{Type: Language, Subtag: "qfy"},
+	{Type: Language, Subtag: "qfz"},
+	{Type: Language, Subtag: "qga"},
+	{Type: Language, Subtag: "qgb"},
+	{Type: Language, Subtag: "qgc"},
+	{Type: Language, Subtag: "qgd"},
+	{Type: Language, Subtag: "qge"},
+	{Type: Language, Subtag: "qgf"},
+	{Type: Language, Subtag: "qgg"},
+	{Type: Language, Subtag: "qgh"},
+	{Type: Language, Subtag: "qgi"},
+	{Type: Language, Subtag: "qgj"},
+	{Type: Language, Subtag: "qgk"},
+	{Type: Language, Subtag: "qgl"},
+	{Type: Language, Subtag: "qgm"},
+	{Type: Language, Subtag: "qgn"},
+	{Type: Language, Subtag: "qgo"},
+	{Type: Language, Subtag: "qgp"},
+	{Type: Language, Subtag: "qgq"},
+	{Type: Language, Subtag: "qgr"},
+	{Type: Language, Subtag: "qgs"},
+	{Type: Language, Subtag: "qgt"},
+	{Type: Language, Subtag: "qgu"},
+	{Type: Language, Subtag: "qgv"},
+	{Type: Language, Subtag: "qgw"},
+	{Type: Language, Subtag: "qgx"},
+	{Type: Language, Subtag: "qgy"},
+	{Type: Language, Subtag: "qgz"},
+	{Type: Language, Subtag: "qha"},
+	{Type: Language, Subtag: "qhb"},
+	{Type: Language, Subtag: "qhc"},
+	{Type: Language, Subtag: "qhd"},
+	{Type: Language, Subtag: "qhe"},
+	{Type: Language, Subtag: "qhf"},
+	{Type: Language, Subtag: "qhg"},
+	{Type: Language, Subtag: "qhh"},
+	{Type: Language, Subtag: "qhi"},
+	{Type: Language, Subtag: "qhj"},
+	{Type: Language, Subtag: "qhk"},
+	{Type: Language, Subtag: "qhl"},
+	{Type: Language, Subtag: "qhm"},
+	{Type: Language, Subtag: "qhn"},
+	{Type: Language, Subtag: "qho"},
+	{Type: Language, Subtag: "qhp"},
+	{Type: Language, Subtag: "qhq"},
+	{Type: Language, Subtag: "qhr"},
+	{Type: Language, Subtag: "qhs"},
+	{Type: Language, Subtag: "qht"},
+	{Type: Language, Subtag: "qhu"},
+	{Type: Language, Subtag: "qhv"},
+	{Type: Language, Subtag: "qhw"},
+	{Type: Language, Subtag: "qhx"},
+	{Type: Language, Subtag: "qhy"},
+	{Type: Language, Subtag: "qhz"},
+	{Type: Language, Subtag: "qia"},
+	{Type: Language, Subtag: "qib"},
+	{Type: Language, Subtag: "qic"},
+	{Type: Language, Subtag: "qid"},
+	{Type: Language, Subtag: "qie"},
+	{Type: Language, Subtag: "qif"},
+	{Type: Language, Subtag: "qig"},
+	{Type: Language, Subtag: "qih"},
+	{Type: Language, Subtag: "qii"},
+	{Type: Language, Subtag: "qij"},
+	{Type: Language, Subtag: "qik"},
+	{Type: Language, Subtag: "qil"},
+	{Type: Language, Subtag: "qim"},
+	{Type: Language, Subtag: "qin"},
+	{Type: Language, Subtag: "qio"},
+	{Type: Language, Subtag: "qip"},
+	{Type: Language, Subtag: "qiq"},
+	{Type: Language, Subtag: "qir"},
+	{Type: Language, Subtag: "qis"},
+	{Type: Language, Subtag: "qit"},
+	{Type: Language, Subtag: "qiu"},
+	{Type: Language, Subtag: "qiv"},
+	{Type: Language, Subtag: "qiw"},
+	{Type: Language, Subtag: "qix"},
+	{Type: Language, Subtag: "qiy"},
+	{Type: Language, Subtag: "qiz"},
+	{Type: Language, Subtag: "qja"},
+	{Type: Language, Subtag: "qjb"},
+	{Type: Language, Subtag: "qjc"},
+	{Type: Language, Subtag: "qjd"},
+	{Type: Language, Subtag: "qje"},
+	{Type: Language, Subtag: "qjf"},
+	{Type: Language, Subtag: "qjg"},
+	{Type: Language, Subtag: "qjh"},
+	{Type: Language, Subtag: "qji"},
+	{Type: Language, Subtag: "qjj"},
+	{Type: Language, Subtag: "qjk"},
+	{Type: Language, Subtag: "qjl"},
+	{Type: Language, Subtag: "qjm"},
+	{Type: Language, Subtag: "qjn"},
+	{Type: Language, Subtag: "qjo"},
+	{Type: Language, Subtag: "qjp"},
+	{Type: Language, Subtag: "qjq"},
+	{Type: Language, Subtag: "qjr"},
+	{Type: Language, Subtag: "qjs"},
+	{Type: Language, Subtag: "qjt"},
+	{Type: Language, Subtag: "qju"},
+	{Type: Language, Subtag: "qjv"},
+	{Type: Language, Subtag: "qjw"},
+	{Type: Language, Subtag: "qjx"},
+	{Type: Language, Subtag: "qjy"},
+	{Type: Language, Subtag: "qjz"},
+	{Type: Language, Subtag: "qka"},
+	{Type: Language, Subtag: "qkb"},
+	{Type: Language, Subtag: "qkc"},
+	{Type: Language, Subtag: "qkd"},
+	{Type: Language, Subtag: "qke"},
+	{Type: Language, Subtag: "qkf"},
+	{Type: Language, Subtag: "qkg"},
+	{Type: Language, Subtag: "qkh"},
+	{Type: Language, Subtag: "qki"},
+	{Type: Language, Subtag: "qkj"},
+	{Type: Language, Subtag: "qkk"},
+	{Type: Language, Subtag: "qkl"},
+	{Type: Language, Subtag: "qkm"},
+	{Type: Language, Subtag: "qkn"},
+	{Type: Language, Subtag: "qko"},
+	{Type: Language, Subtag: "qkp"},
+	{Type: Language, Subtag: "qkq"},
+	{Type: Language, Subtag: "qkr"},
+	{Type: Language, Subtag: "qks"},
+	{Type: Language, Subtag: "qkt"},
+	{Type: Language, Subtag: "qku"},
+	{Type: Language, Subtag: "qkv"},
+	{Type: Language, Subtag: "qkw"},
+	{Type: Language, Subtag: "qkx"},
+	{Type: Language, Subtag: "qky"},
+	{Type: Language, Subtag: "qkz"},
+	{Type: Language, Subtag: "qla"},
+	{Type: Language, Subtag: "qlb"},
+	{Type: Language, Subtag: "qlc"},
+	{Type: Language, Subtag: "qld"},
+	{Type: Language, Subtag: "qle"},
+	{Type: Language, Subtag: "qlf"},
+	{Type: Language, Subtag: "qlg"},
+	{Type: Language, Subtag: "qlh"},
+	{Type: Language, Subtag: "qli"},
+	{Type: Language, Subtag: "qlj"},
+	{Type: Language, Subtag: "qlk"},
+	{Type: Language, Subtag: "qll"},
+	{Type: Language, Subtag: "qlm"},
+	{Type: Language, Subtag: "qln"},
+	{Type: Language, Subtag: "qlo"},
+	{Type: Language, Subtag: "qlp"},
+	{Type: Language, Subtag: "qlq"},
+	{Type: Language, Subtag: "qlr"},
+	{Type: Language, Subtag: "qls"},
+	{Type: Language, Subtag: "qlt"},
+	{Type: Language, Subtag: "qlu"},
+	{Type: Language, Subtag: "qlv"},
+	{Type: Language, Subtag: "qlw"},
+	{Type: Language, Subtag: "qlx"},
+	{Type: Language, Subtag: "qly"},
+	{Type: Language, Subtag: "qlz"},
+	{Type: Language, Subtag: "qma"},
+	{Type: Language, Subtag: "qmb"},
+	{Type: Language, Subtag: "qmc"},
+	{Type: Language, Subtag: "qmd"},
+	{Type: Language, Subtag: "qme"},
+	{Type: Language, Subtag: "qmf"},
+	{Type: Language, Subtag: "qmg"},
+	{Type: Language, Subtag: "qmh"},
+	{Type: Language, Subtag: "qmi"},
+	{Type: Language, Subtag: "qmj"},
+	{Type: Language, Subtag: "qmk"},
+	{Type: Language, Subtag: "qml"},
+	{Type: Language, Subtag: "qmm"},
+	{Type: Language, Subtag: "qmn"},
+	{Type: Language, Subtag: "qmo"},
+	{Type: Language, Subtag: "qmp"},
+	{Type: Language, Subtag: "qmq"},
+	{Type: Language, Subtag: "qmr"},
+	{Type: Language, Subtag: "qms"},
+	{Type: Language, Subtag: "qmt"},
+	{Type: Language, Subtag: "qmu"},
+	{Type: Language, Subtag: "qmv"},
+	{Type: Language, Subtag: "qmw"},
+	{Type: Language, Subtag: "qmx"},
+	{Type: Language, Subtag: "qmy"},
+	{Type: Language, Subtag: "qmz"},
+	{Type: Language, Subtag: "qna"},
+	{Type: Language, Subtag: "qnb"},
+	{Type: Language, Subtag: "qnc"},
+	{Type: Language, Subtag: "qnd"},
+	{Type: Language, Subtag: "qne"},
+	{Type: Language, Subtag: "qnf"},
+	{Type: Language, Subtag: "qng"},
+	{Type: Language, Subtag: "qnh"},
+	{Type: Language, Subtag: "qni"},
+	{Type: Language, Subtag: "qnj"},
+	{Type: Language, Subtag: "qnk"},
+	{Type: Language, Subtag: "qnl"},
+	{Type: Language, Subtag: "qnm"},
+	{Type: Language, Subtag: "qnn"},
+	{Type: Language, Subtag: "qno"},
+	{Type: Language, Subtag: "qnp"},
+	{Type: Language, Subtag: "qnq"},
+	{Type: Language, Subtag: "qnr"},
+	{Type: Language, Subtag: "qns"},
+	{Type: Language, Subtag: "qnt"},
+	{Type: Language, Subtag: "qnu"},
+	{Type: Language, Subtag: "qnv"},
+	{Type: Language, Subtag: "qnw"},
+	{Type: Language, Subtag: "qnx"},
+	{Type: Language, Subtag: "qny"},
+	{Type: Language, Subtag: "qnz"},
+	{Type: Language, Subtag: "qoa"},
+	{Type: Language, Subtag: "qob"},
+	{Type: Language, Subtag: "qoc"},
+	{Type: Language, Subtag: "qod"},
+	{Type: Language, Subtag: "qoe"},
+	{Type: Language, Subtag: "qof"},
+	{Type: Language, Subtag: "qog"},
+	{Type: Language, Subtag: "qoh"},
+	{Type: Language, Subtag: "qoi"},
+	{Type: Language, Subtag: "qoj"},
+	{Type: Language, Subtag: "qok"},
+	{Type: Language, Subtag: "qol"},
+	{Type: Language, Subtag: "qom"},
+	{Type: Language, Subtag: "qon"},
+	{Type: Language, Subtag: "qoo"},
+	{Type: Language, Subtag: "qop"},
+	{Type: Language, Subtag: "qoq"},
+	{Type: Language, Subtag: "qor"},
+	{Type: Language, Subtag: "qos"},
+	{Type: Language, Subtag: "qot"},
+	{Type: Language, Subtag: "qou"},
+	{Type: Language, Subtag: "qov"},
+	{Type: Language, Subtag: "qow"},
+	{Type: Language, Subtag: "qox"},
+	{Type: Language, Subtag: "qoy"},
+	{Type: Language, Subtag: "qoz"},
+	{Type: Language, Subtag: "qpa"},
+	{Type: Language, Subtag: "qpb"},
+	{Type: Language, Subtag: "qpc"},
+	{Type: Language, Subtag: "qpd"},
+	{Type: Language, Subtag: "qpe"},
+	{Type: Language, Subtag: "qpf"},
+	{Type: Language, Subtag: "qpg"},
+	{Type: Language, Subtag: "qph"},
+	{Type: Language, Subtag: "qpi"},
+	{Type: Language, Subtag: "qpj"},
+	{Type: Language, Subtag: "qpk"},
+	{Type: Language, Subtag: "qpl"},
+	{Type: Language, Subtag: "qpm"},
+	{Type: Language, Subtag: "qpn"},
+	{Type: Language, Subtag: "qpo"},
+	{Type: Language, Subtag: "qpp"},
+	{Type: Language, Subtag: "qpq"},
+	{Type: Language, Subtag: "qpr"},
+	{Type: Language, Subtag: "qps"},
+	{Type: Language, Subtag: "qpt"},
+	{Type: Language, Subtag: "qpu"},
+	{Type: Language, Subtag: "qpv"},
+	{Type: Language, Subtag: "qpw"},
+	{Type: Language, Subtag: "qpx"},
+	{Type: Language, Subtag: "qpy"},
+	{Type: Language, Subtag: "qpz"},
+	{Type: Language, Subtag: "qqa"},
+	{Type: Language, Subtag: "qqb"},
+	{Type: Language, Subtag: "qqc"},
+	{Type: Language, Subtag: "qqd"},
+	{Type: Language, Subtag: "qqe"},
+	{Type: Language, Subtag: "qqf"},
+	{Type: Language, Subtag: "qqg"},
+	{Type: Language, Subtag: "qqh"},
+	{Type: Language, Subtag: "qqi"},
+	{Type: Language, Subtag: "qqj"},
+	{Type: Language, Subtag: "qqk"},
+	{Type: Language, Subtag: "qql"},
+	{Type: Language, Subtag: "qqm"},
+	{Type: Language, Subtag: "qqn"},
+	{Type: Language, Subtag: "qqo"},
+	{Type: Language, Subtag: "qqp"},
+	{Type: Language, Subtag: "qqq"},
+	{Type: Language, Subtag: "qqr"},
+	{Type: Language, Subtag: "qqs"},
+	{Type: Language, Subtag: "qqt"},
+	{Type: Language, Subtag: "qqu"},
+	{Type: Language, Subtag: "qqv"},
+	{Type: Language, Subtag: "qqw"},
+	{Type: Language, Subtag: "qqx"},
+	{Type: Language, Subtag: "qqy"},
+	{Type: Language, Subtag: "qqz"},
+	{Type: Language, Subtag: "qra"},
+	{Type: Language, Subtag: "qrb"},
+	{Type: Language, Subtag: "qrc"},
+	{Type: Language, Subtag: "qrd"},
+	{Type: Language, Subtag: "qre"},
+	{Type: Language, Subtag: "qrf"},
+	{Type: Language, Subtag: "qrg"},
+	{Type: Language, Subtag: "qrh"},
+	{Type: Language, Subtag: "qri"},
+	{Type: Language, Subtag: "qrj"},
+	{Type: Language, Subtag: "qrk"},
+	{Type: Language, Subtag: "qrl"},
+	{Type: Language, Subtag: "qrm"},
+	{Type: Language, Subtag: "qrn"},
+	{Type: Language, Subtag: "qro"},
+	{Type: Language, Subtag: "qrp"},
+	{Type: Language, Subtag: "qrq"},
+	{Type: Language, Subtag: "qrr"},
+	{Type: Language, Subtag: "qrs"},
+	{Type: Language, Subtag: "qrt"},
+	{Type: Language, Subtag: "qru"},
+	{Type: Language, Subtag: "qrv"},
+	{Type: Language, Subtag: "qrw"},
+	{Type: Language, Subtag: "qrx"},
+	{Type: Language, Subtag: "qry"},
+	{Type: Language, Subtag: "qrz"},
+	{Type: Language, Subtag: "qsa"},
+	{Type: Language, Subtag: "qsb"},
+	{Type: Language, Subtag: "qsc"},
+	{Type: Language, Subtag: "qsd"},
+	{Type: Language, Subtag: "qse"},
+	{Type: Language, Subtag: "qsf"},
+	{Type: Language, Subtag: "qsg"},
+	{Type: Language, Subtag: "qsh"},
+	{Type: Language, Subtag: "qsi"},
+	{Type: Language, Subtag: "qsj"},
+	{Type: Language, Subtag: "qsk"},
+	{Type: Language, Subtag: "qsl"},
+	{Type: Language, Subtag: "qsm"},
+	{Type: Language, Subtag: "qsn"},
+	{Type: Language, Subtag: "qso"},
+	{Type: Language, Subtag: "qsp"},
+	{Type: Language, Subtag: "qsq"},
+	{Type: Language, Subtag: "qsr"},
+	{Type: Language, Subtag: "qss"},
+	{Type: Language, Subtag: "qst"},
+	{Type: Language, Subtag: "qsu"},
+	{Type: Language, Subtag: "qsv"},
+	{Type: Language, Subtag: "qsw"},
+	{Type: Language, Subtag: "qsx"},
+	{Type: Language, Subtag: "qsy"},
+	{Type: Language, Subtag: "qsz"},
+	{Type: Language, Subtag: "qta"},
+	{Type: Language, Subtag: "qtb"},
+	{Type: Language, Subtag: "qtc"},
+	{Type: Language, Subtag: "qtd"},
+	{Type: Language, Subtag: "qte"},
+	{Type: Language, Subtag: "qtf"},
+	{Type: Language, Subtag: "qtg"},
+	{Type: Language, Subtag: "qth"},
+	{Type: Language, Subtag: "qti"},
+	{Type: Language, Subtag: "qtj"},
+	{Type: Language, Subtag: "qtk"},
+	{Type: Language, Subtag: "qtl"},
+	{Type: Language, Subtag: "qtm"},
+	{Type: Language, Subtag: "qtn"},
+	{Type: Language, Subtag: "qto"},
+	{Type: Language, Subtag: "qtp"},
+	{Type: Language, Subtag: "qtq"},
+	{Type: Language, Subtag: "qtr"},
+	{Type: Language, Subtag: "qts"},
+	{Type: Language, Subtag: "qtt"},
+	{Type: Language, Subtag: "qtu"},
+	{Type: Language, Subtag: "qtv"},
+	{Type: Language, Subtag: "qtw"},
+	{Type: Language, Subtag: "qtx"},
+	{Type: Language, Subtag: "qty"},
+	{Type: Language, Subtag: "qtz"},
+	{Type: Extlang, Subtag: "aao", Prefix: "ar", Preferred: "aao"},
+	{Type: Extlang, Subtag: "abh", Prefix: "ar", Preferred: "abh"},
+	{Type: Extlang, Subtag: "abv", Prefix: "ar", Preferred: "abv"},
+	{Type: Extlang, Subtag: "acm", Prefix: "ar", Preferred: "acm"},
+	{Type: Extlang, Subtag: "acq", Prefix: "ar", Preferred: "acq"},
+	{Type: Extlang, Subtag: "acw", Prefix: "ar", Preferred: "acw"},
+	{Type: Extlang, Subtag: "acx", Prefix: "ar", Preferred: "acx"},
+	{Type: Extlang, Subtag: "acy", Prefix: "ar", Preferred: "acy"},
+	{Type: Extlang, Subtag: "adf", Prefix: "ar", Preferred: "adf"},
+	{Type: Extlang, Subtag: "aeb", Prefix: "ar", Preferred: "aeb"},
+	{Type: Extlang, Subtag: "aec", Prefix: "ar", Preferred: "aec"},
+	{Type: Extlang, Subtag: "afb", Prefix: "ar", Preferred: "afb"},
+	{Type: Extlang, Subtag: "ajp", Prefix: "ar", Preferred: "ajp"},
+	{Type: Extlang, Subtag: "apc", Prefix: "ar", Preferred: "apc"},
+	{Type: Extlang, Subtag: "apd", Prefix: "ar", Preferred: "apd"},
+	{Type: Extlang, Subtag: "arb", Prefix: "ar", Preferred: "arb"},
+	{Type: Extlang, Subtag: "arq", Prefix: "ar", Preferred: "arq"},
+	{Type: Extlang, Subtag: "ars", Prefix: "ar", Preferred: "ars"},
+	{Type: Extlang, Subtag: "ary", Prefix: "ar", Preferred: "ary"},
+	{Type: Extlang, Subtag: "arz", Prefix: "ar", Preferred: "arz"},
+	{Type: Extlang, Subtag: "ase", Prefix: "sgn", Preferred: "ase"},
+	{Type: Extlang, Subtag: "auz", Prefix: "ar", Preferred: "auz"},
+	{Type: Extlang, Subtag: "avl", Prefix: "ar", Preferred: "avl"},
+	{Type: Extlang, Subtag: "ayh", Prefix: "ar", Preferred: "ayh"},
+	{Type: Extlang, Subtag: "ayl", Prefix: "ar", Preferred: "ayl"},
+	{Type: Extlang, Subtag: "ayn", Prefix: "ar", Preferred: "ayn"},
+	{Type: Extlang, Subtag: "ayp", Prefix: "ar", Preferred: "ayp"},
+	{Type: Extlang, Subtag: "bfi", Prefix: "sgn", Preferred: "bfi"},
+	{Type: Extlang, Subtag: "btj", Prefix: "ms", Preferred: "btj"},
+	{Type: Extlang, Subtag: "bve", Prefix: "ms", Preferred: "bve"},
+	{Type: Extlang, Subtag: "bvu", Prefix: "ms", Preferred: "bvu"},
+	{Type: Extlang, Subtag: "bzs", Prefix: "sgn", Preferred: "bzs"},
+	{Type: Extlang, Subtag: "cdo", Prefix: "zh", Preferred: "cdo"},
+	{Type: Extlang, Subtag: "cjy", Prefix: "zh", Preferred: "cjy"},
+	{Type: Extlang, Subtag: "cmn", Prefix: "zh", Preferred: "cmn"},
+	{Type: Extlang, Subtag: "coa", Prefix: "ms", Preferred: "coa"},
+	{Type: Extlang, Subtag: "cpx", Prefix: "zh", Preferred: "cpx"},
+	{Type: Extlang, Subtag: "csl", Prefix: "sgn", Preferred: "csl"},
+	{Type: Extlang, Subtag: "czh", Prefix: "zh", Preferred: "czh"},
+	{Type: Extlang, Subtag: "czo", Prefix: "zh", Preferred: "czo"},
+	{Type: Extlang, Subtag: "dse", Prefix: "sgn", Preferred: "dse"},
+	{Type: Extlang, Subtag: "dup", Prefix: "ms", Preferred: "dup"},
+	{Type: Extlang, Subtag: "fsl", Prefix: "sgn", Preferred: "fsl"},
+	{Type: Extlang, Subtag: "gan", Prefix: "zh", Preferred: "gan"},
+	{Type: Extlang, Subtag: "gsg", Prefix: "sgn", Preferred: "gsg"},
+	{Type: Extlang, Subtag: "hak", Prefix: "zh", Preferred: "hak"},
+	{Type: Extlang, Subtag: "hji", Prefix: "ms", Preferred: "hji"},
+	{Type: Extlang, Subtag: "hsn", Prefix: "zh", Preferred: "hsn"},
+	{Type: Extlang, Subtag: "ils", Prefix: "sgn", Preferred: "ils"},
+	{Type: Extlang, Subtag: "jak", Prefix: "ms", Preferred: "jak"},
+	{Type: Extlang, Subtag: "jax", Prefix: "ms", Preferred: "jax"},
+	{Type: Extlang, Subtag: "jsl", Prefix: "sgn", Preferred: "jsl"},
+	{Type: Extlang, Subtag: "kvb", Prefix: "ms", Preferred: "kvb"},
+	{Type: Extlang, Subtag: "kvr", Prefix: "ms", Preferred: "kvr"},
+	{Type: Extlang, Subtag: "kxd", Prefix: "ms", Preferred: "kxd"},
+	{Type: Extlang, Subtag: "lce", Prefix: "ms", Preferred: "lce"},
+	{Type: Extlang, Subtag: "lcf", Prefix: "ms", Preferred: "lcf"},
+	{Type: Extlang, Subtag: "liw", Prefix: "ms", Preferred: "liw"},
+	{Type: Extlang, Subtag: "lzh", Prefix: "zh", Preferred: "lzh"},
+	{Type: Extlang, Subtag: "max", Prefix: "ms", Preferred: "max"},
+	{Type: Extlang, Subtag: "meo", Prefix: "ms", Preferred: "meo"},
+	{Type: Extlang, Subtag: "mfa", Prefix: "ms", Preferred: "mfa"},
+	{Type: Extlang, Subtag: "mfb", Prefix: "ms", Preferred: "mfb"},
+	{Type: Extlang, Subtag: "min", Prefix: "ms", Preferred: "min"},
+	{Type: Extlang, Subtag: "mnp", Prefix: "zh", Preferred: "mnp"},
+	{Type: Extlang, Subtag: "mqg", Prefix: "ms", Preferred: "mqg"},
+	{Type: Extlang, Subtag: "msi", Prefix: "ms", Preferred: "msi"},
+	{Type: Extlang, Subtag: "mui", Prefix: "ms", Preferred: "mui"},
+	{Type: Extlang, Subtag: "nan", Prefix: "zh", Preferred: "nan"},
+	{Type: Extlang, Subtag: "orn", Prefix: "ms", Preferred: "orn"},
+	{Type: Extlang, Subtag: "ors", Prefix: "ms", Preferred: "ors"},
+	{Type: Extlang, Subtag: "pel", Prefix: "ms", Preferred: "pel"},
+	{Type: Extlang, Subtag: "pga", Prefix: "ar", Preferred: "pga"},
+	{Type: Extlang, Subtag: "pse", Prefix: "ms", Preferred: "pse"},
+	{Type: Extlang, Subtag: "shu", Prefix: "ar", Preferred: "shu"},
+	{Type: Extlang, Subtag: "ssh", Prefix: "ar", Preferred: "ssh"},
+	{Type: Extlang, Subtag: "tmw", Prefix: "ms", Preferred: "tmw"},
+	{Type: Extlang, Subtag: "urk", Prefix: "ms", Preferred: "urk"},
+	{Type: Extlang, Subtag: "vkk", Prefix: "ms", Preferred: "vkk"},
+	{Type: Extlang, Subtag: "vkt", Prefix: "ms", Preferred: "vkt"},
+	{Type: Extlang, Subtag: "wuu", Prefix: "zh", Preferred: "wuu"},
+	{Type: Extlang, Subtag: "xmm", Prefix: "ms", Preferred: "xmm"},
+	{Type: Extlang, Subtag: "yue", Prefix: "zh", Preferred: "yue"},
+	{Type: Extlang, Subtag: "zlm", Prefix: "ms", Preferred: "zlm"},
+	{Type: Extlang, Subtag: "zmi", Prefix: "ms", Preferred: "zmi"},
+	{Type: Extlang, Subtag: "zsm", Prefix: "ms", Preferred: "zsm"},
+	{Type: Script, Subtag: "adlm"},
+	{Type: Script, Subtag: "afak"},
+	{Type: Script, Subtag: "aghb"},
+	{Type: Script, Subtag: "ahom"},
+	{Type: Script, Subtag: "arab"},
+	{Type: Script, Subtag: "aran"},
+	{Type: Script, Subtag: "armi"},
+	{Type: Script, Subtag: "armn"},
+	{Type: Script, Subtag: "avst"},
+	{Type: Script, Subtag: "bali"},
+	{Type: Script, Subtag: "bamu"},
+	{Type: Script, Subtag: "bass"},
+	{Type: Script, Subtag: "batk"},
+	{Type: Script, Subtag: "beng"},
+	{Type: Script, Subtag: "bhks"},
+	{Type: Script, Subtag: "blis"},
+	{Type: Script, Subtag: "bopo"},
+	{Type: Script, Subtag: "brah"},
+	{Type: Script, Subtag: "brai"},
+	{Type: Script, Subtag: "bugi"},
+	{Type: Script, Subtag: "buhd"},
+	{Type: Script, Subtag: "cakm"},
+	{Type: Script, Subtag: "cans"},
+	{Type: Script, Subtag: "cari"},
+	{Type: Script, Subtag: "cham"},
+	{Type: Script, Subtag: "cher"},
+	{Type: Script, Subtag: "cirt"},
+	{Type: Script, Subtag: "copt"},
+	{Type: Script, Subtag: "cprt"},
+	{Type: Script, Subtag: "cyrl"},
+	{Type: Script, Subtag: "cyrs"},
+	{Type: Script, Subtag: "deva"},
+	{Type: Script, Subtag: "dsrt"},
+	{Type: Script, Subtag: "dupl"},
+	{Type: Script, Subtag: "egyd"},
+	{Type: Script, Subtag: "egyh"},
+	{Type: Script, Subtag: "egyp"},
+	{Type: Script, Subtag: "elba"},
+	{Type: Script, Subtag: "ethi"},
+	{Type: Script, Subtag: "geok"},
+	{Type: Script, Subtag: "geor"},
+	{Type: Script, Subtag: "glag"},
+	{Type: Script, Subtag: "goth"},
+	{Type: Script, Subtag: "gran"},
+	{Type: Script, Subtag: "grek"},
+	{Type: Script, Subtag: "gujr"},
+	{Type: Script, Subtag: "guru"},
+	{Type: Script, Subtag: "hanb"},
+	{Type: Script, Subtag: "hang"},
+	{Type: Script, Subtag: "hani"},
+	{Type: Script, Subtag: "hano"},
+	{Type: Script, Subtag: "hans"},
+	{Type: Script, Subtag: "hant"},
+	{Type: Script, Subtag: "hatr"},
+	{Type: Script, Subtag: "hebr"},
+	{Type: Script, Subtag: "hira"},
+	{Type: Script, Subtag: "hluw"},
+	{Type: Script, Subtag: "hmng"},
+	{Type: Script, Subtag: "hrkt"},
+	{Type: Script, Subtag: "hung"},
+	{Type: Script, Subtag: "inds"},
+	{Type: Script, Subtag: "ital"},
+	{Type: Script, Subtag: "jamo"},
+	{Type: Script, Subtag: "java"},
+	{Type: Script, Subtag: "jpan"},
+	{Type: Script, Subtag: "jurc"},
+	{Type: Script, Subtag: "kali"},
+	{Type: Script, Subtag: "kana"},
+	{Type: Script, Subtag: "khar"},
+	{Type: Script, Subtag: "khmr"},
+	{Type: Script, Subtag: "khoj"},
+	{Type: Script, Subtag: "kitl"},
+	{Type: Script, Subtag: "kits"},
+	{Type: Script, Subtag: "knda"},
+	{Type: Script, Subtag: "kore"},
+	{Type: Script, Subtag: "kpel"},
+	{Type: Script, Subtag: "kthi"},
+	{Type: Script, Subtag: "lana"},
+	{Type: Script, Subtag: "laoo"},
+	{Type: Script, Subtag: "latf"},
+	{Type: Script, Subtag: "latg"},
+	{Type: Script, Subtag: "latn"},
+	{Type: Script, Subtag: "leke"},
+	{Type: Script, Subtag: "lepc"},
+	{Type: Script, Subtag: "limb"},
+	{Type: Script, Subtag: "lina"},
+	{Type: Script, Subtag: "linb"},
+	{Type: Script, Subtag: "lisu"},
+	{Type: Script, Subtag: "loma"},
+	{Type: Script, Subtag: "lyci"},
+	{Type: Script, Subtag: "lydi"},
+	{Type: Script, Subtag: "mahj"},
+	{Type: Script, Subtag: "mand"},
+	{Type: Script, Subtag: "mani"},
+	{Type: Script, Subtag: "marc"},
+	{Type: Script, Subtag: "maya"},
+	{Type: Script, Subtag: "mend"},
+	{Type: Script, Subtag: "merc"},
+	{Type: Script, Subtag: "mero"},
+	{Type: Script, Subtag: "mlym"},
+	{Type: Script, Subtag: "modi"},
+	{Type: Script, Subtag: "mong"},
+	{Type: Script, Subtag: "moon"},
+	{Type: Script, Subtag: "mroo"},
+	{Type: Script, Subtag: "mtei"},
+	{Type: Script, Subtag: "mult"},
+	{Type: Script, Subtag: "mymr"},
+	{Type: Script, Subtag: "narb"},
+	{Type: Script, Subtag: "nbat"},
+	{Type: Script, Subtag: "newa"},
+	{Type: Script, Subtag: "nkgb"},
+	{Type: Script, Subtag: "nkoo"},
+	{Type: Script, Subtag: "nshu"},
+	{Type: Script, Subtag: "ogam"},
+	{Type: Script, Subtag: "olck"},
+	{Type: Script, Subtag: "orkh"},
+	{Type: Script, Subtag: "orya"},
+	{Type: Script, Subtag: "osge"},
+	{Type: Script, Subtag: "osma"},
+	{Type: Script, Subtag: "palm"},
+	{Type: Script, Subtag: "pauc"},
+	{Type: Script, Subtag: "perm"},
+	{Type: Script, Subtag: "phag"},
+	{Type: Script, Subtag: "phli"},
+	{Type: Script, Subtag: "phlp"},
+	{Type: Script, Subtag: "phlv"},
+	{Type: Script, Subtag: "phnx"},
+	{Type: Script, Subtag: "piqd"},
+	{Type: Script, Subtag: "plrd"},
+	{Type: Script, Subtag: "prti"},
+	{Type: Script, Subtag: "qaaa"},
+	{Type: Script, Subtag: "qaab"},
+	{Type: Script, Subtag: "qaac"},
+	{Type: Script, Subtag: "qaad"},
+	{Type: Script, Subtag: "qaae"},
+	{Type: Script, Subtag: "qaaf"},
+	{Type: Script, Subtag: "qaag"},
+	{Type: Script, Subtag: "qaah"},
+	{Type: Script, Subtag: "qaai"},
+	{Type: Script, Subtag: "qaaj"},
+	{Type: Script, Subtag: "qaak"},
+	{Type: Script, Subtag: "qaal"},
+	{Type: Script, Subtag: "qaam"},
+	{Type: Script, Subtag: "qaan"},
+	{Type: Script, Subtag: "qaao"},
+	{Type: Script, Subtag: "qaap"},
+	{Type: Script, Subtag: "qaaq"},
+	{Type: Script, Subtag: "qaar"},
+	{Type: Script, Subtag: "qaas"},
+	{Type: Script, Subtag: "qaat"},
+	{Type: Script, Subtag: "qaau"},
+	{Type: Script, Subtag: "qaav"},
+	{Type: Script, Subtag: "qaaw"},
+	{Type: Script, Subtag: "qaax"},
+	{Type: Script, Subtag: "qaay"},
+	{Type: Script, Subtag: "qaaz"},
+	{Type: Script, Subtag: "qaba"},
+	{Type: Script, Subtag: "qabb"},
+	{Type: Script, Subtag: "qabc"},
+	{Type: Script, Subtag: "qabd"},
+	{Type: Script, Subtag: "qabe"},
+	{Type: Script, Subtag: "qabf"},
+	{Type: Script, Subtag: "qabg"},
+	{Type: Script, Subtag: "qabh"},
+	{Type: Script, Subtag: "qabi"},
+	{Type: Script, Subtag: "qabj"},
+	{Type: Script, Subtag: "qabk"},
+	{Type: Script, Subtag: "qabl"},
+	{Type: Script, Subtag: "qabm"},
+	{Type: Script, Subtag: "qabn"},
+	{Type: Script, Subtag: "qabo"},
+	{Type: Script, Subtag: "qabp"},
+	{Type: Script, Subtag: "qabq"},
+	{Type: Script, Subtag: "qabr"},
+	{Type: Script, Subtag: "qabs"},
+	{Type: Script, Subtag: "qabt"},
+	{Type: Script, Subtag: "qabu"},
+	{Type: Script, Subtag: "qabv"},
+	{Type: Script, Subtag: "qabw"},
+	{Type: Script, Subtag: "qabx"},
+	{Type: Script, Subtag: "rjng"},
+	{Type: Script, Subtag: "roro"},
+	{Type: Script, Subtag: "runr"},
+	{Type: Script, Subtag: "samr"},
+	{Type: Script, Subtag: "sara"},
+	{Type: Script, Subtag: "sarb"},
+	{Type: Script, Subtag: "saur"},
+	{Type: Script, Subtag: "sgnw"},
+	{Type: Script, Subtag: "shaw"},
+	{Type: Script, Subtag: "shrd"},
+	{Type: Script, Subtag: "sidd"},
+	{Type: Script, Subtag: "sind"},
+	{Type: Script, Subtag: "sinh"},
+	{Type: Script, Subtag: "sora"},
+	{Type: Script, Subtag: "sund"},
+	{Type: Script, Subtag: "sylo"},
+	{Type: Script, Subtag: "syrc"},
+	{Type: Script, Subtag: "syre"},
+	{Type: Script, Subtag: "syrj"},
+	{Type: Script, Subtag: "syrn"},
+	{Type: Script, Subtag: "tagb"},
+	{Type: Script, Subtag: "takr"},
+	{Type: Script, Subtag: "tale"},
+	{Type: Script, Subtag: "talu"},
+	{Type: Script, Subtag: "taml"},
+	{Type: Script, Subtag: "tang"},
+	{Type: Script, Subtag: "tavt"},
+	{Type: Script, Subtag: "telu"},
+	{Type: Script, Subtag: "teng"},
+	{Type: Script, Subtag: "tfng"},
+	{Type: Script, Subtag: "tglg"},
+	{Type: Script, Subtag: "thaa"},
+	{Type: Script, Subtag: "thai"},
+	{Type: Script, Subtag: "tibt"},
+	{Type: Script, Subtag: "tirh"},
+	{Type: Script, Subtag: "ugar"},
+	{Type: Script, Subtag: "vaii"},
+	{Type: Script, Subtag: "visp"},
+	{Type: Script, Subtag: "wara"},
+	{Type: Script, Subtag: "wole"},
+	{Type: Script, Subtag: "xpeo"},
+	{Type: Script, Subtag: "xsux"},
+	{Type: Script, Subtag: "yiii"},
+	{Type: Script, Subtag: "zinh"},
+	{Type: Script, Subtag: "zmth"},
+	{Type: Script, Subtag: "zsye"},
+	{Type: Script, Subtag: "zsym"},
+	{Type: Script, Subtag: "zxxx"},
+	{Type: Script, Subtag: "zyyy"},
+	{Type: Script, Subtag: "zzzz"},
+	{Type: Region, Subtag: "001"},
+	{Type: Region, Subtag: "002"},
+	{Type: Region, Subtag: "003"},
+	{Type: Region, Subtag: "005"},
+	{Type: Region, Subtag: "009"},
+	{Type: Region, Subtag: "011"},
+	{Type: Region, Subtag: "013"},
+	{Type: Region, Subtag: "014"},
+	{Type: Region, Subtag: "015"},
+	{Type: Region, Subtag: "017"},
+	{Type: Region, Subtag: "018"},
+	{Type: Region, Subtag: "019"},
+	{Type: Region, Subtag: "021"},
+	{Type: Region, Subtag: "029"},
+	{Type: Region, Subtag: "030"},
+	{Type: Region, Subtag: "034"},
+	{Type: Region, Subtag: "035"},
+	{Type: Region, Subtag: "039"},
+	{Type: Region, Subtag: "053"},
+	{Type: Region, Subtag: "054"},
+	{Type: Region, Subtag: "057"},
+	{Type: Region, Subtag: "061"},
+	{Type: Region, Subtag: "142"},
+	{Type: Region, Subtag: "143"},
+	{Type: Region, Subtag: "145"},
+	{Type: Region, Subtag: "150"},
+	{Type: Region, Subtag: "151"},
+	{Type: Region, Subtag: "154"},
+	{Type: Region, Subtag: "155"},
+	{Type: Region, Subtag: "202"},
+	{Type: Region, Subtag: "419"},
+	{Type: Region, Subtag: "aa"},
+	{Type: Region, Subtag: "ac"},
+	{Type: Region, Subtag: "bu", Preferred: "mm"},
+	{Type: Region, Subtag: "cp"},
+	{Type: Region, Subtag: "cs"},
+	{Type: Region, Subtag: "dd", Preferred: "de"},
+	{Type: Region, Subtag: "dg"},
+	{Type: Region, Subtag: "ea"},
+	{Type: Region, Subtag: "eu"},
+	{Type: Region, Subtag: "ez"},
+	{Type: Region, Subtag: "fx", Preferred: "fr"},
+	{Type: Region, Subtag: "ic"},
+	{Type: Region, Subtag: "nt"},
+	{Type: Region, Subtag: "qm"},
+	{Type: Region, Subtag: "qn"},
+	{Type: Region, Subtag: "qo"},
+	{Type: Region, Subtag: "qp"},
+	{Type: Region, Subtag: "qq"},
+	{Type: Region, Subtag: "qr"},
+	{Type: Region, Subtag: "qs"},
+	{Type: Region, Subtag: "qt"},
+	{Type: Region, Subtag: "qu"},
+	{Type: Region, Subtag: "qv"},
+	{Type: Region, Subtag: "qw"},
+	{Type: Region, Subtag: "qx"},
+	{Type: Region, Subtag: "qy"},
+	{Type: Region, Subtag: "qz"},
+	{Type: Region, Subtag: "su"},
+	{Type: Region, Subtag: "ta"},
+	{Type: Region, Subtag: "tp", Preferred: "tl"},
+	{Type: Region, Subtag: "un"},
+	{Type: Region, Subtag: "xa"},
+	{Type: Region, Subtag: "xb"},
+	{Type: Region, Subtag: "xc"},
+	{Type: Region, Subtag: "xd"},
+	{Type: Region, Subtag: "xe"},
+	{Type: Region, Subtag: "xf"},
+	{Type: Region, Subtag: "xg"},
+	{Type: Region, Subtag: "xh"},
+	{Type: Region, Subtag: "xi"},
+	{Type: Region, Subtag: "xj"},
+	{Type: Region, Subtag: "xk"},
+	{Type: Region, Subtag: "xl"},
+	{Type: Region, Subtag: "xm"},
+	{Type: Region, Subtag: "xn"},
+	{Type: Region, Subtag: "xo"},
+	{Type: Region, Subtag: "xp"},
+	{Type: Region, Subtag: "xq"},
+	{Type: Region, Subtag: "xr"},
+	{Type: Region, Subtag: "xs"},
+	{Type: Region, Subtag: "xt"},
+	{Type: Region, Subtag: "xu"},
+	{Type: Region, Subtag: "xv"},
+	{Type: Region, Subtag: "xw"},
+	{Type: Region, Subtag: "xx"},
+	{Type: Region, Subtag: "xy"},
+	{Type: Region, Subtag: "xz"},
+	{Type: Region, Subtag: "yd", Preferred: "ye"},
+	{Type: Region, Subtag: "yu"},
+	{Type: Region, Subtag: "zr", Preferred: "cd"},
+	{Type: Region, Subtag: "zz"},
+	{Type: Variant, Subtag: "1606nict"},
+	{Type: Variant, Subtag: "1694acad"},
+	{Type: Variant, Subtag: "1901"},
+	{Type: Variant, Subtag: "1959acad"},
+	{Type: Variant, Subtag: "1994"},
+	{Type: Variant, Subtag: "1996"},
+	{Type: Variant, Subtag: "abl1943"},
+	{Type: Variant, Subtag: "akuapem"},
+	{Type: Variant, Subtag: "alalc97"},
+	{Type: Variant, Subtag: "aluku"},
+	{Type: Variant, Subtag: "ao1990"},
+	{Type: Variant, Subtag: "aranes"},
+	{Type: Variant, Subtag: "arevela"},
+	{Type: Variant, Subtag: "arevmda"},
+	{Type: Variant, Subtag: "arkaika"},
+	{Type: Variant, Subtag: "asante"},
+	{Type: Variant, Subtag: "auvern"},
+	{Type: Variant, Subtag: "baku1926"},
+	{Type: Variant, Subtag: "balanka"},
+	{Type: Variant, Subtag: "barla"},
+	{Type: Variant, Subtag: "basiceng"},
+	{Type: Variant, Subtag: "bauddha"},
+	{Type: Variant, Subtag: "bciav"},
+	{Type: Variant, Subtag: "bcizbl"},
+	{Type: Variant, Subtag: "biscayan"},
+	{Type: Variant, Subtag: "biske"},
+	{Type: Variant, Subtag: "bohoric"},
+	{Type: Variant, Subtag: "boont"},
+	{Type: Variant, Subtag: "bornholm"},
+	{Type: Variant, Subtag: "cisaup"},
+	{Type: Variant, Subtag: "colb1945"},
+	{Type: Variant, Subtag: "cornu"},
+	{Type: Variant, Subtag: "creiss"},
+	{Type: Variant, Subtag: "dajnko"},
+	{Type: Variant, Subtag: "ekavsk"},
+	{Type: Variant, Subtag: "emodeng"},
+	{Type: Variant, Subtag: "fonipa"},
+	{Type: Variant, Subtag: "fonkirsh"},
+	{Type: Variant, Subtag: "fonnapa"},
+	{Type: Variant, Subtag: "fonupa"},
+	{Type: Variant, Subtag: "fonxsamp"},
+	{Type: Variant, Subtag: "gallo"},
+	{Type: Variant, Subtag: "gascon"},
+	{Type: Variant, Subtag: "grclass"},
+	{Type: Variant, Subtag: "grital"},
+	{Type: Variant, Subtag: "grmistr"},
+	{Type: Variant, Subtag: "hepburn"},
+	{Type: Variant, Subtag: "heploc"},
+	{Type: Variant, Subtag: "heploc", Preferred: "alalc97"},
+	{Type: Variant, Subtag: "hognorsk"},
+	{Type: Variant, Subtag: "hsistemo"},
+	{Type: Variant, Subtag: "ijekavsk"},
+	{Type: Variant, Subtag: "itihasa"},
+	{Type: Variant, Subtag: "ivanchov"},
+	{Type: Variant, Subtag: "jauer"},
+	{Type: Variant, Subtag: "jyutping"},
+	{Type: Variant, Subtag: "kkcor"},
+	{Type: Variant, Subtag: "kociewie"},
+	{Type: Variant, Subtag: "kscor"},
+	{Type: Variant, Subtag: "laukika"},
+	{Type: Variant, Subtag: "lemosin"},
+	{Type: Variant, Subtag: "lengadoc"},
+	{Type: Variant, Subtag: "lipaw"},
+	{Type: Variant, Subtag: "ltg1929"},
+	{Type: Variant, Subtag: "ltg2007"},
+	{Type: Variant, Subtag: "luna1918"},
+	{Type: Variant, Subtag: "metelko"},
+	{Type: Variant, Subtag: "monoton"},
+	{Type: Variant, Subtag: "ndyuka"},
+	{Type: Variant, Subtag: "nedis"},
+	{Type: Variant, Subtag: "newfound"},
+	{Type: Variant, Subtag: "nicard"},
+	{Type: Variant, Subtag: "njiva"},
+	{Type: Variant, Subtag: "nulik"},
+	{Type: Variant, Subtag: "osojs"},
+	{Type: Variant, Subtag: "oxendict"},
+	{Type: Variant, Subtag: "pahawh2"},
+	{Type: Variant, Subtag: "pahawh3"},
+	{Type: Variant, Subtag: "pahawh4"},
+	{Type: Variant, Subtag: "pamaka"},
+	{Type: Variant, Subtag: "peano"},
+	{Type: Variant, Subtag: "petr1708"},
+	{Type: Variant, Subtag: "pinyin"},
+	{Type: Variant, Subtag: "polyton"},
+	{Type: Variant, Subtag: "provenc"},
+	{Type: Variant, Subtag: "puter"},
+	{Type: Variant, Subtag: "rigik"},
+	{Type: Variant, Subtag: "rozaj"},
+	{Type: Variant, Subtag: "rumgr"},
+	{Type: Variant, Subtag: "scotland"},
+	{Type: Variant, Subtag: "scouse"},
+	{Type: Variant, Subtag: "simple"},
+	{Type: Variant, Subtag: "solba"},
+	{Type: Variant, Subtag: "sotav"},
+	{Type: Variant, Subtag: "spanglis"},
+	{Type: Variant, Subtag: "surmiran"},
+	{Type: Variant, Subtag: "sursilv"},
+	{Type: Variant, Subtag: "sutsilv"},
+	{Type: Variant, Subtag: "synnejyl"},
+	{Type: Variant, Subtag: "tarask"},
+	{Type: Variant, Subtag: "tongyong"},
+	{Type: Variant, Subtag: "tunumiit"},
+	{Type: Variant, Subtag: "uccor"},
+	{Type: Variant, Subtag: "ucrcor"},
+	{Type: Variant, Subtag: "ulster"},
+	{Type: Variant, Subtag: "unifon"},
+	{Type: Variant, Subtag: "vaidika"},
+	{Type: Variant, Subtag: "valencia"},
+	{Type: Variant, Subtag: "vallader"},
+	{Type: Variant, Subtag: "vecdruka"},
+	{Type: Variant, Subtag: "vivaraup"},
+	{Type: Variant, Subtag: "wadegile"},
+	{Type: Variant, Subtag: "xsistemo"},
+	{Type: Grandfathered, Subtag: "art-lojban", Preferred: "jbo"},
+	{Type: Grandfathered, Subtag: "cel-gaulish"},
+	{Type: Grandfathered, Subtag: "en-gb-oed", Preferred: "en-gb-oxendict"},
+	{Type: Grandfathered, Subtag: "i-ami", Preferred: "ami"},
+	{Type: Grandfathered, Subtag: "i-bnn", Preferred: "bnn"},
+	{Type: Grandfathered, Subtag: "i-default"},
+	{Type: Grandfathered, Subtag: "i-enochian"},
+	{Type: Grandfathered, Subtag: "i-hak", Preferred: "hak"},
+	{Type: Grandfathered, Subtag: "i-klingon", Preferred: "tlh"},
+	{Type: Grandfathered, Subtag: "i-lux", Preferred: "lb"},
+	{Type: Grandfathered, Subtag: "i-mingo"},
+	{Type: Grandfathered, Subtag: "i-navajo", Preferred: "nv"},
+	{Type: Grandfathered, Subtag: "i-pwn", Preferred: "pwn"},
+	{Type: Grandfathered, Subtag: "i-tao", Preferred: "tao"},
+	{Type: Grandfathered, Subtag: "i-tay", Preferred: "tay"},
+	{Type: Grandfathered, Subtag: "i-tsu", Preferred: "tsu"},
+	{Type: Grandfathered, Subtag: "no-bok", Preferred: "nb"},
+	{Type: Grandfathered, Subtag: "no-nyn", Preferred: "nn"},
+	{Type: Grandfathered, Subtag: "sgn-be-fr", Preferred: "sfb"},
+	{Type: Grandfathered, Subtag: "sgn-be-nl", Preferred: "vgt"},
+	{Type: Grandfathered, Subtag: "sgn-ch-de", Preferred: "sgg"},
+	{Type: Grandfathered, Subtag: "zh-guoyu", Preferred: "cmn"},
+	{Type: Grandfathered, Subtag: "zh-hakka", Preferred: "hak"},
+	{Type: Grandfathered, Subtag: "zh-min"},
+	{Type: Grandfathered, Subtag: "zh-min-nan", Preferred: "nan"},
+	{Type: Grandfathered, Subtag: "zh-xiang", Preferred: "hsn"},
+	{Type: Redundant, Subtag: "az-arab"},
+	{Type: Redundant, Subtag: "az-cyrl"},
+	{Type: Redundant, Subtag: "az-latn"},
+	{Type: Redundant, Subtag: "sgn-br", Preferred: "bzs"},
+	{Type: Redundant, Subtag: "sgn-co", Preferred: "csn"},
+	{Type: Redundant, Subtag: "sgn-de", Preferred: "gsg"},
+	{Type: Redundant, Subtag: "sgn-dk", Preferred: "dsl"},
+	{Type: Redundant, Subtag: "sgn-es", Preferred: "ssp"},
+	{Type: Redundant, Subtag: "sgn-fr", Preferred: "fsl"},
+	{Type: Redundant, Subtag: "sgn-gb", Preferred: "bfi"},
+	{Type: Redundant, Subtag: "sgn-gr", Preferred: "gss"},
+	{Type: Redundant, Subtag: "sgn-ie", Preferred: "isg"},
+	{Type: Redundant, Subtag: "sgn-it", Preferred: "ise"},
+	{Type: Redundant, Subtag: "sgn-jp", Preferred: "jsl"},
+	{Type: Redundant, Subtag: "sgn-mx", Preferred: "mfs"},
+	{Type: Redundant, Subtag: "sgn-ni", Preferred: "ncs"},
+	{Type: Redundant, Subtag: "sgn-nl", Preferred: "dse"},
+	{Type: Redundant, Subtag: "sgn-no", Preferred: "nsl"},
+	{Type: Redundant, Subtag: "sgn-pt", Preferred: "psr"},
+	{Type: Redundant, Subtag: "sgn-se", Preferred: "swl"},
+	{Type: Redundant, Subtag: "sgn-us", Preferred: "ase"},
+	{Type: Redundant, Subtag: "sgn-za", Preferred: "sfs"},
+	{Type: Redundant, Subtag: "sr-cyrl"},
+	{Type: Redundant, Subtag: "sr-latn"},
+	{Type: Redundant, Subtag: "zh-cmn", Preferred: "cmn"},
+	{Type: Redundant, Subtag: "zh-cmn-hans", Preferred: "cmn-hans"},
+	{Type: Redundant, Subtag: "zh-cmn-hant", Preferred: "cmn-hant"},
+	{Type: Redundant, Subtag: "zh-gan", Preferred: "gan"},
+	{Type: Redundant, Subtag: "zh-hans"},
+	{Type: Redundant, Subtag: "zh-hans-cn"},
+	{Type: Redundant, Subtag: "zh-hans-hk"},
+	{Type: Redundant, Subtag: "zh-hans-mo"},
+	{Type: Redundant, Subtag: "zh-hans-sg"},
+	{Type: Redundant, Subtag: "zh-hans-tw"},
+	{Type: Redundant, Subtag: "zh-hant"},
+	{Type: Redundant, Subtag: "zh-hant-cn"},
+	{Type: Redundant, Subtag: "zh-hant-hk"},
+	{Type: Redundant, Subtag: "zh-hant-mo"},
+	{Type: Redundant, Subtag: "zh-hant-sg"},
+	{Type: Redundant, Subtag: "zh-hant-tw"},
+	{Type: Redundant, Subtag: "zh-wuu", Preferred: "wuu"},
+	{Type: Redundant, Subtag: "zh-yue", Preferred: "yue"},
+}
+
+var extensions = map[string]bool{"t": true, "u": true}
@@ -15,9 +15,35 @@ type LocaleInfo struct {
 	DigitZero rune
 	// The rune for the locale's digit 9.
 	DigitNine rune
+	// The locale's standard currency pattern, e.g. "#,##0.00 ¤",
+	// where "¤" is the placeholder for the currency symbol.
+	CurrencyFormat string
 }
 
+type CurrencyInfo struct {
+	// The ISO 4217 code of the currency.
+	Code string
+	// The symbol of the currency, e.g. "US$", or empty if the
+	// currency has no symbol other than its ISO 4217 code.
+	Symbol string
+	// The narrow symbol of the currency, e.g. "$", or empty.
+	NarrowSymbol string
+	// The number of digits after the decimal separator.
+	Digits int
+	// The rounding increment, in units of 10^-Digits, or 0
+	// if an amount needs no rounding beyond Digits.
+	Rounding int
+	// The number of digits after the decimal separator in cash amounts.
+	CashDigits int
+	// The rounding increment, in units of 10^-CashDigits, of cash amounts.
+	CashRounding int
+}
+
+// Locale returns the LocaleInfo of the given locale. If there's no info for
+// the locale, the info of its closest parent, if any, is returned instead.
+// Both "_" and "-" are accepted as the separator of the locale's subtags.
 func Locale(loc string) (LocaleInfo, bool) {
+	loc = strings.ReplaceAll(loc, "-", "_")
 	li, ok := localemap[loc]
 	if !ok {
 		for {
@@ -34,11 +60,22 @@ func Locale(loc string) (LocaleInfo, bool) {
 	return li, ok
 }
 
+// Currency returns the CurrencyInfo of the currency with the given ISO 4217 code.
+func Currency(code string) (CurrencyInfo, bool) {
+	ci, ok := currencymap[code]
+	return ci, ok
+}
+
 var localemap map[string]LocaleInfo
+var currencymap map[string]CurrencyInfo
 
 func init() {
 	localemap = make(map[string]LocaleInfo)
 	for _, li := range localeslice {
 		localemap[li.Lang] = li
 	}
+	currencymap = make(map[string]CurrencyInfo)
+	for _, ci := range currencyslice {
+		currencymap[ci.Code] = ci
+	}
 }
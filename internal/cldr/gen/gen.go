@@ -1,18 +1,26 @@
 package main
 
 import (
+	"archive/zip"
 	"bytes"
 	"fmt"
 	"go/format"
 	"golang.org/x/text/unicode/cldr"
 	"io"
 	"os"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
 	GO "github.com/frk/ast/golang"
 )
 
+// cldrVersion is the CLDR release from which the tables are generated.
+// When changing it, update the README in the same change as ../tables.go.
+const cldrVersion = "44"
+
 func main() {
 	f, err := os.Open(os.Args[1])
 	if err != nil {
@@ -21,6 +29,19 @@ func main() {
 	}
 	defer f.Close()
 
+	version, err := getVersion(f)
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	} else if version != cldrVersion {
+		fmt.Printf("ERROR: the file is from CLDR %q, want CLDR %q\n", version, cldrVersion)
+		return
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
 	var d cldr.Decoder
 	d.SetDirFilter("main", "supplemental")
 
@@ -35,7 +56,9 @@ func main() {
 	locs := getLocales(cldr)
 	inheritMissingInfo(locs, nil)
 
-	file := buildTableFile(locs)
+	ccys := getCurrencies(cldr)
+
+	file := buildTableFile(locs, ccys, version)
 	if err := writeTableFile(file); err != nil {
 		fmt.Println("ERROR:", err)
 		return
@@ -44,11 +67,56 @@ func main() {
 
 var numerics map[string]string
 
+var rxVersion = regexp.MustCompile(`cldrVersion\s+CDATA\s+#FIXED\s+"([^"]+)"`)
+
+// getVersion returns the CLDR version declared by the ldml.dtd of the zip file.
+func getVersion(f *os.File) (string, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	z, err := zip.NewReader(f, fi.Size())
+	if err != nil {
+		return "", err
+	}
+	for _, zf := range z.File {
+		if zf.Name != "common/dtd/ldml.dtd" {
+			continue
+		}
+		r, err := zf.Open()
+		if err != nil {
+			return "", err
+		}
+		defer r.Close()
+
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return "", err
+		}
+		if m := rxVersion.FindSubmatch(b); m != nil {
+			return string(m[1]), nil
+		}
+		break
+	}
+	return "", fmt.Errorf("no CLDR version in %s", f.Name())
+}
+
 type locale struct {
 	lang    string
 	digits  string
 	decimal rune
 	group   rune
+	ccyfmt  string
+}
+
+type currency struct {
+	code         string
+	symbol       string
+	narrow       string
+	digits       int
+	rounding     int
+	cashDigits   int
+	cashRounding int
 }
 
 // collect numberingSystems type=numeric data.
@@ -74,6 +142,16 @@ func getLocales(cldr *cldr.CLDR) (locs []locale) {
 		getLocaleNumberSymbols(ldml, &loc)
 		locs = append(locs, loc)
 	}
+
+	// the locales that don't inherit a currency format
+	// from their parents, inherit it from the root locale
+	root := locale{lang: "root"}
+	getLocaleNumberSymbols(cldr.RawLDML(root.lang), &root)
+	for i := range locs {
+		if locs[i].ccyfmt == "" && !strings.ContainsRune(locs[i].lang, '_') {
+			locs[i].ccyfmt = root.ccyfmt
+		}
+	}
 	return locs
 }
 
@@ -111,12 +189,33 @@ func getLocaleNumberSymbols(ldml *cldr.LDML, loc *locale) {
 			break
 		}
 	}
+
+	for _, cf := range nums.CurrencyFormats {
+		if cf.NumberSystem != nsid && (cf.NumberSystem != "" || nsid == "") {
+			continue
+		}
+		for _, cfl := range cf.CurrencyFormatLength {
+			if cfl.Type != "" { // only the default length
+				continue
+			}
+			for _, cfmt := range cfl.CurrencyFormat {
+				if cfmt.Type == "standard" && len(cfmt.Pattern) > 0 {
+					// keep only the positive subpattern
+					pat := cfmt.Pattern[0].Data()
+					if i := strings.IndexByte(pat, ';'); i > -1 {
+						pat = pat[:i]
+					}
+					loc.ccyfmt = pat
+				}
+			}
+		}
+	}
 }
 
 func inheritMissingInfo(locs []locale, loc *locale) {
 	if loc == nil {
 		for i, loc := range locs {
-			if loc.group > 0 && loc.decimal > 0 && len(loc.digits) > 0 {
+			if loc.group > 0 && loc.decimal > 0 && len(loc.digits) > 0 && len(loc.ccyfmt) > 0 {
 				continue
 			}
 
@@ -141,8 +240,11 @@ func inheritMissingInfo(locs []locale, loc *locale) {
 				if loc.digits == "" {
 					loc.digits = parent.digits
 				}
+				if loc.ccyfmt == "" {
+					loc.ccyfmt = parent.ccyfmt
+				}
 
-				if loc.group > 0 && loc.decimal > 0 && len(loc.digits) > 0 {
+				if loc.group > 0 && loc.decimal > 0 && len(loc.digits) > 0 && len(loc.ccyfmt) > 0 {
 					return // done
 				} else {
 					break // try next parent
@@ -153,12 +255,76 @@ func inheritMissingInfo(locs []locale, loc *locale) {
 	}
 }
 
-func buildTableFile(locs []locale) *GO.File {
+// collect the supplemental currencyData and the symbols of the root locale.
+func getCurrencies(cldr *cldr.CLDR) (ccys []currency) {
+	atoi := func(s string, def int) int {
+		if i, err := strconv.Atoi(s); err == nil {
+			return i
+		}
+		return def
+	}
+
+	// the fraction info of the currencies that don't have
+	// their own is provided by the "DEFAULT" entry
+	fractions := make(map[string]currency)
+	cd := cldr.Supplemental().CurrencyData
+	for _, f := range cd.Fractions {
+		for _, info := range f.Info {
+			c := currency{code: info.Iso4217}
+			c.digits = atoi(info.Digits, 2)
+			c.rounding = atoi(info.Rounding, 0)
+			c.cashDigits = atoi(info.CashDigits, c.digits)
+			c.cashRounding = atoi(info.CashRounding, c.rounding)
+			fractions[c.code] = c
+		}
+	}
+
+	codes := make(map[string]bool)
+	for _, r := range cd.Region {
+		for _, c := range r.Currency {
+			codes[c.Iso4217] = true
+		}
+	}
+
+	symbols := make(map[string]currency)
+	if nums := cldr.RawLDML("root").Numbers; nums != nil && nums.Currencies != nil {
+		for _, c := range nums.Currencies.Currency {
+			sym := symbols[c.Type]
+			for _, s := range c.Symbol {
+				if s.Alt == "narrow" {
+					sym.narrow = s.Data()
+				} else if s.Alt == "" {
+					sym.symbol = s.Data()
+				}
+			}
+			symbols[c.Type] = sym
+		}
+	}
+
+	for code := range codes {
+		c, ok := fractions[code]
+		if !ok {
+			c = fractions["DEFAULT"]
+		}
+		c.code = code
+		c.symbol = symbols[code].symbol
+		c.narrow = symbols[code].narrow
+		ccys = append(ccys, c)
+	}
+	sort.Slice(ccys, func(i, j int) bool {
+		return ccys[i].code < ccys[j].code
+	})
+	return ccys
+}
+
+func buildTableFile(locs []locale, ccys []currency, version string) *GO.File {
 	locales := buildLocaleInfoSlice(locs)
+	currencies := buildCurrencyInfoSlice(ccys)
 
 	file := new(GO.File)
+	file.Preamble = GO.LineComment{" Code generated by gen.go from the CLDR " + version + " core.zip; DO NOT EDIT."}
 	file.PkgName = "cldr"
-	file.Decls = append(file.Decls, locales)
+	file.Decls = append(file.Decls, locales, currencies)
 	return file
 }
 
@@ -196,7 +362,9 @@ func buildLocaleInfoSlice(locs []locale) (decl GO.VarDecl) {
 			f5.Value = GO.IntLit(0)
 		}
 
-		elem := GO.StructLit{Elems: []GO.FieldElement{f1, f2, f3, f4, f5}, Compact: true}
+		f6 := GO.FieldElement{Field: "CurrencyFormat", Value: GO.StringLit(loc.ccyfmt)}
+
+		elem := GO.StructLit{Elems: []GO.FieldElement{f1, f2, f3, f4, f5, f6}, Compact: true}
 		elems = append(elems, elem)
 	}
 	slice.Elems = elems
@@ -204,6 +372,26 @@ func buildLocaleInfoSlice(locs []locale) (decl GO.VarDecl) {
 	return decl
 }
 
+func buildCurrencyInfoSlice(ccys []currency) (decl GO.VarDecl) {
+	slice := GO.SliceLit{Type: GO.SliceType{GO.Ident{"CurrencyInfo"}}}
+	elems := GO.ExprList{}
+	for _, c := range ccys {
+		elem := GO.StructLit{Elems: []GO.FieldElement{
+			{Field: "Code", Value: GO.StringLit(c.code)},
+			{Field: "Symbol", Value: GO.StringLit(c.symbol)},
+			{Field: "NarrowSymbol", Value: GO.StringLit(c.narrow)},
+			{Field: "Digits", Value: GO.IntLit(c.digits)},
+			{Field: "Rounding", Value: GO.IntLit(c.rounding)},
+			{Field: "CashDigits", Value: GO.IntLit(c.cashDigits)},
+			{Field: "CashRounding", Value: GO.IntLit(c.cashRounding)},
+		}, Compact: true}
+		elems = append(elems, elem)
+	}
+	slice.Elems = elems
+	decl.Spec = GO.ValueSpec{Names: GO.Ident{"currencyslice"}, Values: slice}
+	return decl
+}
+
 func writeTableFile(file *GO.File) (err error) {
 	buf := &bytes.Buffer{}
 	if err := GO.Write(file, buf); err != nil {
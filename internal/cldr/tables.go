@@ -1,738 +1,1040 @@
 package cldr
 
 var localeslice = []LocaleInfo{
-	{Lang: "af", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "af_NA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "af_ZA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "agq", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "agq_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ak", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ak_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "am", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "am_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_001", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_AE", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_BH", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_DJ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_DZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_EG", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_EH", SepDecimal: '٫', SepGroup: '٬', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_ER", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_IL", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_IQ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_JO", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_KM", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_KW", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_LB", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_LY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_MA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_MR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_OM", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_PS", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_QA", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_SA", SepDecimal: '٫', SepGroup: '٬', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_SD", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_SO", SepDecimal: '٫', SepGroup: '٬', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_SS", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_SY", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_TD", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ar_TN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ar_YE", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "as", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯'},
-	{Lang: "as_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯'},
-	{Lang: "asa", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "asa_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ast", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ast_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "az", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "az_Cyrl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "az_Cyrl_AZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "az_Latn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "az_Latn_AZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bas", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bas_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "be", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "be_BY", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bem", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "bem_ZM", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "bez", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "bez_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "bg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bg_BG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bm", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "bm_ML", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "bn", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯'},
-	{Lang: "bn_BD", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯'},
-	{Lang: "bn_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯'},
-	{Lang: "bo", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bo_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bo_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "br", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "br_FR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "brx", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "brx_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bs", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bs_Cyrl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bs_Cyrl_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bs_Latn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "bs_Latn_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ca", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ca_AD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ca_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ca_ES_VALENCIA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ca_FR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ca_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ccp", SepDecimal: '.', SepGroup: 0, DigitZero: '𑄶', DigitNine: '𑄿'},
-	{Lang: "ccp_BD", SepDecimal: '.', SepGroup: 0, DigitZero: '𑄶', DigitNine: '𑄿'},
-	{Lang: "ccp_IN", SepDecimal: '.', SepGroup: 0, DigitZero: '𑄶', DigitNine: '𑄿'},
-	{Lang: "ce", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ce_RU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "cgg", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "cgg_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "chr", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "chr_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ckb", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ckb_IQ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ckb_IR", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "cs", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "cs_CZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "cu", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "cu_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "cy", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "cy_GB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "da", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "da_DK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "da_GL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dav", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "dav_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "de", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_AT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_BE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_LI", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "de_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dje", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dje_NE", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dsb", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dsb_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dua", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dua_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dyo", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dyo_SN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "dz", SepDecimal: '.', SepGroup: ',', DigitZero: '༠', DigitNine: '༩'},
-	{Lang: "dz_BT", SepDecimal: '.', SepGroup: ',', DigitZero: '༠', DigitNine: '༩'},
-	{Lang: "ebu", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ebu_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ee", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ee_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ee_TG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "el", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "el_CY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "el_GR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_001", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_150", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_AG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_AI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_AS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_AT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_AU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_BZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CA", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CX", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_CY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_DE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_DG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_DK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_DM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_ER", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_FI", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_FJ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_FK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_FM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GD", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_GY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_IE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_IL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_IM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_IO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_JE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_JM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_KI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_KN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_KY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_LC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_LR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_LS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MP", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_MY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NA", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NF", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_NZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_PG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_PH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_PK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_PN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_PR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_PW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_RW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SD", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SE", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SX", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_SZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_TC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_TK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_TO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_TT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_TV", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_TZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_UG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_UM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_US_POSIX", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_VC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_VG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_VI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_VU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_WS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_ZA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_ZM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "en_ZW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "eo", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "eo_001", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_419", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_AR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_BO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_BR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_BZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_CL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_CO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_CR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_CU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_DO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_EA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_EC", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_GQ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_GT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_HN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_IC", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_MX", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_NI", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_PA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_PE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_PH", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_PR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_PY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_SV", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_US", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_UY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "es_VE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "et", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "et_EE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "eu", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "eu_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ewo", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ewo_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fa", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "fa_AF", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "fa_IR", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "ff", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ff_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ff_GN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ff_MR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ff_SN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fi", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fi_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fil", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fil_PH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fo_DK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fo_FO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_BE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_BF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_BI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_BJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_BL", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CD", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CH", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_DJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_DZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_FR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_GA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_GF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_GN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_GP", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_GQ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_HT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_KM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MC", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_ML", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MQ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_MU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_NC", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_NE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_PF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_PM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_RE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_RW", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_SC", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_SN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_SY", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_TD", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_TG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_TN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_VU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_WF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fr_YT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fur", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fur_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fy", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "fy_NL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ga", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ga_IE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gd", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gd_GB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gl_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gsw", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gsw_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gsw_FR", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gsw_LI", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gu", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "gu_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "guz", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "guz_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "gv", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "gv_IM", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ha", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ha_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ha_NE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ha_NG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "haw", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "haw_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "he", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "he_IL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hi", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hi_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hr", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hr_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hr_HR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hsb", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hsb_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hu", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hu_HU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hy", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "hy_AM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "id", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "id_ID", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ig", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ig_NG", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "ii", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ii_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "is", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "is_IS", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "it", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "it_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "it_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "it_SM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "it_VA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ja", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ja_JP", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "jgo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "jgo_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "jmc", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "jmc_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ka", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ka_GE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kab", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kab_DZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kam", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kam_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kde", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kde_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kea", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kea_CV", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "khq", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "khq_ML", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ki", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ki_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kk_KZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kkj", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kkj_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kl_GL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kln", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kln_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "km", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "km_KH", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kn_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ko", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ko_KP", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ko_KR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kok", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kok_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ks", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "ks_IN", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "ksb", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ksb_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ksf", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ksf_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ksh", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ksh_DE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "kw", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "kw_GB", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ky", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ky_KG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lag", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "lag_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "lb", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lb_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lg", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "lg_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "lkt", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lkt_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ln", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ln_AO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ln_CD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ln_CF", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ln_CG", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lo_LA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lrc", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "lrc_IQ", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "lrc_IR", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "lt", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lt_LT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lu", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lu_CD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "luo", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "luo_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "luy", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "luy_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "lv", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "lv_LV", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mas", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "mas_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "mas_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "mer", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "mer_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "mfe", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mfe_MU", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mg", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mg_MG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mgh", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mgh_MZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mgo", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mgo_CM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mk", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mk_MK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ml", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ml_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mn_MN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mr", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९'},
-	{Lang: "mr_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९'},
-	{Lang: "ms", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ms_BN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ms_MY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ms_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mt", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mt_MT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mua", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "mua_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "my", SepDecimal: '.', SepGroup: ',', DigitZero: '၀', DigitNine: '၉'},
-	{Lang: "my_MM", SepDecimal: '.', SepGroup: ',', DigitZero: '၀', DigitNine: '၉'},
-	{Lang: "mzn", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "mzn_IR", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "naq", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "naq_NA", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "nb", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nb_NO", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nb_SJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nd", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "nd_ZW", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "nds", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nds_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nds_NL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ne", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९'},
-	{Lang: "ne_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९'},
-	{Lang: "ne_NP", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९'},
-	{Lang: "nl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_AW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_BE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_BQ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_CW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_NL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_SR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nl_SX", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nmg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nmg_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nn_NO", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nnh", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nnh_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nus", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nus_SS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "nyn", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "nyn_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "om", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "om_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "om_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "or", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "or_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "os", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "os_GE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "os_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pa", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pa_Arab", SepDecimal: '.', SepGroup: ',', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "pa_Arab_PK", SepDecimal: '.', SepGroup: ',', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "pa_Guru", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pa_Guru_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pl", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pl_PL", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "prg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "prg_001", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ps", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "ps_AF", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "pt", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_AO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_BR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_CH", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_CV", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_GQ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_GW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_MO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_MZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_PT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_ST", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "pt_TL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "qu", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "qu_BO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "qu_EC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "qu_PE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rm", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rm_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rn_BI", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ro", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ro_MD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ro_RO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rof", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "rof_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "ru", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ru_BY", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ru_KG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ru_KZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ru_MD", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ru_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ru_UA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rw", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rw_RW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "rwk", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "rwk_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "sah", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sah_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "saq", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "saq_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "sbp", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sbp_TZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sd", SepDecimal: 0, SepGroup: 0, DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "sd_PK", SepDecimal: 0, SepGroup: 0, DigitZero: '٠', DigitNine: '٩'},
-	{Lang: "se", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "se_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "se_NO", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "se_SE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "seh", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "seh_MZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ses", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ses_ML", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sg", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sg_CF", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "shi", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "shi_Latn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "shi_Latn_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "shi_Tfng", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "shi_Tfng_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "si", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "si_LK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sk_SK", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sl_SI", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "smn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "smn_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sn_ZW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "so", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "so_DJ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "so_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "so_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "so_SO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sq", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sq_AL", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sq_MK", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sq_XK", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Cyrl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Cyrl_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Cyrl_ME", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Cyrl_RS", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Cyrl_XK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Latn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Latn_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Latn_ME", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Latn_RS", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sr_Latn_XK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sv", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sv_AX", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sv_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sv_SE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sw", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sw_CD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sw_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sw_TZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "sw_UG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ta", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ta_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ta_LK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ta_MY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ta_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "te", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "te_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "teo", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "teo_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "teo_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "tg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tg_TJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "th", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "th_TH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ti", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ti_ER", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ti_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tk_TM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "to", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "to_TO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tr", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tr_CY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tr_TR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tt", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tt_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "twq", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "twq_NE", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tzm", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "tzm_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ug", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ug_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "uk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "uk_UA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ur", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "ur_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "ur_PK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "uz", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "uz_Arab", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "uz_Arab_AF", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "uz_Cyrl", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "uz_Cyrl_UZ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹'},
-	{Lang: "uz_Latn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "uz_Latn_UZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vai", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vai_Latn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vai_Latn_LR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vai_Vaii", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vai_Vaii_LR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vi", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vi_VN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "vo", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "vo_001", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "vun", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "vun_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "wae", SepDecimal: ',', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "wae_CH", SepDecimal: ',', SepGroup: '’', DigitZero: '0', DigitNine: '9'},
-	{Lang: "wo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "wo_SN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9'},
-	{Lang: "xog", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "xog_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0},
-	{Lang: "yav", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yav_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yi", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yi_001", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yo", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yo_BJ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yo_NG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yue", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yue_Hans", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yue_Hans_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yue_Hant", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "yue_Hant_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zgh", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zgh_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hans", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hans_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hans_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hans_MO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hans_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hant", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hant_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hant_MO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zh_Hant_TW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zu", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
-	{Lang: "zu_ZA", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9'},
+	{Lang: "af", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "af_NA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "af_ZA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "agq", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "agq_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ak", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ak_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "am", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "am_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ar", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_001", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_AE", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_BH", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_DJ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_DZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_EG", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_EH", SepDecimal: '٫', SepGroup: '٬', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_ER", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_IL", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_IQ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_JO", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_KM", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_KW", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_LB", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_LY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_MA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_MR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_OM", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_PS", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_QA", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_SA", SepDecimal: '٫', SepGroup: '٬', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_SD", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_SO", SepDecimal: '٫', SepGroup: '٬', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_SS", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_SY", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_TD", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_TN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "ar_YE", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "‏#,##0.00 ¤"},
+	{Lang: "as", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "as_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "asa", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "asa_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ast", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ast_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "az", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "az_Cyrl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "az_Cyrl_AZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "az_Latn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "az_Latn_AZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bas", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bas_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "be", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "be_BY", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bem", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "bem_ZM", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "bez", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "bez_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "bg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bg_BG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bm", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "bm_ML", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "bn", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯', CurrencyFormat: "#,##,##0.00¤"},
+	{Lang: "bn_BD", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯', CurrencyFormat: "#,##,##0.00¤"},
+	{Lang: "bn_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '০', DigitNine: '৯', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "bo", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "bo_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "bo_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "br", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "br_FR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "brx", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "brx_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "bs", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bs_Cyrl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bs_Cyrl_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bs_Latn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "bs_Latn_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ca", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ca_AD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ca_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ca_ES_VALENCIA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ca_FR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ca_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ccp", SepDecimal: '.', SepGroup: 0, DigitZero: '𑄶', DigitNine: '𑄿', CurrencyFormat: "#,##,##0.00¤"},
+	{Lang: "ccp_BD", SepDecimal: '.', SepGroup: 0, DigitZero: '𑄶', DigitNine: '𑄿', CurrencyFormat: "#,##,##0.00¤"},
+	{Lang: "ccp_IN", SepDecimal: '.', SepGroup: 0, DigitZero: '𑄶', DigitNine: '𑄿', CurrencyFormat: "#,##,##0.00¤"},
+	{Lang: "ce", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ce_RU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "cgg", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "cgg_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "chr", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "chr_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ckb", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ckb_IQ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ckb_IR", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "cs", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "cs_CZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "cu", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "cu_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "cy", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "cy_GB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "da", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "da_DK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "da_GL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dav", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "dav_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "de", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "de_AT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "de_BE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "de_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "de_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "de_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "de_LI", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "de_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dje", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "dje_NE", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "dsb", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dsb_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dua", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dua_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dyo", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dyo_SN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "dz", SepDecimal: '.', SepGroup: ',', DigitZero: '༠', DigitNine: '༩', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "dz_BT", SepDecimal: '.', SepGroup: ',', DigitZero: '༠', DigitNine: '༩', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "ebu", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ebu_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ee", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ee_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ee_TG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "el", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "el_CY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "el_GR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "en", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_001", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_150", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_AG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_AI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_AS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_AT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "en_AU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "en_BB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_BE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_BI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_BM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_BS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_BW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_BZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CA", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CX", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_CY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_DE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_DG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_DK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_DM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_ER", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_FI", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_FJ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_FK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_FM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GD", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_GY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_IE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_IL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_IM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "en_IO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_JE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_JM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_KI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_KN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_KY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_LC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_LR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_LS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MP", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_MY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NA", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NF", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_NZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_PG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_PH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_PK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_PN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_PR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_PW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_RW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SD", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SE", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SX", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_SZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_TC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_TK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_TO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_TT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_TV", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_TZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_UG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_UM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_US_POSIX", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ 0.00"},
+	{Lang: "en_VC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_VG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_VI", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_VU", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_WS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_ZA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_ZM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "en_ZW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "eo", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "eo_001", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "es", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "es_419", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_AR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_BO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_BR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_BZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_CL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_CO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_CR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_CU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_DO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_EA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "es_EC", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "es_GQ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "es_GT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_HN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_IC", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "es_MX", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_NI", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_PA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_PE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_PH", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "es_PR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_PY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_SV", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_US", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_UY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "es_VE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "et", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "et_EE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "eu", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "eu_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ewo", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ewo_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fa", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "‎¤#,##0.00"},
+	{Lang: "fa_AF", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "fa_IR", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "‎¤#,##0.00"},
+	{Lang: "ff", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ff_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ff_GN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ff_MR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ff_SN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fi", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fi_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fil", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "fil_PH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "fo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fo_DK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fo_FO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_BE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_BF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_BI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_BJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_BL", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CD", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CH", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_DJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_DZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_FR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_GA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_GF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_GN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_GP", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_GQ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_HT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_KM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MC", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_ML", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MQ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MR", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_MU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_NC", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_NE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_PF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_PM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_RE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_RW", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_SC", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_SN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_SY", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_TD", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_TG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_TN", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_VU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_WF", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fr_YT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "fur", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "fur_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "fy", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "fy_NL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ga", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ga_IE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "gd", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "gd_GB", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "gl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "gl_ES", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "gsw", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "gsw_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "gsw_FR", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "gsw_LI", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "gu", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "gu_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "guz", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "guz_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "gv", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "gv_IM", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ha", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ha_GH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ha_NE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ha_NG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "haw", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "haw_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "he", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ‏¤"},
+	{Lang: "he_IL", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "‏#,##0.00 ‏¤"},
+	{Lang: "hi", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "hi_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "hr", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hr_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hr_HR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hsb", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hsb_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hu", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hu_HU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hy", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "hy_AM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "id", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "id_ID", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ig", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ig_NG", SepDecimal: '٫', SepGroup: '٬', DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ii", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ii_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "is", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "is_IS", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "it", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "it_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "it_IT", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "it_SM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "it_VA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ja", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ja_JP", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "jgo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "jgo_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "jmc", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "jmc_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ka", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ka_GE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "kab", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "kab_DZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "kam", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kam_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kde", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kde_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kea", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "kea_CV", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "khq", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "khq_ML", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ki", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ki_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "kk_KZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "kkj", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "kkj_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "kl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kl_GL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kln", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kln_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "km", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "km_KH", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "kn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kn_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ko", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ko_KP", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ko_KR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kok", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "kok_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ks", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ks_IN", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ksb", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ksb_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ksf", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ksf_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ksh", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ksh_DE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "kw", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "kw_GB", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ky", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ky_KG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lag", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "lag_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "lb", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lb_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lg", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "lg_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "lkt", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "lkt_US", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ln", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ln_AO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ln_CD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ln_CF", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ln_CG", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "lo_LA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "lrc", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "lrc_IQ", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "lrc_IR", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "lt", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lt_LT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lu", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "lu_CD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "luo", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "luo_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "luy", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "luy_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "lv", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "lv_LV", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "mas", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mas_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mas_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mer", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mer_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mfe", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mfe_MU", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mg", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mg_MG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mgh", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mgh_MZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mgo", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mgo_CM", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mk", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "mk_MK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ml", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ml_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mn_MN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mr", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mr_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ms", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ms_BN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ms_MY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ms_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mt", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mt_MT", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mua", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "mua_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "my", SepDecimal: '.', SepGroup: ',', DigitZero: '၀', DigitNine: '၉', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "my_MM", SepDecimal: '.', SepGroup: ',', DigitZero: '၀', DigitNine: '၉', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "mzn", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "mzn_IR", SepDecimal: 0, SepGroup: 0, DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "naq", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "naq_NA", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "nb", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nb_NO", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nb_SJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nd", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "nd_ZW", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "nds", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nds_DE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nds_NL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ne", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "ne_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "ne_NP", SepDecimal: '.', SepGroup: ',', DigitZero: '०', DigitNine: '९', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "nl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_AW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_BE", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_BQ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_CW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_NL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_SR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nl_SX", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nmg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nmg_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nn_NO", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "nnh", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nnh_CM", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "nus", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "nus_SS", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "nyn", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "nyn_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "om", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "om_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "om_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "or", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "or_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "os", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "os_GE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "os_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "pa", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "pa_Arab", SepDecimal: '.', SepGroup: ',', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "pa_Arab_PK", SepDecimal: '.', SepGroup: ',', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "pa_Guru", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "pa_Guru_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "pl", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pl_PL", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "prg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "prg_001", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ps", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ps_AF", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "pt", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "pt_AO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_BR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "pt_CH", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_CV", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_GQ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_GW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_LU", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_MO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_MZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_PT", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_ST", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "pt_TL", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "qu", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "qu_BO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "qu_EC", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "qu_PE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "rm", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "rm_CH", SepDecimal: '.', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "rn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "rn_BI", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ro", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ro_MD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ro_RO", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "rof", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "rof_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ru", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ru_BY", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ru_KG", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ru_KZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ru_MD", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ru_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ru_UA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "rw", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "rw_RW", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "rwk", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "rwk_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00¤"},
+	{Lang: "sah", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sah_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "saq", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "saq_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "sbp", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "sbp_TZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "sd", SepDecimal: 0, SepGroup: 0, DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sd_PK", SepDecimal: 0, SepGroup: 0, DigitZero: '٠', DigitNine: '٩', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "se", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "se_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "se_NO", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "se_SE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "seh", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "seh_MZ", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ses", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "ses_ML", SepDecimal: 0, SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "sg", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "sg_CF", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "shi", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "shi_Latn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "shi_Latn_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "shi_Tfng", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "shi_Tfng_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "si", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "si_LK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "sk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sk_SK", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sl_SI", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "smn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "smn_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "sn_ZW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "so", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "so_DJ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "so_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "so_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "so_SO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "sq", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sq_AL", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sq_MK", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sq_XK", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Cyrl", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Cyrl_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Cyrl_ME", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Cyrl_RS", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Cyrl_XK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Latn", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Latn_BA", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Latn_ME", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Latn_RS", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sr_Latn_XK", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sv", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sv_AX", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sv_FI", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sv_SE", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "sw", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "sw_CD", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "sw_KE", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "sw_TZ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "sw_UG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ta", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "ta_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "ta_LK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "ta_MY", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "ta_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "te", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "te_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##,##0.00"},
+	{Lang: "teo", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "teo_KE", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "teo_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "tg", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "tg_TJ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "th", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "th_TH", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ti", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ti_ER", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ti_ET", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "tk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "tk_TM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "to", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "to_TO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "tr", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "tr_CY", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "tr_TR", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "tt", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "tt_RU", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "twq", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "twq_NE", SepDecimal: '.', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "tzm", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "tzm_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ug", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ug_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "uk", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "uk_UA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "ur", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "ur_IN", SepDecimal: '.', SepGroup: ',', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##,##0.00"},
+	{Lang: "ur_PK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "uz", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "uz_Arab", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "uz_Arab_AF", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "uz_Cyrl", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "uz_Cyrl_UZ", SepDecimal: '٫', SepGroup: '٬', DigitZero: '۰', DigitNine: '۹', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "uz_Latn", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "uz_Latn_UZ", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "vai", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "vai_Latn", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "vai_Latn_LR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "vai_Vaii", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "vai_Vaii_LR", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "vi", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "vi_VN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "vo", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "vo_001", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "vun", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "vun_TZ", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "¤#,##0.00"},
+	{Lang: "wae", SepDecimal: ',', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "wae_CH", SepDecimal: ',', SepGroup: '’', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "wo", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "wo_SN", SepDecimal: ',', SepGroup: '.', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "xog", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "xog_UG", SepDecimal: 0, SepGroup: 0, DigitZero: 0, DigitNine: 0, CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "yav", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "yav_CM", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00 ¤"},
+	{Lang: "yi", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "yi_001", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤ #,##0.00"},
+	{Lang: "yo", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yo_BJ", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yo_NG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yue", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yue_Hans", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yue_Hans_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yue_Hant", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "yue_Hant_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zgh", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "zgh_MA", SepDecimal: ',', SepGroup: ' ', DigitZero: '0', DigitNine: '9', CurrencyFormat: "#,##0.00¤"},
+	{Lang: "zh", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hans", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hans_CN", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hans_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hans_MO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hans_SG", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hant", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hant_HK", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hant_MO", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zh_Hant_TW", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zu", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+	{Lang: "zu_ZA", SepDecimal: '.', SepGroup: ',', DigitZero: '0', DigitNine: '9', CurrencyFormat: "¤#,##0.00"},
+}
+
+var currencyslice = []CurrencyInfo{
+	{Code: "ADP", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "AED", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AFA", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AFN", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ALK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ALL", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "AMD", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ANG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AOA", Symbol: "", NarrowSymbol: "Kz", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AOK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AON", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AOR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ARA", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ARL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ARM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ARP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ARS", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ATS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AUD", Symbol: "A$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AWG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AZM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "AZN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BAD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BAM", Symbol: "", NarrowSymbol: "KM", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BAN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BBD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BDT", Symbol: "", NarrowSymbol: "৳", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BEC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BEF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BEL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BGL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BGM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BGN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BGO", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BHD", Symbol: "", NarrowSymbol: "", Digits: 3, Rounding: 0, CashDigits: 3, CashRounding: 0},
+	{Code: "BIF", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "BMD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BND", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BOB", Symbol: "", NarrowSymbol: "Bs", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BOL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BOP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BOV", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRL", Symbol: "R$", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BRZ", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BSD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BTN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BUK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BWP", Symbol: "", NarrowSymbol: "P", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BYB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BYN", Symbol: "", NarrowSymbol: "р.", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "BYR", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "BZD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CAD", Symbol: "CA$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 5},
+	{Code: "CDF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CHE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CHF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 5},
+	{Code: "CHW", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CLE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CLF", Symbol: "", NarrowSymbol: "", Digits: 4, Rounding: 0, CashDigits: 4, CashRounding: 0},
+	{Code: "CLP", Symbol: "", NarrowSymbol: "$", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "CNH", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CNX", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CNY", Symbol: "CN¥", NarrowSymbol: "¥", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "COP", Symbol: "", NarrowSymbol: "$", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "COU", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CRC", Symbol: "", NarrowSymbol: "₡", Digits: 2, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "CSD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CSK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CUC", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CUP", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CVE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CYP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "CZK", Symbol: "", NarrowSymbol: "Kč", Digits: 2, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "DDM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "DEM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "DJF", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "DKK", Symbol: "", NarrowSymbol: "kr", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 50},
+	{Code: "DOP", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "DZD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ECS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ECV", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "EEK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "EGP", Symbol: "", NarrowSymbol: "E£", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ERN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ESA", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ESB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ESP", Symbol: "", NarrowSymbol: "₧", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ETB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "EUR", Symbol: "€", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "FIM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "FJD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "FKP", Symbol: "", NarrowSymbol: "£", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "FRF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GBP", Symbol: "£", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GEK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GEL", Symbol: "", NarrowSymbol: "₾", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GHC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GHS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GIP", Symbol: "", NarrowSymbol: "£", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GMD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GNF", Symbol: "", NarrowSymbol: "FG", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "GNS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GQE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GRD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GTQ", Symbol: "", NarrowSymbol: "Q", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GWE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GWP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "GYD", Symbol: "", NarrowSymbol: "$", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "HKD", Symbol: "HK$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "HNL", Symbol: "", NarrowSymbol: "L", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "HRD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "HRK", Symbol: "", NarrowSymbol: "kn", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "HTG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "HUF", Symbol: "", NarrowSymbol: "Ft", Digits: 2, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "IDR", Symbol: "", NarrowSymbol: "Rp", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "IEP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ILP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ILR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ILS", Symbol: "₪", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "INR", Symbol: "₹", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "IQD", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "IRR", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ISJ", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ISK", Symbol: "", NarrowSymbol: "kr", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ITL", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "JMD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "JOD", Symbol: "", NarrowSymbol: "", Digits: 3, Rounding: 0, CashDigits: 3, CashRounding: 0},
+	{Code: "JPY", Symbol: "JP¥", NarrowSymbol: "¥", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "KES", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "KGS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "KHR", Symbol: "", NarrowSymbol: "៛", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "KMF", Symbol: "", NarrowSymbol: "CF", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "KPW", Symbol: "", NarrowSymbol: "₩", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "KRH", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "KRO", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "KRW", Symbol: "₩", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "KWD", Symbol: "", NarrowSymbol: "", Digits: 3, Rounding: 0, CashDigits: 3, CashRounding: 0},
+	{Code: "KYD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "KZT", Symbol: "", NarrowSymbol: "₸", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LAK", Symbol: "", NarrowSymbol: "₭", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "LBP", Symbol: "", NarrowSymbol: "L£", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "LKR", Symbol: "", NarrowSymbol: "Rs", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LRD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LSL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LTL", Symbol: "", NarrowSymbol: "Lt", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LTT", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LUC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LUF", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "LUL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LVL", Symbol: "", NarrowSymbol: "Ls", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LVR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "LYD", Symbol: "", NarrowSymbol: "", Digits: 3, Rounding: 0, CashDigits: 3, CashRounding: 0},
+	{Code: "MAD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MAF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MCF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MDC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MDL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MGA", Symbol: "", NarrowSymbol: "Ar", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "MGF", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "MKD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MKN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MLF", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MMK", Symbol: "", NarrowSymbol: "K", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "MNT", Symbol: "", NarrowSymbol: "₮", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "MOP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MRO", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "MTL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MTP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MUR", Symbol: "", NarrowSymbol: "Rs", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "MVR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MWK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MXN", Symbol: "MX$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MXP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MXV", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MYR", Symbol: "", NarrowSymbol: "RM", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MZE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MZM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "MZN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NAD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NGN", Symbol: "", NarrowSymbol: "₦", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NIC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NIO", Symbol: "", NarrowSymbol: "C$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NLG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NOK", Symbol: "", NarrowSymbol: "kr", Digits: 2, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "NPR", Symbol: "", NarrowSymbol: "Rs", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "NZD", Symbol: "NZ$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "OMR", Symbol: "", NarrowSymbol: "", Digits: 3, Rounding: 0, CashDigits: 3, CashRounding: 0},
+	{Code: "PAB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PEI", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PEN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PES", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PGK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PHP", Symbol: "", NarrowSymbol: "₱", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PKR", Symbol: "", NarrowSymbol: "Rs", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "PLN", Symbol: "", NarrowSymbol: "zł", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PLZ", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PTE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "PYG", Symbol: "", NarrowSymbol: "₲", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "QAR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "RHD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ROL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "RON", Symbol: "", NarrowSymbol: "lei", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "RSD", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "RUB", Symbol: "", NarrowSymbol: "₽", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "RUR", Symbol: "", NarrowSymbol: "р.", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "RWF", Symbol: "", NarrowSymbol: "RF", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "SAR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SBD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SCR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SDD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SDG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SDP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SEK", Symbol: "", NarrowSymbol: "kr", Digits: 2, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "SGD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SHP", Symbol: "", NarrowSymbol: "£", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SIT", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SKK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SLL", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "SOS", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "SRD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SRG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SSP", Symbol: "", NarrowSymbol: "£", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "STD", Symbol: "", NarrowSymbol: "Db", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "STN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SUR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SVC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "SYP", Symbol: "", NarrowSymbol: "£", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "SZL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "THB", Symbol: "", NarrowSymbol: "฿", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TJR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TJS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TMM", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "TMT", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TND", Symbol: "", NarrowSymbol: "", Digits: 3, Rounding: 0, CashDigits: 3, CashRounding: 0},
+	{Code: "TOP", Symbol: "", NarrowSymbol: "T$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TPE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TRL", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "TRY", Symbol: "", NarrowSymbol: "₺", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TTD", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "TWD", Symbol: "NT$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "TZS", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "UAH", Symbol: "", NarrowSymbol: "₴", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "UAK", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "UGS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "UGX", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "USD", Symbol: "US$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "USN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "USS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "UYI", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "UYP", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "UYU", Symbol: "", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "UZS", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "VEB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "VEF", Symbol: "", NarrowSymbol: "Bs", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "VND", Symbol: "₫", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "VNN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "VUV", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "WST", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XAF", Symbol: "FCFA", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "XAG", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XAU", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XBA", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XBB", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XBC", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XBD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XCD", Symbol: "EC$", NarrowSymbol: "$", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XDR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XEU", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XFO", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XFU", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XOF", Symbol: "CFA", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "XPD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XPF", Symbol: "CFPF", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "XPT", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XRE", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XSU", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XTS", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XUA", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "XXX", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "YDD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "YER", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "YUD", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "YUM", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "YUN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "YUR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZAL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZAR", Symbol: "", NarrowSymbol: "R", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZMK", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ZMW", Symbol: "", NarrowSymbol: "ZK", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZRN", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZRZ", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZWD", Symbol: "", NarrowSymbol: "", Digits: 0, Rounding: 0, CashDigits: 0, CashRounding: 0},
+	{Code: "ZWL", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
+	{Code: "ZWR", Symbol: "", NarrowSymbol: "", Digits: 2, Rounding: 0, CashDigits: 2, CashRounding: 0},
 }
@@ -2,8 +2,10 @@ package command
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"go/format"
+	"go/types"
 	"io"
 	"os"
 	"path/filepath"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/frk/isvalid/internal/analysis"
 	"github.com/frk/isvalid/internal/generator"
+	"github.com/frk/isvalid/internal/lsp"
 	"github.com/frk/isvalid/internal/search"
 )
 
@@ -42,43 +45,45 @@ func New(cfg Config) (*Command, error) {
 }
 
 func (cmd *Command) Run() error {
-	var aConf analysis.Config
-	aConf.FieldKeyTag = cmd.FieldKeyTag.Value
-	aConf.FieldKeyJoin = cmd.FieldKeyJoin.Value
-	aConf.FieldKeySeparator = cmd.FieldKeySeparator.Value
-
 	// 1. search for validator types
 	var AST search.AST
-	pkgs, err := search.Search(cmd.WorkingDirectory.Value, cmd.Recursive.Value, cmd.FileFilterFunc(), &AST)
+	pkgs, err := search.Search(cmd.WorkingDirectory.Value, cmd.Recursive.Value, cmd.FileFilterFunc(),
+		cmd.compiledTargetNameRegexp, &AST)
 	if err != nil {
 		return err
 	}
 
-	// 2. load type information for builtin rule funcs (used for error reporting)
-	analysis.LoadRuleTypeFunc(AST)
+	// 2. load the builtin & custom rule types, each package uses the
+	// config of the directory in which it's located, the packages with
+	// the same config files share the same analysis config
+	aConfs := make(map[string]analysis.Config)
+	result := make([][]*outFile, len(pkgs))
+	for i, pkg := range pkgs {
+		if len(pkg.Files) == 0 {
+			continue
+		}
 
-	// 3. find & analyze custom rule functions
-	for _, rc := range cmd.CustomRules {
-		f, err := search.FindFunc(rc.funcPkg, rc.funcName, AST)
+		conf, err := cmd.dirConfig(filepath.Dir(pkg.Files[0].Path))
 		if err != nil {
 			return err
 		}
-		if err := aConf.AddRuleFunc(rc.Name, f); err != nil {
-			return err
+		key := strings.Join(conf.confPaths, "\n")
+		aConf, ok := aConfs[key]
+		if !ok {
+			if aConf, err = conf.analysisConfig(AST); err != nil {
+				return err
+			}
+			aConfs[key] = aConf
 		}
-	}
 
-	result := make([][]*outFile, len(pkgs))
-	for i, pkg := range pkgs {
 		outFiles := make([]*outFile, len(pkg.Files))
-
 		for j, file := range pkg.Files {
 			out := new(outFile)
-			out.path = cmd.outFilePath(file.Path)
+			out.path = conf.outFilePath(file.Path)
 			out.targInfos = make([]*generator.TargetAnalysis, len(file.Matches))
 
 			for k, match := range file.Matches {
-				// 4. analyze matched targets
+				// 3. analyze matched targets
 				aInfo := new(analysis.Info)
 				vs, err := aConf.Analyze(AST, match, aInfo)
 				if err != nil {
@@ -88,7 +93,7 @@ func (cmd *Command) Run() error {
 				out.targInfos[k] = &generator.TargetAnalysis{ValidatorStruct: vs, Info: aInfo}
 			}
 
-			// 5. generate code
+			// 4. generate code
 			if err := generator.Generate(&out.buf, pkg.Name, out.targInfos); err != nil {
 				return err
 			}
@@ -98,7 +103,7 @@ func (cmd *Command) Run() error {
 		result[i] = outFiles
 	}
 
-	// 6. write to file(s)
+	// 5. write to file(s)
 	for _, outFiles := range result {
 		for _, out := range outFiles {
 			if err := cmd.writeOutFile(out); err != nil {
@@ -110,11 +115,97 @@ func (cmd *Command) Run() error {
 	return nil
 }
 
-func (cmd *Command) outFilePath(inFilePath string) string {
+// ServeLSP runs a language server that provides completion, hover, and diagnostics
+// for the "is" struct tag. The server reads client messages from r and writes
+// its own messages to w until the client exits or r is exhausted.
+func (cmd *Command) ServeLSP(r io.Reader, w io.Writer) error {
+	aConf, err := cmd.analysisConfig(search.AST{})
+	if err != nil {
+		return err
+	}
+	return lsp.NewServer(aConf).Serve(r, w)
+}
+
+// analysisConfig returns a new analysis.Config initialized from the receiver.
+// The returned config has the custom rules already registered.
+func (c *Config) analysisConfig(AST search.AST) (aConf analysis.Config, err error) {
+	aConf.FieldKeyTag = c.FieldKeyTag.Value
+	aConf.FieldKeyJoin = c.FieldKeyJoin.Value
+	aConf.FieldKeySeparator = c.FieldKeySeparator.Value
+
+	// load type information for builtin rule funcs (used for error reporting)
+	analysis.LoadRuleTypeFunc(AST)
+
+	// find & analyze custom rule functions
+	ruleNameMap := make(map[string]string) // to ensure uniqueness
+	for _, rc := range c.CustomRules {
+		if rc == nil {
+			continue
+		}
+
+		f, confjson, err := search.FindRuleFunc(rc.funcPkg, rc.funcName, AST)
+		if err != nil {
+			return aConf, err
+		}
+
+		// the function's own config, if any, is
+		// overridden by the config from the file
+		conf := analysis.RuleConfig{}
+		if len(confjson) > 0 {
+			if err := json.Unmarshal(confjson, &conf); err != nil {
+				return aConf, fmt.Errorf("bad isvalid:rule json for custom rule func: %q -- %v", rc.Func, err)
+			}
+		}
+		conf = rc.merge(conf)
+
+		if len(conf.Name) == 0 {
+			return aConf, fmt.Errorf("missing custom rule name for func: %q", rc.Func)
+		}
+		if fn, ok := ruleNameMap[conf.Name]; ok {
+			return aConf, fmt.Errorf("duplicate custom rule name: %q -- used by %q and %q", conf.Name, fn, rc.Func)
+		}
+		ruleNameMap[conf.Name] = rc.Func
+
+		if err := aConf.AddRuleFunc(conf, f); err != nil {
+			return aConf, err
+		}
+	}
+
+	// find & analyze rule functions that are not listed in the config
+	// but that are marked as rules with the isvalid:rule directive
+	err = search.FindRuleFuncs(c.RulePackages.Value, AST, func(confjson []byte, f *types.Func) error {
+		funcName := f.Pkg().Path() + "." + f.Name()
+		for _, rc := range c.CustomRules {
+			if rc != nil && rc.Func == funcName {
+				return nil // already registered
+			}
+		}
+
+		conf := analysis.RuleConfig{}
+		if err := json.Unmarshal(confjson, &conf); err != nil {
+			return fmt.Errorf("bad isvalid:rule json for rule func: %q -- %v", funcName, err)
+		}
+		if len(conf.Name) == 0 {
+			return fmt.Errorf("missing rule name in isvalid:rule json for rule func: %q", funcName)
+		}
+		if analysis.IsBuiltinRule(conf.Name) {
+			return fmt.Errorf("rule name of func %q is already used by a builtin rule: %q", funcName, conf.Name)
+		}
+		if fn, ok := ruleNameMap[conf.Name]; ok {
+			return fmt.Errorf("duplicate custom rule name: %q -- used by %q and %q", conf.Name, fn, funcName)
+		}
+		ruleNameMap[conf.Name] = funcName
+
+		return aConf.AddRuleFunc(conf, f)
+	})
+	return aConf, err
+}
+
+func (c *Config) outFilePath(inFilePath string) string {
 	dir := filepath.Dir(inFilePath)
 
 	name := strings.TrimSuffix(filepath.Base(inFilePath), ".go")
-	name = fmt.Sprintf(cmd.OutputFileNameFormat.Value, name)
+	name = fmt.Sprintf(c.OutputFileNameFormat.Value, name)
 	if !strings.HasSuffix(name, ".go") {
 		name = name + ".go"
 	}
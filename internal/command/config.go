@@ -5,14 +5,24 @@ import (
 	"flag"
 	"fmt"
 	"go/token"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/frk/isvalid/internal/analysis"
+	"gopkg.in/yaml.v3"
 )
 
 type Config struct {
+	// The path of the config file to be used. If set, the tool will use only
+	// the specified file and it will not look for any other config files.
+	ConfigFile String `json:"-"`
 	// The directory in which the tool will search for files to process.
 	// If not provided, the current working directory will be used by default.
 	WorkingDirectory String `json:"working_directory"`
@@ -26,6 +36,14 @@ type Config struct {
 	// process. The regular expressions must match files that are located in
 	// the working directory.
 	InputFileRegexps StringSlice `json:"input_file_regexps"`
+	// The regular expression used to match the names of the struct types
+	// that the tool should generate code for. Types that do not match can
+	// still be opted in with the "isvalid:target" directive in their doc
+	// comment, and types that do match can be opted out with the
+	// "isvalid:ignore" directive.
+	//
+	// If not provided, the expression "(?i:validator)$" will be used by default.
+	TargetNameRegexp String `json:"target_name_regexp"`
 	// The format used for generating the name of the output files.
 	//
 	// The format can contain one (and only one) "%s" placeholder which the
@@ -56,26 +74,78 @@ type Config struct {
 	// If not provided, the separator "." will be used by default.
 	FieldKeySeparator String `json:"field_key_separator"`
 
-	// TODO add documentation
+	// The list of custom rules. Each custom rule must specify the function
+	// that implements it, the rest of the rule's configuration can be provided
+	// either in the config file, or in the function's documentation using
+	// the same "isvalid:rule" directive that is used by the builtin rules.
+	// The values provided in the config file take priority over the ones
+	// provided in the function's documentation.
 	CustomRules []*RuleConfig `json:"custom_rules"`
+	// List of import paths of packages that the tool should scan for custom
+	// rule functions. In addition to these the tool will always scan the
+	// packages of the input files. A custom rule function is an exported
+	// function whose documentation contains the "isvalid:rule" directive
+	// followed by the rule's json configuration, which has to include the
+	// name of the rule. The name of such a rule must not be the same as the
+	// name of any of the builtin rules.
+	RulePackages StringSlice `json:"rule_packages"`
+
+	// the receiver as it was before ParseFile decoded the config files into
+	// it, used to resolve the configs of the packages in the other directories
+	base *Config
+	// the paths of the config files that ParseFile decoded into the receiver
+	confPaths []string
 
 	// holds the compiled expressions of the InputFileRegexps slice.
 	compiledInputFileRegexps []*regexp.Regexp
+	// holds the compiled expression of the TargetNameRegexp value.
+	compiledTargetNameRegexp *regexp.Regexp
 }
 
+// RuleConfig is the configuration of a custom rule.
 type RuleConfig struct {
-	Name string `json:"name"`
+	// The name, options, and error message configuration of the rule,
+	// see the analysis.RuleConfig type for the documentation of the fields.
+	analysis.RuleConfig
+	// The package-path qualified name of the function that implements
+	// the rule, e.g. "github.com/me/mypkg.MyFunc".
 	Func string `json:"func"`
 
 	funcPkg  string `json:"-"`
 	funcName string `json:"-"`
 }
 
+// merge returns the result of merging the receiver's configuration into
+// the given analysis.RuleConfig, the non-zero fields of the receiver
+// take priority over the fields of the given config.
+func (rc *RuleConfig) merge(conf analysis.RuleConfig) analysis.RuleConfig {
+	if len(rc.Name) > 0 {
+		conf.Name = rc.Name
+	}
+	if rc.OptMin != nil {
+		conf.OptMin = rc.OptMin
+	}
+	if rc.OptMax != nil {
+		conf.OptMax = rc.OptMax
+	}
+	if len(rc.Opts) > 0 {
+		conf.Opts = rc.Opts
+	}
+	if rc.Err != (analysis.ErrMesgConfig{}) {
+		conf.Err = rc.Err
+	}
+	if rc.LOp > 0 {
+		conf.LOp = rc.LOp
+	}
+	return conf
+}
+
 var DefaultConfig = Config{
 	WorkingDirectory:     String{Value: "."},
 	Recursive:            Bool{Value: false},
 	InputFiles:           StringSlice{},
 	InputFileRegexps:     StringSlice{},
+	TargetNameRegexp:     String{Value: "(?i:validator)$"},
 	OutputFileNameFormat: String{Value: "%s_isvalid.go"},
 	FieldKeyTag:          String{Value: "json"},
 	FieldKeyJoin:         Bool{Value: true},
@@ -86,31 +156,117 @@ var DefaultConfig = Config{
 func (c *Config) ParseFlags() {
 	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 	fs.Usage = printUsage
+	fs.Var(&c.ConfigFile, "config", "")
 	fs.Var(&c.WorkingDirectory, "wd", "")
 	fs.Var(&c.Recursive, "r", "")
 	fs.Var(&c.InputFiles, "f", "")
 	fs.Var(&c.InputFileRegexps, "rx", "")
+	fs.Var(&c.TargetNameRegexp, "target", "")
 	fs.Var(&c.OutputFileNameFormat, "o", "")
 	fs.Var(&c.FieldKeyTag, "fktag", "")
 	fs.Var(&c.FieldKeyJoin, "fkjoin", "")
 	fs.Var(&c.FieldKeySeparator, "fksep", "")
+	fs.Var(&c.RulePackages, "rulepkg", "")
 	_ = fs.Parse(os.Args[1:])
 }
 
-// ParseFile looks for an isvalid config file in the git project's root of the receiver's
-// working directory, if it finds such a file it will then unmarshal it into the receiver.
+// ParseFile looks for isvalid config files and, if it finds any, it will
+// unmarshal them into the receiver.
+//
+// If the ConfigFile field is set, only the file at that path will be used.
+// Otherwise the config files are looked up in the hierarchy of the receiver's
+// working directory, starting with the working directory itself and ending
+// with the root directory of the git project. The values from files that are
+// closer to the working directory take priority over the values from files
+// further up the hierarchy, custom rules are merged by name, or by func if
+// they have no name. Flags take priority over all of the config files.
+// The input packages that are in other directories, e.g. when searching
+// recursively, use the config files of their own hierarchy, see dirConfig.
+//
+// The format of a config file is determined by its name, the supported
+// names are ".isvalid" and ".isvalid.json" for JSON, ".isvalid.yaml" and
+// ".isvalid.yml" for YAML, and ".isvalid.toml" for TOML.
 func (c *Config) ParseFile() error {
-	dir, err := filepath.Abs(c.WorkingDirectory.Value)
+	if len(c.ConfigFile.Value) > 0 {
+		return c.decodeFile(c.ConfigFile.Value)
+	}
+
+	confPaths, err := findConfigFiles(c.WorkingDirectory.Value)
 	if err != nil {
 		return err
 	}
 
+	base := *c
+	c.base, c.confPaths = &base, confPaths
+	for _, confPath := range confPaths {
+		if err := c.decodeFile(confPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dirConfig returns the config of the input package in the given directory.
+// The config files are looked up in the hierarchy of dir, the same way that
+// ParseFile looks them up in the hierarchy of the working directory, and they
+// are decoded into a copy of the receiver as it was before ParseFile was invoked.
+// The settings that control which files are used as input, i.e. the working
+// directory, recursive, input files, input file regexps, and target name regexp,
+// are always those of the receiver. If the receiver was decoded from the file
+// of the ConfigFile field, or if dir has the same config files as the working
+// directory, the receiver itself is returned.
+func (c *Config) dirConfig(dir string) (*Config, error) {
+	if c.base == nil {
+		return c, nil
+	}
+
+	confPaths, err := findConfigFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(confPaths, c.confPaths) {
+		return c, nil
+	}
+
+	dc := *c.base
+	dc.CustomRules = append([]*RuleConfig(nil), c.base.CustomRules...)
+	for _, confPath := range confPaths {
+		if err := dc.decodeFile(confPath); err != nil {
+			return nil, err
+		}
+	}
+	dc.base, dc.confPaths = nil, confPaths
+	dc.WorkingDirectory = c.WorkingDirectory
+	dc.Recursive = c.Recursive
+	dc.InputFiles = c.InputFiles
+	dc.InputFileRegexps = c.InputFileRegexps
+	dc.TargetNameRegexp = c.TargetNameRegexp
+	if err := dc.validate(); err != nil {
+		return nil, fmt.Errorf("bad config for directory: %q -- %v", dir, err)
+	}
+	return &dc, nil
+}
+
+// findConfigFiles returns the paths of the config files in the hierarchy of
+// the given directory, starting with the directory itself and ending with the
+// root directory of the git project, in that order. If dir is not inside a git
+// project, no paths are returned.
+func findConfigFiles(dir string) ([]string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
 	var isRoot bool
-	var confName string
+	var confPaths []string
 	for len(dir) > 1 && dir[0] == '/' {
+		var confName string
 		isRoot, confName, err = examineDir(dir)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if confName != "" {
+			confPaths = append(confPaths, filepath.Join(dir, confName))
 		}
 		if isRoot {
 			break
@@ -118,22 +274,197 @@ func (c *Config) ParseFile() error {
 		dir = filepath.Dir(dir) // parent dir will be examined next
 	}
 
-	// if found, unamrshal the config file
-	if confName != "" {
-		confpath := filepath.Join(dir, confName)
-		f, err := os.Open(confpath)
-		if err != nil {
-			return err
+	// NOTE(mkopriva): currently we don't care about .isvalid files that live outside
+	// of a git project, if, in the future, the rules are expanded then this will need
+	// to be either removed or accordingly updated.
+	if !isRoot {
+		return nil, nil
+	}
+
+	// nearest first, see the String type's UnmarshalJSON method
+	return confPaths, nil
+}
+
+// decodeFile decodes the config file at the given path into the receiver. The
+// values that are already set in the receiver will not be overridden, and the
+// receiver's custom rules will take priority over the ones with the same name
+// in the file.
+func (c *Config) decodeFile(confPath string) error {
+	data, err := ioutil.ReadFile(confPath)
+	if err != nil {
+		return err
+	}
+
+	// decode the file into a generic map, regardless of
+	// its format, so that its keys can be checked
+	var m map[string]interface{}
+	switch filepath.Ext(confPath) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &m)
+	case ".toml":
+		_, err = toml.Decode(string(data), &m)
+	default:
+		err = json.Unmarshal(data, &m)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse config file: %q -- %v", confPath, err)
+	}
+	if err := checkConfigKeys(m, reflect.TypeOf(*c), ""); err != nil {
+		return fmt.Errorf("bad config file: %q -- %v", confPath, err)
+	}
+
+	// then re-encode the map as json to reuse the
+	// UnmarshalJSON methods of the config's fields
+	if data, err = json.Marshal(m); err != nil {
+		return fmt.Errorf("bad config file: %q -- %v", confPath, err)
+	}
+
+	customRules := c.CustomRules
+	c.CustomRules = nil
+	if err := json.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("bad config file: %q -- %v", confPath, err)
+	}
+	c.CustomRules = mergeCustomRules(customRules, c.CustomRules)
+	return nil
+}
+
+// checkConfigKeys checks that the keys of the given map match the json
+// tags of the fields of the given struct type. Maps inside slices of
+// structs are checked recursively. The prefix is used for error reporting.
+func checkConfigKeys(m map[string]interface{}, t reflect.Type, prefix string) error {
+	fields := make(map[string]reflect.Type)
+	var collect func(t reflect.Type)
+	collect = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if f.Anonymous && len(name) == 0 && f.Type.Kind() == reflect.Struct {
+				collect(f.Type) // promoted fields
+				continue
+			}
+			if len(name) == 0 || name == "-" || len(f.PkgPath) > 0 {
+				continue
+			}
+			fields[name] = f.Type
 		}
-		defer f.Close()
+	}
+	collect(t)
 
-		if err := json.NewDecoder(f).Decode(c); err != nil {
-			return err
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		ft, ok := fields[key]
+		if !ok {
+			if alt := suggestKey(key, fields); len(alt) > 0 {
+				return fmt.Errorf("unknown key %q, did you mean %q?", prefix+key, prefix+alt)
+			}
+			return fmt.Errorf("unknown key %q", prefix+key)
+		}
+
+		if ft.Kind() != reflect.Slice {
+			continue
+		}
+		if ft = ft.Elem(); ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			continue
+		}
+		// the toml decoder produces arrays of tables as
+		// slices of maps, the other decoders as []interface{}
+		var list []map[string]interface{}
+		switch v := m[key].(type) {
+		case []map[string]interface{}:
+			list = v
+		case []interface{}:
+			for _, v := range v {
+				vm, _ := v.(map[string]interface{})
+				list = append(list, vm)
+			}
+		}
+		for i, vm := range list {
+			if vm != nil {
+				pfx := prefix + key + "[" + strconv.Itoa(i) + "]."
+				if err := checkConfigKeys(vm, ft, pfx); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
 }
 
+// suggestKey returns the key from the given set that is most similar to
+// the given unknown key, if none of the keys are similar enough then
+// an empty string is returned.
+func suggestKey(key string, keys map[string]reflect.Type) (alt string) {
+	min := len(key)/3 + 1
+	for k := range keys {
+		if d := editDistance(key, k); d < min || (d == min && k < alt) {
+			alt, min = k, d
+		}
+	}
+	return alt
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			del, ins, sub := row[j]+1, row[j-1]+1, prev+cost
+			prev = row[j]
+			if row[j] = del; ins < row[j] {
+				row[j] = ins
+			}
+			if sub < row[j] {
+				row[j] = sub
+			}
+		}
+	}
+	return row[len(b)]
+}
+
+// mergeCustomRules returns the rules of a together with those rules of b
+// that are not present in a. Rules are identified by their names, a rule
+// without a name, i.e. one that gets its name from its function's doc,
+// is identified by its function instead.
+func mergeCustomRules(a, b []*RuleConfig) []*RuleConfig {
+	keys := make(map[string]bool)
+	for _, rc := range a {
+		if rc != nil {
+			keys[customRuleKey(rc)] = true
+		}
+	}
+	for _, rc := range b {
+		if rc != nil && !keys[customRuleKey(rc)] {
+			a = append(a, rc)
+		}
+	}
+	return a
+}
+
+// customRuleKey returns the key by which the given rule is merged.
+func customRuleKey(rc *RuleConfig) string {
+	if name := strings.TrimSpace(rc.Name); len(name) > 0 {
+		return "name:" + name
+	}
+	return "func:" + strings.TrimSpace(rc.Func)
+}
+
 func (c *Config) FileFilterFunc() (filter func(filePath string) bool) {
 	if len(c.InputFiles.Value) == 0 && len(c.InputFileRegexps.Value) == 0 {
 		return nil
@@ -190,6 +521,13 @@ func (c *Config) validate() (err error) {
 		c.compiledInputFileRegexps[i] = rx
 	}
 
+	// compile the target name regexp
+	rx, err := regexp.Compile(c.TargetNameRegexp.Value)
+	if err != nil {
+		return fmt.Errorf("error compiling target name regular expression: %q -- %v", c.TargetNameRegexp.Value, err)
+	}
+	c.compiledTargetNameRegexp = rx
+
 	// check that the output filename format contains at most one "%" and
 	// that it is followed by an "s" to form the "%s" verb
 	if n := strings.Count(c.OutputFileNameFormat.Value, "%"); n == 0 {
@@ -215,19 +553,20 @@ func (c *Config) validate() (err error) {
 			continue
 		}
 
+		// NOTE: the name can be omitted if the
+		// function's documentation provides it
 		rc.Name = strings.TrimSpace(rc.Name)
-		if len(rc.Name) == 0 {
-			return fmt.Errorf("missing custom rule name" /*TODO more helpful message */)
-		}
 		rc.Func = strings.TrimSpace(rc.Func)
 		if len(rc.Func) == 0 {
-			return fmt.Errorf("missing custom rule func" /*TODO more helpful message */)
+			return fmt.Errorf("missing custom rule func for rule: %q", rc.Name)
 		}
 
-		if _, ok := ruleNameMap[rc.Name]; ok {
-			return fmt.Errorf("duplicate custom rule name" /*TODO more helpful message */)
+		if len(rc.Name) > 0 {
+			if _, ok := ruleNameMap[rc.Name]; ok {
+				return fmt.Errorf("duplicate custom rule name: %q", rc.Name)
+			}
+			ruleNameMap[rc.Name] = struct{}{}
 		}
-		ruleNameMap[rc.Name] = struct{}{}
 
 		// split function name from package
 		if i := strings.LastIndex(rc.Func, "."); i < 0 {
@@ -247,9 +586,9 @@ func (c *Config) validate() (err error) {
 }
 
 // examineDir reports if the directory at the given path is the root directory
-// of a git project and if it is, it will also report the name of the isvalid config
-// file (either ".isvalid" or ".isvalid.json") if such a file exists in that root
-// directory, otherwise confName will be empty.
+// of a git project and it will also report the name of the isvalid config file
+// if such a file exists in the directory, otherwise confName will be empty. If
+// the directory contains more than one isvalid config file an error is returned.
 func examineDir(path string) (isRoot bool, confName string, err error) {
 	d, err := os.Open(path)
 	if err != nil {
@@ -267,18 +606,25 @@ func examineDir(path string) (isRoot bool, confName string, err error) {
 		if name == ".git" && info.IsDir() {
 			isRoot = true
 		}
-		if (name == ".isvalid" || name == ".isvalid.json") && !info.IsDir() {
+		if isConfigFileName(name) && !info.IsDir() {
+			if confName != "" {
+				return false, "", fmt.Errorf("more than one config file in directory: %q -- %s, %s",
+					path, confName, name)
+			}
 			confName = name
 		}
 	}
+	return isRoot, confName, nil
+}
 
-	// NOTE(mkopriva): currently we don't care about .isvalid files that live outside
-	// of the git project root directory, if, in the future, the rules are expanded
-	// then this will need to be either removed or accordingly updated.
-	if !isRoot {
-		confName = ""
+// isConfigFileName reports whether or not name is one of
+// the file names recognized as an isvalid config file.
+func isConfigFileName(name string) bool {
+	switch name {
+	case ".isvalid", ".isvalid.json", ".isvalid.yaml", ".isvalid.yml", ".isvalid.toml":
+		return true
 	}
-	return isRoot, confName, nil
+	return false
 }
 
 // String implements both the flag.Value and the json.Unmarshal interfaces
@@ -410,14 +756,14 @@ func (ss *StringSlice) UnmarshalJSON(data []byte) error {
 			return nil
 		}
 
+		// an explicitly empty list is set too, it overrides
+		// the lists of the config files further up
 		var value []string
 		if err := json.Unmarshal(data, &value); err != nil {
 			return err
 		}
-		if len(value) > 0 {
-			ss.Value = value
-			ss.IsSet = true
-		}
+		ss.Value = value
+		ss.IsSet = true
 	}
 	return nil
 }
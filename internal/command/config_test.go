@@ -0,0 +1,378 @@
+package command
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeFiles creates the given files, mapped by their slash-separated
+// paths relative to dir, together with any missing parent directories.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// ruleNames returns the names of the given custom rules.
+func ruleNames(rules []*RuleConfig) (names []string) {
+	for _, rc := range rules {
+		names = append(names, rc.Name)
+	}
+	return names
+}
+
+func TestConfigDecodeFile(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{{
+		name: ".isvalid.yaml",
+		data: `
+working_directory: ./foo
+recursive: true
+input_files: [a.go, b.go]
+field_key_tag: yaml
+custom_rules:
+  - name: myrule
+    func: github.com/me/mypkg.MyRule
+    err:
+      text: "must be mine"
+`,
+	}, {
+		name: ".isvalid.yml",
+		data: `
+working_directory: ./foo
+recursive: true
+input_files:
+  - a.go
+  - b.go
+field_key_tag: yaml
+custom_rules:
+  - name: myrule
+    func: github.com/me/mypkg.MyRule
+    err: {text: "must be mine"}
+`,
+	}, {
+		name: ".isvalid.toml",
+		data: `
+working_directory = "./foo"
+recursive = true
+input_files = ["a.go", "b.go"]
+field_key_tag = "yaml"
+
+[[custom_rules]]
+name = "myrule"
+func = "github.com/me/mypkg.MyRule"
+err = { text = "must be mine" }
+`,
+	}, {
+		name: ".isvalid.json",
+		data: `{
+	"working_directory": "./foo",
+	"recursive": true,
+	"input_files": ["a.go", "b.go"],
+	"field_key_tag": "yaml",
+	"custom_rules": [{
+		"name": "myrule",
+		"func": "github.com/me/mypkg.MyRule",
+		"err": {"text": "must be mine"}
+	}]
+}`,
+	}, {
+		name: ".isvalid",
+		data: `{
+	"working_directory": "./foo",
+	"recursive": true,
+	"input_files": ["a.go", "b.go"],
+	"field_key_tag": "yaml",
+	"custom_rules": [{
+		"name": "myrule",
+		"func": "github.com/me/mypkg.MyRule",
+		"err": {"text": "must be mine"}
+	}]
+}`,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFiles(t, dir, map[string]string{tt.name: tt.data})
+
+			var c Config
+			if err := c.decodeFile(filepath.Join(dir, tt.name)); err != nil {
+				t.Fatal(err)
+			}
+
+			want := Config{
+				WorkingDirectory: String{Value: "./foo", IsSet: true},
+				Recursive:        Bool{Value: true, IsSet: true},
+				InputFiles:       StringSlice{Value: []string{"a.go", "b.go"}, IsSet: true},
+				FieldKeyTag:      String{Value: "yaml", IsSet: true},
+			}
+			rules := c.CustomRules
+			c.CustomRules = nil
+			if !reflect.DeepEqual(c, want) {
+				t.Errorf("got=%+v; want=%+v", c, want)
+			}
+
+			if len(rules) != 1 {
+				t.Fatalf("got %d custom rules; want 1", len(rules))
+			}
+			rc := rules[0]
+			if rc.Name != "myrule" || rc.Func != "github.com/me/mypkg.MyRule" || rc.Err.Text != "must be mine" {
+				t.Errorf("got custom rule=%+v", *rc)
+			}
+		})
+	}
+}
+
+func TestConfigDecodeFileUnknownKey(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{{
+		name: ".isvalid.json",
+		data: `{"input_file": ["a.go"]}`,
+		err:  `unknown key "input_file", did you mean "input_files"?`,
+	}, {
+		name: ".isvalid.yaml",
+		data: "recursiv: true\n",
+		err:  `unknown key "recursiv", did you mean "recursive"?`,
+	}, {
+		name: ".isvalid.toml",
+		data: "output_format = \"%s_gen.go\"\n",
+		err:  `unknown key "output_format"`,
+	}, {
+		name: ".isvalid.json",
+		data: `{"custom_rules": [{"name": "a", "func": "p.A"}, {"name": "b", "fun": "p.B"}]}`,
+		err:  `unknown key "custom_rules[1].fun", did you mean "custom_rules[1].func"?`,
+	}, {
+		name: ".isvalid.toml",
+		data: "[[custom_rules]]\nname = \"a\"\nfunc = \"p.A\"\nopt_mn = 1\n",
+		err:  `unknown key "custom_rules[0].opt_mn", did you mean "custom_rules[0].opt_min"?`,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, tt.name)
+			writeFiles(t, dir, map[string]string{tt.name: tt.data})
+
+			var c Config
+			err := c.decodeFile(path)
+			want := "bad config file: " + `"` + path + `"` + " -- " + tt.err
+			if err == nil || err.Error() != want {
+				t.Errorf("got err=%v; want=%s", err, want)
+			}
+		})
+	}
+}
+
+func TestConfigParseFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".git/HEAD": "ref: refs/heads/main\n",
+		".isvalid.yaml": `
+recursive: true
+field_key_tag: yaml
+field_key_separator: "-"
+custom_rules:
+  - name: a
+    func: p.RootA
+  - name: b
+    func: p.RootB
+`,
+		"foo/.isvalid.toml": `
+field_key_tag = "toml"
+target_name_regexp = "Foo$"
+
+[[custom_rules]]
+name = "b"
+func = "p.FooB"
+`,
+		"foo/bar/.isvalid.json": `{
+	"field_key_tag": "json",
+	"custom_rules": [{"name": "c", "func": "p.BarC"}]
+}`,
+	})
+
+	c := Config{WorkingDirectory: String{Value: filepath.Join(dir, "foo", "bar")}}
+	// set as if by a flag
+	if err := c.FieldKeySeparator.Set("/"); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ParseFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := c.FieldKeyTag, (String{Value: "json", IsSet: true}); got != want {
+		t.Errorf("FieldKeyTag got=%+v; want=%+v", got, want)
+	}
+	if got, want := c.TargetNameRegexp, (String{Value: "Foo$", IsSet: true}); got != want {
+		t.Errorf("TargetNameRegexp got=%+v; want=%+v", got, want)
+	}
+	if got, want := c.Recursive, (Bool{Value: true, IsSet: true}); got != want {
+		t.Errorf("Recursive got=%+v; want=%+v", got, want)
+	}
+	if got, want := c.FieldKeySeparator, (String{Value: "/", IsSet: true}); got != want {
+		t.Errorf("FieldKeySeparator got=%+v; want=%+v", got, want)
+	}
+
+	var funcs []string
+	for _, rc := range c.CustomRules {
+		funcs = append(funcs, rc.Func)
+	}
+	if got, want := ruleNames(c.CustomRules), []string{"c", "b", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CustomRules names got=%q; want=%q", got, want)
+	}
+	if got, want := funcs, []string{"p.BarC", "p.FooB", "p.RootA"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CustomRules funcs got=%q; want=%q", got, want)
+	}
+}
+
+func TestConfigParseFileOutsideGit(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".isvalid.json": `{"field_key_tag": "foo"}`,
+	})
+
+	c := Config{WorkingDirectory: String{Value: dir}}
+	if err := c.ParseFile(); err != nil {
+		t.Fatal(err)
+	}
+	if c.FieldKeyTag.IsSet {
+		t.Errorf("FieldKeyTag got=%+v; want it unset", c.FieldKeyTag)
+	}
+}
+
+func TestConfigParseFileConfigFlag(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".git/HEAD":           "ref: refs/heads/main\n",
+		".isvalid.json":       `{"recursive": true}`,
+		"conf/isvalid.yaml":   "field_key_tag: yaml\n",
+		"foo/.isvalid.json":   `{"field_key_tag": "foo"}`,
+		"foo/bar/placeholder": "",
+	})
+
+	c := Config{
+		ConfigFile:       String{Value: filepath.Join(dir, "conf", "isvalid.yaml")},
+		WorkingDirectory: String{Value: filepath.Join(dir, "foo", "bar")},
+	}
+	if err := c.ParseFile(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := c.FieldKeyTag, (String{Value: "yaml", IsSet: true}); got != want {
+		t.Errorf("FieldKeyTag got=%+v; want=%+v", got, want)
+	}
+	if c.Recursive.IsSet {
+		t.Errorf("Recursive got=%+v; want it unset", c.Recursive)
+	}
+}
+
+func TestConfigDirConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".git/HEAD": "ref: refs/heads/main\n",
+		".isvalid.yaml": `
+field_key_tag: yaml
+rule_packages: [p/root]
+custom_rules:
+  - name: a
+    func: p.RootA
+`,
+		"foo/.isvalid.json": `{
+	"field_key_tag": "foo",
+	"target_name_regexp": "Foo$",
+	"rule_packages": [],
+	"custom_rules": [{"name": "a", "func": "p.FooA"}]
+}`,
+		"bar/placeholder": "",
+	})
+
+	c := Config{WorkingDirectory: String{Value: dir}, TargetNameRegexp: String{Value: "V$"}}
+	if err := c.ParseFile(); err != nil {
+		t.Fatal(err)
+	}
+
+	// without config files of its own the package uses the receiver
+	bar, err := c.dirConfig(filepath.Join(dir, "bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bar != &c {
+		t.Errorf("dirConfig(bar) got=%p; want=%p", bar, &c)
+	}
+
+	foo, err := c.dirConfig(filepath.Join(dir, "foo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := foo.FieldKeyTag, (String{Value: "foo", IsSet: true}); got != want {
+		t.Errorf("FieldKeyTag got=%+v; want=%+v", got, want)
+	}
+	if got, want := foo.RulePackages, (StringSlice{Value: []string{}, IsSet: true}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RulePackages got=%+v; want=%+v", got, want)
+	}
+	if got, want := foo.TargetNameRegexp, c.TargetNameRegexp; got != want {
+		t.Errorf("TargetNameRegexp got=%+v; want=%+v", got, want)
+	}
+	if len(foo.CustomRules) != 1 || foo.CustomRules[0].Func != "p.FooA" {
+		t.Errorf("CustomRules got=%+v; want only p.FooA", foo.CustomRules)
+	}
+
+	// the receiver is left intact
+	if got, want := c.FieldKeyTag, (String{Value: "yaml", IsSet: true}); got != want {
+		t.Errorf("FieldKeyTag got=%+v; want=%+v", got, want)
+	}
+	if got, want := c.RulePackages, (StringSlice{Value: []string{"p/root"}, IsSet: true}); !reflect.DeepEqual(got, want) {
+		t.Errorf("RulePackages got=%+v; want=%+v", got, want)
+	}
+	if len(c.CustomRules) != 1 || c.CustomRules[0].Func != "p.RootA" {
+		t.Errorf("CustomRules got=%+v; want only p.RootA", c.CustomRules)
+	}
+}
+
+func TestMergeCustomRules(t *testing.T) {
+	rule := func(name, fn string) *RuleConfig {
+		rc := &RuleConfig{Func: fn}
+		rc.Name = name
+		return rc
+	}
+
+	a := []*RuleConfig{
+		rule("a", "p.A"),
+		rule("", "p.X"),
+		rule(" b ", "p.B"),
+	}
+	b := []*RuleConfig{
+		rule("a", "q.A"),
+		rule("", "q.X"),
+		rule("", " p.X "),
+		rule("b", "q.B"),
+		rule("c", "p.X"),
+		nil,
+	}
+	got := mergeCustomRules(a, b)
+
+	var funcs []string
+	for _, rc := range got {
+		funcs = append(funcs, rc.Func)
+	}
+	if want := []string{"p.A", "p.X", "p.B", "q.X", "p.X"}; !reflect.DeepEqual(funcs, want) {
+		t.Errorf("got funcs=%q; want=%q", funcs, want)
+	}
+	if want := []string{"a", "", " b ", "", "c"}; !reflect.DeepEqual(ruleNames(got), want) {
+		t.Errorf("got names=%q; want=%q", ruleNames(got), want)
+	}
+}
@@ -1,19 +1,30 @@
 package command
 
 import (
-	"fmt"
 	"os"
 )
 
 func printUsage() {
-	fmt.Fprint(os.Stderr, usage)
+	os.Stderr.WriteString(usage)
 }
 
-const usage = `usage: isvalid [-wd] [-r] [-f] [-rx] [-o] [-fktag] [-fkbase] [-fksep]
+const usage = `usage: isvalid [-config] [-wd] [-r] [-f] [-rx] [-target] [-o] [-fktag] [-fkbase] [-fksep] [-rulepkg]
 
 isvalid generates struct field validation .... (todo: write doc)
 
 
+The -config flag specifies the path of the config file to be used. If left unspecified,
+the tool will look for config files in the working directory and in each of its parent
+directories up to the root directory of the git project. The recognized file names are
+".isvalid" and ".isvalid.json" for JSON, ".isvalid.yaml" and ".isvalid.yml" for YAML,
+and ".isvalid.toml" for TOML. If more than one file is found, the values from the file
+nearest to the working directory take priority, and the custom rules are merged by name.
+Flags take priority over all config files. With -r, the packages in the subdirectories
+use the config files of their own directory hierarchy, except for the settings that
+select the input files, i.e. -wd, -r, -f, -rx, and -target, which are always those of
+the working directory.
+
+
 The -wd flag specifies the directory whose files the tool will process. When used
 together with the -f or -rx flags the tool will process only those files that match
 the -f and -rx values. If left unespecified, the current working directory will be
@@ -35,6 +46,13 @@ process. The regular expressions must match files located in the working directo
 The flag can be used more than once to specify multiple regular expressions.
 
 
+The -target flag specifies the regular expression used to match the names of the struct
+types that the tool should generate code for. Types whose names do not match can be opted
+in with the "isvalid:target" directive in their doc comment, and types whose names do match
+can be opted out with the "isvalid:ignore" directive. If left unspecified, the expression
+"(?i:validator)$" will be used by default.
+
+
 The -o flag specifies the format to be used for generating the name of the output files.
 The format can contain one (and only one) "%s" placeholder which the tool will replace
 with the input file's base name, if no placeholder is present then the input file's base
@@ -60,4 +78,12 @@ The -fksep flag specifies the separator to be used for joining fields' tags/name
 when producing the field keys. The separator can be at most one byte long.
 If left unspecified, the separator "." will be used by default.
 
+
+The -rulepkg flag specifies the import path of a package that the tool should scan for
+custom rule functions. The packages of the input files are always scanned. A custom rule
+function is an exported function whose documentation contains the "isvalid:rule" directive
+followed by the rule's json configuration, which has to include the rule's name. The name
+must not be the same as the name of a builtin rule. The flag can be used more than once
+to specify multiple packages.
+
 ` //`
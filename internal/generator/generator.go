@@ -5,6 +5,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/frk/isvalid/internal/analysis"
 
@@ -42,6 +43,12 @@ func Generate(w io.Writer, pkgName string, targets []*TargetAnalysis) error {
 		file.Decls = append([]GO.TopLevelDeclNode{init}, file.Decls...)
 	}
 
+	// declare the package-level variables if needed
+	if len(file.vars) > 0 {
+		decl := GO.VarDecl{Spec: file.vars}
+		file.Decls = append([]GO.TopLevelDeclNode{decl}, file.Decls...)
+	}
+
 	// final touch
 	file.PkgName = pkgName
 	file.Preamble = GO.LineComment{` DO NOT EDIT. This file was generated by "github.com/frk/isvalid".`}
@@ -79,6 +86,12 @@ type file struct {
 	// at the top of the file. If the slice is empty then the init function
 	// will not be generated.
 	init []GO.StmtNode
+	// List of package-level variables to be declared at the top of the
+	// file, e.g. the *isvalid.Dec values of the decimal rules' options.
+	vars GO.ValueSpecList
+	// The names of the package-level variables, used to declare
+	// each of the variables only once.
+	varnames map[string]bool
 }
 
 // varcode holds a particular variable's type and rule information as well as
@@ -92,6 +105,9 @@ type varcode struct {
 	vexpr GO.ExprNode
 	// The field with which the variable is associated.
 	field *analysis.StructField
+	// If set, the key that's passed to the error handler instead of the
+	// field's key. Used for the struct-level rules of the validator.
+	errkey string
 
 	// If vtype is a map and the map-key has one or more rules associated
 	// with it, the key varcode will be used for preparing the validation
@@ -113,6 +129,10 @@ type varcode struct {
 	required *analysis.Rule
 	// Set if the variable's original rule set includes the "notnil" rule, otherwise nil.
 	notnil *analysis.Rule
+	// Set if the variable's original rule set includes the "unique" rule, otherwise nil.
+	unique *analysis.Rule
+	// The variable's quantifier rules, e.g. "any", "atleast", etc.
+	quants []*analysis.Rule
 
 	// A list of if-statement AST nodes built from the rules slice and used
 	// by the generator to produce code that will, according to those rules,
@@ -147,6 +167,10 @@ type generator struct {
 	recv GO.Ident
 	// The main work of the generator.
 	varcodes []*varcode
+	// The code of the validator's group rules.
+	groups []GO.StmtNode
+	// The code of the validator's struct-level rules.
+	structRules []GO.StmtNode
 	// "before validate" hook code.
 	beforeValidate GO.StmtNode
 	// "after validate" hook code.
@@ -157,6 +181,7 @@ type generator struct {
 var (
 	ERR   = GO.Ident{"err"}
 	NIL   = GO.Ident{"nil"}
+	SEEN  = GO.Ident{"seen"}
 	ERROR = GO.Ident{"error"}
 )
 
@@ -165,11 +190,16 @@ func buildValidateMethod(g *generator) {
 	g.recv = GO.Ident{"v"}
 	buildHookCalls(g)
 	buildVarCodes(g)
+	buildGroupRules(g)
+	buildStructRules(g)
 
 	body := assembleBody(g)
 	method := GO.MethodDecl{}
 	method.Recv.Name = g.recv
 	method.Recv.Type = GO.Ident{g.vs.TypeName}
+	if len(g.vs.TypeParams) > 0 {
+		method.Recv.Type = GO.Ident{g.vs.TypeName + "[" + strings.Join(g.vs.TypeParams, ", ") + "]"}
+	}
 	method.Name.Name = "Validate"
 	method.Type.Results = GO.ParamList{{Type: ERROR}}
 	method.Body.List = body
@@ -213,24 +243,35 @@ func buildVarCodes(g *generator) {
 	}
 }
 
-// buildVarCode builds individual nodes of the AST for the given varcode.
-func buildVarCode(g *generator, code *varcode, tn *analysis.TagNode) {
-	// split off the "required" and "notnil" rules, they need special attention.
-	for _, r := range tn.Rules {
-		if r.Name == "required" {
-			code.required = r
-		} else if r.Name == "notnil" {
-			code.notnil = r
-		} else {
-			code.rules = append(code.rules, r)
+// buildGroupRules builds a block statement for each of the validator's group rules.
+func buildGroupRules(g *generator) {
+	for _, r := range g.vs.GroupRules {
+		g.groups = append(g.groups, GO.BlockStmt{newGroupStmts(g, r)})
+	}
+}
+
+// buildStructRules builds a statement for each of the validator's struct-level
+// rules. The errors of these are reported with the StructRule's synthetic key.
+func buildStructRules(g *generator) {
+	for _, sr := range g.vs.StructRules {
+		x, guard := newSelectorExpr(g, g.info.SelectorMap[sr.Field.Key])
+		code := &varcode{vtype: sr.Field.Type, vexpr: x, field: sr.Field, errkey: sr.Key}
+		buildVarCodeRuleSet(g, code, sr.Field.RuleTag)
+
+		s := assembleVarCode(g, code)
+		if s == nil {
+			continue
+		}
+		if guard != nil {
+			s = GO.IfStmt{Cond: guard, Body: GO.BlockStmt{[]GO.StmtNode{s}}}
 		}
+		g.structRules = append(g.structRules, s)
 	}
+}
 
-	buildVarCodeNilGuard(g, code)
-	buildVarCodeRequired(g, code)
-	buildVarCodeNotnil(g, code)
-	buildVarCodeSubBlock(g, code)
-	buildVarCodeRules(g, code)
+// buildVarCode builds individual nodes of the AST for the given varcode.
+func buildVarCode(g *generator, code *varcode, tn *analysis.TagNode) {
+	buildVarCodeRuleSet(g, code, tn)
 
 	switch code.vtype.Kind {
 	case analysis.TypeKindSlice, analysis.TypeKindArray:
@@ -269,6 +310,31 @@ func buildVarCode(g *generator, code *varcode, tn *analysis.TagNode) {
 	}
 }
 
+// buildVarCodeRuleSet builds the AST nodes for the rules of the given TagNode, the
+// key and elem rules of the TagNode, and the rules of any subfields, are ignored.
+func buildVarCodeRuleSet(g *generator, code *varcode, tn *analysis.TagNode) {
+	// split off the "required", "notnil", "unique", and quantifier rules, they need special attention.
+	for _, r := range tn.Rules {
+		if r.Name == "required" {
+			code.required = r
+		} else if r.Name == "notnil" {
+			code.notnil = r
+		} else if r.Name == "unique" {
+			code.unique = r
+		} else if _, ok := g.info.RuleTypeMap[r.Name].(analysis.RuleTypeQuant); ok {
+			code.quants = append(code.quants, r)
+		} else {
+			code.rules = append(code.rules, r)
+		}
+	}
+
+	buildVarCodeNilGuard(g, code)
+	buildVarCodeRequired(g, code)
+	buildVarCodeNotnil(g, code)
+	buildVarCodeSubBlock(g, code)
+	buildVarCodeRules(g, code)
+}
+
 // buildVarCodeNilGuard builds the "nil guard" AST node for the given varcode.
 func buildVarCodeNilGuard(g *generator, code *varcode) {
 	if code.vtype.Kind != analysis.TypeKindPtr {
@@ -353,15 +419,25 @@ func buildVarCodeSubBlock(g *generator, code *varcode) {
 		return // nothing to do
 	}
 
+	// subfields without rules produce no code, e.g. the fields
+	// of a struct that's validated by a single rule function
+	subfields := false
+	for _, f := range code.vtype.Fields {
+		if f.ContainsRules() {
+			subfields = true
+			break
+		}
+	}
+
 	// no subfields and there's a "notnil" or "required" rule then we do not
 	// need a sub-block, instead we want to chain the "notnil"/"required" IfStmt
 	// with the IfStmt produced from ruleifs.
-	if len(code.vtype.Fields) == 0 && (code.nnif != nil || code.rqif != nil) {
+	if !subfields && (code.nnif != nil || code.rqif != nil) {
 		return // nothing to do
 	}
 
 	// no subfields and there's at most 1 rule, no sub-block
-	if len(code.vtype.Fields) == 0 && len(code.rules) < 2 {
+	if !subfields && len(code.rules) < 2 {
 		return // nothing to do
 	}
 
@@ -374,8 +450,18 @@ func buildVarCodeSubBlock(g *generator, code *varcode) {
 
 // buildVarCodeRules builds IfStmt AST nodes for the varcode's rules.
 func buildVarCodeRules(g *generator, code *varcode) {
+	nr, ncode := buildVarCodeNumber(g, code)
 	for _, r := range code.rules {
-		ifs := newRuleIfStmt(g, code, r)
+		var ifs GO.IfStmt
+		if r == nr {
+			ifs = newNumberParseIfStmt(g, code, r)
+		} else if ncode != nil && numberComparisonRules[r.Name] && nr.Name == "decimal" {
+			ifs = newDecimalIfStmt(g, ncode, r)
+		} else if ncode != nil && numberComparisonRules[r.Name] {
+			ifs = newRuleIfStmt(g, ncode, r)
+		} else {
+			ifs = newRuleIfStmt(g, code, r)
+		}
 		if len(r.Context) > 0 {
 			opt := GO.SelectorExpr{X: g.recv, Sel: GO.Ident{g.vs.ContextOption.Name}}
 			bin := GO.BinaryExpr{Op: GO.BinaryEql, X: opt, Y: GO.StringLit(r.Context)}
@@ -386,6 +472,55 @@ func buildVarCodeRules(g *generator, code *varcode) {
 	}
 }
 
+// The rules that, when applied to a string variable, compare the number
+// parsed from the string, rather than the string itself.
+var numberComparisonRules = map[string]bool{
+	"gt": true, "lt": true, "gte": true, "lte": true,
+	"min": true, "max": true, "rng": true,
+	"scale": true, "precision": true,
+}
+
+// buildVarCodeNumber checks whether the varcode's variable is a string that's
+// compared as a number, i.e. if its rules include one of "int", "uint", "float",
+// or "decimal" together with a numeric comparison rule. If it is, the rule that
+// parses the string is moved in front of the comparison rules and returned
+// together with a varcode that represents the parsed number.
+func buildVarCodeNumber(g *generator, code *varcode) (*analysis.Rule, *varcode) {
+	if code.vtype.Kind != analysis.TypeKindString {
+		return nil, nil
+	}
+	nr, typ := (&analysis.TagNode{Rules: code.rules}).NumberRule()
+	if nr == nil {
+		return nil, nil
+	}
+
+	first, pos := -1, 0
+	for i, r := range code.rules {
+		if r == nr {
+			pos = i
+		} else if first < 0 && numberComparisonRules[r.Name] {
+			first = i
+		}
+	}
+	if first < 0 {
+		return nil, nil
+	}
+
+	// the number needs to be parsed before it can be compared
+	if pos > first {
+		rules := make([]*analysis.Rule, 0, len(code.rules))
+		rules = append(rules, code.rules[:first]...)
+		rules = append(rules, nr)
+		rules = append(rules, code.rules[first:pos]...)
+		rules = append(rules, code.rules[pos+1:]...)
+		code.rules = rules
+	}
+
+	field := *code.field
+	field.Type = typ
+	return nr, &varcode{vtype: typ, vexpr: GO.Ident{"num"}, field: &field, errkey: code.errkey}
+}
+
 // assembleBody assembles the built AST nodes into a set of statements that represent
 // the body of the "Validate() error" method.
 func assembleBody(g *generator) (body []GO.StmtNode) {
@@ -397,6 +532,8 @@ func assembleBody(g *generator) (body []GO.StmtNode) {
 			body = append(body, s)
 		}
 	}
+	body = append(body, g.groups...)
+	body = append(body, g.structRules...)
 	if g.afterValidate != nil {
 		body = append(body, g.afterValidate)
 	}
@@ -425,6 +562,9 @@ func assembleVarCode(g *generator, code *varcode) GO.StmtNode {
 
 	// ifstmt for the varcode's rules
 	ifs := assembleVarCodeRules(g, code)
+	if code.unique != nil || len(code.quants) > 0 {
+		return assembleVarCodeLoops(g, code, ifs)
+	}
 	if ifs.Cond != nil {
 		return assembleVarCodeSubBlock(g, code, ifs)
 	}
@@ -490,7 +630,11 @@ func assembleVarCodeRules(g *generator, code *varcode) GO.IfStmt {
 	// only a single rule we can merge its conditional with that of the "nilguard",
 	// note that this works only with single rules, multiple rules would end up
 	// in else-ifs without the nilguard and could cause panic.
-	if (code.ng != nil && code.rqif == nil && code.nnif == nil) && len(code.ruleifs) == 1 {
+	//
+	// The same is true for the "unique" and quantifier rules whose code ends up in an else block.
+	// And if there's a sub-block, then the sub-block is already guarded by the "nilguard".
+	if (code.ng != nil && code.rqif == nil && code.nnif == nil) && len(code.ruleifs) == 1 &&
+		code.unique == nil && len(code.quants) == 0 && code.sb == nil {
 		root.Cond = GO.BinaryExpr{Op: GO.BinaryLAnd, X: code.ng, Y: root.Cond}
 	}
 
@@ -507,22 +651,439 @@ func assembleVarCodeKeyElem(g *generator, code *varcode) (fs GO.ForStmt) {
 	case analysis.TypeKindMap:
 		rc.Key = GO.Ident{"k"}
 		rc.Value = GO.Ident{"e"}
+		if code.key == nil {
+			rc.Key = GO.Ident{"_"}
+		}
 	default:
 		panic("shouldn't reach")
 	}
 	fs.Clause = rc
 
-	if code.vtype.Kind == analysis.TypeKindMap {
+	if code.vtype.Kind == analysis.TypeKindMap && code.key != nil {
 		if sn := assembleVarCode(g, code.key); sn != nil {
 			fs.Body.List = append(fs.Body.List, sn)
 		}
 	}
-	if sn := assembleVarCode(g, code.elem); sn != nil {
-		fs.Body.List = append(fs.Body.List, sn)
+	if code.elem != nil {
+		if sn := assembleVarCode(g, code.elem); sn != nil {
+			fs.Body.List = append(fs.Body.List, sn)
+		}
 	}
 	return fs
 }
 
+// assembleVarCodeLoops assembles the varcode's "unique" and quantifier rules
+// together with the rest of the varcode's AST parts. The elements are checked
+// for uniqueness in a single pass using a map, the same pass is used to validate
+// the keys/elements. Each quantifier rule counts the passing elements in a loop
+// of its own.
+func assembleVarCodeLoops(g *generator, code *varcode, ifs GO.IfStmt) GO.StmtNode {
+	var list []GO.StmtNode
+	if code.unique != nil {
+		list = append(list, newUniqueStmts(g, code)...)
+	} else if code.key != nil || code.elem != nil {
+		if fs := assembleVarCodeKeyElem(g, code); len(fs.Body.List) > 0 {
+			list = append(list, fs)
+		}
+	}
+	for _, r := range code.quants {
+		if len(list) == 0 && len(code.quants) == 1 {
+			list = newQuantStmts(g, code, r)
+		} else {
+			list = append(list, GO.BlockStmt{newQuantStmts(g, code, r)})
+		}
+	}
+	block := GO.BlockStmt{list}
+
+	if ifs.Cond != nil {
+		ifs = appendElseBlock(ifs, block)
+		if code.sb == nil && code.ng != nil && code.rqif == nil && code.nnif == nil {
+			return GO.IfStmt{Cond: code.ng, Body: GO.BlockStmt{[]GO.StmtNode{ifs}}}
+		}
+		return assembleVarCodeSubBlock(g, code, ifs)
+	}
+	if code.ng != nil {
+		return GO.IfStmt{Cond: code.ng, Body: block}
+	}
+	return block
+}
+
+// appendElseBlock appends the given block to the end of the if-else chain.
+func appendElseBlock(ifs GO.IfStmt, block GO.BlockStmt) GO.IfStmt {
+	if next, ok := ifs.Else.(GO.IfStmt); ok {
+		ifs.Else = appendElseBlock(next, block)
+	} else {
+		ifs.Else = block
+	}
+	return ifs
+}
+
+// newUniqueStmts produces statements that declare the set of seen values and
+// loop over the varcode's elements checking each element, or the element's
+// field specified by the "unique" rule's option, against the set.
+func newUniqueStmts(g *generator, code *varcode) []GO.StmtNode {
+	etype := *code.vtype.Elem
+	ktype := etype
+	if opt := code.unique.Options; len(opt) > 0 {
+		for _, f := range etype.PtrBase().Fields {
+			if f.Name == opt[0].Value {
+				ktype = f.Type
+				break
+			}
+		}
+	}
+
+	mtype := GO.MapType{Key: newTypeExpr(g, ktype), Value: GO.Ident{"struct{}"}}
+	mkx := GO.CallMakeExpr{Type: mtype, Size: GO.CallLenExpr{code.vexpr}}
+	seen := GO.AssignStmt{Token: GO.AssignDefine, Lhs: SEEN, Rhs: mkx}
+
+	fs := assembleVarCodeKeyElem(g, code)
+	fs.Body.List = append(newUniqueCheckStmts(g, code, etype), fs.Body.List...)
+	return []GO.StmtNode{seen, fs}
+}
+
+// newUniqueCheckStmts produces statements that check the current element, or the
+// element's field specified by the "unique" rule's option, against the set of
+// already seen values and then add the value to the set.
+func newUniqueCheckStmts(g *generator, code *varcode, etype analysis.Type) []GO.StmtNode {
+	var x GO.ExprNode = GO.Ident{"e"}
+	if opt := code.unique.Options; len(opt) > 0 {
+		x = GO.SelectorExpr{X: x, Sel: GO.Ident{opt[0].Value}}
+	}
+
+	lhs := GO.ExprList{GO.Ident{"_"}, GO.Ident{"ok"}}
+	init := GO.AssignStmt{Token: GO.AssignDefine, Lhs: lhs, Rhs: GO.IndexExpr{X: SEEN, Index: x}}
+	ifs := GO.IfStmt{Init: init, Cond: GO.Ident{"ok"}}
+	ifs.Body.Add(newErrorReturnStmt(g, code, code.unique))
+
+	add := GO.AssignStmt{Token: GO.Assign, Lhs: GO.IndexExpr{X: SEEN, Index: x}, Rhs: GO.ValueLit("struct{}{}")}
+	list := []GO.StmtNode{ifs, add}
+
+	// elements that are nil pointers have no fields to compare
+	if len(code.unique.Options) > 0 && etype.Kind == analysis.TypeKindPtr {
+		cond := GO.BinaryExpr{Op: GO.BinaryNeq, X: GO.Ident{"e"}, Y: NIL}
+		return []GO.StmtNode{GO.IfStmt{Cond: cond, Body: GO.BlockStmt{list}}}
+	}
+	return list
+}
+
+// newQuantStmts produces statements that count the varcode's elements that
+// pass the quantifier rule's inner rules and then check the resulting count.
+func newQuantStmts(g *generator, code *varcode, r *analysis.Rule) []GO.StmtNode {
+	rt := g.info.RuleTypeMap[r.Name].(analysis.RuleTypeQuant)
+	n := GO.Ident{"n"}
+	decl := GO.AssignStmt{Token: GO.AssignDefine, Lhs: n, Rhs: GO.IntLit(0)}
+
+	inc := newQuantPassStmt(g, code, r, rt, GO.IncDecStmt{X: n, Token: GO.IncDecIncrement})
+	fs := GO.ForStmt{}
+	fs.Clause = GO.ForRangeClause{Key: GO.Ident{"_"}, Value: GO.Ident{"e"}, X: code.vexpr, Define: true}
+	fs.Body.Add(inc)
+
+	var cond GO.ExprNode
+	switch r.Name {
+	case "any":
+		cond = GO.BinaryExpr{Op: GO.BinaryEql, X: n, Y: GO.IntLit(0)}
+	case "all":
+		cond = GO.BinaryExpr{Op: GO.BinaryLss, X: n, Y: GO.CallLenExpr{code.vexpr}}
+	case "none":
+		cond = GO.BinaryExpr{Op: GO.BinaryGtr, X: n, Y: GO.IntLit(0)}
+	case "atleast":
+		cond = GO.BinaryExpr{Op: GO.BinaryLss, X: n, Y: newQuantCountExpr(g, r)}
+	case "atmost":
+		cond = GO.BinaryExpr{Op: GO.BinaryGtr, X: n, Y: newQuantCountExpr(g, r)}
+	case "exactly":
+		cond = GO.BinaryExpr{Op: GO.BinaryNeq, X: n, Y: newQuantCountExpr(g, r)}
+	}
+	if len(r.Context) > 0 {
+		opt := GO.SelectorExpr{X: g.recv, Sel: GO.Ident{g.vs.ContextOption.Name}}
+		bin := GO.BinaryExpr{Op: GO.BinaryEql, X: opt, Y: GO.StringLit(r.Context)}
+		cond = GO.BinaryExpr{Op: GO.BinaryLAnd, X: cond, Y: bin}
+	}
+
+	ifs := GO.IfStmt{Cond: cond}
+	ifs.Body.Add(newErrorReturnStmt(g, code, r))
+	return []GO.StmtNode{decl, fs, ifs}
+}
+
+// newGroupStmts produces statements that count the set fields of the given group
+// rule and that check the count against the rule. The key that's passed to the
+// error handler is produced by joining the keys of all of the rule's fields.
+func newGroupStmts(g *generator, r *analysis.Rule) []GO.StmtNode {
+	n := GO.Ident{"n"}
+	decl := GO.AssignStmt{Token: GO.AssignDefine, Lhs: n, Rhs: GO.IntLit(0)}
+	stmts := []GO.StmtNode{decl}
+
+	keys := make([]string, len(r.Options))
+	for i, o := range r.Options {
+		keys[i] = o.Value
+
+		inc := GO.IfStmt{Cond: newGroupSetExpr(g, o)}
+		inc.Body.Add(GO.IncDecStmt{X: n, Token: GO.IncDecIncrement})
+		stmts = append(stmts, inc)
+	}
+
+	var cond GO.ExprNode
+	switch r.Name {
+	case "oneof":
+		cond = GO.BinaryExpr{Op: GO.BinaryNeq, X: n, Y: GO.IntLit(1)}
+	case "anyof":
+		cond = GO.BinaryExpr{Op: GO.BinaryEql, X: n, Y: GO.IntLit(0)}
+	case "allornone":
+		gtr := GO.BinaryExpr{Op: GO.BinaryGtr, X: n, Y: GO.IntLit(0)}
+		lss := GO.BinaryExpr{Op: GO.BinaryLss, X: n, Y: GO.IntLit(len(r.Options))}
+		cond = GO.BinaryExpr{Op: GO.BinaryLAnd, X: gtr, Y: lss}
+	}
+	if len(r.Context) > 0 {
+		opt := GO.SelectorExpr{X: g.recv, Sel: GO.Ident{g.vs.ContextOption.Name}}
+		bin := GO.BinaryExpr{Op: GO.BinaryEql, X: opt, Y: GO.StringLit(r.Context)}
+		cond = GO.BinaryExpr{Op: GO.BinaryLAnd, X: cond, Y: bin}
+	}
+
+	code := &varcode{vexpr: n, field: &analysis.StructField{Key: strings.Join(keys, ",")}}
+	ifs := GO.IfStmt{Cond: cond}
+	ifs.Body.Add(newErrorReturnStmt(g, code, r))
+	return append(stmts, ifs)
+}
+
+// newGroupSetExpr produces an expression that reports whether or not the field,
+// referenced by the given option, is set, i.e. whether or not the field would
+// pass the "required" rule. Pointers in the field's selector are guarded.
+func newGroupSetExpr(g *generator, o *analysis.RuleOption) GO.ExprNode {
+	sel := g.info.SelectorMap[o.Value]
+	x, guard := newSelectorExpr(g, sel)
+
+	f := sel.Last()
+	code := &varcode{vtype: f.Type, vexpr: x, field: f, required: &analysis.Rule{Name: "required"}}
+	buildVarCodeNilGuard(g, code)
+
+	cond := newRequiredExpr(g, code)
+	if cond != nil && code.ng != nil {
+		cond = GO.BinaryExpr{Op: GO.BinaryLOr, X: code.ng, Y: cond}
+	} else if code.ng != nil {
+		cond = code.ng
+	}
+
+	x = newNotExpr(cond)
+	if guard != nil {
+		x = GO.BinaryExpr{Op: GO.BinaryLAnd, X: guard, Y: parenLOr(x)}
+	}
+	return x
+}
+
+// newSelectorExpr produces a selector expression of the given field selector and,
+// if any of the selector's non-leaf fields is a pointer, an expression that
+// checks those pointers against nil, otherwise the returned guard is nil.
+func newSelectorExpr(g *generator, sel analysis.StructFieldSelector) (x, guard GO.ExprNode) {
+	x = g.recv
+	for i, f := range sel {
+		x = GO.SelectorExpr{X: x, Sel: GO.Ident{f.Name}}
+		if i < len(sel)-1 && f.Type.Kind == analysis.TypeKindPtr {
+			bin := GO.BinaryExpr{Op: GO.BinaryNeq, X: x, Y: NIL}
+			if guard == nil {
+				guard = bin
+			} else {
+				guard = GO.BinaryExpr{Op: GO.BinaryLAnd, X: guard, Y: bin}
+			}
+		}
+	}
+	return x, guard
+}
+
+// newQuantCountExpr produces an int expression of the quantifier rule's count option.
+func newQuantCountExpr(g *generator, r *analysis.Rule) GO.ExprNode {
+	opt := r.Options[0]
+	if opt.Type != analysis.OptionTypeField {
+		return GO.ValueLit(opt.Value)
+	}
+
+	typ := g.info.SelectorMap[opt.Value].Last().Type
+	x := newOptionFieldSelectorExpr(g, r, opt, typ)
+	if !typ.Equals(analysis.Type{Kind: analysis.TypeKindInt}) {
+		x = GO.CallExpr{Fun: GO.Ident{"int"}, Args: GO.ArgsList{List: x}}
+	}
+	return x
+}
+
+// newQuantPassStmt produces an if-statement that executes the given statement if
+// the current element passes the quantifier rule's inner rules. The inner rules
+// whose if-statements declare variables, e.g. the number parsed by "int", or the
+// instant used by "within", are checked in nested if-statements so that those
+// variables are in scope of the rules that follow.
+func newQuantPassStmt(g *generator, code *varcode, r *analysis.Rule, rt analysis.RuleTypeQuant, inc GO.StmtNode) GO.StmtNode {
+	etype := *code.vtype.Elem
+	vtype, _ := rt.ElemType(r, etype)
+
+	// if the rule names a field of the element, the element needs
+	// to be checked against nil before the field can be selected
+	var guard GO.ExprNode
+	var vexpr GO.ExprNode = GO.Ident{"e"}
+	if n := len(r.Options); n == 2 || (n == 1 && !rt.Counted) {
+		for t := etype; t.Kind == analysis.TypeKindPtr; t = *t.Elem {
+			if guard == nil {
+				guard = GO.BinaryExpr{Op: GO.BinaryNeq, X: vexpr, Y: NIL}
+			} else {
+				vexpr = GO.ParenExpr{GO.PointerIndirectionExpr{vexpr}}
+				bin := GO.BinaryExpr{Op: GO.BinaryNeq, X: vexpr, Y: NIL}
+				guard = GO.BinaryExpr{Op: GO.BinaryLAnd, X: guard, Y: bin}
+			}
+		}
+		vexpr = GO.SelectorExpr{X: vexpr, Sel: GO.Ident{r.Options[n-1].Value}}
+	}
+
+	// the inner rules' error messages aren't used, make
+	// sure they don't cause unnecessary package imports
+	importErrors, importFmt := g.file.importErrors, g.file.importFmt
+	defer func() { g.file.importErrors, g.file.importFmt = importErrors, importFmt }()
+
+	elem := &varcode{vtype: vtype, vexpr: vexpr, field: code.field}
+	for _, ir := range r.Inner.Rules {
+		if ir.Name == "required" {
+			elem.required = ir
+		} else if ir.Name == "notnil" {
+			elem.notnil = ir
+		} else {
+			elem.rules = append(elem.rules, ir)
+		}
+	}
+	buildVarCodeNilGuard(g, elem)
+	buildVarCodeRequired(g, elem)
+	buildVarCodeNotnil(g, elem)
+	buildVarCodeRules(g, elem)
+
+	// group the conditions under which the element fails the inner
+	// rules by the if-statement whose init declares their variables
+	type level struct {
+		init  GO.StmtNode
+		conds []GO.ExprNode
+	}
+	levels := []*level{{}}
+	if elem.ng != nil && elem.rqif == nil && elem.nnif == nil {
+		levels[0].conds = append(levels[0].conds, newNotExpr(elem.ng))
+	}
+	if elem.rqif != nil {
+		levels[0].conds = append(levels[0].conds, elem.rqif.Cond)
+	}
+	if elem.nnif != nil {
+		levels[0].conds = append(levels[0].conds, elem.nnif.Cond)
+	}
+	for _, ifs := range elem.ruleifs {
+		if ifs.Init != nil {
+			levels = append(levels, &level{init: ifs.Init})
+		}
+		lv := levels[len(levels)-1]
+		lv.conds = append(lv.conds, ifs.Cond)
+	}
+
+	// assemble the if-statements from the innermost outwards
+	stmt := inc
+	for i := len(levels) - 1; i >= 0; i-- {
+		var fail, pass GO.ExprNode
+		for _, c := range levels[i].conds {
+			if fail == nil {
+				fail = c
+			} else {
+				fail = GO.BinaryExpr{Op: GO.BinaryLOr, X: fail, Y: c}
+			}
+		}
+		if fail != nil {
+			pass = newNotExpr(fail)
+		}
+		if i == 0 && guard != nil {
+			if pass == nil {
+				pass = guard
+			} else {
+				pass = GO.BinaryExpr{Op: GO.BinaryLAnd, X: guard, Y: parenLOr(pass)}
+			}
+		}
+		if pass == nil {
+			continue
+		}
+
+		ifs := GO.IfStmt{Init: levels[i].init, Cond: pass}
+		ifs.Body.Add(stmt)
+		stmt = ifs
+	}
+	return stmt
+}
+
+// newNotExpr produces an expression that is the negation of the given boolean
+// expression. Comparisons are negated by inverting the comparison operator and
+// logical expressions by applying De Morgan's laws.
+func newNotExpr(x GO.ExprNode) GO.ExprNode {
+	switch x := x.(type) {
+	case GO.UnaryExpr:
+		if x.Op == GO.UnaryNot {
+			return x.X
+		}
+	case GO.ParenExpr:
+		return GO.ParenExpr{newNotExpr(x.X)}
+	case GO.BinaryExpr:
+		if op, ok := binaryOpInverse[x.Op]; ok {
+			x.Op = op
+			return x
+		}
+		if x.Op == GO.BinaryLOr {
+			return GO.BinaryExpr{Op: GO.BinaryLAnd, X: parenLOr(newNotExpr(x.X)), Y: parenLOr(newNotExpr(x.Y))}
+		}
+		if x.Op == GO.BinaryLAnd {
+			return GO.BinaryExpr{Op: GO.BinaryLOr, X: newNotExpr(x.X), Y: newNotExpr(x.Y)}
+		}
+	case GO.CallExpr, GO.Ident, GO.SelectorExpr, GO.QualifiedIdent:
+		return GO.UnaryExpr{Op: GO.UnaryNot, X: x}
+	}
+	return GO.UnaryExpr{Op: GO.UnaryNot, X: GO.ParenExpr{x}}
+}
+
+// parenLOr wraps the given expression in parentheses if it's a logical OR
+// expression so that it can be used as an operand of a logical AND expression.
+func parenLOr(x GO.ExprNode) GO.ExprNode {
+	if bin, ok := x.(GO.BinaryExpr); ok && bin.Op == GO.BinaryLOr {
+		return GO.ParenExpr{x}
+	}
+	return x
+}
+
+// newTypeExpr produces a type expression of the given type. Named types that
+// are declared in another package are qualified with the package's name.
+func newTypeExpr(g *generator, t analysis.Type) GO.TypeNode {
+	if len(t.Name) > 0 {
+		name := t.Name
+		if len(t.TypeArgs) > 0 {
+			args := make([]string, len(t.TypeArgs))
+			for i, arg := range t.TypeArgs {
+				args[i] = newTypeString(g, arg)
+			}
+			name += "[" + strings.Join(args, ", ") + "]"
+		}
+		if t.IsImported {
+			imp := addimport(g.file, t.PkgPath)
+			return GO.QualifiedIdent{imp.name, name}
+		}
+		return GO.Ident{name}
+	}
+
+	switch t.Kind {
+	case analysis.TypeKindArray:
+		return GO.ArrayType{Len: GO.IntLit(t.ArrayLen), Elem: newTypeExpr(g, *t.Elem)}
+	case analysis.TypeKindPtr:
+		return GO.PointerType{Elem: newTypeExpr(g, *t.Elem)}
+	case analysis.TypeKindStruct:
+		// unnamed struct types are compared as interface
+		// values to avoid having to produce their fields
+		if len(t.Fields) > 0 {
+			return GO.Ident{"interface{}"}
+		}
+	}
+	return GO.Ident{t.String()}
+}
+
+// newTypeString returns the string representation of the type expression of t.
+func newTypeString(g *generator, t analysis.Type) string {
+	var b strings.Builder
+	GO.Write(newTypeExpr(g, t), &b)
+	return b.String()
+}
+
 // newRequiredExpr produces an expression that checks the varcode's variable against the "zero" value.
 func newRequiredExpr(g *generator, code *varcode) GO.ExprNode {
 	switch code.vtype.Kind {
@@ -538,6 +1099,10 @@ func newRequiredExpr(g *generator, code *varcode) GO.ExprNode {
 		return GO.BinaryExpr{Op: GO.BinaryEql, X: code.vexpr, Y: GO.ValueLit("false")}
 	case analysis.TypeKindPtr, analysis.TypeKindInterface:
 		return GO.BinaryExpr{Op: GO.BinaryEql, X: code.vexpr, Y: NIL}
+	case analysis.TypeKindStruct:
+		if code.vtype.IsTime() {
+			return newMethodCallExpr(code.vexpr, "IsZero")
+		}
 	}
 	return nil
 }
@@ -568,7 +1133,19 @@ func newRuleIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt
 			return newRuleTypeBasicRngIfStmt(g, code, r)
 		}
 		return newRuleTypeBasicIfStmt(g, code, r)
+	case analysis.RuleTypeTime:
+		return newRuleTypeTimeIfStmt(g, code, r)
 	case analysis.RuleTypeFunc:
+		// the arguments of a generic function are generated for the
+		// types the function is instantiated with, the call itself
+		// relies on the compiler to infer the type arguments
+		rx, ok := rx.Instantiate(code.vtype)
+		if !ok {
+			// NOTE(mkopriva): this shouldn't happen given that the
+			// analysis already checked that the field's type satisfies
+			// the function's type parameter constraints.
+			panic("shouldn't reach")
+		}
 		if rx.LOp > 0 {
 			return newRuleTypeFuncChainIfStmt(g, code, r, rx)
 		}
@@ -579,6 +1156,122 @@ func newRuleIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt
 	return ifs
 }
 
+// newNumberParseIfStmt produces an if-statement that parses the varcode's string
+// variable as a number, in accordance with the given rule, into the variable "num"
+// and that returns the rule's error if the string could not be parsed.
+func newNumberParseIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt) {
+	var x GO.ExprNode = code.vexpr
+	if len(code.vtype.Name) > 0 {
+		x = GO.CallExpr{Fun: GO.Ident{"string"}, Args: GO.ArgsList{List: x}}
+	}
+
+	var call GO.CallExpr
+	switch r.Name {
+	case "int":
+		imp := addimport(g.file, "strconv")
+		call = GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "ParseInt"},
+			Args: GO.ArgsList{List: GO.ExprList{x, GO.IntLit(10), GO.IntLit(64)}}}
+	case "uint":
+		imp := addimport(g.file, "strconv")
+		call = GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "ParseUint"},
+			Args: GO.ArgsList{List: GO.ExprList{x, GO.IntLit(10), GO.IntLit(64)}}}
+	case "float":
+		imp := addimport(g.file, "strconv")
+		call = GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "ParseFloat"},
+			Args: GO.ArgsList{List: GO.ExprList{x, GO.IntLit(64)}}}
+	case "decimal":
+		rt := g.info.RuleTypeMap[r.Name].(analysis.RuleTypeFunc)
+		imp := addimport(g.file, rt.PkgPath)
+		args := GO.ExprList{x}
+		optypes := rt.TypesForOptions(r.Options)
+		for i, o := range r.Options {
+			args = append(args, newOptionValueExpr(g, r, o, optypes[i]))
+		}
+		call = GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "ParseDec"},
+			Args: GO.ArgsList{List: args}}
+	}
+
+	ifs.Init = GO.AssignStmt{Token: GO.AssignDefine, Lhs: GO.ExprList{GO.Ident{"num"}, ERR}, Rhs: call}
+	ifs.Cond = GO.BinaryExpr{Op: GO.BinaryNeq, X: ERR, Y: NIL}
+	ifs.Body.Add(newErrorReturnStmt(g, code, r))
+	return ifs
+}
+
+// newDecimalIfStmt produces an if-statement that checks the varcode's *isvalid.Dec
+// variable, as parsed by the "decimal" rule, against the given rule. The variable
+// is compared using the Dec's methods so that no precision is lost in the process.
+func newDecimalIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt) {
+	method := func(name string, args ...GO.ExprNode) GO.CallExpr {
+		call := GO.CallExpr{Fun: GO.SelectorExpr{X: code.vexpr, Sel: GO.Ident{name}}}
+		if len(args) > 0 {
+			call.Args = GO.ArgsList{List: GO.ExprList(args)}
+		}
+		return call
+	}
+	cmp := func(op GO.BinaryOp, o *analysis.RuleOption) GO.ExprNode {
+		if o.Type != analysis.OptionTypeField {
+			y := method("Cmp", newDecimalOptionExpr(g, r, o))
+			return GO.BinaryExpr{Op: op, X: y, Y: GO.IntLit(0)}
+		}
+
+		// a referenced integer field's value is compared exactly, a float's
+		// value fails the comparison if it's NaN, since it can't be ordered
+		ftype := g.info.SelectorMap[o.Value].Last().Type.PtrBase()
+		if ftype.Kind.IsUnsigned() {
+			x := newOptionFieldSelectorExpr(g, r, o, analysis.Type{Kind: analysis.TypeKindUint64})
+			return GO.BinaryExpr{Op: op, X: method("CmpUint", x), Y: GO.IntLit(0)}
+		} else if ftype.Kind.IsInteger() {
+			x := newOptionFieldSelectorExpr(g, r, o, analysis.Type{Kind: analysis.TypeKindInt64})
+			return GO.BinaryExpr{Op: op, X: method("CmpInt", x), Y: GO.IntLit(0)}
+		}
+		x := newOptionFieldSelectorExpr(g, r, o, analysis.Type{Kind: analysis.TypeKindFloat64})
+		y := GO.BinaryExpr{Op: op, X: method("CmpFloat", x), Y: GO.IntLit(0)}
+		imp := addimport(g.file, "math")
+		nan := GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "IsNaN"}, Args: GO.ArgsList{List: x}}
+		return GO.ParenExpr{GO.BinaryExpr{Op: GO.BinaryLOr, X: nan, Y: y}}
+	}
+
+	switch r.Name {
+	case "scale", "precision":
+		typ := analysis.Type{Kind: analysis.TypeKindInt} // the methods return an int
+		name := map[string]string{"scale": "Scale", "precision": "Precision"}[r.Name]
+		ifs.Cond = GO.BinaryExpr{Op: GO.BinaryGtr, X: method(name), Y: newOptionValueExpr(g, r, r.Options[0], typ)}
+	case "rng":
+		o1, o2 := r.Options[0], r.Options[1]
+		ifs.Cond = GO.ParenExpr{GO.BinaryExpr{Op: GO.BinaryLOr,
+			X: cmp(GO.BinaryLss, o1), Y: cmp(GO.BinaryGtr, o2)}}
+	default:
+		ifs.Cond = cmp(basicRuleToBinaryOp[r.Name], r.Options[0])
+	}
+
+	ifs.Body.Add(newErrorReturnStmt(g, code, r))
+	return ifs
+}
+
+// newDecimalOptionExpr produces an *isvalid.Dec expression of the given literal
+// option's value. The value is parsed once, by the initializer of a package-level
+// variable, rather than every time the generated code is executed.
+func newDecimalOptionExpr(g *generator, r *analysis.Rule, o *analysis.RuleOption) GO.ExprNode {
+	rt := g.info.RuleTypeMap["decimal"].(analysis.RuleTypeFunc)
+	imp := addimport(g.file, rt.PkgPath)
+	call := GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "MustParseDec"}, Args: GO.ArgsList{List: GO.StringLit(o.Value)}}
+
+	// derive the variable's name from the validator's type name and the
+	// value, e.g. "-0.01" => "_T_m0_01", the validator types are unique
+	// within a package and so the variables will not clash with those
+	// declared by the files generated for the package's other source files
+	name := "_" + g.vs.TypeName + "_" + strings.NewReplacer("-", "m", "+", "", ".", "_").Replace(o.Value)
+	if g.file.varnames[name] {
+		return GO.Ident{name}
+	}
+	if g.file.varnames == nil {
+		g.file.varnames = make(map[string]bool)
+	}
+	g.file.varnames[name] = true
+	g.file.vars = append(g.file.vars, GO.ValueSpec{Names: GO.Ident{name}, Values: call})
+	return GO.Ident{name}
+}
+
 // newRuleTypeIsValidIfStmt produces an if-statement that checks the varcode's variable using the "IsValid()" method.
 func newRuleTypeIsValidIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt) {
 	x := code.vexpr
@@ -618,10 +1311,7 @@ func newRuleTypeEnumIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs G
 
 // newRuleTypeBasicIfStmt produces an if-statement that checks the varcode's variable using basic comparison operators.
 func newRuleTypeBasicIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt) {
-	typ := code.field.Type
-	for typ.Kind == analysis.TypeKindPtr {
-		typ = *typ.Elem
-	}
+	typ := code.vtype.PtrBase()
 
 	binop := basicRuleToBinaryOp[r.Name]
 	logop := basicRuleToLogicalOp[r.Name]
@@ -708,13 +1398,132 @@ func newRuleTypeBasicRngIfStmt(g *generator, code *varcode, r *analysis.Rule) (i
 	o1, o2 := r.Options[0], r.Options[1]
 
 	ifs.Cond = GO.BinaryExpr{Op: GO.BinaryLOr,
-		X: GO.BinaryExpr{Op: GO.BinaryLss, X: code.vexpr, Y: newOptionValueExpr(g, r, o1, code.field.Type.PtrBase())},
-		Y: GO.BinaryExpr{Op: GO.BinaryGtr, X: code.vexpr, Y: newOptionValueExpr(g, r, o2, code.field.Type.PtrBase())}}
+		X: GO.BinaryExpr{Op: GO.BinaryLss, X: code.vexpr, Y: newOptionValueExpr(g, r, o1, code.vtype.PtrBase())},
+		Y: GO.BinaryExpr{Op: GO.BinaryGtr, X: code.vexpr, Y: newOptionValueExpr(g, r, o2, code.vtype.PtrBase())}}
 	ifs.Cond = GO.ParenExpr{ifs.Cond}
 	ifs.Body.Add(newErrorReturnStmt(g, code, r))
 	return ifs
 }
 
+// newRuleTypeTimeIfStmt produces an if-statement that checks the varcode's time.Time,
+// or time.Duration, variable using the value's methods and the "time" package.
+func newRuleTypeTimeIfStmt(g *generator, code *varcode, r *analysis.Rule) (ifs GO.IfStmt) {
+	// the "time" package is imported only if the produced code references it
+	pkg := func() string { return addimport(g.file, "time").name }
+	now := func() GO.ExprNode { return GO.CallExpr{Fun: GO.QualifiedIdent{pkg(), "Now"}} }
+
+	switch r.Name {
+	case "before":
+		ref := newOptionValueExpr(g, r, r.Options[0], code.vtype)
+		ifs.Cond = GO.UnaryExpr{Op: GO.UnaryNot, X: newMethodCallExpr(code.vexpr, "Before", ref)}
+	case "after":
+		ref := newOptionValueExpr(g, r, r.Options[0], code.vtype)
+		ifs.Cond = GO.UnaryExpr{Op: GO.UnaryNot, X: newMethodCallExpr(code.vexpr, "After", ref)}
+	case "future":
+		ifs.Cond = GO.UnaryExpr{Op: GO.UnaryNot, X: newMethodCallExpr(code.vexpr, "After", now())}
+	case "past":
+		ifs.Cond = GO.UnaryExpr{Op: GO.UnaryNot, X: newMethodCallExpr(code.vexpr, "Before", now())}
+	case "within":
+		// the negative and the positive duration
+		var neg, pos GO.ExprNode
+		if o := r.Options[0]; o.Type == analysis.OptionTypeField {
+			pos = newOptionFieldSelectorExpr(g, r, o, g.info.SelectorMap[o.Value].Last().Type)
+			neg = GO.UnaryExpr{Op: GO.UnarySub, X: pos}
+		} else {
+			d, _ := time.ParseDuration(o.Value)
+			pos, neg = newDurationExpr(g, d), newDurationExpr(g, -d)
+		}
+
+		if code.vtype.IsDuration() {
+			ifs.Cond = GO.ParenExpr{GO.BinaryExpr{Op: GO.BinaryLOr,
+				X: GO.BinaryExpr{Op: GO.BinaryLss, X: code.vexpr, Y: neg},
+				Y: GO.BinaryExpr{Op: GO.BinaryGtr, X: code.vexpr, Y: pos}}}
+		} else {
+			ref := newOptionValueExpr(g, r, r.Options[1], code.vtype)
+			if r.Options[1].Type == analysis.OptionTypeNow {
+				// both bounds must be relative to the same instant
+				ifs.Init = GO.AssignStmt{Token: GO.AssignDefine, Lhs: GO.Ident{"now"}, Rhs: ref}
+				ref = GO.Ident{"now"}
+			}
+			ifs.Cond = GO.ParenExpr{GO.BinaryExpr{Op: GO.BinaryLOr,
+				X: newMethodCallExpr(code.vexpr, "Before", newMethodCallExpr(ref, "Add", neg)),
+				Y: newMethodCallExpr(code.vexpr, "After", newMethodCallExpr(ref, "Add", pos))}}
+		}
+	case "weekday":
+		for _, o := range r.Options {
+			day := GO.QualifiedIdent{pkg(), o.Value}
+			cond := GO.BinaryExpr{Op: GO.BinaryNeq, X: newMethodCallExpr(code.vexpr, "Weekday"), Y: day}
+			if ifs.Cond != nil {
+				ifs.Cond = GO.BinaryExpr{Op: GO.BinaryLAnd, X: ifs.Cond, Y: cond}
+			} else {
+				ifs.Cond = cond
+			}
+		}
+	case "businesshours":
+		weekday := newMethodCallExpr(code.vexpr, "Weekday")
+		hour := newMethodCallExpr(code.vexpr, "Hour")
+		ifs.Cond = GO.ParenExpr{GO.BinaryExpr{Op: GO.BinaryLOr,
+			X: GO.BinaryExpr{Op: GO.BinaryLOr,
+				X: GO.BinaryExpr{Op: GO.BinaryEql, X: weekday, Y: GO.QualifiedIdent{pkg(), "Saturday"}},
+				Y: GO.BinaryExpr{Op: GO.BinaryEql, X: weekday, Y: GO.QualifiedIdent{pkg(), "Sunday"}}},
+			Y: GO.BinaryExpr{Op: GO.BinaryLOr,
+				X: GO.BinaryExpr{Op: GO.BinaryLss, X: hour, Y: GO.ValueLit(r.Options[0].Value)},
+				Y: GO.BinaryExpr{Op: GO.BinaryGeq, X: hour, Y: GO.ValueLit(r.Options[1].Value)}}}}
+	default:
+		panic("shouldn't reach")
+	}
+
+	ifs.Body.Add(newErrorReturnStmt(g, code, r))
+	return ifs
+}
+
+// newMethodCallExpr produces an expression that invokes the named method on x.
+func newMethodCallExpr(x GO.ExprNode, name string, args ...GO.ExprNode) GO.CallExpr {
+	if _, ok := x.(GO.PointerIndirectionExpr); ok {
+		x = GO.ParenExpr{x}
+	}
+	call := GO.CallExpr{Fun: GO.SelectorExpr{X: x, Sel: GO.Ident{name}}}
+	if len(args) > 0 {
+		call.Args = GO.ArgsList{List: GO.ExprList(args)}
+	}
+	return call
+}
+
+// The units of the "time" package, from the largest to the smallest.
+var durationUnits = []struct {
+	name string
+	unit time.Duration
+}{
+	{"Hour", time.Hour},
+	{"Minute", time.Minute},
+	{"Second", time.Second},
+	{"Millisecond", time.Millisecond},
+	{"Microsecond", time.Microsecond},
+	{"Nanosecond", time.Nanosecond},
+}
+
+// newDurationExpr produces an expression of the given duration using the
+// largest of the "time" package's units that represents d exactly.
+func newDurationExpr(g *generator, d time.Duration) GO.ExprNode {
+	if d == 0 {
+		return GO.IntLit(0)
+	}
+
+	imp := addimport(g.file, "time")
+	for _, u := range durationUnits {
+		if d%u.unit == 0 {
+			unit := GO.QualifiedIdent{imp.name, u.name}
+			if n := d / u.unit; n != 1 {
+				return GO.BinaryExpr{Op: GO.BinaryMul, X: GO.IntLit(n), Y: unit}
+			}
+			return unit
+		}
+	}
+
+	panic("shouldn't reach")
+	return nil
+}
+
 // newRuleTypeFuncIfStmt produces an if-statement that checks the varcode's variable using the rule's function.
 func newRuleTypeFuncIfStmt(g *generator, code *varcode, r *analysis.Rule, rt analysis.RuleTypeFunc) (ifs GO.IfStmt) {
 	imp := addimport(g.file, rt.PkgPath)
@@ -803,7 +1612,7 @@ func newOptionFieldSelectorExpr(g *generator, r *analysis.Rule, o *analysis.Rule
 		x = GO.UnaryExpr{Op: GO.UnaryAmp, X: x}
 	} else if t.NeedsConversion(last.Type) {
 		cx := GO.CallExpr{}
-		cx.Fun = GO.Ident{last.Type.String()}
+		cx.Fun = GO.Ident{t.String()}
 		cx.Args = GO.ArgsList{List: x}
 		x = cx
 	}
@@ -819,7 +1628,7 @@ func newOptionConstExpr(g *generator, r *analysis.Rule, o *analysis.RuleOption,
 	}
 
 	if t.IsEmptyInterface {
-		if o.Type == analysis.OptionTypeString {
+		if o.Type == analysis.OptionTypeString || o.Type == analysis.OptionTypeDuration {
 			if userawstring {
 				return GO.RawStringLit(o.Value)
 			}
@@ -884,6 +1693,14 @@ func newOptionConstExpr(g *generator, r *analysis.Rule, o *analysis.RuleOption,
 			x = GO.SelectorExpr{X: x, Sel: GO.Ident{f.Name}}
 		}
 		return x
+
+	case analysis.OptionTypeNow:
+		imp := addimport(g.file, "time")
+		return GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "Now"}}
+
+	case analysis.OptionTypeDuration:
+		d, _ := time.ParseDuration(o.Value)
+		return newDurationExpr(g, d)
 	}
 
 	panic("shouldn't reach")
@@ -894,8 +1711,13 @@ func newOptionConstExpr(g *generator, r *analysis.Rule, o *analysis.RuleOption,
 func newErrorReturnStmt(g *generator, code *varcode, r *analysis.Rule) GO.StmtNode {
 	// Build code for custom handler, if one exists.
 	if g.vs.ErrorHandler != nil {
+		key := code.field.Key
+		if len(code.errkey) > 0 {
+			key = code.errkey
+		}
+
 		args := make(GO.ExprList, 3)
-		args[0] = GO.StringLit(code.field.Key)
+		args[0] = GO.StringLit(key)
 		args[1] = code.vexpr
 		args[2] = GO.StringLit(r.Name)
 
@@ -911,6 +1733,16 @@ func newErrorReturnStmt(g *generator, code *varcode, r *analysis.Rule) GO.StmtNo
 				args = append(args, GO.StringLit(o.Value))
 			case analysis.OptionTypeUnknown:
 				args = append(args, GO.StringLit(""))
+			case analysis.OptionTypeNow:
+				imp := addimport(g.file, "time")
+				args = append(args, GO.CallExpr{Fun: GO.QualifiedIdent{imp.name, "Now"}})
+			case analysis.OptionTypeDuration:
+				if code.vtype.Kind == analysis.TypeKindString {
+					args = append(args, GO.StringLit(o.Value))
+				} else {
+					d, _ := time.ParseDuration(o.Value)
+					args = append(args, newDurationExpr(g, d))
+				}
 			default:
 				args = append(args, GO.ValueLit(o.Value))
 			}
@@ -949,6 +1781,12 @@ var errTextMap = map[string][]string{
 // newErrorExpr produces an error value expression.
 func newErrorExpr(g *generator, code *varcode, r *analysis.Rule) (errExpr GO.ExprNode) {
 	errConf := g.info.RuleTypeMap[r.Name].ErrConf()
+	if rt, ok := g.info.RuleTypeMap[r.Name].(analysis.RuleTypeQuant); ok {
+		return newQuantErrorExpr(g, code, r, rt)
+	}
+	if rt, ok := g.info.RuleTypeMap[r.Name].(analysis.RuleTypeGroup); ok {
+		return newGroupErrorExpr(g, r, rt)
+	}
 
 	var textSuffix string
 	if r.Name == "len" || r.Name == "runecount" {
@@ -969,6 +1807,9 @@ func newErrorExpr(g *generator, code *varcode, r *analysis.Rule) (errExpr GO.Exp
 		errConf.Text = "is not valid"
 	}
 
+	// the string options of the time rules are names, e.g. of weekdays, not literals
+	_, unquoted := g.info.RuleTypeMap[r.Name].(analysis.RuleTypeTime)
+
 	typ := code.field.Type.PtrBase()
 	errText := code.field.Key + " " + errConf.Text
 
@@ -993,7 +1834,7 @@ func newErrorExpr(g *generator, code *varcode, r *analysis.Rule) (errExpr GO.Exp
 				}
 				refs = append(refs, x)
 				opts = append(opts, "%v")
-			} else if o.Type == analysis.OptionTypeString {
+			} else if o.Type == analysis.OptionTypeString && !unquoted {
 				opts = append(opts, strconv.Quote(o.Value))
 			} else {
 				opts = append(opts, o.Value)
@@ -1008,6 +1849,103 @@ func newErrorExpr(g *generator, code *varcode, r *analysis.Rule) (errExpr GO.Exp
 		errText += " " + textSuffix
 	}
 
+	return newErrorTextExpr(g, errText, refs)
+}
+
+// newQuantErrorExpr produces an error expression for the given quantifier rule.
+// The error message describes the elements that were counted, e.g. "F must contain
+// at least 2 elements that satisfy "email"".
+func newQuantErrorExpr(g *generator, code *varcode, r *analysis.Rule, rt analysis.RuleTypeQuant) GO.ExprNode {
+	var refs GO.ExprList
+	errText := code.field.Key + " " + rt.Err.Text
+
+	plural := r.Name != "any"
+	opts := r.Options
+	if rt.Counted {
+		if o := opts[0]; o.Type == analysis.OptionTypeField {
+			x := GO.ExprNode(g.recv)
+			for _, f := range g.info.SelectorMap[o.Value] {
+				x = GO.SelectorExpr{X: x, Sel: GO.Ident{f.Name}}
+			}
+			refs = append(refs, x)
+			errText += " %v"
+		} else {
+			errText += " " + o.Value
+			plural = o.Value != "1"
+		}
+		opts = opts[1:]
+	}
+
+	if plural {
+		errText += " elements"
+	} else {
+		errText += " element"
+	}
+	if len(opts) > 0 {
+		errText += " whose " + opts[0].Value
+	} else {
+		errText += " that"
+	}
+	if plural {
+		errText += " satisfy"
+	} else {
+		errText += " satisfies"
+	}
+
+	inner := strconv.Quote(ruleTagString(r.Inner))
+	if len(refs) > 0 {
+		inner = strings.ReplaceAll(inner, "%", "%%")
+	}
+	return newErrorTextExpr(g, errText+" "+inner, refs)
+}
+
+// newGroupErrorExpr produces an error expression for the given group rule.
+// The error message lists the keys of all of the rule's fields, e.g. "exactly
+// one of Email, Phone must be set".
+func newGroupErrorExpr(g *generator, r *analysis.Rule, rt analysis.RuleTypeGroup) GO.ExprNode {
+	keys := make([]string, len(r.Options))
+	for i, o := range r.Options {
+		keys[i] = o.Value
+	}
+	errText := rt.Err.Text + " " + strings.Join(keys, ", ") + " must be set"
+	return newErrorTextExpr(g, errText, nil)
+}
+
+// ruleTagString returns the rules of the given TagNode formatted as in the "is" tag.
+func ruleTagString(tn *analysis.TagNode) string {
+	rules := make([]string, len(tn.Rules))
+	for i, r := range tn.Rules {
+		str := r.Name
+		for _, o := range r.Options {
+			switch o.Type {
+			case analysis.OptionTypeField:
+				str += ":&" + o.Value
+			case analysis.OptionTypeNow:
+				str += ":@now"
+			case analysis.OptionTypeString:
+				if strings.ContainsAny(o.Value, `:,()[]"`) {
+					str += ":" + strconv.Quote(o.Value)
+				} else {
+					str += ":" + o.Value
+				}
+			default:
+				str += ":" + o.Value
+			}
+		}
+		if len(r.Context) > 0 {
+			str += ":@" + r.Context
+		}
+		if r.Inner != nil {
+			str += "(" + ruleTagString(r.Inner) + ")"
+		}
+		rules[i] = str
+	}
+	return strings.Join(rules, ",")
+}
+
+// newErrorTextExpr produces an error expression with the given text. If refs
+// is not empty the text is used as the format for the referenced values.
+func newErrorTextExpr(g *generator, errText string, refs GO.ExprList) (errExpr GO.ExprNode) {
 	errTextExpr := GO.ValueLit(strconv.Quote(errText))
 	if len(refs) > 0 {
 		g.file.importFmt = true
@@ -1102,6 +2040,15 @@ func addimport(f *file, path string) *impspec {
 	return imp
 }
 
+var binaryOpInverse = map[GO.BinaryOp]GO.BinaryOp{
+	GO.BinaryEql: GO.BinaryNeq,
+	GO.BinaryNeq: GO.BinaryEql,
+	GO.BinaryLss: GO.BinaryGeq,
+	GO.BinaryGeq: GO.BinaryLss,
+	GO.BinaryGtr: GO.BinaryLeq,
+	GO.BinaryLeq: GO.BinaryGtr,
+}
+
 var basicRuleToBinaryOp = map[string]GO.BinaryOp{
 	"eq":  GO.BinaryNeq,
 	"ne":  GO.BinaryEql,
@@ -75,12 +75,20 @@ func TestGenerator(t *testing.T) {
 		"slice",
 
 		"strongpass",
+		"generic",
+		"time",
+		"datetime",
+		"unique",
+		"quant",
+		"group",
+		"struct_rules",
+		"number_string", "decimal", "iso31662", "iso639", "zipin", "address", "bic",
 	}
 
 	anConf := analysis.Config{FieldKeyJoin: true, FieldKeySeparator: "."}
 
 	var AST search.AST
-	pkgs, err := search.Search("../testdata/generator", false, nil, &AST)
+	pkgs, err := search.Search("../testdata/generator", false, nil, nil, &AST)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -92,13 +100,16 @@ func TestGenerator(t *testing.T) {
 		{"myrule", "github.com/frk/isvalid/internal/testdata/mypkg", "MyRule"},
 		{"myrule2", "github.com/frk/isvalid/internal/testdata/mypkg", "MyRule2"},
 		{"myrule3", "github.com/frk/isvalid/internal/testdata/mypkg", "MyRule3"},
+		{"myrange", "github.com/frk/isvalid/internal/testdata/mypkg", "MyRange"},
+		{"myoneof", "github.com/frk/isvalid/internal/testdata/mypkg", "MyOneOf"},
+		{"myunique", "github.com/frk/isvalid/internal/testdata/mypkg", "MyUnique"},
 	}
 	for _, cr := range customrules {
 		f, err := search.FindFunc(cr[1], cr[2], AST)
 		if err != nil {
 			t.Fatal(err)
 		}
-		if err := anConf.AddRuleFunc(cr[0], f); err != nil {
+		if err := anConf.AddRuleFunc(analysis.RuleConfig{Name: cr[0]}, f); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -0,0 +1,504 @@
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/url"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/frk/isvalid/internal/analysis"
+	"github.com/frk/isvalid/internal/search"
+)
+
+// completion returns the list of completion items for the given position.
+func (s *Server) completion(p textDocumentPositionParams) *completionList {
+	list := &completionList{Items: []completionItem{}}
+
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok {
+		return list
+	}
+	line := lineAt(doc.text, p.Position.Line)
+	col := byteOffset(line, p.Position.Character)
+	val, start, ok := tagValueAt(line, col)
+	if !ok {
+		return list
+	}
+
+	c := parseTagContext(val, col-start)
+	switch c.kind {
+	case tagContextRuleName:
+		list.Items = s.ruleNameItems()
+	case tagContextRuleOption:
+		list.Items = s.ruleOptionItems(c.rule, c.index)
+	case tagContextFieldRef:
+		list.Items = s.fieldRefItems(p.TextDocument.URI, doc, lineOffset(doc.text, p.Position.Line)+col)
+	case tagContextProperty:
+		files := parsePackageFiles(p.TextDocument.URI, doc)
+		list.Items = contextPropertyItems(doc.text, files.props)
+		if _, ok := s.rules[c.rule].(analysis.RuleTypeTime); ok {
+			now := completionItem{Label: "now", Kind: kindConstant, Detail: "time.Now()"}
+			list.Items = append([]completionItem{now}, list.Items...)
+		}
+	}
+
+	// replace the prefix that's already been typed
+	r := lspRange{Start: p.Position, End: p.Position}
+	r.Start.Character = utf16Len(line[:start+c.start])
+	for i := range list.Items {
+		if list.Items[i].TextEdit == nil {
+			list.Items[i].TextEdit = &textEdit{Range: r, NewText: list.Items[i].Label}
+		}
+	}
+	return list
+}
+
+// ruleNameItems returns an item for each of the known rules.
+func (s *Server) ruleNameItems() (items []completionItem) {
+	names := make([]string, 0, len(s.rules))
+	for name := range s.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rt := s.rules[name]
+		item := completionItem{Label: name, Kind: kindKeyword}
+		if _, ok := rt.(analysis.RuleTypeFunc); ok {
+			item.Kind = kindFunction
+		}
+		item.Detail = ruleSignature(rt)
+		if doc := s.ruleDoc(name); len(doc) > 0 {
+			item.Documentation = &markupContent{Kind: "plaintext", Value: doc}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// ruleOptionItems returns an item for each of the predefined values of the
+// ith option of the named rule, i.e. the values from the rule's "opts" config.
+func (s *Server) ruleOptionItems(name string, i int) (items []completionItem) {
+	rt, ok := s.rules[name].(analysis.RuleTypeFunc)
+	if !ok || i >= len(rt.OptionValues) {
+		return items
+	}
+
+	detail := ""
+	if t, ok := optionArgType(rt, i); ok {
+		detail = t.String()
+	}
+	for key, opt := range rt.OptionValues[i] {
+		if k, ok := key.(string); ok {
+			item := completionItem{Label: k, Kind: kindConstant, Detail: detail}
+			if k != opt.Value {
+				item.Detail = strings.TrimSpace(detail + " " + strconv.Quote(opt.Value))
+			}
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// fieldRefItems returns an item for each of the field keys of the validator
+// struct that encloses the given offset. Since the packages are loaded from
+// disk, the keys reflect the state of the document when it was last saved.
+func (s *Server) fieldRefItems(uri string, doc *document, offset int) (items []completionItem) {
+	filename := uriToPath(uri)
+	if len(filename) == 0 {
+		return items
+	}
+	typeName := enclosingTypeName(filename, doc.text, offset)
+	if len(typeName) == 0 {
+		return items
+	}
+
+	keys := make(map[string]string)
+	pkg := loadPackage(uri, doc)
+	for _, p := range pkg.pkgs {
+		for _, file := range p.Files {
+			for _, match := range file.Matches {
+				if match.Named.Obj().Name() != typeName {
+					continue
+				}
+
+				// The analysis may fail, e.g. because of the very tag
+				// that's being edited, the fields encountered before
+				// the failure will still be in the SelectorMap though.
+				info := new(analysis.Info)
+				_, _ = s.conf.Analyze(pkg.ast, match, info)
+				for key, sel := range info.SelectorMap {
+					keys[key] = sel[len(sel)-1].Type.String()
+				}
+			}
+		}
+	}
+
+	for key, typ := range keys {
+		items = append(items, completionItem{Label: key, Kind: kindField, Detail: typ})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// loadedPackage holds the package of a document as loaded from disk.
+type loadedPackage struct {
+	ast  search.AST
+	pkgs []*search.Package
+}
+
+// Matches any type name, the types that aren't enclosing the cursor
+// are skipped by the caller, regardless of the target name config.
+var rxAnyTypeName = regexp.MustCompile(``)
+
+// loadPackage returns the package of the given document. The package is
+// loaded at most once per version of the document, if loading fails the
+// returned package is empty.
+func loadPackage(uri string, doc *document) *loadedPackage {
+	if doc.pkg != nil {
+		return doc.pkg
+	}
+	doc.pkg = new(loadedPackage)
+
+	filename := uriToPath(uri)
+	if len(filename) == 0 {
+		return doc.pkg
+	}
+	dir := filepath.Dir(filename)
+
+	// errors are ignored, the package may well be broken while it's being edited
+	filter := func(p string) bool { return p == filename }
+	doc.pkg.pkgs, _ = search.Search(dir, false, filter, rxAnyTypeName, &doc.pkg.ast)
+	return doc.pkg
+}
+
+// packageFiles holds the information gathered from the Go files in the
+// directory of a document. The files are only parsed, not type-checked,
+// which makes this a lot cheaper than loading the document's package.
+type packageFiles struct {
+	// The context properties used in the "is" tags of the files.
+	props map[string]bool
+	// The names of the rules implemented by the files' functions
+	// whose documentation contains the "isvalid:rule" directive.
+	rules map[string]bool
+}
+
+// parsePackageFiles returns the information gathered from the Go files in the
+// directory of the given document. The files are parsed at most once per
+// version of the document.
+func parsePackageFiles(uri string, doc *document) *packageFiles {
+	if doc.files != nil {
+		return doc.files
+	}
+	doc.files = &packageFiles{props: make(map[string]bool), rules: make(map[string]bool)}
+
+	filename := uriToPath(uri)
+	if len(filename) == 0 {
+		return doc.files
+	}
+
+	paths, _ := filepath.Glob(filepath.Join(filepath.Dir(filename), "*.go"))
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, _ := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+		if f == nil {
+			continue
+		}
+		addContextProps(doc.files.props, f)
+
+		for _, d := range f.Decls {
+			fd, ok := d.(*ast.FuncDecl)
+			if !ok || fd.Recv != nil || !fd.Name.IsExported() {
+				continue
+			}
+			var conf struct {
+				Name string `json:"name"`
+			}
+			if data := search.RuleJSON(fd.Doc); len(data) > 0 && json.Unmarshal(data, &conf) == nil {
+				doc.files.rules[conf.Name] = true
+			}
+		}
+	}
+	return doc.files
+}
+
+var rxContextProperty = regexp.MustCompile(`:@(\w+)`)
+
+// contextPropertyItems returns an item for each of the context properties
+// that are used in the "is" tags of the given document, or in the given set
+// of properties, i.e. the ones used in the rest of the document's package.
+func contextPropertyItems(text string, pkgProps map[string]bool) (items []completionItem) {
+	props := make(map[string]bool)
+	for prop := range pkgProps {
+		props[prop] = true
+	}
+
+	// the document may be incomplete, use whatever the parser can salvage
+	f, _ := parser.ParseFile(token.NewFileSet(), "", text, 0)
+	addContextProps(props, f)
+
+	for prop := range props {
+		items = append(items, completionItem{Label: prop, Kind: kindValue})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+	return items
+}
+
+// addContextProps adds the context properties used in the
+// "is" tags of the given file's struct fields to the props set.
+func addContextProps(props map[string]bool, f *ast.File) {
+	if f == nil {
+		return
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if field, ok := n.(*ast.Field); ok && field.Tag != nil {
+			tag, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return true
+			}
+			val := reflect.StructTag(tag).Get("is")
+			for _, m := range rxContextProperty.FindAllStringSubmatch(val, -1) {
+				if m[1] != "now" { // "@now" is an option
+					props[m[1]] = true
+				}
+			}
+		}
+		return true
+	})
+}
+
+// hover returns the documentation of the rule, or rule option,
+// at the given position. If there's nothing to show nil is returned.
+func (s *Server) hover(p textDocumentPositionParams) *hover {
+	doc, ok := s.docs[p.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+	line := lineAt(doc.text, p.Position.Line)
+	col := byteOffset(line, p.Position.Character)
+	val, start, ok := tagValueAt(line, col)
+	if !ok {
+		return nil
+	}
+
+	end := tagElemEnd(val, col-start)
+	c := parseTagContext(val, end)
+
+	var md string
+	switch c.kind {
+	case tagContextRuleName:
+		rt, ok := s.rules[c.prefix]
+		if !ok {
+			return nil
+		}
+		if _, ok := rt.(analysis.RuleTypeFunc); ok {
+			md = "```go\n" + ruleSignature(rt) + "\n```\n\n" + s.ruleDoc(c.prefix)
+		} else {
+			min, max := analysis.OptionCount(rt)
+			md = "**" + c.prefix + "** " + optionCountText(min, max) + "\n\n" + s.ruleDoc(c.prefix)
+		}
+	case tagContextRuleOption:
+		rt, ok := s.rules[c.rule]
+		if !ok {
+			return nil
+		}
+		md = optionText(c.rule, c.index, rt)
+	default:
+		return nil
+	}
+
+	r := lspRange{Start: p.Position, End: p.Position}
+	r.Start.Character = utf16Len(line[:start+c.start])
+	r.End.Character = utf16Len(line[:start+end])
+	return &hover{Contents: markupContent{Kind: "markdown", Value: strings.TrimSpace(md)}, Range: &r}
+}
+
+// ruleSignature returns a short, human readable, description of the given
+// RuleType, for rules implemented by a function that's the function signature.
+func ruleSignature(rt analysis.RuleType) string {
+	f, ok := rt.(analysis.RuleTypeFunc)
+	if !ok {
+		min, max := analysis.OptionCount(rt)
+		return "builtin rule, " + optionCountText(min, max)
+	}
+
+	params := []string{f.FieldArgType.String()}
+	for i, t := range f.OptionArgTypes {
+		if f.IsVariadic && i == len(f.OptionArgTypes)-1 && t.Elem != nil {
+			params = append(params, "..."+t.Elem.String())
+		} else {
+			params = append(params, t.String())
+		}
+	}
+	return fmt.Sprintf("func %s.%s(%s) bool", path.Base(f.PkgPath), f.FuncName, strings.Join(params, ", "))
+}
+
+// optionText returns a description of the ith option of the named rule.
+func optionText(name string, i int, rt analysis.RuleType) string {
+	md := fmt.Sprintf("option #%d of rule %q", i+1, name)
+	if f, ok := rt.(analysis.RuleTypeFunc); ok {
+		if t, ok := optionArgType(f, i); ok {
+			md += ", of type `" + t.String() + "`"
+		}
+		if i < len(f.OptionValues) {
+			var vals []string
+			for key, opt := range f.OptionValues[i] {
+				if k, ok := key.(string); ok {
+					vals = append(vals, "`"+k+"`")
+				} else {
+					md += "\n\ndefault: `" + opt.Value + "`"
+				}
+			}
+			if len(vals) > 0 {
+				sort.Strings(vals)
+				md += "\n\naccepted values: " + strings.Join(vals, ", ")
+			}
+		}
+	}
+
+	min, max := analysis.OptionCount(rt)
+	return md + "\n\n" + optionCountText(min, max)
+}
+
+// optionArgType returns the type of the function argument that corresponds
+// to the ith option of the given RuleTypeFunc.
+func optionArgType(f analysis.RuleTypeFunc, i int) (analysis.Type, bool) {
+	n := len(f.OptionArgTypes)
+	if f.LOp > 0 && n > 0 {
+		// each option is passed in a separate call
+		return f.OptionArgTypes[0], true
+	}
+	if i < n && !(f.IsVariadic && i == n-1) {
+		return f.OptionArgTypes[i], true
+	}
+	if f.IsVariadic && n > 0 && f.OptionArgTypes[n-1].Elem != nil {
+		return *f.OptionArgTypes[n-1].Elem, true
+	}
+	return analysis.Type{}, false
+}
+
+// optionCountText describes the number of options that a rule accepts.
+func optionCountText(min, max int) string {
+	switch {
+	case min == 0 && max == 0:
+		return "accepts no options"
+	case max == -1:
+		return fmt.Sprintf("accepts at least %d option(s)", min)
+	case min == max:
+		return fmt.Sprintf("accepts %d option(s)", min)
+	}
+	return fmt.Sprintf("accepts %d to %d options", min, max)
+}
+
+// enclosingTypeName returns the name of the type declaration that encloses
+// the given offset, or an empty string if no such declaration is found.
+func enclosingTypeName(filename, text string, offset int) (name string) {
+	fset := token.NewFileSet()
+	f, _ := parser.ParseFile(fset, filename, text, 0)
+	if f == nil {
+		return ""
+	}
+
+	tf := fset.File(f.Pos())
+	if tf == nil || offset > tf.Size() {
+		return ""
+	}
+	pos := tf.Pos(offset)
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if ts := spec.(*ast.TypeSpec); ts.Pos() <= pos && pos <= ts.End() {
+				return ts.Name.Name
+			}
+		}
+	}
+	return ""
+}
+
+// uriToPath returns the file path of the given "file://" uri.
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return ""
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+// lineAt returns the nth line of the given text.
+func lineAt(text string, n int) string {
+	for ; n > 0; n-- {
+		i := strings.IndexByte(text, '\n')
+		if i < 0 {
+			return ""
+		}
+		text = text[i+1:]
+	}
+	if i := strings.IndexByte(text, '\n'); i > -1 {
+		text = text[:i]
+	}
+	return strings.TrimSuffix(text, "\r")
+}
+
+// lineOffset returns the byte offset of the start of the nth line of the given text.
+func lineOffset(text string, n int) (off int) {
+	for ; n > 0; n-- {
+		i := strings.IndexByte(text[off:], '\n')
+		if i < 0 {
+			return len(text)
+		}
+		off += i + 1
+	}
+	return off
+}
+
+// offsetPosition converts the byte offset in the given text
+// to the line and UTF-16 based character position.
+func offsetPosition(text string, off int) (p position) {
+	if off > len(text) {
+		off = len(text)
+	}
+	p.Line = strings.Count(text[:off], "\n")
+	start := strings.LastIndexByte(text[:off], '\n') + 1
+	p.Character = utf16Len(text[start:off])
+	return p
+}
+
+// byteOffset converts the UTF-16 based character offset, as used
+// by the protocol, to the byte offset in the given line.
+func byteOffset(line string, char int) int {
+	n := 0
+	for i, r := range line {
+		if n >= char {
+			return i
+		}
+		n += len(utf16.Encode([]rune{r}))
+	}
+	return len(line)
+}
+
+// utf16Len returns the length of s in UTF-16 code units.
+func utf16Len(s string) (n int) {
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		n += len(utf16.Encode([]rune{r}))
+	}
+	return n
+}
@@ -0,0 +1,91 @@
+package lsp
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+
+	"github.com/frk/isvalid/internal/analysis"
+)
+
+// publishDiagnostics queues the diagnostics of the
+// document with the given uri to be sent to the client.
+func (s *Server) publishDiagnostics(uri string) {
+	doc, ok := s.docs[uri]
+	if !ok {
+		return
+	}
+	s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Version:     doc.version,
+		Diagnostics: s.diagnostics(doc.text, parsePackageFiles(uri, doc).rules),
+	})
+}
+
+// diagnostics returns a diagnostic for each "is" tag in the given text that
+// cannot be parsed, and for each unknown rule used in such a tag. A rule is
+// known if it's known to the server, or if it's in the given set of rules,
+// i.e. those declared in the document's package. Only the tag itself is
+// checked, the rules' options and the types of the fields are left to the
+// analysis that is run by the generator.
+func (s *Server) diagnostics(text string, pkgRules map[string]bool) []diagnostic {
+	list := []diagnostic{}
+
+	// the document may be incomplete, use whatever the parser can salvage
+	fset := token.NewFileSet()
+	f, _ := parser.ParseFile(fset, "", text, 0)
+	if f == nil {
+		return list
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		field, ok := n.(*ast.Field)
+		if !ok || field.Tag == nil || field.Tag.Value[0] != '`' {
+			return true
+		}
+		lit := field.Tag.Value
+		tag, err := strconv.Unquote(lit)
+		if err != nil {
+			return true
+		}
+		val, start, ok := isTagValue(tag)
+		if !ok {
+			return true
+		}
+
+		// the raw literal has no escape sequences, offsets into the
+		// tag are therefore offsets into the literal minus the "`"
+		off := fset.Position(field.Tag.Pos()).Offset + 1 + start
+		r := lspRange{Start: offsetPosition(text, off), End: offsetPosition(text, off+len(val))}
+
+		tn, err := analysis.ParseRuleTag(tag)
+		if err != nil {
+			list = append(list, diagnostic{Range: r, Severity: severityError,
+				Source: "isvalid", Message: "bad \"is\" tag: " + err.Error()})
+			return true
+		}
+		for _, name := range s.unknownRules(tn, pkgRules, nil) {
+			list = append(list, diagnostic{Range: r, Severity: severityError,
+				Source: "isvalid", Message: "unknown rule: " + strconv.Quote(name)})
+		}
+		return true
+	})
+	return list
+}
+
+// unknownRules appends the names of the rules of the given node, and of its
+// child nodes, that are neither known to the server nor in pkgRules to names.
+func (s *Server) unknownRules(tn *analysis.TagNode, pkgRules map[string]bool, names []string) []string {
+	if tn == nil {
+		return names
+	}
+	for _, r := range tn.Rules {
+		if _, ok := s.rules[r.Name]; !ok && !pkgRules[r.Name] {
+			names = append(names, r.Name)
+		}
+		names = s.unknownRules(r.Inner, pkgRules, names)
+	}
+	names = s.unknownRules(tn.Key, pkgRules, names)
+	return s.unknownRules(tn.Elem, pkgRules, names)
+}
@@ -0,0 +1,168 @@
+package lsp
+
+import (
+	"encoding/json"
+)
+
+// The subset of the JSON-RPC 2.0 and Language Server Protocol types
+// that is needed by the server. Field names follow the specification,
+// see https://microsoft.github.io/language-server-protocol/specification.
+
+type (
+	// request represents both a JSON-RPC request and a notification,
+	// the latter is identified by the absence of the ID field.
+	request struct {
+		JSONRPC string           `json:"jsonrpc"`
+		ID      *json.RawMessage `json:"id,omitempty"`
+		Method  string           `json:"method"`
+		Params  json.RawMessage  `json:"params,omitempty"`
+	}
+
+	response struct {
+		JSONRPC string           `json:"jsonrpc"`
+		ID      *json.RawMessage `json:"id"`
+		Result  interface{}      `json:"result"`
+		Error   *responseError   `json:"error,omitempty"`
+	}
+
+	// notification is a message sent by the server
+	// to which the client does not respond.
+	notification struct {
+		JSONRPC string      `json:"jsonrpc"`
+		Method  string      `json:"method"`
+		Params  interface{} `json:"params"`
+	}
+
+	responseError struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	initializeResult struct {
+		Capabilities serverCapabilities `json:"capabilities"`
+		ServerInfo   serverInfo         `json:"serverInfo"`
+	}
+
+	serverInfo struct {
+		Name string `json:"name"`
+	}
+
+	serverCapabilities struct {
+		TextDocumentSync   int               `json:"textDocumentSync"`
+		CompletionProvider completionOptions `json:"completionProvider"`
+		HoverProvider      bool              `json:"hoverProvider"`
+	}
+
+	completionOptions struct {
+		TriggerCharacters []string `json:"triggerCharacters"`
+	}
+
+	textDocumentItem struct {
+		URI     string `json:"uri"`
+		Version int    `json:"version"`
+		Text    string `json:"text"`
+	}
+
+	textDocumentIdentifier struct {
+		URI string `json:"uri"`
+	}
+
+	versionedTextDocumentIdentifier struct {
+		URI     string `json:"uri"`
+		Version int    `json:"version"`
+	}
+
+	didOpenTextDocumentParams struct {
+		TextDocument textDocumentItem `json:"textDocument"`
+	}
+
+	didChangeTextDocumentParams struct {
+		TextDocument   versionedTextDocumentIdentifier  `json:"textDocument"`
+		ContentChanges []textDocumentContentChangeEvent `json:"contentChanges"`
+	}
+
+	textDocumentContentChangeEvent struct {
+		Text string `json:"text"`
+	}
+
+	didCloseTextDocumentParams struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+	}
+
+	textDocumentPositionParams struct {
+		TextDocument textDocumentIdentifier `json:"textDocument"`
+		Position     position               `json:"position"`
+	}
+
+	position struct {
+		Line      int `json:"line"`
+		Character int `json:"character"`
+	}
+
+	lspRange struct {
+		Start position `json:"start"`
+		End   position `json:"end"`
+	}
+
+	textEdit struct {
+		Range   lspRange `json:"range"`
+		NewText string   `json:"newText"`
+	}
+
+	markupContent struct {
+		Kind  string `json:"kind"`
+		Value string `json:"value"`
+	}
+
+	completionList struct {
+		IsIncomplete bool             `json:"isIncomplete"`
+		Items        []completionItem `json:"items"`
+	}
+
+	completionItem struct {
+		Label         string         `json:"label"`
+		Kind          int            `json:"kind,omitempty"`
+		Detail        string         `json:"detail,omitempty"`
+		Documentation *markupContent `json:"documentation,omitempty"`
+		TextEdit      *textEdit      `json:"textEdit,omitempty"`
+	}
+
+	hover struct {
+		Contents markupContent `json:"contents"`
+		Range    *lspRange     `json:"range,omitempty"`
+	}
+
+	publishDiagnosticsParams struct {
+		URI         string       `json:"uri"`
+		Version     int          `json:"version"`
+		Diagnostics []diagnostic `json:"diagnostics"`
+	}
+
+	diagnostic struct {
+		Range    lspRange `json:"range"`
+		Severity int      `json:"severity"`
+		Source   string   `json:"source"`
+		Message  string   `json:"message"`
+	}
+)
+
+const (
+	// TextDocumentSyncKind
+	syncFull = 1
+
+	// CompletionItemKind
+	kindFunction = 3
+	kindField    = 5
+	kindValue    = 12
+	kindKeyword  = 14
+	kindConstant = 21
+
+	// DiagnosticSeverity
+	severityError = 1
+
+	// JSON-RPC error codes
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
@@ -0,0 +1,294 @@
+// package lsp implements a language server that provides completion, hover, and
+// diagnostics for the "is" struct tag, the server communicates with the client
+// using the Language Server Protocol.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/frk/isvalid/internal/analysis"
+	"github.com/frk/isvalid/internal/search"
+)
+
+// Server is a language server that provides features for the "is" struct tag.
+type Server struct {
+	// The analysis configuration used for looking up rules and field keys.
+	conf analysis.Config
+	// The RuleTypes known to conf, mapped by rule name.
+	rules map[string]analysis.RuleType
+	// The open documents, mapped by document uri.
+	docs map[string]*document
+	// Cache of the rule functions' documentation, mapped by rule name.
+	ruleDocs map[string]string
+	// Set once the "shutdown" request is received.
+	shutdown bool
+	// The notifications to be sent to the client
+	// once the current message has been handled.
+	outbox []*notification
+
+	mu sync.Mutex
+}
+
+// NewServer returns a new Server that will use the given analysis
+// configuration to look up the rules to be offered to the client.
+func NewServer(conf analysis.Config) *Server {
+	s := new(Server)
+	s.conf = conf
+	s.rules = conf.RuleTypeMap()
+	s.docs = make(map[string]*document)
+	s.ruleDocs = make(map[string]string)
+	return s
+}
+
+// document is a text document opened by the client.
+type document struct {
+	// The document's text and the version of that text.
+	text    string
+	version int
+	// The document's package as loaded from disk, and the
+	// information gathered from the files of that package,
+	// both are nil until needed for the current version.
+	pkg   *loadedPackage
+	files *packageFiles
+}
+
+// Serve reads the client's messages from r and writes the server's messages to w.
+// Serve returns when the client sends the "exit" notification, or when r is
+// exhausted, or if an error occurs while reading or writing messages.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	tr := textproto.NewReader(bufio.NewReader(r))
+	for {
+		body, err := readMessage(tr)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		req := new(request)
+		if err := json.Unmarshal(body, req); err != nil {
+			res := &response{JSONRPC: "2.0", Error: &responseError{codeParseError, err.Error()}}
+			if err := writeMessage(w, res); err != nil {
+				return err
+			}
+			continue
+		}
+		if req.Method == "exit" {
+			return nil
+		}
+
+		result, rerr := s.handle(req)
+		for _, n := range s.flush() {
+			if err := writeMessage(w, n); err != nil {
+				return err
+			}
+		}
+		if req.ID == nil {
+			// notifications get no response
+			continue
+		}
+
+		res := &response{JSONRPC: "2.0", ID: req.ID, Result: result, Error: rerr}
+		if err := writeMessage(w, res); err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches the request to the appropriate method and returns the result.
+func (s *Server) handle(req *request) (result interface{}, err *responseError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch req.Method {
+	case "initialize":
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync: syncFull,
+				CompletionProvider: completionOptions{
+					TriggerCharacters: []string{`"`, ",", ":", "[", "]", "&", "@"},
+				},
+				HoverProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "isvalid"},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		p := didOpenTextDocumentParams{}
+		if err := unmarshalParams(req, &p); err != nil {
+			return nil, err
+		}
+		s.docs[p.TextDocument.URI] = &document{text: p.TextDocument.Text, version: p.TextDocument.Version}
+		s.publishDiagnostics(p.TextDocument.URI)
+		return nil, nil
+	case "textDocument/didChange":
+		p := didChangeTextDocumentParams{}
+		if err := unmarshalParams(req, &p); err != nil {
+			return nil, err
+		}
+		// the server asks for full sync, the last change is the whole text
+		if n := len(p.ContentChanges); n > 0 {
+			s.docs[p.TextDocument.URI] = &document{text: p.ContentChanges[n-1].Text, version: p.TextDocument.Version}
+			s.publishDiagnostics(p.TextDocument.URI)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		p := didCloseTextDocumentParams{}
+		if err := unmarshalParams(req, &p); err != nil {
+			return nil, err
+		}
+		delete(s.docs, p.TextDocument.URI)
+		// clear the document's diagnostics
+		s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI: p.TextDocument.URI, Diagnostics: []diagnostic{}})
+		return nil, nil
+	case "textDocument/completion":
+		p := textDocumentPositionParams{}
+		if err := unmarshalParams(req, &p); err != nil {
+			return nil, err
+		}
+		return s.completion(p), nil
+	case "textDocument/hover":
+		p := textDocumentPositionParams{}
+		if err := unmarshalParams(req, &p); err != nil {
+			return nil, err
+		}
+		if h := s.hover(p); h != nil {
+			return h, nil
+		}
+		return nil, nil
+	}
+
+	if req.ID != nil && !strings.HasPrefix(req.Method, "$/") {
+		return nil, &responseError{codeMethodNotFound, "method not supported: " + req.Method}
+	}
+	return nil, nil
+}
+
+// notify queues a notification to be sent to the client.
+func (s *Server) notify(method string, params interface{}) {
+	s.outbox = append(s.outbox, &notification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+// flush returns, and removes from the queue, the pending notifications.
+func (s *Server) flush() (out []*notification) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out, s.outbox = s.outbox, nil
+	return out
+}
+
+func unmarshalParams(req *request, v interface{}) *responseError {
+	if err := json.Unmarshal(req.Params, v); err != nil {
+		return &responseError{codeInvalidParams, err.Error()}
+	}
+	return nil
+}
+
+// readMessage reads the header and content parts of the next message
+// from the given reader and returns the content part.
+func readMessage(r *textproto.Reader) ([]byte, error) {
+	h, err := r.ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF || (err == io.ErrUnexpectedEOF && len(h) == 0) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+
+	n, err := strconv.Atoi(h.Get("Content-Length"))
+	if err != nil || n < 0 {
+		return nil, fmt.Errorf("lsp: bad Content-Length header: %q", h.Get("Content-Length"))
+	}
+
+	body := make([]byte, n)
+	if _, err := io.ReadFull(r.R, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// writeMessage writes the given message, preceded by
+// the header part, to the given writer.
+func writeMessage(w io.Writer, msg interface{}) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n", len(body)); err != nil {
+		return err
+	}
+	_, err = w.Write(body)
+	return err
+}
+
+// ruleDoc returns the documentation of the function of the named rule, if any.
+func (s *Server) ruleDoc(name string) string {
+	if doc, ok := s.ruleDocs[name]; ok {
+		return doc
+	}
+
+	var doc string
+	if rt, ok := s.rules[name].(analysis.RuleTypeFunc); ok {
+		// errors are ignored, the docs are a nice-to-have
+		doc, _ = search.FindFuncDoc(rt.PkgPath, rt.FuncName, search.AST{})
+	} else {
+		doc = builtinRuleDocs[name]
+	}
+	s.ruleDocs[name] = doc
+	return doc
+}
+
+// Appended to the documentation of the rules that compare numbers.
+const numberStringDoc = " A string value is parsed as a number if the rule is used together with \"int\", \"uint\", \"float\", or \"decimal\"." +
+	" Strings parsed by \"decimal\" are compared without loss of precision."
+
+// Documentation for the builtin rules that are not backed by a function.
+var builtinRuleDocs = map[string]string{
+	"required":  "Checks that the value is not empty, i.e. not the zero value of its type, nor an empty slice, map, or string.",
+	"notnil":    "Checks that the value is not nil.",
+	"eq":        "Checks that the value is equal to one of the options.",
+	"ne":        "Checks that the value is not equal to any of the options.",
+	"gt":        "Checks that the value is greater than the option." + numberStringDoc,
+	"lt":        "Checks that the value is less than the option." + numberStringDoc,
+	"gte":       "Checks that the value is greater than or equal to the option." + numberStringDoc,
+	"lte":       "Checks that the value is less than or equal to the option." + numberStringDoc,
+	"min":       "Checks that the value is greater than or equal to the option." + numberStringDoc,
+	"max":       "Checks that the value is less than or equal to the option." + numberStringDoc,
+	"rng":       "Checks that the value is between the two options, inclusive." + numberStringDoc,
+	"scale":     "Checks that the decimal number has at most as many digits after the decimal point as the option. The rule must be used together with \"decimal\".",
+	"precision": "Checks that the decimal number has at most as many digits, not counting leading zeros, as the option. The rule must be used together with \"decimal\".",
+	"len":       "Checks the length of the value. With one option the length must be equal to it, with two options the length must be between them; either of the two can be omitted to leave that end of the range open, e.g. \"len:1:\".",
+	"runecount": "Checks the number of runes in the value. The options work the same as those of the \"len\" rule.",
+	"unique":    "Checks that the elements of the slice, array, or map are unique. If an option is given it names the field by which struct elements are compared.",
+	"any":       "Checks that at least one element passes the rules enclosed in parentheses, e.g. \"any(email)\". If an option is given it names the field of the struct elements that the rules are applied to.",
+	"all":       "Checks that every element passes the rules enclosed in parentheses. If an option is given it names the field of the struct elements that the rules are applied to.",
+	"none":      "Checks that no element passes the rules enclosed in parentheses. If an option is given it names the field of the struct elements that the rules are applied to.",
+	"atleast":   "Checks that at least as many elements as the first option pass the rules enclosed in parentheses, e.g. \"atleast:2(email)\". The optional second option names the field of the struct elements that the rules are applied to.",
+	"atmost":    "Checks that at most as many elements as the first option pass the rules enclosed in parentheses. The optional second option names the field of the struct elements that the rules are applied to.",
+	"exactly":   "Checks that exactly as many elements as the first option pass the rules enclosed in parentheses, e.g. \"exactly:1:IsPrimary(eq:true)\". The optional second option names the field of the struct elements that the rules are applied to.",
+	"oneof":     "Checks that exactly one of the fields, whose keys are the options, is set. Must be declared in the tag of a blank field, e.g. _ struct{} `is:\"oneof:Email:Phone\"`.",
+	"anyof":     "Checks that at least one of the fields, whose keys are the options, is set. Must be declared in the tag of a blank field.",
+	"allornone": "Checks that either all or none of the fields, whose keys are the options, are set. Must be declared in the tag of a blank field.",
+	"isvalid":   "Checks the value by invoking its IsValid() method. The rule is applied automatically to types that implement the method.",
+	"-isvalid":  "Prevents the \"isvalid\" rule from being applied automatically.",
+	"enum":      "Checks that the value is equal to one of the constants declared with the value's type.",
+
+	"before":        "Checks that the time is before the option, which is either \"@now\" or a reference to a time.Time field.",
+	"after":         "Checks that the time is after the option, which is either \"@now\" or a reference to a time.Time field.",
+	"future":        "Checks that the time is in the future.",
+	"past":          "Checks that the time is in the past.",
+	"within":        "Checks that the time is within the duration, e.g. \"within:24h\", of the second option, which is either \"@now\" (the default) or a reference to a time.Time field. With a time.Duration value it checks that the value is between the negative and the positive duration.",
+	"weekday":       "Checks that the time falls on one of the days of the week, e.g. \"weekday:sat:sun\". Without options the days are Monday through Friday.",
+	"businesshours": "Checks that the time falls on a day from Monday through Friday, between the opening (inclusive) and the closing (exclusive) hour. Without options the hours are 9 and 17.",
+}
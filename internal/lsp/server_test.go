@@ -0,0 +1,347 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/frk/compare"
+	"github.com/frk/isvalid/internal/analysis"
+	"github.com/frk/isvalid/internal/search"
+)
+
+const testfile = "../testdata/lsp/validator.go"
+
+func TestMain(m *testing.M) {
+	// load the builtin rule funcs, the same as the command does
+	analysis.LoadRuleTypeFunc(search.AST{})
+
+	os.Exit(m.Run())
+}
+
+// testdoc returns the uri and the text of the test file with the line
+// that contains the "Name" field replaced by the given line. The cursor
+// position is marked in the line by "|" and it is returned as pos.
+func testdoc(t *testing.T, line string) (uri, text string, pos position) {
+	t.Helper()
+	path, err := filepath.Abs(testfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(string(data), "\n")
+	for i := range lines {
+		if strings.HasPrefix(lines[i], "\tName ") {
+			col := strings.IndexByte(line, '|')
+			if col > -1 {
+				line = line[:col] + line[col+1:]
+				pos = position{Line: i, Character: utf16Len(line[:col])}
+			}
+			lines[i] = line
+			break
+		}
+	}
+	return "file://" + filepath.ToSlash(path), strings.Join(lines, "\n"), pos
+}
+
+// open sends the didOpen notification with the given document to the server.
+func open(t *testing.T, s *Server, uri, text string) {
+	t.Helper()
+	params, _ := json.Marshal(didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, Version: 1, Text: text}})
+	if _, err := s.handle(&request{Method: "textDocument/didOpen", Params: params}); err != nil {
+		t.Fatal(err)
+	}
+	s.outbox = nil
+}
+
+// labels returns the labels of the given items.
+func labels(items []completionItem) (out []string) {
+	for _, item := range items {
+		out = append(out, item.Label)
+	}
+	return out
+}
+
+func TestServerCompletion(t *testing.T) {
+	tests := []struct {
+		line string
+		// if set, the labels must match exactly
+		want []string
+		// if set, the labels must contain these
+		contains []string
+		// the character at which the replaced text starts
+		start int
+	}{{
+		line:     "\tName string `is:\"req|\"`",
+		contains: []string{"email", "len", "required"},
+		start:    18,
+	}, {
+		line:     "\tName string `is:\"required,|\"`",
+		contains: []string{"email", "len", "required"},
+		start:    27,
+	}, {
+		line:  "\tName string `is:\"base64:|\"`",
+		want:  []string{"url"},
+		start: 25,
+	}, {
+		line:  "\tName string `is:\"rng:&|\"`",
+		want:  []string{"Address", "Address.City", "Email", "Finish", "Name", "Start", "context"},
+		start: 23,
+	}, {
+		line:  "\tName string `is:\"rng:&Fi|\"`",
+		want:  []string{"Address", "Address.City", "Email", "Finish", "Name", "Start", "context"},
+		start: 23,
+	}, {
+		// the "create" property is used only in the package's other file
+		line:  "\tName string `is:\"len:1:@|\"`",
+		want:  []string{"create", "update"},
+		start: 25,
+	}, {
+		line:  "\tName string `is:\"len:1:@|\"` // another:\"len:1:@ignored\"",
+		want:  []string{"create", "update"},
+		start: 25,
+	}, {
+		line:  "\tName string `is:\"before:@|\"`",
+		want:  []string{"now", "create", "update"},
+		start: 26,
+	}, {
+		line: "\tName string `json:\"|\"`",
+		want: []string{},
+	}, {
+		line: "\tName string // is:\"|\"",
+		want: []string{},
+	}}
+
+	s := NewServer(analysis.Config{FieldKeyJoin: true, FieldKeySeparator: "."})
+	for _, tt := range tests {
+		uri, text, pos := testdoc(t, tt.line)
+		open(t, s, uri, text)
+
+		p := textDocumentPositionParams{TextDocument: textDocumentIdentifier{URI: uri}, Position: pos}
+		list := s.completion(p)
+
+		got := labels(list.Items)
+		if tt.want != nil {
+			if got == nil {
+				got = []string{}
+			}
+			if e := compare.Compare(got, tt.want); e != nil {
+				t.Errorf("%q: got %q: %v", tt.line, got, e)
+			}
+		}
+		for _, label := range tt.contains {
+			if !strings.Contains(" "+strings.Join(got, " ")+" ", " "+label+" ") {
+				t.Errorf("%q: got %q; want it to contain %q", tt.line, got, label)
+			}
+		}
+		for _, item := range list.Items {
+			want := lspRange{Start: position{pos.Line, tt.start}, End: pos}
+			if item.TextEdit == nil || item.TextEdit.Range != want {
+				t.Errorf("%q: item %q got edit %+v; want range %+v", tt.line, item.Label, item.TextEdit, want)
+				break
+			}
+		}
+	}
+}
+
+func TestServerCompletionLoadsPackageOncePerVersion(t *testing.T) {
+	s := NewServer(analysis.Config{})
+	uri, text, pos := testdoc(t, "\tName string `is:\"rng:&|\"`")
+	open(t, s, uri, text)
+
+	p := textDocumentPositionParams{TextDocument: textDocumentIdentifier{URI: uri}, Position: pos}
+	s.completion(p)
+	pkg := s.docs[uri].pkg
+	if pkg == nil || len(pkg.pkgs) == 0 {
+		t.Fatalf("got package=%+v; want it loaded", pkg)
+	}
+	if s.completion(p); s.docs[uri].pkg != pkg {
+		t.Errorf("package reloaded for the same document version")
+	}
+
+	params, _ := json.Marshal(didChangeTextDocumentParams{
+		TextDocument:   versionedTextDocumentIdentifier{URI: uri, Version: 2},
+		ContentChanges: []textDocumentContentChangeEvent{{Text: text}},
+	})
+	if _, err := s.handle(&request{Method: "textDocument/didChange", Params: params}); err != nil {
+		t.Fatal(err)
+	}
+	if s.completion(p); s.docs[uri].pkg == pkg {
+		t.Errorf("package not reloaded for a new document version")
+	}
+}
+
+func TestServerHover(t *testing.T) {
+	tests := []struct {
+		line string
+		// the contents must contain all of these, nil if no hover is expected
+		want []string
+		// the range of the hovered element
+		start, end int
+	}{{
+		line:  "\tName string `is:\"requ|ired,len:1:32\"`",
+		want:  []string{"**required** accepts no options", "Checks that the value is not empty"},
+		start: 18, end: 26,
+	}, {
+		line:  "\tName string `is:\"required,|len:1:32\"`",
+		want:  []string{"**len** accepts 1 to 2 options", "Checks the length of the value."},
+		start: 27, end: 30,
+	}, {
+		line:  "\tName string `is:\"required,len:1|:32\"`",
+		want:  []string{`option #1 of rule "len"`},
+		start: 31, end: 32,
+	}, {
+		line:  "\tName string `is:\"em|ail\"`",
+		want:  []string{"```go\nfunc isvalid.Email(string) bool\n```", "Email reports whether or not v is a valid email address."},
+		start: 18, end: 23,
+	}, {
+		line: "\tName string `is:\"foo|\"`",
+		want: nil,
+	}, {
+		line: "\tNa|me string `is:\"required\"`",
+		want: nil,
+	}}
+
+	s := NewServer(analysis.Config{})
+	for _, tt := range tests {
+		uri, text, pos := testdoc(t, tt.line)
+		open(t, s, uri, text)
+
+		h := s.hover(textDocumentPositionParams{TextDocument: textDocumentIdentifier{URI: uri}, Position: pos})
+		if tt.want == nil {
+			if h != nil {
+				t.Errorf("%q: got %+v; want nil", tt.line, h)
+			}
+			continue
+		}
+		if h == nil {
+			t.Errorf("%q: got nil; want hover", tt.line)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(h.Contents.Value, want) {
+				t.Errorf("%q: got %q; want it to contain %q", tt.line, h.Contents.Value, want)
+			}
+		}
+		want := lspRange{Start: position{pos.Line, tt.start}, End: position{pos.Line, tt.end}}
+		if h.Range == nil || *h.Range != want {
+			t.Errorf("%q: got range %+v; want %+v", tt.line, h.Range, want)
+		}
+	}
+}
+
+func TestServerDiagnostics(t *testing.T) {
+	text := "package p\n" +
+		"\n" +
+		"type FooValidator struct {\n" +
+		"\tA []string `is:\"required,len:1:\"`\n" +
+		"\tB []string `json:\"b\" is:\"atleast:1(required\"`\n" +
+		"\tC []string `is:\"required,foo,any(bar)\"`\n" +
+		"\tD string   `is:\"-\"`\n" +
+		"\tE string   \"is:\\\"foo\\\"\"\n" +
+		"\tF struct {\n" +
+		"\t\tG string `is:\"baz\"`\n" +
+		"\t}\n" +
+		"}\n"
+
+	rng := func(line, start, end int) lspRange {
+		return lspRange{Start: position{line, start}, End: position{line, end}}
+	}
+	want := []diagnostic{{
+		Range:    rng(4, 26, 44),
+		Severity: severityError,
+		Source:   "isvalid",
+		Message:  `bad "is" tag: missing closing parenthesis`,
+	}, {
+		Range:    rng(5, 17, 38),
+		Severity: severityError,
+		Source:   "isvalid",
+		Message:  `unknown rule: "foo"`,
+	}, {
+		Range:    rng(9, 16, 19),
+		Severity: severityError,
+		Source:   "isvalid",
+		Message:  `unknown rule: "baz"`,
+	}}
+
+	s := NewServer(analysis.Config{})
+	// "bar" is declared by the document's package
+	got := s.diagnostics(text, map[string]bool{"bar": true})
+	if e := compare.Compare(got, want); e != nil {
+		t.Error(e)
+	}
+
+	// the rules declared in the package are gathered from the files on disk
+	uri, text, _ := testdoc(t, "\tName string `is:\"userid,userid2\"`")
+	open(t, s, uri, text)
+	s.publishDiagnostics(uri)
+	if len(s.outbox) != 1 {
+		t.Fatalf("got %d notifications; want 1", len(s.outbox))
+	}
+	params := s.outbox[0].Params.(publishDiagnosticsParams)
+	if len(params.Diagnostics) != 1 || params.Diagnostics[0].Message != `unknown rule: "userid2"` {
+		t.Errorf("got %+v; want only userid2 to be unknown", params.Diagnostics)
+	}
+
+	// an unparsable document produces no diagnostics, rather than an error
+	if got := s.diagnostics("package p\n\ntype", nil); len(got) != 0 {
+		t.Errorf("got %+v; want none", got)
+	}
+}
+
+func TestServerServe(t *testing.T) {
+	uri := "file:///tmp/foo.go"
+	text := "package p\n\ntype FooValidator struct {\n\tF string `is:\"foo\"`\n}\n"
+
+	msgs := []string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":%q,"version":3,"text":%q}}}`, uri, text),
+		fmt.Sprintf(`{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{"textDocument":{"uri":%q},"position":{"line":3,"character":12}}}`, uri),
+		fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":%q}}}`, uri),
+		`{"jsonrpc":"2.0","id":3,"method":"foo/bar"}`,
+		`{"jsonrpc":"2.0","id":4,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+	}
+	in := new(bytes.Buffer)
+	for _, msg := range msgs {
+		fmt.Fprintf(in, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+	}
+
+	out := new(bytes.Buffer)
+	if err := NewServer(analysis.Config{}).Serve(in, out); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	r := textproto.NewReader(bufio.NewReader(out))
+	for {
+		body, err := readMessage(r)
+		if err != nil {
+			break
+		}
+		got = append(got, string(body))
+	}
+
+	want := []string{
+		`{"jsonrpc":"2.0","id":1,"result":{"capabilities":{"textDocumentSync":1,"completionProvider":{"triggerCharacters":["\"",",",":","[","]","\u0026","@"]},"hoverProvider":true},"serverInfo":{"name":"isvalid"}}}`,
+		`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///tmp/foo.go","version":3,"diagnostics":[{"range":{"start":{"line":3,"character":15},"end":{"line":3,"character":18}},"severity":1,"source":"isvalid","message":"unknown rule: \"foo\""}]}}`,
+		`{"jsonrpc":"2.0","id":2,"result":null}`,
+		`{"jsonrpc":"2.0","method":"textDocument/publishDiagnostics","params":{"uri":"file:///tmp/foo.go","version":0,"diagnostics":[]}}`,
+		`{"jsonrpc":"2.0","id":3,"result":null,"error":{"code":-32601,"message":"method not supported: foo/bar"}}`,
+		`{"jsonrpc":"2.0","id":4,"result":null}`,
+	}
+	if e := compare.Compare(got, want); e != nil {
+		t.Error(e)
+	}
+}
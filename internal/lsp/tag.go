@@ -0,0 +1,184 @@
+package lsp
+
+import (
+	"strings"
+)
+
+// tagValueAt looks for the value of the "is" struct tag in the given line of
+// source text that spans the byte offset col. If found, the raw value (i.e.
+// with any escape sequences intact) is returned together with the offset of
+// the value's first byte in the line.
+//
+// Struct tags are expected to be raw string literals that do not span
+// multiple lines, which, in practice, they pretty much always are.
+func tagValueAt(line string, col int) (val string, start int, ok bool) {
+	for a := 0; a < len(line); a++ {
+		if line[a] != '`' {
+			continue
+		}
+
+		// find the closing backquote, if missing then
+		// the tag is still being written, use the rest
+		b := strings.IndexByte(line[a+1:], '`')
+		if b < 0 {
+			b = len(line)
+		} else {
+			b += a + 1
+		}
+
+		if a < col && col <= b {
+			return tagValueIn(line[a+1:b], col-(a+1), a+1)
+		}
+		a = b
+	}
+	return "", 0, false
+}
+
+// tagValueIn scans the struct tag for the value of the "is" key and reports
+// whether that value spans the byte offset col. The base argument is added
+// to the returned start offset.
+func tagValueIn(tag string, col int, base int) (val string, start int, ok bool) {
+	val, start, ok = isTagValue(tag)
+	if !ok || col < start || col > start+len(val) {
+		return "", 0, false
+	}
+	return val, base + start, true
+}
+
+// isTagValue scans the struct tag for the value of the "is" key and returns
+// the raw value together with the offset of the value's first byte in the tag.
+// If the value's closing quote is missing the rest of the tag is returned.
+func isTagValue(tag string) (val string, start int, ok bool) {
+	i := 0
+	for i < len(tag) {
+		// skip leading space
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+
+		// scan to colon, a space, a quote, or a control character
+		// is considered to be an invalid key, same as reflect does
+		j := i
+		for j < len(tag) && tag[j] > ' ' && tag[j] != ':' && tag[j] != '"' && tag[j] != 0x7f {
+			j++
+		}
+		if j == i || j+1 >= len(tag) || tag[j] != ':' || tag[j+1] != '"' {
+			break
+		}
+		key := tag[i:j]
+
+		// scan the quoted value
+		k := j + 2
+		for k < len(tag) && tag[k] != '"' {
+			if tag[k] == '\\' {
+				k++
+			}
+			k++
+		}
+		if k > len(tag) {
+			k = len(tag)
+		}
+
+		if key == "is" {
+			return tag[j+2 : k], j + 2, true
+		}
+		i = k + 1
+	}
+	return "", 0, false
+}
+
+// tagContextKind indicates the kind of the tag element at the cursor.
+type tagContextKind uint
+
+const (
+	tagContextNone       tagContextKind = iota
+	tagContextRuleName                  // rule name, e.g. "req|"
+	tagContextRuleOption                // rule option, e.g. "len:1|"
+	tagContextFieldRef                  // field reference, e.g. "rng:&Mi|"
+	tagContextProperty                  // context property, e.g. "len:1:@upd|"
+)
+
+// tagContext describes the element of the "is" tag's value at the cursor.
+type tagContext struct {
+	kind tagContextKind
+	// The name of the rule whose option is at the cursor.
+	rule string
+	// The index of the option at the cursor, context properties are not counted.
+	index int
+	// The text between the start of the element and the cursor, in
+	// case of field references and context properties this excludes
+	// the leading "&" and "@" respectively.
+	prefix string
+	// The offset of the start of the prefix in the tag's value.
+	start int
+}
+
+// parseTagContext parses the raw value of the "is" tag up to the byte offset
+// off and returns the context at that offset. The value is the "raw" one, as
+// found in source, meaning that quoted rule options are delimited by \".
+func parseTagContext(val string, off int) (c tagContext) {
+	if off > len(val) {
+		off = len(val)
+	}
+
+	c.kind = tagContextRuleName
+	start := 0
+	for i := 0; i < off; i++ {
+		switch val[i] {
+		case '[', ']', ',':
+			// a new rule begins after these
+			c = tagContext{kind: tagContextRuleName}
+			start = i + 1
+		case ':':
+			if c.kind == tagContextRuleName {
+				c.rule = strings.TrimSpace(val[start:i])
+				c.index = 0
+			} else if val[start:i] == "" || val[start] != '@' {
+				c.index += 1
+			}
+			c.kind = tagContextRuleOption
+			start = i + 1
+		case '\\':
+			if i+1 < len(val) && val[i+1] == '"' && c.kind == tagContextRuleOption {
+				// skip the quoted option
+				j := strings.Index(val[i+2:], `\"`)
+				if j < 0 || i+2+j >= off {
+					// the cursor is inside the quotes, nothing to offer
+					return tagContext{}
+				}
+				i += 2 + j + 1
+			} else {
+				i++
+			}
+		}
+	}
+
+	if c.kind == tagContextRuleName {
+		for start < off && val[start] == ' ' {
+			start++
+		}
+	}
+	if c.kind == tagContextRuleOption && start < off {
+		switch val[start] {
+		case '&':
+			c.kind = tagContextFieldRef
+			start += 1
+		case '@':
+			c.kind = tagContextProperty
+			start += 1
+		}
+	}
+
+	c.prefix = val[start:off]
+	c.start = start
+	return c
+}
+
+// tagElemEnd returns the offset of the end of the tag element
+// that spans the given offset.
+func tagElemEnd(val string, off int) int {
+	for off < len(val) && !strings.ContainsRune(`:,[]\`, rune(val[off])) {
+		off++
+	}
+	return off
+}
@@ -0,0 +1,103 @@
+package lsp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/frk/compare"
+)
+
+func TestTagValueAt(t *testing.T) {
+	tests := []struct {
+		line  string
+		ok    bool
+		val   string
+		start int
+	}{{
+		line: "\tF string `json:\"f\" is:\"len:1:|\"`",
+		ok:   true, val: "len:1:", start: 24,
+	}, {
+		line: "\tF string `json:\"f|\" is:\"len:1:\"`",
+		ok:   false,
+	}, {
+		line: "\tF string `is:\"re:\\\"^a|\\\"\" json:\"f\"`",
+		ok:   true, val: `re:\"^a\"`, start: 15,
+	}, {
+		// tag still being written
+		line: "\tF string `is:\"req|",
+		ok:   true, val: "req", start: 15,
+	}, {
+		line: "\tF string // is:\"req|\"",
+		ok:   false,
+	}}
+
+	for _, tt := range tests {
+		col := strings.IndexByte(tt.line, '|')
+		line := tt.line[:col] + tt.line[col+1:]
+
+		val, start, ok := tagValueAt(line, col)
+		if ok != tt.ok || val != tt.val || start != tt.start {
+			t.Errorf("%q: got (%q, %d, %t), want (%q, %d, %t)",
+				tt.line, val, start, ok, tt.val, tt.start, tt.ok)
+		}
+	}
+}
+
+func TestParseTagContext(t *testing.T) {
+	tests := []struct {
+		val  string
+		want tagContext
+	}{{
+		val:  `|`,
+		want: tagContext{kind: tagContextRuleName},
+	}, {
+		val:  `req|`,
+		want: tagContext{kind: tagContextRuleName, prefix: "req"},
+	}, {
+		val:  `required,em|`,
+		want: tagContext{kind: tagContextRuleName, prefix: "em", start: 9},
+	}, {
+		val:  `required, em|`,
+		want: tagContext{kind: tagContextRuleName, prefix: "em", start: 10},
+	}, {
+		val:  `[len:1:]|`,
+		want: tagContext{kind: tagContextRuleName, start: 8},
+	}, {
+		val:  `[ru|`,
+		want: tagContext{kind: tagContextRuleName, prefix: "ru", start: 1},
+	}, {
+		val:  `len:|`,
+		want: tagContext{kind: tagContextRuleOption, rule: "len", start: 4},
+	}, {
+		val:  `len:1:1|`,
+		want: tagContext{kind: tagContextRuleOption, rule: "len", index: 1, prefix: "1", start: 6},
+	}, {
+		val:  `[len:1:]rng:&Min:&Ma|`,
+		want: tagContext{kind: tagContextFieldRef, rule: "rng", index: 1, prefix: "Ma", start: 18},
+	}, {
+		val:  `len:1:@up|`,
+		want: tagContext{kind: tagContextProperty, rule: "len", index: 1, prefix: "up", start: 7},
+	}, {
+		val:  `len:@update:1:|`,
+		want: tagContext{kind: tagContextRuleOption, rule: "len", index: 1, start: 14},
+	}, {
+		val:  `re:\"a:b|`,
+		want: tagContext{},
+	}, {
+		val:  `re:\"a:b\",uu|`,
+		want: tagContext{kind: tagContextRuleName, prefix: "uu", start: 11},
+	}, {
+		val:  `re:\"a:b\":|`,
+		want: tagContext{kind: tagContextRuleOption, rule: "re", index: 1, start: 11},
+	}}
+
+	for _, tt := range tests {
+		off := strings.IndexByte(tt.val, '|')
+		val := tt.val[:off] + tt.val[off+1:]
+
+		got := parseTagContext(val, off)
+		if e := compare.Compare(got, tt.want); e != nil {
+			t.Errorf("%q: %v", tt.val, e)
+		}
+	}
+}
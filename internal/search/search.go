@@ -24,13 +24,17 @@ const loadMode = packages.NeedName |
 	packages.NeedTypesInfo
 
 var (
-	// Matches names of types that are valid targets for the generator.
+	// Matches names of types that are valid targets for the generator,
+	// used by Search if it's not given a regular expression of its own.
 	rxTargetName = regexp.MustCompile(`(?i:validator)$`)
 )
 
 // AST is used to hold the packages that were loaded during a call to Search.
 type AST struct {
 	pkgs map[string]*packages.Package
+	// the packages that matched the pattern given to Search,
+	// i.e. the above map minus the packages' imports
+	roots []*packages.Package
 }
 
 // add adds the given packages to the AST instance. If the given packages
@@ -71,6 +75,9 @@ type Match struct {
 	Fset *token.FileSet
 	// The source position of the matched type.
 	Pos token.Pos
+	// The struct-level rules declared with "isvalid:rule" directives in
+	// the matched type's documentation, e.g. "StartDate lt:&EndDate".
+	Rules []string
 }
 
 // File represents a Go file that contains one or more matching validator struct types.
@@ -89,13 +96,22 @@ type Package struct {
 	Files []*File
 }
 
-// Search scans one or more Go packages looking for named struct types that have
-// their name suffixed with "Validator", e.g. "type InputValidator struct { ...".
+// Search scans one or more Go packages looking for named struct types that are
+// targets for the generator. A type is a target if its name matches the given
+// targetName regular expression or if its documentation contains the
+// "isvalid:target" directive, and it is not a target, regardless of its
+// name, if its documentation contains the "isvalid:ignore" directive. If
+// targetName is nil, the types whose names are suffixed with "Validator",
+// e.g. "type InputValidator struct { ...", will be matched.
+//
 // The result will be a list of Packages, where each Package will contain a list
 // of Files that belong to that Package, and each of these Files will contain a
-// list of Matches each representing a validator struct type declared in that File.
+// list of Matches each representing a target struct type declared in that File.
+//
+// Generic struct types are matched as declared, i.e. uninstantiated, and the
+// Match's Named type will therefore hold the type's type parameters.
 //
-// Scanned files and packages that do not contain any matching validator struct
+// Scanned files and packages that do not contain any matching target struct
 // type declarations will be omitted from the result.
 //
 // Search will scan the Go package that is located in the specified directory and,
@@ -104,7 +120,7 @@ type Package struct {
 //
 // If the *AST argument is not nil it will be populated with the list of
 // packages that were loaded from the specified directory.
-func Search(dir string, recursive bool, filter func(filePath string) bool, a *AST) (out []*Package, err error) {
+func Search(dir string, recursive bool, filter func(filePath string) bool, targetName *regexp.Regexp, a *AST) (out []*Package, err error) {
 	// resolve absolute dir path
 	if dir, err = filepath.Abs(dir); err != nil {
 		return nil, err
@@ -114,6 +130,9 @@ func Search(dir string, recursive bool, filter func(filePath string) bool, a *AS
 	if filter == nil {
 		filter = func(string) bool { return true }
 	}
+	if targetName == nil {
+		targetName = rxTargetName
+	}
 
 	// initialize the pattern to use with packages.Load
 	pattern := "."
@@ -155,7 +174,11 @@ func Search(dir string, recursive bool, filter func(filePath string) bool, a *AS
 
 				for _, spec := range gd.Specs {
 					typeSpec, ok := spec.(*ast.TypeSpec)
-					if !ok || !rxTargetName.MatchString(typeSpec.Name.Name) || hasIgnoreDirective(typeSpec.Doc) {
+					if !ok || hasIgnoreDirective(typeSpec.Doc) {
+						continue
+					}
+					if !targetName.MatchString(typeSpec.Name.Name) && !hasTargetDirective(gd.Doc) &&
+						!hasTargetDirective(typeSpec.Doc) {
 						continue
 					}
 
@@ -171,11 +194,15 @@ func Search(dir string, recursive bool, filter func(filePath string) bool, a *AS
 					if !ok {
 						continue
 					}
+					if _, ok := named.Underlying().(*types.Struct); !ok {
+						continue
+					}
 
 					match := new(Match)
 					match.Named = named
 					match.Fset = pkg.Fset
 					match.Pos = typeName.Pos()
+					match.Rules = gettyperules(gd.Doc, typeSpec.Doc)
 					f.Matches = append(f.Matches, match)
 				}
 			}
@@ -193,6 +220,9 @@ func Search(dir string, recursive bool, filter func(filePath string) bool, a *AS
 	}
 
 	a.add(pkgs...)
+	if a != nil {
+		a.roots = append(a.roots, pkgs...)
+	}
 	return out, nil
 }
 
@@ -209,6 +239,19 @@ func hasIgnoreDirective(doc *ast.CommentGroup) bool {
 	return false
 }
 
+// hasTargetDirective reports whether or not the given documentation contains
+// the "isvalid:target" directive indicating that the type should be matched.
+func hasTargetDirective(doc *ast.CommentGroup) bool {
+	if doc != nil {
+		for _, com := range doc.List {
+			if strings.Contains(com.Text, "isvalid:target") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // FindConstantsByType scans the given AST looking for all declared constants
 // of the type identified by pkgpath and name. On success the result will be
 // a slice of go/types.Const instances that represent those constants.
@@ -295,8 +338,7 @@ func findpkg(pkgpath, fname string, a AST) (*packages.Package, error) {
 		// in the AST instance supplied to the Search function, therefore
 		// look there next and only if it's not there attempt to load it.
 		if pkg, ok = a.pkgs[pkgpath]; !ok {
-			cfg := &packages.Config{Mode: packages.NeedFiles | packages.NeedSyntax |
-				packages.NeedTypes | packages.NeedTypesInfo}
+			cfg := &packages.Config{Mode: loadMode}
 			pkgs, err := packages.Load(cfg, pkgpath)
 			if err != nil || len(pkgs) == 0 {
 				pe := pkgLoadError{pkgpath, fname, err}
@@ -326,10 +368,50 @@ func findpkg(pkgpath, fname string, a AST) (*packages.Package, error) {
 // The pkgpath parameter should be the import path of a single package,
 // if it's a pattern or something else then the result is undefined.
 func FindFunc(pkgpath, name string, a AST) (*types.Func, error) {
-	pkg, err := findpkg(pkgpath, name, a)
+	f, _, err := findfunc(pkgpath, name, a)
 	if err != nil {
 		return nil, err
 	}
+	return f, nil
+}
+
+// FindRuleFunc is like FindFunc but in addition to the go/types.Func it also
+// returns the json bytes as parsed from the function's "isvalid:rule" directive,
+// if the function's documentation has no such directive the json will be nil.
+func FindRuleFunc(pkgpath, name string, a AST) (*types.Func, []byte, error) {
+	f, fd, err := findfunc(pkgpath, name, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, getrulejson(fd.Doc), nil
+}
+
+// FindFuncDoc scans the package identified by pkgpath looking for a function
+// with the given name and, if successful, returns the text of that function's
+// documentation with the "isvalid:rule" directive and its json omitted.
+//
+// Like FindFunc, FindFuncDoc is exepcted to be invoked with the AST that
+// was given to Search, or with a zero AST if Search was not invoked.
+func FindFuncDoc(pkgpath, name string, a AST) (string, error) {
+	_, fd, err := findfunc(pkgpath, name, a)
+	if err != nil {
+		return "", err
+	}
+
+	doc := fd.Doc.Text()
+	if i := strings.Index(doc, "isvalid:rule"); i > -1 {
+		doc = doc[:i]
+	}
+	return strings.TrimSpace(doc), nil
+}
+
+// findfunc looks up the exported, package-level function identified by
+// pkgpath and name and returns both its go/types and its go/ast representation.
+func findfunc(pkgpath, name string, a AST) (*types.Func, *ast.FuncDecl, error) {
+	pkg, err := findpkg(pkgpath, name, a)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	for _, syn := range pkg.Syntax {
 		for _, dec := range syn.Decls {
@@ -345,13 +427,13 @@ func FindFunc(pkgpath, name string, a AST) (*types.Func, error) {
 				}
 
 				if f, ok := obj.(*types.Func); ok {
-					return f, nil
+					return f, fd, nil
 				}
 			}
 		}
 	}
 
-	return nil, findFuncError{pkgpath, name}
+	return nil, nil, findFuncError{pkgpath, name}
 }
 
 // LoadBuiltinFuncs
@@ -375,10 +457,57 @@ func LoadBuiltinFuncs(a AST, callback func([]byte, *types.Func) error) error {
 		return err
 	}
 
+	// all the builtin funcs are in the isvalid.go file
+	filter := func(filename string) bool {
+		return strings.HasSuffix(filename, "isvalid.go")
+	}
+	return findrulefuncs(pkg, filter, callback)
+}
+
+// FindRuleFuncs scans the packages that matched the pattern given to Search,
+// which excludes their imports, and the packages identified by pkgpaths looking
+// for exported functions whose documentation contains the "isvalid:rule" directive.
+// For each such function the callback is invoked with the function's go/types.Func
+// representation and with the json bytes as parsed from the directive.
+//
+// The "github.com/frk/isvalid" package is always skipped since its
+// functions with the directive are the builtin rules.
+func FindRuleFuncs(pkgpaths []string, a AST, callback func([]byte, *types.Func) error) error {
+	seen := map[string]bool{"github.com/frk/isvalid": true}
+	for _, pkg := range a.roots {
+		if seen[pkg.PkgPath] {
+			continue
+		}
+		seen[pkg.PkgPath] = true
+
+		if err := findrulefuncs(pkg, nil, callback); err != nil {
+			return err
+		}
+	}
+
+	for _, pkgpath := range pkgpaths {
+		if seen[pkgpath] {
+			continue
+		}
+		seen[pkgpath] = true
+
+		pkg, err := findpkg(pkgpath, "", a)
+		if err != nil {
+			return err
+		}
+		if err := findrulefuncs(pkg, nil, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findrulefuncs invokes the callback for each exported function of the package
+// whose documentation contains the "isvalid:rule" directive. If the filter is
+// not nil then only those files of the package that pass the filter are scanned.
+func findrulefuncs(pkg *packages.Package, filter func(filename string) bool, callback func([]byte, *types.Func) error) error {
 	for i, syn := range pkg.Syntax {
-		// all the builtin funcs are in the isvalid.go file,
-		// if this is not it; next
-		if !strings.HasSuffix(pkg.GoFiles[i], "isvalid.go") {
+		if filter != nil && !filter(pkg.GoFiles[i]) {
 			continue
 		}
 
@@ -408,6 +537,12 @@ func LoadBuiltinFuncs(a AST, callback func([]byte, *types.Func) error) error {
 	return nil
 }
 
+// RuleJSON returns the json bytes as parsed from the "isvalid:rule" directive
+// in the given function documentation, or nil if there's no such directive.
+func RuleJSON(doc *ast.CommentGroup) []byte {
+	return getrulejson(doc)
+}
+
 // getrulejson returns the json bytes as parsed from the "isvalid:rule"
 // directive in the given documentation, if no "isvalid:rule" directive is
 // found, nil will be returned instead.
@@ -440,6 +575,25 @@ func getrulejson(doc *ast.CommentGroup) (out []byte) {
 	return out
 }
 
+// gettyperules returns the text that follows each of the "isvalid:rule"
+// directives in the given type documentation. Unlike the directive of rule
+// functions, the directive of a type is expected to fit on a single line.
+func gettyperules(docs ...*ast.CommentGroup) (out []string) {
+	const directive = "isvalid:rule"
+
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, com := range doc.List {
+			if i := strings.Index(com.Text, directive); i > -1 {
+				out = append(out, strings.TrimSpace(com.Text[i+len(directive):]))
+			}
+		}
+	}
+	return out
+}
+
 type pkgLoadError struct {
 	pkgpath string
 	fname   string
@@ -4,6 +4,7 @@ import (
 	"go/types"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/frk/compare"
@@ -12,13 +13,42 @@ import (
 var testast AST
 
 func TestMain(m *testing.M) {
-	if _, err := Search("../testdata/search", false, nil, &testast); err != nil {
+	if _, err := Search("../testdata/search", false, nil, nil, &testast); err != nil {
 		log.Fatal(err)
 	}
 
 	os.Exit(m.Run())
 }
 
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		rx   *regexp.Regexp
+		want []string
+	}{
+		{nil, []string{"FooValidator", "CreateFooRequest", "DeleteFooRequest", "PageValidator"}},
+		{regexp.MustCompile(`Request$`), []string{"CreateFooRequest", "UpdateFooRequest", "DeleteFooRequest"}},
+	}
+
+	for _, tt := range tests {
+		pkgs, err := Search("../testdata/search", false, nil, tt.rx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, pkg := range pkgs {
+			for _, file := range pkg.Files {
+				for _, match := range file.Matches {
+					got = append(got, match.Named.Obj().Name())
+				}
+			}
+		}
+		if e := compare.Compare(got, tt.want); e != nil {
+			t.Errorf("%v: %v", tt.rx, e)
+		}
+	}
+}
+
 func TestLoadBuiltinFuncs(t *testing.T) {
 	// TODO could use a test
 	// LoadBuiltinFuncs(testast, func(data []byte, fn *types.Func) error {
@@ -53,6 +83,65 @@ func TestFindFunc(t *testing.T) {
 	}
 }
 
+func TestFindRuleFunc(t *testing.T) {
+	tests := []struct {
+		pkgpath string
+		name    string
+		json    string
+		doc     string
+	}{
+		{"github.com/frk/isvalid/internal/testdata/search", "IsFoo", ``, ``},
+		{"github.com/frk/isvalid/internal/testdata/search", "IsBar",
+			`{"name": "bar","err": { "text": "must be bar" }}`, `IsBar is a rule func with config.`},
+	}
+
+	for _, tt := range tests {
+		f, json, err := FindRuleFunc(tt.pkgpath, tt.name, testast)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if f.Name() != tt.name {
+			t.Errorf("%s: got func %s", tt.name, f.Name())
+		}
+		if string(json) != tt.json {
+			t.Errorf("%s: got json %q, want %q", tt.name, json, tt.json)
+		}
+
+		doc, err := FindFuncDoc(tt.pkgpath, tt.name, testast)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		} else if doc != tt.doc {
+			t.Errorf("%s: got doc %q, want %q", tt.name, doc, tt.doc)
+		}
+	}
+}
+
+func TestFindRuleFuncs(t *testing.T) {
+	tests := []struct {
+		pkgpaths []string
+		want     []string
+	}{
+		{nil, []string{"IsBar"}},
+		{[]string{"github.com/frk/isvalid/internal/testdata/search"}, []string{"IsBar"}},
+		{[]string{"github.com/frk/isvalid"}, []string{"IsBar"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		err := FindRuleFuncs(tt.pkgpaths, testast, func(_ []byte, f *types.Func) error {
+			got = append(got, f.Name())
+			return nil
+		})
+		if err != nil {
+			t.Error(err)
+		}
+		if e := compare.Compare(got, tt.want); e != nil {
+			t.Errorf("%v: %v", tt.pkgpaths, e)
+		}
+	}
+}
+
 func TestFindConstantsByType(t *testing.T) {
 	type konst struct {
 		name string
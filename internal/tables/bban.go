@@ -0,0 +1,225 @@
+package tables
+
+import (
+	"github.com/frk/isvalid/internal/algo"
+)
+
+// Map of country codes with corresponding functions that validate the
+// national check digits of the BBAN, i.e. the IBAN without the country
+// code and the IBAN check digits. The BBAN passed to the functions is
+// expected to have already been matched against the country's IBANRegexp.
+//
+// References:
+// - https://www.swift.com/standards/data-standards/iban-international-bank-account-number
+// - https://www.ecbs.org/iban.htm
+var BBANChecksum = map[string]func(bban string) bool{
+	"AL": bbanAL,
+	"AO": bbanMod97_10,
+	"BA": bbanMod97_10,
+	"BE": bbanBE,
+	"BL": bbanRIB,
+	"CF": bbanRIB,
+	"CG": bbanRIB,
+	"CM": bbanRIB,
+	"CV": bbanMod97_10,
+	"CZ": bbanCZ,
+	"DJ": bbanRIB,
+	"EE": bbanEE,
+	"ES": bbanES,
+	"FI": algo.Luhn,
+	"FR": bbanRIB,
+	"GA": bbanRIB,
+	"GF": bbanRIB,
+	"GP": bbanRIB,
+	"GQ": bbanRIB,
+	"HR": bbanHR,
+	"HU": bbanHU,
+	"IS": bbanIS,
+	"IT": bbanIT,
+	"KM": bbanRIB,
+	"MC": bbanRIB,
+	"ME": bbanMod97_10,
+	"MF": bbanRIB,
+	"MG": bbanRIB,
+	"MK": bbanMod97_10,
+	"MQ": bbanRIB,
+	"MR": bbanRIB,
+	"MZ": bbanMod97_10,
+	"NC": bbanRIB,
+	"NO": bbanNO,
+	"PF": bbanRIB,
+	"PL": bbanPL,
+	"PM": bbanRIB,
+	"PT": bbanMod97_10,
+	"RE": bbanRIB,
+	"RS": bbanMod97_10,
+	"SI": bbanMod97_10,
+	"SK": bbanCZ,
+	"SM": bbanIT,
+	"TD": bbanRIB,
+	"TF": bbanRIB,
+	"TL": bbanMod97_10,
+	"WF": bbanRIB,
+	"XK": bbanMod97_10,
+	"YT": bbanRIB,
+}
+
+// The BBAN ends with two ISO 7064 MOD 97-10 check digits.
+func bbanMod97_10(bban string) bool {
+	return algo.Mod97_10(bban)
+}
+
+// weightedSum returns the sum of the products of the digits
+// of v and the corresponding weights.
+func weightedSum(v string, weights []int) (sum int) {
+	for i := 0; i < len(v) && i < len(weights); i++ {
+		sum += int(v[i]-'0') * weights[i]
+	}
+	return sum
+}
+
+// mod97 returns the remainder of dividing the number represented by v by 97.
+func mod97(v string) (r int) {
+	for i := 0; i < len(v); i++ {
+		r = (r*10 + int(v[i]-'0')) % 97
+	}
+	return r
+}
+
+// Albania: the last digit of the 8-digit bank & branch code.
+func bbanAL(bban string) bool {
+	sum := weightedSum(bban[:7], []int{9, 7, 3, 1, 9, 7, 3})
+	return (10-sum%10)%10 == int(bban[7]-'0')
+}
+
+// Belgium: the first 10 digits mod 97, with 0 represented as 97.
+func bbanBE(bban string) bool {
+	r := mod97(bban[:10])
+	if r == 0 {
+		r = 97
+	}
+	return r == int(bban[10]-'0')*10+int(bban[11]-'0')
+}
+
+// Czech Republic & Slovakia: the account prefix and the account number
+// are each validated by a weighted sum divisible by 11.
+func bbanCZ(bban string) bool {
+	prefix, account := bban[4:10], bban[10:]
+	return weightedSum(prefix, []int{10, 5, 8, 4, 2, 1})%11 == 0 &&
+		weightedSum(account, []int{6, 3, 7, 9, 10, 5, 8, 4, 2, 1})%11 == 0
+}
+
+// Estonia: the 7-3-1 method applied to the account number,
+// i.e. the BBAN without the 2-digit bank code.
+func bbanEE(bban string) bool {
+	account := bban[2:]
+	for len(account) > 1 && account[0] == '0' {
+		account = account[1:]
+	}
+
+	weights := []int{7, 3, 1}
+	sum := 0
+	for i, j := len(account)-2, 0; i >= 0; i, j = i-1, j+1 {
+		sum += int(account[i]-'0') * weights[j%3]
+	}
+	return (10-sum%10)%10 == int(account[len(account)-1]-'0')
+}
+
+// Spain: the two "dígitos de control", the first one for the bank
+// & branch code, and the second one for the account number.
+func bbanES(bban string) bool {
+	dc := func(v string) int {
+		r := 11 - weightedSum(v, []int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6})%11
+		switch r {
+		case 11:
+			return 0
+		case 10:
+			return 1
+		}
+		return r
+	}
+	return dc("00"+bban[:8]) == int(bban[8]-'0') && dc(bban[10:]) == int(bban[9]-'0')
+}
+
+// Croatia: the bank code and the account number each end with
+// an ISO 7064 MOD 11,10 check digit.
+func bbanHR(bban string) bool {
+	return algo.Mod11_10(bban[:7]) && algo.Mod11_10(bban[7:])
+}
+
+// Hungary: the bank & branch code and the account number
+// are each validated by a weighted sum divisible by 10.
+func bbanHU(bban string) bool {
+	weights := []int{9, 7, 3, 1, 9, 7, 3, 1, 9, 7, 3, 1, 9, 7, 3, 1}
+	return weightedSum(bban[:8], weights)%10 == 0 &&
+		weightedSum(bban[8:], weights)%10 == 0
+}
+
+// Iceland: the last 10 digits of the BBAN are the account holder's
+// kennitala (national id number) whose 9th digit is a check digit.
+func bbanIS(bban string) bool {
+	kt := bban[12:]
+	r := 11 - weightedSum(kt[:8], []int{3, 2, 7, 6, 5, 4, 3, 2})%11
+	if r == 11 {
+		r = 0
+	}
+	return r == int(kt[8]-'0')
+}
+
+// Italy & San Marino: the CIN, i.e. the leading letter
+// that is computed from the rest of the BBAN.
+func bbanIT(bban string) bool {
+	odd := []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+	sum := 0
+	for i := 1; i < len(bban); i++ {
+		v := int(bban[i] - '0')
+		if bban[i] >= 'A' && bban[i] <= 'Z' {
+			v = int(bban[i] - 'A')
+		}
+
+		// positions are counted from 1, i.e. the
+		// character after the CIN is in position 1
+		if i%2 == 1 {
+			sum += odd[v]
+		} else {
+			sum += v
+		}
+	}
+	return int(bban[0]-'A') == sum%26
+}
+
+// Norway: the last digit of the account number, MOD 11.
+func bbanNO(bban string) bool {
+	r := 11 - weightedSum(bban[:10], []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2})%11
+	if r == 11 {
+		r = 0
+	}
+	return r == int(bban[10]-'0')
+}
+
+// Poland: the last digit of the 8-digit bank & branch code.
+func bbanPL(bban string) bool {
+	sum := weightedSum(bban[:7], []int{3, 9, 7, 1, 3, 9, 7})
+	return (10-sum%10)%10 == int(bban[7]-'0')
+}
+
+// France, Monaco, and the countries using the French RIB format: the two-digit
+// "clé RIB" computed from the 5-digit bank code, the 5-digit branch code, and
+// the 11-character account number, in which letters are converted to digits.
+func bbanRIB(bban string) bool {
+	const letters = "12345678912345678923456789"
+
+	b := []byte(bban)
+	for i, c := range b {
+		if c >= 'A' && c <= 'Z' {
+			b[i] = letters[c-'A']
+		}
+	}
+
+	bank := mod97(string(b[:5]))
+	branch := mod97(string(b[5:10]))
+	account := mod97(string(b[10:21]))
+	key := mod97(string(b[21:23]))
+	return (89*bank+15*branch+3*account+key)%97 == 0
+}
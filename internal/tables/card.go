@@ -0,0 +1,70 @@
+package tables
+
+// CardNetwork describes a payment card network by the
+// IIN ranges and the lengths of the network's card numbers.
+type CardNetwork struct {
+	// The network's name, e.g. "visa".
+	Name string
+	// The IIN (Issuer Identification Number) prefix ranges of the network.
+	IIN []IINRange
+	// The valid lengths of the network's card numbers.
+	Lengths []int
+}
+
+// IINRange represents a range of card number prefixes.
+type IINRange struct {
+	// The first and the last prefix of the range, inclusive. The two
+	// prefixes must be of the same length, e.g. "2221" and "2720".
+	From, To string
+}
+
+// List of payment card networks. A card number belongs to every network with
+// a matching IIN range. The ranges that overlap are co-branded, e.g. Discover's
+// 622126-622925 is part of UnionPay's 62 and RuPay's 652150-653149 is part of
+// Discover's 65, the network with the longest matching prefix is the number's
+// primary network.
+//
+// References:
+// - https://en.wikipedia.org/wiki/Payment_card_number#Issuer_identification_number_(IIN)
+var CardNetworks = []CardNetwork{{
+	Name:    "amex",
+	IIN:     []IINRange{{"34", "34"}, {"37", "37"}},
+	Lengths: []int{15},
+}, {
+	Name:    "diners",
+	IIN:     []IINRange{{"300", "305"}, {"3095", "3095"}, {"36", "36"}, {"38", "39"}},
+	Lengths: []int{14, 15, 16, 17, 18, 19},
+}, {
+	Name:    "discover",
+	IIN:     []IINRange{{"6011", "6011"}, {"644", "649"}, {"65", "65"}, {"622126", "622925"}},
+	Lengths: []int{16, 17, 18, 19},
+}, {
+	Name:    "jcb",
+	IIN:     []IINRange{{"3528", "3589"}, {"1800", "1800"}, {"2131", "2131"}},
+	Lengths: []int{15, 16, 17, 18, 19},
+}, {
+	Name: "maestro",
+	IIN: []IINRange{{"5018", "5018"}, {"5020", "5020"}, {"5038", "5038"}, {"5893", "5893"}, {"6304", "6304"},
+		{"639", "639"}, {"6759", "6759"}, {"6761", "6763"}, {"676770", "676770"}, {"676774", "676774"}},
+	Lengths: []int{12, 13, 14, 15, 16, 17, 18, 19},
+}, {
+	Name:    "mastercard",
+	IIN:     []IINRange{{"51", "55"}, {"2221", "2720"}},
+	Lengths: []int{16},
+}, {
+	Name:    "mir",
+	IIN:     []IINRange{{"2200", "2204"}},
+	Lengths: []int{16, 17, 18, 19},
+}, {
+	Name:    "rupay",
+	IIN:     []IINRange{{"508500", "508999"}, {"606985", "607984"}, {"608001", "608500"}, {"652150", "653149"}},
+	Lengths: []int{16},
+}, {
+	Name:    "unionpay",
+	IIN:     []IINRange{{"62", "62"}},
+	Lengths: []int{16, 17, 18, 19},
+}, {
+	Name:    "visa",
+	IIN:     []IINRange{{"4", "4"}},
+	Lengths: []int{13, 16, 19},
+}}
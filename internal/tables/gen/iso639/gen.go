@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+
+	GO "github.com/frk/ast/golang"
+)
+
+func main() {
+	if len(os.Args) < 4 {
+		fmt.Println("usage: go run gen.go <iso-639-3.tab> <iso-639-3-macrolanguages.tab> <iso639-5.tsv>")
+		return
+	}
+
+	langs, err := readTSV(os.Args[1])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+	macros, err := readTSV(os.Args[2])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+	groups, err := readTSV(os.Args[3])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	if err := checkMacros(langs, macros); err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	file := buildTableFile(getLanguages(langs, macros), getGroups(groups))
+	if err := writeTableFile(file); err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+}
+
+// readTSV reads the tab separated file at the given path and returns its
+// records, excluding the header, with each record's fields mapped to the
+// header's column names.
+func readTSV(path string) (recs []map[string]string, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = '\t'
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	for _, row := range rows[1:] {
+		rec := make(map[string]string)
+		for i, v := range row {
+			if i < len(header) {
+				rec[header[i]] = v
+			}
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
+type language struct {
+	code  string
+	part2 string
+	part1 string
+	scope string
+	macro string
+	name  string
+}
+
+func getLanguages(langs, macros []map[string]string) (list []language) {
+	part1 := make(map[string]string)
+	for _, rec := range langs {
+		if len(rec["Part1"]) > 0 {
+			part1[rec["Id"]] = rec["Part1"]
+		}
+	}
+
+	// map the individual languages to their macrolanguages, the
+	// macrolanguage is represented by its ISO 639-1 code, if it has
+	// one, otherwise by its ISO 639-2 (same as ISO 639-3) code
+	macro := make(map[string]string)
+	for _, rec := range macros {
+		if rec["I_Status"] != "A" {
+			continue
+		}
+		m := rec["M_Id"]
+		if p1, ok := part1[m]; ok {
+			m = p1
+		}
+		macro[rec["I_Id"]] = m
+	}
+
+	for _, rec := range langs {
+		list = append(list, language{
+			code:  rec["Id"],
+			part2: rec["Part2T"],
+			part1: rec["Part1"],
+			scope: rec["Scope"],
+			macro: macro[rec["Id"]],
+			name:  rec["Ref_Name"],
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].code < list[j].code
+	})
+	return list
+}
+
+// checkMacros checks that the macrolanguage mapping is from the same release
+// of the code tables as the languages, i.e. that each active mapping is from a
+// macrolanguage to an individual language of the languages table.
+func checkMacros(langs, macros []map[string]string) error {
+	scope := make(map[string]string)
+	for _, rec := range langs {
+		scope[rec["Id"]] = rec["Scope"]
+	}
+	for _, rec := range macros {
+		if rec["I_Status"] != "A" {
+			continue
+		}
+		if scope[rec["M_Id"]] != "M" {
+			return fmt.Errorf("%q is not a macrolanguage in the languages table", rec["M_Id"])
+		}
+		if scope[rec["I_Id"]] != "I" {
+			return fmt.Errorf("%q is not an individual language in the languages table", rec["I_Id"])
+		}
+	}
+	return nil
+}
+
+type group struct {
+	code string
+	name string
+}
+
+func getGroups(recs []map[string]string) (list []group) {
+	for _, rec := range recs {
+		list = append(list, group{code: rec["code"], name: rec["Label (English)"]})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].code < list[j].code
+	})
+	return list
+}
+
+func buildTableFile(langs []language, groups []group) *GO.File {
+	file := new(GO.File)
+	file.PkgName = "tables"
+	file.Decls = append(file.Decls, buildLanguageSlice(langs))
+	file.Decls = append(file.Decls, buildGroupSlice(groups))
+	return file
+}
+
+func buildLanguageSlice(langs []language) (decl GO.VarDecl) {
+	elems := GO.ExprList{}
+	for _, lang := range langs {
+		fields := []GO.FieldElement{{Field: "Code", Value: GO.StringLit(lang.code)}}
+		if len(lang.part2) > 0 {
+			fields = append(fields, GO.FieldElement{Field: "Part2", Value: GO.StringLit(lang.part2)})
+		}
+		if len(lang.part1) > 0 {
+			fields = append(fields, GO.FieldElement{Field: "Part1", Value: GO.StringLit(lang.part1)})
+		}
+		fields = append(fields, GO.FieldElement{Field: "Scope", Value: GO.RuneLit(lang.scope[0])})
+		if len(lang.macro) > 0 {
+			fields = append(fields, GO.FieldElement{Field: "Macro", Value: GO.StringLit(lang.macro)})
+		}
+		fields = append(fields, GO.FieldElement{Field: "Name", Value: GO.StringLit(lang.name)})
+		elems = append(elems, GO.StructLit{Elems: fields, Compact: true})
+	}
+
+	slice := GO.SliceLit{Type: GO.SliceType{Elem: GO.Ident{Name: "Language3"}}, Elems: elems}
+	decl.Spec = GO.ValueSpec{Names: GO.Ident{Name: "languages3"}, Values: slice}
+	return decl
+}
+
+func buildGroupSlice(groups []group) (decl GO.VarDecl) {
+	elems := GO.ExprList{}
+	for _, g := range groups {
+		elems = append(elems, GO.StructLit{Elems: []GO.FieldElement{
+			{Field: "Code", Value: GO.StringLit(g.code)},
+			{Field: "Name", Value: GO.StringLit(g.name)},
+		}, Compact: true})
+	}
+
+	slice := GO.SliceLit{Type: GO.SliceType{Elem: GO.Ident{Name: "LanguageGroup"}}, Elems: elems}
+	decl.Spec = GO.ValueSpec{Names: GO.Ident{Name: "languages5"}, Values: slice}
+	return decl
+}
+
+func writeTableFile(file *GO.File) (err error) {
+	buf := &bytes.Buffer{}
+	if err := GO.Write(file, buf); err != nil {
+		return err
+	}
+
+	path := "../../iso639.go"
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	// make it look pretty
+	bs, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	buf = bytes.NewBuffer(bs)
+	if _, err := io.Copy(f, buf); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
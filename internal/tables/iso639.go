@@ -0,0 +1,8032 @@
+package tables
+
+var languages3 = []Language3{
+	{Code: "aaa", Scope: 'I', Name: "Ghotuo"},
+	{Code: "aab", Scope: 'I', Name: "Alumu-Tesu"},
+	{Code: "aac", Scope: 'I', Name: "Ari"},
+	{Code: "aad", Scope: 'I', Name: "Amal"},
+	{Code: "aae", Scope: 'I', Macro: "sq", Name: "Arbëreshë Albanian"},
+	{Code: "aaf", Scope: 'I', Name: "Aranadan"},
+	{Code: "aag", Scope: 'I', Name: "Ambrak"},
+	{Code: "aah", Scope: 'I', Name: "Abu' Arapesh"},
+	{Code: "aai", Scope: 'I', Name: "Arifama-Miniafia"},
+	{Code: "aak", Scope: 'I', Name: "Ankave"},
+	{Code: "aal", Scope: 'I', Name: "Afade"},
+	{Code: "aan", Scope: 'I', Name: "Anambé"},
+	{Code: "aao", Scope: 'I', Macro: "ar", Name: "Algerian Saharan Arabic"},
+	{Code: "aap", Scope: 'I', Name: "Pará Arára"},
+	{Code: "aaq", Scope: 'I', Name: "Eastern Abnaki"},
+	{Code: "aar", Part2: "aar", Part1: "aa", Scope: 'I', Name: "Afar"},
+	{Code: "aas", Scope: 'I', Name: "Aasáx"},
+	{Code: "aat", Scope: 'I', Macro: "sq", Name: "Arvanitika Albanian"},
+	{Code: "aau", Scope: 'I', Name: "Abau"},
+	{Code: "aaw", Scope: 'I', Name: "Solong"},
+	{Code: "aax", Scope: 'I', Name: "Mandobo Atas"},
+	{Code: "aaz", Scope: 'I', Name: "Amarasi"},
+	{Code: "aba", Scope: 'I', Name: "Abé"},
+	{Code: "abb", Scope: 'I', Name: "Bankon"},
+	{Code: "abc", Scope: 'I', Name: "Ambala Ayta"},
+	{Code: "abd", Scope: 'I', Name: "Manide"},
+	{Code: "abe", Scope: 'I', Name: "Western Abnaki"},
+	{Code: "abf", Scope: 'I', Name: "Abai Sungai"},
+	{Code: "abg", Scope: 'I', Name: "Abaga"},
+	{Code: "abh", Scope: 'I', Macro: "ar", Name: "Tajiki Arabic"},
+	{Code: "abi", Scope: 'I', Name: "Abidji"},
+	{Code: "abj", Scope: 'I', Name: "Aka-Bea"},
+	{Code: "abk", Part2: "abk", Part1: "ab", Scope: 'I', Name: "Abkhazian"},
+	{Code: "abl", Scope: 'I', Name: "Lampung Nyo"},
+	{Code: "abm", Scope: 'I', Name: "Abanyom"},
+	{Code: "abn", Scope: 'I', Name: "Abua"},
+	{Code: "abo", Scope: 'I', Name: "Abon"},
+	{Code: "abp", Scope: 'I', Name: "Abellen Ayta"},
+	{Code: "abq", Scope: 'I', Name: "Abaza"},
+	{Code: "abr", Scope: 'I', Name: "Abron"},
+	{Code: "abs", Scope: 'I', Name: "Ambonese Malay"},
+	{Code: "abt", Scope: 'I', Name: "Ambulas"},
+	{Code: "abu", Scope: 'I', Name: "Abure"},
+	{Code: "abv", Scope: 'I', Macro: "ar", Name: "Baharna Arabic"},
+	{Code: "abw", Scope: 'I', Name: "Pal"},
+	{Code: "abx", Scope: 'I', Name: "Inabaknon"},
+	{Code: "aby", Scope: 'I', Name: "Aneme Wake"},
+	{Code: "abz", Scope: 'I', Name: "Abui"},
+	{Code: "aca", Scope: 'I', Name: "Achagua"},
+	{Code: "acb", Scope: 'I', Name: "Áncá"},
+	{Code: "acd", Scope: 'I', Name: "Gikyode"},
+	{Code: "ace", Part2: "ace", Scope: 'I', Name: "Achinese"},
+	{Code: "acf", Scope: 'I', Name: "Saint Lucian Creole French"},
+	{Code: "ach", Part2: "ach", Scope: 'I', Name: "Acoli"},
+	{Code: "aci", Scope: 'I', Name: "Aka-Cari"},
+	{Code: "ack", Scope: 'I', Name: "Aka-Kora"},
+	{Code: "acl", Scope: 'I', Name: "Akar-Bale"},
+	{Code: "acm", Scope: 'I', Macro: "ar", Name: "Mesopotamian Arabic"},
+	{Code: "acn", Scope: 'I', Name: "Achang"},
+	{Code: "acp", Scope: 'I', Name: "Eastern Acipa"},
+	{Code: "acq", Scope: 'I', Macro: "ar", Name: "Ta'izzi-Adeni Arabic"},
+	{Code: "acr", Scope: 'I', Name: "Achi"},
+	{Code: "acs", Scope: 'I', Name: "Acroá"},
+	{Code: "act", Scope: 'I', Name: "Achterhoeks"},
+	{Code: "acu", Scope: 'I', Name: "Achuar-Shiwiar"},
+	{Code: "acv", Scope: 'I', Name: "Achumawi"},
+	{Code: "acw", Scope: 'I', Macro: "ar", Name: "Hijazi Arabic"},
+	{Code: "acx", Scope: 'I', Macro: "ar", Name: "Omani Arabic"},
+	{Code: "acy", Scope: 'I', Macro: "ar", Name: "Cypriot Arabic"},
+	{Code: "acz", Scope: 'I', Name: "Acheron"},
+	{Code: "ada", Part2: "ada", Scope: 'I', Name: "Adangme"},
+	{Code: "adb", Scope: 'I', Name: "Atauran"},
+	{Code: "add", Scope: 'I', Name: "Lidzonka"},
+	{Code: "ade", Scope: 'I', Name: "Adele"},
+	{Code: "adf", Scope: 'I', Macro: "ar", Name: "Dhofari Arabic"},
+	{Code: "adg", Scope: 'I', Name: "Andegerebinha"},
+	{Code: "adh", Scope: 'I', Name: "Adhola"},
+	{Code: "adi", Scope: 'I', Name: "Adi"},
+	{Code: "adj", Scope: 'I', Name: "Adioukrou"},
+	{Code: "adl", Scope: 'I', Name: "Galo"},
+	{Code: "adn", Scope: 'I', Name: "Adang"},
+	{Code: "ado", Scope: 'I', Name: "Abu"},
+	{Code: "adq", Scope: 'I', Name: "Adangbe"},
+	{Code: "adr", Scope: 'I', Name: "Adonara"},
+	{Code: "ads", Scope: 'I', Name: "Adamorobe Sign Language"},
+	{Code: "adt", Scope: 'I', Name: "Adnyamathanha"},
+	{Code: "adu", Scope: 'I', Name: "Aduge"},
+	{Code: "adw", Scope: 'I', Name: "Amundava"},
+	{Code: "adx", Scope: 'I', Name: "Amdo Tibetan"},
+	{Code: "ady", Part2: "ady", Scope: 'I', Name: "Adyghe"},
+	{Code: "adz", Scope: 'I', Name: "Adzera"},
+	{Code: "aea", Scope: 'I', Name: "Areba"},
+	{Code: "aeb", Scope: 'I', Macro: "ar", Name: "Tunisian Arabic"},
+	{Code: "aec", Scope: 'I', Macro: "ar", Name: "Saidi Arabic"},
+	{Code: "aed", Scope: 'I', Name: "Argentine Sign Language"},
+	{Code: "aee", Scope: 'I', Name: "Northeast Pashai"},
+	{Code: "aek", Scope: 'I', Name: "Haeke"},
+	{Code: "ael", Scope: 'I', Name: "Ambele"},
+	{Code: "aem", Scope: 'I', Name: "Arem"},
+	{Code: "aen", Scope: 'I', Name: "Armenian Sign Language"},
+	{Code: "aeq", Scope: 'I', Name: "Aer"},
+	{Code: "aer", Scope: 'I', Name: "Eastern Arrernte"},
+	{Code: "aes", Scope: 'I', Name: "Alsea"},
+	{Code: "aeu", Scope: 'I', Name: "Akeu"},
+	{Code: "aew", Scope: 'I', Name: "Ambakich"},
+	{Code: "aey", Scope: 'I', Name: "Amele"},
+	{Code: "aez", Scope: 'I', Name: "Aeka"},
+	{Code: "afb", Scope: 'I', Macro: "ar", Name: "Gulf Arabic"},
+	{Code: "afd", Scope: 'I', Name: "Andai"},
+	{Code: "afe", Scope: 'I', Name: "Putukwam"},
+	{Code: "afg", Scope: 'I', Name: "Afghan Sign Language"},
+	{Code: "afh", Part2: "afh", Scope: 'I', Name: "Afrihili"},
+	{Code: "afi", Scope: 'I', Name: "Akrukay"},
+	{Code: "afk", Scope: 'I', Name: "Nanubae"},
+	{Code: "afn", Scope: 'I', Name: "Defaka"},
+	{Code: "afo", Scope: 'I', Name: "Eloyi"},
+	{Code: "afp", Scope: 'I', Name: "Tapei"},
+	{Code: "afr", Part2: "afr", Part1: "af", Scope: 'I', Name: "Afrikaans"},
+	{Code: "afs", Scope: 'I', Name: "Afro-Seminole Creole"},
+	{Code: "aft", Scope: 'I', Name: "Afitti"},
+	{Code: "afu", Scope: 'I', Name: "Awutu"},
+	{Code: "afz", Scope: 'I', Name: "Obokuitai"},
+	{Code: "aga", Scope: 'I', Name: "Aguano"},
+	{Code: "agb", Scope: 'I', Name: "Legbo"},
+	{Code: "agc", Scope: 'I', Name: "Agatu"},
+	{Code: "agd", Scope: 'I', Name: "Agarabi"},
+	{Code: "age", Scope: 'I', Name: "Angal"},
+	{Code: "agf", Scope: 'I', Name: "Arguni"},
+	{Code: "agg", Scope: 'I', Name: "Angor"},
+	{Code: "agh", Scope: 'I', Name: "Ngelima"},
+	{Code: "agi", Scope: 'I', Name: "Agariya"},
+	{Code: "agj", Scope: 'I', Name: "Argobba"},
+	{Code: "agk", Scope: 'I', Name: "Isarog Agta"},
+	{Code: "agl", Scope: 'I', Name: "Fembe"},
+	{Code: "agm", Scope: 'I', Name: "Angaataha"},
+	{Code: "agn", Scope: 'I', Name: "Agutaynen"},
+	{Code: "ago", Scope: 'I', Name: "Tainae"},
+	{Code: "agq", Scope: 'I', Name: "Aghem"},
+	{Code: "agr", Scope: 'I', Name: "Aguaruna"},
+	{Code: "ags", Scope: 'I', Name: "Esimbi"},
+	{Code: "agt", Scope: 'I', Name: "Central Cagayan Agta"},
+	{Code: "agu", Scope: 'I', Name: "Aguacateco"},
+	{Code: "agv", Scope: 'I', Name: "Remontado Dumagat"},
+	{Code: "agw", Scope: 'I', Name: "Kahua"},
+	{Code: "agx", Scope: 'I', Name: "Aghul"},
+	{Code: "agy", Scope: 'I', Name: "Southern Alta"},
+	{Code: "agz", Scope: 'I', Name: "Mt. Iriga Agta"},
+	{Code: "aha", Scope: 'I', Name: "Ahanta"},
+	{Code: "ahb", Scope: 'I', Name: "Axamb"},
+	{Code: "ahg", Scope: 'I', Name: "Qimant"},
+	{Code: "ahh", Scope: 'I', Name: "Aghu"},
+	{Code: "ahi", Scope: 'I', Name: "Tiagbamrin Aizi"},
+	{Code: "ahk", Scope: 'I', Name: "Akha"},
+	{Code: "ahl", Scope: 'I', Name: "Igo"},
+	{Code: "ahm", Scope: 'I', Name: "Mobumrin Aizi"},
+	{Code: "ahn", Scope: 'I', Name: "Àhàn"},
+	{Code: "aho", Scope: 'I', Name: "Ahom"},
+	{Code: "ahp", Scope: 'I', Name: "Aproumu Aizi"},
+	{Code: "ahr", Scope: 'I', Name: "Ahirani"},
+	{Code: "ahs", Scope: 'I', Name: "Ashe"},
+	{Code: "aht", Scope: 'I', Name: "Ahtena"},
+	{Code: "aia", Scope: 'I', Name: "Arosi"},
+	{Code: "aib", Scope: 'I', Name: "Ainu (China)"},
+	{Code: "aic", Scope: 'I', Name: "Ainbai"},
+	{Code: "aid", Scope: 'I', Name: "Alngith"},
+	{Code: "aie", Scope: 'I', Name: "Amara"},
+	{Code: "aif", Scope: 'I', Name: "Agi"},
+	{Code: "aig", Scope: 'I', Name: "Antigua and Barbuda Creole English"},
+	{Code: "aih", Scope: 'I', Name: "Ai-Cham"},
+	{Code: "aii", Scope: 'I', Name: "Assyrian Neo-Aramaic"},
+	{Code: "aij", Scope: 'I', Name: "Lishanid Noshan"},
+	{Code: "aik", Scope: 'I', Name: "Ake"},
+	{Code: "ail", Scope: 'I', Name: "Aimele"},
+	{Code: "aim", Scope: 'I', Name: "Aimol"},
+	{Code: "ain", Part2: "ain", Scope: 'I', Name: "Ainu (Japan)"},
+	{Code: "aio", Scope: 'I', Name: "Aiton"},
+	{Code: "aip", Scope: 'I', Name: "Burumakok"},
+	{Code: "aiq", Scope: 'I', Name: "Aimaq"},
+	{Code: "air", Scope: 'I', Name: "Airoran"},
+	{Code: "ait", Scope: 'I', Name: "Arikem"},
+	{Code: "aiw", Scope: 'I', Name: "Aari"},
+	{Code: "aix", Scope: 'I', Name: "Aighon"},
+	{Code: "aiy", Scope: 'I', Name: "Ali"},
+	{Code: "aja", Scope: 'I', Name: "Aja (South Sudan)"},
+	{Code: "ajg", Scope: 'I', Name: "Aja (Benin)"},
+	{Code: "aji", Scope: 'I', Name: "Ajië"},
+	{Code: "ajn", Scope: 'I', Name: "Andajin"},
+	{Code: "ajp", Scope: 'I', Macro: "ar", Name: "South Levantine Arabic"},
+	{Code: "ajs", Scope: 'I', Name: "Algerian Jewish Sign Language"},
+	{Code: "aju", Scope: 'I', Macro: "jrb", Name: "Judeo-Moroccan Arabic"},
+	{Code: "ajw", Scope: 'I', Name: "Ajawa"},
+	{Code: "ajz", Scope: 'I', Name: "Amri Karbi"},
+	{Code: "aka", Part2: "aka", Part1: "ak", Scope: 'M', Name: "Akan"},
+	{Code: "akb", Scope: 'I', Name: "Batak Angkola"},
+	{Code: "akc", Scope: 'I', Name: "Mpur"},
+	{Code: "akd", Scope: 'I', Name: "Ukpet-Ehom"},
+	{Code: "ake", Scope: 'I', Name: "Akawaio"},
+	{Code: "akf", Scope: 'I', Name: "Akpa"},
+	{Code: "akg", Scope: 'I', Name: "Anakalangu"},
+	{Code: "akh", Scope: 'I', Name: "Angal Heneng"},
+	{Code: "aki", Scope: 'I', Name: "Aiome"},
+	{Code: "akj", Scope: 'I', Name: "Aka-Jeru"},
+	{Code: "akk", Part2: "akk", Scope: 'I', Name: "Akkadian"},
+	{Code: "akl", Scope: 'I', Name: "Aklanon"},
+	{Code: "akm", Scope: 'I', Name: "Aka-Bo"},
+	{Code: "ako", Scope: 'I', Name: "Akurio"},
+	{Code: "akp", Scope: 'I', Name: "Siwu"},
+	{Code: "akq", Scope: 'I', Name: "Ak"},
+	{Code: "akr", Scope: 'I', Name: "Araki"},
+	{Code: "aks", Scope: 'I', Name: "Akaselem"},
+	{Code: "akt", Scope: 'I', Name: "Akolet"},
+	{Code: "aku", Scope: 'I', Name: "Akum"},
+	{Code: "akv", Scope: 'I', Name: "Akhvakh"},
+	{Code: "akw", Scope: 'I', Name: "Akwa"},
+	{Code: "akx", Scope: 'I', Name: "Aka-Kede"},
+	{Code: "aky", Scope: 'I', Name: "Aka-Kol"},
+	{Code: "akz", Scope: 'I', Name: "Alabama"},
+	{Code: "ala", Scope: 'I', Name: "Alago"},
+	{Code: "alc", Scope: 'I', Name: "Qawasqar"},
+	{Code: "ald", Scope: 'I', Name: "Alladian"},
+	{Code: "ale", Part2: "ale", Scope: 'I', Name: "Aleut"},
+	{Code: "alf", Scope: 'I', Name: "Alege"},
+	{Code: "alh", Scope: 'I', Name: "Alawa"},
+	{Code: "ali", Scope: 'I', Name: "Amaimon"},
+	{Code: "alj", Scope: 'I', Name: "Alangan"},
+	{Code: "alk", Scope: 'I', Name: "Alak"},
+	{Code: "all", Scope: 'I', Name: "Allar"},
+	{Code: "alm", Scope: 'I', Name: "Amblong"},
+	{Code: "aln", Scope: 'I', Macro: "sq", Name: "Gheg Albanian"},
+	{Code: "alo", Scope: 'I', Name: "Larike-Wakasihu"},
+	{Code: "alp", Scope: 'I', Name: "Alune"},
+	{Code: "alq", Scope: 'I', Name: "Algonquin"},
+	{Code: "alr", Scope: 'I', Name: "Alutor"},
+	{Code: "als", Scope: 'I', Macro: "sq", Name: "Tosk Albanian"},
+	{Code: "alt", Part2: "alt", Scope: 'I', Name: "Southern Altai"},
+	{Code: "alu", Scope: 'I', Name: "'Are'are"},
+	{Code: "alw", Scope: 'I', Name: "Alaba-K’abeena"},
+	{Code: "alx", Scope: 'I', Name: "Amol"},
+	{Code: "aly", Scope: 'I', Name: "Alyawarr"},
+	{Code: "alz", Scope: 'I', Name: "Alur"},
+	{Code: "ama", Scope: 'I', Name: "Amanayé"},
+	{Code: "amb", Scope: 'I', Name: "Ambo"},
+	{Code: "amc", Scope: 'I', Name: "Amahuaca"},
+	{Code: "ame", Scope: 'I', Name: "Yanesha'"},
+	{Code: "amf", Scope: 'I', Name: "Hamer-Banna"},
+	{Code: "amg", Scope: 'I', Name: "Amurdak"},
+	{Code: "amh", Part2: "amh", Part1: "am", Scope: 'I', Name: "Amharic"},
+	{Code: "ami", Scope: 'I', Name: "Amis"},
+	{Code: "amj", Scope: 'I', Name: "Amdang"},
+	{Code: "amk", Scope: 'I', Name: "Ambai"},
+	{Code: "aml", Scope: 'I', Name: "War-Jaintia"},
+	{Code: "amm", Scope: 'I', Name: "Ama (Papua New Guinea)"},
+	{Code: "amn", Scope: 'I', Name: "Amanab"},
+	{Code: "amo", Scope: 'I', Name: "Amo"},
+	{Code: "amp", Scope: 'I', Name: "Alamblak"},
+	{Code: "amq", Scope: 'I', Name: "Amahai"},
+	{Code: "amr", Scope: 'I', Name: "Amarakaeri"},
+	{Code: "ams", Scope: 'I', Name: "Southern Amami-Oshima"},
+	{Code: "amt", Scope: 'I', Name: "Amto"},
+	{Code: "amu", Scope: 'I', Name: "Guerrero Amuzgo"},
+	{Code: "amv", Scope: 'I', Name: "Ambelau"},
+	{Code: "amw", Scope: 'I', Name: "Western Neo-Aramaic"},
+	{Code: "amx", Scope: 'I', Name: "Anmatyerre"},
+	{Code: "amy", Scope: 'I', Name: "Ami"},
+	{Code: "amz", Scope: 'I', Name: "Atampaya"},
+	{Code: "ana", Scope: 'I', Name: "Andaqui"},
+	{Code: "anb", Scope: 'I', Name: "Andoa"},
+	{Code: "anc", Scope: 'I', Name: "Ngas"},
+	{Code: "and", Scope: 'I', Name: "Ansus"},
+	{Code: "ane", Scope: 'I', Name: "Xârâcùù"},
+	{Code: "anf", Scope: 'I', Name: "Animere"},
+	{Code: "ang", Part2: "ang", Scope: 'I', Name: "Old English (ca. 450-1100)"},
+	{Code: "anh", Scope: 'I', Name: "Nend"},
+	{Code: "ani", Scope: 'I', Name: "Andi"},
+	{Code: "anj", Scope: 'I', Name: "Anor"},
+	{Code: "ank", Scope: 'I', Name: "Goemai"},
+	{Code: "anl", Scope: 'I', Name: "Anu-Hkongso Chin"},
+	{Code: "anm", Scope: 'I', Name: "Anal"},
+	{Code: "ann", Scope: 'I', Name: "Obolo"},
+	{Code: "ano", Scope: 'I', Name: "Andoque"},
+	{Code: "anp", Part2: "anp", Scope: 'I', Name: "Angika"},
+	{Code: "anq", Scope: 'I', Name: "Jarawa (India)"},
+	{Code: "anr", Scope: 'I', Name: "Andh"},
+	{Code: "ans", Scope: 'I', Name: "Anserma"},
+	{Code: "ant", Scope: 'I', Name: "Antakarinya"},
+	{Code: "anu", Scope: 'I', Name: "Anuak"},
+	{Code: "anv", Scope: 'I', Name: "Denya"},
+	{Code: "anw", Scope: 'I', Name: "Anaang"},
+	{Code: "anx", Scope: 'I', Name: "Andra-Hus"},
+	{Code: "any", Scope: 'I', Name: "Anyin"},
+	{Code: "anz", Scope: 'I', Name: "Anem"},
+	{Code: "aoa", Scope: 'I', Name: "Angolar"},
+	{Code: "aob", Scope: 'I', Name: "Abom"},
+	{Code: "aoc", Scope: 'I', Name: "Pemon"},
+	{Code: "aod", Scope: 'I', Name: "Andarum"},
+	{Code: "aoe", Scope: 'I', Name: "Angal Enen"},
+	{Code: "aof", Scope: 'I', Name: "Bragat"},
+	{Code: "aog", Scope: 'I', Name: "Angoram"},
+	{Code: "aoi", Scope: 'I', Name: "Anindilyakwa"},
+	{Code: "aoj", Scope: 'I', Name: "Mufian"},
+	{Code: "aok", Scope: 'I', Name: "Arhö"},
+	{Code: "aol", Scope: 'I', Name: "Alor"},
+	{Code: "aom", Scope: 'I', Name: "Ömie"},
+	{Code: "aon", Scope: 'I', Name: "Bumbita Arapesh"},
+	{Code: "aor", Scope: 'I', Name: "Aore"},
+	{Code: "aos", Scope: 'I', Name: "Taikat"},
+	{Code: "aot", Scope: 'I', Name: "Atong (India)"},
+	{Code: "aou", Scope: 'I', Name: "A'ou"},
+	{Code: "aox", Scope: 'I', Name: "Atorada"},
+	{Code: "aoz", Scope: 'I', Name: "Uab Meto"},
+	{Code: "apb", Scope: 'I', Name: "Sa'a"},
+	{Code: "apc", Scope: 'I', Macro: "ar", Name: "North Levantine Arabic"},
+	{Code: "apd", Scope: 'I', Macro: "ar", Name: "Sudanese Arabic"},
+	{Code: "ape", Scope: 'I', Name: "Bukiyip"},
+	{Code: "apf", Scope: 'I', Name: "Pahanan Agta"},
+	{Code: "apg", Scope: 'I', Name: "Ampanang"},
+	{Code: "aph", Scope: 'I', Name: "Athpariya"},
+	{Code: "api", Scope: 'I', Name: "Apiaká"},
+	{Code: "apj", Scope: 'I', Name: "Jicarilla Apache"},
+	{Code: "apk", Scope: 'I', Name: "Kiowa Apache"},
+	{Code: "apl", Scope: 'I', Name: "Lipan Apache"},
+	{Code: "apm", Scope: 'I', Name: "Mescalero-Chiricahua Apache"},
+	{Code: "apn", Scope: 'I', Name: "Apinayé"},
+	{Code: "apo", Scope: 'I', Name: "Ambul"},
+	{Code: "app", Scope: 'I', Name: "Apma"},
+	{Code: "apq", Scope: 'I', Name: "A-Pucikwar"},
+	{Code: "apr", Scope: 'I', Name: "Arop-Lokep"},
+	{Code: "aps", Scope: 'I', Name: "Arop-Sissano"},
+	{Code: "apt", Scope: 'I', Name: "Apatani"},
+	{Code: "apu", Scope: 'I', Name: "Apurinã"},
+	{Code: "apv", Scope: 'I', Name: "Alapmunte"},
+	{Code: "apw", Scope: 'I', Name: "Western Apache"},
+	{Code: "apx", Scope: 'I', Name: "Aputai"},
+	{Code: "apy", Scope: 'I', Name: "Apalaí"},
+	{Code: "apz", Scope: 'I', Name: "Safeyoka"},
+	{Code: "aqc", Scope: 'I', Name: "Archi"},
+	{Code: "aqd", Scope: 'I', Name: "Ampari Dogon"},
+	{Code: "aqg", Scope: 'I', Name: "Arigidi"},
+	{Code: "aqk", Scope: 'I', Name: "Aninka"},
+	{Code: "aqm", Scope: 'I', Name: "Atohwaim"},
+	{Code: "aqn", Scope: 'I', Name: "Northern Alta"},
+	{Code: "aqp", Scope: 'I', Name: "Atakapa"},
+	{Code: "aqr", Scope: 'I', Name: "Arhâ"},
+	{Code: "aqt", Scope: 'I', Name: "Angaité"},
+	{Code: "aqz", Scope: 'I', Name: "Akuntsu"},
+	{Code: "ara", Part2: "ara", Part1: "ar", Scope: 'M', Name: "Arabic"},
+	{Code: "arb", Scope: 'I', Macro: "ar", Name: "Standard Arabic"},
+	{Code: "arc", Part2: "arc", Scope: 'I', Name: "Official Aramaic (700-300 BCE)"},
+	{Code: "ard", Scope: 'I', Name: "Arabana"},
+	{Code: "are", Scope: 'I', Name: "Western Arrarnta"},
+	{Code: "arg", Part2: "arg", Part1: "an", Scope: 'I', Name: "Aragonese"},
+	{Code: "arh", Scope: 'I', Name: "Arhuaco"},
+	{Code: "ari", Scope: 'I', Name: "Arikara"},
+	{Code: "arj", Scope: 'I', Name: "Arapaso"},
+	{Code: "ark", Scope: 'I', Name: "Arikapú"},
+	{Code: "arl", Scope: 'I', Name: "Arabela"},
+	{Code: "arn", Part2: "arn", Scope: 'I', Name: "Mapudungun"},
+	{Code: "aro", Scope: 'I', Name: "Araona"},
+	{Code: "arp", Part2: "arp", Scope: 'I', Name: "Arapaho"},
+	{Code: "arq", Scope: 'I', Macro: "ar", Name: "Algerian Arabic"},
+	{Code: "arr", Scope: 'I', Name: "Karo (Brazil)"},
+	{Code: "ars", Scope: 'I', Macro: "ar", Name: "Najdi Arabic"},
+	{Code: "aru", Scope: 'I', Name: "Aruá (Amazonas State)"},
+	{Code: "arv", Scope: 'I', Name: "Arbore"},
+	{Code: "arw", Part2: "arw", Scope: 'I', Name: "Arawak"},
+	{Code: "arx", Scope: 'I', Name: "Aruá (Rodonia State)"},
+	{Code: "ary", Scope: 'I', Macro: "ar", Name: "Moroccan Arabic"},
+	{Code: "arz", Scope: 'I', Macro: "ar", Name: "Egyptian Arabic"},
+	{Code: "asa", Scope: 'I', Name: "Asu (Tanzania)"},
+	{Code: "asb", Scope: 'I', Name: "Assiniboine"},
+	{Code: "asc", Scope: 'I', Name: "Casuarina Coast Asmat"},
+	{Code: "ase", Scope: 'I', Name: "American Sign Language"},
+	{Code: "asf", Scope: 'I', Name: "Auslan"},
+	{Code: "asg", Scope: 'I', Name: "Cishingini"},
+	{Code: "ash", Scope: 'I', Name: "Abishira"},
+	{Code: "asi", Scope: 'I', Name: "Buruwai"},
+	{Code: "asj", Scope: 'I', Name: "Sari"},
+	{Code: "ask", Scope: 'I', Name: "Ashkun"},
+	{Code: "asl", Scope: 'I', Name: "Asilulu"},
+	{Code: "asm", Part2: "asm", Part1: "as", Scope: 'I', Name: "Assamese"},
+	{Code: "asn", Scope: 'I', Name: "Xingú Asuriní"},
+	{Code: "aso", Scope: 'I', Name: "Dano"},
+	{Code: "asp", Scope: 'I', Name: "Algerian Sign Language"},
+	{Code: "asq", Scope: 'I', Name: "Austrian Sign Language"},
+	{Code: "asr", Scope: 'I', Name: "Asuri"},
+	{Code: "ass", Scope: 'I', Name: "Ipulo"},
+	{Code: "ast", Part2: "ast", Scope: 'I', Name: "Asturian"},
+	{Code: "asu", Scope: 'I', Name: "Tocantins Asurini"},
+	{Code: "asv", Scope: 'I', Name: "Asoa"},
+	{Code: "asw", Scope: 'I', Name: "Australian Aborigines Sign Language"},
+	{Code: "asx", Scope: 'I', Name: "Muratayak"},
+	{Code: "asy", Scope: 'I', Name: "Yaosakor Asmat"},
+	{Code: "asz", Scope: 'I', Name: "As"},
+	{Code: "ata", Scope: 'I', Name: "Pele-Ata"},
+	{Code: "atb", Scope: 'I', Name: "Zaiwa"},
+	{Code: "atc", Scope: 'I', Name: "Atsahuaca"},
+	{Code: "atd", Scope: 'I', Name: "Ata Manobo"},
+	{Code: "ate", Scope: 'I', Name: "Atemble"},
+	{Code: "atg", Scope: 'I', Name: "Ivbie North-Okpela-Arhe"},
+	{Code: "ati", Scope: 'I', Name: "Attié"},
+	{Code: "atj", Scope: 'I', Name: "Atikamekw"},
+	{Code: "atk", Scope: 'I', Name: "Ati"},
+	{Code: "atl", Scope: 'I', Name: "Mt. Iraya Agta"},
+	{Code: "atm", Scope: 'I', Name: "Ata"},
+	{Code: "atn", Scope: 'I', Name: "Ashtiani"},
+	{Code: "ato", Scope: 'I', Name: "Atong (Cameroon)"},
+	{Code: "atp", Scope: 'I', Name: "Pudtol Atta"},
+	{Code: "atq", Scope: 'I', Name: "Aralle-Tabulahan"},
+	{Code: "atr", Scope: 'I', Name: "Waimiri-Atroari"},
+	{Code: "ats", Scope: 'I', Name: "Gros Ventre"},
+	{Code: "att", Scope: 'I', Name: "Pamplona Atta"},
+	{Code: "atu", Scope: 'I', Name: "Reel"},
+	{Code: "atv", Scope: 'I', Name: "Northern Altai"},
+	{Code: "atw", Scope: 'I', Name: "Atsugewi"},
+	{Code: "atx", Scope: 'I', Name: "Arutani"},
+	{Code: "aty", Scope: 'I', Name: "Aneityum"},
+	{Code: "atz", Scope: 'I', Name: "Arta"},
+	{Code: "aua", Scope: 'I', Name: "Asumboa"},
+	{Code: "aub", Scope: 'I', Name: "Alugu"},
+	{Code: "auc", Scope: 'I', Name: "Waorani"},
+	{Code: "aud", Scope: 'I', Name: "Anuta"},
+	{Code: "aug", Scope: 'I', Name: "Aguna"},
+	{Code: "auh", Scope: 'I', Name: "Aushi"},
+	{Code: "aui", Scope: 'I', Name: "Anuki"},
+	{Code: "auj", Scope: 'I', Name: "Awjilah"},
+	{Code: "auk", Scope: 'I', Name: "Heyo"},
+	{Code: "aul", Scope: 'I', Name: "Aulua"},
+	{Code: "aum", Scope: 'I', Name: "Asu (Nigeria)"},
+	{Code: "aun", Scope: 'I', Name: "Molmo One"},
+	{Code: "auo", Scope: 'I', Name: "Auyokawa"},
+	{Code: "aup", Scope: 'I', Name: "Makayam"},
+	{Code: "auq", Scope: 'I', Name: "Anus"},
+	{Code: "aur", Scope: 'I', Name: "Aruek"},
+	{Code: "aut", Scope: 'I', Name: "Austral"},
+	{Code: "auu", Scope: 'I', Name: "Auye"},
+	{Code: "auw", Scope: 'I', Name: "Awyi"},
+	{Code: "aux", Scope: 'I', Name: "Aurá"},
+	{Code: "auy", Scope: 'I', Name: "Awiyaana"},
+	{Code: "auz", Scope: 'I', Macro: "ar", Name: "Uzbeki Arabic"},
+	{Code: "ava", Part2: "ava", Part1: "av", Scope: 'I', Name: "Avaric"},
+	{Code: "avb", Scope: 'I', Name: "Avau"},
+	{Code: "avd", Scope: 'I', Name: "Alviri-Vidari"},
+	{Code: "ave", Part2: "ave", Part1: "ae", Scope: 'I', Name: "Avestan"},
+	{Code: "avi", Scope: 'I', Name: "Avikam"},
+	{Code: "avk", Scope: 'I', Name: "Kotava"},
+	{Code: "avl", Scope: 'I', Macro: "ar", Name: "Eastern Egyptian Bedawi Arabic"},
+	{Code: "avm", Scope: 'I', Name: "Angkamuthi"},
+	{Code: "avn", Scope: 'I', Name: "Avatime"},
+	{Code: "avo", Scope: 'I', Name: "Agavotaguerra"},
+	{Code: "avs", Scope: 'I', Name: "Aushiri"},
+	{Code: "avt", Scope: 'I', Name: "Au"},
+	{Code: "avu", Scope: 'I', Name: "Avokaya"},
+	{Code: "avv", Scope: 'I', Name: "Avá-Canoeiro"},
+	{Code: "awa", Part2: "awa", Scope: 'I', Name: "Awadhi"},
+	{Code: "awb", Scope: 'I', Name: "Awa (Papua New Guinea)"},
+	{Code: "awc", Scope: 'I', Name: "Cicipu"},
+	{Code: "awe", Scope: 'I', Name: "Awetí"},
+	{Code: "awg", Scope: 'I', Name: "Anguthimri"},
+	{Code: "awh", Scope: 'I', Name: "Awbono"},
+	{Code: "awi", Scope: 'I', Name: "Aekyom"},
+	{Code: "awk", Scope: 'I', Name: "Awabakal"},
+	{Code: "awm", Scope: 'I', Name: "Arawum"},
+	{Code: "awn", Scope: 'I', Name: "Awngi"},
+	{Code: "awo", Scope: 'I', Name: "Awak"},
+	{Code: "awr", Scope: 'I', Name: "Awera"},
+	{Code: "aws", Scope: 'I', Name: "South Awyu"},
+	{Code: "awt", Scope: 'I', Name: "Araweté"},
+	{Code: "awu", Scope: 'I', Name: "Central Awyu"},
+	{Code: "awv", Scope: 'I', Name: "Jair Awyu"},
+	{Code: "aww", Scope: 'I', Name: "Awun"},
+	{Code: "awx", Scope: 'I', Name: "Awara"},
+	{Code: "awy", Scope: 'I', Name: "Edera Awyu"},
+	{Code: "axb", Scope: 'I', Name: "Abipon"},
+	{Code: "axe", Scope: 'I', Name: "Ayerrerenge"},
+	{Code: "axg", Scope: 'I', Name: "Mato Grosso Arára"},
+	{Code: "axk", Scope: 'I', Name: "Yaka (Central African Republic)"},
+	{Code: "axl", Scope: 'I', Name: "Lower Southern Aranda"},
+	{Code: "axm", Scope: 'I', Name: "Middle Armenian"},
+	{Code: "axx", Scope: 'I', Name: "Xârâgurè"},
+	{Code: "aya", Scope: 'I', Name: "Awar"},
+	{Code: "ayb", Scope: 'I', Name: "Ayizo Gbe"},
+	{Code: "ayc", Scope: 'I', Name: "Southern Aymara"},
+	{Code: "ayd", Scope: 'I', Name: "Ayabadhu"},
+	{Code: "aye", Scope: 'I', Name: "Ayere"},
+	{Code: "ayg", Scope: 'I', Name: "Ginyanga"},
+	{Code: "ayh", Scope: 'I', Macro: "ar", Name: "Hadrami Arabic"},
+	{Code: "ayi", Scope: 'I', Name: "Leyigha"},
+	{Code: "ayk", Scope: 'I', Name: "Akuku"},
+	{Code: "ayl", Scope: 'I', Macro: "ar", Name: "Libyan Arabic"},
+	{Code: "aym", Part2: "aym", Part1: "ay", Scope: 'M', Name: "Aymara"},
+	{Code: "ayn", Scope: 'I', Macro: "ar", Name: "Sanaani Arabic"},
+	{Code: "ayo", Scope: 'I', Name: "Ayoreo"},
+	{Code: "ayp", Scope: 'I', Macro: "ar", Name: "North Mesopotamian Arabic"},
+	{Code: "ayq", Scope: 'I', Name: "Ayi (Papua New Guinea)"},
+	{Code: "ayr", Scope: 'I', Macro: "ay", Name: "Central Aymara"},
+	{Code: "ays", Scope: 'I', Name: "Sorsogon Ayta"},
+	{Code: "ayt", Scope: 'I', Name: "Magbukun Ayta"},
+	{Code: "ayu", Scope: 'I', Name: "Ayu"},
+	{Code: "ayz", Scope: 'I', Name: "Mai Brat"},
+	{Code: "aza", Scope: 'I', Name: "Azha"},
+	{Code: "azb", Scope: 'I', Macro: "az", Name: "South Azerbaijani"},
+	{Code: "azd", Scope: 'I', Name: "Eastern Durango Nahuatl"},
+	{Code: "aze", Part2: "aze", Part1: "az", Scope: 'M', Name: "Azerbaijani"},
+	{Code: "azg", Scope: 'I', Name: "San Pedro Amuzgos Amuzgo"},
+	{Code: "azj", Scope: 'I', Macro: "az", Name: "North Azerbaijani"},
+	{Code: "azm", Scope: 'I', Name: "Ipalapa Amuzgo"},
+	{Code: "azn", Scope: 'I', Name: "Western Durango Nahuatl"},
+	{Code: "azo", Scope: 'I', Name: "Awing"},
+	{Code: "azt", Scope: 'I', Name: "Faire Atta"},
+	{Code: "azz", Scope: 'I', Name: "Highland Puebla Nahuatl"},
+	{Code: "baa", Scope: 'I', Name: "Babatana"},
+	{Code: "bab", Scope: 'I', Name: "Bainouk-Gunyuño"},
+	{Code: "bac", Scope: 'I', Name: "Badui"},
+	{Code: "bae", Scope: 'I', Name: "Baré"},
+	{Code: "baf", Scope: 'I', Name: "Nubaca"},
+	{Code: "bag", Scope: 'I', Name: "Tuki"},
+	{Code: "bah", Scope: 'I', Name: "Bahamas Creole English"},
+	{Code: "baj", Scope: 'I', Name: "Barakai"},
+	{Code: "bak", Part2: "bak", Part1: "ba", Scope: 'I', Name: "Bashkir"},
+	{Code: "bal", Part2: "bal", Scope: 'M', Name: "Baluchi"},
+	{Code: "bam", Part2: "bam", Part1: "bm", Scope: 'I', Name: "Bambara"},
+	{Code: "ban", Part2: "ban", Scope: 'I', Name: "Balinese"},
+	{Code: "bao", Scope: 'I', Name: "Waimaha"},
+	{Code: "bap", Scope: 'I', Name: "Bantawa"},
+	{Code: "bar", Scope: 'I', Name: "Bavarian"},
+	{Code: "bas", Part2: "bas", Scope: 'I', Name: "Basa (Cameroon)"},
+	{Code: "bau", Scope: 'I', Name: "Bada (Nigeria)"},
+	{Code: "bav", Scope: 'I', Name: "Vengo"},
+	{Code: "baw", Scope: 'I', Name: "Bambili-Bambui"},
+	{Code: "bax", Scope: 'I', Name: "Bamun"},
+	{Code: "bay", Scope: 'I', Name: "Batuley"},
+	{Code: "bba", Scope: 'I', Name: "Baatonum"},
+	{Code: "bbb", Scope: 'I', Name: "Barai"},
+	{Code: "bbc", Scope: 'I', Name: "Batak Toba"},
+	{Code: "bbd", Scope: 'I', Name: "Bau"},
+	{Code: "bbe", Scope: 'I', Name: "Bangba"},
+	{Code: "bbf", Scope: 'I', Name: "Baibai"},
+	{Code: "bbg", Scope: 'I', Name: "Barama"},
+	{Code: "bbh", Scope: 'I', Name: "Bugan"},
+	{Code: "bbi", Scope: 'I', Name: "Barombi"},
+	{Code: "bbj", Scope: 'I', Name: "Ghomálá'"},
+	{Code: "bbk", Scope: 'I', Name: "Babanki"},
+	{Code: "bbl", Scope: 'I', Name: "Bats"},
+	{Code: "bbm", Scope: 'I', Name: "Babango"},
+	{Code: "bbn", Scope: 'I', Name: "Uneapa"},
+	{Code: "bbo", Scope: 'I', Name: "Northern Bobo Madaré"},
+	{Code: "bbp", Scope: 'I', Name: "West Central Banda"},
+	{Code: "bbq", Scope: 'I', Name: "Bamali"},
+	{Code: "bbr", Scope: 'I', Name: "Girawa"},
+	{Code: "bbs", Scope: 'I', Name: "Bakpinka"},
+	{Code: "bbt", Scope: 'I', Name: "Mburku"},
+	{Code: "bbu", Scope: 'I', Name: "Kulung (Nigeria)"},
+	{Code: "bbv", Scope: 'I', Name: "Karnai"},
+	{Code: "bbw", Scope: 'I', Name: "Baba"},
+	{Code: "bbx", Scope: 'I', Name: "Bubia"},
+	{Code: "bby", Scope: 'I', Name: "Befang"},
+	{Code: "bca", Scope: 'I', Name: "Central Bai"},
+	{Code: "bcb", Scope: 'I', Name: "Bainouk-Samik"},
+	{Code: "bcc", Scope: 'I', Macro: "bal", Name: "Southern Balochi"},
+	{Code: "bcd", Scope: 'I', Name: "North Babar"},
+	{Code: "bce", Scope: 'I', Name: "Bamenyam"},
+	{Code: "bcf", Scope: 'I', Name: "Bamu"},
+	{Code: "bcg", Scope: 'I', Name: "Baga Pokur"},
+	{Code: "bch", Scope: 'I', Name: "Bariai"},
+	{Code: "bci", Scope: 'I', Name: "Baoulé"},
+	{Code: "bcj", Scope: 'I', Name: "Bardi"},
+	{Code: "bck", Scope: 'I', Name: "Bunuba"},
+	{Code: "bcl", Scope: 'I', Macro: "bik", Name: "Central Bikol"},
+	{Code: "bcm", Scope: 'I', Name: "Bannoni"},
+	{Code: "bcn", Scope: 'I', Name: "Bali (Nigeria)"},
+	{Code: "bco", Scope: 'I', Name: "Kaluli"},
+	{Code: "bcp", Scope: 'I', Name: "Bali (Democratic Republic of Congo)"},
+	{Code: "bcq", Scope: 'I', Name: "Bench"},
+	{Code: "bcr", Scope: 'I', Name: "Babine"},
+	{Code: "bcs", Scope: 'I', Name: "Kohumono"},
+	{Code: "bct", Scope: 'I', Name: "Bendi"},
+	{Code: "bcu", Scope: 'I', Name: "Awad Bing"},
+	{Code: "bcv", Scope: 'I', Name: "Shoo-Minda-Nye"},
+	{Code: "bcw", Scope: 'I', Name: "Bana"},
+	{Code: "bcy", Scope: 'I', Name: "Bacama"},
+	{Code: "bcz", Scope: 'I', Name: "Bainouk-Gunyaamolo"},
+	{Code: "bda", Scope: 'I', Name: "Bayot"},
+	{Code: "bdb", Scope: 'I', Name: "Basap"},
+	{Code: "bdc", Scope: 'I', Name: "Emberá-Baudó"},
+	{Code: "bdd", Scope: 'I', Name: "Bunama"},
+	{Code: "bde", Scope: 'I', Name: "Bade"},
+	{Code: "bdf", Scope: 'I', Name: "Biage"},
+	{Code: "bdg", Scope: 'I', Name: "Bonggi"},
+	{Code: "bdh", Scope: 'I', Name: "Baka (South Sudan)"},
+	{Code: "bdi", Scope: 'I', Name: "Burun"},
+	{Code: "bdj", Scope: 'I', Name: "Bai (South Sudan)"},
+	{Code: "bdk", Scope: 'I', Name: "Budukh"},
+	{Code: "bdl", Scope: 'I', Name: "Indonesian Bajau"},
+	{Code: "bdm", Scope: 'I', Name: "Buduma"},
+	{Code: "bdn", Scope: 'I', Name: "Baldemu"},
+	{Code: "bdo", Scope: 'I', Name: "Morom"},
+	{Code: "bdp", Scope: 'I', Name: "Bende"},
+	{Code: "bdq", Scope: 'I', Name: "Bahnar"},
+	{Code: "bdr", Scope: 'I', Name: "West Coast Bajau"},
+	{Code: "bds", Scope: 'I', Name: "Burunge"},
+	{Code: "bdt", Scope: 'I', Name: "Bokoto"},
+	{Code: "bdu", Scope: 'I', Name: "Oroko"},
+	{Code: "bdv", Scope: 'I', Name: "Bodo Parja"},
+	{Code: "bdw", Scope: 'I', Name: "Baham"},
+	{Code: "bdx", Scope: 'I', Name: "Budong-Budong"},
+	{Code: "bdy", Scope: 'I', Name: "Bandjalang"},
+	{Code: "bdz", Scope: 'I', Name: "Badeshi"},
+	{Code: "bea", Scope: 'I', Name: "Beaver"},
+	{Code: "beb", Scope: 'I', Name: "Bebele"},
+	{Code: "bec", Scope: 'I', Name: "Iceve-Maci"},
+	{Code: "bed", Scope: 'I', Name: "Bedoanas"},
+	{Code: "bee", Scope: 'I', Name: "Byangsi"},
+	{Code: "bef", Scope: 'I', Name: "Benabena"},
+	{Code: "beg", Scope: 'I', Name: "Belait"},
+	{Code: "beh", Scope: 'I', Name: "Biali"},
+	{Code: "bei", Scope: 'I', Name: "Bekati'"},
+	{Code: "bej", Part2: "bej", Scope: 'I', Name: "Beja"},
+	{Code: "bek", Scope: 'I', Name: "Bebeli"},
+	{Code: "bel", Part2: "bel", Part1: "be", Scope: 'I', Name: "Belarusian"},
+	{Code: "bem", Part2: "bem", Scope: 'I', Name: "Bemba (Zambia)"},
+	{Code: "ben", Part2: "ben", Part1: "bn", Scope: 'I', Name: "Bengali"},
+	{Code: "beo", Scope: 'I', Name: "Beami"},
+	{Code: "bep", Scope: 'I', Name: "Besoa"},
+	{Code: "beq", Scope: 'I', Name: "Beembe"},
+	{Code: "bes", Scope: 'I', Name: "Besme"},
+	{Code: "bet", Scope: 'I', Name: "Guiberoua Béte"},
+	{Code: "beu", Scope: 'I', Name: "Blagar"},
+	{Code: "bev", Scope: 'I', Name: "Daloa Bété"},
+	{Code: "bew", Scope: 'I', Name: "Betawi"},
+	{Code: "bex", Scope: 'I', Name: "Jur Modo"},
+	{Code: "bey", Scope: 'I', Name: "Beli (Papua New Guinea)"},
+	{Code: "bez", Scope: 'I', Name: "Bena (Tanzania)"},
+	{Code: "bfa", Scope: 'I', Name: "Bari"},
+	{Code: "bfb", Scope: 'I', Name: "Pauri Bareli"},
+	{Code: "bfc", Scope: 'I', Name: "Panyi Bai"},
+	{Code: "bfd", Scope: 'I', Name: "Bafut"},
+	{Code: "bfe", Scope: 'I', Name: "Betaf"},
+	{Code: "bff", Scope: 'I', Name: "Bofi"},
+	{Code: "bfg", Scope: 'I', Name: "Busang Kayan"},
+	{Code: "bfh", Scope: 'I', Name: "Blafe"},
+	{Code: "bfi", Scope: 'I', Name: "British Sign Language"},
+	{Code: "bfj", Scope: 'I', Name: "Bafanji"},
+	{Code: "bfk", Scope: 'I', Name: "Ban Khor Sign Language"},
+	{Code: "bfl", Scope: 'I', Name: "Banda-Ndélé"},
+	{Code: "bfm", Scope: 'I', Name: "Mmen"},
+	{Code: "bfn", Scope: 'I', Name: "Bunak"},
+	{Code: "bfo", Scope: 'I', Name: "Malba Birifor"},
+	{Code: "bfp", Scope: 'I', Name: "Beba"},
+	{Code: "bfq", Scope: 'I', Name: "Badaga"},
+	{Code: "bfr", Scope: 'I', Name: "Bazigar"},
+	{Code: "bfs", Scope: 'I', Name: "Southern Bai"},
+	{Code: "bft", Scope: 'I', Name: "Balti"},
+	{Code: "bfu", Scope: 'I', Name: "Gahri"},
+	{Code: "bfw", Scope: 'I', Name: "Bondo"},
+	{Code: "bfx", Scope: 'I', Name: "Bantayanon"},
+	{Code: "bfy", Scope: 'I', Name: "Bagheli"},
+	{Code: "bfz", Scope: 'I', Name: "Mahasu Pahari"},
+	{Code: "bga", Scope: 'I', Name: "Gwamhi-Wuri"},
+	{Code: "bgb", Scope: 'I', Name: "Bobongko"},
+	{Code: "bgc", Scope: 'I', Name: "Haryanvi"},
+	{Code: "bgd", Scope: 'I', Name: "Rathwi Bareli"},
+	{Code: "bge", Scope: 'I', Name: "Bauria"},
+	{Code: "bgf", Scope: 'I', Name: "Bangandu"},
+	{Code: "bgg", Scope: 'I', Name: "Bugun"},
+	{Code: "bgi", Scope: 'I', Name: "Giangan"},
+	{Code: "bgj", Scope: 'I', Name: "Bangolan"},
+	{Code: "bgk", Scope: 'I', Name: "Bit"},
+	{Code: "bgl", Scope: 'I', Name: "Bo (Laos)"},
+	{Code: "bgn", Scope: 'I', Name: "Western Balochi"},
+	{Code: "bgo", Scope: 'I', Name: "Baga Koga"},
+	{Code: "bgp", Scope: 'I', Name: "Eastern Balochi"},
+	{Code: "bgq", Scope: 'I', Name: "Bagri"},
+	{Code: "bgr", Scope: 'I', Name: "Bawm Chin"},
+	{Code: "bgs", Scope: 'I', Name: "Tagabawa"},
+	{Code: "bgt", Scope: 'I', Name: "Bughotu"},
+	{Code: "bgu", Scope: 'I', Name: "Mbongno"},
+	{Code: "bgv", Scope: 'I', Name: "Warkay-Bipim"},
+	{Code: "bgw", Scope: 'I', Name: "Bhatri"},
+	{Code: "bgx", Scope: 'I', Name: "Balkan Gagauz Turkish"},
+	{Code: "bgy", Scope: 'I', Name: "Benggoi"},
+	{Code: "bgz", Scope: 'I', Name: "Banggai"},
+	{Code: "bha", Scope: 'I', Name: "Bharia"},
+	{Code: "bhb", Scope: 'I', Name: "Bhili"},
+	{Code: "bhc", Scope: 'I', Name: "Biga"},
+	{Code: "bhd", Scope: 'I', Name: "Bhadrawahi"},
+	{Code: "bhe", Scope: 'I', Name: "Bhaya"},
+	{Code: "bhf", Scope: 'I', Name: "Odiai"},
+	{Code: "bhg", Scope: 'I', Name: "Binandere"},
+	{Code: "bhh", Scope: 'I', Name: "Bukharic"},
+	{Code: "bhi", Scope: 'I', Name: "Bhilali"},
+	{Code: "bhj", Scope: 'I', Name: "Bahing"},
+	{Code: "bhl", Scope: 'I', Name: "Bimin"},
+	{Code: "bhm", Scope: 'I', Name: "Bathari"},
+	{Code: "bhn", Scope: 'I', Name: "Bohtan Neo-Aramaic"},
+	{Code: "bho", Part2: "bho", Scope: 'I', Name: "Bhojpuri"},
+	{Code: "bhp", Scope: 'I', Name: "Bima"},
+	{Code: "bhq", Scope: 'I', Name: "Tukang Besi South"},
+	{Code: "bhr", Scope: 'I', Name: "Bara Malagasy"},
+	{Code: "bhs", Scope: 'I', Name: "Buwal"},
+	{Code: "bht", Scope: 'I', Name: "Bhattiyali"},
+	{Code: "bhu", Scope: 'I', Name: "Bhunjia"},
+	{Code: "bhv", Scope: 'I', Name: "Bahau"},
+	{Code: "bhw", Scope: 'I', Name: "Biak"},
+	{Code: "bhx", Scope: 'I', Name: "Bhalay"},
+	{Code: "bhy", Scope: 'I', Name: "Bhele"},
+	{Code: "bhz", Scope: 'I', Name: "Bada (Indonesia)"},
+	{Code: "bia", Scope: 'I', Name: "Badimaya"},
+	{Code: "bib", Scope: 'I', Name: "Bissa"},
+	{Code: "bid", Scope: 'I', Name: "Bidiyo"},
+	{Code: "bie", Scope: 'I', Name: "Bepour"},
+	{Code: "bif", Scope: 'I', Name: "Biafada"},
+	{Code: "big", Scope: 'I', Name: "Biangai"},
+	{Code: "bik", Part2: "bik", Scope: 'M', Name: "Bikol"},
+	{Code: "bil", Scope: 'I', Name: "Bile"},
+	{Code: "bim", Scope: 'I', Name: "Bimoba"},
+	{Code: "bin", Part2: "bin", Scope: 'I', Name: "Bini"},
+	{Code: "bio", Scope: 'I', Name: "Nai"},
+	{Code: "bip", Scope: 'I', Name: "Bila"},
+	{Code: "biq", Scope: 'I', Name: "Bipi"},
+	{Code: "bir", Scope: 'I', Name: "Bisorio"},
+	{Code: "bis", Part2: "bis", Part1: "bi", Scope: 'I', Name: "Bislama"},
+	{Code: "bit", Scope: 'I', Name: "Berinomo"},
+	{Code: "biu", Scope: 'I', Name: "Biete"},
+	{Code: "biv", Scope: 'I', Name: "Southern Birifor"},
+	{Code: "biw", Scope: 'I', Name: "Kol (Cameroon)"},
+	{Code: "bix", Scope: 'I', Name: "Bijori"},
+	{Code: "biy", Scope: 'I', Name: "Birhor"},
+	{Code: "biz", Scope: 'I', Name: "Baloi"},
+	{Code: "bja", Scope: 'I', Name: "Budza"},
+	{Code: "bjb", Scope: 'I', Name: "Banggarla"},
+	{Code: "bjc", Scope: 'I', Name: "Bariji"},
+	{Code: "bje", Scope: 'I', Name: "Biao-Jiao Mien"},
+	{Code: "bjf", Scope: 'I', Name: "Barzani Jewish Neo-Aramaic"},
+	{Code: "bjg", Scope: 'I', Name: "Bidyogo"},
+	{Code: "bjh", Scope: 'I', Name: "Bahinemo"},
+	{Code: "bji", Scope: 'I', Name: "Burji"},
+	{Code: "bjj", Scope: 'I', Name: "Kanauji"},
+	{Code: "bjk", Scope: 'I', Name: "Barok"},
+	{Code: "bjl", Scope: 'I', Name: "Bulu (Papua New Guinea)"},
+	{Code: "bjm", Scope: 'I', Name: "Bajelani"},
+	{Code: "bjn", Scope: 'I', Macro: "ms", Name: "Banjar"},
+	{Code: "bjo", Scope: 'I', Name: "Mid-Southern Banda"},
+	{Code: "bjp", Scope: 'I', Name: "Fanamaket"},
+	{Code: "bjr", Scope: 'I', Name: "Binumarien"},
+	{Code: "bjs", Scope: 'I', Name: "Bajan"},
+	{Code: "bjt", Scope: 'I', Name: "Balanta-Ganja"},
+	{Code: "bju", Scope: 'I', Name: "Busuu"},
+	{Code: "bjv", Scope: 'I', Name: "Bedjond"},
+	{Code: "bjw", Scope: 'I', Name: "Bakwé"},
+	{Code: "bjx", Scope: 'I', Name: "Banao Itneg"},
+	{Code: "bjy", Scope: 'I', Name: "Bayali"},
+	{Code: "bjz", Scope: 'I', Name: "Baruga"},
+	{Code: "bka", Scope: 'I', Name: "Kyak"},
+	{Code: "bkc", Scope: 'I', Name: "Baka (Cameroon)"},
+	{Code: "bkd", Scope: 'I', Name: "Binukid"},
+	{Code: "bkf", Scope: 'I', Name: "Beeke"},
+	{Code: "bkg", Scope: 'I', Name: "Buraka"},
+	{Code: "bkh", Scope: 'I', Name: "Bakoko"},
+	{Code: "bki", Scope: 'I', Name: "Baki"},
+	{Code: "bkj", Scope: 'I', Name: "Pande"},
+	{Code: "bkk", Scope: 'I', Name: "Brokskat"},
+	{Code: "bkl", Scope: 'I', Name: "Berik"},
+	{Code: "bkm", Scope: 'I', Name: "Kom (Cameroon)"},
+	{Code: "bkn", Scope: 'I', Name: "Bukitan"},
+	{Code: "bko", Scope: 'I', Name: "Kwa'"},
+	{Code: "bkp", Scope: 'I', Name: "Boko (Democratic Republic of Congo)"},
+	{Code: "bkq", Scope: 'I', Name: "Bakairí"},
+	{Code: "bkr", Scope: 'I', Name: "Bakumpai"},
+	{Code: "bks", Scope: 'I', Name: "Northern Sorsoganon"},
+	{Code: "bkt", Scope: 'I', Name: "Boloki"},
+	{Code: "bku", Scope: 'I', Name: "Buhid"},
+	{Code: "bkv", Scope: 'I', Name: "Bekwarra"},
+	{Code: "bkw", Scope: 'I', Name: "Bekwel"},
+	{Code: "bkx", Scope: 'I', Name: "Baikeno"},
+	{Code: "bky", Scope: 'I', Name: "Bokyi"},
+	{Code: "bkz", Scope: 'I', Name: "Bungku"},
+	{Code: "bla", Part2: "bla", Scope: 'I', Name: "Siksika"},
+	{Code: "blb", Scope: 'I', Name: "Bilua"},
+	{Code: "blc", Scope: 'I', Name: "Bella Coola"},
+	{Code: "bld", Scope: 'I', Name: "Bolango"},
+	{Code: "ble", Scope: 'I', Name: "Balanta-Kentohe"},
+	{Code: "blf", Scope: 'I', Name: "Buol"},
+	{Code: "blh", Scope: 'I', Name: "Kuwaa"},
+	{Code: "bli", Scope: 'I', Name: "Bolia"},
+	{Code: "blj", Scope: 'I', Name: "Bolongan"},
+	{Code: "blk", Scope: 'I', Name: "Pa'o Karen"},
+	{Code: "bll", Scope: 'I', Name: "Biloxi"},
+	{Code: "blm", Scope: 'I', Name: "Beli (South Sudan)"},
+	{Code: "bln", Scope: 'I', Name: "Southern Catanduanes Bikol"},
+	{Code: "blo", Scope: 'I', Name: "Anii"},
+	{Code: "blp", Scope: 'I', Name: "Blablanga"},
+	{Code: "blq", Scope: 'I', Name: "Baluan-Pam"},
+	{Code: "blr", Scope: 'I', Name: "Blang"},
+	{Code: "bls", Scope: 'I', Name: "Balaesang"},
+	{Code: "blt", Scope: 'I', Name: "Tai Dam"},
+	{Code: "blv", Scope: 'I', Name: "Kibala"},
+	{Code: "blw", Scope: 'I', Name: "Balangao"},
+	{Code: "blx", Scope: 'I', Name: "Mag-Indi Ayta"},
+	{Code: "bly", Scope: 'I', Name: "Notre"},
+	{Code: "blz", Scope: 'I', Name: "Balantak"},
+	{Code: "bma", Scope: 'I', Name: "Lame"},
+	{Code: "bmb", Scope: 'I', Name: "Bembe"},
+	{Code: "bmc", Scope: 'I', Name: "Biem"},
+	{Code: "bmd", Scope: 'I', Name: "Baga Manduri"},
+	{Code: "bme", Scope: 'I', Name: "Limassa"},
+	{Code: "bmf", Scope: 'I', Name: "Bom-Kim"},
+	{Code: "bmg", Scope: 'I', Name: "Bamwe"},
+	{Code: "bmh", Scope: 'I', Name: "Kein"},
+	{Code: "bmi", Scope: 'I', Name: "Bagirmi"},
+	{Code: "bmj", Scope: 'I', Name: "Bote-Majhi"},
+	{Code: "bmk", Scope: 'I', Name: "Ghayavi"},
+	{Code: "bml", Scope: 'I', Name: "Bomboli"},
+	{Code: "bmm", Scope: 'I', Name: "Northern Betsimisaraka Malagasy"},
+	{Code: "bmn", Scope: 'I', Name: "Bina (Papua New Guinea)"},
+	{Code: "bmo", Scope: 'I', Name: "Bambalang"},
+	{Code: "bmp", Scope: 'I', Name: "Bulgebi"},
+	{Code: "bmq", Scope: 'I', Name: "Bomu"},
+	{Code: "bmr", Scope: 'I', Name: "Muinane"},
+	{Code: "bms", Scope: 'I', Name: "Bilma Kanuri"},
+	{Code: "bmt", Scope: 'I', Name: "Biao Mon"},
+	{Code: "bmu", Scope: 'I', Name: "Somba-Siawari"},
+	{Code: "bmv", Scope: 'I', Name: "Bum"},
+	{Code: "bmw", Scope: 'I', Name: "Bomwali"},
+	{Code: "bmx", Scope: 'I', Name: "Baimak"},
+	{Code: "bmz", Scope: 'I', Name: "Baramu"},
+	{Code: "bna", Scope: 'I', Name: "Bonerate"},
+	{Code: "bnb", Scope: 'I', Name: "Bookan"},
+	{Code: "bnc", Scope: 'M', Name: "Bontok"},
+	{Code: "bnd", Scope: 'I', Name: "Banda (Indonesia)"},
+	{Code: "bne", Scope: 'I', Name: "Bintauna"},
+	{Code: "bnf", Scope: 'I', Name: "Masiwang"},
+	{Code: "bng", Scope: 'I', Name: "Benga"},
+	{Code: "bni", Scope: 'I', Name: "Bangi"},
+	{Code: "bnj", Scope: 'I', Name: "Eastern Tawbuid"},
+	{Code: "bnk", Scope: 'I', Name: "Bierebo"},
+	{Code: "bnl", Scope: 'I', Name: "Boon"},
+	{Code: "bnm", Scope: 'I', Name: "Batanga"},
+	{Code: "bnn", Scope: 'I', Name: "Bunun"},
+	{Code: "bno", Scope: 'I', Name: "Bantoanon"},
+	{Code: "bnp", Scope: 'I', Name: "Bola"},
+	{Code: "bnq", Scope: 'I', Name: "Bantik"},
+	{Code: "bnr", Scope: 'I', Name: "Butmas-Tur"},
+	{Code: "bns", Scope: 'I', Name: "Bundeli"},
+	{Code: "bnu", Scope: 'I', Name: "Bentong"},
+	{Code: "bnv", Scope: 'I', Name: "Bonerif"},
+	{Code: "bnw", Scope: 'I', Name: "Bisis"},
+	{Code: "bnx", Scope: 'I', Name: "Bangubangu"},
+	{Code: "bny", Scope: 'I', Name: "Bintulu"},
+	{Code: "bnz", Scope: 'I', Name: "Beezen"},
+	{Code: "boa", Scope: 'I', Name: "Bora"},
+	{Code: "bob", Scope: 'I', Name: "Aweer"},
+	{Code: "bod", Part2: "bod", Part1: "bo", Scope: 'I', Name: "Tibetan"},
+	{Code: "boe", Scope: 'I', Name: "Mundabli"},
+	{Code: "bof", Scope: 'I', Name: "Bolon"},
+	{Code: "bog", Scope: 'I', Name: "Bamako Sign Language"},
+	{Code: "boh", Scope: 'I', Name: "Boma"},
+	{Code: "boi", Scope: 'I', Name: "Barbareño"},
+	{Code: "boj", Scope: 'I', Name: "Anjam"},
+	{Code: "bok", Scope: 'I', Name: "Bonjo"},
+	{Code: "bol", Scope: 'I', Name: "Bole"},
+	{Code: "bom", Scope: 'I', Name: "Berom"},
+	{Code: "bon", Scope: 'I', Name: "Bine"},
+	{Code: "boo", Scope: 'I', Name: "Tiemacèwè Bozo"},
+	{Code: "bop", Scope: 'I', Name: "Bonkiman"},
+	{Code: "boq", Scope: 'I', Name: "Bogaya"},
+	{Code: "bor", Scope: 'I', Name: "Borôro"},
+	{Code: "bos", Part2: "bos", Part1: "bs", Scope: 'I', Macro: "sh", Name: "Bosnian"},
+	{Code: "bot", Scope: 'I', Name: "Bongo"},
+	{Code: "bou", Scope: 'I', Name: "Bondei"},
+	{Code: "bov", Scope: 'I', Name: "Tuwuli"},
+	{Code: "bow", Scope: 'I', Name: "Rema"},
+	{Code: "box", Scope: 'I', Name: "Buamu"},
+	{Code: "boy", Scope: 'I', Name: "Bodo (Central African Republic)"},
+	{Code: "boz", Scope: 'I', Name: "Tiéyaxo Bozo"},
+	{Code: "bpa", Scope: 'I', Name: "Daakaka"},
+	{Code: "bpc", Scope: 'I', Name: "Mbuk"},
+	{Code: "bpd", Scope: 'I', Name: "Banda-Banda"},
+	{Code: "bpe", Scope: 'I', Name: "Bauni"},
+	{Code: "bpg", Scope: 'I', Name: "Bonggo"},
+	{Code: "bph", Scope: 'I', Name: "Botlikh"},
+	{Code: "bpi", Scope: 'I', Name: "Bagupi"},
+	{Code: "bpj", Scope: 'I', Name: "Binji"},
+	{Code: "bpk", Scope: 'I', Name: "Orowe"},
+	{Code: "bpl", Scope: 'I', Name: "Broome Pearling Lugger Pidgin"},
+	{Code: "bpm", Scope: 'I', Name: "Biyom"},
+	{Code: "bpn", Scope: 'I', Name: "Dzao Min"},
+	{Code: "bpo", Scope: 'I', Name: "Anasi"},
+	{Code: "bpp", Scope: 'I', Name: "Kaure"},
+	{Code: "bpq", Scope: 'I', Name: "Banda Malay"},
+	{Code: "bpr", Scope: 'I', Name: "Koronadal Blaan"},
+	{Code: "bps", Scope: 'I', Name: "Sarangani Blaan"},
+	{Code: "bpt", Scope: 'I', Name: "Barrow Point"},
+	{Code: "bpu", Scope: 'I', Name: "Bongu"},
+	{Code: "bpv", Scope: 'I', Name: "Bian Marind"},
+	{Code: "bpw", Scope: 'I', Name: "Bo (Papua New Guinea)"},
+	{Code: "bpx", Scope: 'I', Name: "Palya Bareli"},
+	{Code: "bpy", Scope: 'I', Name: "Bishnupriya"},
+	{Code: "bpz", Scope: 'I', Name: "Bilba"},
+	{Code: "bqa", Scope: 'I', Name: "Tchumbuli"},
+	{Code: "bqb", Scope: 'I', Name: "Bagusa"},
+	{Code: "bqc", Scope: 'I', Name: "Boko (Benin)"},
+	{Code: "bqd", Scope: 'I', Name: "Bung"},
+	{Code: "bqf", Scope: 'I', Name: "Baga Kaloum"},
+	{Code: "bqg", Scope: 'I', Name: "Bago-Kusuntu"},
+	{Code: "bqh", Scope: 'I', Name: "Baima"},
+	{Code: "bqi", Scope: 'I', Name: "Bakhtiari"},
+	{Code: "bqj", Scope: 'I', Name: "Bandial"},
+	{Code: "bqk", Scope: 'I', Name: "Banda-Mbrès"},
+	{Code: "bql", Scope: 'I', Name: "Bilakura"},
+	{Code: "bqm", Scope: 'I', Name: "Wumboko"},
+	{Code: "bqn", Scope: 'I', Name: "Bulgarian Sign Language"},
+	{Code: "bqo", Scope: 'I', Name: "Balo"},
+	{Code: "bqp", Scope: 'I', Name: "Busa"},
+	{Code: "bqq", Scope: 'I', Name: "Biritai"},
+	{Code: "bqr", Scope: 'I', Name: "Burusu"},
+	{Code: "bqs", Scope: 'I', Name: "Bosngun"},
+	{Code: "bqt", Scope: 'I', Name: "Bamukumbit"},
+	{Code: "bqu", Scope: 'I', Name: "Boguru"},
+	{Code: "bqv", Scope: 'I', Name: "Koro Wachi"},
+	{Code: "bqw", Scope: 'I', Name: "Buru (Nigeria)"},
+	{Code: "bqx", Scope: 'I', Name: "Baangi"},
+	{Code: "bqy", Scope: 'I', Name: "Bengkala Sign Language"},
+	{Code: "bqz", Scope: 'I', Name: "Bakaka"},
+	{Code: "bra", Part2: "bra", Scope: 'I', Name: "Braj"},
+	{Code: "brb", Scope: 'I', Name: "Brao"},
+	{Code: "brc", Scope: 'I', Name: "Berbice Creole Dutch"},
+	{Code: "brd", Scope: 'I', Name: "Baraamu"},
+	{Code: "bre", Part2: "bre", Part1: "br", Scope: 'I', Name: "Breton"},
+	{Code: "brf", Scope: 'I', Name: "Bira"},
+	{Code: "brg", Scope: 'I', Name: "Baure"},
+	{Code: "brh", Scope: 'I', Name: "Brahui"},
+	{Code: "bri", Scope: 'I', Name: "Mokpwe"},
+	{Code: "brj", Scope: 'I', Name: "Bieria"},
+	{Code: "brk", Scope: 'I', Name: "Birked"},
+	{Code: "brl", Scope: 'I', Name: "Birwa"},
+	{Code: "brm", Scope: 'I', Name: "Barambu"},
+	{Code: "brn", Scope: 'I', Name: "Boruca"},
+	{Code: "bro", Scope: 'I', Name: "Brokkat"},
+	{Code: "brp", Scope: 'I', Name: "Barapasi"},
+	{Code: "brq", Scope: 'I', Name: "Breri"},
+	{Code: "brr", Scope: 'I', Name: "Birao"},
+	{Code: "brs", Scope: 'I', Name: "Baras"},
+	{Code: "brt", Scope: 'I', Name: "Bitare"},
+	{Code: "bru", Scope: 'I', Name: "Eastern Bru"},
+	{Code: "brv", Scope: 'I', Name: "Western Bru"},
+	{Code: "brw", Scope: 'I', Name: "Bellari"},
+	{Code: "brx", Scope: 'I', Name: "Bodo (India)"},
+	{Code: "bry", Scope: 'I', Name: "Burui"},
+	{Code: "brz", Scope: 'I', Name: "Bilbil"},
+	{Code: "bsa", Scope: 'I', Name: "Abinomn"},
+	{Code: "bsb", Scope: 'I', Name: "Brunei Bisaya"},
+	{Code: "bsc", Scope: 'I', Name: "Bassari"},
+	{Code: "bse", Scope: 'I', Name: "Wushi"},
+	{Code: "bsf", Scope: 'I', Name: "Bauchi"},
+	{Code: "bsg", Scope: 'I', Name: "Bashkardi"},
+	{Code: "bsh", Scope: 'I', Name: "Kati"},
+	{Code: "bsi", Scope: 'I', Name: "Bassossi"},
+	{Code: "bsj", Scope: 'I', Name: "Bangwinji"},
+	{Code: "bsk", Scope: 'I', Name: "Burushaski"},
+	{Code: "bsl", Scope: 'I', Name: "Basa-Gumna"},
+	{Code: "bsm", Scope: 'I', Name: "Busami"},
+	{Code: "bsn", Scope: 'I', Name: "Barasana-Eduria"},
+	{Code: "bso", Scope: 'I', Name: "Buso"},
+	{Code: "bsp", Scope: 'I', Name: "Baga Sitemu"},
+	{Code: "bsq", Scope: 'I', Name: "Bassa"},
+	{Code: "bsr", Scope: 'I', Name: "Bassa-Kontagora"},
+	{Code: "bss", Scope: 'I', Name: "Akoose"},
+	{Code: "bst", Scope: 'I', Name: "Basketo"},
+	{Code: "bsu", Scope: 'I', Name: "Bahonsuai"},
+	{Code: "bsv", Scope: 'I', Name: "Baga Sobané"},
+	{Code: "bsw", Scope: 'I', Name: "Baiso"},
+	{Code: "bsx", Scope: 'I', Name: "Yangkam"},
+	{Code: "bsy", Scope: 'I', Name: "Sabah Bisaya"},
+	{Code: "bta", Scope: 'I', Name: "Bata"},
+	{Code: "btc", Scope: 'I', Name: "Bati (Cameroon)"},
+	{Code: "btd", Scope: 'I', Name: "Batak Dairi"},
+	{Code: "bte", Scope: 'I', Name: "Gamo-Ningi"},
+	{Code: "btf", Scope: 'I', Name: "Birgit"},
+	{Code: "btg", Scope: 'I', Name: "Gagnoa Bété"},
+	{Code: "bth", Scope: 'I', Name: "Biatah Bidayuh"},
+	{Code: "bti", Scope: 'I', Name: "Burate"},
+	{Code: "btj", Scope: 'I', Macro: "ms", Name: "Bacanese Malay"},
+	{Code: "btm", Scope: 'I', Name: "Batak Mandailing"},
+	{Code: "btn", Scope: 'I', Name: "Ratagnon"},
+	{Code: "bto", Scope: 'I', Name: "Rinconada Bikol"},
+	{Code: "btp", Scope: 'I', Name: "Budibud"},
+	{Code: "btq", Scope: 'I', Name: "Batek"},
+	{Code: "btr", Scope: 'I', Name: "Baetora"},
+	{Code: "bts", Scope: 'I', Name: "Batak Simalungun"},
+	{Code: "btt", Scope: 'I', Name: "Bete-Bendi"},
+	{Code: "btu", Scope: 'I', Name: "Batu"},
+	{Code: "btv", Scope: 'I', Name: "Bateri"},
+	{Code: "btw", Scope: 'I', Name: "Butuanon"},
+	{Code: "btx", Scope: 'I', Name: "Batak Karo"},
+	{Code: "bty", Scope: 'I', Name: "Bobot"},
+	{Code: "btz", Scope: 'I', Name: "Batak Alas-Kluet"},
+	{Code: "bua", Part2: "bua", Scope: 'M', Name: "Buriat"},
+	{Code: "bub", Scope: 'I', Name: "Bua"},
+	{Code: "buc", Scope: 'I', Name: "Bushi"},
+	{Code: "bud", Scope: 'I', Name: "Ntcham"},
+	{Code: "bue", Scope: 'I', Name: "Beothuk"},
+	{Code: "buf", Scope: 'I', Name: "Bushoong"},
+	{Code: "bug", Part2: "bug", Scope: 'I', Name: "Buginese"},
+	{Code: "buh", Scope: 'I', Name: "Younuo Bunu"},
+	{Code: "bui", Scope: 'I', Name: "Bongili"},
+	{Code: "buj", Scope: 'I', Name: "Basa-Gurmana"},
+	{Code: "buk", Scope: 'I', Name: "Bugawac"},
+	{Code: "bul", Part2: "bul", Part1: "bg", Scope: 'I', Name: "Bulgarian"},
+	{Code: "bum", Scope: 'I', Name: "Bulu (Cameroon)"},
+	{Code: "bun", Scope: 'I', Name: "Sherbro"},
+	{Code: "buo", Scope: 'I', Name: "Terei"},
+	{Code: "bup", Scope: 'I', Name: "Busoa"},
+	{Code: "buq", Scope: 'I', Name: "Brem"},
+	{Code: "bus", Scope: 'I', Name: "Bokobaru"},
+	{Code: "but", Scope: 'I', Name: "Bungain"},
+	{Code: "buu", Scope: 'I', Name: "Budu"},
+	{Code: "buv", Scope: 'I', Name: "Bun"},
+	{Code: "buw", Scope: 'I', Name: "Bubi"},
+	{Code: "bux", Scope: 'I', Name: "Boghom"},
+	{Code: "buy", Scope: 'I', Name: "Bullom So"},
+	{Code: "buz", Scope: 'I', Name: "Bukwen"},
+	{Code: "bva", Scope: 'I', Name: "Barein"},
+	{Code: "bvb", Scope: 'I', Name: "Bube"},
+	{Code: "bvc", Scope: 'I', Name: "Baelelea"},
+	{Code: "bvd", Scope: 'I', Name: "Baeggu"},
+	{Code: "bve", Scope: 'I', Macro: "ms", Name: "Berau Malay"},
+	{Code: "bvf", Scope: 'I', Name: "Boor"},
+	{Code: "bvg", Scope: 'I', Name: "Bonkeng"},
+	{Code: "bvh", Scope: 'I', Name: "Bure"},
+	{Code: "bvi", Scope: 'I', Name: "Belanda Viri"},
+	{Code: "bvj", Scope: 'I', Name: "Baan"},
+	{Code: "bvk", Scope: 'I', Name: "Bukat"},
+	{Code: "bvl", Scope: 'I', Name: "Bolivian Sign Language"},
+	{Code: "bvm", Scope: 'I', Name: "Bamunka"},
+	{Code: "bvn", Scope: 'I', Name: "Buna"},
+	{Code: "bvo", Scope: 'I', Name: "Bolgo"},
+	{Code: "bvp", Scope: 'I', Name: "Bumang"},
+	{Code: "bvq", Scope: 'I', Name: "Birri"},
+	{Code: "bvr", Scope: 'I', Name: "Burarra"},
+	{Code: "bvt", Scope: 'I', Name: "Bati (Indonesia)"},
+	{Code: "bvu", Scope: 'I', Macro: "ms", Name: "Bukit Malay"},
+	{Code: "bvv", Scope: 'I', Name: "Baniva"},
+	{Code: "bvw", Scope: 'I', Name: "Boga"},
+	{Code: "bvx", Scope: 'I', Name: "Dibole"},
+	{Code: "bvy", Scope: 'I', Name: "Baybayanon"},
+	{Code: "bvz", Scope: 'I', Name: "Bauzi"},
+	{Code: "bwa", Scope: 'I', Name: "Bwatoo"},
+	{Code: "bwb", Scope: 'I', Name: "Namosi-Naitasiri-Serua"},
+	{Code: "bwc", Scope: 'I', Name: "Bwile"},
+	{Code: "bwd", Scope: 'I', Name: "Bwaidoka"},
+	{Code: "bwe", Scope: 'I', Name: "Bwe Karen"},
+	{Code: "bwf", Scope: 'I', Name: "Boselewa"},
+	{Code: "bwg", Scope: 'I', Name: "Barwe"},
+	{Code: "bwh", Scope: 'I', Name: "Bishuo"},
+	{Code: "bwi", Scope: 'I', Name: "Baniwa"},
+	{Code: "bwj", Scope: 'I', Name: "Láá Láá Bwamu"},
+	{Code: "bwk", Scope: 'I', Name: "Bauwaki"},
+	{Code: "bwl", Scope: 'I', Name: "Bwela"},
+	{Code: "bwm", Scope: 'I', Name: "Biwat"},
+	{Code: "bwn", Scope: 'I', Name: "Wunai Bunu"},
+	{Code: "bwo", Scope: 'I', Name: "Boro (Ethiopia)"},
+	{Code: "bwp", Scope: 'I', Name: "Mandobo Bawah"},
+	{Code: "bwq", Scope: 'I', Name: "Southern Bobo Madaré"},
+	{Code: "bwr", Scope: 'I', Name: "Bura-Pabir"},
+	{Code: "bws", Scope: 'I', Name: "Bomboma"},
+	{Code: "bwt", Scope: 'I', Name: "Bafaw-Balong"},
+	{Code: "bwu", Scope: 'I', Name: "Buli (Ghana)"},
+	{Code: "bww", Scope: 'I', Name: "Bwa"},
+	{Code: "bwx", Scope: 'I', Name: "Bu-Nao Bunu"},
+	{Code: "bwy", Scope: 'I', Name: "Cwi Bwamu"},
+	{Code: "bwz", Scope: 'I', Name: "Bwisi"},
+	{Code: "bxa", Scope: 'I', Name: "Tairaha"},
+	{Code: "bxb", Scope: 'I', Name: "Belanda Bor"},
+	{Code: "bxc", Scope: 'I', Name: "Molengue"},
+	{Code: "bxd", Scope: 'I', Name: "Pela"},
+	{Code: "bxe", Scope: 'I', Name: "Birale"},
+	{Code: "bxf", Scope: 'I', Name: "Bilur"},
+	{Code: "bxg", Scope: 'I', Name: "Bangala"},
+	{Code: "bxh", Scope: 'I', Name: "Buhutu"},
+	{Code: "bxi", Scope: 'I', Name: "Pirlatapa"},
+	{Code: "bxj", Scope: 'I', Name: "Bayungu"},
+	{Code: "bxk", Scope: 'I', Macro: "luy", Name: "Bukusu"},
+	{Code: "bxl", Scope: 'I', Name: "Jalkunan"},
+	{Code: "bxm", Scope: 'I', Name: "Mongolia Buriat"},
+	{Code: "bxn", Scope: 'I', Name: "Burduna"},
+	{Code: "bxo", Scope: 'I', Name: "Barikanchi"},
+	{Code: "bxp", Scope: 'I', Name: "Bebil"},
+	{Code: "bxq", Scope: 'I', Name: "Beele"},
+	{Code: "bxr", Scope: 'I', Macro: "bua", Name: "Russia Buriat"},
+	{Code: "bxs", Scope: 'I', Name: "Busam"},
+	{Code: "bxu", Scope: 'I', Name: "China Buriat"},
+	{Code: "bxv", Scope: 'I', Name: "Berakou"},
+	{Code: "bxw", Scope: 'I', Name: "Bankagooma"},
+	{Code: "bxz", Scope: 'I', Name: "Binahari"},
+	{Code: "bya", Scope: 'I', Name: "Batak"},
+	{Code: "byb", Scope: 'I', Name: "Bikya"},
+	{Code: "byc", Scope: 'I', Name: "Ubaghara"},
+	{Code: "byd", Scope: 'I', Name: "Benyadu'"},
+	{Code: "bye", Scope: 'I', Name: "Pouye"},
+	{Code: "byf", Scope: 'I', Name: "Bete"},
+	{Code: "byg", Scope: 'I', Name: "Baygo"},
+	{Code: "byh", Scope: 'I', Name: "Bhujel"},
+	{Code: "byi", Scope: 'I', Name: "Buyu"},
+	{Code: "byj", Scope: 'I', Name: "Bina (Nigeria)"},
+	{Code: "byk", Scope: 'I', Name: "Biao"},
+	{Code: "byl", Scope: 'I', Name: "Bayono"},
+	{Code: "bym", Scope: 'I', Name: "Bidjara"},
+	{Code: "byn", Part2: "byn", Scope: 'I', Name: "Bilin"},
+	{Code: "byo", Scope: 'I', Name: "Biyo"},
+	{Code: "byp", Scope: 'I', Name: "Bumaji"},
+	{Code: "byq", Scope: 'I', Name: "Basay"},
+	{Code: "byr", Scope: 'I', Name: "Baruya"},
+	{Code: "bys", Scope: 'I', Name: "Burak"},
+	{Code: "byt", Scope: 'I', Name: "Berti"},
+	{Code: "byv", Scope: 'I', Name: "Medumba"},
+	{Code: "byw", Scope: 'I', Name: "Belhariya"},
+	{Code: "byx", Scope: 'I', Name: "Qaqet"},
+	{Code: "byz", Scope: 'I', Name: "Banaro"},
+	{Code: "bza", Scope: 'I', Name: "Bandi"},
+	{Code: "bzb", Scope: 'I', Name: "Andio"},
+	{Code: "bzc", Scope: 'I', Name: "Southern Betsimisaraka Malagasy"},
+	{Code: "bzd", Scope: 'I', Name: "Bribri"},
+	{Code: "bze", Scope: 'I', Name: "Jenaama Bozo"},
+	{Code: "bzf", Scope: 'I', Name: "Boikin"},
+	{Code: "bzg", Scope: 'I', Name: "Babuza"},
+	{Code: "bzh", Scope: 'I', Name: "Mapos Buang"},
+	{Code: "bzi", Scope: 'I', Name: "Bisu"},
+	{Code: "bzj", Scope: 'I', Name: "Belize Kriol English"},
+	{Code: "bzk", Scope: 'I', Name: "Nicaragua Creole English"},
+	{Code: "bzl", Scope: 'I', Name: "Boano (Sulawesi)"},
+	{Code: "bzm", Scope: 'I', Name: "Bolondo"},
+	{Code: "bzn", Scope: 'I', Name: "Boano (Maluku)"},
+	{Code: "bzo", Scope: 'I', Name: "Bozaba"},
+	{Code: "bzp", Scope: 'I', Name: "Kemberano"},
+	{Code: "bzq", Scope: 'I', Name: "Buli (Indonesia)"},
+	{Code: "bzr", Scope: 'I', Name: "Biri"},
+	{Code: "bzs", Scope: 'I', Name: "Brazilian Sign Language"},
+	{Code: "bzt", Scope: 'I', Name: "Brithenig"},
+	{Code: "bzu", Scope: 'I', Name: "Burmeso"},
+	{Code: "bzv", Scope: 'I', Name: "Naami"},
+	{Code: "bzw", Scope: 'I', Name: "Basa (Nigeria)"},
+	{Code: "bzx", Scope: 'I', Name: "Kɛlɛngaxo Bozo"},
+	{Code: "bzy", Scope: 'I', Name: "Obanliku"},
+	{Code: "bzz", Scope: 'I', Name: "Evant"},
+	{Code: "caa", Scope: 'I', Name: "Chortí"},
+	{Code: "cab", Scope: 'I', Name: "Garifuna"},
+	{Code: "cac", Scope: 'I', Name: "Chuj"},
+	{Code: "cad", Part2: "cad", Scope: 'I', Name: "Caddo"},
+	{Code: "cae", Scope: 'I', Name: "Lehar"},
+	{Code: "caf", Scope: 'I', Name: "Southern Carrier"},
+	{Code: "cag", Scope: 'I', Name: "Nivaclé"},
+	{Code: "cah", Scope: 'I', Name: "Cahuarano"},
+	{Code: "caj", Scope: 'I', Name: "Chané"},
+	{Code: "cak", Scope: 'I', Name: "Kaqchikel"},
+	{Code: "cal", Scope: 'I', Name: "Carolinian"},
+	{Code: "cam", Scope: 'I', Name: "Cemuhî"},
+	{Code: "can", Scope: 'I', Name: "Chambri"},
+	{Code: "cao", Scope: 'I', Name: "Chácobo"},
+	{Code: "cap", Scope: 'I', Name: "Chipaya"},
+	{Code: "caq", Scope: 'I', Name: "Car Nicobarese"},
+	{Code: "car", Part2: "car", Scope: 'I', Name: "Galibi Carib"},
+	{Code: "cas", Scope: 'I', Name: "Tsimané"},
+	{Code: "cat", Part2: "cat", Part1: "ca", Scope: 'I', Name: "Catalan"},
+	{Code: "cav", Scope: 'I', Name: "Cavineña"},
+	{Code: "caw", Scope: 'I', Name: "Callawalla"},
+	{Code: "cax", Scope: 'I', Name: "Chiquitano"},
+	{Code: "cay", Scope: 'I', Name: "Cayuga"},
+	{Code: "caz", Scope: 'I', Name: "Canichana"},
+	{Code: "cbb", Scope: 'I', Name: "Cabiyarí"},
+	{Code: "cbc", Scope: 'I', Name: "Carapana"},
+	{Code: "cbd", Scope: 'I', Name: "Carijona"},
+	{Code: "cbg", Scope: 'I', Name: "Chimila"},
+	{Code: "cbi", Scope: 'I', Name: "Chachi"},
+	{Code: "cbj", Scope: 'I', Name: "Ede Cabe"},
+	{Code: "cbk", Scope: 'I', Name: "Chavacano"},
+	{Code: "cbl", Scope: 'I', Name: "Bualkhaw Chin"},
+	{Code: "cbn", Scope: 'I', Name: "Nyahkur"},
+	{Code: "cbo", Scope: 'I', Name: "Izora"},
+	{Code: "cbq", Scope: 'I', Name: "Tsucuba"},
+	{Code: "cbr", Scope: 'I', Name: "Cashibo-Cacataibo"},
+	{Code: "cbs", Scope: 'I', Name: "Cashinahua"},
+	{Code: "cbt", Scope: 'I', Name: "Chayahuita"},
+	{Code: "cbu", Scope: 'I', Name: "Candoshi-Shapra"},
+	{Code: "cbv", Scope: 'I', Name: "Cacua"},
+	{Code: "cbw", Scope: 'I', Name: "Kinabalian"},
+	{Code: "cby", Scope: 'I', Name: "Carabayo"},
+	{Code: "ccc", Scope: 'I', Name: "Chamicuro"},
+	{Code: "ccd", Scope: 'I', Name: "Cafundo Creole"},
+	{Code: "cce", Scope: 'I', Name: "Chopi"},
+	{Code: "ccg", Scope: 'I', Name: "Samba Daka"},
+	{Code: "cch", Scope: 'I', Name: "Atsam"},
+	{Code: "ccj", Scope: 'I', Name: "Kasanga"},
+	{Code: "ccl", Scope: 'I', Name: "Cutchi-Swahili"},
+	{Code: "ccm", Scope: 'I', Name: "Malaccan Creole Malay"},
+	{Code: "cco", Scope: 'I', Name: "Comaltepec Chinantec"},
+	{Code: "ccp", Scope: 'I', Name: "Chakma"},
+	{Code: "ccr", Scope: 'I', Name: "Cacaopera"},
+	{Code: "cda", Scope: 'I', Name: "Choni"},
+	{Code: "cde", Scope: 'I', Name: "Chenchu"},
+	{Code: "cdf", Scope: 'I', Name: "Chiru"},
+	{Code: "cdh", Scope: 'I', Name: "Chambeali"},
+	{Code: "cdi", Scope: 'I', Name: "Chodri"},
+	{Code: "cdj", Scope: 'I', Name: "Churahi"},
+	{Code: "cdm", Scope: 'I', Name: "Chepang"},
+	{Code: "cdn", Scope: 'I', Name: "Chaudangsi"},
+	{Code: "cdo", Scope: 'I', Macro: "zh", Name: "Min Dong Chinese"},
+	{Code: "cdr", Scope: 'I', Name: "Cinda-Regi-Tiyal"},
+	{Code: "cds", Scope: 'I', Name: "Chadian Sign Language"},
+	{Code: "cdy", Scope: 'I', Name: "Chadong"},
+	{Code: "cdz", Scope: 'I', Name: "Koda"},
+	{Code: "cea", Scope: 'I', Name: "Lower Chehalis"},
+	{Code: "ceb", Part2: "ceb", Scope: 'I', Name: "Cebuano"},
+	{Code: "ceg", Scope: 'I', Name: "Chamacoco"},
+	{Code: "cek", Scope: 'I', Name: "Eastern Khumi Chin"},
+	{Code: "cen", Scope: 'I', Name: "Cen"},
+	{Code: "ces", Part2: "ces", Part1: "cs", Scope: 'I', Name: "Czech"},
+	{Code: "cet", Scope: 'I', Name: "Centúúm"},
+	{Code: "cey", Scope: 'I', Name: "Ekai Chin"},
+	{Code: "cfa", Scope: 'I', Name: "Dijim-Bwilim"},
+	{Code: "cfd", Scope: 'I', Name: "Cara"},
+	{Code: "cfg", Scope: 'I', Name: "Como Karim"},
+	{Code: "cfm", Scope: 'I', Name: "Falam Chin"},
+	{Code: "cga", Scope: 'I', Name: "Changriwa"},
+	{Code: "cgc", Scope: 'I', Name: "Kagayanen"},
+	{Code: "cgg", Scope: 'I', Name: "Chiga"},
+	{Code: "cgk", Scope: 'I', Name: "Chocangacakha"},
+	{Code: "cha", Part2: "cha", Part1: "ch", Scope: 'I', Name: "Chamorro"},
+	{Code: "chb", Part2: "chb", Scope: 'I', Name: "Chibcha"},
+	{Code: "chc", Scope: 'I', Name: "Catawba"},
+	{Code: "chd", Scope: 'I', Name: "Highland Oaxaca Chontal"},
+	{Code: "che", Part2: "che", Part1: "ce", Scope: 'I', Name: "Chechen"},
+	{Code: "chf", Scope: 'I', Name: "Tabasco Chontal"},
+	{Code: "chg", Part2: "chg", Scope: 'I', Name: "Chagatai"},
+	{Code: "chh", Scope: 'I', Name: "Chinook"},
+	{Code: "chj", Scope: 'I', Name: "Ojitlán Chinantec"},
+	{Code: "chk", Part2: "chk", Scope: 'I', Name: "Chuukese"},
+	{Code: "chl", Scope: 'I', Name: "Cahuilla"},
+	{Code: "chm", Part2: "chm", Scope: 'M', Name: "Mari (Russia)"},
+	{Code: "chn", Part2: "chn", Scope: 'I', Name: "Chinook jargon"},
+	{Code: "cho", Part2: "cho", Scope: 'I', Name: "Choctaw"},
+	{Code: "chp", Part2: "chp", Scope: 'I', Name: "Chipewyan"},
+	{Code: "chq", Scope: 'I', Name: "Quiotepec Chinantec"},
+	{Code: "chr", Part2: "chr", Scope: 'I', Name: "Cherokee"},
+	{Code: "cht", Scope: 'I', Name: "Cholón"},
+	{Code: "chu", Part2: "chu", Part1: "cu", Scope: 'I', Name: "Church Slavic"},
+	{Code: "chv", Part2: "chv", Part1: "cv", Scope: 'I', Name: "Chuvash"},
+	{Code: "chw", Scope: 'I', Name: "Chuwabu"},
+	{Code: "chx", Scope: 'I', Name: "Chantyal"},
+	{Code: "chy", Part2: "chy", Scope: 'I', Name: "Cheyenne"},
+	{Code: "chz", Scope: 'I', Name: "Ozumacín Chinantec"},
+	{Code: "cia", Scope: 'I', Name: "Cia-Cia"},
+	{Code: "cib", Scope: 'I', Name: "Ci Gbe"},
+	{Code: "cic", Scope: 'I', Name: "Chickasaw"},
+	{Code: "cid", Scope: 'I', Name: "Chimariko"},
+	{Code: "cie", Scope: 'I', Name: "Cineni"},
+	{Code: "cih", Scope: 'I', Name: "Chinali"},
+	{Code: "cik", Scope: 'I', Name: "Chitkuli Kinnauri"},
+	{Code: "cim", Scope: 'I', Name: "Cimbrian"},
+	{Code: "cin", Scope: 'I', Name: "Cinta Larga"},
+	{Code: "cip", Scope: 'I', Name: "Chiapanec"},
+	{Code: "cir", Scope: 'I', Name: "Tiri"},
+	{Code: "ciw", Scope: 'I', Name: "Chippewa"},
+	{Code: "ciy", Scope: 'I', Name: "Chaima"},
+	{Code: "cja", Scope: 'I', Name: "Western Cham"},
+	{Code: "cje", Scope: 'I', Name: "Chru"},
+	{Code: "cjh", Scope: 'I', Name: "Upper Chehalis"},
+	{Code: "cji", Scope: 'I', Name: "Chamalal"},
+	{Code: "cjk", Scope: 'I', Name: "Chokwe"},
+	{Code: "cjm", Scope: 'I', Name: "Eastern Cham"},
+	{Code: "cjn", Scope: 'I', Name: "Chenapian"},
+	{Code: "cjo", Scope: 'I', Name: "Ashéninka Pajonal"},
+	{Code: "cjp", Scope: 'I', Name: "Cabécar"},
+	{Code: "cjs", Scope: 'I', Name: "Shor"},
+	{Code: "cjv", Scope: 'I', Name: "Chuave"},
+	{Code: "cjy", Scope: 'I', Macro: "zh", Name: "Jinyu Chinese"},
+	{Code: "ckb", Scope: 'I', Macro: "ku", Name: "Central Kurdish"},
+	{Code: "ckh", Scope: 'I', Name: "Chak"},
+	{Code: "ckl", Scope: 'I', Name: "Cibak"},
+	{Code: "ckm", Scope: 'I', Name: "Chakavian"},
+	{Code: "ckn", Scope: 'I', Name: "Kaang Chin"},
+	{Code: "cko", Scope: 'I', Name: "Anufo"},
+	{Code: "ckq", Scope: 'I', Name: "Kajakse"},
+	{Code: "ckr", Scope: 'I', Name: "Kairak"},
+	{Code: "cks", Scope: 'I', Name: "Tayo"},
+	{Code: "ckt", Scope: 'I', Name: "Chukot"},
+	{Code: "cku", Scope: 'I', Name: "Koasati"},
+	{Code: "ckv", Scope: 'I', Name: "Kavalan"},
+	{Code: "ckx", Scope: 'I', Name: "Caka"},
+	{Code: "cky", Scope: 'I', Name: "Cakfem-Mushere"},
+	{Code: "ckz", Scope: 'I', Name: "Cakchiquel-Quiché Mixed Language"},
+	{Code: "cla", Scope: 'I', Name: "Ron"},
+	{Code: "clc", Scope: 'I', Name: "Chilcotin"},
+	{Code: "cld", Scope: 'I', Macro: "syr", Name: "Chaldean Neo-Aramaic"},
+	{Code: "cle", Scope: 'I', Name: "Lealao Chinantec"},
+	{Code: "clh", Scope: 'I', Name: "Chilisso"},
+	{Code: "cli", Scope: 'I', Name: "Chakali"},
+	{Code: "clj", Scope: 'I', Name: "Laitu Chin"},
+	{Code: "clk", Scope: 'I', Name: "Idu-Mishmi"},
+	{Code: "cll", Scope: 'I', Name: "Chala"},
+	{Code: "clm", Scope: 'I', Name: "Clallam"},
+	{Code: "clo", Scope: 'I', Name: "Lowland Oaxaca Chontal"},
+	{Code: "clt", Scope: 'I', Name: "Lautu Chin"},
+	{Code: "clu", Scope: 'I', Name: "Caluyanun"},
+	{Code: "clw", Scope: 'I', Name: "Chulym"},
+	{Code: "cly", Scope: 'I', Name: "Eastern Highland Chatino"},
+	{Code: "cma", Scope: 'I', Name: "Maa"},
+	{Code: "cme", Scope: 'I', Name: "Cerma"},
+	{Code: "cmg", Scope: 'I', Name: "Classical Mongolian"},
+	{Code: "cmi", Scope: 'I', Name: "Emberá-Chamí"},
+	{Code: "cml", Scope: 'I', Name: "Campalagian"},
+	{Code: "cmm", Scope: 'I', Name: "Michigamea"},
+	{Code: "cmn", Scope: 'I', Macro: "zh", Name: "Mandarin Chinese"},
+	{Code: "cmo", Scope: 'I', Name: "Central Mnong"},
+	{Code: "cmr", Scope: 'I', Name: "Mro-Khimi Chin"},
+	{Code: "cms", Scope: 'I', Name: "Messapic"},
+	{Code: "cmt", Scope: 'I', Name: "Camtho"},
+	{Code: "cna", Scope: 'I', Name: "Changthang"},
+	{Code: "cnb", Scope: 'I', Name: "Chinbon Chin"},
+	{Code: "cnc", Scope: 'I', Name: "Côông"},
+	{Code: "cng", Scope: 'I', Name: "Northern Qiang"},
+	{Code: "cnh", Scope: 'I', Name: "Hakha Chin"},
+	{Code: "cni", Scope: 'I', Name: "Asháninka"},
+	{Code: "cnk", Scope: 'I', Name: "Khumi Chin"},
+	{Code: "cnl", Scope: 'I', Name: "Lalana Chinantec"},
+	{Code: "cno", Scope: 'I', Name: "Con"},
+	{Code: "cnp", Scope: 'I', Macro: "zh", Name: "Northern Ping Chinese"},
+	{Code: "cnq", Scope: 'I', Name: "Chung"},
+	{Code: "cnr", Part2: "cnr", Scope: 'I', Macro: "sh", Name: "Montenegrin"},
+	{Code: "cns", Scope: 'I', Name: "Central Asmat"},
+	{Code: "cnt", Scope: 'I', Name: "Tepetotutla Chinantec"},
+	{Code: "cnu", Scope: 'I', Name: "Chenoua"},
+	{Code: "cnw", Scope: 'I', Name: "Ngawn Chin"},
+	{Code: "cnx", Scope: 'I', Name: "Middle Cornish"},
+	{Code: "coa", Scope: 'I', Macro: "ms", Name: "Cocos Islands Malay"},
+	{Code: "cob", Scope: 'I', Name: "Chicomuceltec"},
+	{Code: "coc", Scope: 'I', Name: "Cocopa"},
+	{Code: "cod", Scope: 'I', Name: "Cocama-Cocamilla"},
+	{Code: "coe", Scope: 'I', Name: "Koreguaje"},
+	{Code: "cof", Scope: 'I', Name: "Colorado"},
+	{Code: "cog", Scope: 'I', Name: "Chong"},
+	{Code: "coh", Scope: 'I', Name: "Chonyi-Dzihana-Kauma"},
+	{Code: "coj", Scope: 'I', Name: "Cochimi"},
+	{Code: "cok", Scope: 'I', Name: "Santa Teresa Cora"},
+	{Code: "col", Scope: 'I', Name: "Columbia-Wenatchi"},
+	{Code: "com", Scope: 'I', Name: "Comanche"},
+	{Code: "con", Scope: 'I', Name: "Cofán"},
+	{Code: "coo", Scope: 'I', Name: "Comox"},
+	{Code: "cop", Part2: "cop", Scope: 'I', Name: "Coptic"},
+	{Code: "coq", Scope: 'I', Name: "Coquille"},
+	{Code: "cor", Part2: "cor", Part1: "kw", Scope: 'I', Name: "Cornish"},
+	{Code: "cos", Part2: "cos", Part1: "co", Scope: 'I', Name: "Corsican"},
+	{Code: "cot", Scope: 'I', Name: "Caquinte"},
+	{Code: "cou", Scope: 'I', Name: "Wamey"},
+	{Code: "cov", Scope: 'I', Name: "Cao Miao"},
+	{Code: "cow", Scope: 'I', Name: "Cowlitz"},
+	{Code: "cox", Scope: 'I', Name: "Nanti"},
+	{Code: "coz", Scope: 'I', Name: "Chochotec"},
+	{Code: "cpa", Scope: 'I', Name: "Palantla Chinantec"},
+	{Code: "cpb", Scope: 'I', Name: "Ucayali-Yurúa Ashéninka"},
+	{Code: "cpc", Scope: 'I', Name: "Ajyíninka Apurucayali"},
+	{Code: "cpg", Scope: 'I', Name: "Cappadocian Greek"},
+	{Code: "cpi", Scope: 'I', Name: "Chinese Pidgin English"},
+	{Code: "cpn", Scope: 'I', Name: "Cherepon"},
+	{Code: "cpo", Scope: 'I', Name: "Kpeego"},
+	{Code: "cps", Scope: 'I', Name: "Capiznon"},
+	{Code: "cpu", Scope: 'I', Name: "Pichis Ashéninka"},
+	{Code: "cpx", Scope: 'I', Macro: "zh", Name: "Pu-Xian Chinese"},
+	{Code: "cpy", Scope: 'I', Name: "South Ucayali Ashéninka"},
+	{Code: "cqd", Scope: 'I', Name: "Chuanqiandian Cluster Miao"},
+	{Code: "cra", Scope: 'I', Name: "Chara"},
+	{Code: "crb", Scope: 'I', Name: "Island Carib"},
+	{Code: "crc", Scope: 'I', Name: "Lonwolwol"},
+	{Code: "crd", Scope: 'I', Name: "Coeur d'Alene"},
+	{Code: "cre", Part2: "cre", Part1: "cr", Scope: 'M', Name: "Cree"},
+	{Code: "crf", Scope: 'I', Name: "Caramanta"},
+	{Code: "crg", Scope: 'I', Name: "Michif"},
+	{Code: "crh", Part2: "crh", Scope: 'I', Name: "Crimean Tatar"},
+	{Code: "cri", Scope: 'I', Name: "Sãotomense"},
+	{Code: "crj", Scope: 'I', Name: "Southern East Cree"},
+	{Code: "crk", Scope: 'I', Name: "Plains Cree"},
+	{Code: "crl", Scope: 'I', Name: "Northern East Cree"},
+	{Code: "crm", Scope: 'I', Name: "Moose Cree"},
+	{Code: "crn", Scope: 'I', Name: "El Nayar Cora"},
+	{Code: "cro", Scope: 'I', Name: "Crow"},
+	{Code: "crq", Scope: 'I', Name: "Iyo'wujwa Chorote"},
+	{Code: "crr", Scope: 'I', Name: "Carolina Algonquian"},
+	{Code: "crs", Scope: 'I', Name: "Seselwa Creole French"},
+	{Code: "crt", Scope: 'I', Name: "Iyojwa'ja Chorote"},
+	{Code: "crv", Scope: 'I', Name: "Chaura"},
+	{Code: "crw", Scope: 'I', Name: "Chrau"},
+	{Code: "crx", Scope: 'I', Name: "Carrier"},
+	{Code: "cry", Scope: 'I', Name: "Cori"},
+	{Code: "crz", Scope: 'I', Name: "Cruzeño"},
+	{Code: "csa", Scope: 'I', Name: "Chiltepec Chinantec"},
+	{Code: "csb", Part2: "csb", Scope: 'I', Name: "Kashubian"},
+	{Code: "csc", Scope: 'I', Name: "Catalan Sign Language"},
+	{Code: "csd", Scope: 'I', Name: "Chiangmai Sign Language"},
+	{Code: "cse", Scope: 'I', Name: "Czech Sign Language"},
+	{Code: "csf", Scope: 'I', Name: "Cuba Sign Language"},
+	{Code: "csg", Scope: 'I', Name: "Chilean Sign Language"},
+	{Code: "csh", Scope: 'I', Name: "Asho Chin"},
+	{Code: "csi", Scope: 'I', Name: "Coast Miwok"},
+	{Code: "csj", Scope: 'I', Name: "Songlai Chin"},
+	{Code: "csk", Scope: 'I', Name: "Jola-Kasa"},
+	{Code: "csl", Scope: 'I', Name: "Chinese Sign Language"},
+	{Code: "csm", Scope: 'I', Name: "Central Sierra Miwok"},
+	{Code: "csn", Scope: 'I', Name: "Colombian Sign Language"},
+	{Code: "cso", Scope: 'I', Name: "Sochiapam Chinantec"},
+	{Code: "csp", Scope: 'I', Macro: "zh", Name: "Southern Ping Chinese"},
+	{Code: "csq", Scope: 'I', Name: "Croatia Sign Language"},
+	{Code: "csr", Scope: 'I', Name: "Costa Rican Sign Language"},
+	{Code: "css", Scope: 'I', Name: "Southern Ohlone"},
+	{Code: "cst", Scope: 'I', Name: "Northern Ohlone"},
+	{Code: "csv", Scope: 'I', Name: "Sumtu Chin"},
+	{Code: "csw", Scope: 'I', Name: "Swampy Cree"},
+	{Code: "csx", Scope: 'I', Name: "Cambodian Sign Language"},
+	{Code: "csy", Scope: 'I', Name: "Siyin Chin"},
+	{Code: "csz", Scope: 'I', Name: "Coos"},
+	{Code: "cta", Scope: 'I', Name: "Tataltepec Chatino"},
+	{Code: "ctc", Scope: 'I', Name: "Chetco"},
+	{Code: "ctd", Scope: 'I', Name: "Tedim Chin"},
+	{Code: "cte", Scope: 'I', Name: "Tepinapa Chinantec"},
+	{Code: "ctg", Scope: 'I', Name: "Chittagonian"},
+	{Code: "cth", Scope: 'I', Name: "Thaiphum Chin"},
+	{Code: "ctl", Scope: 'I', Name: "Tlacoatzintepec Chinantec"},
+	{Code: "ctm", Scope: 'I', Name: "Chitimacha"},
+	{Code: "ctn", Scope: 'I', Name: "Chhintange"},
+	{Code: "cto", Scope: 'I', Name: "Emberá-Catío"},
+	{Code: "ctp", Scope: 'I', Name: "Western Highland Chatino"},
+	{Code: "cts", Scope: 'I', Name: "Northern Catanduanes Bikol"},
+	{Code: "ctt", Scope: 'I', Name: "Wayanad Chetti"},
+	{Code: "ctu", Scope: 'I', Name: "Chol"},
+	{Code: "cty", Scope: 'I', Name: "Moundadan Chetty"},
+	{Code: "ctz", Scope: 'I', Name: "Zacatepec Chatino"},
+	{Code: "cua", Scope: 'I', Name: "Cua"},
+	{Code: "cub", Scope: 'I', Name: "Cubeo"},
+	{Code: "cuc", Scope: 'I', Name: "Usila Chinantec"},
+	{Code: "cuh", Scope: 'I', Name: "Chuka"},
+	{Code: "cui", Scope: 'I', Name: "Cuiba"},
+	{Code: "cuj", Scope: 'I', Name: "Mashco Piro"},
+	{Code: "cuk", Scope: 'I', Name: "San Blas Kuna"},
+	{Code: "cul", Scope: 'I', Name: "Culina"},
+	{Code: "cuo", Scope: 'I', Name: "Cumanagoto"},
+	{Code: "cup", Scope: 'I', Name: "Cupeño"},
+	{Code: "cuq", Scope: 'I', Name: "Cun"},
+	{Code: "cur", Scope: 'I', Name: "Chhulung"},
+	{Code: "cut", Scope: 'I', Name: "Teutila Cuicatec"},
+	{Code: "cuu", Scope: 'I', Name: "Tai Ya"},
+	{Code: "cuv", Scope: 'I', Name: "Cuvok"},
+	{Code: "cuw", Scope: 'I', Name: "Chukwa"},
+	{Code: "cux", Scope: 'I', Name: "Tepeuxila Cuicatec"},
+	{Code: "cuy", Scope: 'I', Name: "Cuitlatec"},
+	{Code: "cvg", Scope: 'I', Name: "Chug"},
+	{Code: "cvn", Scope: 'I', Name: "Valle Nacional Chinantec"},
+	{Code: "cwa", Scope: 'I', Name: "Kabwa"},
+	{Code: "cwb", Scope: 'I', Name: "Maindo"},
+	{Code: "cwd", Scope: 'I', Macro: "cr", Name: "Woods Cree"},
+	{Code: "cwe", Scope: 'I', Name: "Kwere"},
+	{Code: "cwg", Scope: 'I', Name: "Chewong"},
+	{Code: "cwt", Scope: 'I', Name: "Kuwaataay"},
+	{Code: "cya", Scope: 'I', Name: "Nopala Chatino"},
+	{Code: "cyb", Scope: 'I', Name: "Cayubaba"},
+	{Code: "cym", Part2: "cym", Part1: "cy", Scope: 'I', Name: "Welsh"},
+	{Code: "cyo", Scope: 'I', Name: "Cuyonon"},
+	{Code: "czh", Scope: 'I', Macro: "zh", Name: "Huizhou Chinese"},
+	{Code: "czk", Scope: 'I', Name: "Knaanic"},
+	{Code: "czn", Scope: 'I', Name: "Zenzontepec Chatino"},
+	{Code: "czo", Scope: 'I', Macro: "zh", Name: "Min Zhong Chinese"},
+	{Code: "czt", Scope: 'I', Name: "Zotung Chin"},
+	{Code: "daa", Scope: 'I', Name: "Dangaléat"},
+	{Code: "dac", Scope: 'I', Name: "Dambi"},
+	{Code: "dad", Scope: 'I', Name: "Marik"},
+	{Code: "dae", Scope: 'I', Name: "Duupa"},
+	{Code: "dag", Scope: 'I', Name: "Dagbani"},
+	{Code: "dah", Scope: 'I', Name: "Gwahatike"},
+	{Code: "dai", Scope: 'I', Name: "Day"},
+	{Code: "daj", Scope: 'I', Name: "Dar Fur Daju"},
+	{Code: "dak", Part2: "dak", Scope: 'I', Name: "Dakota"},
+	{Code: "dal", Scope: 'I', Name: "Dahalo"},
+	{Code: "dam", Scope: 'I', Name: "Damakawa"},
+	{Code: "dan", Part2: "dan", Part1: "da", Scope: 'I', Name: "Danish"},
+	{Code: "dao", Scope: 'I', Name: "Daai Chin"},
+	{Code: "daq", Scope: 'I', Name: "Dandami Maria"},
+	{Code: "dar", Part2: "dar", Scope: 'I', Name: "Dargwa"},
+	{Code: "das", Scope: 'I', Name: "Daho-Doo"},
+	{Code: "dau", Scope: 'I', Name: "Dar Sila Daju"},
+	{Code: "dav", Scope: 'I', Name: "Taita"},
+	{Code: "daw", Scope: 'I', Name: "Davawenyo"},
+	{Code: "dax", Scope: 'I', Name: "Dayi"},
+	{Code: "daz", Scope: 'I', Name: "Dao"},
+	{Code: "dba", Scope: 'I', Name: "Bangime"},
+	{Code: "dbb", Scope: 'I', Name: "Deno"},
+	{Code: "dbd", Scope: 'I', Name: "Dadiya"},
+	{Code: "dbe", Scope: 'I', Name: "Dabe"},
+	{Code: "dbf", Scope: 'I', Name: "Edopi"},
+	{Code: "dbg", Scope: 'I', Name: "Dogul Dom Dogon"},
+	{Code: "dbi", Scope: 'I', Name: "Doka"},
+	{Code: "dbj", Scope: 'I', Name: "Ida'an"},
+	{Code: "dbl", Scope: 'I', Name: "Dyirbal"},
+	{Code: "dbm", Scope: 'I', Name: "Duguri"},
+	{Code: "dbn", Scope: 'I', Name: "Duriankere"},
+	{Code: "dbo", Scope: 'I', Name: "Dulbu"},
+	{Code: "dbp", Scope: 'I', Name: "Duwai"},
+	{Code: "dbq", Scope: 'I', Name: "Daba"},
+	{Code: "dbr", Scope: 'I', Name: "Dabarre"},
+	{Code: "dbt", Scope: 'I', Name: "Ben Tey Dogon"},
+	{Code: "dbu", Scope: 'I', Name: "Bondum Dom Dogon"},
+	{Code: "dbv", Scope: 'I', Name: "Dungu"},
+	{Code: "dbw", Scope: 'I', Name: "Bankan Tey Dogon"},
+	{Code: "dby", Scope: 'I', Name: "Dibiyaso"},
+	{Code: "dcc", Scope: 'I', Name: "Deccan"},
+	{Code: "dcr", Scope: 'I', Name: "Negerhollands"},
+	{Code: "dda", Scope: 'I', Name: "Dadi Dadi"},
+	{Code: "ddd", Scope: 'I', Name: "Dongotono"},
+	{Code: "dde", Scope: 'I', Name: "Doondo"},
+	{Code: "ddg", Scope: 'I', Name: "Fataluku"},
+	{Code: "ddi", Scope: 'I', Name: "West Goodenough"},
+	{Code: "ddj", Scope: 'I', Name: "Jaru"},
+	{Code: "ddn", Scope: 'I', Name: "Dendi (Benin)"},
+	{Code: "ddo", Scope: 'I', Name: "Dido"},
+	{Code: "ddr", Scope: 'I', Name: "Dhudhuroa"},
+	{Code: "dds", Scope: 'I', Name: "Donno So Dogon"},
+	{Code: "ddw", Scope: 'I', Name: "Dawera-Daweloor"},
+	{Code: "dec", Scope: 'I', Name: "Dagik"},
+	{Code: "ded", Scope: 'I', Name: "Dedua"},
+	{Code: "dee", Scope: 'I', Name: "Dewoin"},
+	{Code: "def", Scope: 'I', Name: "Dezfuli"},
+	{Code: "deg", Scope: 'I', Name: "Degema"},
+	{Code: "deh", Scope: 'I', Name: "Dehwari"},
+	{Code: "dei", Scope: 'I', Name: "Demisa"},
+	{Code: "dek", Scope: 'I', Name: "Dek"},
+	{Code: "del", Part2: "del", Scope: 'M', Name: "Delaware"},
+	{Code: "dem", Scope: 'I', Name: "Dem"},
+	{Code: "den", Part2: "den", Scope: 'M', Name: "Slave (Athapascan)"},
+	{Code: "dep", Scope: 'I', Name: "Pidgin Delaware"},
+	{Code: "deq", Scope: 'I', Name: "Dendi (Central African Republic)"},
+	{Code: "der", Scope: 'I', Name: "Deori"},
+	{Code: "des", Scope: 'I', Name: "Desano"},
+	{Code: "deu", Part2: "deu", Part1: "de", Scope: 'I', Name: "German"},
+	{Code: "dev", Scope: 'I', Name: "Domung"},
+	{Code: "dez", Scope: 'I', Name: "Dengese"},
+	{Code: "dga", Scope: 'I', Name: "Southern Dagaare"},
+	{Code: "dgb", Scope: 'I', Name: "Bunoge Dogon"},
+	{Code: "dgc", Scope: 'I', Name: "Casiguran Dumagat Agta"},
+	{Code: "dgd", Scope: 'I', Name: "Dagaari Dioula"},
+	{Code: "dge", Scope: 'I', Name: "Degenan"},
+	{Code: "dgg", Scope: 'I', Name: "Doga"},
+	{Code: "dgh", Scope: 'I', Name: "Dghwede"},
+	{Code: "dgi", Scope: 'I', Name: "Northern Dagara"},
+	{Code: "dgk", Scope: 'I', Name: "Dagba"},
+	{Code: "dgl", Scope: 'I', Name: "Andaandi"},
+	{Code: "dgn", Scope: 'I', Name: "Dagoman"},
+	{Code: "dgo", Scope: 'I', Macro: "doi", Name: "Dogri (individual language)"},
+	{Code: "dgr", Part2: "dgr", Scope: 'I', Name: "Dogrib"},
+	{Code: "dgs", Scope: 'I', Name: "Dogoso"},
+	{Code: "dgt", Scope: 'I', Name: "Ndra'ngith"},
+	{Code: "dgw", Scope: 'I', Name: "Daungwurrung"},
+	{Code: "dgx", Scope: 'I', Name: "Doghoro"},
+	{Code: "dgz", Scope: 'I', Name: "Daga"},
+	{Code: "dhd", Scope: 'I', Macro: "mwr", Name: "Dhundari"},
+	{Code: "dhg", Scope: 'I', Name: "Dhangu-Djangu"},
+	{Code: "dhi", Scope: 'I', Name: "Dhimal"},
+	{Code: "dhl", Scope: 'I', Name: "Dhalandji"},
+	{Code: "dhm", Scope: 'I', Name: "Zemba"},
+	{Code: "dhn", Scope: 'I', Name: "Dhanki"},
+	{Code: "dho", Scope: 'I', Name: "Dhodia"},
+	{Code: "dhr", Scope: 'I', Name: "Dhargari"},
+	{Code: "dhs", Scope: 'I', Name: "Dhaiso"},
+	{Code: "dhu", Scope: 'I', Name: "Dhurga"},
+	{Code: "dhv", Scope: 'I', Name: "Dehu"},
+	{Code: "dhw", Scope: 'I', Name: "Dhanwar (Nepal)"},
+	{Code: "dhx", Scope: 'I', Name: "Dhungaloo"},
+	{Code: "dia", Scope: 'I', Name: "Dia"},
+	{Code: "dib", Scope: 'I', Name: "South Central Dinka"},
+	{Code: "dic", Scope: 'I', Name: "Lakota Dida"},
+	{Code: "did", Scope: 'I', Name: "Didinga"},
+	{Code: "dif", Scope: 'I', Name: "Dieri"},
+	{Code: "dig", Scope: 'I', Name: "Digo"},
+	{Code: "dih", Scope: 'I', Name: "Kumiai"},
+	{Code: "dii", Scope: 'I', Name: "Dimbong"},
+	{Code: "dij", Scope: 'I', Name: "Dai"},
+	{Code: "dik", Scope: 'I', Macro: "din", Name: "Southwestern Dinka"},
+	{Code: "dil", Scope: 'I', Name: "Dilling"},
+	{Code: "dim", Scope: 'I', Name: "Dime"},
+	{Code: "din", Part2: "din", Scope: 'M', Name: "Dinka"},
+	{Code: "dio", Scope: 'I', Name: "Dibo"},
+	{Code: "dip", Scope: 'I', Name: "Northeastern Dinka"},
+	{Code: "diq", Scope: 'I', Macro: "zza", Name: "Dimli (individual language)"},
+	{Code: "dir", Scope: 'I', Name: "Dirim"},
+	{Code: "dis", Scope: 'I', Name: "Dimasa"},
+	{Code: "diu", Scope: 'I', Name: "Diriku"},
+	{Code: "div", Part2: "div", Part1: "dv", Scope: 'I', Name: "Dhivehi"},
+	{Code: "diw", Scope: 'I', Name: "Northwestern Dinka"},
+	{Code: "dix", Scope: 'I', Name: "Dixon Reef"},
+	{Code: "diy", Scope: 'I', Name: "Diuwe"},
+	{Code: "diz", Scope: 'I', Name: "Ding"},
+	{Code: "dja", Scope: 'I', Name: "Djadjawurrung"},
+	{Code: "djb", Scope: 'I', Name: "Djinba"},
+	{Code: "djc", Scope: 'I', Name: "Dar Daju Daju"},
+	{Code: "djd", Scope: 'I', Name: "Djamindjung"},
+	{Code: "dje", Scope: 'I', Name: "Zarma"},
+	{Code: "djf", Scope: 'I', Name: "Djangun"},
+	{Code: "dji", Scope: 'I', Name: "Djinang"},
+	{Code: "djj", Scope: 'I', Name: "Djeebbana"},
+	{Code: "djk", Scope: 'I', Name: "Eastern Maroon Creole"},
+	{Code: "djm", Scope: 'I', Name: "Jamsay Dogon"},
+	{Code: "djn", Scope: 'I', Name: "Jawoyn"},
+	{Code: "djo", Scope: 'I', Name: "Jangkang"},
+	{Code: "djr", Scope: 'I', Name: "Djambarrpuyngu"},
+	{Code: "dju", Scope: 'I', Name: "Kapriman"},
+	{Code: "djw", Scope: 'I', Name: "Djawi"},
+	{Code: "dka", Scope: 'I', Name: "Dakpakha"},
+	{Code: "dkg", Scope: 'I', Name: "Kadung"},
+	{Code: "dkk", Scope: 'I', Name: "Dakka"},
+	{Code: "dkr", Scope: 'I', Name: "Kuijau"},
+	{Code: "dks", Scope: 'I', Name: "Southeastern Dinka"},
+	{Code: "dkx", Scope: 'I', Name: "Mazagway"},
+	{Code: "dlg", Scope: 'I', Name: "Dolgan"},
+	{Code: "dlk", Scope: 'I', Name: "Dahalik"},
+	{Code: "dlm", Scope: 'I', Name: "Dalmatian"},
+	{Code: "dln", Scope: 'I', Name: "Darlong"},
+	{Code: "dma", Scope: 'I', Name: "Duma"},
+	{Code: "dmb", Scope: 'I', Name: "Mombo Dogon"},
+	{Code: "dmc", Scope: 'I', Name: "Gavak"},
+	{Code: "dmd", Scope: 'I', Name: "Madhi Madhi"},
+	{Code: "dme", Scope: 'I', Name: "Dugwor"},
+	{Code: "dmf", Scope: 'I', Name: "Medefaidrin"},
+	{Code: "dmg", Scope: 'I', Name: "Upper Kinabatangan"},
+	{Code: "dmk", Scope: 'I', Name: "Domaaki"},
+	{Code: "dml", Scope: 'I', Name: "Dameli"},
+	{Code: "dmm", Scope: 'I', Name: "Dama"},
+	{Code: "dmo", Scope: 'I', Name: "Kemedzung"},
+	{Code: "dmr", Scope: 'I', Name: "East Damar"},
+	{Code: "dms", Scope: 'I', Name: "Dampelas"},
+	{Code: "dmu", Scope: 'I', Name: "Dubu"},
+	{Code: "dmv", Scope: 'I', Name: "Dumpas"},
+	{Code: "dmw", Scope: 'I', Name: "Mudburra"},
+	{Code: "dmx", Scope: 'I', Name: "Dema"},
+	{Code: "dmy", Scope: 'I', Name: "Demta"},
+	{Code: "dna", Scope: 'I', Name: "Upper Grand Valley Dani"},
+	{Code: "dnd", Scope: 'I', Name: "Daonda"},
+	{Code: "dne", Scope: 'I', Name: "Ndendeule"},
+	{Code: "dng", Scope: 'I', Name: "Dungan"},
+	{Code: "dni", Scope: 'I', Name: "Lower Grand Valley Dani"},
+	{Code: "dnj", Scope: 'I', Name: "Dan"},
+	{Code: "dnk", Scope: 'I', Name: "Dengka"},
+	{Code: "dnn", Scope: 'I', Name: "Dzùùngoo"},
+	{Code: "dno", Scope: 'I', Name: "Ndrulo"},
+	{Code: "dnr", Scope: 'I', Name: "Danaru"},
+	{Code: "dnt", Scope: 'I', Name: "Mid Grand Valley Dani"},
+	{Code: "dnu", Scope: 'I', Name: "Danau"},
+	{Code: "dnv", Scope: 'I', Name: "Danu"},
+	{Code: "dnw", Scope: 'I', Name: "Western Dani"},
+	{Code: "dny", Scope: 'I', Name: "Dení"},
+	{Code: "doa", Scope: 'I', Name: "Dom"},
+	{Code: "dob", Scope: 'I', Name: "Dobu"},
+	{Code: "doc", Scope: 'I', Name: "Northern Dong"},
+	{Code: "doe", Scope: 'I', Name: "Doe"},
+	{Code: "dof", Scope: 'I', Name: "Domu"},
+	{Code: "doh", Scope: 'I', Name: "Dong"},
+	{Code: "doi", Part2: "doi", Scope: 'M', Name: "Dogri (macrolanguage)"},
+	{Code: "dok", Scope: 'I', Name: "Dondo"},
+	{Code: "dol", Scope: 'I', Name: "Doso"},
+	{Code: "don", Scope: 'I', Name: "Toura (Papua New Guinea)"},
+	{Code: "doo", Scope: 'I', Name: "Dongo"},
+	{Code: "dop", Scope: 'I', Name: "Lukpa"},
+	{Code: "doq", Scope: 'I', Name: "Dominican Sign Language"},
+	{Code: "dor", Scope: 'I', Name: "Dori'o"},
+	{Code: "dos", Scope: 'I', Name: "Dogosé"},
+	{Code: "dot", Scope: 'I', Name: "Dass"},
+	{Code: "dov", Scope: 'I', Name: "Dombe"},
+	{Code: "dow", Scope: 'I', Name: "Doyayo"},
+	{Code: "dox", Scope: 'I', Name: "Bussa"},
+	{Code: "doy", Scope: 'I', Name: "Dompo"},
+	{Code: "doz", Scope: 'I', Name: "Dorze"},
+	{Code: "dpp", Scope: 'I', Name: "Papar"},
+	{Code: "drb", Scope: 'I', Name: "Dair"},
+	{Code: "drc", Scope: 'I', Name: "Minderico"},
+	{Code: "drd", Scope: 'I', Name: "Darmiya"},
+	{Code: "dre", Scope: 'I', Name: "Dolpo"},
+	{Code: "drg", Scope: 'I', Name: "Rungus"},
+	{Code: "dri", Scope: 'I', Name: "C'Lela"},
+	{Code: "drl", Scope: 'I', Name: "Paakantyi"},
+	{Code: "drn", Scope: 'I', Name: "West Damar"},
+	{Code: "dro", Scope: 'I', Name: "Daro-Matu Melanau"},
+	{Code: "drq", Scope: 'I', Name: "Dura"},
+	{Code: "drs", Scope: 'I', Name: "Gedeo"},
+	{Code: "drt", Scope: 'I', Name: "Drents"},
+	{Code: "dru", Scope: 'I', Name: "Rukai"},
+	{Code: "dry", Scope: 'I', Name: "Darai"},
+	{Code: "dsb", Part2: "dsb", Scope: 'I', Name: "Lower Sorbian"},
+	{Code: "dse", Scope: 'I', Name: "Dutch Sign Language"},
+	{Code: "dsh", Scope: 'I', Name: "Daasanach"},
+	{Code: "dsi", Scope: 'I', Name: "Disa"},
+	{Code: "dsl", Scope: 'I', Name: "Danish Sign Language"},
+	{Code: "dsn", Scope: 'I', Name: "Dusner"},
+	{Code: "dso", Scope: 'I', Name: "Desiya"},
+	{Code: "dsq", Scope: 'I', Name: "Tadaksahak"},
+	{Code: "dsz", Scope: 'I', Name: "Mardin Sign Language"},
+	{Code: "dta", Scope: 'I', Name: "Daur"},
+	{Code: "dtb", Scope: 'I', Name: "Labuk-Kinabatangan Kadazan"},
+	{Code: "dtd", Scope: 'I', Name: "Ditidaht"},
+	{Code: "dth", Scope: 'I', Name: "Adithinngithigh"},
+	{Code: "dti", Scope: 'I', Name: "Ana Tinga Dogon"},
+	{Code: "dtk", Scope: 'I', Name: "Tene Kan Dogon"},
+	{Code: "dtm", Scope: 'I', Name: "Tomo Kan Dogon"},
+	{Code: "dtn", Scope: 'I', Name: "Daatsʼíin"},
+	{Code: "dto", Scope: 'I', Name: "Tommo So Dogon"},
+	{Code: "dtp", Scope: 'I', Name: "Kadazan Dusun"},
+	{Code: "dtr", Scope: 'I', Name: "Lotud"},
+	{Code: "dts", Scope: 'I', Name: "Toro So Dogon"},
+	{Code: "dtt", Scope: 'I', Name: "Toro Tegu Dogon"},
+	{Code: "dtu", Scope: 'I', Name: "Tebul Ure Dogon"},
+	{Code: "dty", Scope: 'I', Macro: "ne", Name: "Dotyali"},
+	{Code: "dua", Part2: "dua", Scope: 'I', Name: "Duala"},
+	{Code: "dub", Scope: 'I', Name: "Dubli"},
+	{Code: "duc", Scope: 'I', Name: "Duna"},
+	{Code: "due", Scope: 'I', Name: "Umiray Dumaget Agta"},
+	{Code: "duf", Scope: 'I', Name: "Dumbea"},
+	{Code: "dug", Scope: 'I', Name: "Duruma"},
+	{Code: "duh", Scope: 'I', Name: "Dungra Bhil"},
+	{Code: "dui", Scope: 'I', Name: "Dumun"},
+	{Code: "duk", Scope: 'I', Name: "Uyajitaya"},
+	{Code: "dul", Scope: 'I', Name: "Alabat Island Agta"},
+	{Code: "dum", Part2: "dum", Scope: 'I', Name: "Middle Dutch (ca. 1050-1350)"},
+	{Code: "dun", Scope: 'I', Name: "Dusun Deyah"},
+	{Code: "duo", Scope: 'I', Name: "Dupaninan Agta"},
+	{Code: "dup", Scope: 'I', Macro: "ms", Name: "Duano"},
+	{Code: "duq", Scope: 'I', Name: "Dusun Malang"},
+	{Code: "dur", Scope: 'I', Name: "Dii"},
+	{Code: "dus", Scope: 'I', Name: "Dumi"},
+	{Code: "duu", Scope: 'I', Name: "Drung"},
+	{Code: "duv", Scope: 'I', Name: "Duvle"},
+	{Code: "duw", Scope: 'I', Name: "Dusun Witu"},
+	{Code: "dux", Scope: 'I', Name: "Duungooma"},
+	{Code: "duy", Scope: 'I', Name: "Dicamay Agta"},
+	{Code: "duz", Scope: 'I', Name: "Duli-Gey"},
+	{Code: "dva", Scope: 'I', Name: "Duau"},
+	{Code: "dwa", Scope: 'I', Name: "Diri"},
+	{Code: "dwk", Scope: 'I', Name: "Dawik Kui"},
+	{Code: "dwr", Scope: 'I', Name: "Dawro"},
+	{Code: "dws", Scope: 'I', Name: "Dutton World Speedwords"},
+	{Code: "dwu", Scope: 'I', Name: "Dhuwal"},
+	{Code: "dww", Scope: 'I', Name: "Dawawa"},
+	{Code: "dwy", Scope: 'I', Name: "Dhuwaya"},
+	{Code: "dwz", Scope: 'I', Name: "Dewas Rai"},
+	{Code: "dya", Scope: 'I', Name: "Dyan"},
+	{Code: "dyb", Scope: 'I', Name: "Dyaberdyaber"},
+	{Code: "dyd", Scope: 'I', Name: "Dyugun"},
+	{Code: "dyg", Scope: 'I', Name: "Villa Viciosa Agta"},
+	{Code: "dyi", Scope: 'I', Name: "Djimini Senoufo"},
+	{Code: "dym", Scope: 'I', Name: "Yanda Dom Dogon"},
+	{Code: "dyn", Scope: 'I', Name: "Dyangadi"},
+	{Code: "dyo", Scope: 'I', Name: "Jola-Fonyi"},
+	{Code: "dyu", Part2: "dyu", Scope: 'I', Name: "Dyula"},
+	{Code: "dyy", Scope: 'I', Name: "Djabugay"},
+	{Code: "dza", Scope: 'I', Name: "Tunzu"},
+	{Code: "dze", Scope: 'I', Name: "Djiwarli"},
+	{Code: "dzg", Scope: 'I', Name: "Dazaga"},
+	{Code: "dzl", Scope: 'I', Name: "Dzalakha"},
+	{Code: "dzn", Scope: 'I', Name: "Dzando"},
+	{Code: "dzo", Part2: "dzo", Part1: "dz", Scope: 'I', Name: "Dzongkha"},
+	{Code: "eaa", Scope: 'I', Name: "Karenggapa"},
+	{Code: "ebc", Scope: 'I', Name: "Beginci"},
+	{Code: "ebg", Scope: 'I', Name: "Ebughu"},
+	{Code: "ebk", Scope: 'I', Name: "Eastern Bontok"},
+	{Code: "ebo", Scope: 'I', Name: "Teke-Ebo"},
+	{Code: "ebr", Scope: 'I', Name: "Ebrié"},
+	{Code: "ebu", Scope: 'I', Name: "Embu"},
+	{Code: "ecr", Scope: 'I', Name: "Eteocretan"},
+	{Code: "ecs", Scope: 'I', Name: "Ecuadorian Sign Language"},
+	{Code: "ecy", Scope: 'I', Name: "Eteocypriot"},
+	{Code: "eee", Scope: 'I', Name: "E"},
+	{Code: "efa", Scope: 'I', Name: "Efai"},
+	{Code: "efe", Scope: 'I', Name: "Efe"},
+	{Code: "efi", Part2: "efi", Scope: 'I', Name: "Efik"},
+	{Code: "ega", Scope: 'I', Name: "Ega"},
+	{Code: "egl", Scope: 'I', Name: "Emilian"},
+	{Code: "egm", Scope: 'I', Name: "Benamanga"},
+	{Code: "ego", Scope: 'I', Name: "Eggon"},
+	{Code: "egy", Part2: "egy", Scope: 'I', Name: "Egyptian (Ancient)"},
+	{Code: "ehs", Scope: 'I', Name: "Miyakubo Sign Language"},
+	{Code: "ehu", Scope: 'I', Name: "Ehueun"},
+	{Code: "eip", Scope: 'I', Name: "Eipomek"},
+	{Code: "eit", Scope: 'I', Name: "Eitiep"},
+	{Code: "eiv", Scope: 'I', Name: "Askopan"},
+	{Code: "eja", Scope: 'I', Name: "Ejamat"},
+	{Code: "eka", Part2: "eka", Scope: 'I', Name: "Ekajuk"},
+	{Code: "eke", Scope: 'I', Name: "Ekit"},
+	{Code: "ekg", Scope: 'I', Name: "Ekari"},
+	{Code: "eki", Scope: 'I', Name: "Eki"},
+	{Code: "ekk", Scope: 'I', Macro: "et", Name: "Standard Estonian"},
+	{Code: "ekl", Scope: 'I', Name: "Kol (Bangladesh)"},
+	{Code: "ekm", Scope: 'I', Name: "Elip"},
+	{Code: "eko", Scope: 'I', Name: "Koti"},
+	{Code: "ekp", Scope: 'I', Name: "Ekpeye"},
+	{Code: "ekr", Scope: 'I', Name: "Yace"},
+	{Code: "eky", Scope: 'I', Name: "Eastern Kayah"},
+	{Code: "ele", Scope: 'I', Name: "Elepi"},
+	{Code: "elh", Scope: 'I', Name: "El Hugeirat"},
+	{Code: "eli", Scope: 'I', Name: "Nding"},
+	{Code: "elk", Scope: 'I', Name: "Elkei"},
+	{Code: "ell", Part2: "ell", Part1: "el", Scope: 'I', Name: "Modern Greek (1453-)"},
+	{Code: "elm", Scope: 'I', Name: "Eleme"},
+	{Code: "elo", Scope: 'I', Name: "El Molo"},
+	{Code: "elu", Scope: 'I', Name: "Elu"},
+	{Code: "elx", Part2: "elx", Scope: 'I', Name: "Elamite"},
+	{Code: "ema", Scope: 'I', Name: "Emai-Iuleha-Ora"},
+	{Code: "emb", Scope: 'I', Name: "Embaloh"},
+	{Code: "eme", Scope: 'I', Name: "Emerillon"},
+	{Code: "emg", Scope: 'I', Name: "Eastern Meohang"},
+	{Code: "emi", Scope: 'I', Name: "Mussau-Emira"},
+	{Code: "emk", Scope: 'I', Macro: "man", Name: "Eastern Maninkakan"},
+	{Code: "emm", Scope: 'I', Name: "Mamulique"},
+	{Code: "emn", Scope: 'I', Name: "Eman"},
+	{Code: "emp", Scope: 'I', Name: "Northern Emberá"},
+	{Code: "emq", Scope: 'I', Name: "Eastern Minyag"},
+	{Code: "ems", Scope: 'I', Name: "Pacific Gulf Yupik"},
+	{Code: "emu", Scope: 'I', Name: "Eastern Muria"},
+	{Code: "emw", Scope: 'I', Name: "Emplawas"},
+	{Code: "emx", Scope: 'I', Name: "Erromintxela"},
+	{Code: "emy", Scope: 'I', Name: "Epigraphic Mayan"},
+	{Code: "emz", Scope: 'I', Name: "Mbessa"},
+	{Code: "ena", Scope: 'I', Name: "Apali"},
+	{Code: "enb", Scope: 'I', Name: "Markweeta"},
+	{Code: "enc", Scope: 'I', Name: "En"},
+	{Code: "end", Scope: 'I', Name: "Ende"},
+	{Code: "enf", Scope: 'I', Name: "Forest Enets"},
+	{Code: "eng", Part2: "eng", Part1: "en", Scope: 'I', Name: "English"},
+	{Code: "enh", Scope: 'I', Name: "Tundra Enets"},
+	{Code: "enl", Scope: 'I', Name: "Enlhet"},
+	{Code: "enm", Part2: "enm", Scope: 'I', Name: "Middle English (1100-1500)"},
+	{Code: "enn", Scope: 'I', Name: "Engenni"},
+	{Code: "eno", Scope: 'I', Name: "Enggano"},
+	{Code: "enq", Scope: 'I', Name: "Enga"},
+	{Code: "enr", Scope: 'I', Name: "Emumu"},
+	{Code: "enu", Scope: 'I', Name: "Enu"},
+	{Code: "env", Scope: 'I', Name: "Enwan (Edo State)"},
+	{Code: "enw", Scope: 'I', Name: "Enwan (Akwa Ibom State)"},
+	{Code: "enx", Scope: 'I', Name: "Enxet"},
+	{Code: "eot", Scope: 'I', Name: "Beti (Côte d'Ivoire)"},
+	{Code: "epi", Scope: 'I', Name: "Epie"},
+	{Code: "epo", Part2: "epo", Part1: "eo", Scope: 'I', Name: "Esperanto"},
+	{Code: "era", Scope: 'I', Name: "Eravallan"},
+	{Code: "erg", Scope: 'I', Name: "Sie"},
+	{Code: "erh", Scope: 'I', Name: "Eruwa"},
+	{Code: "eri", Scope: 'I', Name: "Ogea"},
+	{Code: "erk", Scope: 'I', Name: "South Efate"},
+	{Code: "ero", Scope: 'I', Name: "Horpa"},
+	{Code: "err", Scope: 'I', Name: "Erre"},
+	{Code: "ers", Scope: 'I', Name: "Ersu"},
+	{Code: "ert", Scope: 'I', Name: "Eritai"},
+	{Code: "erw", Scope: 'I', Name: "Erokwanas"},
+	{Code: "ese", Scope: 'I', Name: "Ese Ejja"},
+	{Code: "esg", Scope: 'I', Name: "Aheri Gondi"},
+	{Code: "esh", Scope: 'I', Name: "Eshtehardi"},
+	{Code: "esi", Scope: 'I', Macro: "ik", Name: "North Alaskan Inupiatun"},
+	{Code: "esk", Scope: 'I', Macro: "ik", Name: "Northwest Alaska Inupiatun"},
+	{Code: "esl", Scope: 'I', Name: "Egypt Sign Language"},
+	{Code: "esm", Scope: 'I', Name: "Esuma"},
+	{Code: "esn", Scope: 'I', Name: "Salvadoran Sign Language"},
+	{Code: "eso", Scope: 'I', Name: "Estonian Sign Language"},
+	{Code: "esq", Scope: 'I', Name: "Esselen"},
+	{Code: "ess", Scope: 'I', Name: "Central Siberian Yupik"},
+	{Code: "est", Part2: "est", Part1: "et", Scope: 'M', Name: "Estonian"},
+	{Code: "esu", Scope: 'I', Name: "Central Yupik"},
+	{Code: "esy", Scope: 'I', Name: "Eskayan"},
+	{Code: "etb", Scope: 'I', Name: "Etebi"},
+	{Code: "etc", Scope: 'I', Name: "Etchemin"},
+	{Code: "eth", Scope: 'I', Name: "Ethiopian Sign Language"},
+	{Code: "etn", Scope: 'I', Name: "Eton (Vanuatu)"},
+	{Code: "eto", Scope: 'I', Name: "Eton (Cameroon)"},
+	{Code: "etr", Scope: 'I', Name: "Edolo"},
+	{Code: "ets", Scope: 'I', Name: "Yekhee"},
+	{Code: "ett", Scope: 'I', Name: "Etruscan"},
+	{Code: "etu", Scope: 'I', Name: "Ejagham"},
+	{Code: "etx", Scope: 'I', Name: "Eten"},
+	{Code: "etz", Scope: 'I', Name: "Semimi"},
+	{Code: "eus", Part2: "eus", Part1: "eu", Scope: 'I', Name: "Basque"},
+	{Code: "eve", Scope: 'I', Name: "Even"},
+	{Code: "evh", Scope: 'I', Name: "Uvbie"},
+	{Code: "evn", Scope: 'I', Name: "Evenki"},
+	{Code: "ewe", Part2: "ewe", Part1: "ee", Scope: 'I', Name: "Ewe"},
+	{Code: "ewo", Part2: "ewo", Scope: 'I', Name: "Ewondo"},
+	{Code: "ext", Scope: 'I', Name: "Extremaduran"},
+	{Code: "eya", Scope: 'I', Name: "Eyak"},
+	{Code: "eyo", Scope: 'I', Name: "Keiyo"},
+	{Code: "eza", Scope: 'I', Name: "Ezaa"},
+	{Code: "eze", Scope: 'I', Name: "Uzekwe"},
+	{Code: "faa", Scope: 'I', Name: "Fasu"},
+	{Code: "fab", Scope: 'I', Name: "Fa d'Ambu"},
+	{Code: "fad", Scope: 'I', Name: "Wagi"},
+	{Code: "faf", Scope: 'I', Name: "Fagani"},
+	{Code: "fag", Scope: 'I', Name: "Finongan"},
+	{Code: "fah", Scope: 'I', Name: "Baissa Fali"},
+	{Code: "fai", Scope: 'I', Name: "Faiwol"},
+	{Code: "faj", Scope: 'I', Name: "Faita"},
+	{Code: "fak", Scope: 'I', Name: "Fang (Cameroon)"},
+	{Code: "fal", Scope: 'I', Name: "South Fali"},
+	{Code: "fam", Scope: 'I', Name: "Fam"},
+	{Code: "fan", Part2: "fan", Scope: 'I', Name: "Fang (Equatorial Guinea)"},
+	{Code: "fao", Part2: "fao", Part1: "fo", Scope: 'I', Name: "Faroese"},
+	{Code: "fap", Scope: 'I', Name: "Paloor"},
+	{Code: "far", Scope: 'I', Name: "Fataleka"},
+	{Code: "fas", Part2: "fas", Part1: "fa", Scope: 'M', Name: "Persian"},
+	{Code: "fat", Part2: "fat", Scope: 'I', Macro: "ak", Name: "Fanti"},
+	{Code: "fau", Scope: 'I', Name: "Fayu"},
+	{Code: "fax", Scope: 'I', Name: "Fala"},
+	{Code: "fay", Scope: 'I', Name: "Southwestern Fars"},
+	{Code: "faz", Scope: 'I', Name: "Northwestern Fars"},
+	{Code: "fbl", Scope: 'I', Name: "West Albay Bikol"},
+	{Code: "fcs", Scope: 'I', Name: "Quebec Sign Language"},
+	{Code: "fer", Scope: 'I', Name: "Feroge"},
+	{Code: "ffi", Scope: 'I', Name: "Foia Foia"},
+	{Code: "ffm", Scope: 'I', Macro: "ff", Name: "Maasina Fulfulde"},
+	{Code: "fgr", Scope: 'I', Name: "Fongoro"},
+	{Code: "fia", Scope: 'I', Name: "Nobiin"},
+	{Code: "fie", Scope: 'I', Name: "Fyer"},
+	{Code: "fif", Scope: 'I', Name: "Faifi"},
+	{Code: "fij", Part2: "fij", Part1: "fj", Scope: 'I', Name: "Fijian"},
+	{Code: "fil", Part2: "fil", Scope: 'I', Name: "Filipino"},
+	{Code: "fin", Part2: "fin", Part1: "fi", Scope: 'I', Name: "Finnish"},
+	{Code: "fip", Scope: 'I', Name: "Fipa"},
+	{Code: "fir", Scope: 'I', Name: "Firan"},
+	{Code: "fit", Scope: 'I', Name: "Tornedalen Finnish"},
+	{Code: "fiw", Scope: 'I', Name: "Fiwaga"},
+	{Code: "fkk", Scope: 'I', Name: "Kirya-Konzəl"},
+	{Code: "fkv", Scope: 'I', Name: "Kven Finnish"},
+	{Code: "fla", Scope: 'I', Name: "Kalispel-Pend d'Oreille"},
+	{Code: "flh", Scope: 'I', Name: "Foau"},
+	{Code: "fli", Scope: 'I', Name: "Fali"},
+	{Code: "fll", Scope: 'I', Name: "North Fali"},
+	{Code: "fln", Scope: 'I', Name: "Flinders Island"},
+	{Code: "flr", Scope: 'I', Name: "Fuliiru"},
+	{Code: "fly", Scope: 'I', Name: "Flaaitaal"},
+	{Code: "fmp", Scope: 'I', Name: "Fe'fe'"},
+	{Code: "fmu", Scope: 'I', Name: "Far Western Muria"},
+	{Code: "fnb", Scope: 'I', Name: "Fanbak"},
+	{Code: "fng", Scope: 'I', Name: "Fanagalo"},
+	{Code: "fni", Scope: 'I', Name: "Fania"},
+	{Code: "fod", Scope: 'I', Name: "Foodo"},
+	{Code: "foi", Scope: 'I', Name: "Foi"},
+	{Code: "fom", Scope: 'I', Name: "Foma"},
+	{Code: "fon", Part2: "fon", Scope: 'I', Name: "Fon"},
+	{Code: "for", Scope: 'I', Name: "Fore"},
+	{Code: "fos", Scope: 'I', Name: "Siraya"},
+	{Code: "fpe", Scope: 'I', Name: "Fernando Po Creole English"},
+	{Code: "fqs", Scope: 'I', Name: "Fas"},
+	{Code: "fra", Part2: "fra", Part1: "fr", Scope: 'I', Name: "French"},
+	{Code: "frc", Scope: 'I', Name: "Cajun French"},
+	{Code: "frd", Scope: 'I', Name: "Fordata"},
+	{Code: "frk", Scope: 'I', Name: "Frankish"},
+	{Code: "frm", Part2: "frm", Scope: 'I', Name: "Middle French (ca. 1400-1600)"},
+	{Code: "fro", Part2: "fro", Scope: 'I', Name: "Old French (842-ca. 1400)"},
+	{Code: "frp", Scope: 'I', Name: "Arpitan"},
+	{Code: "frq", Scope: 'I', Name: "Forak"},
+	{Code: "frr", Part2: "frr", Scope: 'I', Name: "Northern Frisian"},
+	{Code: "frs", Part2: "frs", Scope: 'I', Name: "Eastern Frisian"},
+	{Code: "frt", Scope: 'I', Name: "Fortsenal"},
+	{Code: "fry", Part2: "fry", Part1: "fy", Scope: 'I', Name: "Western Frisian"},
+	{Code: "fse", Scope: 'I', Name: "Finnish Sign Language"},
+	{Code: "fsl", Scope: 'I', Name: "French Sign Language"},
+	{Code: "fss", Scope: 'I', Name: "Finland-Swedish Sign Language"},
+	{Code: "fub", Scope: 'I', Macro: "ff", Name: "Adamawa Fulfulde"},
+	{Code: "fuc", Scope: 'I', Macro: "ff", Name: "Pulaar"},
+	{Code: "fud", Scope: 'I', Name: "East Futuna"},
+	{Code: "fue", Scope: 'I', Macro: "ff", Name: "Borgu Fulfulde"},
+	{Code: "fuf", Scope: 'I', Macro: "ff", Name: "Pular"},
+	{Code: "fuh", Scope: 'I', Macro: "ff", Name: "Western Niger Fulfulde"},
+	{Code: "fui", Scope: 'I', Macro: "ff", Name: "Bagirmi Fulfulde"},
+	{Code: "fuj", Scope: 'I', Name: "Ko"},
+	{Code: "ful", Part2: "ful", Part1: "ff", Scope: 'M', Name: "Fulah"},
+	{Code: "fum", Scope: 'I', Name: "Fum"},
+	{Code: "fun", Scope: 'I', Name: "Fulniô"},
+	{Code: "fuq", Scope: 'I', Macro: "ff", Name: "Central-Eastern Niger Fulfulde"},
+	{Code: "fur", Part2: "fur", Scope: 'I', Name: "Friulian"},
+	{Code: "fut", Scope: 'I', Name: "Futuna-Aniwa"},
+	{Code: "fuu", Scope: 'I', Name: "Furu"},
+	{Code: "fuv", Scope: 'I', Macro: "ff", Name: "Nigerian Fulfulde"},
+	{Code: "fuy", Scope: 'I', Name: "Fuyug"},
+	{Code: "fvr", Scope: 'I', Name: "Fur"},
+	{Code: "fwa", Scope: 'I', Name: "Fwâi"},
+	{Code: "fwe", Scope: 'I', Name: "Fwe"},
+	{Code: "gaa", Part2: "gaa", Scope: 'I', Name: "Ga"},
+	{Code: "gab", Scope: 'I', Name: "Gabri"},
+	{Code: "gac", Scope: 'I', Name: "Mixed Great Andamanese"},
+	{Code: "gad", Scope: 'I', Name: "Gaddang"},
+	{Code: "gae", Scope: 'I', Name: "Guarequena"},
+	{Code: "gaf", Scope: 'I', Name: "Gende"},
+	{Code: "gag", Scope: 'I', Name: "Gagauz"},
+	{Code: "gah", Scope: 'I', Name: "Alekano"},
+	{Code: "gai", Scope: 'I', Name: "Borei"},
+	{Code: "gaj", Scope: 'I', Name: "Gadsup"},
+	{Code: "gak", Scope: 'I', Name: "Gamkonora"},
+	{Code: "gal", Scope: 'I', Name: "Galolen"},
+	{Code: "gam", Scope: 'I', Name: "Kandawo"},
+	{Code: "gan", Scope: 'I', Macro: "zh", Name: "Gan Chinese"},
+	{Code: "gao", Scope: 'I', Name: "Gants"},
+	{Code: "gap", Scope: 'I', Name: "Gal"},
+	{Code: "gaq", Scope: 'I', Name: "Gata'"},
+	{Code: "gar", Scope: 'I', Name: "Galeya"},
+	{Code: "gas", Scope: 'I', Name: "Adiwasi Garasia"},
+	{Code: "gat", Scope: 'I', Name: "Kenati"},
+	{Code: "gau", Scope: 'I', Name: "Mudhili Gadaba"},
+	{Code: "gaw", Scope: 'I', Name: "Nobonob"},
+	{Code: "gax", Scope: 'I', Name: "Borana-Arsi-Guji Oromo"},
+	{Code: "gay", Part2: "gay", Scope: 'I', Name: "Gayo"},
+	{Code: "gaz", Scope: 'I', Macro: "om", Name: "West Central Oromo"},
+	{Code: "gba", Part2: "gba", Scope: 'M', Name: "Gbaya (Central African Republic)"},
+	{Code: "gbb", Scope: 'I', Name: "Kaytetye"},
+	{Code: "gbd", Scope: 'I', Name: "Karajarri"},
+	{Code: "gbe", Scope: 'I', Name: "Niksek"},
+	{Code: "gbf", Scope: 'I', Name: "Gaikundi"},
+	{Code: "gbg", Scope: 'I', Name: "Gbanziri"},
+	{Code: "gbh", Scope: 'I', Name: "Defi Gbe"},
+	{Code: "gbi", Scope: 'I', Name: "Galela"},
+	{Code: "gbj", Scope: 'I', Name: "Bodo Gadaba"},
+	{Code: "gbk", Scope: 'I', Name: "Gaddi"},
+	{Code: "gbl", Scope: 'I', Name: "Gamit"},
+	{Code: "gbm", Scope: 'I', Name: "Garhwali"},
+	{Code: "gbn", Scope: 'I', Name: "Mo'da"},
+	{Code: "gbo", Scope: 'I', Macro: "grb", Name: "Northern Grebo"},
+	{Code: "gbp", Scope: 'I', Name: "Gbaya-Bossangoa"},
+	{Code: "gbq", Scope: 'I', Name: "Gbaya-Bozoum"},
+	{Code: "gbr", Scope: 'I', Name: "Gbagyi"},
+	{Code: "gbs", Scope: 'I', Name: "Gbesi Gbe"},
+	{Code: "gbu", Scope: 'I', Name: "Gagadu"},
+	{Code: "gbv", Scope: 'I', Name: "Gbanu"},
+	{Code: "gbw", Scope: 'I', Name: "Gabi-Gabi"},
+	{Code: "gbx", Scope: 'I', Name: "Eastern Xwla Gbe"},
+	{Code: "gby", Scope: 'I', Name: "Gbari"},
+	{Code: "gbz", Scope: 'I', Name: "Zoroastrian Dari"},
+	{Code: "gcc", Scope: 'I', Name: "Mali"},
+	{Code: "gcd", Scope: 'I', Name: "Ganggalida"},
+	{Code: "gce", Scope: 'I', Name: "Galice"},
+	{Code: "gcf", Scope: 'I', Name: "Guadeloupean Creole French"},
+	{Code: "gcl", Scope: 'I', Name: "Grenadian Creole English"},
+	{Code: "gcn", Scope: 'I', Name: "Gaina"},
+	{Code: "gcr", Scope: 'I', Name: "Guianese Creole French"},
+	{Code: "gct", Scope: 'I', Name: "Colonia Tovar German"},
+	{Code: "gda", Scope: 'I', Name: "Gade Lohar"},
+	{Code: "gdb", Scope: 'I', Name: "Pottangi Ollar Gadaba"},
+	{Code: "gdc", Scope: 'I', Name: "Gugu Badhun"},
+	{Code: "gdd", Scope: 'I', Name: "Gedaged"},
+	{Code: "gde", Scope: 'I', Name: "Gude"},
+	{Code: "gdf", Scope: 'I', Name: "Guduf-Gava"},
+	{Code: "gdg", Scope: 'I', Name: "Ga'dang"},
+	{Code: "gdh", Scope: 'I', Name: "Gadjerawang"},
+	{Code: "gdi", Scope: 'I', Name: "Gundi"},
+	{Code: "gdj", Scope: 'I', Name: "Gurdjar"},
+	{Code: "gdk", Scope: 'I', Name: "Gadang"},
+	{Code: "gdl", Scope: 'I', Name: "Dirasha"},
+	{Code: "gdm", Scope: 'I', Name: "Laal"},
+	{Code: "gdn", Scope: 'I', Name: "Umanakaina"},
+	{Code: "gdo", Scope: 'I', Name: "Ghodoberi"},
+	{Code: "gdq", Scope: 'I', Name: "Mehri"},
+	{Code: "gdr", Scope: 'I', Name: "Wipi"},
+	{Code: "gds", Scope: 'I', Name: "Ghandruk Sign Language"},
+	{Code: "gdt", Scope: 'I', Name: "Kungardutyi"},
+	{Code: "gdu", Scope: 'I', Name: "Gudu"},
+	{Code: "gdx", Scope: 'I', Name: "Godwari"},
+	{Code: "gea", Scope: 'I', Name: "Geruma"},
+	{Code: "geb", Scope: 'I', Name: "Kire"},
+	{Code: "gec", Scope: 'I', Name: "Gboloo Grebo"},
+	{Code: "ged", Scope: 'I', Name: "Gade"},
+	{Code: "gef", Scope: 'I', Name: "Gerai"},
+	{Code: "geg", Scope: 'I', Name: "Gengle"},
+	{Code: "geh", Scope: 'I', Name: "Hutterite German"},
+	{Code: "gei", Scope: 'I', Name: "Gebe"},
+	{Code: "gej", Scope: 'I', Name: "Gen"},
+	{Code: "gek", Scope: 'I', Name: "Ywom"},
+	{Code: "gel", Scope: 'I', Name: "ut-Ma'in"},
+	{Code: "geq", Scope: 'I', Name: "Geme"},
+	{Code: "ges", Scope: 'I', Name: "Geser-Gorom"},
+	{Code: "gev", Scope: 'I', Name: "Eviya"},
+	{Code: "gew", Scope: 'I', Name: "Gera"},
+	{Code: "gex", Scope: 'I', Name: "Garre"},
+	{Code: "gey", Scope: 'I', Name: "Enya"},
+	{Code: "gez", Part2: "gez", Scope: 'I', Name: "Geez"},
+	{Code: "gfk", Scope: 'I', Name: "Patpatar"},
+	{Code: "gft", Scope: 'I', Name: "Gafat"},
+	{Code: "gga", Scope: 'I', Name: "Gao"},
+	{Code: "ggb", Scope: 'I', Name: "Gbii"},
+	{Code: "ggd", Scope: 'I', Name: "Gugadj"},
+	{Code: "gge", Scope: 'I', Name: "Gurr-goni"},
+	{Code: "ggg", Scope: 'I', Name: "Gurgula"},
+	{Code: "ggk", Scope: 'I', Name: "Kungarakany"},
+	{Code: "ggl", Scope: 'I', Name: "Ganglau"},
+	{Code: "ggt", Scope: 'I', Name: "Gitua"},
+	{Code: "ggu", Scope: 'I', Name: "Gagu"},
+	{Code: "ggw", Scope: 'I', Name: "Gogodala"},
+	{Code: "gha", Scope: 'I', Name: "Ghadamès"},
+	{Code: "ghc", Scope: 'I', Name: "Hiberno-Scottish Gaelic"},
+	{Code: "ghe", Scope: 'I', Name: "Southern Ghale"},
+	{Code: "ghh", Scope: 'I', Name: "Northern Ghale"},
+	{Code: "ghk", Scope: 'I', Name: "Geko Karen"},
+	{Code: "ghl", Scope: 'I', Name: "Ghulfan"},
+	{Code: "ghn", Scope: 'I', Name: "Ghanongga"},
+	{Code: "gho", Scope: 'I', Name: "Ghomara"},
+	{Code: "ghr", Scope: 'I', Name: "Ghera"},
+	{Code: "ghs", Scope: 'I', Name: "Guhu-Samane"},
+	{Code: "ght", Scope: 'I', Name: "Kuke"},
+	{Code: "gia", Scope: 'I', Name: "Kija"},
+	{Code: "gib", Scope: 'I', Name: "Gibanawa"},
+	{Code: "gic", Scope: 'I', Name: "Gail"},
+	{Code: "gid", Scope: 'I', Name: "Gidar"},
+	{Code: "gie", Scope: 'I', Name: "Gaɓogbo"},
+	{Code: "gig", Scope: 'I', Name: "Goaria"},
+	{Code: "gih", Scope: 'I', Name: "Githabul"},
+	{Code: "gii", Scope: 'I', Name: "Girirra"},
+	{Code: "gil", Part2: "gil", Scope: 'I', Name: "Gilbertese"},
+	{Code: "gim", Scope: 'I', Name: "Gimi (Eastern Highlands)"},
+	{Code: "gin", Scope: 'I', Name: "Hinukh"},
+	{Code: "gip", Scope: 'I', Name: "Gimi (West New Britain)"},
+	{Code: "giq", Scope: 'I', Name: "Green Gelao"},
+	{Code: "gir", Scope: 'I', Name: "Red Gelao"},
+	{Code: "gis", Scope: 'I', Name: "North Giziga"},
+	{Code: "git", Scope: 'I', Name: "Gitxsan"},
+	{Code: "giu", Scope: 'I', Name: "Mulao"},
+	{Code: "giw", Scope: 'I', Name: "White Gelao"},
+	{Code: "gix", Scope: 'I', Name: "Gilima"},
+	{Code: "giy", Scope: 'I', Name: "Giyug"},
+	{Code: "giz", Scope: 'I', Name: "South Giziga"},
+	{Code: "gjk", Scope: 'I', Name: "Kachi Koli"},
+	{Code: "gjm", Scope: 'I', Name: "Gunditjmara"},
+	{Code: "gjn", Scope: 'I', Name: "Gonja"},
+	{Code: "gjr", Scope: 'I', Name: "Gurindji Kriol"},
+	{Code: "gju", Scope: 'I', Name: "Gujari"},
+	{Code: "gka", Scope: 'I', Name: "Guya"},
+	{Code: "gkd", Scope: 'I', Name: "Magɨ (Madang Province)"},
+	{Code: "gke", Scope: 'I', Name: "Ndai"},
+	{Code: "gkn", Scope: 'I', Name: "Gokana"},
+	{Code: "gko", Scope: 'I', Name: "Kok-Nar"},
+	{Code: "gkp", Scope: 'I', Name: "Guinea Kpelle"},
+	{Code: "gku", Scope: 'I', Name: "ǂUngkue"},
+	{Code: "gla", Part2: "gla", Part1: "gd", Scope: 'I', Name: "Scottish Gaelic"},
+	{Code: "glb", Scope: 'I', Name: "Belning"},
+	{Code: "glc", Scope: 'I', Name: "Bon Gula"},
+	{Code: "gld", Scope: 'I', Name: "Nanai"},
+	{Code: "gle", Part2: "gle", Part1: "ga", Scope: 'I', Name: "Irish"},
+	{Code: "glg", Part2: "glg", Part1: "gl", Scope: 'I', Name: "Galician"},
+	{Code: "glh", Scope: 'I', Name: "Northwest Pashai"},
+	{Code: "glj", Scope: 'I', Name: "Gula Iro"},
+	{Code: "glk", Scope: 'I', Name: "Gilaki"},
+	{Code: "gll", Scope: 'I', Name: "Garlali"},
+	{Code: "glo", Scope: 'I', Name: "Galambu"},
+	{Code: "glr", Scope: 'I', Name: "Glaro-Twabo"},
+	{Code: "glu", Scope: 'I', Name: "Gula (Chad)"},
+	{Code: "glv", Part2: "glv", Part1: "gv", Scope: 'I', Name: "Manx"},
+	{Code: "glw", Scope: 'I', Name: "Glavda"},
+	{Code: "gly", Scope: 'I', Name: "Gule"},
+	{Code: "gma", Scope: 'I', Name: "Gambera"},
+	{Code: "gmb", Scope: 'I', Name: "Gula'alaa"},
+	{Code: "gmd", Scope: 'I', Name: "Mághdì"},
+	{Code: "gmg", Scope: 'I', Name: "Magɨyi"},
+	{Code: "gmh", Part2: "gmh", Scope: 'I', Name: "Middle High German (ca. 1050-1500)"},
+	{Code: "gml", Scope: 'I', Name: "Middle Low German"},
+	{Code: "gmm", Scope: 'I', Name: "Gbaya-Mbodomo"},
+	{Code: "gmn", Scope: 'I', Name: "Gimnime"},
+	{Code: "gmr", Scope: 'I', Name: "Mirning"},
+	{Code: "gmu", Scope: 'I', Name: "Gumalu"},
+	{Code: "gmv", Scope: 'I', Name: "Gamo"},
+	{Code: "gmx", Scope: 'I', Name: "Magoma"},
+	{Code: "gmy", Scope: 'I', Name: "Mycenaean Greek"},
+	{Code: "gmz", Scope: 'I', Name: "Mgbolizhia"},
+	{Code: "gna", Scope: 'I', Name: "Kaansa"},
+	{Code: "gnb", Scope: 'I', Name: "Gangte"},
+	{Code: "gnc", Scope: 'I', Name: "Guanche"},
+	{Code: "gnd", Scope: 'I', Name: "Zulgo-Gemzek"},
+	{Code: "gne", Scope: 'I', Name: "Ganang"},
+	{Code: "gng", Scope: 'I', Name: "Ngangam"},
+	{Code: "gnh", Scope: 'I', Name: "Lere"},
+	{Code: "gni", Scope: 'I', Name: "Gooniyandi"},
+	{Code: "gnj", Scope: 'I', Name: "Ngen"},
+	{Code: "gnk", Scope: 'I', Name: "ǁGana"},
+	{Code: "gnl", Scope: 'I', Name: "Gangulu"},
+	{Code: "gnm", Scope: 'I', Name: "Ginuman"},
+	{Code: "gnn", Scope: 'I', Name: "Gumatj"},
+	{Code: "gno", Scope: 'I', Macro: "gon", Name: "Northern Gondi"},
+	{Code: "gnq", Scope: 'I', Name: "Gana"},
+	{Code: "gnr", Scope: 'I', Name: "Gureng Gureng"},
+	{Code: "gnt", Scope: 'I', Name: "Guntai"},
+	{Code: "gnu", Scope: 'I', Name: "Gnau"},
+	{Code: "gnw", Scope: 'I', Macro: "gn", Name: "Western Bolivian Guaraní"},
+	{Code: "gnz", Scope: 'I', Name: "Ganzi"},
+	{Code: "goa", Scope: 'I', Name: "Guro"},
+	{Code: "gob", Scope: 'I', Name: "Playero"},
+	{Code: "goc", Scope: 'I', Name: "Gorakor"},
+	{Code: "god", Scope: 'I', Name: "Godié"},
+	{Code: "goe", Scope: 'I', Name: "Gongduk"},
+	{Code: "gof", Scope: 'I', Name: "Gofa"},
+	{Code: "gog", Scope: 'I', Name: "Gogo"},
+	{Code: "goh", Part2: "goh", Scope: 'I', Name: "Old High German (ca. 750-1050)"},
+	{Code: "goi", Scope: 'I', Name: "Gobasi"},
+	{Code: "goj", Scope: 'I', Name: "Gowlan"},
+	{Code: "gok", Scope: 'I', Name: "Gowli"},
+	{Code: "gol", Scope: 'I', Name: "Gola"},
+	{Code: "gom", Scope: 'I', Macro: "kok", Name: "Goan Konkani"},
+	{Code: "gon", Part2: "gon", Scope: 'M', Name: "Gondi"},
+	{Code: "goo", Scope: 'I', Name: "Gone Dau"},
+	{Code: "gop", Scope: 'I', Name: "Yeretuar"},
+	{Code: "goq", Scope: 'I', Name: "Gorap"},
+	{Code: "gor", Part2: "gor", Scope: 'I', Name: "Gorontalo"},
+	{Code: "gos", Scope: 'I', Name: "Gronings"},
+	{Code: "got", Part2: "got", Scope: 'I', Name: "Gothic"},
+	{Code: "gou", Scope: 'I', Name: "Gavar"},
+	{Code: "gov", Scope: 'I', Name: "Goo"},
+	{Code: "gow", Scope: 'I', Name: "Gorowa"},
+	{Code: "gox", Scope: 'I', Name: "Gobu"},
+	{Code: "goy", Scope: 'I', Name: "Goundo"},
+	{Code: "goz", Scope: 'I', Name: "Gozarkhani"},
+	{Code: "gpa", Scope: 'I', Name: "Gupa-Abawa"},
+	{Code: "gpe", Scope: 'I', Name: "Ghanaian Pidgin English"},
+	{Code: "gpn", Scope: 'I', Name: "Taiap"},
+	{Code: "gqa", Scope: 'I', Name: "Ga'anda"},
+	{Code: "gqi", Scope: 'I', Name: "Guiqiong"},
+	{Code: "gqn", Scope: 'I', Name: "Guana (Brazil)"},
+	{Code: "gqr", Scope: 'I', Name: "Gor"},
+	{Code: "gqu", Scope: 'I', Name: "Qau"},
+	{Code: "gra", Scope: 'I', Name: "Rajput Garasia"},
+	{Code: "grb", Part2: "grb", Scope: 'M', Name: "Grebo"},
+	{Code: "grc", Part2: "grc", Scope: 'I', Name: "Ancient Greek (to 1453)"},
+	{Code: "grd", Scope: 'I', Name: "Guruntum-Mbaaru"},
+	{Code: "grg", Scope: 'I', Name: "Madi"},
+	{Code: "grh", Scope: 'I', Name: "Gbiri-Niragu"},
+	{Code: "gri", Scope: 'I', Name: "Ghari"},
+	{Code: "grj", Scope: 'I', Name: "Southern Grebo"},
+	{Code: "grm", Scope: 'I', Name: "Kota Marudu Talantang"},
+	{Code: "grn", Part2: "grn", Part1: "gn", Scope: 'M', Name: "Guarani"},
+	{Code: "gro", Scope: 'I', Name: "Groma"},
+	{Code: "grq", Scope: 'I', Name: "Gorovu"},
+	{Code: "grr", Scope: 'I', Name: "Taznatit"},
+	{Code: "grs", Scope: 'I', Name: "Gresi"},
+	{Code: "grt", Scope: 'I', Name: "Garo"},
+	{Code: "gru", Scope: 'I', Name: "Kistane"},
+	{Code: "grv", Scope: 'I', Name: "Central Grebo"},
+	{Code: "grw", Scope: 'I', Name: "Gweda"},
+	{Code: "grx", Scope: 'I', Name: "Guriaso"},
+	{Code: "gry", Scope: 'I', Name: "Barclayville Grebo"},
+	{Code: "grz", Scope: 'I', Name: "Guramalum"},
+	{Code: "gse", Scope: 'I', Name: "Ghanaian Sign Language"},
+	{Code: "gsg", Scope: 'I', Name: "German Sign Language"},
+	{Code: "gsl", Scope: 'I', Name: "Gusilay"},
+	{Code: "gsm", Scope: 'I', Name: "Guatemalan Sign Language"},
+	{Code: "gsn", Scope: 'I', Name: "Nema"},
+	{Code: "gso", Scope: 'I', Name: "Southwest Gbaya"},
+	{Code: "gsp", Scope: 'I', Name: "Wasembo"},
+	{Code: "gss", Scope: 'I', Name: "Greek Sign Language"},
+	{Code: "gsw", Part2: "gsw", Scope: 'I', Name: "Swiss German"},
+	{Code: "gta", Scope: 'I', Name: "Guató"},
+	{Code: "gtu", Scope: 'I', Name: "Aghu-Tharnggala"},
+	{Code: "gua", Scope: 'I', Name: "Shiki"},
+	{Code: "gub", Scope: 'I', Name: "Guajajára"},
+	{Code: "guc", Scope: 'I', Name: "Wayuu"},
+	{Code: "gud", Scope: 'I', Name: "Yocoboué Dida"},
+	{Code: "gue", Scope: 'I', Name: "Gurindji"},
+	{Code: "guf", Scope: 'I', Name: "Gupapuyngu"},
+	{Code: "gug", Scope: 'I', Macro: "gn", Name: "Paraguayan Guaraní"},
+	{Code: "guh", Scope: 'I', Name: "Guahibo"},
+	{Code: "gui", Scope: 'I', Macro: "gn", Name: "Eastern Bolivian Guaraní"},
+	{Code: "guj", Part2: "guj", Part1: "gu", Scope: 'I', Name: "Gujarati"},
+	{Code: "guk", Scope: 'I', Name: "Gumuz"},
+	{Code: "gul", Scope: 'I', Name: "Sea Island Creole English"},
+	{Code: "gum", Scope: 'I', Name: "Guambiano"},
+	{Code: "gun", Scope: 'I', Macro: "gn", Name: "Mbyá Guaraní"},
+	{Code: "guo", Scope: 'I', Name: "Guayabero"},
+	{Code: "gup", Scope: 'I', Name: "Gunwinggu"},
+	{Code: "guq", Scope: 'I', Name: "Aché"},
+	{Code: "gur", Scope: 'I', Name: "Farefare"},
+	{Code: "gus", Scope: 'I', Name: "Guinean Sign Language"},
+	{Code: "gut", Scope: 'I', Name: "Maléku Jaíka"},
+	{Code: "guu", Scope: 'I', Name: "Yanomamö"},
+	{Code: "guw", Scope: 'I', Name: "Gun"},
+	{Code: "gux", Scope: 'I', Name: "Gourmanchéma"},
+	{Code: "guz", Scope: 'I', Name: "Gusii"},
+	{Code: "gva", Scope: 'I', Name: "Guana (Paraguay)"},
+	{Code: "gvc", Scope: 'I', Name: "Guanano"},
+	{Code: "gve", Scope: 'I', Name: "Duwet"},
+	{Code: "gvf", Scope: 'I', Name: "Golin"},
+	{Code: "gvj", Scope: 'I', Name: "Guajá"},
+	{Code: "gvl", Scope: 'I', Name: "Gulay"},
+	{Code: "gvm", Scope: 'I', Name: "Gurmana"},
+	{Code: "gvn", Scope: 'I', Name: "Kuku-Yalanji"},
+	{Code: "gvo", Scope: 'I', Name: "Gavião Do Jiparaná"},
+	{Code: "gvp", Scope: 'I', Name: "Pará Gavião"},
+	{Code: "gvr", Scope: 'I', Name: "Gurung"},
+	{Code: "gvs", Scope: 'I', Name: "Gumawana"},
+	{Code: "gvy", Scope: 'I', Name: "Guyani"},
+	{Code: "gwa", Scope: 'I', Name: "Mbato"},
+	{Code: "gwb", Scope: 'I', Name: "Gwa"},
+	{Code: "gwc", Scope: 'I', Name: "Gawri"},
+	{Code: "gwd", Scope: 'I', Name: "Gawwada"},
+	{Code: "gwe", Scope: 'I', Name: "Gweno"},
+	{Code: "gwf", Scope: 'I', Name: "Gowro"},
+	{Code: "gwg", Scope: 'I', Name: "Moo"},
+	{Code: "gwi", Part2: "gwi", Scope: 'I', Name: "Gwichʼin"},
+	{Code: "gwj", Scope: 'I', Name: "ǀGwi"},
+	{Code: "gwm", Scope: 'I', Name: "Awngthim"},
+	{Code: "gwn", Scope: 'I', Name: "Gwandara"},
+	{Code: "gwr", Scope: 'I', Name: "Gwere"},
+	{Code: "gwt", Scope: 'I', Name: "Gawar-Bati"},
+	{Code: "gwu", Scope: 'I', Name: "Guwamu"},
+	{Code: "gww", Scope: 'I', Name: "Kwini"},
+	{Code: "gwx", Scope: 'I', Name: "Gua"},
+	{Code: "gxx", Scope: 'I', Name: "Wè Southern"},
+	{Code: "gya", Scope: 'I', Macro: "gba", Name: "Northwest Gbaya"},
+	{Code: "gyb", Scope: 'I', Name: "Garus"},
+	{Code: "gyd", Scope: 'I', Name: "Kayardild"},
+	{Code: "gye", Scope: 'I', Name: "Gyem"},
+	{Code: "gyf", Scope: 'I', Name: "Gungabula"},
+	{Code: "gyg", Scope: 'I', Name: "Gbayi"},
+	{Code: "gyi", Scope: 'I', Name: "Gyele"},
+	{Code: "gyl", Scope: 'I', Name: "Gayil"},
+	{Code: "gym", Scope: 'I', Name: "Ngäbere"},
+	{Code: "gyn", Scope: 'I', Name: "Guyanese Creole English"},
+	{Code: "gyo", Scope: 'I', Name: "Gyalsumdo"},
+	{Code: "gyr", Scope: 'I', Name: "Guarayu"},
+	{Code: "gyy", Scope: 'I', Name: "Gunya"},
+	{Code: "gyz", Scope: 'I', Name: "Geji"},
+	{Code: "gza", Scope: 'I', Name: "Ganza"},
+	{Code: "gzi", Scope: 'I', Name: "Gazi"},
+	{Code: "gzn", Scope: 'I', Name: "Gane"},
+	{Code: "haa", Scope: 'I', Name: "Han"},
+	{Code: "hab", Scope: 'I', Name: "Hanoi Sign Language"},
+	{Code: "hac", Scope: 'I', Name: "Gurani"},
+	{Code: "had", Scope: 'I', Name: "Hatam"},
+	{Code: "hae", Scope: 'I', Name: "Eastern Oromo"},
+	{Code: "haf", Scope: 'I', Name: "Haiphong Sign Language"},
+	{Code: "hag", Scope: 'I', Name: "Hanga"},
+	{Code: "hah", Scope: 'I', Name: "Hahon"},
+	{Code: "hai", Part2: "hai", Scope: 'M', Name: "Haida"},
+	{Code: "haj", Scope: 'I', Name: "Hajong"},
+	{Code: "hak", Scope: 'I', Macro: "zh", Name: "Hakka Chinese"},
+	{Code: "hal", Scope: 'I', Name: "Halang"},
+	{Code: "ham", Scope: 'I', Name: "Hewa"},
+	{Code: "han", Scope: 'I', Name: "Hangaza"},
+	{Code: "hao", Scope: 'I', Name: "Hakö"},
+	{Code: "hap", Scope: 'I', Name: "Hupla"},
+	{Code: "haq", Scope: 'I', Name: "Ha"},
+	{Code: "har", Scope: 'I', Name: "Harari"},
+	{Code: "has", Scope: 'I', Name: "Haisla"},
+	{Code: "hat", Part2: "hat", Part1: "ht", Scope: 'I', Name: "Haitian"},
+	{Code: "hau", Part2: "hau", Part1: "ha", Scope: 'I', Name: "Hausa"},
+	{Code: "hav", Scope: 'I', Name: "Havu"},
+	{Code: "haw", Part2: "haw", Scope: 'I', Name: "Hawaiian"},
+	{Code: "hax", Scope: 'I', Name: "Southern Haida"},
+	{Code: "hay", Scope: 'I', Name: "Haya"},
+	{Code: "haz", Scope: 'I', Name: "Hazaragi"},
+	{Code: "hba", Scope: 'I', Name: "Hamba"},
+	{Code: "hbb", Scope: 'I', Name: "Huba"},
+	{Code: "hbn", Scope: 'I', Name: "Heiban"},
+	{Code: "hbo", Scope: 'I', Name: "Ancient Hebrew"},
+	{Code: "hbs", Part1: "sh", Scope: 'M', Name: "Serbo-Croatian"},
+	{Code: "hbu", Scope: 'I', Name: "Habu"},
+	{Code: "hca", Scope: 'I', Name: "Andaman Creole Hindi"},
+	{Code: "hch", Scope: 'I', Name: "Huichol"},
+	{Code: "hdn", Scope: 'I', Macro: "hai", Name: "Northern Haida"},
+	{Code: "hds", Scope: 'I', Name: "Honduras Sign Language"},
+	{Code: "hdy", Scope: 'I', Name: "Hadiyya"},
+	{Code: "hea", Scope: 'I', Macro: "hmn", Name: "Northern Qiandong Miao"},
+	{Code: "heb", Part2: "heb", Part1: "he", Scope: 'I', Name: "Hebrew"},
+	{Code: "hed", Scope: 'I', Name: "Herdé"},
+	{Code: "heg", Scope: 'I', Name: "Helong"},
+	{Code: "heh", Scope: 'I', Name: "Hehe"},
+	{Code: "hei", Scope: 'I', Name: "Heiltsuk"},
+	{Code: "hem", Scope: 'I', Name: "Hemba"},
+	{Code: "her", Part2: "her", Part1: "hz", Scope: 'I', Name: "Herero"},
+	{Code: "hgm", Scope: 'I', Name: "Haiǁom"},
+	{Code: "hgw", Scope: 'I', Name: "Haigwai"},
+	{Code: "hhi", Scope: 'I', Name: "Hoia Hoia"},
+	{Code: "hhr", Scope: 'I', Name: "Kerak"},
+	{Code: "hhy", Scope: 'I', Name: "Hoyahoya"},
+	{Code: "hia", Scope: 'I', Name: "Lamang"},
+	{Code: "hib", Scope: 'I', Name: "Hibito"},
+	{Code: "hid", Scope: 'I', Name: "Hidatsa"},
+	{Code: "hif", Scope: 'I', Name: "Fiji Hindi"},
+	{Code: "hig", Scope: 'I', Name: "Kamwe"},
+	{Code: "hih", Scope: 'I', Name: "Pamosu"},
+	{Code: "hii", Scope: 'I', Name: "Hinduri"},
+	{Code: "hij", Scope: 'I', Name: "Hijuk"},
+	{Code: "hik", Scope: 'I', Name: "Seit-Kaitetu"},
+	{Code: "hil", Part2: "hil", Scope: 'I', Name: "Hiligaynon"},
+	{Code: "hin", Part2: "hin", Part1: "hi", Scope: 'I', Name: "Hindi"},
+	{Code: "hio", Scope: 'I', Name: "Tsoa"},
+	{Code: "hir", Scope: 'I', Name: "Himarimã"},
+	{Code: "hit", Part2: "hit", Scope: 'I', Name: "Hittite"},
+	{Code: "hiw", Scope: 'I', Name: "Hiw"},
+	{Code: "hix", Scope: 'I', Name: "Hixkaryána"},
+	{Code: "hji", Scope: 'I', Macro: "ms", Name: "Haji"},
+	{Code: "hka", Scope: 'I', Name: "Kahe"},
+	{Code: "hke", Scope: 'I', Name: "Hunde"},
+	{Code: "hkh", Scope: 'I', Name: "Khah"},
+	{Code: "hkk", Scope: 'I', Name: "Hunjara-Kaina Ke"},
+	{Code: "hkn", Scope: 'I', Name: "Mel-Khaonh"},
+	{Code: "hks", Scope: 'I', Name: "Hong Kong Sign Language"},
+	{Code: "hla", Scope: 'I', Name: "Halia"},
+	{Code: "hlb", Scope: 'I', Name: "Halbi"},
+	{Code: "hld", Scope: 'I', Name: "Halang Doan"},
+	{Code: "hle", Scope: 'I', Name: "Hlersu"},
+	{Code: "hlt", Scope: 'I', Name: "Matu Chin"},
+	{Code: "hlu", Scope: 'I', Name: "Hieroglyphic Luwian"},
+	{Code: "hma", Scope: 'I', Name: "Southern Mashan Hmong"},
+	{Code: "hmb", Scope: 'I', Name: "Humburi Senni Songhay"},
+	{Code: "hmc", Scope: 'I', Name: "Central Huishui Hmong"},
+	{Code: "hmd", Scope: 'I', Name: "Large Flowery Miao"},
+	{Code: "hme", Scope: 'I', Name: "Eastern Huishui Hmong"},
+	{Code: "hmf", Scope: 'I', Name: "Hmong Don"},
+	{Code: "hmg", Scope: 'I', Name: "Southwestern Guiyang Hmong"},
+	{Code: "hmh", Scope: 'I', Name: "Southwestern Huishui Hmong"},
+	{Code: "hmi", Scope: 'I', Name: "Northern Huishui Hmong"},
+	{Code: "hmj", Scope: 'I', Name: "Ge"},
+	{Code: "hmk", Scope: 'I', Name: "Maek"},
+	{Code: "hml", Scope: 'I', Name: "Luopohe Hmong"},
+	{Code: "hmm", Scope: 'I', Name: "Central Mashan Hmong"},
+	{Code: "hmn", Part2: "hmn", Scope: 'M', Name: "Hmong"},
+	{Code: "hmo", Part2: "hmo", Part1: "ho", Scope: 'I', Name: "Hiri Motu"},
+	{Code: "hmp", Scope: 'I', Name: "Northern Mashan Hmong"},
+	{Code: "hmq", Scope: 'I', Name: "Eastern Qiandong Miao"},
+	{Code: "hmr", Scope: 'I', Name: "Hmar"},
+	{Code: "hms", Scope: 'I', Name: "Southern Qiandong Miao"},
+	{Code: "hmt", Scope: 'I', Name: "Hamtai"},
+	{Code: "hmu", Scope: 'I', Name: "Hamap"},
+	{Code: "hmv", Scope: 'I', Name: "Hmong Dô"},
+	{Code: "hmw", Scope: 'I', Name: "Western Mashan Hmong"},
+	{Code: "hmy", Scope: 'I', Name: "Southern Guiyang Hmong"},
+	{Code: "hmz", Scope: 'I', Name: "Hmong Shua"},
+	{Code: "hna", Scope: 'I', Name: "Mina (Cameroon)"},
+	{Code: "hnd", Scope: 'I', Name: "Southern Hindko"},
+	{Code: "hne", Scope: 'I', Name: "Chhattisgarhi"},
+	{Code: "hng", Scope: 'I', Name: "Hungu"},
+	{Code: "hnh", Scope: 'I', Name: "ǁAni"},
+	{Code: "hni", Scope: 'I', Name: "Hani"},
+	{Code: "hnj", Scope: 'I', Name: "Hmong Njua"},
+	{Code: "hnn", Scope: 'I', Name: "Hanunoo"},
+	{Code: "hno", Scope: 'I', Name: "Northern Hindko"},
+	{Code: "hns", Scope: 'I', Name: "Caribbean Hindustani"},
+	{Code: "hnu", Scope: 'I', Name: "Hung"},
+	{Code: "hoa", Scope: 'I', Name: "Hoava"},
+	{Code: "hob", Scope: 'I', Name: "Mari (Madang Province)"},
+	{Code: "hoc", Scope: 'I', Name: "Ho"},
+	{Code: "hod", Scope: 'I', Name: "Holma"},
+	{Code: "hoe", Scope: 'I', Name: "Horom"},
+	{Code: "hoh", Scope: 'I', Name: "Hobyót"},
+	{Code: "hoi", Scope: 'I', Name: "Holikachuk"},
+	{Code: "hoj", Scope: 'I', Name: "Hadothi"},
+	{Code: "hol", Scope: 'I', Name: "Holu"},
+	{Code: "hom", Scope: 'I', Name: "Homa"},
+	{Code: "hoo", Scope: 'I', Name: "Holoholo"},
+	{Code: "hop", Scope: 'I', Name: "Hopi"},
+	{Code: "hor", Scope: 'I', Name: "Horo"},
+	{Code: "hos", Scope: 'I', Name: "Ho Chi Minh City Sign Language"},
+	{Code: "hot", Scope: 'I', Name: "Hote"},
+	{Code: "hov", Scope: 'I', Name: "Hovongan"},
+	{Code: "how", Scope: 'I', Name: "Honi"},
+	{Code: "hoy", Scope: 'I', Name: "Holiya"},
+	{Code: "hoz", Scope: 'I', Name: "Hozo"},
+	{Code: "hpo", Scope: 'I', Name: "Hpon"},
+	{Code: "hps", Scope: 'I', Name: "Hawai'i Sign Language (HSL)"},
+	{Code: "hra", Scope: 'I', Name: "Hrangkhol"},
+	{Code: "hrc", Scope: 'I', Name: "Niwer Mil"},
+	{Code: "hre", Scope: 'I', Name: "Hre"},
+	{Code: "hrk", Scope: 'I', Name: "Haruku"},
+	{Code: "hrm", Scope: 'I', Name: "Horned Miao"},
+	{Code: "hro", Scope: 'I', Name: "Haroi"},
+	{Code: "hrp", Scope: 'I', Name: "Nhirrpi"},
+	{Code: "hrt", Scope: 'I', Name: "Hértevin"},
+	{Code: "hru", Scope: 'I', Name: "Hruso"},
+	{Code: "hrv", Part2: "hrv", Part1: "hr", Scope: 'I', Macro: "sh", Name: "Croatian"},
+	{Code: "hrw", Scope: 'I', Name: "Warwar Feni"},
+	{Code: "hrx", Scope: 'I', Name: "Hunsrik"},
+	{Code: "hrz", Scope: 'I', Name: "Harzani"},
+	{Code: "hsb", Part2: "hsb", Scope: 'I', Name: "Upper Sorbian"},
+	{Code: "hsh", Scope: 'I', Name: "Hungarian Sign Language"},
+	{Code: "hsl", Scope: 'I', Name: "Hausa Sign Language"},
+	{Code: "hsn", Scope: 'I', Macro: "zh", Name: "Xiang Chinese"},
+	{Code: "hss", Scope: 'I', Name: "Harsusi"},
+	{Code: "hti", Scope: 'I', Name: "Hoti"},
+	{Code: "hto", Scope: 'I', Name: "Minica Huitoto"},
+	{Code: "hts", Scope: 'I', Name: "Hadza"},
+	{Code: "htu", Scope: 'I', Name: "Hitu"},
+	{Code: "htx", Scope: 'I', Name: "Middle Hittite"},
+	{Code: "hub", Scope: 'I', Name: "Huambisa"},
+	{Code: "huc", Scope: 'I', Name: "ǂHua"},
+	{Code: "hud", Scope: 'I', Name: "Huaulu"},
+	{Code: "hue", Scope: 'I', Name: "San Francisco Del Mar Huave"},
+	{Code: "huf", Scope: 'I', Name: "Humene"},
+	{Code: "hug", Scope: 'I', Name: "Huachipaeri"},
+	{Code: "huh", Scope: 'I', Name: "Huilliche"},
+	{Code: "hui", Scope: 'I', Name: "Huli"},
+	{Code: "huj", Scope: 'I', Name: "Northern Guiyang Hmong"},
+	{Code: "huk", Scope: 'I', Name: "Hulung"},
+	{Code: "hul", Scope: 'I', Name: "Hula"},
+	{Code: "hum", Scope: 'I', Name: "Hungana"},
+	{Code: "hun", Part2: "hun", Part1: "hu", Scope: 'I', Name: "Hungarian"},
+	{Code: "huo", Scope: 'I', Name: "Hu"},
+	{Code: "hup", Part2: "hup", Scope: 'I', Name: "Hupa"},
+	{Code: "huq", Scope: 'I', Name: "Tsat"},
+	{Code: "hur", Scope: 'I', Name: "Halkomelem"},
+	{Code: "hus", Scope: 'I', Name: "Huastec"},
+	{Code: "hut", Scope: 'I', Name: "Humla"},
+	{Code: "huu", Scope: 'I', Name: "Murui Huitoto"},
+	{Code: "huv", Scope: 'I', Name: "San Mateo Del Mar Huave"},
+	{Code: "huw", Scope: 'I', Name: "Hukumina"},
+	{Code: "hux", Scope: 'I', Name: "Nüpode Huitoto"},
+	{Code: "huy", Scope: 'I', Name: "Hulaulá"},
+	{Code: "huz", Scope: 'I', Name: "Hunzib"},
+	{Code: "hvc", Scope: 'I', Name: "Haitian Vodoun Culture Language"},
+	{Code: "hve", Scope: 'I', Name: "San Dionisio Del Mar Huave"},
+	{Code: "hvk", Scope: 'I', Name: "Haveke"},
+	{Code: "hvn", Scope: 'I', Name: "Sabu"},
+	{Code: "hvv", Scope: 'I', Name: "Santa María Del Mar Huave"},
+	{Code: "hwa", Scope: 'I', Name: "Wané"},
+	{Code: "hwc", Scope: 'I', Name: "Hawai'i Creole English"},
+	{Code: "hwo", Scope: 'I', Name: "Hwana"},
+	{Code: "hya", Scope: 'I', Name: "Hya"},
+	{Code: "hye", Part2: "hye", Part1: "hy", Scope: 'I', Name: "Armenian"},
+	{Code: "hyw", Scope: 'I', Name: "Western Armenian"},
+	{Code: "iai", Scope: 'I', Name: "Iaai"},
+	{Code: "ian", Scope: 'I', Name: "Iatmul"},
+	{Code: "iar", Scope: 'I', Name: "Purari"},
+	{Code: "iba", Part2: "iba", Scope: 'I', Name: "Iban"},
+	{Code: "ibb", Scope: 'I', Name: "Ibibio"},
+	{Code: "ibd", Scope: 'I', Name: "Iwaidja"},
+	{Code: "ibe", Scope: 'I', Name: "Akpes"},
+	{Code: "ibg", Scope: 'I', Name: "Ibanag"},
+	{Code: "ibh", Scope: 'I', Name: "Bih"},
+	{Code: "ibl", Scope: 'I', Name: "Ibaloi"},
+	{Code: "ibm", Scope: 'I', Name: "Agoi"},
+	{Code: "ibn", Scope: 'I', Name: "Ibino"},
+	{Code: "ibo", Part2: "ibo", Part1: "ig", Scope: 'I', Name: "Igbo"},
+	{Code: "ibr", Scope: 'I', Name: "Ibuoro"},
+	{Code: "ibu", Scope: 'I', Name: "Ibu"},
+	{Code: "iby", Scope: 'I', Name: "Ibani"},
+	{Code: "ica", Scope: 'I', Name: "Ede Ica"},
+	{Code: "ich", Scope: 'I', Name: "Etkywan"},
+	{Code: "icl", Scope: 'I', Name: "Icelandic Sign Language"},
+	{Code: "icr", Scope: 'I', Name: "Islander Creole English"},
+	{Code: "ida", Scope: 'I', Name: "Idakho-Isukha-Tiriki"},
+	{Code: "idb", Scope: 'I', Name: "Indo-Portuguese"},
+	{Code: "idc", Scope: 'I', Name: "Idon"},
+	{Code: "idd", Scope: 'I', Name: "Ede Idaca"},
+	{Code: "ide", Scope: 'I', Name: "Idere"},
+	{Code: "idi", Scope: 'I', Name: "Idi"},
+	{Code: "ido", Part2: "ido", Part1: "io", Scope: 'I', Name: "Ido"},
+	{Code: "idr", Scope: 'I', Name: "Indri"},
+	{Code: "ids", Scope: 'I', Name: "Idesa"},
+	{Code: "idt", Scope: 'I', Name: "Idaté"},
+	{Code: "idu", Scope: 'I', Name: "Idoma"},
+	{Code: "ifa", Scope: 'I', Name: "Amganad Ifugao"},
+	{Code: "ifb", Scope: 'I', Name: "Batad Ifugao"},
+	{Code: "ife", Scope: 'I', Name: "Ifè"},
+	{Code: "iff", Scope: 'I', Name: "Ifo"},
+	{Code: "ifk", Scope: 'I', Name: "Tuwali Ifugao"},
+	{Code: "ifm", Scope: 'I', Name: "Teke-Fuumu"},
+	{Code: "ifu", Scope: 'I', Name: "Mayoyao Ifugao"},
+	{Code: "ify", Scope: 'I', Name: "Keley-I Kallahan"},
+	{Code: "igb", Scope: 'I', Name: "Ebira"},
+	{Code: "ige", Scope: 'I', Name: "Igede"},
+	{Code: "igg", Scope: 'I', Name: "Igana"},
+	{Code: "igl", Scope: 'I', Name: "Igala"},
+	{Code: "igm", Scope: 'I', Name: "Kanggape"},
+	{Code: "ign", Scope: 'I', Name: "Ignaciano"},
+	{Code: "igo", Scope: 'I', Name: "Isebe"},
+	{Code: "igs", Scope: 'I', Name: "Interglossa"},
+	{Code: "igw", Scope: 'I', Name: "Igwe"},
+	{Code: "ihb", Scope: 'I', Name: "Iha Based Pidgin"},
+	{Code: "ihi", Scope: 'I', Name: "Ihievbe"},
+	{Code: "ihp", Scope: 'I', Name: "Iha"},
+	{Code: "ihw", Scope: 'I', Name: "Bidhawal"},
+	{Code: "iii", Part2: "iii", Part1: "ii", Scope: 'I', Name: "Sichuan Yi"},
+	{Code: "iin", Scope: 'I', Name: "Thiin"},
+	{Code: "ijc", Scope: 'I', Name: "Izon"},
+	{Code: "ije", Scope: 'I', Name: "Biseni"},
+	{Code: "ijj", Scope: 'I', Name: "Ede Ije"},
+	{Code: "ijn", Scope: 'I', Name: "Kalabari"},
+	{Code: "ijs", Scope: 'I', Name: "Southeast Ijo"},
+	{Code: "ike", Scope: 'I', Macro: "iu", Name: "Eastern Canadian Inuktitut"},
+	{Code: "iki", Scope: 'I', Name: "Iko"},
+	{Code: "ikk", Scope: 'I', Name: "Ika"},
+	{Code: "ikl", Scope: 'I', Name: "Ikulu"},
+	{Code: "iko", Scope: 'I', Name: "Olulumo-Ikom"},
+	{Code: "ikp", Scope: 'I', Name: "Ikpeshi"},
+	{Code: "ikr", Scope: 'I', Name: "Ikaranggal"},
+	{Code: "iks", Scope: 'I', Name: "Inuit Sign Language"},
+	{Code: "ikt", Scope: 'I', Macro: "iu", Name: "Inuinnaqtun"},
+	{Code: "iku", Part2: "iku", Part1: "iu", Scope: 'M', Name: "Inuktitut"},
+	{Code: "ikv", Scope: 'I', Name: "Iku-Gora-Ankwa"},
+	{Code: "ikw", Scope: 'I', Name: "Ikwere"},
+	{Code: "ikx", Scope: 'I', Name: "Ik"},
+	{Code: "ikz", Scope: 'I', Name: "Ikizu"},
+	{Code: "ila", Scope: 'I', Name: "Ile Ape"},
+	{Code: "ilb", Scope: 'I', Name: "Ila"},
+	{Code: "ile", Part2: "ile", Part1: "ie", Scope: 'I', Name: "Interlingue"},
+	{Code: "ilg", Scope: 'I', Name: "Garig-Ilgar"},
+	{Code: "ili", Scope: 'I', Name: "Ili Turki"},
+	{Code: "ilk", Scope: 'I', Name: "Ilongot"},
+	{Code: "ilm", Scope: 'I', Name: "Iranun (Malaysia)"},
+	{Code: "ilo", Part2: "ilo", Scope: 'I', Name: "Iloko"},
+	{Code: "ilp", Scope: 'I', Name: "Iranun (Philippines)"},
+	{Code: "ils", Scope: 'I', Name: "International Sign"},
+	{Code: "ilu", Scope: 'I', Name: "Ili'uun"},
+	{Code: "ilv", Scope: 'I', Name: "Ilue"},
+	{Code: "ima", Scope: 'I', Name: "Mala Malasar"},
+	{Code: "imi", Scope: 'I', Name: "Anamgura"},
+	{Code: "iml", Scope: 'I', Name: "Miluk"},
+	{Code: "imn", Scope: 'I', Name: "Imonda"},
+	{Code: "imo", Scope: 'I', Name: "Imbongu"},
+	{Code: "imr", Scope: 'I', Name: "Imroing"},
+	{Code: "ims", Scope: 'I', Name: "Marsian"},
+	{Code: "imt", Scope: 'I', Name: "Imotong"},
+	{Code: "imy", Scope: 'I', Name: "Milyan"},
+	{Code: "ina", Part2: "ina", Part1: "ia", Scope: 'I', Name: "Interlingua (International Auxiliary Language Association)"},
+	{Code: "inb", Scope: 'I', Name: "Inga"},
+	{Code: "ind", Part2: "ind", Part1: "id", Scope: 'I', Name: "Indonesian"},
+	{Code: "ing", Scope: 'I', Name: "Degexit'an"},
+	{Code: "inh", Part2: "inh", Scope: 'I', Name: "Ingush"},
+	{Code: "inj", Scope: 'I', Name: "Jungle Inga"},
+	{Code: "inl", Scope: 'I', Name: "Indonesian Sign Language"},
+	{Code: "inm", Scope: 'I', Name: "Minaean"},
+	{Code: "inn", Scope: 'I', Name: "Isinai"},
+	{Code: "ino", Scope: 'I', Name: "Inoke-Yate"},
+	{Code: "inp", Scope: 'I', Name: "Iñapari"},
+	{Code: "ins", Scope: 'I', Name: "Indian Sign Language"},
+	{Code: "int", Scope: 'I', Name: "Intha"},
+	{Code: "inz", Scope: 'I', Name: "Ineseño"},
+	{Code: "ior", Scope: 'I', Name: "Inor"},
+	{Code: "iou", Scope: 'I', Name: "Tuma-Irumu"},
+	{Code: "iow", Scope: 'I', Name: "Iowa-Oto"},
+	{Code: "ipi", Scope: 'I', Name: "Ipili"},
+	{Code: "ipk", Part2: "ipk", Part1: "ik", Scope: 'M', Name: "Inupiaq"},
+	{Code: "ipo", Scope: 'I', Name: "Ipiko"},
+	{Code: "iqu", Scope: 'I', Name: "Iquito"},
+	{Code: "iqw", Scope: 'I', Name: "Ikwo"},
+	{Code: "ire", Scope: 'I', Name: "Iresim"},
+	{Code: "irh", Scope: 'I', Name: "Irarutu"},
+	{Code: "iri", Scope: 'I', Name: "Rigwe"},
+	{Code: "irk", Scope: 'I', Name: "Iraqw"},
+	{Code: "irn", Scope: 'I', Name: "Irántxe"},
+	{Code: "irr", Scope: 'I', Name: "Ir"},
+	{Code: "iru", Scope: 'I', Name: "Irula"},
+	{Code: "irx", Scope: 'I', Name: "Kamberau"},
+	{Code: "iry", Scope: 'I', Name: "Iraya"},
+	{Code: "isa", Scope: 'I', Name: "Isabi"},
+	{Code: "isc", Scope: 'I', Name: "Isconahua"},
+	{Code: "isd", Scope: 'I', Name: "Isnag"},
+	{Code: "ise", Scope: 'I', Name: "Italian Sign Language"},
+	{Code: "isg", Scope: 'I', Name: "Irish Sign Language"},
+	{Code: "ish", Scope: 'I', Name: "Esan"},
+	{Code: "isi", Scope: 'I', Name: "Nkem-Nkum"},
+	{Code: "isk", Scope: 'I', Name: "Ishkashimi"},
+	{Code: "isl", Part2: "isl", Part1: "is", Scope: 'I', Name: "Icelandic"},
+	{Code: "ism", Scope: 'I', Name: "Masimasi"},
+	{Code: "isn", Scope: 'I', Name: "Isanzu"},
+	{Code: "iso", Scope: 'I', Name: "Isoko"},
+	{Code: "isr", Scope: 'I', Name: "Israeli Sign Language"},
+	{Code: "ist", Scope: 'I', Name: "Istriot"},
+	{Code: "isu", Scope: 'I', Name: "Isu (Menchum Division)"},
+	{Code: "ita", Part2: "ita", Part1: "it", Scope: 'I', Name: "Italian"},
+	{Code: "itb", Scope: 'I', Name: "Binongan Itneg"},
+	{Code: "itd", Scope: 'I', Name: "Southern Tidung"},
+	{Code: "ite", Scope: 'I', Name: "Itene"},
+	{Code: "iti", Scope: 'I', Name: "Inlaod Itneg"},
+	{Code: "itk", Scope: 'I', Name: "Judeo-Italian"},
+	{Code: "itl", Scope: 'I', Name: "Itelmen"},
+	{Code: "itm", Scope: 'I', Name: "Itu Mbon Uzo"},
+	{Code: "ito", Scope: 'I', Name: "Itonama"},
+	{Code: "itr", Scope: 'I', Name: "Iteri"},
+	{Code: "its", Scope: 'I', Name: "Isekiri"},
+	{Code: "itt", Scope: 'I', Name: "Maeng Itneg"},
+	{Code: "itv", Scope: 'I', Name: "Itawit"},
+	{Code: "itw", Scope: 'I', Name: "Ito"},
+	{Code: "itx", Scope: 'I', Name: "Itik"},
+	{Code: "ity", Scope: 'I', Name: "Moyadan Itneg"},
+	{Code: "itz", Scope: 'I', Name: "Itzá"},
+	{Code: "ium", Scope: 'I', Name: "Iu Mien"},
+	{Code: "ivb", Scope: 'I', Name: "Ibatan"},
+	{Code: "ivv", Scope: 'I', Name: "Ivatan"},
+	{Code: "iwk", Scope: 'I', Name: "I-Wak"},
+	{Code: "iwm", Scope: 'I', Name: "Iwam"},
+	{Code: "iwo", Scope: 'I', Name: "Iwur"},
+	{Code: "iws", Scope: 'I', Name: "Sepik Iwam"},
+	{Code: "ixc", Scope: 'I', Name: "Ixcatec"},
+	{Code: "ixl", Scope: 'I', Name: "Ixil"},
+	{Code: "iya", Scope: 'I', Name: "Iyayu"},
+	{Code: "iyo", Scope: 'I', Name: "Mesaka"},
+	{Code: "iyx", Scope: 'I', Name: "Yaka (Congo)"},
+	{Code: "izh", Scope: 'I', Name: "Ingrian"},
+	{Code: "izr", Scope: 'I', Name: "Izere"},
+	{Code: "izz", Scope: 'I', Name: "Izii"},
+	{Code: "jaa", Scope: 'I', Name: "Jamamadí"},
+	{Code: "jab", Scope: 'I', Name: "Hyam"},
+	{Code: "jac", Scope: 'I', Name: "Popti'"},
+	{Code: "jad", Scope: 'I', Name: "Jahanka"},
+	{Code: "jae", Scope: 'I', Name: "Yabem"},
+	{Code: "jaf", Scope: 'I', Name: "Jara"},
+	{Code: "jah", Scope: 'I', Name: "Jah Hut"},
+	{Code: "jaj", Scope: 'I', Name: "Zazao"},
+	{Code: "jak", Scope: 'I', Macro: "ms", Name: "Jakun"},
+	{Code: "jal", Scope: 'I', Name: "Yalahatan"},
+	{Code: "jam", Scope: 'I', Name: "Jamaican Creole English"},
+	{Code: "jan", Scope: 'I', Name: "Jandai"},
+	{Code: "jao", Scope: 'I', Name: "Yanyuwa"},
+	{Code: "jaq", Scope: 'I', Name: "Yaqay"},
+	{Code: "jas", Scope: 'I', Name: "New Caledonian Javanese"},
+	{Code: "jat", Scope: 'I', Name: "Jakati"},
+	{Code: "jau", Scope: 'I', Name: "Yaur"},
+	{Code: "jav", Part2: "jav", Part1: "jv", Scope: 'I', Name: "Javanese"},
+	{Code: "jax", Scope: 'I', Macro: "ms", Name: "Jambi Malay"},
+	{Code: "jay", Scope: 'I', Name: "Yan-nhangu"},
+	{Code: "jaz", Scope: 'I', Name: "Jawe"},
+	{Code: "jbe", Scope: 'I', Name: "Judeo-Berber"},
+	{Code: "jbi", Scope: 'I', Name: "Badjiri"},
+	{Code: "jbj", Scope: 'I', Name: "Arandai"},
+	{Code: "jbk", Scope: 'I', Name: "Barikewa"},
+	{Code: "jbm", Scope: 'I', Name: "Bijim"},
+	{Code: "jbn", Scope: 'I', Name: "Nafusi"},
+	{Code: "jbo", Part2: "jbo", Scope: 'I', Name: "Lojban"},
+	{Code: "jbr", Scope: 'I', Name: "Jofotek-Bromnya"},
+	{Code: "jbt", Scope: 'I', Name: "Jabutí"},
+	{Code: "jbu", Scope: 'I', Name: "Jukun Takum"},
+	{Code: "jbw", Scope: 'I', Name: "Yawijibaya"},
+	{Code: "jcs", Scope: 'I', Name: "Jamaican Country Sign Language"},
+	{Code: "jct", Scope: 'I', Name: "Krymchak"},
+	{Code: "jda", Scope: 'I', Name: "Jad"},
+	{Code: "jdg", Scope: 'I', Name: "Jadgali"},
+	{Code: "jdt", Scope: 'I', Name: "Judeo-Tat"},
+	{Code: "jeb", Scope: 'I', Name: "Jebero"},
+	{Code: "jee", Scope: 'I', Name: "Jerung"},
+	{Code: "jeh", Scope: 'I', Name: "Jeh"},
+	{Code: "jei", Scope: 'I', Name: "Yei"},
+	{Code: "jek", Scope: 'I', Name: "Jeri Kuo"},
+	{Code: "jel", Scope: 'I', Name: "Yelmek"},
+	{Code: "jen", Scope: 'I', Name: "Dza"},
+	{Code: "jer", Scope: 'I', Name: "Jere"},
+	{Code: "jet", Scope: 'I', Name: "Manem"},
+	{Code: "jeu", Scope: 'I', Name: "Jonkor Bourmataguil"},
+	{Code: "jgb", Scope: 'I', Name: "Ngbee"},
+	{Code: "jge", Scope: 'I', Name: "Judeo-Georgian"},
+	{Code: "jgk", Scope: 'I', Name: "Gwak"},
+	{Code: "jgo", Scope: 'I', Name: "Ngomba"},
+	{Code: "jhi", Scope: 'I', Name: "Jehai"},
+	{Code: "jhs", Scope: 'I', Name: "Jhankot Sign Language"},
+	{Code: "jia", Scope: 'I', Name: "Jina"},
+	{Code: "jib", Scope: 'I', Name: "Jibu"},
+	{Code: "jic", Scope: 'I', Name: "Tol"},
+	{Code: "jid", Scope: 'I', Name: "Bu (Kaduna State)"},
+	{Code: "jie", Scope: 'I', Name: "Jilbe"},
+	{Code: "jig", Scope: 'I', Name: "Jingulu"},
+	{Code: "jih", Scope: 'I', Name: "sTodsde"},
+	{Code: "jii", Scope: 'I', Name: "Jiiddu"},
+	{Code: "jil", Scope: 'I', Name: "Jilim"},
+	{Code: "jim", Scope: 'I', Name: "Jimi (Cameroon)"},
+	{Code: "jio", Scope: 'I', Name: "Jiamao"},
+	{Code: "jiq", Scope: 'I', Name: "Guanyinqiao"},
+	{Code: "jit", Scope: 'I', Name: "Jita"},
+	{Code: "jiu", Scope: 'I', Name: "Youle Jinuo"},
+	{Code: "jiv", Scope: 'I', Name: "Shuar"},
+	{Code: "jiy", Scope: 'I', Name: "Buyuan Jinuo"},
+	{Code: "jje", Scope: 'I', Name: "Jejueo"},
+	{Code: "jjr", Scope: 'I', Name: "Bankal"},
+	{Code: "jka", Scope: 'I', Name: "Kaera"},
+	{Code: "jkm", Scope: 'I', Name: "Mobwa Karen"},
+	{Code: "jko", Scope: 'I', Name: "Kubo"},
+	{Code: "jkp", Scope: 'I', Name: "Paku Karen"},
+	{Code: "jkr", Scope: 'I', Name: "Koro (India)"},
+	{Code: "jks", Scope: 'I', Name: "Amami Koniya Sign Language"},
+	{Code: "jku", Scope: 'I', Name: "Labir"},
+	{Code: "jle", Scope: 'I', Name: "Ngile"},
+	{Code: "jls", Scope: 'I', Name: "Jamaican Sign Language"},
+	{Code: "jma", Scope: 'I', Name: "Dima"},
+	{Code: "jmb", Scope: 'I', Name: "Zumbun"},
+	{Code: "jmc", Scope: 'I', Name: "Machame"},
+	{Code: "jmd", Scope: 'I', Name: "Yamdena"},
+	{Code: "jmi", Scope: 'I', Name: "Jimi (Nigeria)"},
+	{Code: "jml", Scope: 'I', Name: "Jumli"},
+	{Code: "jmn", Scope: 'I', Name: "Makuri Naga"},
+	{Code: "jmr", Scope: 'I', Name: "Kamara"},
+	{Code: "jms", Scope: 'I', Name: "Mashi (Nigeria)"},
+	{Code: "jmw", Scope: 'I', Name: "Mouwase"},
+	{Code: "jmx", Scope: 'I', Name: "Western Juxtlahuaca Mixtec"},
+	{Code: "jna", Scope: 'I', Name: "Jangshung"},
+	{Code: "jnd", Scope: 'I', Name: "Jandavra"},
+	{Code: "jng", Scope: 'I', Name: "Yangman"},
+	{Code: "jni", Scope: 'I', Name: "Janji"},
+	{Code: "jnj", Scope: 'I', Name: "Yemsa"},
+	{Code: "jnl", Scope: 'I', Name: "Rawat"},
+	{Code: "jns", Scope: 'I', Name: "Jaunsari"},
+	{Code: "job", Scope: 'I', Name: "Joba"},
+	{Code: "jod", Scope: 'I', Name: "Wojenaka"},
+	{Code: "jog", Scope: 'I', Name: "Jogi"},
+	{Code: "jor", Scope: 'I', Name: "Jorá"},
+	{Code: "jos", Scope: 'I', Name: "Jordanian Sign Language"},
+	{Code: "jow", Scope: 'I', Name: "Jowulu"},
+	{Code: "jpa", Scope: 'I', Name: "Jewish Palestinian Aramaic"},
+	{Code: "jpn", Part2: "jpn", Part1: "ja", Scope: 'I', Name: "Japanese"},
+	{Code: "jpr", Part2: "jpr", Scope: 'I', Name: "Judeo-Persian"},
+	{Code: "jqr", Scope: 'I', Name: "Jaqaru"},
+	{Code: "jra", Scope: 'I', Name: "Jarai"},
+	{Code: "jrb", Part2: "jrb", Scope: 'M', Name: "Judeo-Arabic"},
+	{Code: "jrr", Scope: 'I', Name: "Jiru"},
+	{Code: "jrt", Scope: 'I', Name: "Jakattoe"},
+	{Code: "jru", Scope: 'I', Name: "Japrería"},
+	{Code: "jsl", Scope: 'I', Name: "Japanese Sign Language"},
+	{Code: "jua", Scope: 'I', Name: "Júma"},
+	{Code: "jub", Scope: 'I', Name: "Wannu"},
+	{Code: "juc", Scope: 'I', Name: "Jurchen"},
+	{Code: "jud", Scope: 'I', Name: "Worodougou"},
+	{Code: "juh", Scope: 'I', Name: "Hõne"},
+	{Code: "jui", Scope: 'I', Name: "Ngadjuri"},
+	{Code: "juk", Scope: 'I', Name: "Wapan"},
+	{Code: "jul", Scope: 'I', Name: "Jirel"},
+	{Code: "jum", Scope: 'I', Name: "Jumjum"},
+	{Code: "jun", Scope: 'I', Name: "Juang"},
+	{Code: "juo", Scope: 'I', Name: "Jiba"},
+	{Code: "jup", Scope: 'I', Name: "Hupdë"},
+	{Code: "jur", Scope: 'I', Name: "Jurúna"},
+	{Code: "jus", Scope: 'I', Name: "Jumla Sign Language"},
+	{Code: "jut", Scope: 'I', Name: "Jutish"},
+	{Code: "juu", Scope: 'I', Name: "Ju"},
+	{Code: "juw", Scope: 'I', Name: "Wãpha"},
+	{Code: "juy", Scope: 'I', Name: "Juray"},
+	{Code: "jvd", Scope: 'I', Name: "Javindo"},
+	{Code: "jvn", Scope: 'I', Name: "Caribbean Javanese"},
+	{Code: "jwi", Scope: 'I', Name: "Jwira-Pepesa"},
+	{Code: "jya", Scope: 'I', Name: "Jiarong"},
+	{Code: "jye", Scope: 'I', Name: "Judeo-Yemeni Arabic"},
+	{Code: "jyy", Scope: 'I', Name: "Jaya"},
+	{Code: "kaa", Part2: "kaa", Scope: 'I', Name: "Kara-Kalpak"},
+	{Code: "kab", Part2: "kab", Scope: 'I', Name: "Kabyle"},
+	{Code: "kac", Part2: "kac", Scope: 'I', Name: "Kachin"},
+	{Code: "kad", Scope: 'I', Name: "Adara"},
+	{Code: "kae", Scope: 'I', Name: "Ketangalan"},
+	{Code: "kaf", Scope: 'I', Name: "Katso"},
+	{Code: "kag", Scope: 'I', Name: "Kajaman"},
+	{Code: "kah", Scope: 'I', Name: "Kara (Central African Republic)"},
+	{Code: "kai", Scope: 'I', Name: "Karekare"},
+	{Code: "kaj", Scope: 'I', Name: "Jju"},
+	{Code: "kak", Scope: 'I', Name: "Kalanguya"},
+	{Code: "kal", Part2: "kal", Part1: "kl", Scope: 'I', Name: "Kalaallisut"},
+	{Code: "kam", Part2: "kam", Scope: 'I', Name: "Kamba (Kenya)"},
+	{Code: "kan", Part2: "kan", Part1: "kn", Scope: 'I', Name: "Kannada"},
+	{Code: "kao", Scope: 'I', Name: "Xaasongaxango"},
+	{Code: "kap", Scope: 'I', Name: "Bezhta"},
+	{Code: "kaq", Scope: 'I', Name: "Capanahua"},
+	{Code: "kas", Part2: "kas", Part1: "ks", Scope: 'I', Name: "Kashmiri"},
+	{Code: "kat", Part2: "kat", Part1: "ka", Scope: 'I', Name: "Georgian"},
+	{Code: "kau", Part2: "kau", Part1: "kr", Scope: 'M', Name: "Kanuri"},
+	{Code: "kav", Scope: 'I', Name: "Katukína"},
+	{Code: "kaw", Part2: "kaw", Scope: 'I', Name: "Kawi"},
+	{Code: "kax", Scope: 'I', Name: "Kao"},
+	{Code: "kay", Scope: 'I', Name: "Kamayurá"},
+	{Code: "kaz", Part2: "kaz", Part1: "kk", Scope: 'I', Name: "Kazakh"},
+	{Code: "kba", Scope: 'I', Name: "Kalarko"},
+	{Code: "kbb", Scope: 'I', Name: "Kaxuiâna"},
+	{Code: "kbc", Scope: 'I', Name: "Kadiwéu"},
+	{Code: "kbd", Part2: "kbd", Scope: 'I', Name: "Kabardian"},
+	{Code: "kbe", Scope: 'I', Name: "Kanju"},
+	{Code: "kbg", Scope: 'I', Name: "Khamba"},
+	{Code: "kbh", Scope: 'I', Name: "Camsá"},
+	{Code: "kbi", Scope: 'I', Name: "Kaptiau"},
+	{Code: "kbj", Scope: 'I', Name: "Kari"},
+	{Code: "kbk", Scope: 'I', Name: "Grass Koiari"},
+	{Code: "kbl", Scope: 'I', Name: "Kanembu"},
+	{Code: "kbm", Scope: 'I', Name: "Iwal"},
+	{Code: "kbn", Scope: 'I', Name: "Kare (Central African Republic)"},
+	{Code: "kbo", Scope: 'I', Name: "Keliko"},
+	{Code: "kbp", Scope: 'I', Name: "Kabiyè"},
+	{Code: "kbq", Scope: 'I', Name: "Kamano"},
+	{Code: "kbr", Scope: 'I', Name: "Kafa"},
+	{Code: "kbs", Scope: 'I', Name: "Kande"},
+	{Code: "kbt", Scope: 'I', Name: "Abadi"},
+	{Code: "kbu", Scope: 'I', Name: "Kabutra"},
+	{Code: "kbv", Scope: 'I', Name: "Dera (Indonesia)"},
+	{Code: "kbw", Scope: 'I', Name: "Kaiep"},
+	{Code: "kbx", Scope: 'I', Name: "Ap Ma"},
+	{Code: "kby", Scope: 'I', Macro: "kr", Name: "Manga Kanuri"},
+	{Code: "kbz", Scope: 'I', Name: "Duhwa"},
+	{Code: "kca", Scope: 'I', Name: "Khanty"},
+	{Code: "kcb", Scope: 'I', Name: "Kawacha"},
+	{Code: "kcc", Scope: 'I', Name: "Lubila"},
+	{Code: "kcd", Scope: 'I', Name: "Ngkâlmpw Kanum"},
+	{Code: "kce", Scope: 'I', Name: "Kaivi"},
+	{Code: "kcf", Scope: 'I', Name: "Ukaan"},
+	{Code: "kcg", Scope: 'I', Name: "Tyap"},
+	{Code: "kch", Scope: 'I', Name: "Vono"},
+	{Code: "kci", Scope: 'I', Name: "Kamantan"},
+	{Code: "kcj", Scope: 'I', Name: "Kobiana"},
+	{Code: "kck", Scope: 'I', Name: "Kalanga"},
+	{Code: "kcl", Scope: 'I', Name: "Kela (Papua New Guinea)"},
+	{Code: "kcm", Scope: 'I', Name: "Gula (Central African Republic)"},
+	{Code: "kcn", Scope: 'I', Name: "Nubi"},
+	{Code: "kco", Scope: 'I', Name: "Kinalakna"},
+	{Code: "kcp", Scope: 'I', Name: "Kanga"},
+	{Code: "kcq", Scope: 'I', Name: "Kamo"},
+	{Code: "kcr", Scope: 'I', Name: "Katla"},
+	{Code: "kcs", Scope: 'I', Name: "Koenoem"},
+	{Code: "kct", Scope: 'I', Name: "Kaian"},
+	{Code: "kcu", Scope: 'I', Name: "Kami (Tanzania)"},
+	{Code: "kcv", Scope: 'I', Name: "Kete"},
+	{Code: "kcw", Scope: 'I', Name: "Kabwari"},
+	{Code: "kcx", Scope: 'I', Name: "Kachama-Ganjule"},
+	{Code: "kcy", Scope: 'I', Name: "Korandje"},
+	{Code: "kcz", Scope: 'I', Name: "Konongo"},
+	{Code: "kda", Scope: 'I', Name: "Worimi"},
+	{Code: "kdc", Scope: 'I', Name: "Kutu"},
+	{Code: "kdd", Scope: 'I', Name: "Yankunytjatjara"},
+	{Code: "kde", Scope: 'I', Name: "Makonde"},
+	{Code: "kdf", Scope: 'I', Name: "Mamusi"},
+	{Code: "kdg", Scope: 'I', Name: "Seba"},
+	{Code: "kdh", Scope: 'I', Name: "Tem"},
+	{Code: "kdi", Scope: 'I', Name: "Kumam"},
+	{Code: "kdj", Scope: 'I', Name: "Karamojong"},
+	{Code: "kdk", Scope: 'I', Name: "Numèè"},
+	{Code: "kdl", Scope: 'I', Name: "Tsikimba"},
+	{Code: "kdm", Scope: 'I', Name: "Kagoma"},
+	{Code: "kdn", Scope: 'I', Name: "Kunda"},
+	{Code: "kdp", Scope: 'I', Name: "Kaningdon-Nindem"},
+	{Code: "kdq", Scope: 'I', Name: "Koch"},
+	{Code: "kdr", Scope: 'I', Name: "Karaim"},
+	{Code: "kdt", Scope: 'I', Name: "Kuy"},
+	{Code: "kdu", Scope: 'I', Name: "Kadaru"},
+	{Code: "kdw", Scope: 'I', Name: "Koneraw"},
+	{Code: "kdx", Scope: 'I', Name: "Kam"},
+	{Code: "kdy", Scope: 'I', Name: "Keder"},
+	{Code: "kdz", Scope: 'I', Name: "Kwaja"},
+	{Code: "kea", Scope: 'I', Name: "Kabuverdianu"},
+	{Code: "keb", Scope: 'I', Name: "Kélé"},
+	{Code: "kec", Scope: 'I', Name: "Keiga"},
+	{Code: "ked", Scope: 'I', Name: "Kerewe"},
+	{Code: "kee", Scope: 'I', Name: "Eastern Keres"},
+	{Code: "kef", Scope: 'I', Name: "Kpessi"},
+	{Code: "keg", Scope: 'I', Name: "Tese"},
+	{Code: "keh", Scope: 'I', Name: "Keak"},
+	{Code: "kei", Scope: 'I', Name: "Kei"},
+	{Code: "kej", Scope: 'I', Name: "Kadar"},
+	{Code: "kek", Scope: 'I', Name: "Kekchí"},
+	{Code: "kel", Scope: 'I', Name: "Kela (Democratic Republic of Congo)"},
+	{Code: "kem", Scope: 'I', Name: "Kemak"},
+	{Code: "ken", Scope: 'I', Name: "Kenyang"},
+	{Code: "keo", Scope: 'I', Name: "Kakwa"},
+	{Code: "kep", Scope: 'I', Name: "Kaikadi"},
+	{Code: "keq", Scope: 'I', Name: "Kamar"},
+	{Code: "ker", Scope: 'I', Name: "Kera"},
+	{Code: "kes", Scope: 'I', Name: "Kugbo"},
+	{Code: "ket", Scope: 'I', Name: "Ket"},
+	{Code: "keu", Scope: 'I', Name: "Akebu"},
+	{Code: "kev", Scope: 'I', Name: "Kanikkaran"},
+	{Code: "kew", Scope: 'I', Name: "West Kewa"},
+	{Code: "kex", Scope: 'I', Name: "Kukna"},
+	{Code: "key", Scope: 'I', Name: "Kupia"},
+	{Code: "kez", Scope: 'I', Name: "Kukele"},
+	{Code: "kfa", Scope: 'I', Name: "Kodava"},
+	{Code: "kfb", Scope: 'I', Name: "Northwestern Kolami"},
+	{Code: "kfc", Scope: 'I', Name: "Konda-Dora"},
+	{Code: "kfd", Scope: 'I', Name: "Korra Koraga"},
+	{Code: "kfe", Scope: 'I', Name: "Kota (India)"},
+	{Code: "kff", Scope: 'I', Name: "Koya"},
+	{Code: "kfg", Scope: 'I', Name: "Kudiya"},
+	{Code: "kfh", Scope: 'I', Name: "Kurichiya"},
+	{Code: "kfi", Scope: 'I', Name: "Kannada Kurumba"},
+	{Code: "kfj", Scope: 'I', Name: "Kemiehua"},
+	{Code: "kfk", Scope: 'I', Name: "Kinnauri"},
+	{Code: "kfl", Scope: 'I', Name: "Kung"},
+	{Code: "kfm", Scope: 'I', Name: "Khunsari"},
+	{Code: "kfn", Scope: 'I', Name: "Kuk"},
+	{Code: "kfo", Scope: 'I', Name: "Koro (Côte d'Ivoire)"},
+	{Code: "kfp", Scope: 'I', Name: "Korwa"},
+	{Code: "kfq", Scope: 'I', Name: "Korku"},
+	{Code: "kfr", Scope: 'I', Name: "Kachhi"},
+	{Code: "kfs", Scope: 'I', Name: "Bilaspuri"},
+	{Code: "kft", Scope: 'I', Name: "Kanjari"},
+	{Code: "kfu", Scope: 'I', Name: "Katkari"},
+	{Code: "kfv", Scope: 'I', Name: "Kurmukar"},
+	{Code: "kfw", Scope: 'I', Name: "Kharam Naga"},
+	{Code: "kfx", Scope: 'I', Name: "Kullu Pahari"},
+	{Code: "kfy", Scope: 'I', Name: "Kumaoni"},
+	{Code: "kfz", Scope: 'I', Name: "Koromfé"},
+	{Code: "kga", Scope: 'I', Name: "Koyaga"},
+	{Code: "kgb", Scope: 'I', Name: "Kawe"},
+	{Code: "kge", Scope: 'I', Name: "Komering"},
+	{Code: "kgf", Scope: 'I', Name: "Kube"},
+	{Code: "kgg", Scope: 'I', Name: "Kusunda"},
+	{Code: "kgi", Scope: 'I', Name: "Selangor Sign Language"},
+	{Code: "kgj", Scope: 'I', Name: "Gamale Kham"},
+	{Code: "kgk", Scope: 'I', Name: "Kaiwá"},
+	{Code: "kgl", Scope: 'I', Name: "Kunggari"},
+	{Code: "kgm", Scope: 'I', Name: "Karipúna"},
+	{Code: "kgn", Scope: 'I', Name: "Karingani"},
+	{Code: "kgo", Scope: 'I', Name: "Krongo"},
+	{Code: "kgp", Scope: 'I', Name: "Kaingang"},
+	{Code: "kgq", Scope: 'I', Name: "Kamoro"},
+	{Code: "kgr", Scope: 'I', Name: "Abun"},
+	{Code: "kgs", Scope: 'I', Name: "Kumbainggar"},
+	{Code: "kgt", Scope: 'I', Name: "Somyev"},
+	{Code: "kgu", Scope: 'I', Name: "Kobol"},
+	{Code: "kgv", Scope: 'I', Name: "Karas"},
+	{Code: "kgw", Scope: 'I', Name: "Karon Dori"},
+	{Code: "kgx", Scope: 'I', Name: "Kamaru"},
+	{Code: "kgy", Scope: 'I', Name: "Kyerung"},
+	{Code: "kha", Part2: "kha", Scope: 'I', Name: "Khasi"},
+	{Code: "khb", Scope: 'I', Name: "Lü"},
+	{Code: "khc", Scope: 'I', Name: "Tukang Besi North"},
+	{Code: "khd", Scope: 'I', Name: "Bädi Kanum"},
+	{Code: "khe", Scope: 'I', Name: "Korowai"},
+	{Code: "khf", Scope: 'I', Name: "Khuen"},
+	{Code: "khg", Scope: 'I', Name: "Khams Tibetan"},
+	{Code: "khh", Scope: 'I', Name: "Kehu"},
+	{Code: "khj", Scope: 'I', Name: "Kuturmi"},
+	{Code: "khk", Scope: 'I', Macro: "mn", Name: "Halh Mongolian"},
+	{Code: "khl", Scope: 'I', Name: "Lusi"},
+	{Code: "khm", Part2: "khm", Part1: "km", Scope: 'I', Name: "Khmer"},
+	{Code: "khn", Scope: 'I', Name: "Khandesi"},
+	{Code: "kho", Part2: "kho", Scope: 'I', Name: "Khotanese"},
+	{Code: "khp", Scope: 'I', Name: "Kapori"},
+	{Code: "khq", Scope: 'I', Name: "Koyra Chiini Songhay"},
+	{Code: "khr", Scope: 'I', Name: "Kharia"},
+	{Code: "khs", Scope: 'I', Name: "Kasua"},
+	{Code: "kht", Scope: 'I', Name: "Khamti"},
+	{Code: "khu", Scope: 'I', Name: "Nkhumbi"},
+	{Code: "khv", Scope: 'I', Name: "Khvarshi"},
+	{Code: "khw", Scope: 'I', Name: "Khowar"},
+	{Code: "khx", Scope: 'I', Name: "Kanu"},
+	{Code: "khy", Scope: 'I', Name: "Kele (Democratic Republic of Congo)"},
+	{Code: "khz", Scope: 'I', Name: "Keapara"},
+	{Code: "kia", Scope: 'I', Name: "Kim"},
+	{Code: "kib", Scope: 'I', Name: "Koalib"},
+	{Code: "kic", Scope: 'I', Name: "Kickapoo"},
+	{Code: "kid", Scope: 'I', Name: "Koshin"},
+	{Code: "kie", Scope: 'I', Name: "Kibet"},
+	{Code: "kif", Scope: 'I', Name: "Eastern Parbate Kham"},
+	{Code: "kig", Scope: 'I', Name: "Kimaama"},
+	{Code: "kih", Scope: 'I', Name: "Kilmeri"},
+	{Code: "kii", Scope: 'I', Name: "Kitsai"},
+	{Code: "kij", Scope: 'I', Name: "Kilivila"},
+	{Code: "kik", Part2: "kik", Part1: "ki", Scope: 'I', Name: "Kikuyu"},
+	{Code: "kil", Scope: 'I', Name: "Kariya"},
+	{Code: "kim", Scope: 'I', Name: "Karagas"},
+	{Code: "kin", Part2: "kin", Part1: "rw", Scope: 'I', Name: "Kinyarwanda"},
+	{Code: "kio", Scope: 'I', Name: "Kiowa"},
+	{Code: "kip", Scope: 'I', Name: "Sheshi Kham"},
+	{Code: "kiq", Scope: 'I', Name: "Kosadle"},
+	{Code: "kir", Part2: "kir", Part1: "ky", Scope: 'I', Name: "Kirghiz"},
+	{Code: "kis", Scope: 'I', Name: "Kis"},
+	{Code: "kit", Scope: 'I', Name: "Agob"},
+	{Code: "kiu", Scope: 'I', Macro: "zza", Name: "Kirmanjki (individual language)"},
+	{Code: "kiv", Scope: 'I', Name: "Kimbu"},
+	{Code: "kiw", Scope: 'I', Name: "Northeast Kiwai"},
+	{Code: "kix", Scope: 'I', Name: "Khiamniungan Naga"},
+	{Code: "kiy", Scope: 'I', Name: "Kirikiri"},
+	{Code: "kiz", Scope: 'I', Name: "Kisi"},
+	{Code: "kja", Scope: 'I', Name: "Mlap"},
+	{Code: "kjb", Scope: 'I', Name: "Q'anjob'al"},
+	{Code: "kjc", Scope: 'I', Name: "Coastal Konjo"},
+	{Code: "kjd", Scope: 'I', Name: "Southern Kiwai"},
+	{Code: "kje", Scope: 'I', Name: "Kisar"},
+	{Code: "kjg", Scope: 'I', Name: "Khmu"},
+	{Code: "kjh", Scope: 'I', Name: "Khakas"},
+	{Code: "kji", Scope: 'I', Name: "Zabana"},
+	{Code: "kjj", Scope: 'I', Name: "Khinalugh"},
+	{Code: "kjk", Scope: 'I', Name: "Highland Konjo"},
+	{Code: "kjl", Scope: 'I', Name: "Western Parbate Kham"},
+	{Code: "kjm", Scope: 'I', Name: "Kháng"},
+	{Code: "kjn", Scope: 'I', Name: "Kunjen"},
+	{Code: "kjo", Scope: 'I', Name: "Harijan Kinnauri"},
+	{Code: "kjp", Scope: 'I', Name: "Pwo Eastern Karen"},
+	{Code: "kjq", Scope: 'I', Name: "Western Keres"},
+	{Code: "kjr", Scope: 'I', Name: "Kurudu"},
+	{Code: "kjs", Scope: 'I', Name: "East Kewa"},
+	{Code: "kjt", Scope: 'I', Name: "Phrae Pwo Karen"},
+	{Code: "kju", Scope: 'I', Name: "Kashaya"},
+	{Code: "kjv", Scope: 'I', Name: "Kaikavian Literary Language"},
+	{Code: "kjx", Scope: 'I', Name: "Ramopa"},
+	{Code: "kjy", Scope: 'I', Name: "Erave"},
+	{Code: "kjz", Scope: 'I', Name: "Bumthangkha"},
+	{Code: "kka", Scope: 'I', Name: "Kakanda"},
+	{Code: "kkb", Scope: 'I', Name: "Kwerisa"},
+	{Code: "kkc", Scope: 'I', Name: "Odoodee"},
+	{Code: "kkd", Scope: 'I', Name: "Kinuku"},
+	{Code: "kke", Scope: 'I', Name: "Kakabe"},
+	{Code: "kkf", Scope: 'I', Name: "Kalaktang Monpa"},
+	{Code: "kkg", Scope: 'I', Name: "Mabaka Valley Kalinga"},
+	{Code: "kkh", Scope: 'I', Name: "Khün"},
+	{Code: "kki", Scope: 'I', Name: "Kagulu"},
+	{Code: "kkj", Scope: 'I', Name: "Kako"},
+	{Code: "kkk", Scope: 'I', Name: "Kokota"},
+	{Code: "kkl", Scope: 'I', Name: "Kosarek Yale"},
+	{Code: "kkm", Scope: 'I', Name: "Kiong"},
+	{Code: "kkn", Scope: 'I', Name: "Kon Keu"},
+	{Code: "kko", Scope: 'I', Name: "Karko"},
+	{Code: "kkp", Scope: 'I', Name: "Gugubera"},
+	{Code: "kkq", Scope: 'I', Name: "Kaeku"},
+	{Code: "kkr", Scope: 'I', Name: "Kir-Balar"},
+	{Code: "kks", Scope: 'I', Name: "Giiwo"},
+	{Code: "kkt", Scope: 'I', Name: "Koi"},
+	{Code: "kku", Scope: 'I', Name: "Tumi"},
+	{Code: "kkv", Scope: 'I', Name: "Kangean"},
+	{Code: "kkw", Scope: 'I', Name: "Teke-Kukuya"},
+	{Code: "kkx", Scope: 'I', Name: "Kohin"},
+	{Code: "kky", Scope: 'I', Name: "Guugu Yimidhirr"},
+	{Code: "kkz", Scope: 'I', Name: "Kaska"},
+	{Code: "kla", Scope: 'I', Name: "Klamath-Modoc"},
+	{Code: "klb", Scope: 'I', Name: "Kiliwa"},
+	{Code: "klc", Scope: 'I', Name: "Kolbila"},
+	{Code: "kld", Scope: 'I', Name: "Gamilaraay"},
+	{Code: "kle", Scope: 'I', Name: "Kulung (Nepal)"},
+	{Code: "klf", Scope: 'I', Name: "Kendeje"},
+	{Code: "klg", Scope: 'I', Name: "Tagakaulo"},
+	{Code: "klh", Scope: 'I', Name: "Weliki"},
+	{Code: "kli", Scope: 'I', Name: "Kalumpang"},
+	{Code: "klj", Scope: 'I', Name: "Khalaj"},
+	{Code: "klk", Scope: 'I', Name: "Kono (Nigeria)"},
+	{Code: "kll", Scope: 'I', Name: "Kagan Kalagan"},
+	{Code: "klm", Scope: 'I', Name: "Migum"},
+	{Code: "kln", Scope: 'M', Name: "Kalenjin"},
+	{Code: "klo", Scope: 'I', Name: "Kapya"},
+	{Code: "klp", Scope: 'I', Name: "Kamasa"},
+	{Code: "klq", Scope: 'I', Name: "Rumu"},
+	{Code: "klr", Scope: 'I', Name: "Khaling"},
+	{Code: "kls", Scope: 'I', Name: "Kalasha"},
+	{Code: "klt", Scope: 'I', Name: "Nukna"},
+	{Code: "klu", Scope: 'I', Name: "Klao"},
+	{Code: "klv", Scope: 'I', Name: "Maskelynes"},
+	{Code: "klw", Scope: 'I', Name: "Tado"},
+	{Code: "klx", Scope: 'I', Name: "Koluwawa"},
+	{Code: "kly", Scope: 'I', Name: "Kalao"},
+	{Code: "klz", Scope: 'I', Name: "Kabola"},
+	{Code: "kma", Scope: 'I', Name: "Konni"},
+	{Code: "kmb", Part2: "kmb", Scope: 'I', Name: "Kimbundu"},
+	{Code: "kmc", Scope: 'I', Name: "Southern Dong"},
+	{Code: "kmd", Scope: 'I', Name: "Majukayang Kalinga"},
+	{Code: "kme", Scope: 'I', Name: "Bakole"},
+	{Code: "kmf", Scope: 'I', Name: "Kare (Papua New Guinea)"},
+	{Code: "kmg", Scope: 'I', Name: "Kâte"},
+	{Code: "kmh", Scope: 'I', Name: "Kalam"},
+	{Code: "kmi", Scope: 'I', Name: "Kami (Nigeria)"},
+	{Code: "kmj", Scope: 'I', Name: "Kumarbhag Paharia"},
+	{Code: "kmk", Scope: 'I', Name: "Limos Kalinga"},
+	{Code: "kml", Scope: 'I', Name: "Tanudan Kalinga"},
+	{Code: "kmm", Scope: 'I', Name: "Kom (India)"},
+	{Code: "kmn", Scope: 'I', Name: "Awtuw"},
+	{Code: "kmo", Scope: 'I', Name: "Kwoma"},
+	{Code: "kmp", Scope: 'I', Name: "Gimme"},
+	{Code: "kmq", Scope: 'I', Name: "Kwama"},
+	{Code: "kmr", Scope: 'I', Macro: "ku", Name: "Northern Kurdish"},
+	{Code: "kms", Scope: 'I', Name: "Kamasau"},
+	{Code: "kmt", Scope: 'I', Name: "Kemtuik"},
+	{Code: "kmu", Scope: 'I', Name: "Kanite"},
+	{Code: "kmv", Scope: 'I', Name: "Karipúna Creole French"},
+	{Code: "kmw", Scope: 'I', Name: "Komo (Democratic Republic of Congo)"},
+	{Code: "kmx", Scope: 'I', Name: "Waboda"},
+	{Code: "kmy", Scope: 'I', Name: "Koma"},
+	{Code: "kmz", Scope: 'I', Name: "Khorasani Turkish"},
+	{Code: "kna", Scope: 'I', Name: "Dera (Nigeria)"},
+	{Code: "knb", Scope: 'I', Name: "Lubuagan Kalinga"},
+	{Code: "knc", Scope: 'I', Macro: "kr", Name: "Central Kanuri"},
+	{Code: "knd", Scope: 'I', Name: "Konda"},
+	{Code: "kne", Scope: 'I', Name: "Kankanaey"},
+	{Code: "knf", Scope: 'I', Name: "Mankanya"},
+	{Code: "kng", Scope: 'I', Macro: "kg", Name: "Koongo"},
+	{Code: "kni", Scope: 'I', Name: "Kanufi"},
+	{Code: "knj", Scope: 'I', Name: "Western Kanjobal"},
+	{Code: "knk", Scope: 'I', Name: "Kuranko"},
+	{Code: "knl", Scope: 'I', Name: "Keninjal"},
+	{Code: "knm", Scope: 'I', Name: "Kanamarí"},
+	{Code: "knn", Scope: 'I', Name: "Konkani (individual language)"},
+	{Code: "kno", Scope: 'I', Name: "Kono (Sierra Leone)"},
+	{Code: "knp", Scope: 'I', Name: "Kwanja"},
+	{Code: "knq", Scope: 'I', Name: "Kintaq"},
+	{Code: "knr", Scope: 'I', Name: "Kaningra"},
+	{Code: "kns", Scope: 'I', Name: "Kensiu"},
+	{Code: "knt", Scope: 'I', Name: "Panoan Katukína"},
+	{Code: "knu", Scope: 'I', Name: "Kono (Guinea)"},
+	{Code: "knv", Scope: 'I', Name: "Tabo"},
+	{Code: "knw", Scope: 'I', Name: "Kung-Ekoka"},
+	{Code: "knx", Scope: 'I', Name: "Kendayan"},
+	{Code: "kny", Scope: 'I', Name: "Kanyok"},
+	{Code: "knz", Scope: 'I', Name: "Kalamsé"},
+	{Code: "koa", Scope: 'I', Name: "Konomala"},
+	{Code: "koc", Scope: 'I', Name: "Kpati"},
+	{Code: "kod", Scope: 'I', Name: "Kodi"},
+	{Code: "koe", Scope: 'I', Name: "Kacipo-Bale Suri"},
+	{Code: "kof", Scope: 'I', Name: "Kubi"},
+	{Code: "kog", Scope: 'I', Name: "Cogui"},
+	{Code: "koh", Scope: 'I', Name: "Koyo"},
+	{Code: "koi", Scope: 'I', Name: "Komi-Permyak"},
+	{Code: "kok", Part2: "kok", Scope: 'M', Name: "Konkani (macrolanguage)"},
+	{Code: "kol", Scope: 'I', Name: "Kol (Papua New Guinea)"},
+	{Code: "kom", Part2: "kom", Part1: "kv", Scope: 'M', Name: "Komi"},
+	{Code: "kon", Part2: "kon", Part1: "kg", Scope: 'M', Name: "Kongo"},
+	{Code: "koo", Scope: 'I', Name: "Konzo"},
+	{Code: "kop", Scope: 'I', Name: "Waube"},
+	{Code: "koq", Scope: 'I', Name: "Kota (Gabon)"},
+	{Code: "kor", Part2: "kor", Part1: "ko", Scope: 'I', Name: "Korean"},
+	{Code: "kos", Part2: "kos", Scope: 'I', Name: "Kosraean"},
+	{Code: "kot", Scope: 'I', Name: "Lagwan"},
+	{Code: "kou", Scope: 'I', Name: "Koke"},
+	{Code: "kov", Scope: 'I', Name: "Kudu-Camo"},
+	{Code: "kow", Scope: 'I', Name: "Kugama"},
+	{Code: "koy", Scope: 'I', Name: "Koyukon"},
+	{Code: "koz", Scope: 'I', Name: "Korak"},
+	{Code: "kpa", Scope: 'I', Name: "Kutto"},
+	{Code: "kpb", Scope: 'I', Name: "Mullu Kurumba"},
+	{Code: "kpc", Scope: 'I', Name: "Curripaco"},
+	{Code: "kpd", Scope: 'I', Name: "Koba"},
+	{Code: "kpe", Part2: "kpe", Scope: 'M', Name: "Kpelle"},
+	{Code: "kpf", Scope: 'I', Name: "Komba"},
+	{Code: "kpg", Scope: 'I', Name: "Kapingamarangi"},
+	{Code: "kph", Scope: 'I', Name: "Kplang"},
+	{Code: "kpi", Scope: 'I', Name: "Kofei"},
+	{Code: "kpj", Scope: 'I', Name: "Karajá"},
+	{Code: "kpk", Scope: 'I', Name: "Kpan"},
+	{Code: "kpl", Scope: 'I', Name: "Kpala"},
+	{Code: "kpm", Scope: 'I', Name: "Koho"},
+	{Code: "kpn", Scope: 'I', Name: "Kepkiriwát"},
+	{Code: "kpo", Scope: 'I', Name: "Ikposo"},
+	{Code: "kpq", Scope: 'I', Name: "Korupun-Sela"},
+	{Code: "kpr", Scope: 'I', Name: "Korafe-Yegha"},
+	{Code: "kps", Scope: 'I', Name: "Tehit"},
+	{Code: "kpt", Scope: 'I', Name: "Karata"},
+	{Code: "kpu", Scope: 'I', Name: "Kafoa"},
+	{Code: "kpv", Scope: 'I', Macro: "kv", Name: "Komi-Zyrian"},
+	{Code: "kpw", Scope: 'I', Name: "Kobon"},
+	{Code: "kpx", Scope: 'I', Name: "Mountain Koiali"},
+	{Code: "kpy", Scope: 'I', Name: "Koryak"},
+	{Code: "kpz", Scope: 'I', Name: "Kupsabiny"},
+	{Code: "kqa", Scope: 'I', Name: "Mum"},
+	{Code: "kqb", Scope: 'I', Name: "Kovai"},
+	{Code: "kqc", Scope: 'I', Name: "Doromu-Koki"},
+	{Code: "kqd", Scope: 'I', Name: "Koy Sanjaq Surat"},
+	{Code: "kqe", Scope: 'I', Name: "Kalagan"},
+	{Code: "kqf", Scope: 'I', Name: "Kakabai"},
+	{Code: "kqg", Scope: 'I', Name: "Khe"},
+	{Code: "kqh", Scope: 'I', Name: "Kisankasa"},
+	{Code: "kqi", Scope: 'I', Name: "Koitabu"},
+	{Code: "kqj", Scope: 'I', Name: "Koromira"},
+	{Code: "kqk", Scope: 'I', Name: "Kotafon Gbe"},
+	{Code: "kql", Scope: 'I', Name: "Kyenele"},
+	{Code: "kqm", Scope: 'I', Name: "Khisa"},
+	{Code: "kqn", Scope: 'I', Name: "Kaonde"},
+	{Code: "kqo", Scope: 'I', Name: "Eastern Krahn"},
+	{Code: "kqp", Scope: 'I', Name: "Kimré"},
+	{Code: "kqq", Scope: 'I', Name: "Krenak"},
+	{Code: "kqr", Scope: 'I', Name: "Kimaragang"},
+	{Code: "kqs", Scope: 'I', Name: "Northern Kissi"},
+	{Code: "kqt", Scope: 'I', Name: "Klias River Kadazan"},
+	{Code: "kqu", Scope: 'I', Name: "Seroa"},
+	{Code: "kqv", Scope: 'I', Name: "Okolod"},
+	{Code: "kqw", Scope: 'I', Name: "Kandas"},
+	{Code: "kqx", Scope: 'I', Name: "Mser"},
+	{Code: "kqy", Scope: 'I', Name: "Koorete"},
+	{Code: "kqz", Scope: 'I', Name: "Korana"},
+	{Code: "kra", Scope: 'I', Name: "Kumhali"},
+	{Code: "krb", Scope: 'I', Name: "Karkin"},
+	{Code: "krc", Part2: "krc", Scope: 'I', Name: "Karachay-Balkar"},
+	{Code: "krd", Scope: 'I', Name: "Kairui-Midiki"},
+	{Code: "kre", Scope: 'I', Name: "Panará"},
+	{Code: "krf", Scope: 'I', Name: "Koro (Vanuatu)"},
+	{Code: "krh", Scope: 'I', Name: "Kurama"},
+	{Code: "kri", Scope: 'I', Name: "Krio"},
+	{Code: "krj", Scope: 'I', Name: "Kinaray-A"},
+	{Code: "krk", Scope: 'I', Name: "Kerek"},
+	{Code: "krl", Part2: "krl", Scope: 'I', Name: "Karelian"},
+	{Code: "krn", Scope: 'I', Name: "Sapo"},
+	{Code: "krp", Scope: 'I', Name: "Korop"},
+	{Code: "krr", Scope: 'I', Name: "Krung"},
+	{Code: "krs", Scope: 'I', Name: "Gbaya (Sudan)"},
+	{Code: "krt", Scope: 'I', Macro: "kr", Name: "Tumari Kanuri"},
+	{Code: "kru", Part2: "kru", Scope: 'I', Name: "Kurukh"},
+	{Code: "krv", Scope: 'I', Name: "Kavet"},
+	{Code: "krw", Scope: 'I', Name: "Western Krahn"},
+	{Code: "krx", Scope: 'I', Name: "Karon"},
+	{Code: "kry", Scope: 'I', Name: "Kryts"},
+	{Code: "krz", Scope: 'I', Name: "Sota Kanum"},
+	{Code: "ksa", Scope: 'I', Name: "Shuwa-Zamani"},
+	{Code: "ksb", Scope: 'I', Name: "Shambala"},
+	{Code: "ksc", Scope: 'I', Name: "Southern Kalinga"},
+	{Code: "ksd", Scope: 'I', Name: "Kuanua"},
+	{Code: "kse", Scope: 'I', Name: "Kuni"},
+	{Code: "ksf", Scope: 'I', Name: "Bafia"},
+	{Code: "ksg", Scope: 'I', Name: "Kusaghe"},
+	{Code: "ksh", Scope: 'I', Name: "Kölsch"},
+	{Code: "ksi", Scope: 'I', Name: "Krisa"},
+	{Code: "ksj", Scope: 'I', Name: "Uare"},
+	{Code: "ksk", Scope: 'I', Name: "Kansa"},
+	{Code: "ksl", Scope: 'I', Name: "Kumalu"},
+	{Code: "ksm", Scope: 'I', Name: "Kumba"},
+	{Code: "ksn", Scope: 'I', Name: "Kasiguranin"},
+	{Code: "kso", Scope: 'I', Name: "Kofa"},
+	{Code: "ksp", Scope: 'I', Name: "Kaba"},
+	{Code: "ksq", Scope: 'I', Name: "Kwaami"},
+	{Code: "ksr", Scope: 'I', Name: "Borong"},
+	{Code: "kss", Scope: 'I', Name: "Southern Kisi"},
+	{Code: "kst", Scope: 'I', Name: "Winyé"},
+	{Code: "ksu", Scope: 'I', Name: "Khamyang"},
+	{Code: "ksv", Scope: 'I', Name: "Kusu"},
+	{Code: "ksw", Scope: 'I', Name: "S'gaw Karen"},
+	{Code: "ksx", Scope: 'I', Name: "Kedang"},
+	{Code: "ksy", Scope: 'I', Name: "Kharia Thar"},
+	{Code: "ksz", Scope: 'I', Name: "Kodaku"},
+	{Code: "kta", Scope: 'I', Name: "Katua"},
+	{Code: "ktb", Scope: 'I', Name: "Kambaata"},
+	{Code: "ktc", Scope: 'I', Name: "Kholok"},
+	{Code: "ktd", Scope: 'I', Name: "Kokata"},
+	{Code: "kte", Scope: 'I', Name: "Nubri"},
+	{Code: "ktf", Scope: 'I', Name: "Kwami"},
+	{Code: "ktg", Scope: 'I', Name: "Kalkutung"},
+	{Code: "kth", Scope: 'I', Name: "Karanga"},
+	{Code: "kti", Scope: 'I', Name: "North Muyu"},
+	{Code: "ktj", Scope: 'I', Name: "Plapo Krumen"},
+	{Code: "ktk", Scope: 'I', Name: "Kaniet"},
+	{Code: "ktl", Scope: 'I', Name: "Koroshi"},
+	{Code: "ktm", Scope: 'I', Name: "Kurti"},
+	{Code: "ktn", Scope: 'I', Name: "Karitiâna"},
+	{Code: "kto", Scope: 'I', Name: "Kuot"},
+	{Code: "ktp", Scope: 'I', Name: "Kaduo"},
+	{Code: "ktq", Scope: 'I', Name: "Katabaga"},
+	{Code: "kts", Scope: 'I', Name: "South Muyu"},
+	{Code: "ktt", Scope: 'I', Name: "Ketum"},
+	{Code: "ktu", Scope: 'I', Name: "Kituba (Democratic Republic of Congo)"},
+	{Code: "ktv", Scope: 'I', Name: "Eastern Katu"},
+	{Code: "ktw", Scope: 'I', Name: "Kato"},
+	{Code: "ktx", Scope: 'I', Name: "Kaxararí"},
+	{Code: "kty", Scope: 'I', Name: "Kango (Bas-Uélé District)"},
+	{Code: "ktz", Scope: 'I', Name: "Juǀʼhoan"},
+	{Code: "kua", Part2: "kua", Part1: "kj", Scope: 'I', Name: "Kuanyama"},
+	{Code: "kub", Scope: 'I', Name: "Kutep"},
+	{Code: "kuc", Scope: 'I', Name: "Kwinsu"},
+	{Code: "kud", Scope: 'I', Name: "'Auhelawa"},
+	{Code: "kue", Scope: 'I', Name: "Kuman (Papua New Guinea)"},
+	{Code: "kuf", Scope: 'I', Name: "Western Katu"},
+	{Code: "kug", Scope: 'I', Name: "Kupa"},
+	{Code: "kuh", Scope: 'I', Name: "Kushi"},
+	{Code: "kui", Scope: 'I', Name: "Kuikúro-Kalapálo"},
+	{Code: "kuj", Scope: 'I', Name: "Kuria"},
+	{Code: "kuk", Scope: 'I', Name: "Kepo'"},
+	{Code: "kul", Scope: 'I', Name: "Kulere"},
+	{Code: "kum", Part2: "kum", Scope: 'I', Name: "Kumyk"},
+	{Code: "kun", Scope: 'I', Name: "Kunama"},
+	{Code: "kuo", Scope: 'I', Name: "Kumukio"},
+	{Code: "kup", Scope: 'I', Name: "Kunimaipa"},
+	{Code: "kuq", Scope: 'I', Name: "Karipuna"},
+	{Code: "kur", Part2: "kur", Part1: "ku", Scope: 'M', Name: "Kurdish"},
+	{Code: "kus", Scope: 'I', Name: "Kusaal"},
+	{Code: "kut", Part2: "kut", Scope: 'I', Name: "Kutenai"},
+	{Code: "kuu", Scope: 'I', Name: "Upper Kuskokwim"},
+	{Code: "kuv", Scope: 'I', Name: "Kur"},
+	{Code: "kuw", Scope: 'I', Name: "Kpagua"},
+	{Code: "kux", Scope: 'I', Name: "Kukatja"},
+	{Code: "kuy", Scope: 'I', Name: "Kuuku-Ya'u"},
+	{Code: "kuz", Scope: 'I', Name: "Kunza"},
+	{Code: "kva", Scope: 'I', Name: "Bagvalal"},
+	{Code: "kvb", Scope: 'I', Macro: "ms", Name: "Kubu"},
+	{Code: "kvc", Scope: 'I', Name: "Kove"},
+	{Code: "kvd", Scope: 'I', Name: "Kui (Indonesia)"},
+	{Code: "kve", Scope: 'I', Name: "Kalabakan"},
+	{Code: "kvf", Scope: 'I', Name: "Kabalai"},
+	{Code: "kvg", Scope: 'I', Name: "Kuni-Boazi"},
+	{Code: "kvh", Scope: 'I', Name: "Komodo"},
+	{Code: "kvi", Scope: 'I', Name: "Kwang"},
+	{Code: "kvj", Scope: 'I', Name: "Psikye"},
+	{Code: "kvk", Scope: 'I', Name: "Korean Sign Language"},
+	{Code: "kvl", Scope: 'I', Name: "Kayaw"},
+	{Code: "kvm", Scope: 'I', Name: "Kendem"},
+	{Code: "kvn", Scope: 'I', Name: "Border Kuna"},
+	{Code: "kvo", Scope: 'I', Name: "Dobel"},
+	{Code: "kvp", Scope: 'I', Name: "Kompane"},
+	{Code: "kvq", Scope: 'I', Name: "Geba Karen"},
+	{Code: "kvr", Scope: 'I', Macro: "ms", Name: "Kerinci"},
+	{Code: "kvt", Scope: 'I', Name: "Lahta Karen"},
+	{Code: "kvu", Scope: 'I', Name: "Yinbaw Karen"},
+	{Code: "kvv", Scope: 'I', Name: "Kola"},
+	{Code: "kvw", Scope: 'I', Name: "Wersing"},
+	{Code: "kvx", Scope: 'I', Name: "Parkari Koli"},
+	{Code: "kvy", Scope: 'I', Name: "Yintale Karen"},
+	{Code: "kvz", Scope: 'I', Name: "Tsakwambo"},
+	{Code: "kwa", Scope: 'I', Name: "Dâw"},
+	{Code: "kwb", Scope: 'I', Name: "Kwa"},
+	{Code: "kwc", Scope: 'I', Name: "Likwala"},
+	{Code: "kwd", Scope: 'I', Name: "Kwaio"},
+	{Code: "kwe", Scope: 'I', Name: "Kwerba"},
+	{Code: "kwf", Scope: 'I', Name: "Kwara'ae"},
+	{Code: "kwg", Scope: 'I', Name: "Sara Kaba Deme"},
+	{Code: "kwh", Scope: 'I', Name: "Kowiai"},
+	{Code: "kwi", Scope: 'I', Name: "Awa-Cuaiquer"},
+	{Code: "kwj", Scope: 'I', Name: "Kwanga"},
+	{Code: "kwk", Scope: 'I', Name: "Kwakiutl"},
+	{Code: "kwl", Scope: 'I', Name: "Kofyar"},
+	{Code: "kwm", Scope: 'I', Name: "Kwambi"},
+	{Code: "kwn", Scope: 'I', Name: "Kwangali"},
+	{Code: "kwo", Scope: 'I', Name: "Kwomtari"},
+	{Code: "kwp", Scope: 'I', Name: "Kodia"},
+	{Code: "kwr", Scope: 'I', Name: "Kwer"},
+	{Code: "kws", Scope: 'I', Name: "Kwese"},
+	{Code: "kwt", Scope: 'I', Name: "Kwesten"},
+	{Code: "kwu", Scope: 'I', Name: "Kwakum"},
+	{Code: "kwv", Scope: 'I', Name: "Sara Kaba Náà"},
+	{Code: "kww", Scope: 'I', Name: "Kwinti"},
+	{Code: "kwx", Scope: 'I', Name: "Khirwar"},
+	{Code: "kwy", Scope: 'I', Macro: "kg", Name: "San Salvador Kongo"},
+	{Code: "kwz", Scope: 'I', Name: "Kwadi"},
+	{Code: "kxa", Scope: 'I', Name: "Kairiru"},
+	{Code: "kxb", Scope: 'I', Name: "Krobu"},
+	{Code: "kxc", Scope: 'I', Name: "Konso"},
+	{Code: "kxd", Scope: 'I', Macro: "ms", Name: "Brunei"},
+	{Code: "kxf", Scope: 'I', Name: "Manumanaw Karen"},
+	{Code: "kxh", Scope: 'I', Name: "Karo (Ethiopia)"},
+	{Code: "kxi", Scope: 'I', Name: "Keningau Murut"},
+	{Code: "kxj", Scope: 'I', Name: "Kulfa"},
+	{Code: "kxk", Scope: 'I', Name: "Zayein Karen"},
+	{Code: "kxm", Scope: 'I', Name: "Northern Khmer"},
+	{Code: "kxn", Scope: 'I', Name: "Kanowit-Tanjong Melanau"},
+	{Code: "kxo", Scope: 'I', Name: "Kanoé"},
+	{Code: "kxp", Scope: 'I', Name: "Wadiyara Koli"},
+	{Code: "kxq", Scope: 'I', Name: "Smärky Kanum"},
+	{Code: "kxr", Scope: 'I', Name: "Koro (Papua New Guinea)"},
+	{Code: "kxs", Scope: 'I', Name: "Kangjia"},
+	{Code: "kxt", Scope: 'I', Name: "Koiwat"},
+	{Code: "kxv", Scope: 'I', Name: "Kuvi"},
+	{Code: "kxw", Scope: 'I', Name: "Konai"},
+	{Code: "kxx", Scope: 'I', Name: "Likuba"},
+	{Code: "kxy", Scope: 'I', Name: "Kayong"},
+	{Code: "kxz", Scope: 'I', Name: "Kerewo"},
+	{Code: "kya", Scope: 'I', Name: "Kwaya"},
+	{Code: "kyb", Scope: 'I', Name: "Butbut Kalinga"},
+	{Code: "kyc", Scope: 'I', Name: "Kyaka"},
+	{Code: "kyd", Scope: 'I', Name: "Karey"},
+	{Code: "kye", Scope: 'I', Name: "Krache"},
+	{Code: "kyf", Scope: 'I', Name: "Kouya"},
+	{Code: "kyg", Scope: 'I', Name: "Keyagana"},
+	{Code: "kyh", Scope: 'I', Name: "Karok"},
+	{Code: "kyi", Scope: 'I', Name: "Kiput"},
+	{Code: "kyj", Scope: 'I', Name: "Karao"},
+	{Code: "kyk", Scope: 'I', Name: "Kamayo"},
+	{Code: "kyl", Scope: 'I', Name: "Kalapuya"},
+	{Code: "kym", Scope: 'I', Name: "Kpatili"},
+	{Code: "kyn", Scope: 'I', Name: "Northern Binukidnon"},
+	{Code: "kyo", Scope: 'I', Name: "Kelon"},
+	{Code: "kyp", Scope: 'I', Name: "Kang"},
+	{Code: "kyq", Scope: 'I', Name: "Kenga"},
+	{Code: "kyr", Scope: 'I', Name: "Kuruáya"},
+	{Code: "kys", Scope: 'I', Name: "Baram Kayan"},
+	{Code: "kyt", Scope: 'I', Name: "Kayagar"},
+	{Code: "kyu", Scope: 'I', Name: "Western Kayah"},
+	{Code: "kyv", Scope: 'I', Name: "Kayort"},
+	{Code: "kyw", Scope: 'I', Name: "Kudmali"},
+	{Code: "kyx", Scope: 'I', Name: "Rapoisi"},
+	{Code: "kyy", Scope: 'I', Name: "Kambaira"},
+	{Code: "kyz", Scope: 'I', Name: "Kayabí"},
+	{Code: "kza", Scope: 'I', Name: "Western Karaboro"},
+	{Code: "kzb", Scope: 'I', Name: "Kaibobo"},
+	{Code: "kzc", Scope: 'I', Name: "Bondoukou Kulango"},
+	{Code: "kzd", Scope: 'I', Name: "Kadai"},
+	{Code: "kze", Scope: 'I', Name: "Kosena"},
+	{Code: "kzf", Scope: 'I', Name: "Da'a Kaili"},
+	{Code: "kzg", Scope: 'I', Name: "Kikai"},
+	{Code: "kzi", Scope: 'I', Name: "Kelabit"},
+	{Code: "kzk", Scope: 'I', Name: "Kazukuru"},
+	{Code: "kzl", Scope: 'I', Name: "Kayeli"},
+	{Code: "kzm", Scope: 'I', Name: "Kais"},
+	{Code: "kzn", Scope: 'I', Name: "Kokola"},
+	{Code: "kzo", Scope: 'I', Name: "Kaningi"},
+	{Code: "kzp", Scope: 'I', Name: "Kaidipang"},
+	{Code: "kzq", Scope: 'I', Name: "Kaike"},
+	{Code: "kzr", Scope: 'I', Name: "Karang"},
+	{Code: "kzs", Scope: 'I', Name: "Sugut Dusun"},
+	{Code: "kzu", Scope: 'I', Name: "Kayupulau"},
+	{Code: "kzv", Scope: 'I', Name: "Komyandaret"},
+	{Code: "kzw", Scope: 'I', Name: "Karirí-Xocó"},
+	{Code: "kzx", Scope: 'I', Name: "Kamarian"},
+	{Code: "kzy", Scope: 'I', Name: "Kango (Tshopo District)"},
+	{Code: "kzz", Scope: 'I', Name: "Kalabra"},
+	{Code: "laa", Scope: 'I', Name: "Southern Subanen"},
+	{Code: "lab", Scope: 'I', Name: "Linear A"},
+	{Code: "lac", Scope: 'I', Name: "Lacandon"},
+	{Code: "lad", Part2: "lad", Scope: 'I', Name: "Ladino"},
+	{Code: "lae", Scope: 'I', Name: "Pattani"},
+	{Code: "laf", Scope: 'I', Name: "Lafofa"},
+	{Code: "lag", Scope: 'I', Name: "Langi"},
+	{Code: "lah", Part2: "lah", Scope: 'M', Name: "Lahnda"},
+	{Code: "lai", Scope: 'I', Name: "Lambya"},
+	{Code: "laj", Scope: 'I', Name: "Lango (Uganda)"},
+	{Code: "lal", Scope: 'I', Name: "Lalia"},
+	{Code: "lam", Part2: "lam", Scope: 'I', Name: "Lamba"},
+	{Code: "lan", Scope: 'I', Name: "Laru"},
+	{Code: "lao", Part2: "lao", Part1: "lo", Scope: 'I', Name: "Lao"},
+	{Code: "lap", Scope: 'I', Name: "Laka (Chad)"},
+	{Code: "laq", Scope: 'I', Name: "Qabiao"},
+	{Code: "lar", Scope: 'I', Name: "Larteh"},
+	{Code: "las", Scope: 'I', Name: "Lama (Togo)"},
+	{Code: "lat", Part2: "lat", Part1: "la", Scope: 'I', Name: "Latin"},
+	{Code: "lau", Scope: 'I', Name: "Laba"},
+	{Code: "lav", Part2: "lav", Part1: "lv", Scope: 'M', Name: "Latvian"},
+	{Code: "law", Scope: 'I', Name: "Lauje"},
+	{Code: "lax", Scope: 'I', Name: "Tiwa"},
+	{Code: "lay", Scope: 'I', Name: "Lama Bai"},
+	{Code: "laz", Scope: 'I', Name: "Aribwatsa"},
+	{Code: "lbb", Scope: 'I', Name: "Label"},
+	{Code: "lbc", Scope: 'I', Name: "Lakkia"},
+	{Code: "lbe", Scope: 'I', Name: "Lak"},
+	{Code: "lbf", Scope: 'I', Name: "Tinani"},
+	{Code: "lbg", Scope: 'I', Name: "Laopang"},
+	{Code: "lbi", Scope: 'I', Name: "La'bi"},
+	{Code: "lbj", Scope: 'I', Name: "Ladakhi"},
+	{Code: "lbk", Scope: 'I', Macro: "bnc", Name: "Central Bontok"},
+	{Code: "lbl", Scope: 'I', Name: "Libon Bikol"},
+	{Code: "lbm", Scope: 'I', Name: "Lodhi"},
+	{Code: "lbn", Scope: 'I', Name: "Rmeet"},
+	{Code: "lbo", Scope: 'I', Name: "Laven"},
+	{Code: "lbq", Scope: 'I', Name: "Wampar"},
+	{Code: "lbr", Scope: 'I', Name: "Lohorung"},
+	{Code: "lbs", Scope: 'I', Name: "Libyan Sign Language"},
+	{Code: "lbt", Scope: 'I', Name: "Lachi"},
+	{Code: "lbu", Scope: 'I', Name: "Labu"},
+	{Code: "lbv", Scope: 'I', Name: "Lavatbura-Lamusong"},
+	{Code: "lbw", Scope: 'I', Name: "Tolaki"},
+	{Code: "lbx", Scope: 'I', Name: "Lawangan"},
+	{Code: "lby", Scope: 'I', Name: "Lamalama"},
+	{Code: "lbz", Scope: 'I', Name: "Lardil"},
+	{Code: "lcc", Scope: 'I', Name: "Legenyem"},
+	{Code: "lcd", Scope: 'I', Name: "Lola"},
+	{Code: "lce", Scope: 'I', Macro: "ms", Name: "Loncong"},
+	{Code: "lcf", Scope: 'I', Macro: "ms", Name: "Lubu"},
+	{Code: "lch", Scope: 'I', Name: "Luchazi"},
+	{Code: "lcl", Scope: 'I', Name: "Lisela"},
+	{Code: "lcm", Scope: 'I', Name: "Tungag"},
+	{Code: "lcp", Scope: 'I', Name: "Western Lawa"},
+	{Code: "lcq", Scope: 'I', Name: "Luhu"},
+	{Code: "lcs", Scope: 'I', Name: "Lisabata-Nuniali"},
+	{Code: "lda", Scope: 'I', Name: "Kla-Dan"},
+	{Code: "ldb", Scope: 'I', Name: "Dũya"},
+	{Code: "ldd", Scope: 'I', Name: "Luri"},
+	{Code: "ldg", Scope: 'I', Name: "Lenyima"},
+	{Code: "ldh", Scope: 'I', Name: "Lamja-Dengsa-Tola"},
+	{Code: "ldi", Scope: 'I', Macro: "kg", Name: "Laari"},
+	{Code: "ldj", Scope: 'I', Name: "Lemoro"},
+	{Code: "ldk", Scope: 'I', Name: "Leelau"},
+	{Code: "ldl", Scope: 'I', Name: "Kaan"},
+	{Code: "ldm", Scope: 'I', Name: "Landoma"},
+	{Code: "ldn", Scope: 'I', Name: "Láadan"},
+	{Code: "ldo", Scope: 'I', Name: "Loo"},
+	{Code: "ldp", Scope: 'I', Name: "Tso"},
+	{Code: "ldq", Scope: 'I', Name: "Lufu"},
+	{Code: "lea", Scope: 'I', Name: "Lega-Shabunda"},
+	{Code: "leb", Scope: 'I', Name: "Lala-Bisa"},
+	{Code: "lec", Scope: 'I', Name: "Leco"},
+	{Code: "led", Scope: 'I', Name: "Lendu"},
+	{Code: "lee", Scope: 'I', Name: "Lyélé"},
+	{Code: "lef", Scope: 'I', Name: "Lelemi"},
+	{Code: "leh", Scope: 'I', Name: "Lenje"},
+	{Code: "lei", Scope: 'I', Name: "Lemio"},
+	{Code: "lej", Scope: 'I', Name: "Lengola"},
+	{Code: "lek", Scope: 'I', Name: "Leipon"},
+	{Code: "lel", Scope: 'I', Name: "Lele (Democratic Republic of Congo)"},
+	{Code: "lem", Scope: 'I', Name: "Nomaande"},
+	{Code: "len", Scope: 'I', Name: "Lenca"},
+	{Code: "leo", Scope: 'I', Name: "Leti (Cameroon)"},
+	{Code: "lep", Scope: 'I', Name: "Lepcha"},
+	{Code: "leq", Scope: 'I', Name: "Lembena"},
+	{Code: "ler", Scope: 'I', Name: "Lenkau"},
+	{Code: "les", Scope: 'I', Name: "Lese"},
+	{Code: "let", Scope: 'I', Name: "Lesing-Gelimi"},
+	{Code: "leu", Scope: 'I', Name: "Kara (Papua New Guinea)"},
+	{Code: "lev", Scope: 'I', Name: "Lamma"},
+	{Code: "lew", Scope: 'I', Name: "Ledo Kaili"},
+	{Code: "lex", Scope: 'I', Name: "Luang"},
+	{Code: "ley", Scope: 'I', Name: "Lemolang"},
+	{Code: "lez", Part2: "lez", Scope: 'I', Name: "Lezghian"},
+	{Code: "lfa", Scope: 'I', Name: "Lefa"},
+	{Code: "lfn", Scope: 'I', Name: "Lingua Franca Nova"},
+	{Code: "lga", Scope: 'I', Name: "Lungga"},
+	{Code: "lgb", Scope: 'I', Name: "Laghu"},
+	{Code: "lgg", Scope: 'I', Name: "Lugbara"},
+	{Code: "lgh", Scope: 'I', Name: "Laghuu"},
+	{Code: "lgi", Scope: 'I', Name: "Lengilu"},
+	{Code: "lgk", Scope: 'I', Name: "Lingarak"},
+	{Code: "lgl", Scope: 'I', Name: "Wala"},
+	{Code: "lgm", Scope: 'I', Name: "Lega-Mwenga"},
+	{Code: "lgn", Scope: 'I', Name: "T'apo"},
+	{Code: "lgo", Scope: 'I', Name: "Lango (South Sudan)"},
+	{Code: "lgq", Scope: 'I', Name: "Logba"},
+	{Code: "lgr", Scope: 'I', Name: "Lengo"},
+	{Code: "lgt", Scope: 'I', Name: "Pahi"},
+	{Code: "lgu", Scope: 'I', Name: "Longgu"},
+	{Code: "lgz", Scope: 'I', Name: "Ligenza"},
+	{Code: "lha", Scope: 'I', Name: "Laha (Viet Nam)"},
+	{Code: "lhh", Scope: 'I', Name: "Laha (Indonesia)"},
+	{Code: "lhi", Scope: 'I', Name: "Lahu Shi"},
+	{Code: "lhl", Scope: 'I', Name: "Lahul Lohar"},
+	{Code: "lhm", Scope: 'I', Name: "Lhomi"},
+	{Code: "lhn", Scope: 'I', Name: "Lahanan"},
+	{Code: "lhp", Scope: 'I', Name: "Lhokpu"},
+	{Code: "lhs", Scope: 'I', Name: "Mlahsö"},
+	{Code: "lht", Scope: 'I', Name: "Lo-Toga"},
+	{Code: "lhu", Scope: 'I', Name: "Lahu"},
+	{Code: "lia", Scope: 'I', Name: "West-Central Limba"},
+	{Code: "lib", Scope: 'I', Name: "Likum"},
+	{Code: "lic", Scope: 'I', Name: "Hlai"},
+	{Code: "lid", Scope: 'I', Name: "Nyindrou"},
+	{Code: "lie", Scope: 'I', Name: "Likila"},
+	{Code: "lif", Scope: 'I', Name: "Limbu"},
+	{Code: "lig", Scope: 'I', Name: "Ligbi"},
+	{Code: "lih", Scope: 'I', Name: "Lihir"},
+	{Code: "lij", Scope: 'I', Name: "Ligurian"},
+	{Code: "lik", Scope: 'I', Name: "Lika"},
+	{Code: "lil", Scope: 'I', Name: "Lillooet"},
+	{Code: "lim", Part2: "lim", Part1: "li", Scope: 'I', Name: "Limburgan"},
+	{Code: "lin", Part2: "lin", Part1: "ln", Scope: 'I', Name: "Lingala"},
+	{Code: "lio", Scope: 'I', Name: "Liki"},
+	{Code: "lip", Scope: 'I', Name: "Sekpele"},
+	{Code: "liq", Scope: 'I', Name: "Libido"},
+	{Code: "lir", Scope: 'I', Name: "Liberian English"},
+	{Code: "lis", Scope: 'I', Name: "Lisu"},
+	{Code: "lit", Part2: "lit", Part1: "lt", Scope: 'I', Name: "Lithuanian"},
+	{Code: "liu", Scope: 'I', Name: "Logorik"},
+	{Code: "liv", Scope: 'I', Name: "Liv"},
+	{Code: "liw", Scope: 'I', Macro: "ms", Name: "Col"},
+	{Code: "lix", Scope: 'I', Name: "Liabuku"},
+	{Code: "liy", Scope: 'I', Name: "Banda-Bambari"},
+	{Code: "liz", Scope: 'I', Name: "Libinza"},
+	{Code: "lja", Scope: 'I', Name: "Golpa"},
+	{Code: "lje", Scope: 'I', Name: "Rampi"},
+	{Code: "lji", Scope: 'I', Name: "Laiyolo"},
+	{Code: "ljl", Scope: 'I', Name: "Li'o"},
+	{Code: "ljp", Scope: 'I', Name: "Lampung Api"},
+	{Code: "ljw", Scope: 'I', Name: "Yirandali"},
+	{Code: "ljx", Scope: 'I', Name: "Yuru"},
+	{Code: "lka", Scope: 'I', Name: "Lakalei"},
+	{Code: "lkb", Scope: 'I', Name: "Kabras"},
+	{Code: "lkc", Scope: 'I', Name: "Kucong"},
+	{Code: "lkd", Scope: 'I', Name: "Lakondê"},
+	{Code: "lke", Scope: 'I', Name: "Kenyi"},
+	{Code: "lkh", Scope: 'I', Name: "Lakha"},
+	{Code: "lki", Scope: 'I', Name: "Laki"},
+	{Code: "lkj", Scope: 'I', Name: "Remun"},
+	{Code: "lkl", Scope: 'I', Name: "Laeko-Libuat"},
+	{Code: "lkm", Scope: 'I', Name: "Kalaamaya"},
+	{Code: "lkn", Scope: 'I', Name: "Lakon"},
+	{Code: "lko", Scope: 'I', Name: "Khayo"},
+	{Code: "lkr", Scope: 'I', Name: "Päri"},
+	{Code: "lks", Scope: 'I', Name: "Kisa"},
+	{Code: "lkt", Scope: 'I', Name: "Lakota"},
+	{Code: "lku", Scope: 'I', Name: "Kungkari"},
+	{Code: "lky", Scope: 'I', Name: "Lokoya"},
+	{Code: "lla", Scope: 'I', Name: "Lala-Roba"},
+	{Code: "llb", Scope: 'I', Name: "Lolo"},
+	{Code: "llc", Scope: 'I', Name: "Lele (Guinea)"},
+	{Code: "lld", Scope: 'I', Name: "Ladin"},
+	{Code: "lle", Scope: 'I', Name: "Lele (Papua New Guinea)"},
+	{Code: "llf", Scope: 'I', Name: "Hermit"},
+	{Code: "llg", Scope: 'I', Name: "Lole"},
+	{Code: "llh", Scope: 'I', Name: "Lamu"},
+	{Code: "lli", Scope: 'I', Name: "Teke-Laali"},
+	{Code: "llj", Scope: 'I', Name: "Ladji Ladji"},
+	{Code: "llk", Scope: 'I', Name: "Lelak"},
+	{Code: "lll", Scope: 'I', Name: "Lilau"},
+	{Code: "llm", Scope: 'I', Name: "Lasalimu"},
+	{Code: "lln", Scope: 'I', Name: "Lele (Chad)"},
+	{Code: "llp", Scope: 'I', Name: "North Efate"},
+	{Code: "llq", Scope: 'I', Name: "Lolak"},
+	{Code: "lls", Scope: 'I', Name: "Lithuanian Sign Language"},
+	{Code: "llu", Scope: 'I', Name: "Lau"},
+	{Code: "llx", Scope: 'I', Name: "Lauan"},
+	{Code: "lma", Scope: 'I', Name: "East Limba"},
+	{Code: "lmb", Scope: 'I', Name: "Merei"},
+	{Code: "lmc", Scope: 'I', Name: "Limilngan"},
+	{Code: "lmd", Scope: 'I', Name: "Lumun"},
+	{Code: "lme", Scope: 'I', Name: "Pévé"},
+	{Code: "lmf", Scope: 'I', Name: "South Lembata"},
+	{Code: "lmg", Scope: 'I', Name: "Lamogai"},
+	{Code: "lmh", Scope: 'I', Name: "Lambichhong"},
+	{Code: "lmi", Scope: 'I', Name: "Lombi"},
+	{Code: "lmj", Scope: 'I', Name: "West Lembata"},
+	{Code: "lmk", Scope: 'I', Name: "Lamkang"},
+	{Code: "lml", Scope: 'I', Name: "Hano"},
+	{Code: "lmn", Scope: 'I', Name: "Lambadi"},
+	{Code: "lmo", Scope: 'I', Name: "Lombard"},
+	{Code: "lmp", Scope: 'I', Name: "Limbum"},
+	{Code: "lmq", Scope: 'I', Name: "Lamatuka"},
+	{Code: "lmr", Scope: 'I', Name: "Lamalera"},
+	{Code: "lmu", Scope: 'I', Name: "Lamenu"},
+	{Code: "lmv", Scope: 'I', Name: "Lomaiviti"},
+	{Code: "lmw", Scope: 'I', Name: "Lake Miwok"},
+	{Code: "lmx", Scope: 'I', Name: "Laimbue"},
+	{Code: "lmy", Scope: 'I', Name: "Lamboya"},
+	{Code: "lna", Scope: 'I', Name: "Langbashe"},
+	{Code: "lnb", Scope: 'I', Name: "Mbalanhu"},
+	{Code: "lnd", Scope: 'I', Name: "Lundayeh"},
+	{Code: "lng", Scope: 'I', Name: "Langobardic"},
+	{Code: "lnh", Scope: 'I', Name: "Lanoh"},
+	{Code: "lni", Scope: 'I', Name: "Daantanai'"},
+	{Code: "lnj", Scope: 'I', Name: "Leningitij"},
+	{Code: "lnl", Scope: 'I', Name: "South Central Banda"},
+	{Code: "lnm", Scope: 'I', Name: "Langam"},
+	{Code: "lnn", Scope: 'I', Name: "Lorediakarkar"},
+	{Code: "lns", Scope: 'I', Name: "Lamnso'"},
+	{Code: "lnu", Scope: 'I', Name: "Longuda"},
+	{Code: "lnw", Scope: 'I', Name: "Lanima"},
+	{Code: "lnz", Scope: 'I', Name: "Lonzo"},
+	{Code: "loa", Scope: 'I', Name: "Loloda"},
+	{Code: "lob", Scope: 'I', Name: "Lobi"},
+	{Code: "loc", Scope: 'I', Name: "Inonhan"},
+	{Code: "loe", Scope: 'I', Name: "Saluan"},
+	{Code: "lof", Scope: 'I', Name: "Logol"},
+	{Code: "log", Scope: 'I', Name: "Logo"},
+	{Code: "loh", Scope: 'I', Name: "Narim"},
+	{Code: "loi", Scope: 'I', Name: "Loma (Côte d'Ivoire)"},
+	{Code: "loj", Scope: 'I', Name: "Lou"},
+	{Code: "lok", Scope: 'I', Name: "Loko"},
+	{Code: "lol", Part2: "lol", Scope: 'I', Name: "Mongo"},
+	{Code: "lom", Scope: 'I', Name: "Loma (Liberia)"},
+	{Code: "lon", Scope: 'I', Name: "Malawi Lomwe"},
+	{Code: "loo", Scope: 'I', Name: "Lombo"},
+	{Code: "lop", Scope: 'I', Name: "Lopa"},
+	{Code: "loq", Scope: 'I', Name: "Lobala"},
+	{Code: "lor", Scope: 'I', Name: "Téén"},
+	{Code: "los", Scope: 'I', Name: "Loniu"},
+	{Code: "lot", Scope: 'I', Name: "Otuho"},
+	{Code: "lou", Scope: 'I', Name: "Louisiana Creole"},
+	{Code: "lov", Scope: 'I', Name: "Lopi"},
+	{Code: "low", Scope: 'I', Name: "Tampias Lobu"},
+	{Code: "lox", Scope: 'I', Name: "Loun"},
+	{Code: "loy", Scope: 'I', Name: "Loke"},
+	{Code: "loz", Part2: "loz", Scope: 'I', Name: "Lozi"},
+	{Code: "lpa", Scope: 'I', Name: "Lelepa"},
+	{Code: "lpe", Scope: 'I', Name: "Lepki"},
+	{Code: "lpn", Scope: 'I', Name: "Long Phuri Naga"},
+	{Code: "lpo", Scope: 'I', Name: "Lipo"},
+	{Code: "lpx", Scope: 'I', Name: "Lopit"},
+	{Code: "lqr", Scope: 'I', Name: "Logir"},
+	{Code: "lra", Scope: 'I', Name: "Rara Bakati'"},
+	{Code: "lrc", Scope: 'I', Name: "Northern Luri"},
+	{Code: "lre", Scope: 'I', Name: "Laurentian"},
+	{Code: "lrg", Scope: 'I', Name: "Laragia"},
+	{Code: "lri", Scope: 'I', Name: "Marachi"},
+	{Code: "lrk", Scope: 'I', Name: "Loarki"},
+	{Code: "lrl", Scope: 'I', Name: "Lari"},
+	{Code: "lrm", Scope: 'I', Name: "Marama"},
+	{Code: "lrn", Scope: 'I', Name: "Lorang"},
+	{Code: "lro", Scope: 'I', Name: "Laro"},
+	{Code: "lrr", Scope: 'I', Name: "Southern Yamphu"},
+	{Code: "lrt", Scope: 'I', Name: "Larantuka Malay"},
+	{Code: "lrv", Scope: 'I', Name: "Larevat"},
+	{Code: "lrz", Scope: 'I', Name: "Lemerig"},
+	{Code: "lsa", Scope: 'I', Name: "Lasgerdi"},
+	{Code: "lsb", Scope: 'I', Name: "Burundian Sign Language"},
+	{Code: "lsc", Scope: 'I', Name: "Albarradas Sign Language"},
+	{Code: "lsd", Scope: 'I', Name: "Lishana Deni"},
+	{Code: "lse", Scope: 'I', Name: "Lusengo"},
+	{Code: "lsh", Scope: 'I', Name: "Lish"},
+	{Code: "lsi", Scope: 'I', Name: "Lashi"},
+	{Code: "lsl", Scope: 'I', Name: "Latvian Sign Language"},
+	{Code: "lsm", Scope: 'I', Name: "Saamia"},
+	{Code: "lsn", Scope: 'I', Name: "Tibetan Sign Language"},
+	{Code: "lso", Scope: 'I', Name: "Laos Sign Language"},
+	{Code: "lsp", Scope: 'I', Name: "Panamanian Sign Language"},
+	{Code: "lsr", Scope: 'I', Name: "Aruop"},
+	{Code: "lss", Scope: 'I', Name: "Lasi"},
+	{Code: "lst", Scope: 'I', Name: "Trinidad and Tobago Sign Language"},
+	{Code: "lsv", Scope: 'I', Name: "Sivia Sign Language"},
+	{Code: "lsw", Scope: 'I', Name: "Seychelles Sign Language"},
+	{Code: "lsy", Scope: 'I', Name: "Mauritian Sign Language"},
+	{Code: "ltc", Scope: 'I', Name: "Late Middle Chinese"},
+	{Code: "ltg", Scope: 'I', Macro: "lv", Name: "Latgalian"},
+	{Code: "lth", Scope: 'I', Name: "Thur"},
+	{Code: "lti", Scope: 'I', Name: "Leti (Indonesia)"},
+	{Code: "ltn", Scope: 'I', Name: "Latundê"},
+	{Code: "lto", Scope: 'I', Name: "Tsotso"},
+	{Code: "lts", Scope: 'I', Name: "Tachoni"},
+	{Code: "ltu", Scope: 'I', Name: "Latu"},
+	{Code: "ltz", Part2: "ltz", Part1: "lb", Scope: 'I', Name: "Luxembourgish"},
+	{Code: "lua", Part2: "lua", Scope: 'I', Name: "Luba-Lulua"},
+	{Code: "lub", Part2: "lub", Part1: "lu", Scope: 'I', Name: "Luba-Katanga"},
+	{Code: "luc", Scope: 'I', Name: "Aringa"},
+	{Code: "lud", Scope: 'I', Name: "Ludian"},
+	{Code: "lue", Scope: 'I', Name: "Luvale"},
+	{Code: "luf", Scope: 'I', Name: "Laua"},
+	{Code: "lug", Part2: "lug", Part1: "lg", Scope: 'I', Name: "Ganda"},
+	{Code: "lui", Part2: "lui", Scope: 'I', Name: "Luiseno"},
+	{Code: "luj", Scope: 'I', Name: "Luna"},
+	{Code: "luk", Scope: 'I', Name: "Lunanakha"},
+	{Code: "lul", Scope: 'I', Name: "Olu'bo"},
+	{Code: "lum", Scope: 'I', Name: "Luimbi"},
+	{Code: "lun", Part2: "lun", Scope: 'I', Name: "Lunda"},
+	{Code: "luo", Part2: "luo", Scope: 'I', Name: "Luo (Kenya and Tanzania)"},
+	{Code: "lup", Scope: 'I', Name: "Lumbu"},
+	{Code: "luq", Scope: 'I', Name: "Lucumi"},
+	{Code: "lur", Scope: 'I', Name: "Laura"},
+	{Code: "lus", Part2: "lus", Scope: 'I', Name: "Lushai"},
+	{Code: "lut", Scope: 'I', Name: "Lushootseed"},
+	{Code: "luu", Scope: 'I', Name: "Lumba-Yakkha"},
+	{Code: "luv", Scope: 'I', Name: "Luwati"},
+	{Code: "luw", Scope: 'I', Name: "Luo (Cameroon)"},
+	{Code: "luy", Scope: 'M', Name: "Luyia"},
+	{Code: "luz", Scope: 'I', Name: "Southern Luri"},
+	{Code: "lva", Scope: 'I', Name: "Maku'a"},
+	{Code: "lvi", Scope: 'I', Name: "Lavi"},
+	{Code: "lvk", Scope: 'I', Name: "Lavukaleve"},
+	{Code: "lvs", Scope: 'I', Macro: "lv", Name: "Standard Latvian"},
+	{Code: "lvu", Scope: 'I', Name: "Levuka"},
+	{Code: "lwa", Scope: 'I', Name: "Lwalu"},
+	{Code: "lwe", Scope: 'I', Name: "Lewo Eleng"},
+	{Code: "lwg", Scope: 'I', Name: "Wanga"},
+	{Code: "lwh", Scope: 'I', Name: "White Lachi"},
+	{Code: "lwl", Scope: 'I', Name: "Eastern Lawa"},
+	{Code: "lwm", Scope: 'I', Name: "Laomian"},
+	{Code: "lwo", Scope: 'I', Name: "Luwo"},
+	{Code: "lws", Scope: 'I', Name: "Malawian Sign Language"},
+	{Code: "lwt", Scope: 'I', Name: "Lewotobi"},
+	{Code: "lwu", Scope: 'I', Name: "Lawu"},
+	{Code: "lww", Scope: 'I', Name: "Lewo"},
+	{Code: "lxm", Scope: 'I', Name: "Lakurumau"},
+	{Code: "lya", Scope: 'I', Name: "Layakha"},
+	{Code: "lyg", Scope: 'I', Name: "Lyngngam"},
+	{Code: "lyn", Scope: 'I', Name: "Luyana"},
+	{Code: "lzh", Scope: 'I', Macro: "zh", Name: "Literary Chinese"},
+	{Code: "lzl", Scope: 'I', Name: "Litzlitz"},
+	{Code: "lzn", Scope: 'I', Name: "Leinong Naga"},
+	{Code: "lzz", Scope: 'I', Name: "Laz"},
+	{Code: "maa", Scope: 'I', Name: "San Jerónimo Tecóatl Mazatec"},
+	{Code: "mab", Scope: 'I', Name: "Yutanduchi Mixtec"},
+	{Code: "mad", Part2: "mad", Scope: 'I', Name: "Madurese"},
+	{Code: "mae", Scope: 'I', Name: "Bo-Rukul"},
+	{Code: "maf", Scope: 'I', Name: "Mafa"},
+	{Code: "mag", Part2: "mag", Scope: 'I', Name: "Magahi"},
+	{Code: "mah", Part2: "mah", Part1: "mh", Scope: 'I', Name: "Marshallese"},
+	{Code: "mai", Part2: "mai", Scope: 'I', Name: "Maithili"},
+	{Code: "maj", Scope: 'I', Name: "Jalapa De Díaz Mazatec"},
+	{Code: "mak", Part2: "mak", Scope: 'I', Name: "Makasar"},
+	{Code: "mal", Part2: "mal", Part1: "ml", Scope: 'I', Name: "Malayalam"},
+	{Code: "mam", Scope: 'I', Name: "Mam"},
+	{Code: "man", Part2: "man", Scope: 'M', Name: "Mandingo"},
+	{Code: "maq", Scope: 'I', Name: "Chiquihuitlán Mazatec"},
+	{Code: "mar", Part2: "mar", Part1: "mr", Scope: 'I', Name: "Marathi"},
+	{Code: "mas", Part2: "mas", Scope: 'I', Name: "Masai"},
+	{Code: "mat", Scope: 'I', Name: "San Francisco Matlatzinca"},
+	{Code: "mau", Scope: 'I', Name: "Huautla Mazatec"},
+	{Code: "mav", Scope: 'I', Name: "Sateré-Mawé"},
+	{Code: "maw", Scope: 'I', Name: "Mampruli"},
+	{Code: "max", Scope: 'I', Macro: "ms", Name: "North Moluccan Malay"},
+	{Code: "maz", Scope: 'I', Name: "Central Mazahua"},
+	{Code: "mba", Scope: 'I', Name: "Higaonon"},
+	{Code: "mbb", Scope: 'I', Name: "Western Bukidnon Manobo"},
+	{Code: "mbc", Scope: 'I', Name: "Macushi"},
+	{Code: "mbd", Scope: 'I', Name: "Dibabawon Manobo"},
+	{Code: "mbe", Scope: 'I', Name: "Molale"},
+	{Code: "mbf", Scope: 'I', Name: "Baba Malay"},
+	{Code: "mbh", Scope: 'I', Name: "Mangseng"},
+	{Code: "mbi", Scope: 'I', Name: "Ilianen Manobo"},
+	{Code: "mbj", Scope: 'I', Name: "Nadëb"},
+	{Code: "mbk", Scope: 'I', Name: "Malol"},
+	{Code: "mbl", Scope: 'I', Name: "Maxakalí"},
+	{Code: "mbm", Scope: 'I', Name: "Ombamba"},
+	{Code: "mbn", Scope: 'I', Name: "Macaguán"},
+	{Code: "mbo", Scope: 'I', Name: "Mbo (Cameroon)"},
+	{Code: "mbp", Scope: 'I', Name: "Malayo"},
+	{Code: "mbq", Scope: 'I', Name: "Maisin"},
+	{Code: "mbr", Scope: 'I', Name: "Nukak Makú"},
+	{Code: "mbs", Scope: 'I', Name: "Sarangani Manobo"},
+	{Code: "mbt", Scope: 'I', Name: "Matigsalug Manobo"},
+	{Code: "mbu", Scope: 'I', Name: "Mbula-Bwazza"},
+	{Code: "mbv", Scope: 'I', Name: "Mbulungish"},
+	{Code: "mbw", Scope: 'I', Name: "Maring"},
+	{Code: "mbx", Scope: 'I', Name: "Mari (East Sepik Province)"},
+	{Code: "mby", Scope: 'I', Name: "Memoni"},
+	{Code: "mbz", Scope: 'I', Name: "Amoltepec Mixtec"},
+	{Code: "mca", Scope: 'I', Name: "Maca"},
+	{Code: "mcb", Scope: 'I', Name: "Machiguenga"},
+	{Code: "mcc", Scope: 'I', Name: "Bitur"},
+	{Code: "mcd", Scope: 'I', Name: "Sharanahua"},
+	{Code: "mce", Scope: 'I', Name: "Itundujia Mixtec"},
+	{Code: "mcf", Scope: 'I', Name: "Matsés"},
+	{Code: "mcg", Scope: 'I', Name: "Mapoyo"},
+	{Code: "mch", Scope: 'I', Name: "Maquiritari"},
+	{Code: "mci", Scope: 'I', Name: "Mese"},
+	{Code: "mcj", Scope: 'I', Name: "Mvanip"},
+	{Code: "mck", Scope: 'I', Name: "Mbunda"},
+	{Code: "mcl", Scope: 'I', Name: "Macaguaje"},
+	{Code: "mcm", Scope: 'I', Name: "Malaccan Creole Portuguese"},
+	{Code: "mcn", Scope: 'I', Name: "Masana"},
+	{Code: "mco", Scope: 'I', Name: "Coatlán Mixe"},
+	{Code: "mcp", Scope: 'I', Name: "Makaa"},
+	{Code: "mcq", Scope: 'I', Name: "Ese"},
+	{Code: "mcr", Scope: 'I', Name: "Menya"},
+	{Code: "mcs", Scope: 'I', Name: "Mambai"},
+	{Code: "mct", Scope: 'I', Name: "Mengisa"},
+	{Code: "mcu", Scope: 'I', Name: "Cameroon Mambila"},
+	{Code: "mcv", Scope: 'I', Name: "Minanibai"},
+	{Code: "mcw", Scope: 'I', Name: "Mawa (Chad)"},
+	{Code: "mcx", Scope: 'I', Name: "Mpiemo"},
+	{Code: "mcy", Scope: 'I', Name: "South Watut"},
+	{Code: "mcz", Scope: 'I', Name: "Mawan"},
+	{Code: "mda", Scope: 'I', Name: "Mada (Nigeria)"},
+	{Code: "mdb", Scope: 'I', Name: "Morigi"},
+	{Code: "mdc", Scope: 'I', Name: "Male (Papua New Guinea)"},
+	{Code: "mdd", Scope: 'I', Name: "Mbum"},
+	{Code: "mde", Scope: 'I', Name: "Maba (Chad)"},
+	{Code: "mdf", Part2: "mdf", Scope: 'I', Name: "Moksha"},
+	{Code: "mdg", Scope: 'I', Name: "Massalat"},
+	{Code: "mdh", Scope: 'I', Name: "Maguindanaon"},
+	{Code: "mdi", Scope: 'I', Name: "Mamvu"},
+	{Code: "mdj", Scope: 'I', Name: "Mangbetu"},
+	{Code: "mdk", Scope: 'I', Name: "Mangbutu"},
+	{Code: "mdl", Scope: 'I', Name: "Maltese Sign Language"},
+	{Code: "mdm", Scope: 'I', Name: "Mayogo"},
+	{Code: "mdn", Scope: 'I', Name: "Mbati"},
+	{Code: "mdp", Scope: 'I', Name: "Mbala"},
+	{Code: "mdq", Scope: 'I', Name: "Mbole"},
+	{Code: "mdr", Part2: "mdr", Scope: 'I', Name: "Mandar"},
+	{Code: "mds", Scope: 'I', Name: "Maria (Papua New Guinea)"},
+	{Code: "mdt", Scope: 'I', Name: "Mbere"},
+	{Code: "mdu", Scope: 'I', Name: "Mboko"},
+	{Code: "mdv", Scope: 'I', Name: "Santa Lucía Monteverde Mixtec"},
+	{Code: "mdw", Scope: 'I', Name: "Mbosi"},
+	{Code: "mdx", Scope: 'I', Name: "Dizin"},
+	{Code: "mdy", Scope: 'I', Name: "Male (Ethiopia)"},
+	{Code: "mdz", Scope: 'I', Name: "Suruí Do Pará"},
+	{Code: "mea", Scope: 'I', Name: "Menka"},
+	{Code: "meb", Scope: 'I', Name: "Ikobi"},
+	{Code: "mec", Scope: 'I', Name: "Marra"},
+	{Code: "med", Scope: 'I', Name: "Melpa"},
+	{Code: "mee", Scope: 'I', Name: "Mengen"},
+	{Code: "mef", Scope: 'I', Name: "Megam"},
+	{Code: "meh", Scope: 'I', Name: "Southwestern Tlaxiaco Mixtec"},
+	{Code: "mei", Scope: 'I', Name: "Midob"},
+	{Code: "mej", Scope: 'I', Name: "Meyah"},
+	{Code: "mek", Scope: 'I', Name: "Mekeo"},
+	{Code: "mel", Scope: 'I', Name: "Central Melanau"},
+	{Code: "mem", Scope: 'I', Name: "Mangala"},
+	{Code: "men", Part2: "men", Scope: 'I', Name: "Mende (Sierra Leone)"},
+	{Code: "meo", Scope: 'I', Macro: "ms", Name: "Kedah Malay"},
+	{Code: "mep", Scope: 'I', Name: "Miriwoong"},
+	{Code: "meq", Scope: 'I', Name: "Merey"},
+	{Code: "mer", Scope: 'I', Name: "Meru"},
+	{Code: "mes", Scope: 'I', Name: "Masmaje"},
+	{Code: "met", Scope: 'I', Name: "Mato"},
+	{Code: "meu", Scope: 'I', Name: "Motu"},
+	{Code: "mev", Scope: 'I', Name: "Mano"},
+	{Code: "mew", Scope: 'I', Name: "Maaka"},
+	{Code: "mey", Scope: 'I', Name: "Hassaniyya"},
+	{Code: "mez", Scope: 'I', Name: "Menominee"},
+	{Code: "mfa", Scope: 'I', Macro: "ms", Name: "Pattani Malay"},
+	{Code: "mfb", Scope: 'I', Macro: "ms", Name: "Bangka"},
+	{Code: "mfc", Scope: 'I', Name: "Mba"},
+	{Code: "mfd", Scope: 'I', Name: "Mendankwe-Nkwen"},
+	{Code: "mfe", Scope: 'I', Name: "Morisyen"},
+	{Code: "mff", Scope: 'I', Name: "Naki"},
+	{Code: "mfg", Scope: 'I', Name: "Mogofin"},
+	{Code: "mfh", Scope: 'I', Name: "Matal"},
+	{Code: "mfi", Scope: 'I', Name: "Wandala"},
+	{Code: "mfj", Scope: 'I', Name: "Mefele"},
+	{Code: "mfk", Scope: 'I', Name: "North Mofu"},
+	{Code: "mfl", Scope: 'I', Name: "Putai"},
+	{Code: "mfm", Scope: 'I', Name: "Marghi South"},
+	{Code: "mfn", Scope: 'I', Name: "Cross River Mbembe"},
+	{Code: "mfo", Scope: 'I', Name: "Mbe"},
+	{Code: "mfp", Scope: 'I', Name: "Makassar Malay"},
+	{Code: "mfq", Scope: 'I', Name: "Moba"},
+	{Code: "mfr", Scope: 'I', Name: "Marrithiyel"},
+	{Code: "mfs", Scope: 'I', Name: "Mexican Sign Language"},
+	{Code: "mft", Scope: 'I', Name: "Mokerang"},
+	{Code: "mfu", Scope: 'I', Name: "Mbwela"},
+	{Code: "mfv", Scope: 'I', Name: "Mandjak"},
+	{Code: "mfw", Scope: 'I', Name: "Mulaha"},
+	{Code: "mfx", Scope: 'I', Name: "Melo"},
+	{Code: "mfy", Scope: 'I', Name: "Mayo"},
+	{Code: "mfz", Scope: 'I', Name: "Mabaan"},
+	{Code: "mga", Part2: "mga", Scope: 'I', Name: "Middle Irish (900-1200)"},
+	{Code: "mgb", Scope: 'I', Name: "Mararit"},
+	{Code: "mgc", Scope: 'I', Name: "Morokodo"},
+	{Code: "mgd", Scope: 'I', Name: "Moru"},
+	{Code: "mge", Scope: 'I', Name: "Mango"},
+	{Code: "mgf", Scope: 'I', Name: "Maklew"},
+	{Code: "mgg", Scope: 'I', Name: "Mpumpong"},
+	{Code: "mgh", Scope: 'I', Name: "Makhuwa-Meetto"},
+	{Code: "mgi", Scope: 'I', Name: "Lijili"},
+	{Code: "mgj", Scope: 'I', Name: "Abureni"},
+	{Code: "mgk", Scope: 'I', Name: "Mawes"},
+	{Code: "mgl", Scope: 'I', Name: "Maleu-Kilenge"},
+	{Code: "mgm", Scope: 'I', Name: "Mambae"},
+	{Code: "mgn", Scope: 'I', Name: "Mbangi"},
+	{Code: "mgo", Scope: 'I', Name: "Meta'"},
+	{Code: "mgp", Scope: 'I', Name: "Eastern Magar"},
+	{Code: "mgq", Scope: 'I', Name: "Malila"},
+	{Code: "mgr", Scope: 'I', Name: "Mambwe-Lungu"},
+	{Code: "mgs", Scope: 'I', Name: "Manda (Tanzania)"},
+	{Code: "mgt", Scope: 'I', Name: "Mongol"},
+	{Code: "mgu", Scope: 'I', Name: "Mailu"},
+	{Code: "mgv", Scope: 'I', Name: "Matengo"},
+	{Code: "mgw", Scope: 'I', Name: "Matumbi"},
+	{Code: "mgy", Scope: 'I', Name: "Mbunga"},
+	{Code: "mgz", Scope: 'I', Name: "Mbugwe"},
+	{Code: "mha", Scope: 'I', Name: "Manda (India)"},
+	{Code: "mhb", Scope: 'I', Name: "Mahongwe"},
+	{Code: "mhc", Scope: 'I', Name: "Mocho"},
+	{Code: "mhd", Scope: 'I', Name: "Mbugu"},
+	{Code: "mhe", Scope: 'I', Name: "Besisi"},
+	{Code: "mhf", Scope: 'I', Name: "Mamaa"},
+	{Code: "mhg", Scope: 'I', Name: "Margu"},
+	{Code: "mhi", Scope: 'I', Name: "Ma'di"},
+	{Code: "mhj", Scope: 'I', Name: "Mogholi"},
+	{Code: "mhk", Scope: 'I', Name: "Mungaka"},
+	{Code: "mhl", Scope: 'I', Name: "Mauwake"},
+	{Code: "mhm", Scope: 'I', Name: "Makhuwa-Moniga"},
+	{Code: "mhn", Scope: 'I', Name: "Mócheno"},
+	{Code: "mho", Scope: 'I', Name: "Mashi (Zambia)"},
+	{Code: "mhp", Scope: 'I', Name: "Balinese Malay"},
+	{Code: "mhq", Scope: 'I', Name: "Mandan"},
+	{Code: "mhr", Scope: 'I', Macro: "chm", Name: "Eastern Mari"},
+	{Code: "mhs", Scope: 'I', Name: "Buru (Indonesia)"},
+	{Code: "mht", Scope: 'I', Name: "Mandahuaca"},
+	{Code: "mhu", Scope: 'I', Name: "Digaro-Mishmi"},
+	{Code: "mhw", Scope: 'I', Name: "Mbukushu"},
+	{Code: "mhx", Scope: 'I', Name: "Maru"},
+	{Code: "mhy", Scope: 'I', Name: "Ma'anyan"},
+	{Code: "mhz", Scope: 'I', Name: "Mor (Mor Islands)"},
+	{Code: "mia", Scope: 'I', Name: "Miami"},
+	{Code: "mib", Scope: 'I', Name: "Atatláhuca Mixtec"},
+	{Code: "mic", Part2: "mic", Scope: 'I', Name: "Mi'kmaq"},
+	{Code: "mid", Scope: 'I', Name: "Mandaic"},
+	{Code: "mie", Scope: 'I', Name: "Ocotepec Mixtec"},
+	{Code: "mif", Scope: 'I', Name: "Mofu-Gudur"},
+	{Code: "mig", Scope: 'I', Name: "San Miguel El Grande Mixtec"},
+	{Code: "mih", Scope: 'I', Name: "Chayuco Mixtec"},
+	{Code: "mii", Scope: 'I', Name: "Chigmecatitlán Mixtec"},
+	{Code: "mij", Scope: 'I', Name: "Abar"},
+	{Code: "mik", Scope: 'I', Name: "Mikasuki"},
+	{Code: "mil", Scope: 'I', Name: "Peñoles Mixtec"},
+	{Code: "mim", Scope: 'I', Name: "Alacatlatzala Mixtec"},
+	{Code: "min", Part2: "min", Scope: 'I', Macro: "ms", Name: "Minangkabau"},
+	{Code: "mio", Scope: 'I', Name: "Pinotepa Nacional Mixtec"},
+	{Code: "mip", Scope: 'I', Name: "Apasco-Apoala Mixtec"},
+	{Code: "miq", Scope: 'I', Name: "Mískito"},
+	{Code: "mir", Scope: 'I', Name: "Isthmus Mixe"},
+	{Code: "mis", Part2: "mis", Scope: 'S', Name: "Uncoded languages"},
+	{Code: "mit", Scope: 'I', Name: "Southern Puebla Mixtec"},
+	{Code: "miu", Scope: 'I', Name: "Cacaloxtepec Mixtec"},
+	{Code: "miw", Scope: 'I', Name: "Akoye"},
+	{Code: "mix", Scope: 'I', Name: "Mixtepec Mixtec"},
+	{Code: "miy", Scope: 'I', Name: "Ayutla Mixtec"},
+	{Code: "miz", Scope: 'I', Name: "Coatzospan Mixtec"},
+	{Code: "mjb", Scope: 'I', Name: "Makalero"},
+	{Code: "mjc", Scope: 'I', Name: "San Juan Colorado Mixtec"},
+	{Code: "mjd", Scope: 'I', Name: "Northwest Maidu"},
+	{Code: "mje", Scope: 'I', Name: "Muskum"},
+	{Code: "mjg", Scope: 'I', Name: "Tu"},
+	{Code: "mjh", Scope: 'I', Name: "Mwera (Nyasa)"},
+	{Code: "mji", Scope: 'I', Name: "Kim Mun"},
+	{Code: "mjj", Scope: 'I', Name: "Mawak"},
+	{Code: "mjk", Scope: 'I', Name: "Matukar"},
+	{Code: "mjl", Scope: 'I', Name: "Mandeali"},
+	{Code: "mjm", Scope: 'I', Name: "Medebur"},
+	{Code: "mjn", Scope: 'I', Name: "Ma (Papua New Guinea)"},
+	{Code: "mjo", Scope: 'I', Name: "Malankuravan"},
+	{Code: "mjp", Scope: 'I', Name: "Malapandaram"},
+	{Code: "mjq", Scope: 'I', Name: "Malaryan"},
+	{Code: "mjr", Scope: 'I', Name: "Malavedan"},
+	{Code: "mjs", Scope: 'I', Name: "Miship"},
+	{Code: "mjt", Scope: 'I', Name: "Sauria Paharia"},
+	{Code: "mju", Scope: 'I', Name: "Manna-Dora"},
+	{Code: "mjv", Scope: 'I', Name: "Mannan"},
+	{Code: "mjw", Scope: 'I', Name: "Karbi"},
+	{Code: "mjx", Scope: 'I', Name: "Mahali"},
+	{Code: "mjy", Scope: 'I', Name: "Mahican"},
+	{Code: "mjz", Scope: 'I', Name: "Majhi"},
+	{Code: "mka", Scope: 'I', Name: "Mbre"},
+	{Code: "mkb", Scope: 'I', Name: "Mal Paharia"},
+	{Code: "mkc", Scope: 'I', Name: "Siliput"},
+	{Code: "mkd", Part2: "mkd", Part1: "mk", Scope: 'I', Name: "Macedonian"},
+	{Code: "mke", Scope: 'I', Name: "Mawchi"},
+	{Code: "mkf", Scope: 'I', Name: "Miya"},
+	{Code: "mkg", Scope: 'I', Name: "Mak (China)"},
+	{Code: "mki", Scope: 'I', Name: "Dhatki"},
+	{Code: "mkj", Scope: 'I', Name: "Mokilese"},
+	{Code: "mkk", Scope: 'I', Name: "Byep"},
+	{Code: "mkl", Scope: 'I', Name: "Mokole"},
+	{Code: "mkm", Scope: 'I', Name: "Moklen"},
+	{Code: "mkn", Scope: 'I', Name: "Kupang Malay"},
+	{Code: "mko", Scope: 'I', Name: "Mingang Doso"},
+	{Code: "mkp", Scope: 'I', Name: "Moikodi"},
+	{Code: "mkq", Scope: 'I', Name: "Bay Miwok"},
+	{Code: "mkr", Scope: 'I', Name: "Malas"},
+	{Code: "mks", Scope: 'I', Name: "Silacayoapan Mixtec"},
+	{Code: "mkt", Scope: 'I', Name: "Vamale"},
+	{Code: "mku", Scope: 'I', Name: "Konyanka Maninka"},
+	{Code: "mkv", Scope: 'I', Name: "Mafea"},
+	{Code: "mkw", Scope: 'I', Name: "Kituba (Congo)"},
+	{Code: "mkx", Scope: 'I', Name: "Kinamiging Manobo"},
+	{Code: "mky", Scope: 'I', Name: "East Makian"},
+	{Code: "mkz", Scope: 'I', Name: "Makasae"},
+	{Code: "mla", Scope: 'I', Name: "Malo"},
+	{Code: "mlb", Scope: 'I', Name: "Mbule"},
+	{Code: "mlc", Scope: 'I', Name: "Cao Lan"},
+	{Code: "mle", Scope: 'I', Name: "Manambu"},
+	{Code: "mlf", Scope: 'I', Name: "Mal"},
+	{Code: "mlg", Part2: "mlg", Part1: "mg", Scope: 'M', Name: "Malagasy"},
+	{Code: "mlh", Scope: 'I', Name: "Mape"},
+	{Code: "mli", Scope: 'I', Name: "Malimpung"},
+	{Code: "mlj", Scope: 'I', Name: "Miltu"},
+	{Code: "mlk", Scope: 'I', Name: "Ilwana"},
+	{Code: "mll", Scope: 'I', Name: "Malua Bay"},
+	{Code: "mlm", Scope: 'I', Name: "Mulam"},
+	{Code: "mln", Scope: 'I', Name: "Malango"},
+	{Code: "mlo", Scope: 'I', Name: "Mlomp"},
+	{Code: "mlp", Scope: 'I', Name: "Bargam"},
+	{Code: "mlq", Scope: 'I', Name: "Western Maninkakan"},
+	{Code: "mlr", Scope: 'I', Name: "Vame"},
+	{Code: "mls", Scope: 'I', Name: "Masalit"},
+	{Code: "mlt", Part2: "mlt", Part1: "mt", Scope: 'I', Name: "Maltese"},
+	{Code: "mlu", Scope: 'I', Name: "To'abaita"},
+	{Code: "mlv", Scope: 'I', Name: "Motlav"},
+	{Code: "mlw", Scope: 'I', Name: "Moloko"},
+	{Code: "mlx", Scope: 'I', Name: "Malfaxal"},
+	{Code: "mlz", Scope: 'I', Name: "Malaynon"},
+	{Code: "mma", Scope: 'I', Name: "Mama"},
+	{Code: "mmb", Scope: 'I', Name: "Momina"},
+	{Code: "mmc", Scope: 'I', Name: "Michoacán Mazahua"},
+	{Code: "mmd", Scope: 'I', Name: "Maonan"},
+	{Code: "mme", Scope: 'I', Name: "Mae"},
+	{Code: "mmf", Scope: 'I', Name: "Mundat"},
+	{Code: "mmg", Scope: 'I', Name: "North Ambrym"},
+	{Code: "mmh", Scope: 'I', Name: "Mehináku"},
+	{Code: "mmi", Scope: 'I', Name: "Musar"},
+	{Code: "mmj", Scope: 'I', Name: "Majhwar"},
+	{Code: "mmk", Scope: 'I', Name: "Mukha-Dora"},
+	{Code: "mml", Scope: 'I', Name: "Man Met"},
+	{Code: "mmm", Scope: 'I', Name: "Maii"},
+	{Code: "mmn", Scope: 'I', Name: "Mamanwa"},
+	{Code: "mmo", Scope: 'I', Name: "Mangga Buang"},
+	{Code: "mmp", Scope: 'I', Name: "Siawi"},
+	{Code: "mmq", Scope: 'I', Name: "Musak"},
+	{Code: "mmr", Scope: 'I', Name: "Western Xiangxi Miao"},
+	{Code: "mmt", Scope: 'I', Name: "Malalamai"},
+	{Code: "mmu", Scope: 'I', Name: "Mmaala"},
+	{Code: "mmv", Scope: 'I', Name: "Miriti"},
+	{Code: "mmw", Scope: 'I', Name: "Emae"},
+	{Code: "mmx", Scope: 'I', Name: "Madak"},
+	{Code: "mmy", Scope: 'I', Name: "Migaama"},
+	{Code: "mmz", Scope: 'I', Name: "Mabaale"},
+	{Code: "mna", Scope: 'I', Name: "Mbula"},
+	{Code: "mnb", Scope: 'I', Name: "Muna"},
+	{Code: "mnc", Part2: "mnc", Scope: 'I', Name: "Manchu"},
+	{Code: "mnd", Scope: 'I', Name: "Mondé"},
+	{Code: "mne", Scope: 'I', Name: "Naba"},
+	{Code: "mnf", Scope: 'I', Name: "Mundani"},
+	{Code: "mng", Scope: 'I', Name: "Eastern Mnong"},
+	{Code: "mnh", Scope: 'I', Name: "Mono (Democratic Republic of Congo)"},
+	{Code: "mni", Part2: "mni", Scope: 'I', Name: "Manipuri"},
+	{Code: "mnj", Scope: 'I', Name: "Munji"},
+	{Code: "mnk", Scope: 'I', Macro: "man", Name: "Mandinka"},
+	{Code: "mnl", Scope: 'I', Name: "Tiale"},
+	{Code: "mnm", Scope: 'I', Name: "Mapena"},
+	{Code: "mnn", Scope: 'I', Name: "Southern Mnong"},
+	{Code: "mnp", Scope: 'I', Macro: "zh", Name: "Min Bei Chinese"},
+	{Code: "mnq", Scope: 'I', Name: "Minriq"},
+	{Code: "mnr", Scope: 'I', Name: "Mono (USA)"},
+	{Code: "mns", Scope: 'I', Name: "Mansi"},
+	{Code: "mnu", Scope: 'I', Name: "Mer"},
+	{Code: "mnv", Scope: 'I', Name: "Rennell-Bellona"},
+	{Code: "mnw", Scope: 'I', Name: "Mon"},
+	{Code: "mnx", Scope: 'I', Name: "Manikion"},
+	{Code: "mny", Scope: 'I', Name: "Manyawa"},
+	{Code: "mnz", Scope: 'I', Name: "Moni"},
+	{Code: "moa", Scope: 'I', Name: "Mwan"},
+	{Code: "moc", Scope: 'I', Name: "Mocoví"},
+	{Code: "mod", Scope: 'I', Name: "Mobilian"},
+	{Code: "moe", Scope: 'I', Name: "Innu"},
+	{Code: "mog", Scope: 'I', Name: "Mongondow"},
+	{Code: "moh", Part2: "moh", Scope: 'I', Name: "Mohawk"},
+	{Code: "moi", Scope: 'I', Name: "Mboi"},
+	{Code: "moj", Scope: 'I', Name: "Monzombo"},
+	{Code: "mok", Scope: 'I', Name: "Morori"},
+	{Code: "mom", Scope: 'I', Name: "Mangue"},
+	{Code: "mon", Part2: "mon", Part1: "mn", Scope: 'M', Name: "Mongolian"},
+	{Code: "moo", Scope: 'I', Name: "Monom"},
+	{Code: "mop", Scope: 'I', Name: "Mopán Maya"},
+	{Code: "moq", Scope: 'I', Name: "Mor (Bomberai Peninsula)"},
+	{Code: "mor", Scope: 'I', Name: "Moro"},
+	{Code: "mos", Part2: "mos", Scope: 'I', Name: "Mossi"},
+	{Code: "mot", Scope: 'I', Name: "Barí"},
+	{Code: "mou", Scope: 'I', Name: "Mogum"},
+	{Code: "mov", Scope: 'I', Name: "Mohave"},
+	{Code: "mow", Scope: 'I', Name: "Moi (Congo)"},
+	{Code: "mox", Scope: 'I', Name: "Molima"},
+	{Code: "moy", Scope: 'I', Name: "Shekkacho"},
+	{Code: "moz", Scope: 'I', Name: "Mukulu"},
+	{Code: "mpa", Scope: 'I', Name: "Mpoto"},
+	{Code: "mpb", Scope: 'I', Name: "Malak Malak"},
+	{Code: "mpc", Scope: 'I', Name: "Mangarrayi"},
+	{Code: "mpd", Scope: 'I', Name: "Machinere"},
+	{Code: "mpe", Scope: 'I', Name: "Majang"},
+	{Code: "mpg", Scope: 'I', Name: "Marba"},
+	{Code: "mph", Scope: 'I', Name: "Maung"},
+	{Code: "mpi", Scope: 'I', Name: "Mpade"},
+	{Code: "mpj", Scope: 'I', Name: "Martu Wangka"},
+	{Code: "mpk", Scope: 'I', Name: "Mbara (Chad)"},
+	{Code: "mpl", Scope: 'I', Name: "Middle Watut"},
+	{Code: "mpm", Scope: 'I', Name: "Yosondúa Mixtec"},
+	{Code: "mpn", Scope: 'I', Name: "Mindiri"},
+	{Code: "mpo", Scope: 'I', Name: "Miu"},
+	{Code: "mpp", Scope: 'I', Name: "Migabac"},
+	{Code: "mpq", Scope: 'I', Name: "Matís"},
+	{Code: "mpr", Scope: 'I', Name: "Vangunu"},
+	{Code: "mps", Scope: 'I', Name: "Dadibi"},
+	{Code: "mpt", Scope: 'I', Name: "Mian"},
+	{Code: "mpu", Scope: 'I', Name: "Makuráp"},
+	{Code: "mpv", Scope: 'I', Name: "Mungkip"},
+	{Code: "mpw", Scope: 'I', Name: "Mapidian"},
+	{Code: "mpx", Scope: 'I', Name: "Misima-Panaeati"},
+	{Code: "mpy", Scope: 'I', Name: "Mapia"},
+	{Code: "mpz", Scope: 'I', Name: "Mpi"},
+	{Code: "mqa", Scope: 'I', Name: "Maba (Indonesia)"},
+	{Code: "mqb", Scope: 'I', Name: "Mbuko"},
+	{Code: "mqc", Scope: 'I', Name: "Mangole"},
+	{Code: "mqe", Scope: 'I', Name: "Matepi"},
+	{Code: "mqf", Scope: 'I', Name: "Momuna"},
+	{Code: "mqg", Scope: 'I', Macro: "ms", Name: "Kota Bangun Kutai Malay"},
+	{Code: "mqh", Scope: 'I', Name: "Tlazoyaltepec Mixtec"},
+	{Code: "mqi", Scope: 'I', Name: "Mariri"},
+	{Code: "mqj", Scope: 'I', Name: "Mamasa"},
+	{Code: "mqk", Scope: 'I', Name: "Rajah Kabunsuwan Manobo"},
+	{Code: "mql", Scope: 'I', Name: "Mbelime"},
+	{Code: "mqm", Scope: 'I', Name: "South Marquesan"},
+	{Code: "mqn", Scope: 'I', Name: "Moronene"},
+	{Code: "mqo", Scope: 'I', Name: "Modole"},
+	{Code: "mqp", Scope: 'I', Name: "Manipa"},
+	{Code: "mqq", Scope: 'I', Name: "Minokok"},
+	{Code: "mqr", Scope: 'I', Name: "Mander"},
+	{Code: "mqs", Scope: 'I', Name: "West Makian"},
+	{Code: "mqt", Scope: 'I', Name: "Mok"},
+	{Code: "mqu", Scope: 'I', Name: "Mandari"},
+	{Code: "mqv", Scope: 'I', Name: "Mosimo"},
+	{Code: "mqw", Scope: 'I', Name: "Murupi"},
+	{Code: "mqx", Scope: 'I', Name: "Mamuju"},
+	{Code: "mqy", Scope: 'I', Name: "Manggarai"},
+	{Code: "mqz", Scope: 'I', Name: "Pano"},
+	{Code: "mra", Scope: 'I', Name: "Mlabri"},
+	{Code: "mrb", Scope: 'I', Name: "Marino"},
+	{Code: "mrc", Scope: 'I', Name: "Maricopa"},
+	{Code: "mrd", Scope: 'I', Name: "Western Magar"},
+	{Code: "mre", Scope: 'I', Name: "Martha's Vineyard Sign Language"},
+	{Code: "mrf", Scope: 'I', Name: "Elseng"},
+	{Code: "mrg", Scope: 'I', Name: "Mising"},
+	{Code: "mrh", Scope: 'I', Name: "Mara Chin"},
+	{Code: "mri", Part2: "mri", Part1: "mi", Scope: 'I', Name: "Maori"},
+	{Code: "mrj", Scope: 'I', Name: "Western Mari"},
+	{Code: "mrk", Scope: 'I', Name: "Hmwaveke"},
+	{Code: "mrl", Scope: 'I', Name: "Mortlockese"},
+	{Code: "mrm", Scope: 'I', Name: "Merlav"},
+	{Code: "mrn", Scope: 'I', Name: "Cheke Holo"},
+	{Code: "mro", Scope: 'I', Name: "Mru"},
+	{Code: "mrp", Scope: 'I', Name: "Morouas"},
+	{Code: "mrq", Scope: 'I', Name: "North Marquesan"},
+	{Code: "mrr", Scope: 'I', Name: "Maria (India)"},
+	{Code: "mrs", Scope: 'I', Name: "Maragus"},
+	{Code: "mrt", Scope: 'I', Name: "Marghi Central"},
+	{Code: "mru", Scope: 'I', Name: "Mono (Cameroon)"},
+	{Code: "mrv", Scope: 'I', Name: "Mangareva"},
+	{Code: "mrw", Scope: 'I', Name: "Maranao"},
+	{Code: "mrx", Scope: 'I', Name: "Maremgi"},
+	{Code: "mry", Scope: 'I', Name: "Mandaya"},
+	{Code: "mrz", Scope: 'I', Name: "Marind"},
+	{Code: "msa", Part2: "msa", Part1: "ms", Scope: 'M', Name: "Malay (macrolanguage)"},
+	{Code: "msb", Scope: 'I', Name: "Masbatenyo"},
+	{Code: "msc", Scope: 'I', Name: "Sankaran Maninka"},
+	{Code: "msd", Scope: 'I', Name: "Yucatec Maya Sign Language"},
+	{Code: "mse", Scope: 'I', Name: "Musey"},
+	{Code: "msf", Scope: 'I', Name: "Mekwei"},
+	{Code: "msg", Scope: 'I', Name: "Moraid"},
+	{Code: "msh", Scope: 'I', Name: "Masikoro Malagasy"},
+	{Code: "msi", Scope: 'I', Macro: "ms", Name: "Sabah Malay"},
+	{Code: "msj", Scope: 'I', Name: "Ma (Democratic Republic of Congo)"},
+	{Code: "msk", Scope: 'I', Name: "Mansaka"},
+	{Code: "msl", Scope: 'I', Name: "Molof"},
+	{Code: "msm", Scope: 'I', Name: "Agusan Manobo"},
+	{Code: "msn", Scope: 'I', Name: "Vurës"},
+	{Code: "mso", Scope: 'I', Name: "Mombum"},
+	{Code: "msp", Scope: 'I', Name: "Maritsauá"},
+	{Code: "msq", Scope: 'I', Name: "Caac"},
+	{Code: "msr", Scope: 'I', Name: "Mongolian Sign Language"},
+	{Code: "mss", Scope: 'I', Name: "West Masela"},
+	{Code: "msu", Scope: 'I', Name: "Musom"},
+	{Code: "msv", Scope: 'I', Name: "Maslam"},
+	{Code: "msw", Scope: 'I', Name: "Mansoanka"},
+	{Code: "msx", Scope: 'I', Name: "Moresada"},
+	{Code: "msy", Scope: 'I', Name: "Aruamu"},
+	{Code: "msz", Scope: 'I', Name: "Momare"},
+	{Code: "mta", Scope: 'I', Name: "Cotabato Manobo"},
+	{Code: "mtb", Scope: 'I', Name: "Anyin Morofo"},
+	{Code: "mtc", Scope: 'I', Name: "Munit"},
+	{Code: "mtd", Scope: 'I', Name: "Mualang"},
+	{Code: "mte", Scope: 'I', Name: "Mono (Solomon Islands)"},
+	{Code: "mtf", Scope: 'I', Name: "Murik (Papua New Guinea)"},
+	{Code: "mtg", Scope: 'I', Name: "Una"},
+	{Code: "mth", Scope: 'I', Name: "Munggui"},
+	{Code: "mti", Scope: 'I', Name: "Maiwa (Papua New Guinea)"},
+	{Code: "mtj", Scope: 'I', Name: "Moskona"},
+	{Code: "mtk", Scope: 'I', Name: "Mbe'"},
+	{Code: "mtl", Scope: 'I', Name: "Montol"},
+	{Code: "mtm", Scope: 'I', Name: "Mator"},
+	{Code: "mtn", Scope: 'I', Name: "Matagalpa"},
+	{Code: "mto", Scope: 'I', Name: "Totontepec Mixe"},
+	{Code: "mtp", Scope: 'I', Name: "Wichí Lhamtés Nocten"},
+	{Code: "mtq", Scope: 'I', Name: "Muong"},
+	{Code: "mtr", Scope: 'I', Name: "Mewari"},
+	{Code: "mts", Scope: 'I', Name: "Yora"},
+	{Code: "mtt", Scope: 'I', Name: "Mota"},
+	{Code: "mtu", Scope: 'I', Name: "Tututepec Mixtec"},
+	{Code: "mtv", Scope: 'I', Name: "Asaro'o"},
+	{Code: "mtw", Scope: 'I', Name: "Southern Binukidnon"},
+	{Code: "mtx", Scope: 'I', Name: "Tidaá Mixtec"},
+	{Code: "mty", Scope: 'I', Name: "Nabi"},
+	{Code: "mua", Scope: 'I', Name: "Mundang"},
+	{Code: "mub", Scope: 'I', Name: "Mubi"},
+	{Code: "muc", Scope: 'I', Name: "Ajumbu"},
+	{Code: "mud", Scope: 'I', Name: "Mednyj Aleut"},
+	{Code: "mue", Scope: 'I', Name: "Media Lengua"},
+	{Code: "mug", Scope: 'I', Name: "Musgu"},
+	{Code: "muh", Scope: 'I', Name: "Mündü"},
+	{Code: "mui", Scope: 'I', Macro: "ms", Name: "Musi"},
+	{Code: "muj", Scope: 'I', Name: "Mabire"},
+	{Code: "muk", Scope: 'I', Name: "Mugom"},
+	{Code: "mul", Part2: "mul", Scope: 'S', Name: "Multiple languages"},
+	{Code: "mum", Scope: 'I', Name: "Maiwala"},
+	{Code: "muo", Scope: 'I', Name: "Nyong"},
+	{Code: "mup", Scope: 'I', Macro: "raj", Name: "Malvi"},
+	{Code: "muq", Scope: 'I', Name: "Eastern Xiangxi Miao"},
+	{Code: "mur", Scope: 'I', Name: "Murle"},
+	{Code: "mus", Part2: "mus", Scope: 'I', Name: "Creek"},
+	{Code: "mut", Scope: 'I', Name: "Western Muria"},
+	{Code: "muu", Scope: 'I', Name: "Yaaku"},
+	{Code: "muv", Scope: 'I', Name: "Muthuvan"},
+	{Code: "mux", Scope: 'I', Name: "Bo-Ung"},
+	{Code: "muy", Scope: 'I', Name: "Muyang"},
+	{Code: "muz", Scope: 'I', Name: "Mursi"},
+	{Code: "mva", Scope: 'I', Name: "Manam"},
+	{Code: "mvb", Scope: 'I', Name: "Mattole"},
+	{Code: "mvd", Scope: 'I', Name: "Mamboru"},
+	{Code: "mve", Scope: 'I', Name: "Marwari (Pakistan)"},
+	{Code: "mvf", Scope: 'I', Macro: "mn", Name: "Peripheral Mongolian"},
+	{Code: "mvg", Scope: 'I', Name: "Yucuañe Mixtec"},
+	{Code: "mvh", Scope: 'I', Name: "Mulgi"},
+	{Code: "mvi", Scope: 'I', Name: "Miyako"},
+	{Code: "mvk", Scope: 'I', Name: "Mekmek"},
+	{Code: "mvl", Scope: 'I', Name: "Mbara (Australia)"},
+	{Code: "mvn", Scope: 'I', Name: "Minaveha"},
+	{Code: "mvo", Scope: 'I', Name: "Marovo"},
+	{Code: "mvp", Scope: 'I', Name: "Duri"},
+	{Code: "mvq", Scope: 'I', Name: "Moere"},
+	{Code: "mvr", Scope: 'I', Name: "Marau"},
+	{Code: "mvs", Scope: 'I', Name: "Massep"},
+	{Code: "mvt", Scope: 'I', Name: "Mpotovoro"},
+	{Code: "mvu", Scope: 'I', Name: "Marfa"},
+	{Code: "mvv", Scope: 'I', Name: "Tagal Murut"},
+	{Code: "mvw", Scope: 'I', Name: "Machinga"},
+	{Code: "mvx", Scope: 'I', Name: "Meoswar"},
+	{Code: "mvy", Scope: 'I', Name: "Indus Kohistani"},
+	{Code: "mvz", Scope: 'I', Name: "Mesqan"},
+	{Code: "mwa", Scope: 'I', Name: "Mwatebu"},
+	{Code: "mwb", Scope: 'I', Name: "Juwal"},
+	{Code: "mwc", Scope: 'I', Name: "Are"},
+	{Code: "mwe", Scope: 'I', Name: "Mwera (Chimwera)"},
+	{Code: "mwf", Scope: 'I', Name: "Murrinh-Patha"},
+	{Code: "mwg", Scope: 'I', Name: "Aiklep"},
+	{Code: "mwh", Scope: 'I', Name: "Mouk-Aria"},
+	{Code: "mwi", Scope: 'I', Name: "Labo"},
+	{Code: "mwk", Scope: 'I', Name: "Kita Maninkakan"},
+	{Code: "mwl", Part2: "mwl", Scope: 'I', Name: "Mirandese"},
+	{Code: "mwm", Scope: 'I', Name: "Sar"},
+	{Code: "mwn", Scope: 'I', Name: "Nyamwanga"},
+	{Code: "mwo", Scope: 'I', Name: "Central Maewo"},
+	{Code: "mwp", Scope: 'I', Name: "Kala Lagaw Ya"},
+	{Code: "mwq", Scope: 'I', Name: "Mün Chin"},
+	{Code: "mwr", Part2: "mwr", Scope: 'M', Name: "Marwari"},
+	{Code: "mws", Scope: 'I', Name: "Mwimbi-Muthambi"},
+	{Code: "mwt", Scope: 'I', Name: "Moken"},
+	{Code: "mwu", Scope: 'I', Name: "Mittu"},
+	{Code: "mwv", Scope: 'I', Name: "Mentawai"},
+	{Code: "mww", Scope: 'I', Name: "Hmong Daw"},
+	{Code: "mwz", Scope: 'I', Name: "Moingi"},
+	{Code: "mxa", Scope: 'I', Name: "Northwest Oaxaca Mixtec"},
+	{Code: "mxb", Scope: 'I', Name: "Tezoatlán Mixtec"},
+	{Code: "mxc", Scope: 'I', Name: "Manyika"},
+	{Code: "mxd", Scope: 'I', Name: "Modang"},
+	{Code: "mxe", Scope: 'I', Name: "Mele-Fila"},
+	{Code: "mxf", Scope: 'I', Name: "Malgbe"},
+	{Code: "mxg", Scope: 'I', Name: "Mbangala"},
+	{Code: "mxh", Scope: 'I', Name: "Mvuba"},
+	{Code: "mxi", Scope: 'I', Name: "Mozarabic"},
+	{Code: "mxj", Scope: 'I', Name: "Miju-Mishmi"},
+	{Code: "mxk", Scope: 'I', Name: "Monumbo"},
+	{Code: "mxl", Scope: 'I', Name: "Maxi Gbe"},
+	{Code: "mxm", Scope: 'I', Name: "Meramera"},
+	{Code: "mxn", Scope: 'I', Name: "Moi (Indonesia)"},
+	{Code: "mxo", Scope: 'I', Name: "Mbowe"},
+	{Code: "mxp", Scope: 'I', Name: "Tlahuitoltepec Mixe"},
+	{Code: "mxq", Scope: 'I', Name: "Juquila Mixe"},
+	{Code: "mxr", Scope: 'I', Name: "Murik (Malaysia)"},
+	{Code: "mxs", Scope: 'I', Name: "Huitepec Mixtec"},
+	{Code: "mxt", Scope: 'I', Name: "Jamiltepec Mixtec"},
+	{Code: "mxu", Scope: 'I', Name: "Mada (Cameroon)"},
+	{Code: "mxv", Scope: 'I', Name: "Metlatónoc Mixtec"},
+	{Code: "mxw", Scope: 'I', Name: "Namo"},
+	{Code: "mxx", Scope: 'I', Name: "Mahou"},
+	{Code: "mxy", Scope: 'I', Name: "Southeastern Nochixtlán Mixtec"},
+	{Code: "mxz", Scope: 'I', Name: "Central Masela"},
+	{Code: "mya", Part2: "mya", Part1: "my", Scope: 'I', Name: "Burmese"},
+	{Code: "myb", Scope: 'I', Name: "Mbay"},
+	{Code: "myc", Scope: 'I', Name: "Mayeka"},
+	{Code: "mye", Scope: 'I', Name: "Myene"},
+	{Code: "myf", Scope: 'I', Name: "Bambassi"},
+	{Code: "myg", Scope: 'I', Name: "Manta"},
+	{Code: "myh", Scope: 'I', Name: "Makah"},
+	{Code: "myj", Scope: 'I', Name: "Mangayat"},
+	{Code: "myk", Scope: 'I', Name: "Mamara Senoufo"},
+	{Code: "myl", Scope: 'I', Name: "Moma"},
+	{Code: "mym", Scope: 'I', Name: "Me'en"},
+	{Code: "myo", Scope: 'I', Name: "Anfillo"},
+	{Code: "myp", Scope: 'I', Name: "Pirahã"},
+	{Code: "myr", Scope: 'I', Name: "Muniche"},
+	{Code: "mys", Scope: 'I', Name: "Mesmes"},
+	{Code: "myu", Scope: 'I', Name: "Mundurukú"},
+	{Code: "myv", Part2: "myv", Scope: 'I', Name: "Erzya"},
+	{Code: "myw", Scope: 'I', Name: "Muyuw"},
+	{Code: "myx", Scope: 'I', Name: "Masaaba"},
+	{Code: "myy", Scope: 'I', Name: "Macuna"},
+	{Code: "myz", Scope: 'I', Name: "Classical Mandaic"},
+	{Code: "mza", Scope: 'I', Name: "Santa María Zacatepec Mixtec"},
+	{Code: "mzb", Scope: 'I', Name: "Tumzabt"},
+	{Code: "mzc", Scope: 'I', Name: "Madagascar Sign Language"},
+	{Code: "mzd", Scope: 'I', Name: "Malimba"},
+	{Code: "mze", Scope: 'I', Name: "Morawa"},
+	{Code: "mzg", Scope: 'I', Name: "Monastic Sign Language"},
+	{Code: "mzh", Scope: 'I', Name: "Wichí Lhamtés Güisnay"},
+	{Code: "mzi", Scope: 'I', Name: "Ixcatlán Mazatec"},
+	{Code: "mzj", Scope: 'I', Name: "Manya"},
+	{Code: "mzk", Scope: 'I', Name: "Nigeria Mambila"},
+	{Code: "mzl", Scope: 'I', Name: "Mazatlán Mixe"},
+	{Code: "mzm", Scope: 'I', Name: "Mumuye"},
+	{Code: "mzn", Scope: 'I', Name: "Mazanderani"},
+	{Code: "mzo", Scope: 'I', Name: "Matipuhy"},
+	{Code: "mzp", Scope: 'I', Name: "Movima"},
+	{Code: "mzq", Scope: 'I', Name: "Mori Atas"},
+	{Code: "mzr", Scope: 'I', Name: "Marúbo"},
+	{Code: "mzs", Scope: 'I', Name: "Macanese"},
+	{Code: "mzt", Scope: 'I', Name: "Mintil"},
+	{Code: "mzu", Scope: 'I', Name: "Inapang"},
+	{Code: "mzv", Scope: 'I', Name: "Manza"},
+	{Code: "mzw", Scope: 'I', Name: "Deg"},
+	{Code: "mzx", Scope: 'I', Name: "Mawayana"},
+	{Code: "mzy", Scope: 'I', Name: "Mozambican Sign Language"},
+	{Code: "mzz", Scope: 'I', Name: "Maiadomu"},
+	{Code: "naa", Scope: 'I', Name: "Namla"},
+	{Code: "nab", Scope: 'I', Name: "Southern Nambikuára"},
+	{Code: "nac", Scope: 'I', Name: "Narak"},
+	{Code: "nae", Scope: 'I', Name: "Naka'ela"},
+	{Code: "naf", Scope: 'I', Name: "Nabak"},
+	{Code: "nag", Scope: 'I', Name: "Naga Pidgin"},
+	{Code: "naj", Scope: 'I', Name: "Nalu"},
+	{Code: "nak", Scope: 'I', Name: "Nakanai"},
+	{Code: "nal", Scope: 'I', Name: "Nalik"},
+	{Code: "nam", Scope: 'I', Name: "Ngan'gityemerri"},
+	{Code: "nan", Scope: 'I', Macro: "zh", Name: "Min Nan Chinese"},
+	{Code: "nao", Scope: 'I', Name: "Naaba"},
+	{Code: "nap", Part2: "nap", Scope: 'I', Name: "Neapolitan"},
+	{Code: "naq", Scope: 'I', Name: "Khoekhoe"},
+	{Code: "nar", Scope: 'I', Name: "Iguta"},
+	{Code: "nas", Scope: 'I', Name: "Naasioi"},
+	{Code: "nat", Scope: 'I', Name: "Ca̱hungwa̱rya̱"},
+	{Code: "nau", Part2: "nau", Part1: "na", Scope: 'I', Name: "Nauru"},
+	{Code: "nav", Part2: "nav", Part1: "nv", Scope: 'I', Name: "Navajo"},
+	{Code: "naw", Scope: 'I', Name: "Nawuri"},
+	{Code: "nax", Scope: 'I', Name: "Nakwi"},
+	{Code: "nay", Scope: 'I', Name: "Ngarrindjeri"},
+	{Code: "naz", Scope: 'I', Name: "Coatepec Nahuatl"},
+	{Code: "nba", Scope: 'I', Name: "Nyemba"},
+	{Code: "nbb", Scope: 'I', Name: "Ndoe"},
+	{Code: "nbc", Scope: 'I', Name: "Chang Naga"},
+	{Code: "nbd", Scope: 'I', Name: "Ngbinda"},
+	{Code: "nbe", Scope: 'I', Name: "Konyak Naga"},
+	{Code: "nbg", Scope: 'I', Name: "Nagarchal"},
+	{Code: "nbh", Scope: 'I', Name: "Ngamo"},
+	{Code: "nbi", Scope: 'I', Name: "Mao Naga"},
+	{Code: "nbj", Scope: 'I', Name: "Ngarinyman"},
+	{Code: "nbk", Scope: 'I', Name: "Nake"},
+	{Code: "nbl", Part2: "nbl", Part1: "nr", Scope: 'I', Name: "South Ndebele"},
+	{Code: "nbm", Scope: 'I', Name: "Ngbaka Ma'bo"},
+	{Code: "nbn", Scope: 'I', Name: "Kuri"},
+	{Code: "nbo", Scope: 'I', Name: "Nkukoli"},
+	{Code: "nbp", Scope: 'I', Name: "Nnam"},
+	{Code: "nbq", Scope: 'I', Name: "Nggem"},
+	{Code: "nbr", Scope: 'I', Name: "Numana"},
+	{Code: "nbs", Scope: 'I', Name: "Namibian Sign Language"},
+	{Code: "nbt", Scope: 'I', Name: "Na"},
+	{Code: "nbu", Scope: 'I', Name: "Rongmei Naga"},
+	{Code: "nbv", Scope: 'I', Name: "Ngamambo"},
+	{Code: "nbw", Scope: 'I', Name: "Southern Ngbandi"},
+	{Code: "nby", Scope: 'I', Name: "Ningera"},
+	{Code: "nca", Scope: 'I', Name: "Iyo"},
+	{Code: "ncb", Scope: 'I', Name: "Central Nicobarese"},
+	{Code: "ncc", Scope: 'I', Name: "Ponam"},
+	{Code: "ncd", Scope: 'I', Name: "Nachering"},
+	{Code: "nce", Scope: 'I', Name: "Yale"},
+	{Code: "ncf", Scope: 'I', Name: "Notsi"},
+	{Code: "ncg", Scope: 'I', Name: "Nisga'a"},
+	{Code: "nch", Scope: 'I', Name: "Central Huasteca Nahuatl"},
+	{Code: "nci", Scope: 'I', Name: "Classical Nahuatl"},
+	{Code: "ncj", Scope: 'I', Name: "Northern Puebla Nahuatl"},
+	{Code: "nck", Scope: 'I', Name: "Na-kara"},
+	{Code: "ncl", Scope: 'I', Name: "Michoacán Nahuatl"},
+	{Code: "ncm", Scope: 'I', Name: "Nambo"},
+	{Code: "ncn", Scope: 'I', Name: "Nauna"},
+	{Code: "nco", Scope: 'I', Name: "Sibe"},
+	{Code: "ncq", Scope: 'I', Name: "Northern Katang"},
+	{Code: "ncr", Scope: 'I', Name: "Ncane"},
+	{Code: "ncs", Scope: 'I', Name: "Nicaraguan Sign Language"},
+	{Code: "nct", Scope: 'I', Name: "Chothe Naga"},
+	{Code: "ncu", Scope: 'I', Name: "Chumburung"},
+	{Code: "ncx", Scope: 'I', Name: "Central Puebla Nahuatl"},
+	{Code: "ncz", Scope: 'I', Name: "Natchez"},
+	{Code: "nda", Scope: 'I', Name: "Ndasa"},
+	{Code: "ndb", Scope: 'I', Name: "Kenswei Nsei"},
+	{Code: "ndc", Scope: 'I', Name: "Ndau"},
+	{Code: "ndd", Scope: 'I', Name: "Nde-Nsele-Nta"},
+	{Code: "nde", Part2: "nde", Part1: "nd", Scope: 'I', Name: "North Ndebele"},
+	{Code: "ndf", Scope: 'I', Name: "Nadruvian"},
+	{Code: "ndg", Scope: 'I', Name: "Ndengereko"},
+	{Code: "ndh", Scope: 'I', Name: "Ndali"},
+	{Code: "ndi", Scope: 'I', Name: "Samba Leko"},
+	{Code: "ndj", Scope: 'I', Name: "Ndamba"},
+	{Code: "ndk", Scope: 'I', Name: "Ndaka"},
+	{Code: "ndl", Scope: 'I', Name: "Ndolo"},
+	{Code: "ndm", Scope: 'I', Name: "Ndam"},
+	{Code: "ndn", Scope: 'I', Name: "Ngundi"},
+	{Code: "ndo", Part2: "ndo", Part1: "ng", Scope: 'I', Name: "Ndonga"},
+	{Code: "ndp", Scope: 'I', Name: "Ndo"},
+	{Code: "ndq", Scope: 'I', Name: "Ndombe"},
+	{Code: "ndr", Scope: 'I', Name: "Ndoola"},
+	{Code: "nds", Part2: "nds", Scope: 'I', Name: "Low German"},
+	{Code: "ndt", Scope: 'I', Name: "Ndunga"},
+	{Code: "ndu", Scope: 'I', Name: "Dugun"},
+	{Code: "ndv", Scope: 'I', Name: "Ndut"},
+	{Code: "ndw", Scope: 'I', Name: "Ndobo"},
+	{Code: "ndx", Scope: 'I', Name: "Nduga"},
+	{Code: "ndy", Scope: 'I', Name: "Lutos"},
+	{Code: "ndz", Scope: 'I', Name: "Ndogo"},
+	{Code: "nea", Scope: 'I', Name: "Eastern Ngad'a"},
+	{Code: "neb", Scope: 'I', Name: "Toura (Côte d'Ivoire)"},
+	{Code: "nec", Scope: 'I', Name: "Nedebang"},
+	{Code: "ned", Scope: 'I', Name: "Nde-Gbite"},
+	{Code: "nee", Scope: 'I', Name: "Nêlêmwa-Nixumwak"},
+	{Code: "nef", Scope: 'I', Name: "Nefamese"},
+	{Code: "neg", Scope: 'I', Name: "Negidal"},
+	{Code: "neh", Scope: 'I', Name: "Nyenkha"},
+	{Code: "nei", Scope: 'I', Name: "Neo-Hittite"},
+	{Code: "nej", Scope: 'I', Name: "Neko"},
+	{Code: "nek", Scope: 'I', Name: "Neku"},
+	{Code: "nem", Scope: 'I', Name: "Nemi"},
+	{Code: "nen", Scope: 'I', Name: "Nengone"},
+	{Code: "neo", Scope: 'I', Name: "Ná-Meo"},
+	{Code: "nep", Part2: "nep", Part1: "ne", Scope: 'M', Name: "Nepali (macrolanguage)"},
+	{Code: "neq", Scope: 'I', Name: "North Central Mixe"},
+	{Code: "ner", Scope: 'I', Name: "Yahadian"},
+	{Code: "nes", Scope: 'I', Name: "Bhoti Kinnauri"},
+	{Code: "net", Scope: 'I', Name: "Nete"},
+	{Code: "neu", Scope: 'I', Name: "Neo"},
+	{Code: "nev", Scope: 'I', Name: "Nyaheun"},
+	{Code: "new", Part2: "new", Scope: 'I', Name: "Newari"},
+	{Code: "nex", Scope: 'I', Name: "Neme"},
+	{Code: "ney", Scope: 'I', Name: "Neyo"},
+	{Code: "nez", Scope: 'I', Name: "Nez Perce"},
+	{Code: "nfa", Scope: 'I', Name: "Dhao"},
+	{Code: "nfd", Scope: 'I', Name: "Ahwai"},
+	{Code: "nfl", Scope: 'I', Name: "Ayiwo"},
+	{Code: "nfr", Scope: 'I', Name: "Nafaanra"},
+	{Code: "nfu", Scope: 'I', Name: "Mfumte"},
+	{Code: "nga", Scope: 'I', Name: "Ngbaka"},
+	{Code: "ngb", Scope: 'I', Name: "Northern Ngbandi"},
+	{Code: "ngc", Scope: 'I', Name: "Ngombe (Democratic Republic of Congo)"},
+	{Code: "ngd", Scope: 'I', Name: "Ngando (Central African Republic)"},
+	{Code: "nge", Scope: 'I', Name: "Ngemba"},
+	{Code: "ngg", Scope: 'I', Name: "Ngbaka Manza"},
+	{Code: "ngh", Scope: 'I', Name: "Nǁng"},
+	{Code: "ngi", Scope: 'I', Name: "Ngizim"},
+	{Code: "ngj", Scope: 'I', Name: "Ngie"},
+	{Code: "ngk", Scope: 'I', Name: "Dalabon"},
+	{Code: "ngl", Scope: 'I', Name: "Lomwe"},
+	{Code: "ngm", Scope: 'I', Name: "Ngatik Men's Creole"},
+	{Code: "ngn", Scope: 'I', Name: "Ngwo"},
+	{Code: "ngp", Scope: 'I', Name: "Ngulu"},
+	{Code: "ngq", Scope: 'I', Name: "Ngurimi"},
+	{Code: "ngr", Scope: 'I', Name: "Engdewu"},
+	{Code: "ngs", Scope: 'I', Name: "Gvoko"},
+	{Code: "ngt", Scope: 'I', Name: "Kriang"},
+	{Code: "ngu", Scope: 'I', Name: "Guerrero Nahuatl"},
+	{Code: "ngv", Scope: 'I', Name: "Nagumi"},
+	{Code: "ngw", Scope: 'I', Name: "Ngwaba"},
+	{Code: "ngx", Scope: 'I', Name: "Nggwahyi"},
+	{Code: "ngy", Scope: 'I', Name: "Tibea"},
+	{Code: "ngz", Scope: 'I', Name: "Ngungwel"},
+	{Code: "nha", Scope: 'I', Name: "Nhanda"},
+	{Code: "nhb", Scope: 'I', Name: "Beng"},
+	{Code: "nhc", Scope: 'I', Name: "Tabasco Nahuatl"},
+	{Code: "nhd", Scope: 'I', Macro: "gn", Name: "Chiripá"},
+	{Code: "nhe", Scope: 'I', Name: "Eastern Huasteca Nahuatl"},
+	{Code: "nhf", Scope: 'I', Name: "Nhuwala"},
+	{Code: "nhg", Scope: 'I', Name: "Tetelcingo Nahuatl"},
+	{Code: "nhh", Scope: 'I', Name: "Nahari"},
+	{Code: "nhi", Scope: 'I', Name: "Zacatlán-Ahuacatlán-Tepetzintla Nahuatl"},
+	{Code: "nhk", Scope: 'I', Name: "Isthmus-Cosoleacaque Nahuatl"},
+	{Code: "nhm", Scope: 'I', Name: "Morelos Nahuatl"},
+	{Code: "nhn", Scope: 'I', Name: "Central Nahuatl"},
+	{Code: "nho", Scope: 'I', Name: "Takuu"},
+	{Code: "nhp", Scope: 'I', Name: "Isthmus-Pajapan Nahuatl"},
+	{Code: "nhq", Scope: 'I', Name: "Huaxcaleca Nahuatl"},
+	{Code: "nhr", Scope: 'I', Name: "Naro"},
+	{Code: "nht", Scope: 'I', Name: "Ometepec Nahuatl"},
+	{Code: "nhu", Scope: 'I', Name: "Noone"},
+	{Code: "nhv", Scope: 'I', Name: "Temascaltepec Nahuatl"},
+	{Code: "nhw", Scope: 'I', Name: "Western Huasteca Nahuatl"},
+	{Code: "nhx", Scope: 'I', Name: "Isthmus-Mecayapan Nahuatl"},
+	{Code: "nhy", Scope: 'I', Name: "Northern Oaxaca Nahuatl"},
+	{Code: "nhz", Scope: 'I', Name: "Santa María La Alta Nahuatl"},
+	{Code: "nia", Part2: "nia", Scope: 'I', Name: "Nias"},
+	{Code: "nib", Scope: 'I', Name: "Nakame"},
+	{Code: "nid", Scope: 'I', Name: "Ngandi"},
+	{Code: "nie", Scope: 'I', Name: "Niellim"},
+	{Code: "nif", Scope: 'I', Name: "Nek"},
+	{Code: "nig", Scope: 'I', Name: "Ngalakgan"},
+	{Code: "nih", Scope: 'I', Name: "Nyiha (Tanzania)"},
+	{Code: "nii", Scope: 'I', Name: "Nii"},
+	{Code: "nij", Scope: 'I', Name: "Ngaju"},
+	{Code: "nik", Scope: 'I', Name: "Southern Nicobarese"},
+	{Code: "nil", Scope: 'I', Name: "Nila"},
+	{Code: "nim", Scope: 'I', Name: "Nilamba"},
+	{Code: "nin", Scope: 'I', Name: "Ninzo"},
+	{Code: "nio", Scope: 'I', Name: "Nganasan"},
+	{Code: "niq", Scope: 'I', Name: "Nandi"},
+	{Code: "nir", Scope: 'I', Name: "Nimboran"},
+	{Code: "nis", Scope: 'I', Name: "Nimi"},
+	{Code: "nit", Scope: 'I', Name: "Southeastern Kolami"},
+	{Code: "niu", Part2: "niu", Scope: 'I', Name: "Niuean"},
+	{Code: "niv", Scope: 'I', Name: "Gilyak"},
+	{Code: "niw", Scope: 'I', Name: "Nimo"},
+	{Code: "nix", Scope: 'I', Name: "Hema"},
+	{Code: "niy", Scope: 'I', Name: "Ngiti"},
+	{Code: "niz", Scope: 'I', Name: "Ningil"},
+	{Code: "nja", Scope: 'I', Name: "Nzanyi"},
+	{Code: "njb", Scope: 'I', Name: "Nocte Naga"},
+	{Code: "njd", Scope: 'I', Name: "Ndonde Hamba"},
+	{Code: "njh", Scope: 'I', Name: "Lotha Naga"},
+	{Code: "nji", Scope: 'I', Name: "Gudanji"},
+	{Code: "njj", Scope: 'I', Name: "Njen"},
+	{Code: "njl", Scope: 'I', Name: "Njalgulgule"},
+	{Code: "njm", Scope: 'I', Name: "Angami Naga"},
+	{Code: "njn", Scope: 'I', Name: "Liangmai Naga"},
+	{Code: "njo", Scope: 'I', Name: "Ao Naga"},
+	{Code: "njr", Scope: 'I', Name: "Njerep"},
+	{Code: "njs", Scope: 'I', Name: "Nisa"},
+	{Code: "njt", Scope: 'I', Name: "Ndyuka-Trio Pidgin"},
+	{Code: "nju", Scope: 'I', Name: "Ngadjunmaya"},
+	{Code: "njx", Scope: 'I', Name: "Kunyi"},
+	{Code: "njy", Scope: 'I', Name: "Njyem"},
+	{Code: "njz", Scope: 'I', Name: "Nyishi"},
+	{Code: "nka", Scope: 'I', Name: "Nkoya"},
+	{Code: "nkb", Scope: 'I', Name: "Khoibu Naga"},
+	{Code: "nkc", Scope: 'I', Name: "Nkongho"},
+	{Code: "nkd", Scope: 'I', Name: "Koireng"},
+	{Code: "nke", Scope: 'I', Name: "Duke"},
+	{Code: "nkf", Scope: 'I', Name: "Inpui Naga"},
+	{Code: "nkg", Scope: 'I', Name: "Nekgini"},
+	{Code: "nkh", Scope: 'I', Name: "Khezha Naga"},
+	{Code: "nki", Scope: 'I', Name: "Thangal Naga"},
+	{Code: "nkj", Scope: 'I', Name: "Nakai"},
+	{Code: "nkk", Scope: 'I', Name: "Nokuku"},
+	{Code: "nkm", Scope: 'I', Name: "Namat"},
+	{Code: "nkn", Scope: 'I', Name: "Nkangala"},
+	{Code: "nko", Scope: 'I', Name: "Nkonya"},
+	{Code: "nkp", Scope: 'I', Name: "Niuatoputapu"},
+	{Code: "nkq", Scope: 'I', Name: "Nkami"},
+	{Code: "nkr", Scope: 'I', Name: "Nukuoro"},
+	{Code: "nks", Scope: 'I', Name: "North Asmat"},
+	{Code: "nkt", Scope: 'I', Name: "Nyika (Tanzania)"},
+	{Code: "nku", Scope: 'I', Name: "Bouna Kulango"},
+	{Code: "nkv", Scope: 'I', Name: "Nyika (Malawi and Zambia)"},
+	{Code: "nkw", Scope: 'I', Name: "Nkutu"},
+	{Code: "nkx", Scope: 'I', Name: "Nkoroo"},
+	{Code: "nkz", Scope: 'I', Name: "Nkari"},
+	{Code: "nla", Scope: 'I', Name: "Ngombale"},
+	{Code: "nlc", Scope: 'I', Name: "Nalca"},
+	{Code: "nld", Part2: "nld", Part1: "nl", Scope: 'I', Name: "Dutch"},
+	{Code: "nle", Scope: 'I', Name: "East Nyala"},
+	{Code: "nlg", Scope: 'I', Name: "Gela"},
+	{Code: "nli", Scope: 'I', Name: "Grangali"},
+	{Code: "nlj", Scope: 'I', Name: "Nyali"},
+	{Code: "nlk", Scope: 'I', Name: "Ninia Yali"},
+	{Code: "nll", Scope: 'I', Name: "Nihali"},
+	{Code: "nlm", Scope: 'I', Name: "Mankiyali"},
+	{Code: "nlo", Scope: 'I', Name: "Ngul"},
+	{Code: "nlq", Scope: 'I', Name: "Lao Naga"},
+	{Code: "nlu", Scope: 'I', Name: "Nchumbulu"},
+	{Code: "nlv", Scope: 'I', Name: "Orizaba Nahuatl"},
+	{Code: "nlw", Scope: 'I', Name: "Walangama"},
+	{Code: "nlx", Scope: 'I', Name: "Nahali"},
+	{Code: "nly", Scope: 'I', Name: "Nyamal"},
+	{Code: "nlz", Scope: 'I', Name: "Nalögo"},
+	{Code: "nma", Scope: 'I', Name: "Maram Naga"},
+	{Code: "nmb", Scope: 'I', Name: "Big Nambas"},
+	{Code: "nmc", Scope: 'I', Name: "Ngam"},
+	{Code: "nmd", Scope: 'I', Name: "Ndumu"},
+	{Code: "nme", Scope: 'I', Name: "Mzieme Naga"},
+	{Code: "nmf", Scope: 'I', Name: "Tangkhul Naga (India)"},
+	{Code: "nmg", Scope: 'I', Name: "Kwasio"},
+	{Code: "nmh", Scope: 'I', Name: "Monsang Naga"},
+	{Code: "nmi", Scope: 'I', Name: "Nyam"},
+	{Code: "nmj", Scope: 'I', Name: "Ngombe (Central African Republic)"},
+	{Code: "nmk", Scope: 'I', Name: "Namakura"},
+	{Code: "nml", Scope: 'I', Name: "Ndemli"},
+	{Code: "nmm", Scope: 'I', Name: "Manangba"},
+	{Code: "nmn", Scope: 'I', Name: "ǃXóõ"},
+	{Code: "nmo", Scope: 'I', Name: "Moyon Naga"},
+	{Code: "nmp", Scope: 'I', Name: "Nimanbur"},
+	{Code: "nmq", Scope: 'I', Name: "Nambya"},
+	{Code: "nmr", Scope: 'I', Name: "Nimbari"},
+	{Code: "nms", Scope: 'I', Name: "Letemboi"},
+	{Code: "nmt", Scope: 'I', Name: "Namonuito"},
+	{Code: "nmu", Scope: 'I', Name: "Northeast Maidu"},
+	{Code: "nmv", Scope: 'I', Name: "Ngamini"},
+	{Code: "nmw", Scope: 'I', Name: "Nimoa"},
+	{Code: "nmx", Scope: 'I', Name: "Nama (Papua New Guinea)"},
+	{Code: "nmy", Scope: 'I', Name: "Namuyi"},
+	{Code: "nmz", Scope: 'I', Name: "Nawdm"},
+	{Code: "nna", Scope: 'I', Name: "Nyangumarta"},
+	{Code: "nnb", Scope: 'I', Name: "Nande"},
+	{Code: "nnc", Scope: 'I', Name: "Nancere"},
+	{Code: "nnd", Scope: 'I', Name: "West Ambae"},
+	{Code: "nne", Scope: 'I', Name: "Ngandyera"},
+	{Code: "nnf", Scope: 'I', Name: "Ngaing"},
+	{Code: "nng", Scope: 'I', Name: "Maring Naga"},
+	{Code: "nnh", Scope: 'I', Name: "Ngiemboon"},
+	{Code: "nni", Scope: 'I', Name: "North Nuaulu"},
+	{Code: "nnj", Scope: 'I', Name: "Nyangatom"},
+	{Code: "nnk", Scope: 'I', Name: "Nankina"},
+	{Code: "nnl", Scope: 'I', Name: "Northern Rengma Naga"},
+	{Code: "nnm", Scope: 'I', Name: "Namia"},
+	{Code: "nnn", Scope: 'I', Name: "Ngete"},
+	{Code: "nno", Part2: "nno", Part1: "nn", Scope: 'I', Macro: "no", Name: "Norwegian Nynorsk"},
+	{Code: "nnp", Scope: 'I', Name: "Wancho Naga"},
+	{Code: "nnq", Scope: 'I', Name: "Ngindo"},
+	{Code: "nnr", Scope: 'I', Name: "Narungga"},
+	{Code: "nnt", Scope: 'I', Name: "Nanticoke"},
+	{Code: "nnu", Scope: 'I', Name: "Dwang"},
+	{Code: "nnv", Scope: 'I', Name: "Nugunu (Australia)"},
+	{Code: "nnw", Scope: 'I', Name: "Southern Nuni"},
+	{Code: "nny", Scope: 'I', Name: "Nyangga"},
+	{Code: "nnz", Scope: 'I', Name: "Nda'nda'"},
+	{Code: "noa", Scope: 'I', Name: "Woun Meu"},
+	{Code: "nob", Part2: "nob", Part1: "nb", Scope: 'I', Macro: "no", Name: "Norwegian Bokmål"},
+	{Code: "noc", Scope: 'I', Name: "Nuk"},
+	{Code: "nod", Scope: 'I', Name: "Northern Thai"},
+	{Code: "noe", Scope: 'I', Name: "Nimadi"},
+	{Code: "nof", Scope: 'I', Name: "Nomane"},
+	{Code: "nog", Part2: "nog", Scope: 'I', Name: "Nogai"},
+	{Code: "noh", Scope: 'I', Name: "Nomu"},
+	{Code: "noi", Scope: 'I', Name: "Noiri"},
+	{Code: "noj", Scope: 'I', Name: "Nonuya"},
+	{Code: "nok", Scope: 'I', Name: "Nooksack"},
+	{Code: "nol", Scope: 'I', Name: "Nomlaki"},
+	{Code: "nom", Scope: 'I', Name: "Nocamán"},
+	{Code: "non", Part2: "non", Scope: 'I', Name: "Old Norse"},
+	{Code: "nop", Scope: 'I', Name: "Numanggang"},
+	{Code: "noq", Scope: 'I', Name: "Ngongo"},
+	{Code: "nor", Part2: "nor", Part1: "no", Scope: 'M', Name: "Norwegian"},
+	{Code: "nos", Scope: 'I', Name: "Eastern Nisu"},
+	{Code: "not", Scope: 'I', Name: "Nomatsiguenga"},
+	{Code: "nou", Scope: 'I', Name: "Ewage-Notu"},
+	{Code: "nov", Scope: 'I', Name: "Novial"},
+	{Code: "now", Scope: 'I', Name: "Nyambo"},
+	{Code: "noy", Scope: 'I', Name: "Noy"},
+	{Code: "noz", Scope: 'I', Name: "Nayi"},
+	{Code: "npa", Scope: 'I', Name: "Nar Phu"},
+	{Code: "npb", Scope: 'I', Name: "Nupbikha"},
+	{Code: "npg", Scope: 'I', Name: "Ponyo-Gongwang Naga"},
+	{Code: "nph", Scope: 'I', Name: "Phom Naga"},
+	{Code: "npi", Scope: 'I', Macro: "ne", Name: "Nepali (individual language)"},
+	{Code: "npl", Scope: 'I', Name: "Southeastern Puebla Nahuatl"},
+	{Code: "npn", Scope: 'I', Name: "Mondropolon"},
+	{Code: "npo", Scope: 'I', Name: "Pochuri Naga"},
+	{Code: "nps", Scope: 'I', Name: "Nipsan"},
+	{Code: "npu", Scope: 'I', Name: "Puimei Naga"},
+	{Code: "npx", Scope: 'I', Name: "Noipx"},
+	{Code: "npy", Scope: 'I', Name: "Napu"},
+	{Code: "nqg", Scope: 'I', Name: "Southern Nago"},
+	{Code: "nqk", Scope: 'I', Name: "Kura Ede Nago"},
+	{Code: "nql", Scope: 'I', Name: "Ngendelengo"},
+	{Code: "nqm", Scope: 'I', Name: "Ndom"},
+	{Code: "nqn", Scope: 'I', Name: "Nen"},
+	{Code: "nqo", Part2: "nqo", Scope: 'I', Name: "N'Ko"},
+	{Code: "nqq", Scope: 'I', Name: "Kyan-Karyaw Naga"},
+	{Code: "nqt", Scope: 'I', Name: "Nteng"},
+	{Code: "nqy", Scope: 'I', Name: "Akyaung Ari Naga"},
+	{Code: "nra", Scope: 'I', Name: "Ngom"},
+	{Code: "nrb", Scope: 'I', Name: "Nara"},
+	{Code: "nrc", Scope: 'I', Name: "Noric"},
+	{Code: "nre", Scope: 'I', Name: "Southern Rengma Naga"},
+	{Code: "nrf", Scope: 'I', Name: "Jèrriais"},
+	{Code: "nrg", Scope: 'I', Name: "Narango"},
+	{Code: "nri", Scope: 'I', Name: "Chokri Naga"},
+	{Code: "nrk", Scope: 'I', Name: "Ngarla"},
+	{Code: "nrl", Scope: 'I', Name: "Ngarluma"},
+	{Code: "nrm", Scope: 'I', Name: "Narom"},
+	{Code: "nrn", Scope: 'I', Name: "Norn"},
+	{Code: "nrp", Scope: 'I', Name: "North Picene"},
+	{Code: "nrr", Scope: 'I', Name: "Norra"},
+	{Code: "nrt", Scope: 'I', Name: "Northern Kalapuya"},
+	{Code: "nru", Scope: 'I', Name: "Narua"},
+	{Code: "nrx", Scope: 'I', Name: "Ngurmbur"},
+	{Code: "nrz", Scope: 'I', Name: "Lala"},
+	{Code: "nsa", Scope: 'I', Name: "Sangtam Naga"},
+	{Code: "nsb", Scope: 'I', Name: "Lower Nossob"},
+	{Code: "nsc", Scope: 'I', Name: "Nshi"},
+	{Code: "nsd", Scope: 'I', Name: "Southern Nisu"},
+	{Code: "nse", Scope: 'I', Name: "Nsenga"},
+	{Code: "nsf", Scope: 'I', Name: "Northwestern Nisu"},
+	{Code: "nsg", Scope: 'I', Name: "Ngasa"},
+	{Code: "nsh", Scope: 'I', Name: "Ngoshie"},
+	{Code: "nsi", Scope: 'I', Name: "Nigerian Sign Language"},
+	{Code: "nsk", Scope: 'I', Name: "Naskapi"},
+	{Code: "nsl", Scope: 'I', Name: "Norwegian Sign Language"},
+	{Code: "nsm", Scope: 'I', Name: "Sumi Naga"},
+	{Code: "nsn", Scope: 'I', Name: "Nehan"},
+	{Code: "nso", Part2: "nso", Scope: 'I', Name: "Pedi"},
+	{Code: "nsp", Scope: 'I', Name: "Nepalese Sign Language"},
+	{Code: "nsq", Scope: 'I', Name: "Northern Sierra Miwok"},
+	{Code: "nsr", Scope: 'I', Name: "Maritime Sign Language"},
+	{Code: "nss", Scope: 'I', Name: "Nali"},
+	{Code: "nst", Scope: 'I', Name: "Tase Naga"},
+	{Code: "nsu", Scope: 'I', Name: "Sierra Negra Nahuatl"},
+	{Code: "nsv", Scope: 'I', Name: "Southwestern Nisu"},
+	{Code: "nsw", Scope: 'I', Name: "Navut"},
+	{Code: "nsx", Scope: 'I', Name: "Nsongo"},
+	{Code: "nsy", Scope: 'I', Name: "Nasal"},
+	{Code: "nsz", Scope: 'I', Name: "Nisenan"},
+	{Code: "ntd", Scope: 'I', Name: "Northern Tidung"},
+	{Code: "nte", Scope: 'I', Name: "Nathembo"},
+	{Code: "ntg", Scope: 'I', Name: "Ngantangarra"},
+	{Code: "nti", Scope: 'I', Name: "Natioro"},
+	{Code: "ntj", Scope: 'I', Name: "Ngaanyatjarra"},
+	{Code: "ntk", Scope: 'I', Name: "Ikoma-Nata-Isenye"},
+	{Code: "ntm", Scope: 'I', Name: "Nateni"},
+	{Code: "nto", Scope: 'I', Name: "Ntomba"},
+	{Code: "ntp", Scope: 'I', Name: "Northern Tepehuan"},
+	{Code: "ntr", Scope: 'I', Name: "Delo"},
+	{Code: "ntu", Scope: 'I', Name: "Natügu"},
+	{Code: "ntw", Scope: 'I', Name: "Nottoway"},
+	{Code: "ntx", Scope: 'I', Name: "Tangkhul Naga (Myanmar)"},
+	{Code: "nty", Scope: 'I', Name: "Mantsi"},
+	{Code: "ntz", Scope: 'I', Name: "Natanzi"},
+	{Code: "nua", Scope: 'I', Name: "Yuanga"},
+	{Code: "nuc", Scope: 'I', Name: "Nukuini"},
+	{Code: "nud", Scope: 'I', Name: "Ngala"},
+	{Code: "nue", Scope: 'I', Name: "Ngundu"},
+	{Code: "nuf", Scope: 'I', Name: "Nusu"},
+	{Code: "nug", Scope: 'I', Name: "Nungali"},
+	{Code: "nuh", Scope: 'I', Name: "Ndunda"},
+	{Code: "nui", Scope: 'I', Name: "Ngumbi"},
+	{Code: "nuj", Scope: 'I', Name: "Nyole"},
+	{Code: "nuk", Scope: 'I', Name: "Nuu-chah-nulth"},
+	{Code: "nul", Scope: 'I', Name: "Nusa Laut"},
+	{Code: "num", Scope: 'I', Name: "Niuafo'ou"},
+	{Code: "nun", Scope: 'I', Name: "Anong"},
+	{Code: "nuo", Scope: 'I', Name: "Nguôn"},
+	{Code: "nup", Scope: 'I', Name: "Nupe-Nupe-Tako"},
+	{Code: "nuq", Scope: 'I', Name: "Nukumanu"},
+	{Code: "nur", Scope: 'I', Name: "Nukuria"},
+	{Code: "nus", Scope: 'I', Name: "Nuer"},
+	{Code: "nut", Scope: 'I', Name: "Nung (Viet Nam)"},
+	{Code: "nuu", Scope: 'I', Name: "Ngbundu"},
+	{Code: "nuv", Scope: 'I', Name: "Northern Nuni"},
+	{Code: "nuw", Scope: 'I', Name: "Nguluwan"},
+	{Code: "nux", Scope: 'I', Name: "Mehek"},
+	{Code: "nuy", Scope: 'I', Name: "Nunggubuyu"},
+	{Code: "nuz", Scope: 'I', Name: "Tlamacazapa Nahuatl"},
+	{Code: "nvh", Scope: 'I', Name: "Nasarian"},
+	{Code: "nvm", Scope: 'I', Name: "Namiae"},
+	{Code: "nvo", Scope: 'I', Name: "Nyokon"},
+	{Code: "nwa", Scope: 'I', Name: "Nawathinehena"},
+	{Code: "nwb", Scope: 'I', Name: "Nyabwa"},
+	{Code: "nwc", Part2: "nwc", Scope: 'I', Name: "Classical Newari"},
+	{Code: "nwe", Scope: 'I', Name: "Ngwe"},
+	{Code: "nwg", Scope: 'I', Name: "Ngayawung"},
+	{Code: "nwi", Scope: 'I', Name: "Southwest Tanna"},
+	{Code: "nwm", Scope: 'I', Name: "Nyamusa-Molo"},
+	{Code: "nwo", Scope: 'I', Name: "Nauo"},
+	{Code: "nwr", Scope: 'I', Name: "Nawaru"},
+	{Code: "nww", Scope: 'I', Name: "Ndwewe"},
+	{Code: "nwx", Scope: 'I', Name: "Middle Newar"},
+	{Code: "nwy", Scope: 'I', Name: "Nottoway-Meherrin"},
+	{Code: "nxa", Scope: 'I', Name: "Nauete"},
+	{Code: "nxd", Scope: 'I', Name: "Ngando (Democratic Republic of Congo)"},
+	{Code: "nxe", Scope: 'I', Name: "Nage"},
+	{Code: "nxg", Scope: 'I', Name: "Ngad'a"},
+	{Code: "nxi", Scope: 'I', Name: "Nindi"},
+	{Code: "nxk", Scope: 'I', Name: "Koki Naga"},
+	{Code: "nxl", Scope: 'I', Name: "South Nuaulu"},
+	{Code: "nxm", Scope: 'I', Name: "Numidian"},
+	{Code: "nxn", Scope: 'I', Name: "Ngawun"},
+	{Code: "nxo", Scope: 'I', Name: "Ndambomo"},
+	{Code: "nxq", Scope: 'I', Name: "Naxi"},
+	{Code: "nxr", Scope: 'I', Name: "Ninggerum"},
+	{Code: "nxx", Scope: 'I', Name: "Nafri"},
+	{Code: "nya", Part2: "nya", Part1: "ny", Scope: 'I', Name: "Nyanja"},
+	{Code: "nyb", Scope: 'I', Name: "Nyangbo"},
+	{Code: "nyc", Scope: 'I', Name: "Nyanga-li"},
+	{Code: "nyd", Scope: 'I', Name: "Nyore"},
+	{Code: "nye", Scope: 'I', Name: "Nyengo"},
+	{Code: "nyf", Scope: 'I', Name: "Giryama"},
+	{Code: "nyg", Scope: 'I', Name: "Nyindu"},
+	{Code: "nyh", Scope: 'I', Name: "Nyikina"},
+	{Code: "nyi", Scope: 'I', Name: "Ama (Sudan)"},
+	{Code: "nyj", Scope: 'I', Name: "Nyanga"},
+	{Code: "nyk", Scope: 'I', Name: "Nyaneka"},
+	{Code: "nyl", Scope: 'I', Name: "Nyeu"},
+	{Code: "nym", Part2: "nym", Scope: 'I', Name: "Nyamwezi"},
+	{Code: "nyn", Part2: "nyn", Scope: 'I', Name: "Nyankole"},
+	{Code: "nyo", Part2: "nyo", Scope: 'I', Name: "Nyoro"},
+	{Code: "nyp", Scope: 'I', Name: "Nyang'i"},
+	{Code: "nyq", Scope: 'I', Name: "Nayini"},
+	{Code: "nyr", Scope: 'I', Name: "Nyiha (Malawi)"},
+	{Code: "nys", Scope: 'I', Name: "Nyungar"},
+	{Code: "nyt", Scope: 'I', Name: "Nyawaygi"},
+	{Code: "nyu", Scope: 'I', Name: "Nyungwe"},
+	{Code: "nyv", Scope: 'I', Name: "Nyulnyul"},
+	{Code: "nyw", Scope: 'I', Name: "Nyaw"},
+	{Code: "nyx", Scope: 'I', Name: "Nganyaywana"},
+	{Code: "nyy", Scope: 'I', Name: "Nyakyusa-Ngonde"},
+	{Code: "nza", Scope: 'I', Name: "Tigon Mbembe"},
+	{Code: "nzb", Scope: 'I', Name: "Njebi"},
+	{Code: "nzd", Scope: 'I', Name: "Nzadi"},
+	{Code: "nzi", Part2: "nzi", Scope: 'I', Name: "Nzima"},
+	{Code: "nzk", Scope: 'I', Name: "Nzakara"},
+	{Code: "nzm", Scope: 'I', Name: "Zeme Naga"},
+	{Code: "nzs", Scope: 'I', Name: "New Zealand Sign Language"},
+	{Code: "nzu", Scope: 'I', Name: "Teke-Nzikou"},
+	{Code: "nzy", Scope: 'I', Name: "Nzakambay"},
+	{Code: "nzz", Scope: 'I', Name: "Nanga Dama Dogon"},
+	{Code: "oaa", Scope: 'I', Name: "Orok"},
+	{Code: "oac", Scope: 'I', Name: "Oroch"},
+	{Code: "oar", Scope: 'I', Name: "Old Aramaic (up to 700 BCE)"},
+	{Code: "oav", Scope: 'I', Name: "Old Avar"},
+	{Code: "obi", Scope: 'I', Name: "Obispeño"},
+	{Code: "obk", Scope: 'I', Name: "Southern Bontok"},
+	{Code: "obl", Scope: 'I', Name: "Oblo"},
+	{Code: "obm", Scope: 'I', Name: "Moabite"},
+	{Code: "obo", Scope: 'I', Name: "Obo Manobo"},
+	{Code: "obr", Scope: 'I', Name: "Old Burmese"},
+	{Code: "obt", Scope: 'I', Name: "Old Breton"},
+	{Code: "obu", Scope: 'I', Name: "Obulom"},
+	{Code: "oca", Scope: 'I', Name: "Ocaina"},
+	{Code: "och", Scope: 'I', Name: "Old Chinese"},
+	{Code: "oci", Part2: "oci", Part1: "oc", Scope: 'I', Name: "Occitan (post 1500)"},
+	{Code: "ocm", Scope: 'I', Name: "Old Cham"},
+	{Code: "oco", Scope: 'I', Name: "Old Cornish"},
+	{Code: "ocu", Scope: 'I', Name: "Atzingo Matlatzinca"},
+	{Code: "oda", Scope: 'I', Name: "Odut"},
+	{Code: "odk", Scope: 'I', Name: "Od"},
+	{Code: "odt", Scope: 'I', Name: "Old Dutch"},
+	{Code: "odu", Scope: 'I', Name: "Odual"},
+	{Code: "ofo", Scope: 'I', Name: "Ofo"},
+	{Code: "ofs", Scope: 'I', Name: "Old Frisian"},
+	{Code: "ofu", Scope: 'I', Name: "Efutop"},
+	{Code: "ogb", Scope: 'I', Name: "Ogbia"},
+	{Code: "ogc", Scope: 'I', Name: "Ogbah"},
+	{Code: "oge", Scope: 'I', Name: "Old Georgian"},
+	{Code: "ogg", Scope: 'I', Name: "Ogbogolo"},
+	{Code: "ogo", Scope: 'I', Name: "Khana"},
+	{Code: "ogu", Scope: 'I', Name: "Ogbronuagum"},
+	{Code: "oht", Scope: 'I', Name: "Old Hittite"},
+	{Code: "ohu", Scope: 'I', Name: "Old Hungarian"},
+	{Code: "oia", Scope: 'I', Name: "Oirata"},
+	{Code: "oie", Scope: 'I', Name: "Okolie"},
+	{Code: "oin", Scope: 'I', Name: "Inebu One"},
+	{Code: "ojb", Scope: 'I', Name: "Northwestern Ojibwa"},
+	{Code: "ojc", Scope: 'I', Name: "Central Ojibwa"},
+	{Code: "ojg", Scope: 'I', Macro: "oj", Name: "Eastern Ojibwa"},
+	{Code: "oji", Part2: "oji", Part1: "oj", Scope: 'M', Name: "Ojibwa"},
+	{Code: "ojp", Scope: 'I', Name: "Old Japanese"},
+	{Code: "ojs", Scope: 'I', Name: "Severn Ojibwa"},
+	{Code: "ojv", Scope: 'I', Name: "Ontong Java"},
+	{Code: "ojw", Scope: 'I', Name: "Western Ojibwa"},
+	{Code: "oka", Scope: 'I', Name: "Okanagan"},
+	{Code: "okb", Scope: 'I', Name: "Okobo"},
+	{Code: "okc", Scope: 'I', Name: "Kobo"},
+	{Code: "okd", Scope: 'I', Name: "Okodia"},
+	{Code: "oke", Scope: 'I', Name: "Okpe (Southwestern Edo)"},
+	{Code: "okg", Scope: 'I', Name: "Koko Babangk"},
+	{Code: "okh", Scope: 'I', Name: "Koresh-e Rostam"},
+	{Code: "oki", Scope: 'I', Name: "Okiek"},
+	{Code: "okj", Scope: 'I', Name: "Oko-Juwoi"},
+	{Code: "okk", Scope: 'I', Name: "Kwamtim One"},
+	{Code: "okl", Scope: 'I', Name: "Old Kentish Sign Language"},
+	{Code: "okm", Scope: 'I', Name: "Middle Korean (10th-16th cent.)"},
+	{Code: "okn", Scope: 'I', Name: "Oki-No-Erabu"},
+	{Code: "oko", Scope: 'I', Name: "Old Korean (3rd-9th cent.)"},
+	{Code: "okr", Scope: 'I', Name: "Kirike"},
+	{Code: "oks", Scope: 'I', Name: "Oko-Eni-Osayen"},
+	{Code: "oku", Scope: 'I', Name: "Oku"},
+	{Code: "okv", Scope: 'I', Name: "Orokaiva"},
+	{Code: "okx", Scope: 'I', Name: "Okpe (Northwestern Edo)"},
+	{Code: "okz", Scope: 'I', Name: "Old Khmer"},
+	{Code: "ola", Scope: 'I', Name: "Walungge"},
+	{Code: "old", Scope: 'I', Name: "Mochi"},
+	{Code: "ole", Scope: 'I', Name: "Olekha"},
+	{Code: "olk", Scope: 'I', Name: "Olkol"},
+	{Code: "olm", Scope: 'I', Name: "Oloma"},
+	{Code: "olo", Scope: 'I', Name: "Livvi"},
+	{Code: "olr", Scope: 'I', Name: "Olrat"},
+	{Code: "olt", Scope: 'I', Name: "Old Lithuanian"},
+	{Code: "olu", Scope: 'I', Name: "Kuvale"},
+	{Code: "oma", Scope: 'I', Name: "Omaha-Ponca"},
+	{Code: "omb", Scope: 'I', Name: "East Ambae"},
+	{Code: "omc", Scope: 'I', Name: "Mochica"},
+	{Code: "omg", Scope: 'I', Name: "Omagua"},
+	{Code: "omi", Scope: 'I', Name: "Omi"},
+	{Code: "omk", Scope: 'I', Name: "Omok"},
+	{Code: "oml", Scope: 'I', Name: "Ombo"},
+	{Code: "omn", Scope: 'I', Name: "Minoan"},
+	{Code: "omo", Scope: 'I', Name: "Utarmbung"},
+	{Code: "omp", Scope: 'I', Name: "Old Manipuri"},
+	{Code: "omr", Scope: 'I', Name: "Old Marathi"},
+	{Code: "omt", Scope: 'I', Name: "Omotik"},
+	{Code: "omu", Scope: 'I', Name: "Omurano"},
+	{Code: "omw", Scope: 'I', Name: "South Tairora"},
+	{Code: "omx", Scope: 'I', Name: "Old Mon"},
+	{Code: "omy", Scope: 'I', Name: "Old Malay"},
+	{Code: "ona", Scope: 'I', Name: "Ona"},
+	{Code: "onb", Scope: 'I', Name: "Lingao"},
+	{Code: "one", Scope: 'I', Name: "Oneida"},
+	{Code: "ong", Scope: 'I', Name: "Olo"},
+	{Code: "oni", Scope: 'I', Name: "Onin"},
+	{Code: "onj", Scope: 'I', Name: "Onjob"},
+	{Code: "onk", Scope: 'I', Name: "Kabore One"},
+	{Code: "onn", Scope: 'I', Name: "Onobasulu"},
+	{Code: "ono", Scope: 'I', Name: "Onondaga"},
+	{Code: "onp", Scope: 'I', Name: "Sartang"},
+	{Code: "onr", Scope: 'I', Name: "Northern One"},
+	{Code: "ons", Scope: 'I', Name: "Ono"},
+	{Code: "ont", Scope: 'I', Name: "Ontenu"},
+	{Code: "onu", Scope: 'I', Name: "Unua"},
+	{Code: "onw", Scope: 'I', Name: "Old Nubian"},
+	{Code: "onx", Scope: 'I', Name: "Onin Based Pidgin"},
+	{Code: "ood", Scope: 'I', Name: "Tohono O'odham"},
+	{Code: "oog", Scope: 'I', Name: "Ong"},
+	{Code: "oon", Scope: 'I', Name: "Önge"},
+	{Code: "oor", Scope: 'I', Name: "Oorlams"},
+	{Code: "oos", Scope: 'I', Name: "Old Ossetic"},
+	{Code: "opa", Scope: 'I', Name: "Okpamheri"},
+	{Code: "opk", Scope: 'I', Name: "Kopkaka"},
+	{Code: "opm", Scope: 'I', Name: "Oksapmin"},
+	{Code: "opo", Scope: 'I', Name: "Opao"},
+	{Code: "opt", Scope: 'I', Name: "Opata"},
+	{Code: "opy", Scope: 'I', Name: "Ofayé"},
+	{Code: "ora", Scope: 'I', Name: "Oroha"},
+	{Code: "orc", Scope: 'I', Name: "Orma"},
+	{Code: "ore", Scope: 'I', Name: "Orejón"},
+	{Code: "org", Scope: 'I', Name: "Oring"},
+	{Code: "orh", Scope: 'I', Name: "Oroqen"},
+	{Code: "ori", Part2: "ori", Part1: "or", Scope: 'M', Name: "Oriya (macrolanguage)"},
+	{Code: "orm", Part2: "orm", Part1: "om", Scope: 'M', Name: "Oromo"},
+	{Code: "orn", Scope: 'I', Macro: "ms", Name: "Orang Kanaq"},
+	{Code: "oro", Scope: 'I', Name: "Orokolo"},
+	{Code: "orr", Scope: 'I', Name: "Oruma"},
+	{Code: "ors", Scope: 'I', Macro: "ms", Name: "Orang Seletar"},
+	{Code: "ort", Scope: 'I', Name: "Adivasi Oriya"},
+	{Code: "oru", Scope: 'I', Name: "Ormuri"},
+	{Code: "orv", Scope: 'I', Name: "Old Russian"},
+	{Code: "orw", Scope: 'I', Name: "Oro Win"},
+	{Code: "orx", Scope: 'I', Name: "Oro"},
+	{Code: "ory", Scope: 'I', Macro: "or", Name: "Odia"},
+	{Code: "orz", Scope: 'I', Name: "Ormu"},
+	{Code: "osa", Part2: "osa", Scope: 'I', Name: "Osage"},
+	{Code: "osc", Scope: 'I', Name: "Oscan"},
+	{Code: "osi", Scope: 'I', Name: "Osing"},
+	{Code: "osn", Scope: 'I', Name: "Old Sundanese"},
+	{Code: "oso", Scope: 'I', Name: "Ososo"},
+	{Code: "osp", Scope: 'I', Name: "Old Spanish"},
+	{Code: "oss", Part2: "oss", Part1: "os", Scope: 'I', Name: "Ossetian"},
+	{Code: "ost", Scope: 'I', Name: "Osatu"},
+	{Code: "osu", Scope: 'I', Name: "Southern One"},
+	{Code: "osx", Scope: 'I', Name: "Old Saxon"},
+	{Code: "ota", Part2: "ota", Scope: 'I', Name: "Ottoman Turkish (1500-1928)"},
+	{Code: "otb", Scope: 'I', Name: "Old Tibetan"},
+	{Code: "otd", Scope: 'I', Name: "Ot Danum"},
+	{Code: "ote", Scope: 'I', Name: "Mezquital Otomi"},
+	{Code: "oti", Scope: 'I', Name: "Oti"},
+	{Code: "otk", Scope: 'I', Name: "Old Turkish"},
+	{Code: "otl", Scope: 'I', Name: "Tilapa Otomi"},
+	{Code: "otm", Scope: 'I', Name: "Eastern Highland Otomi"},
+	{Code: "otn", Scope: 'I', Name: "Tenango Otomi"},
+	{Code: "otq", Scope: 'I', Name: "Querétaro Otomi"},
+	{Code: "otr", Scope: 'I', Name: "Otoro"},
+	{Code: "ots", Scope: 'I', Name: "Estado de México Otomi"},
+	{Code: "ott", Scope: 'I', Name: "Temoaya Otomi"},
+	{Code: "otu", Scope: 'I', Name: "Otuke"},
+	{Code: "otw", Scope: 'I', Name: "Ottawa"},
+	{Code: "otx", Scope: 'I', Name: "Texcatepec Otomi"},
+	{Code: "oty", Scope: 'I', Name: "Old Tamil"},
+	{Code: "otz", Scope: 'I', Name: "Ixtenco Otomi"},
+	{Code: "oua", Scope: 'I', Name: "Tagargrent"},
+	{Code: "oub", Scope: 'I', Name: "Glio-Oubi"},
+	{Code: "oue", Scope: 'I', Name: "Oune"},
+	{Code: "oui", Scope: 'I', Name: "Old Uighur"},
+	{Code: "oum", Scope: 'I', Name: "Ouma"},
+	{Code: "ovd", Scope: 'I', Name: "Elfdalian"},
+	{Code: "owi", Scope: 'I', Name: "Owiniga"},
+	{Code: "owl", Scope: 'I', Name: "Old Welsh"},
+	{Code: "oyb", Scope: 'I', Name: "Oy"},
+	{Code: "oyd", Scope: 'I', Name: "Oyda"},
+	{Code: "oym", Scope: 'I', Name: "Wayampi"},
+	{Code: "oyy", Scope: 'I', Name: "Oya'oya"},
+	{Code: "ozm", Scope: 'I', Name: "Koonzime"},
+	{Code: "pab", Scope: 'I', Name: "Parecís"},
+	{Code: "pac", Scope: 'I', Name: "Pacoh"},
+	{Code: "pad", Scope: 'I', Name: "Paumarí"},
+	{Code: "pae", Scope: 'I', Name: "Pagibete"},
+	{Code: "paf", Scope: 'I', Name: "Paranawát"},
+	{Code: "pag", Part2: "pag", Scope: 'I', Name: "Pangasinan"},
+	{Code: "pah", Scope: 'I', Name: "Tenharim"},
+	{Code: "pai", Scope: 'I', Name: "Pe"},
+	{Code: "pak", Scope: 'I', Name: "Parakanã"},
+	{Code: "pal", Part2: "pal", Scope: 'I', Name: "Pahlavi"},
+	{Code: "pam", Part2: "pam", Scope: 'I', Name: "Pampanga"},
+	{Code: "pan", Part2: "pan", Part1: "pa", Scope: 'I', Name: "Panjabi"},
+	{Code: "pao", Scope: 'I', Name: "Northern Paiute"},
+	{Code: "pap", Part2: "pap", Scope: 'I', Name: "Papiamento"},
+	{Code: "paq", Scope: 'I', Name: "Parya"},
+	{Code: "par", Scope: 'I', Name: "Panamint"},
+	{Code: "pas", Scope: 'I', Name: "Papasena"},
+	{Code: "pau", Part2: "pau", Scope: 'I', Name: "Palauan"},
+	{Code: "pav", Scope: 'I', Name: "Pakaásnovos"},
+	{Code: "paw", Scope: 'I', Name: "Pawnee"},
+	{Code: "pax", Scope: 'I', Name: "Pankararé"},
+	{Code: "pay", Scope: 'I', Name: "Pech"},
+	{Code: "paz", Scope: 'I', Name: "Pankararú"},
+	{Code: "pbb", Scope: 'I', Name: "Páez"},
+	{Code: "pbc", Scope: 'I', Name: "Patamona"},
+	{Code: "pbe", Scope: 'I', Name: "Mezontla Popoloca"},
+	{Code: "pbf", Scope: 'I', Name: "Coyotepec Popoloca"},
+	{Code: "pbg", Scope: 'I', Name: "Paraujano"},
+	{Code: "pbh", Scope: 'I', Name: "E'ñapa Woromaipu"},
+	{Code: "pbi", Scope: 'I', Name: "Parkwa"},
+	{Code: "pbl", Scope: 'I', Name: "Mak (Nigeria)"},
+	{Code: "pbm", Scope: 'I', Name: "Puebla Mazatec"},
+	{Code: "pbn", Scope: 'I', Name: "Kpasam"},
+	{Code: "pbo", Scope: 'I', Name: "Papel"},
+	{Code: "pbp", Scope: 'I', Name: "Badyara"},
+	{Code: "pbr", Scope: 'I', Name: "Pangwa"},
+	{Code: "pbs", Scope: 'I', Name: "Central Pame"},
+	{Code: "pbt", Scope: 'I', Macro: "ps", Name: "Southern Pashto"},
+	{Code: "pbu", Scope: 'I', Macro: "ps", Name: "Northern Pashto"},
+	{Code: "pbv", Scope: 'I', Name: "Pnar"},
+	{Code: "pby", Scope: 'I', Name: "Pyu (Papua New Guinea)"},
+	{Code: "pca", Scope: 'I', Name: "Santa Inés Ahuatempan Popoloca"},
+	{Code: "pcb", Scope: 'I', Name: "Pear"},
+	{Code: "pcc", Scope: 'I', Name: "Bouyei"},
+	{Code: "pcd", Scope: 'I', Name: "Picard"},
+	{Code: "pce", Scope: 'I', Name: "Ruching Palaung"},
+	{Code: "pcf", Scope: 'I', Name: "Paliyan"},
+	{Code: "pcg", Scope: 'I', Name: "Paniya"},
+	{Code: "pch", Scope: 'I', Name: "Pardhan"},
+	{Code: "pci", Scope: 'I', Name: "Duruwa"},
+	{Code: "pcj", Scope: 'I', Name: "Parenga"},
+	{Code: "pck", Scope: 'I', Name: "Paite Chin"},
+	{Code: "pcl", Scope: 'I', Name: "Pardhi"},
+	{Code: "pcm", Scope: 'I', Name: "Nigerian Pidgin"},
+	{Code: "pcn", Scope: 'I', Name: "Piti"},
+	{Code: "pcp", Scope: 'I', Name: "Pacahuara"},
+	{Code: "pcw", Scope: 'I', Name: "Pyapun"},
+	{Code: "pda", Scope: 'I', Name: "Anam"},
+	{Code: "pdc", Scope: 'I', Name: "Pennsylvania German"},
+	{Code: "pdi", Scope: 'I', Name: "Pa Di"},
+	{Code: "pdn", Scope: 'I', Name: "Podena"},
+	{Code: "pdo", Scope: 'I', Name: "Padoe"},
+	{Code: "pdt", Scope: 'I', Name: "Plautdietsch"},
+	{Code: "pdu", Scope: 'I', Name: "Kayan"},
+	{Code: "pea", Scope: 'I', Name: "Peranakan Indonesian"},
+	{Code: "peb", Scope: 'I', Name: "Eastern Pomo"},
+	{Code: "ped", Scope: 'I', Name: "Mala (Papua New Guinea)"},
+	{Code: "pee", Scope: 'I', Name: "Taje"},
+	{Code: "pef", Scope: 'I', Name: "Northeastern Pomo"},
+	{Code: "peg", Scope: 'I', Name: "Pengo"},
+	{Code: "peh", Scope: 'I', Name: "Bonan"},
+	{Code: "pei", Scope: 'I', Name: "Chichimeca-Jonaz"},
+	{Code: "pej", Scope: 'I', Name: "Northern Pomo"},
+	{Code: "pek", Scope: 'I', Name: "Penchal"},
+	{Code: "pel", Scope: 'I', Macro: "ms", Name: "Pekal"},
+	{Code: "pem", Scope: 'I', Name: "Phende"},
+	{Code: "peo", Part2: "peo", Scope: 'I', Name: "Old Persian (ca. 600-400 B.C.)"},
+	{Code: "pep", Scope: 'I', Name: "Kunja"},
+	{Code: "peq", Scope: 'I', Name: "Southern Pomo"},
+	{Code: "pes", Scope: 'I', Macro: "fa", Name: "Iranian Persian"},
+	{Code: "pev", Scope: 'I', Name: "Pémono"},
+	{Code: "pex", Scope: 'I', Name: "Petats"},
+	{Code: "pey", Scope: 'I', Name: "Petjo"},
+	{Code: "pez", Scope: 'I', Name: "Eastern Penan"},
+	{Code: "pfa", Scope: 'I', Name: "Pááfang"},
+	{Code: "pfe", Scope: 'I', Name: "Pere"},
+	{Code: "pfl", Scope: 'I', Name: "Pfaelzisch"},
+	{Code: "pga", Scope: 'I', Macro: "ar", Name: "Sudanese Creole Arabic"},
+	{Code: "pgd", Scope: 'I', Name: "Gāndhārī"},
+	{Code: "pgg", Scope: 'I', Name: "Pangwali"},
+	{Code: "pgi", Scope: 'I', Name: "Pagi"},
+	{Code: "pgk", Scope: 'I', Name: "Rerep"},
+	{Code: "pgl", Scope: 'I', Name: "Primitive Irish"},
+	{Code: "pgn", Scope: 'I', Name: "Paelignian"},
+	{Code: "pgs", Scope: 'I', Name: "Pangseng"},
+	{Code: "pgu", Scope: 'I', Name: "Pagu"},
+	{Code: "pgz", Scope: 'I', Name: "Papua New Guinean Sign Language"},
+	{Code: "pha", Scope: 'I', Name: "Pa-Hng"},
+	{Code: "phd", Scope: 'I', Name: "Phudagi"},
+	{Code: "phg", Scope: 'I', Name: "Phuong"},
+	{Code: "phh", Scope: 'I', Name: "Phukha"},
+	{Code: "phj", Scope: 'I', Name: "Pahari"},
+	{Code: "phk", Scope: 'I', Name: "Phake"},
+	{Code: "phl", Scope: 'I', Name: "Phalura"},
+	{Code: "phm", Scope: 'I', Name: "Phimbi"},
+	{Code: "phn", Part2: "phn", Scope: 'I', Name: "Phoenician"},
+	{Code: "pho", Scope: 'I', Name: "Phunoi"},
+	{Code: "phq", Scope: 'I', Name: "Phana'"},
+	{Code: "phr", Scope: 'I', Name: "Pahari-Potwari"},
+	{Code: "pht", Scope: 'I', Name: "Phu Thai"},
+	{Code: "phu", Scope: 'I', Name: "Phuan"},
+	{Code: "phv", Scope: 'I', Name: "Pahlavani"},
+	{Code: "phw", Scope: 'I', Name: "Phangduwali"},
+	{Code: "pia", Scope: 'I', Name: "Pima Bajo"},
+	{Code: "pib", Scope: 'I', Name: "Yine"},
+	{Code: "pic", Scope: 'I', Name: "Pinji"},
+	{Code: "pid", Scope: 'I', Name: "Piaroa"},
+	{Code: "pie", Scope: 'I', Name: "Piro"},
+	{Code: "pif", Scope: 'I', Name: "Pingelapese"},
+	{Code: "pig", Scope: 'I', Name: "Pisabo"},
+	{Code: "pih", Scope: 'I', Name: "Pitcairn-Norfolk"},
+	{Code: "pij", Scope: 'I', Name: "Pijao"},
+	{Code: "pil", Scope: 'I', Name: "Yom"},
+	{Code: "pim", Scope: 'I', Name: "Powhatan"},
+	{Code: "pin", Scope: 'I', Name: "Piame"},
+	{Code: "pio", Scope: 'I', Name: "Piapoco"},
+	{Code: "pip", Scope: 'I', Name: "Pero"},
+	{Code: "pir", Scope: 'I', Name: "Piratapuyo"},
+	{Code: "pis", Scope: 'I', Name: "Pijin"},
+	{Code: "pit", Scope: 'I', Name: "Pitta Pitta"},
+	{Code: "piu", Scope: 'I', Name: "Pintupi-Luritja"},
+	{Code: "piv", Scope: 'I', Name: "Pileni"},
+	{Code: "piw", Scope: 'I', Name: "Pimbwe"},
+	{Code: "pix", Scope: 'I', Name: "Piu"},
+	{Code: "piy", Scope: 'I', Name: "Piya-Kwonci"},
+	{Code: "piz", Scope: 'I', Name: "Pije"},
+	{Code: "pjt", Scope: 'I', Name: "Pitjantjatjara"},
+	{Code: "pka", Scope: 'I', Name: "Ardhamāgadhī Prākrit"},
+	{Code: "pkb", Scope: 'I', Name: "Pokomo"},
+	{Code: "pkc", Scope: 'I', Name: "Paekche"},
+	{Code: "pkg", Scope: 'I', Name: "Pak-Tong"},
+	{Code: "pkh", Scope: 'I', Name: "Pankhu"},
+	{Code: "pkn", Scope: 'I', Name: "Pakanha"},
+	{Code: "pko", Scope: 'I', Name: "Pökoot"},
+	{Code: "pkp", Scope: 'I', Name: "Pukapuka"},
+	{Code: "pkr", Scope: 'I', Name: "Attapady Kurumba"},
+	{Code: "pks", Scope: 'I', Name: "Pakistan Sign Language"},
+	{Code: "pkt", Scope: 'I', Name: "Maleng"},
+	{Code: "pku", Scope: 'I', Name: "Paku"},
+	{Code: "pla", Scope: 'I', Name: "Miani"},
+	{Code: "plb", Scope: 'I', Name: "Polonombauk"},
+	{Code: "plc", Scope: 'I', Name: "Central Palawano"},
+	{Code: "pld", Scope: 'I', Name: "Polari"},
+	{Code: "ple", Scope: 'I', Name: "Palu'e"},
+	{Code: "plg", Scope: 'I', Name: "Pilagá"},
+	{Code: "plh", Scope: 'I', Name: "Paulohi"},
+	{Code: "pli", Part2: "pli", Part1: "pi", Scope: 'I', Name: "Pali"},
+	{Code: "plj", Scope: 'I', Name: "Polci"},
+	{Code: "plk", Scope: 'I', Name: "Kohistani Shina"},
+	{Code: "pll", Scope: 'I', Name: "Shwe Palaung"},
+	{Code: "pln", Scope: 'I', Name: "Palenquero"},
+	{Code: "plo", Scope: 'I', Name: "Oluta Popoluca"},
+	{Code: "plq", Scope: 'I', Name: "Palaic"},
+	{Code: "plr", Scope: 'I', Name: "Palaka Senoufo"},
+	{Code: "pls", Scope: 'I', Name: "San Marcos Tlacoyalco Popoloca"},
+	{Code: "plt", Scope: 'I', Macro: "mg", Name: "Plateau Malagasy"},
+	{Code: "plu", Scope: 'I', Name: "Palikúr"},
+	{Code: "plv", Scope: 'I', Name: "Southwest Palawano"},
+	{Code: "plw", Scope: 'I', Name: "Brooke's Point Palawano"},
+	{Code: "ply", Scope: 'I', Name: "Bolyu"},
+	{Code: "plz", Scope: 'I', Name: "Paluan"},
+	{Code: "pma", Scope: 'I', Name: "Paama"},
+	{Code: "pmb", Scope: 'I', Name: "Pambia"},
+	{Code: "pmd", Scope: 'I', Name: "Pallanganmiddang"},
+	{Code: "pme", Scope: 'I', Name: "Pwaamei"},
+	{Code: "pmf", Scope: 'I', Name: "Pamona"},
+	{Code: "pmh", Scope: 'I', Name: "Māhārāṣṭri Prākrit"},
+	{Code: "pmi", Scope: 'I', Name: "Northern Pumi"},
+	{Code: "pmj", Scope: 'I', Name: "Southern Pumi"},
+	{Code: "pmk", Scope: 'I', Name: "Pamlico"},
+	{Code: "pml", Scope: 'I', Name: "Lingua Franca"},
+	{Code: "pmm", Scope: 'I', Name: "Pomo"},
+	{Code: "pmn", Scope: 'I', Name: "Pam"},
+	{Code: "pmo", Scope: 'I', Name: "Pom"},
+	{Code: "pmq", Scope: 'I', Name: "Northern Pame"},
+	{Code: "pmr", Scope: 'I', Name: "Paynamar"},
+	{Code: "pms", Scope: 'I', Name: "Piemontese"},
+	{Code: "pmt", Scope: 'I', Name: "Tuamotuan"},
+	{Code: "pmw", Scope: 'I', Name: "Plains Miwok"},
+	{Code: "pmx", Scope: 'I', Name: "Poumei Naga"},
+	{Code: "pmy", Scope: 'I', Name: "Papuan Malay"},
+	{Code: "pmz", Scope: 'I', Name: "Southern Pame"},
+	{Code: "pna", Scope: 'I', Name: "Punan Bah-Biau"},
+	{Code: "pnb", Scope: 'I', Macro: "lah", Name: "Western Panjabi"},
+	{Code: "pnc", Scope: 'I', Name: "Pannei"},
+	{Code: "pnd", Scope: 'I', Name: "Mpinda"},
+	{Code: "pne", Scope: 'I', Name: "Western Penan"},
+	{Code: "png", Scope: 'I', Name: "Pangu"},
+	{Code: "pnh", Scope: 'I', Name: "Penrhyn"},
+	{Code: "pni", Scope: 'I', Name: "Aoheng"},
+	{Code: "pnj", Scope: 'I', Name: "Pinjarup"},
+	{Code: "pnk", Scope: 'I', Name: "Paunaka"},
+	{Code: "pnl", Scope: 'I', Name: "Paleni"},
+	{Code: "pnm", Scope: 'I', Name: "Punan Batu 1"},
+	{Code: "pnn", Scope: 'I', Name: "Pinai-Hagahai"},
+	{Code: "pno", Scope: 'I', Name: "Panobo"},
+	{Code: "pnp", Scope: 'I', Name: "Pancana"},
+	{Code: "pnq", Scope: 'I', Name: "Pana (Burkina Faso)"},
+	{Code: "pnr", Scope: 'I', Name: "Panim"},
+	{Code: "pns", Scope: 'I', Name: "Ponosakan"},
+	{Code: "pnt", Scope: 'I', Name: "Pontic"},
+	{Code: "pnu", Scope: 'I', Name: "Jiongnai Bunu"},
+	{Code: "pnv", Scope: 'I', Name: "Pinigura"},
+	{Code: "pnw", Scope: 'I', Name: "Banyjima"},
+	{Code: "pnx", Scope: 'I', Name: "Phong-Kniang"},
+	{Code: "pny", Scope: 'I', Name: "Pinyin"},
+	{Code: "pnz", Scope: 'I', Name: "Pana (Central African Republic)"},
+	{Code: "poc", Scope: 'I', Name: "Poqomam"},
+	{Code: "poe", Scope: 'I', Name: "San Juan Atzingo Popoloca"},
+	{Code: "pof", Scope: 'I', Name: "Poke"},
+	{Code: "pog", Scope: 'I', Name: "Potiguára"},
+	{Code: "poh", Scope: 'I', Name: "Poqomchi'"},
+	{Code: "poi", Scope: 'I', Name: "Highland Popoluca"},
+	{Code: "pok", Scope: 'I', Name: "Pokangá"},
+	{Code: "pol", Part2: "pol", Part1: "pl", Scope: 'I', Name: "Polish"},
+	{Code: "pom", Scope: 'I', Name: "Southeastern Pomo"},
+	{Code: "pon", Part2: "pon", Scope: 'I', Name: "Pohnpeian"},
+	{Code: "poo", Scope: 'I', Name: "Central Pomo"},
+	{Code: "pop", Scope: 'I', Name: "Pwapwâ"},
+	{Code: "poq", Scope: 'I', Name: "Texistepec Popoluca"},
+	{Code: "por", Part2: "por", Part1: "pt", Scope: 'I', Name: "Portuguese"},
+	{Code: "pos", Scope: 'I', Name: "Sayula Popoluca"},
+	{Code: "pot", Scope: 'I', Name: "Potawatomi"},
+	{Code: "pov", Scope: 'I', Name: "Upper Guinea Crioulo"},
+	{Code: "pow", Scope: 'I', Name: "San Felipe Otlaltepec Popoloca"},
+	{Code: "pox", Scope: 'I', Name: "Polabian"},
+	{Code: "poy", Scope: 'I', Name: "Pogolo"},
+	{Code: "ppe", Scope: 'I', Name: "Papi"},
+	{Code: "ppi", Scope: 'I', Name: "Paipai"},
+	{Code: "ppk", Scope: 'I', Name: "Uma"},
+	{Code: "ppl", Scope: 'I', Name: "Pipil"},
+	{Code: "ppm", Scope: 'I', Name: "Papuma"},
+	{Code: "ppn", Scope: 'I', Name: "Papapana"},
+	{Code: "ppo", Scope: 'I', Name: "Folopa"},
+	{Code: "ppp", Scope: 'I', Name: "Pelende"},
+	{Code: "ppq", Scope: 'I', Name: "Pei"},
+	{Code: "pps", Scope: 'I', Name: "San Luís Temalacayuca Popoloca"},
+	{Code: "ppt", Scope: 'I', Name: "Pare"},
+	{Code: "ppu", Scope: 'I', Name: "Papora"},
+	{Code: "pqa", Scope: 'I', Name: "Pa'a"},
+	{Code: "pqm", Scope: 'I', Name: "Malecite-Passamaquoddy"},
+	{Code: "prc", Scope: 'I', Name: "Parachi"},
+	{Code: "prd", Scope: 'I', Name: "Parsi-Dari"},
+	{Code: "pre", Scope: 'I', Name: "Principense"},
+	{Code: "prf", Scope: 'I', Name: "Paranan"},
+	{Code: "prg", Scope: 'I', Name: "Prussian"},
+	{Code: "prh", Scope: 'I', Name: "Porohanon"},
+	{Code: "pri", Scope: 'I', Name: "Paicî"},
+	{Code: "prk", Scope: 'I', Name: "Parauk"},
+	{Code: "prl", Scope: 'I', Name: "Peruvian Sign Language"},
+	{Code: "prm", Scope: 'I', Name: "Kibiri"},
+	{Code: "prn", Scope: 'I', Name: "Prasuni"},
+	{Code: "pro", Part2: "pro", Scope: 'I', Name: "Old Provençal (to 1500)"},
+	{Code: "prp", Scope: 'I', Name: "Parsi"},
+	{Code: "prq", Scope: 'I', Name: "Ashéninka Perené"},
+	{Code: "prr", Scope: 'I', Name: "Puri"},
+	{Code: "prs", Scope: 'I', Macro: "fa", Name: "Dari"},
+	{Code: "prt", Scope: 'I', Name: "Phai"},
+	{Code: "pru", Scope: 'I', Name: "Puragi"},
+	{Code: "prw", Scope: 'I', Name: "Parawen"},
+	{Code: "prx", Scope: 'I', Name: "Purik"},
+	{Code: "prz", Scope: 'I', Name: "Providencia Sign Language"},
+	{Code: "psa", Scope: 'I', Name: "Asue Awyu"},
+	{Code: "psc", Scope: 'I', Name: "Iranian Sign Language"},
+	{Code: "psd", Scope: 'I', Name: "Plains Indian Sign Language"},
+	{Code: "pse", Scope: 'I', Macro: "ms", Name: "Central Malay"},
+	{Code: "psg", Scope: 'I', Name: "Penang Sign Language"},
+	{Code: "psh", Scope: 'I', Name: "Southwest Pashai"},
+	{Code: "psi", Scope: 'I', Name: "Southeast Pashai"},
+	{Code: "psl", Scope: 'I', Name: "Puerto Rican Sign Language"},
+	{Code: "psm", Scope: 'I', Name: "Pauserna"},
+	{Code: "psn", Scope: 'I', Name: "Panasuan"},
+	{Code: "pso", Scope: 'I', Name: "Polish Sign Language"},
+	{Code: "psp", Scope: 'I', Name: "Philippine Sign Language"},
+	{Code: "psq", Scope: 'I', Name: "Pasi"},
+	{Code: "psr", Scope: 'I', Name: "Portuguese Sign Language"},
+	{Code: "pss", Scope: 'I', Name: "Kaulong"},
+	{Code: "pst", Scope: 'I', Macro: "ps", Name: "Central Pashto"},
+	{Code: "psu", Scope: 'I', Name: "Sauraseni Prākrit"},
+	{Code: "psw", Scope: 'I', Name: "Port Sandwich"},
+	{Code: "psy", Scope: 'I', Name: "Piscataway"},
+	{Code: "pta", Scope: 'I', Name: "Pai Tavytera"},
+	{Code: "pth", Scope: 'I', Name: "Pataxó Hã-Ha-Hãe"},
+	{Code: "pti", Scope: 'I', Name: "Pindiini"},
+	{Code: "ptn", Scope: 'I', Name: "Patani"},
+	{Code: "pto", Scope: 'I', Name: "Zo'é"},
+	{Code: "ptp", Scope: 'I', Name: "Patep"},
+	{Code: "ptq", Scope: 'I', Name: "Pattapu"},
+	{Code: "ptr", Scope: 'I', Name: "Piamatsina"},
+	{Code: "ptt", Scope: 'I', Name: "Enrekang"},
+	{Code: "ptu", Scope: 'I', Name: "Bambam"},
+	{Code: "ptv", Scope: 'I', Name: "Port Vato"},
+	{Code: "ptw", Scope: 'I', Name: "Pentlatch"},
+	{Code: "pty", Scope: 'I', Name: "Pathiya"},
+	{Code: "pua", Scope: 'I', Name: "Western Highland Purepecha"},
+	{Code: "pub", Scope: 'I', Name: "Purum"},
+	{Code: "puc", Scope: 'I', Name: "Punan Merap"},
+	{Code: "pud", Scope: 'I', Name: "Punan Aput"},
+	{Code: "pue", Scope: 'I', Name: "Puelche"},
+	{Code: "puf", Scope: 'I', Name: "Punan Merah"},
+	{Code: "pug", Scope: 'I', Name: "Phuie"},
+	{Code: "pui", Scope: 'I', Name: "Puinave"},
+	{Code: "puj", Scope: 'I', Name: "Punan Tubu"},
+	{Code: "pum", Scope: 'I', Name: "Puma"},
+	{Code: "puo", Scope: 'I', Name: "Puoc"},
+	{Code: "pup", Scope: 'I', Name: "Pulabu"},
+	{Code: "puq", Scope: 'I', Name: "Puquina"},
+	{Code: "pur", Scope: 'I', Name: "Puruborá"},
+	{Code: "pus", Part2: "pus", Part1: "ps", Scope: 'M', Name: "Pushto"},
+	{Code: "put", Scope: 'I', Name: "Putoh"},
+	{Code: "puu", Scope: 'I', Name: "Punu"},
+	{Code: "puw", Scope: 'I', Name: "Puluwatese"},
+	{Code: "pux", Scope: 'I', Name: "Puare"},
+	{Code: "puy", Scope: 'I', Name: "Purisimeño"},
+	{Code: "pwa", Scope: 'I', Name: "Pawaia"},
+	{Code: "pwb", Scope: 'I', Name: "Panawa"},
+	{Code: "pwg", Scope: 'I', Name: "Gapapaiwa"},
+	{Code: "pwi", Scope: 'I', Name: "Patwin"},
+	{Code: "pwm", Scope: 'I', Name: "Molbog"},
+	{Code: "pwn", Scope: 'I', Name: "Paiwan"},
+	{Code: "pwo", Scope: 'I', Name: "Pwo Western Karen"},
+	{Code: "pwr", Scope: 'I', Name: "Powari"},
+	{Code: "pww", Scope: 'I', Name: "Pwo Northern Karen"},
+	{Code: "pxm", Scope: 'I', Name: "Quetzaltepec Mixe"},
+	{Code: "pye", Scope: 'I', Name: "Pye Krumen"},
+	{Code: "pym", Scope: 'I', Name: "Fyam"},
+	{Code: "pyn", Scope: 'I', Name: "Poyanáwa"},
+	{Code: "pys", Scope: 'I', Name: "Paraguayan Sign Language"},
+	{Code: "pyu", Scope: 'I', Name: "Puyuma"},
+	{Code: "pyx", Scope: 'I', Name: "Pyu (Myanmar)"},
+	{Code: "pyy", Scope: 'I', Name: "Pyen"},
+	{Code: "pzh", Scope: 'I', Name: "Pazeh"},
+	{Code: "pzn", Scope: 'I', Name: "Jejara Naga"},
+	{Code: "qua", Scope: 'I', Name: "Quapaw"},
+	{Code: "qub", Scope: 'I', Name: "Huallaga Huánuco Quechua"},
+	{Code: "quc", Scope: 'I', Name: "K'iche'"},
+	{Code: "qud", Scope: 'I', Name: "Calderón Highland Quichua"},
+	{Code: "que", Part2: "que", Part1: "qu", Scope: 'M', Name: "Quechua"},
+	{Code: "quf", Scope: 'I', Name: "Lambayeque Quechua"},
+	{Code: "qug", Scope: 'I', Name: "Chimborazo Highland Quichua"},
+	{Code: "quh", Scope: 'I', Name: "South Bolivian Quechua"},
+	{Code: "qui", Scope: 'I', Name: "Quileute"},
+	{Code: "quk", Scope: 'I', Name: "Chachapoyas Quechua"},
+	{Code: "qul", Scope: 'I', Name: "North Bolivian Quechua"},
+	{Code: "qum", Scope: 'I', Name: "Sipacapense"},
+	{Code: "qun", Scope: 'I', Name: "Quinault"},
+	{Code: "qup", Scope: 'I', Name: "Southern Pastaza Quechua"},
+	{Code: "quq", Scope: 'I', Name: "Quinqui"},
+	{Code: "qur", Scope: 'I', Name: "Yanahuanca Pasco Quechua"},
+	{Code: "qus", Scope: 'I', Name: "Santiago del Estero Quichua"},
+	{Code: "quv", Scope: 'I', Name: "Sacapulteco"},
+	{Code: "quw", Scope: 'I', Name: "Tena Lowland Quichua"},
+	{Code: "qux", Scope: 'I', Name: "Yauyos Quechua"},
+	{Code: "quy", Scope: 'I', Name: "Ayacucho Quechua"},
+	{Code: "quz", Scope: 'I', Macro: "qu", Name: "Cusco Quechua"},
+	{Code: "qva", Scope: 'I', Name: "Ambo-Pasco Quechua"},
+	{Code: "qvc", Scope: 'I', Name: "Cajamarca Quechua"},
+	{Code: "qve", Scope: 'I', Name: "Eastern Apurímac Quechua"},
+	{Code: "qvh", Scope: 'I', Name: "Huamalíes-Dos de Mayo Huánuco Quechua"},
+	{Code: "qvi", Scope: 'I', Name: "Imbabura Highland Quichua"},
+	{Code: "qvj", Scope: 'I', Name: "Loja Highland Quichua"},
+	{Code: "qvl", Scope: 'I', Name: "Cajatambo North Lima Quechua"},
+	{Code: "qvm", Scope: 'I', Name: "Margos-Yarowilca-Lauricocha Quechua"},
+	{Code: "qvn", Scope: 'I', Name: "North Junín Quechua"},
+	{Code: "qvo", Scope: 'I', Name: "Napo Lowland Quechua"},
+	{Code: "qvp", Scope: 'I', Name: "Pacaraos Quechua"},
+	{Code: "qvs", Scope: 'I', Name: "San Martín Quechua"},
+	{Code: "qvw", Scope: 'I', Name: "Huaylla Wanca Quechua"},
+	{Code: "qvy", Scope: 'I', Name: "Queyu"},
+	{Code: "qvz", Scope: 'I', Name: "Northern Pastaza Quichua"},
+	{Code: "qwa", Scope: 'I', Name: "Corongo Ancash Quechua"},
+	{Code: "qwc", Scope: 'I', Name: "Classical Quechua"},
+	{Code: "qwh", Scope: 'I', Name: "Huaylas Ancash Quechua"},
+	{Code: "qwm", Scope: 'I', Name: "Kuman (Russia)"},
+	{Code: "qws", Scope: 'I', Name: "Sihuas Ancash Quechua"},
+	{Code: "qwt", Scope: 'I', Name: "Kwalhioqua-Tlatskanai"},
+	{Code: "qxa", Scope: 'I', Name: "Chiquián Ancash Quechua"},
+	{Code: "qxc", Scope: 'I', Name: "Chincha Quechua"},
+	{Code: "qxh", Scope: 'I', Name: "Panao Huánuco Quechua"},
+	{Code: "qxl", Scope: 'I', Name: "Salasaca Highland Quichua"},
+	{Code: "qxn", Scope: 'I', Name: "Northern Conchucos Ancash Quechua"},
+	{Code: "qxo", Scope: 'I', Name: "Southern Conchucos Ancash Quechua"},
+	{Code: "qxp", Scope: 'I', Name: "Puno Quechua"},
+	{Code: "qxq", Scope: 'I', Name: "Qashqa'i"},
+	{Code: "qxr", Scope: 'I', Name: "Cañar Highland Quichua"},
+	{Code: "qxs", Scope: 'I', Name: "Southern Qiang"},
+	{Code: "qxt", Scope: 'I', Name: "Santa Ana de Tusi Pasco Quechua"},
+	{Code: "qxu", Scope: 'I', Name: "Arequipa-La Unión Quechua"},
+	{Code: "qxw", Scope: 'I', Name: "Jauja Wanca Quechua"},
+	{Code: "qya", Scope: 'I', Name: "Quenya"},
+	{Code: "qyp", Scope: 'I', Name: "Quiripi"},
+	{Code: "raa", Scope: 'I', Name: "Dungmali"},
+	{Code: "rab", Scope: 'I', Name: "Camling"},
+	{Code: "rac", Scope: 'I', Name: "Rasawa"},
+	{Code: "rad", Scope: 'I', Name: "Rade"},
+	{Code: "raf", Scope: 'I', Name: "Western Meohang"},
+	{Code: "rag", Scope: 'I', Name: "Logooli"},
+	{Code: "rah", Scope: 'I', Name: "Rabha"},
+	{Code: "rai", Scope: 'I', Name: "Ramoaaina"},
+	{Code: "raj", Part2: "raj", Scope: 'M', Name: "Rajasthani"},
+	{Code: "rak", Scope: 'I', Name: "Tulu-Bohuai"},
+	{Code: "ral", Scope: 'I', Name: "Ralte"},
+	{Code: "ram", Scope: 'I', Name: "Canela"},
+	{Code: "ran", Scope: 'I', Name: "Riantana"},
+	{Code: "rao", Scope: 'I', Name: "Rao"},
+	{Code: "rap", Part2: "rap", Scope: 'I', Name: "Rapanui"},
+	{Code: "raq", Scope: 'I', Name: "Saam"},
+	{Code: "rar", Part2: "rar", Scope: 'I', Name: "Rarotongan"},
+	{Code: "ras", Scope: 'I', Name: "Tegali"},
+	{Code: "rat", Scope: 'I', Name: "Razajerdi"},
+	{Code: "rau", Scope: 'I', Name: "Raute"},
+	{Code: "rav", Scope: 'I', Name: "Sampang"},
+	{Code: "raw", Scope: 'I', Name: "Rawang"},
+	{Code: "rax", Scope: 'I', Name: "Rang"},
+	{Code: "ray", Scope: 'I', Name: "Rapa"},
+	{Code: "raz", Scope: 'I', Name: "Rahambuu"},
+	{Code: "rbb", Scope: 'I', Name: "Rumai Palaung"},
+	{Code: "rbk", Scope: 'I', Name: "Northern Bontok"},
+	{Code: "rbl", Scope: 'I', Name: "Miraya Bikol"},
+	{Code: "rbp", Scope: 'I', Name: "Barababaraba"},
+	{Code: "rcf", Scope: 'I', Name: "Réunion Creole French"},
+	{Code: "rdb", Scope: 'I', Name: "Rudbari"},
+	{Code: "rea", Scope: 'I', Name: "Rerau"},
+	{Code: "reb", Scope: 'I', Name: "Rembong"},
+	{Code: "ree", Scope: 'I', Name: "Rejang Kayan"},
+	{Code: "reg", Scope: 'I', Name: "Kara (Tanzania)"},
+	{Code: "rei", Scope: 'I', Name: "Reli"},
+	{Code: "rej", Scope: 'I', Name: "Rejang"},
+	{Code: "rel", Scope: 'I', Name: "Rendille"},
+	{Code: "rem", Scope: 'I', Name: "Remo"},
+	{Code: "ren", Scope: 'I', Name: "Rengao"},
+	{Code: "rer", Scope: 'I', Name: "Rer Bare"},
+	{Code: "res", Scope: 'I', Name: "Reshe"},
+	{Code: "ret", Scope: 'I', Name: "Retta"},
+	{Code: "rey", Scope: 'I', Name: "Reyesano"},
+	{Code: "rga", Scope: 'I', Name: "Roria"},
+	{Code: "rge", Scope: 'I', Name: "Romano-Greek"},
+	{Code: "rgk", Scope: 'I', Name: "Rangkas"},
+	{Code: "rgn", Scope: 'I', Name: "Romagnol"},
+	{Code: "rgr", Scope: 'I', Name: "Resígaro"},
+	{Code: "rgs", Scope: 'I', Name: "Southern Roglai"},
+	{Code: "rgu", Scope: 'I', Name: "Ringgou"},
+	{Code: "rhg", Scope: 'I', Name: "Rohingya"},
+	{Code: "rhp", Scope: 'I', Name: "Yahang"},
+	{Code: "ria", Scope: 'I', Name: "Riang (India)"},
+	{Code: "rib", Scope: 'I', Name: "Bribri Sign Language"},
+	{Code: "rif", Scope: 'I', Name: "Tarifit"},
+	{Code: "ril", Scope: 'I', Name: "Riang Lang"},
+	{Code: "rim", Scope: 'I', Name: "Nyaturu"},
+	{Code: "rin", Scope: 'I', Name: "Nungu"},
+	{Code: "rir", Scope: 'I', Name: "Ribun"},
+	{Code: "rit", Scope: 'I', Name: "Ritharrngu"},
+	{Code: "riu", Scope: 'I', Name: "Riung"},
+	{Code: "rjg", Scope: 'I', Name: "Rajong"},
+	{Code: "rji", Scope: 'I', Name: "Raji"},
+	{Code: "rjs", Scope: 'I', Name: "Rajbanshi"},
+	{Code: "rka", Scope: 'I', Name: "Kraol"},
+	{Code: "rkb", Scope: 'I', Name: "Rikbaktsa"},
+	{Code: "rkh", Scope: 'I', Name: "Rakahanga-Manihiki"},
+	{Code: "rki", Scope: 'I', Name: "Rakhine"},
+	{Code: "rkm", Scope: 'I', Name: "Marka"},
+	{Code: "rkt", Scope: 'I', Name: "Rangpuri"},
+	{Code: "rkw", Scope: 'I', Name: "Arakwal"},
+	{Code: "rma", Scope: 'I', Name: "Rama"},
+	{Code: "rmb", Scope: 'I', Name: "Rembarrnga"},
+	{Code: "rmc", Scope: 'I', Name: "Carpathian Romani"},
+	{Code: "rmd", Scope: 'I', Name: "Traveller Danish"},
+	{Code: "rme", Scope: 'I', Name: "Angloromani"},
+	{Code: "rmf", Scope: 'I', Name: "Kalo Finnish Romani"},
+	{Code: "rmg", Scope: 'I', Name: "Traveller Norwegian"},
+	{Code: "rmh", Scope: 'I', Name: "Murkim"},
+	{Code: "rmi", Scope: 'I', Name: "Lomavren"},
+	{Code: "rmk", Scope: 'I', Name: "Romkun"},
+	{Code: "rml", Scope: 'I', Name: "Baltic Romani"},
+	{Code: "rmm", Scope: 'I', Name: "Roma"},
+	{Code: "rmn", Scope: 'I', Name: "Balkan Romani"},
+	{Code: "rmo", Scope: 'I', Name: "Sinte Romani"},
+	{Code: "rmp", Scope: 'I', Name: "Rempi"},
+	{Code: "rmq", Scope: 'I', Name: "Caló"},
+	{Code: "rms", Scope: 'I', Name: "Romanian Sign Language"},
+	{Code: "rmt", Scope: 'I', Name: "Domari"},
+	{Code: "rmu", Scope: 'I', Name: "Tavringer Romani"},
+	{Code: "rmv", Scope: 'I', Name: "Romanova"},
+	{Code: "rmw", Scope: 'I', Name: "Welsh Romani"},
+	{Code: "rmx", Scope: 'I', Name: "Romam"},
+	{Code: "rmy", Scope: 'I', Macro: "rom", Name: "Vlax Romani"},
+	{Code: "rmz", Scope: 'I', Name: "Marma"},
+	{Code: "rnb", Scope: 'I', Name: "Brunca Sign Language"},
+	{Code: "rnd", Scope: 'I', Name: "Ruund"},
+	{Code: "rng", Scope: 'I', Name: "Ronga"},
+	{Code: "rnl", Scope: 'I', Name: "Ranglong"},
+	{Code: "rnn", Scope: 'I', Name: "Roon"},
+	{Code: "rnp", Scope: 'I', Name: "Rongpo"},
+	{Code: "rnr", Scope: 'I', Name: "Nari Nari"},
+	{Code: "rnw", Scope: 'I', Name: "Rungwa"},
+	{Code: "rob", Scope: 'I', Name: "Tae'"},
+	{Code: "roc", Scope: 'I', Name: "Cacgia Roglai"},
+	{Code: "rod", Scope: 'I', Name: "Rogo"},
+	{Code: "roe", Scope: 'I', Name: "Ronji"},
+	{Code: "rof", Scope: 'I', Name: "Rombo"},
+	{Code: "rog", Scope: 'I', Name: "Northern Roglai"},
+	{Code: "roh", Part2: "roh", Part1: "rm", Scope: 'I', Name: "Romansh"},
+	{Code: "rol", Scope: 'I', Name: "Romblomanon"},
+	{Code: "rom", Part2: "rom", Scope: 'M', Name: "Romany"},
+	{Code: "ron", Part2: "ron", Part1: "ro", Scope: 'I', Name: "Romanian"},
+	{Code: "roo", Scope: 'I', Name: "Rotokas"},
+	{Code: "rop", Scope: 'I', Name: "Kriol"},
+	{Code: "ror", Scope: 'I', Name: "Rongga"},
+	{Code: "rou", Scope: 'I', Name: "Runga"},
+	{Code: "row", Scope: 'I', Name: "Dela-Oenale"},
+	{Code: "rpn", Scope: 'I', Name: "Repanbitip"},
+	{Code: "rpt", Scope: 'I', Name: "Rapting"},
+	{Code: "rri", Scope: 'I', Name: "Ririo"},
+	{Code: "rro", Scope: 'I', Name: "Waima"},
+	{Code: "rrt", Scope: 'I', Name: "Arritinngithigh"},
+	{Code: "rsb", Scope: 'I', Name: "Romano-Serbian"},
+	{Code: "rsk", Scope: 'I', Name: "Ruthenian"},
+	{Code: "rsl", Scope: 'I', Name: "Russian Sign Language"},
+	{Code: "rsm", Scope: 'I', Name: "Miriwoong Sign Language"},
+	{Code: "rsn", Scope: 'I', Name: "Rwandan Sign Language"},
+	{Code: "rtc", Scope: 'I', Name: "Rungtu Chin"},
+	{Code: "rth", Scope: 'I', Name: "Ratahan"},
+	{Code: "rtm", Scope: 'I', Name: "Rotuman"},
+	{Code: "rts", Scope: 'I', Name: "Yurats"},
+	{Code: "rtw", Scope: 'I', Name: "Rathawi"},
+	{Code: "rub", Scope: 'I', Name: "Gungu"},
+	{Code: "ruc", Scope: 'I', Name: "Ruuli"},
+	{Code: "rue", Scope: 'I', Name: "Rusyn"},
+	{Code: "ruf", Scope: 'I', Name: "Luguru"},
+	{Code: "rug", Scope: 'I', Name: "Roviana"},
+	{Code: "ruh", Scope: 'I', Name: "Ruga"},
+	{Code: "rui", Scope: 'I', Name: "Rufiji"},
+	{Code: "ruk", Scope: 'I', Name: "Che"},
+	{Code: "run", Part2: "run", Part1: "rn", Scope: 'I', Name: "Rundi"},
+	{Code: "ruo", Scope: 'I', Name: "Istro Romanian"},
+	{Code: "rup", Part2: "rup", Scope: 'I', Name: "Macedo-Romanian"},
+	{Code: "ruq", Scope: 'I', Name: "Megleno Romanian"},
+	{Code: "rus", Part2: "rus", Part1: "ru", Scope: 'I', Name: "Russian"},
+	{Code: "rut", Scope: 'I', Name: "Rutul"},
+	{Code: "ruu", Scope: 'I', Name: "Lanas Lobu"},
+	{Code: "ruy", Scope: 'I', Name: "Mala (Nigeria)"},
+	{Code: "ruz", Scope: 'I', Name: "Ruma"},
+	{Code: "rwa", Scope: 'I', Name: "Rawo"},
+	{Code: "rwk", Scope: 'I', Name: "Rwa"},
+	{Code: "rwl", Scope: 'I', Name: "Ruwila"},
+	{Code: "rwm", Scope: 'I', Name: "Amba (Uganda)"},
+	{Code: "rwo", Scope: 'I', Name: "Rawa"},
+	{Code: "rwr", Scope: 'I', Name: "Marwari (India)"},
+	{Code: "rxd", Scope: 'I', Name: "Ngardi"},
+	{Code: "rxw", Scope: 'I', Name: "Karuwali"},
+	{Code: "ryn", Scope: 'I', Name: "Northern Amami-Oshima"},
+	{Code: "rys", Scope: 'I', Name: "Yaeyama"},
+	{Code: "ryu", Scope: 'I', Name: "Central Okinawan"},
+	{Code: "rzh", Scope: 'I', Name: "Rāziḥī"},
+	{Code: "saa", Scope: 'I', Name: "Saba"},
+	{Code: "sab", Scope: 'I', Name: "Buglere"},
+	{Code: "sac", Scope: 'I', Name: "Meskwaki"},
+	{Code: "sad", Part2: "sad", Scope: 'I', Name: "Sandawe"},
+	{Code: "sae", Scope: 'I', Name: "Sabanê"},
+	{Code: "saf", Scope: 'I', Name: "Safaliba"},
+	{Code: "sag", Part2: "sag", Part1: "sg", Scope: 'I', Name: "Sango"},
+	{Code: "sah", Part2: "sah", Scope: 'I', Name: "Yakut"},
+	{Code: "saj", Scope: 'I', Name: "Sahu"},
+	{Code: "sak", Scope: 'I', Name: "Sake"},
+	{Code: "sam", Part2: "sam", Scope: 'I', Name: "Samaritan Aramaic"},
+	{Code: "san", Part2: "san", Part1: "sa", Scope: 'I', Name: "Sanskrit"},
+	{Code: "sao", Scope: 'I', Name: "Sause"},
+	{Code: "saq", Scope: 'I', Name: "Samburu"},
+	{Code: "sar", Scope: 'I', Name: "Saraveca"},
+	{Code: "sas", Part2: "sas", Scope: 'I', Name: "Sasak"},
+	{Code: "sat", Part2: "sat", Scope: 'I', Name: "Santali"},
+	{Code: "sau", Scope: 'I', Name: "Saleman"},
+	{Code: "sav", Scope: 'I', Name: "Saafi-Saafi"},
+	{Code: "saw", Scope: 'I', Name: "Sawi"},
+	{Code: "sax", Scope: 'I', Name: "Sa"},
+	{Code: "say", Scope: 'I', Name: "Saya"},
+	{Code: "saz", Scope: 'I', Name: "Saurashtra"},
+	{Code: "sba", Scope: 'I', Name: "Ngambay"},
+	{Code: "sbb", Scope: 'I', Name: "Simbo"},
+	{Code: "sbc", Scope: 'I', Name: "Kele (Papua New Guinea)"},
+	{Code: "sbd", Scope: 'I', Name: "Southern Samo"},
+	{Code: "sbe", Scope: 'I', Name: "Saliba"},
+	{Code: "sbf", Scope: 'I', Name: "Chabu"},
+	{Code: "sbg", Scope: 'I', Name: "Seget"},
+	{Code: "sbh", Scope: 'I', Name: "Sori-Harengan"},
+	{Code: "sbi", Scope: 'I', Name: "Seti"},
+	{Code: "sbj", Scope: 'I', Name: "Surbakhal"},
+	{Code: "sbk", Scope: 'I', Name: "Safwa"},
+	{Code: "sbl", Scope: 'I', Name: "Botolan Sambal"},
+	{Code: "sbm", Scope: 'I', Name: "Sagala"},
+	{Code: "sbn", Scope: 'I', Name: "Sindhi Bhil"},
+	{Code: "sbo", Scope: 'I', Name: "Sabüm"},
+	{Code: "sbp", Scope: 'I', Name: "Sangu (Tanzania)"},
+	{Code: "sbq", Scope: 'I', Name: "Sileibi"},
+	{Code: "sbr", Scope: 'I', Name: "Sembakung Murut"},
+	{Code: "sbs", Scope: 'I', Name: "Subiya"},
+	{Code: "sbt", Scope: 'I', Name: "Kimki"},
+	{Code: "sbu", Scope: 'I', Name: "Stod Bhoti"},
+	{Code: "sbv", Scope: 'I', Name: "Sabine"},
+	{Code: "sbw", Scope: 'I', Name: "Simba"},
+	{Code: "sbx", Scope: 'I', Name: "Seberuang"},
+	{Code: "sby", Scope: 'I', Name: "Soli"},
+	{Code: "sbz", Scope: 'I', Name: "Sara Kaba"},
+	{Code: "scb", Scope: 'I', Name: "Chut"},
+	{Code: "sce", Scope: 'I', Name: "Dongxiang"},
+	{Code: "scf", Scope: 'I', Name: "San Miguel Creole French"},
+	{Code: "scg", Scope: 'I', Name: "Sanggau"},
+	{Code: "sch", Scope: 'I', Name: "Sakachep"},
+	{Code: "sci", Scope: 'I', Name: "Sri Lankan Creole Malay"},
+	{Code: "sck", Scope: 'I', Name: "Sadri"},
+	{Code: "scl", Scope: 'I', Name: "Shina"},
+	{Code: "scn", Part2: "scn", Scope: 'I', Name: "Sicilian"},
+	{Code: "sco", Part2: "sco", Scope: 'I', Name: "Scots"},
+	{Code: "scp", Scope: 'I', Name: "Hyolmo"},
+	{Code: "scq", Scope: 'I', Name: "Sa'och"},
+	{Code: "scs", Scope: 'I', Name: "North Slavey"},
+	{Code: "sct", Scope: 'I', Name: "Southern Katang"},
+	{Code: "scu", Scope: 'I', Name: "Shumcho"},
+	{Code: "scv", Scope: 'I', Name: "Sheni"},
+	{Code: "scw", Scope: 'I', Name: "Sha"},
+	{Code: "scx", Scope: 'I', Name: "Sicel"},
+	{Code: "sda", Scope: 'I', Name: "Toraja-Sa'dan"},
+	{Code: "sdb", Scope: 'I', Name: "Shabak"},
+	{Code: "sdc", Scope: 'I', Name: "Sassarese Sardinian"},
+	{Code: "sde", Scope: 'I', Name: "Surubu"},
+	{Code: "sdf", Scope: 'I', Name: "Sarli"},
+	{Code: "sdg", Scope: 'I', Name: "Savi"},
+	{Code: "sdh", Scope: 'I', Macro: "ku", Name: "Southern Kurdish"},
+	{Code: "sdj", Scope: 'I', Name: "Suundi"},
+	{Code: "sdk", Scope: 'I', Name: "Sos Kundi"},
+	{Code: "sdl", Scope: 'I', Name: "Saudi Arabian Sign Language"},
+	{Code: "sdn", Scope: 'I', Name: "Gallurese Sardinian"},
+	{Code: "sdo", Scope: 'I', Name: "Bukar-Sadung Bidayuh"},
+	{Code: "sdp", Scope: 'I', Name: "Sherdukpen"},
+	{Code: "sdq", Scope: 'I', Name: "Semandang"},
+	{Code: "sdr", Scope: 'I', Name: "Oraon Sadri"},
+	{Code: "sds", Scope: 'I', Name: "Sened"},
+	{Code: "sdt", Scope: 'I', Name: "Shuadit"},
+	{Code: "sdu", Scope: 'I', Name: "Sarudu"},
+	{Code: "sdx", Scope: 'I', Name: "Sibu Melanau"},
+	{Code: "sdz", Scope: 'I', Name: "Sallands"},
+	{Code: "sea", Scope: 'I', Name: "Semai"},
+	{Code: "seb", Scope: 'I', Name: "Shempire Senoufo"},
+	{Code: "sec", Scope: 'I', Name: "Sechelt"},
+	{Code: "sed", Scope: 'I', Name: "Sedang"},
+	{Code: "see", Scope: 'I', Name: "Seneca"},
+	{Code: "sef", Scope: 'I', Name: "Cebaara Senoufo"},
+	{Code: "seg", Scope: 'I', Name: "Segeju"},
+	{Code: "seh", Scope: 'I', Name: "Sena"},
+	{Code: "sei", Scope: 'I', Name: "Seri"},
+	{Code: "sej", Scope: 'I', Name: "Sene"},
+	{Code: "sek", Scope: 'I', Name: "Sekani"},
+	{Code: "sel", Part2: "sel", Scope: 'I', Name: "Selkup"},
+	{Code: "sen", Scope: 'I', Name: "Nanerigé Sénoufo"},
+	{Code: "seo", Scope: 'I', Name: "Suarmin"},
+	{Code: "sep", Scope: 'I', Name: "Sìcìté Sénoufo"},
+	{Code: "seq", Scope: 'I', Name: "Senara Sénoufo"},
+	{Code: "ser", Scope: 'I', Name: "Serrano"},
+	{Code: "ses", Scope: 'I', Name: "Koyraboro Senni Songhai"},
+	{Code: "set", Scope: 'I', Name: "Sentani"},
+	{Code: "seu", Scope: 'I', Name: "Serui-Laut"},
+	{Code: "sev", Scope: 'I', Name: "Nyarafolo Senoufo"},
+	{Code: "sew", Scope: 'I', Name: "Sewa Bay"},
+	{Code: "sey", Scope: 'I', Name: "Secoya"},
+	{Code: "sez", Scope: 'I', Name: "Senthang Chin"},
+	{Code: "sfb", Scope: 'I', Name: "Langue des signes de Belgique Francophone"},
+	{Code: "sfe", Scope: 'I', Name: "Eastern Subanen"},
+	{Code: "sfm", Scope: 'I', Name: "Small Flowery Miao"},
+	{Code: "sfs", Scope: 'I', Name: "South African Sign Language"},
+	{Code: "sfw", Scope: 'I', Name: "Sehwi"},
+	{Code: "sga", Part2: "sga", Scope: 'I', Name: "Old Irish (to 900)"},
+	{Code: "sgb", Scope: 'I', Name: "Mag-antsi Ayta"},
+	{Code: "sgc", Scope: 'I', Name: "Kipsigis"},
+	{Code: "sgd", Scope: 'I', Name: "Surigaonon"},
+	{Code: "sge", Scope: 'I', Name: "Segai"},
+	{Code: "sgg", Scope: 'I', Name: "Swiss-German Sign Language"},
+	{Code: "sgh", Scope: 'I', Name: "Shughni"},
+	{Code: "sgi", Scope: 'I', Name: "Suga"},
+	{Code: "sgj", Scope: 'I', Name: "Surgujia"},
+	{Code: "sgk", Scope: 'I', Name: "Sangkong"},
+	{Code: "sgm", Scope: 'I', Name: "Singa"},
+	{Code: "sgp", Scope: 'I', Name: "Singpho"},
+	{Code: "sgr", Scope: 'I', Name: "Sangisari"},
+	{Code: "sgs", Scope: 'I', Name: "Samogitian"},
+	{Code: "sgt", Scope: 'I', Name: "Brokpake"},
+	{Code: "sgu", Scope: 'I', Name: "Salas"},
+	{Code: "sgw", Scope: 'I', Name: "Sebat Bet Gurage"},
+	{Code: "sgx", Scope: 'I', Name: "Sierra Leone Sign Language"},
+	{Code: "sgy", Scope: 'I', Name: "Sanglechi"},
+	{Code: "sgz", Scope: 'I', Name: "Sursurunga"},
+	{Code: "sha", Scope: 'I', Name: "Shall-Zwall"},
+	{Code: "shb", Scope: 'I', Name: "Ninam"},
+	{Code: "shc", Scope: 'I', Name: "Sonde"},
+	{Code: "shd", Scope: 'I', Name: "Kundal Shahi"},
+	{Code: "she", Scope: 'I', Name: "Sheko"},
+	{Code: "shg", Scope: 'I', Name: "Shua"},
+	{Code: "shh", Scope: 'I', Name: "Shoshoni"},
+	{Code: "shi", Scope: 'I', Name: "Tachelhit"},
+	{Code: "shj", Scope: 'I', Name: "Shatt"},
+	{Code: "shk", Scope: 'I', Name: "Shilluk"},
+	{Code: "shl", Scope: 'I', Name: "Shendu"},
+	{Code: "shm", Scope: 'I', Name: "Shahrudi"},
+	{Code: "shn", Part2: "shn", Scope: 'I', Name: "Shan"},
+	{Code: "sho", Scope: 'I', Name: "Shanga"},
+	{Code: "shp", Scope: 'I', Name: "Shipibo-Conibo"},
+	{Code: "shq", Scope: 'I', Name: "Sala"},
+	{Code: "shr", Scope: 'I', Name: "Shi"},
+	{Code: "shs", Scope: 'I', Name: "Shuswap"},
+	{Code: "sht", Scope: 'I', Name: "Shasta"},
+	{Code: "shu", Scope: 'I', Macro: "ar", Name: "Chadian Arabic"},
+	{Code: "shv", Scope: 'I', Name: "Shehri"},
+	{Code: "shw", Scope: 'I', Name: "Shwai"},
+	{Code: "shx", Scope: 'I', Name: "She"},
+	{Code: "shy", Scope: 'I', Name: "Tachawit"},
+	{Code: "shz", Scope: 'I', Name: "Syenara Senoufo"},
+	{Code: "sia", Scope: 'I', Name: "Akkala Sami"},
+	{Code: "sib", Scope: 'I', Name: "Sebop"},
+	{Code: "sid", Part2: "sid", Scope: 'I', Name: "Sidamo"},
+	{Code: "sie", Scope: 'I', Name: "Simaa"},
+	{Code: "sif", Scope: 'I', Name: "Siamou"},
+	{Code: "sig", Scope: 'I', Name: "Paasaal"},
+	{Code: "sih", Scope: 'I', Name: "Zire"},
+	{Code: "sii", Scope: 'I', Name: "Shom Peng"},
+	{Code: "sij", Scope: 'I', Name: "Numbami"},
+	{Code: "sik", Scope: 'I', Name: "Sikiana"},
+	{Code: "sil", Scope: 'I', Name: "Tumulung Sisaala"},
+	{Code: "sim", Scope: 'I', Name: "Mende (Papua New Guinea)"},
+	{Code: "sin", Part2: "sin", Part1: "si", Scope: 'I', Name: "Sinhala"},
+	{Code: "sip", Scope: 'I', Name: "Sikkimese"},
+	{Code: "siq", Scope: 'I', Name: "Sonia"},
+	{Code: "sir", Scope: 'I', Name: "Siri"},
+	{Code: "sis", Scope: 'I', Name: "Siuslaw"},
+	{Code: "siu", Scope: 'I', Name: "Sinagen"},
+	{Code: "siv", Scope: 'I', Name: "Sumariup"},
+	{Code: "siw", Scope: 'I', Name: "Siwai"},
+	{Code: "six", Scope: 'I', Name: "Sumau"},
+	{Code: "siy", Scope: 'I', Name: "Sivandi"},
+	{Code: "siz", Scope: 'I', Name: "Siwi"},
+	{Code: "sja", Scope: 'I', Name: "Epena"},
+	{Code: "sjb", Scope: 'I', Name: "Sajau Basap"},
+	{Code: "sjd", Scope: 'I', Name: "Kildin Sami"},
+	{Code: "sje", Scope: 'I', Name: "Pite Sami"},
+	{Code: "sjg", Scope: 'I', Name: "Assangori"},
+	{Code: "sjk", Scope: 'I', Name: "Kemi Sami"},
+	{Code: "sjl", Scope: 'I', Name: "Sajalong"},
+	{Code: "sjm", Scope: 'I', Name: "Mapun"},
+	{Code: "sjn", Scope: 'I', Name: "Sindarin"},
+	{Code: "sjo", Scope: 'I', Name: "Xibe"},
+	{Code: "sjp", Scope: 'I', Name: "Surjapuri"},
+	{Code: "sjr", Scope: 'I', Name: "Siar-Lak"},
+	{Code: "sjs", Scope: 'I', Name: "Senhaja De Srair"},
+	{Code: "sjt", Scope: 'I', Name: "Ter Sami"},
+	{Code: "sju", Scope: 'I', Name: "Ume Sami"},
+	{Code: "sjw", Scope: 'I', Name: "Shawnee"},
+	{Code: "ska", Scope: 'I', Name: "Skagit"},
+	{Code: "skb", Scope: 'I', Name: "Saek"},
+	{Code: "skc", Scope: 'I', Name: "Ma Manda"},
+	{Code: "skd", Scope: 'I', Name: "Southern Sierra Miwok"},
+	{Code: "ske", Scope: 'I', Name: "Seke (Vanuatu)"},
+	{Code: "skf", Scope: 'I', Name: "Sakirabiá"},
+	{Code: "skg", Scope: 'I', Name: "Sakalava Malagasy"},
+	{Code: "skh", Scope: 'I', Name: "Sikule"},
+	{Code: "ski", Scope: 'I', Name: "Sika"},
+	{Code: "skj", Scope: 'I', Name: "Seke (Nepal)"},
+	{Code: "skm", Scope: 'I', Name: "Kutong"},
+	{Code: "skn", Scope: 'I', Name: "Kolibugan Subanon"},
+	{Code: "sko", Scope: 'I', Name: "Seko Tengah"},
+	{Code: "skp", Scope: 'I', Name: "Sekapan"},
+	{Code: "skq", Scope: 'I', Name: "Sininkere"},
+	{Code: "skr", Scope: 'I', Name: "Saraiki"},
+	{Code: "sks", Scope: 'I', Name: "Maia"},
+	{Code: "skt", Scope: 'I', Name: "Sakata"},
+	{Code: "sku", Scope: 'I', Name: "Sakao"},
+	{Code: "skv", Scope: 'I', Name: "Skou"},
+	{Code: "skw", Scope: 'I', Name: "Skepi Creole Dutch"},
+	{Code: "skx", Scope: 'I', Name: "Seko Padang"},
+	{Code: "sky", Scope: 'I', Name: "Sikaiana"},
+	{Code: "skz", Scope: 'I', Name: "Sekar"},
+	{Code: "slc", Scope: 'I', Name: "Sáliba"},
+	{Code: "sld", Scope: 'I', Name: "Sissala"},
+	{Code: "sle", Scope: 'I', Name: "Sholaga"},
+	{Code: "slf", Scope: 'I', Name: "Swiss-Italian Sign Language"},
+	{Code: "slg", Scope: 'I', Name: "Selungai Murut"},
+	{Code: "slh", Scope: 'I', Name: "Southern Puget Sound Salish"},
+	{Code: "sli", Scope: 'I', Name: "Lower Silesian"},
+	{Code: "slj", Scope: 'I', Name: "Salumá"},
+	{Code: "slk", Part2: "slk", Part1: "sk", Scope: 'I', Name: "Slovak"},
+	{Code: "sll", Scope: 'I', Name: "Salt-Yui"},
+	{Code: "slm", Scope: 'I', Name: "Pangutaran Sama"},
+	{Code: "sln", Scope: 'I', Name: "Salinan"},
+	{Code: "slp", Scope: 'I', Name: "Lamaholot"},
+	{Code: "slq", Scope: 'I', Name: "Salchuq"},
+	{Code: "slr", Scope: 'I', Name: "Salar"},
+	{Code: "sls", Scope: 'I', Name: "Singapore Sign Language"},
+	{Code: "slt", Scope: 'I', Name: "Sila"},
+	{Code: "slu", Scope: 'I', Name: "Selaru"},
+	{Code: "slv", Part2: "slv", Part1: "sl", Scope: 'I', Name: "Slovenian"},
+	{Code: "slw", Scope: 'I', Name: "Sialum"},
+	{Code: "slx", Scope: 'I', Name: "Salampasu"},
+	{Code: "sly", Scope: 'I', Name: "Selayar"},
+	{Code: "slz", Scope: 'I', Name: "Ma'ya"},
+	{Code: "sma", Part2: "sma", Scope: 'I', Name: "Southern Sami"},
+	{Code: "smb", Scope: 'I', Name: "Simbari"},
+	{Code: "smc", Scope: 'I', Name: "Som"},
+	{Code: "sme", Part2: "sme", Part1: "se", Scope: 'I', Name: "Northern Sami"},
+	{Code: "smf", Scope: 'I', Name: "Auwe"},
+	{Code: "smg", Scope: 'I', Name: "Simbali"},
+	{Code: "smh", Scope: 'I', Name: "Samei"},
+	{Code: "smj", Part2: "smj", Scope: 'I', Name: "Lule Sami"},
+	{Code: "smk", Scope: 'I', Name: "Bolinao"},
+	{Code: "sml", Scope: 'I', Name: "Central Sama"},
+	{Code: "smm", Scope: 'I', Name: "Musasa"},
+	{Code: "smn", Part2: "smn", Scope: 'I', Name: "Inari Sami"},
+	{Code: "smo", Part2: "smo", Part1: "sm", Scope: 'I', Name: "Samoan"},
+	{Code: "smp", Scope: 'I', Name: "Samaritan"},
+	{Code: "smq", Scope: 'I', Name: "Samo"},
+	{Code: "smr", Scope: 'I', Name: "Simeulue"},
+	{Code: "sms", Part2: "sms", Scope: 'I', Name: "Skolt Sami"},
+	{Code: "smt", Scope: 'I', Name: "Simte"},
+	{Code: "smu", Scope: 'I', Name: "Somray"},
+	{Code: "smv", Scope: 'I', Name: "Samvedi"},
+	{Code: "smw", Scope: 'I', Name: "Sumbawa"},
+	{Code: "smx", Scope: 'I', Name: "Samba"},
+	{Code: "smy", Scope: 'I', Name: "Semnani"},
+	{Code: "smz", Scope: 'I', Name: "Simeku"},
+	{Code: "sna", Part2: "sna", Part1: "sn", Scope: 'I', Name: "Shona"},
+	{Code: "snc", Scope: 'I', Name: "Sinaugoro"},
+	{Code: "snd", Part2: "snd", Part1: "sd", Scope: 'I', Name: "Sindhi"},
+	{Code: "sne", Scope: 'I', Name: "Bau Bidayuh"},
+	{Code: "snf", Scope: 'I', Name: "Noon"},
+	{Code: "sng", Scope: 'I', Name: "Sanga (Democratic Republic of Congo)"},
+	{Code: "sni", Scope: 'I', Name: "Sensi"},
+	{Code: "snj", Scope: 'I', Name: "Riverain Sango"},
+	{Code: "snk", Part2: "snk", Scope: 'I', Name: "Soninke"},
+	{Code: "snl", Scope: 'I', Name: "Sangil"},
+	{Code: "snm", Scope: 'I', Name: "Southern Ma'di"},
+	{Code: "snn", Scope: 'I', Name: "Siona"},
+	{Code: "sno", Scope: 'I', Name: "Snohomish"},
+	{Code: "snp", Scope: 'I', Name: "Siane"},
+	{Code: "snq", Scope: 'I', Name: "Sangu (Gabon)"},
+	{Code: "snr", Scope: 'I', Name: "Sihan"},
+	{Code: "sns", Scope: 'I', Name: "South West Bay"},
+	{Code: "snu", Scope: 'I', Name: "Senggi"},
+	{Code: "snv", Scope: 'I', Name: "Sa'ban"},
+	{Code: "snw", Scope: 'I', Name: "Selee"},
+	{Code: "snx", Scope: 'I', Name: "Sam"},
+	{Code: "sny", Scope: 'I', Name: "Saniyo-Hiyewe"},
+	{Code: "snz", Scope: 'I', Name: "Kou"},
+	{Code: "soa", Scope: 'I', Name: "Thai Song"},
+	{Code: "sob", Scope: 'I', Name: "Sobei"},
+	{Code: "soc", Scope: 'I', Name: "So (Democratic Republic of Congo)"},
+	{Code: "sod", Scope: 'I', Name: "Songoora"},
+	{Code: "soe", Scope: 'I', Name: "Songomeno"},
+	{Code: "sog", Part2: "sog", Scope: 'I', Name: "Sogdian"},
+	{Code: "soh", Scope: 'I', Name: "Aka"},
+	{Code: "soi", Scope: 'I', Name: "Sonha"},
+	{Code: "soj", Scope: 'I', Name: "Soi"},
+	{Code: "sok", Scope: 'I', Name: "Sokoro"},
+	{Code: "sol", Scope: 'I', Name: "Solos"},
+	{Code: "som", Part2: "som", Part1: "so", Scope: 'I', Name: "Somali"},
+	{Code: "soo", Scope: 'I', Name: "Songo"},
+	{Code: "sop", Scope: 'I', Name: "Songe"},
+	{Code: "soq", Scope: 'I', Name: "Kanasi"},
+	{Code: "sor", Scope: 'I', Name: "Somrai"},
+	{Code: "sos", Scope: 'I', Name: "Seeku"},
+	{Code: "sot", Part2: "sot", Part1: "st", Scope: 'I', Name: "Southern Sotho"},
+	{Code: "sou", Scope: 'I', Name: "Southern Thai"},
+	{Code: "sov", Scope: 'I', Name: "Sonsorol"},
+	{Code: "sow", Scope: 'I', Name: "Sowanda"},
+	{Code: "sox", Scope: 'I', Name: "Swo"},
+	{Code: "soy", Scope: 'I', Name: "Miyobe"},
+	{Code: "soz", Scope: 'I', Name: "Temi"},
+	{Code: "spa", Part2: "spa", Part1: "es", Scope: 'I', Name: "Spanish"},
+	{Code: "spb", Scope: 'I', Name: "Sepa (Indonesia)"},
+	{Code: "spc", Scope: 'I', Name: "Sapé"},
+	{Code: "spd", Scope: 'I', Name: "Saep"},
+	{Code: "spe", Scope: 'I', Name: "Sepa (Papua New Guinea)"},
+	{Code: "spg", Scope: 'I', Name: "Sian"},
+	{Code: "spi", Scope: 'I', Name: "Saponi"},
+	{Code: "spk", Scope: 'I', Name: "Sengo"},
+	{Code: "spl", Scope: 'I', Name: "Selepet"},
+	{Code: "spm", Scope: 'I', Name: "Akukem"},
+	{Code: "spn", Scope: 'I', Name: "Sanapaná"},
+	{Code: "spo", Scope: 'I', Name: "Spokane"},
+	{Code: "spp", Scope: 'I', Name: "Supyire Senoufo"},
+	{Code: "spq", Scope: 'I', Name: "Loreto-Ucayali Spanish"},
+	{Code: "spr", Scope: 'I', Name: "Saparua"},
+	{Code: "sps", Scope: 'I', Name: "Saposa"},
+	{Code: "spt", Scope: 'I', Name: "Spiti Bhoti"},
+	{Code: "spu", Scope: 'I', Name: "Sapuan"},
+	{Code: "spv", Scope: 'I', Macro: "or", Name: "Sambalpuri"},
+	{Code: "spx", Scope: 'I', Name: "South Picene"},
+	{Code: "spy", Scope: 'I', Macro: "kln", Name: "Sabaot"},
+	{Code: "sqa", Scope: 'I', Name: "Shama-Sambuga"},
+	{Code: "sqh", Scope: 'I', Name: "Shau"},
+	{Code: "sqi", Part2: "sqi", Part1: "sq", Scope: 'M', Name: "Albanian"},
+	{Code: "sqk", Scope: 'I', Name: "Albanian Sign Language"},
+	{Code: "sqm", Scope: 'I', Name: "Suma"},
+	{Code: "sqn", Scope: 'I', Name: "Susquehannock"},
+	{Code: "sqo", Scope: 'I', Name: "Sorkhei"},
+	{Code: "sqq", Scope: 'I', Name: "Sou"},
+	{Code: "sqr", Scope: 'I', Name: "Siculo Arabic"},
+	{Code: "sqs", Scope: 'I', Name: "Sri Lankan Sign Language"},
+	{Code: "sqt", Scope: 'I', Name: "Soqotri"},
+	{Code: "squ", Scope: 'I', Name: "Squamish"},
+	{Code: "sqx", Scope: 'I', Name: "Kufr Qassem Sign Language (KQSL)"},
+	{Code: "sra", Scope: 'I', Name: "Saruga"},
+	{Code: "srb", Scope: 'I', Name: "Sora"},
+	{Code: "src", Scope: 'I', Macro: "sc", Name: "Logudorese Sardinian"},
+	{Code: "srd", Part2: "srd", Part1: "sc", Scope: 'M', Name: "Sardinian"},
+	{Code: "sre", Scope: 'I', Name: "Sara"},
+	{Code: "srf", Scope: 'I', Name: "Nafi"},
+	{Code: "srg", Scope: 'I', Name: "Sulod"},
+	{Code: "srh", Scope: 'I', Name: "Sarikoli"},
+	{Code: "sri", Scope: 'I', Name: "Siriano"},
+	{Code: "srk", Scope: 'I', Name: "Serudung Murut"},
+	{Code: "srl", Scope: 'I', Name: "Isirawa"},
+	{Code: "srm", Scope: 'I', Name: "Saramaccan"},
+	{Code: "srn", Part2: "srn", Scope: 'I', Name: "Sranan Tongo"},
+	{Code: "sro", Scope: 'I', Name: "Campidanese Sardinian"},
+	{Code: "srp", Part2: "srp", Part1: "sr", Scope: 'I', Macro: "sh", Name: "Serbian"},
+	{Code: "srq", Scope: 'I', Name: "Sirionó"},
+	{Code: "srr", Part2: "srr", Scope: 'I', Name: "Serer"},
+	{Code: "srs", Scope: 'I', Name: "Sarsi"},
+	{Code: "srt", Scope: 'I', Name: "Sauri"},
+	{Code: "sru", Scope: 'I', Name: "Suruí"},
+	{Code: "srv", Scope: 'I', Name: "Southern Sorsoganon"},
+	{Code: "srw", Scope: 'I', Name: "Serua"},
+	{Code: "srx", Scope: 'I', Name: "Sirmauri"},
+	{Code: "sry", Scope: 'I', Name: "Sera"},
+	{Code: "srz", Scope: 'I', Name: "Shahmirzadi"},
+	{Code: "ssb", Scope: 'I', Name: "Southern Sama"},
+	{Code: "ssc", Scope: 'I', Name: "Suba-Simbiti"},
+	{Code: "ssd", Scope: 'I', Name: "Siroi"},
+	{Code: "sse", Scope: 'I', Name: "Balangingi"},
+	{Code: "ssf", Scope: 'I', Name: "Thao"},
+	{Code: "ssg", Scope: 'I', Name: "Seimat"},
+	{Code: "ssh", Scope: 'I', Macro: "ar", Name: "Shihhi Arabic"},
+	{Code: "ssi", Scope: 'I', Name: "Sansi"},
+	{Code: "ssj", Scope: 'I', Name: "Sausi"},
+	{Code: "ssk", Scope: 'I', Name: "Sunam"},
+	{Code: "ssl", Scope: 'I', Name: "Western Sisaala"},
+	{Code: "ssm", Scope: 'I', Name: "Semnam"},
+	{Code: "ssn", Scope: 'I', Name: "Waata"},
+	{Code: "sso", Scope: 'I', Name: "Sissano"},
+	{Code: "ssp", Scope: 'I', Name: "Spanish Sign Language"},
+	{Code: "ssq", Scope: 'I', Name: "So'a"},
+	{Code: "ssr", Scope: 'I', Name: "Swiss-French Sign Language"},
+	{Code: "sss", Scope: 'I', Name: "Sô"},
+	{Code: "sst", Scope: 'I', Name: "Sinasina"},
+	{Code: "ssu", Scope: 'I', Name: "Susuami"},
+	{Code: "ssv", Scope: 'I', Name: "Shark Bay"},
+	{Code: "ssw", Part2: "ssw", Part1: "ss", Scope: 'I', Name: "Swati"},
+	{Code: "ssx", Scope: 'I', Name: "Samberigi"},
+	{Code: "ssy", Scope: 'I', Name: "Saho"},
+	{Code: "ssz", Scope: 'I', Name: "Sengseng"},
+	{Code: "sta", Scope: 'I', Name: "Settla"},
+	{Code: "stb", Scope: 'I', Name: "Northern Subanen"},
+	{Code: "std", Scope: 'I', Name: "Sentinel"},
+	{Code: "ste", Scope: 'I', Name: "Liana-Seti"},
+	{Code: "stf", Scope: 'I', Name: "Seta"},
+	{Code: "stg", Scope: 'I', Name: "Trieng"},
+	{Code: "sth", Scope: 'I', Name: "Shelta"},
+	{Code: "sti", Scope: 'I', Name: "Bulo Stieng"},
+	{Code: "stj", Scope: 'I', Name: "Matya Samo"},
+	{Code: "stk", Scope: 'I', Name: "Arammba"},
+	{Code: "stl", Scope: 'I', Name: "Stellingwerfs"},
+	{Code: "stm", Scope: 'I', Name: "Setaman"},
+	{Code: "stn", Scope: 'I', Name: "Owa"},
+	{Code: "sto", Scope: 'I', Name: "Stoney"},
+	{Code: "stp", Scope: 'I', Name: "Southeastern Tepehuan"},
+	{Code: "stq", Scope: 'I', Name: "Saterfriesisch"},
+	{Code: "str", Scope: 'I', Name: "Straits Salish"},
+	{Code: "sts", Scope: 'I', Name: "Shumashti"},
+	{Code: "stt", Scope: 'I', Name: "Budeh Stieng"},
+	{Code: "stu", Scope: 'I', Name: "Samtao"},
+	{Code: "stv", Scope: 'I', Name: "Silt'e"},
+	{Code: "stw", Scope: 'I', Name: "Satawalese"},
+	{Code: "sty", Scope: 'I', Name: "Siberian Tatar"},
+	{Code: "sua", Scope: 'I', Name: "Sulka"},
+	{Code: "sub", Scope: 'I', Name: "Suku"},
+	{Code: "suc", Scope: 'I', Name: "Western Subanon"},
+	{Code: "sue", Scope: 'I', Name: "Suena"},
+	{Code: "sug", Scope: 'I', Name: "Suganga"},
+	{Code: "sui", Scope: 'I', Name: "Suki"},
+	{Code: "suj", Scope: 'I', Name: "Shubi"},
+	{Code: "suk", Part2: "suk", Scope: 'I', Name: "Sukuma"},
+	{Code: "sun", Part2: "sun", Part1: "su", Scope: 'I', Name: "Sundanese"},
+	{Code: "suo", Scope: 'I', Name: "Bouni"},
+	{Code: "suq", Scope: 'I', Name: "Tirmaga-Chai Suri"},
+	{Code: "sur", Scope: 'I', Name: "Mwaghavul"},
+	{Code: "sus", Part2: "sus", Scope: 'I', Name: "Susu"},
+	{Code: "sut", Scope: 'I', Name: "Subtiaba"},
+	{Code: "suv", Scope: 'I', Name: "Puroik"},
+	{Code: "suw", Scope: 'I', Name: "Sumbwa"},
+	{Code: "sux", Part2: "sux", Scope: 'I', Name: "Sumerian"},
+	{Code: "suy", Scope: 'I', Name: "Suyá"},
+	{Code: "suz", Scope: 'I', Name: "Sunwar"},
+	{Code: "sva", Scope: 'I', Name: "Svan"},
+	{Code: "svb", Scope: 'I', Name: "Ulau-Suain"},
+	{Code: "svc", Scope: 'I', Name: "Vincentian Creole English"},
+	{Code: "sve", Scope: 'I', Name: "Serili"},
+	{Code: "svk", Scope: 'I', Name: "Slovakian Sign Language"},
+	{Code: "svm", Scope: 'I', Name: "Slavomolisano"},
+	{Code: "svs", Scope: 'I', Name: "Savosavo"},
+	{Code: "svx", Scope: 'I', Name: "Skalvian"},
+	{Code: "swa", Part2: "swa", Part1: "sw", Scope: 'M', Name: "Swahili (macrolanguage)"},
+	{Code: "swb", Scope: 'I', Name: "Maore Comorian"},
+	{Code: "swc", Scope: 'I', Macro: "sw", Name: "Congo Swahili"},
+	{Code: "swe", Part2: "swe", Part1: "sv", Scope: 'I', Name: "Swedish"},
+	{Code: "swf", Scope: 'I', Name: "Sere"},
+	{Code: "swg", Scope: 'I', Name: "Swabian"},
+	{Code: "swh", Scope: 'I', Macro: "sw", Name: "Swahili (individual language)"},
+	{Code: "swi", Scope: 'I', Name: "Sui"},
+	{Code: "swj", Scope: 'I', Name: "Sira"},
+	{Code: "swk", Scope: 'I', Name: "Malawi Sena"},
+	{Code: "swl", Scope: 'I', Name: "Swedish Sign Language"},
+	{Code: "swm", Scope: 'I', Name: "Samosa"},
+	{Code: "swn", Scope: 'I', Name: "Sawknah"},
+	{Code: "swo", Scope: 'I', Name: "Shanenawa"},
+	{Code: "swp", Scope: 'I', Name: "Suau"},
+	{Code: "swq", Scope: 'I', Name: "Sharwa"},
+	{Code: "swr", Scope: 'I', Name: "Saweru"},
+	{Code: "sws", Scope: 'I', Name: "Seluwasan"},
+	{Code: "swt", Scope: 'I', Name: "Sawila"},
+	{Code: "swu", Scope: 'I', Name: "Suwawa"},
+	{Code: "swv", Scope: 'I', Name: "Shekhawati"},
+	{Code: "sww", Scope: 'I', Name: "Sowa"},
+	{Code: "swx", Scope: 'I', Name: "Suruahá"},
+	{Code: "swy", Scope: 'I', Name: "Sarua"},
+	{Code: "sxb", Scope: 'I', Name: "Suba"},
+	{Code: "sxc", Scope: 'I', Name: "Sicanian"},
+	{Code: "sxe", Scope: 'I', Name: "Sighu"},
+	{Code: "sxg", Scope: 'I', Name: "Shuhi"},
+	{Code: "sxk", Scope: 'I', Name: "Southern Kalapuya"},
+	{Code: "sxl", Scope: 'I', Name: "Selian"},
+	{Code: "sxm", Scope: 'I', Name: "Samre"},
+	{Code: "sxn", Scope: 'I', Name: "Sangir"},
+	{Code: "sxo", Scope: 'I', Name: "Sorothaptic"},
+	{Code: "sxr", Scope: 'I', Name: "Saaroa"},
+	{Code: "sxs", Scope: 'I', Name: "Sasaru"},
+	{Code: "sxu", Scope: 'I', Name: "Upper Saxon"},
+	{Code: "sxw", Scope: 'I', Name: "Saxwe Gbe"},
+	{Code: "sya", Scope: 'I', Name: "Siang"},
+	{Code: "syb", Scope: 'I', Name: "Central Subanen"},
+	{Code: "syc", Part2: "syc", Scope: 'I', Name: "Classical Syriac"},
+	{Code: "syi", Scope: 'I', Name: "Seki"},
+	{Code: "syk", Scope: 'I', Name: "Sukur"},
+	{Code: "syl", Scope: 'I', Name: "Sylheti"},
+	{Code: "sym", Scope: 'I', Name: "Maya Samo"},
+	{Code: "syn", Scope: 'I', Name: "Senaya"},
+	{Code: "syo", Scope: 'I', Name: "Suoy"},
+	{Code: "syr", Part2: "syr", Scope: 'M', Name: "Syriac"},
+	{Code: "sys", Scope: 'I', Name: "Sinyar"},
+	{Code: "syw", Scope: 'I', Name: "Kagate"},
+	{Code: "syx", Scope: 'I', Name: "Samay"},
+	{Code: "syy", Scope: 'I', Name: "Al-Sayyid Bedouin Sign Language"},
+	{Code: "sza", Scope: 'I', Name: "Semelai"},
+	{Code: "szb", Scope: 'I', Name: "Ngalum"},
+	{Code: "szc", Scope: 'I', Name: "Semaq Beri"},
+	{Code: "szd", Scope: 'I', Name: "Seru"},
+	{Code: "sze", Scope: 'I', Name: "Seze"},
+	{Code: "szg", Scope: 'I', Name: "Sengele"},
+	{Code: "szl", Scope: 'I', Name: "Silesian"},
+	{Code: "szn", Scope: 'I', Name: "Sula"},
+	{Code: "szp", Scope: 'I', Name: "Suabo"},
+	{Code: "szs", Scope: 'I', Name: "Solomon Islands Sign Language"},
+	{Code: "szv", Scope: 'I', Name: "Isu (Fako Division)"},
+	{Code: "szw", Scope: 'I', Name: "Sawai"},
+	{Code: "szy", Scope: 'I', Name: "Sakizaya"},
+	{Code: "taa", Scope: 'I', Name: "Lower Tanana"},
+	{Code: "tab", Scope: 'I', Name: "Tabassaran"},
+	{Code: "tac", Scope: 'I', Name: "Lowland Tarahumara"},
+	{Code: "tad", Scope: 'I', Name: "Tause"},
+	{Code: "tae", Scope: 'I', Name: "Tariana"},
+	{Code: "taf", Scope: 'I', Name: "Tapirapé"},
+	{Code: "tag", Scope: 'I', Name: "Tagoi"},
+	{Code: "tah", Part2: "tah", Part1: "ty", Scope: 'I', Name: "Tahitian"},
+	{Code: "taj", Scope: 'I', Name: "Eastern Tamang"},
+	{Code: "tak", Scope: 'I', Name: "Tala"},
+	{Code: "tal", Scope: 'I', Name: "Tal"},
+	{Code: "tam", Part2: "tam", Part1: "ta", Scope: 'I', Name: "Tamil"},
+	{Code: "tan", Scope: 'I', Name: "Tangale"},
+	{Code: "tao", Scope: 'I', Name: "Yami"},
+	{Code: "tap", Scope: 'I', Name: "Taabwa"},
+	{Code: "taq", Scope: 'I', Name: "Tamasheq"},
+	{Code: "tar", Scope: 'I', Name: "Central Tarahumara"},
+	{Code: "tas", Scope: 'I', Name: "Tay Boi"},
+	{Code: "tat", Part2: "tat", Part1: "tt", Scope: 'I', Name: "Tatar"},
+	{Code: "tau", Scope: 'I', Name: "Upper Tanana"},
+	{Code: "tav", Scope: 'I', Name: "Tatuyo"},
+	{Code: "taw", Scope: 'I', Name: "Tai"},
+	{Code: "tax", Scope: 'I', Name: "Tamki"},
+	{Code: "tay", Scope: 'I', Name: "Atayal"},
+	{Code: "taz", Scope: 'I', Name: "Tocho"},
+	{Code: "tba", Scope: 'I', Name: "Aikanã"},
+	{Code: "tbc", Scope: 'I', Name: "Takia"},
+	{Code: "tbd", Scope: 'I', Name: "Kaki Ae"},
+	{Code: "tbe", Scope: 'I', Name: "Tanimbili"},
+	{Code: "tbf", Scope: 'I', Name: "Mandara"},
+	{Code: "tbg", Scope: 'I', Name: "North Tairora"},
+	{Code: "tbh", Scope: 'I', Name: "Dharawal"},
+	{Code: "tbi", Scope: 'I', Name: "Gaam"},
+	{Code: "tbj", Scope: 'I', Name: "Tiang"},
+	{Code: "tbk", Scope: 'I', Name: "Calamian Tagbanwa"},
+	{Code: "tbl", Scope: 'I', Name: "Tboli"},
+	{Code: "tbm", Scope: 'I', Name: "Tagbu"},
+	{Code: "tbn", Scope: 'I', Name: "Barro Negro Tunebo"},
+	{Code: "tbo", Scope: 'I', Name: "Tawala"},
+	{Code: "tbp", Scope: 'I', Name: "Taworta"},
+	{Code: "tbr", Scope: 'I', Name: "Tumtum"},
+	{Code: "tbs", Scope: 'I', Name: "Tanguat"},
+	{Code: "tbt", Scope: 'I', Name: "Tembo (Kitembo)"},
+	{Code: "tbu", Scope: 'I', Name: "Tubar"},
+	{Code: "tbv", Scope: 'I', Name: "Tobo"},
+	{Code: "tbw", Scope: 'I', Name: "Tagbanwa"},
+	{Code: "tbx", Scope: 'I', Name: "Kapin"},
+	{Code: "tby", Scope: 'I', Name: "Tabaru"},
+	{Code: "tbz", Scope: 'I', Name: "Ditammari"},
+	{Code: "tca", Scope: 'I', Name: "Ticuna"},
+	{Code: "tcb", Scope: 'I', Name: "Tanacross"},
+	{Code: "tcc", Scope: 'I', Name: "Datooga"},
+	{Code: "tcd", Scope: 'I', Name: "Tafi"},
+	{Code: "tce", Scope: 'I', Name: "Southern Tutchone"},
+	{Code: "tcf", Scope: 'I', Name: "Malinaltepec Me'phaa"},
+	{Code: "tcg", Scope: 'I', Name: "Tamagario"},
+	{Code: "tch", Scope: 'I', Name: "Turks And Caicos Creole English"},
+	{Code: "tci", Scope: 'I', Name: "Wára"},
+	{Code: "tck", Scope: 'I', Name: "Tchitchege"},
+	{Code: "tcl", Scope: 'I', Name: "Taman (Myanmar)"},
+	{Code: "tcm", Scope: 'I', Name: "Tanahmerah"},
+	{Code: "tcn", Scope: 'I', Name: "Tichurong"},
+	{Code: "tco", Scope: 'I', Name: "Taungyo"},
+	{Code: "tcp", Scope: 'I', Name: "Tawr Chin"},
+	{Code: "tcq", Scope: 'I', Name: "Kaiy"},
+	{Code: "tcs", Scope: 'I', Name: "Torres Strait Creole"},
+	{Code: "tct", Scope: 'I', Name: "T'en"},
+	{Code: "tcu", Scope: 'I', Name: "Southeastern Tarahumara"},
+	{Code: "tcw", Scope: 'I', Name: "Tecpatlán Totonac"},
+	{Code: "tcx", Scope: 'I', Name: "Toda"},
+	{Code: "tcy", Scope: 'I', Name: "Tulu"},
+	{Code: "tcz", Scope: 'I', Name: "Thado Chin"},
+	{Code: "tda", Scope: 'I', Name: "Tagdal"},
+	{Code: "tdb", Scope: 'I', Name: "Panchpargania"},
+	{Code: "tdc", Scope: 'I', Name: "Emberá-Tadó"},
+	{Code: "tdd", Scope: 'I', Name: "Tai Nüa"},
+	{Code: "tde", Scope: 'I', Name: "Tiranige Diga Dogon"},
+	{Code: "tdf", Scope: 'I', Name: "Talieng"},
+	{Code: "tdg", Scope: 'I', Name: "Western Tamang"},
+	{Code: "tdh", Scope: 'I', Name: "Thulung"},
+	{Code: "tdi", Scope: 'I', Name: "Tomadino"},
+	{Code: "tdj", Scope: 'I', Name: "Tajio"},
+	{Code: "tdk", Scope: 'I', Name: "Tambas"},
+	{Code: "tdl", Scope: 'I', Name: "Sur"},
+	{Code: "tdm", Scope: 'I', Name: "Taruma"},
+	{Code: "tdn", Scope: 'I', Name: "Tondano"},
+	{Code: "tdo", Scope: 'I', Name: "Teme"},
+	{Code: "tdq", Scope: 'I', Name: "Tita"},
+	{Code: "tdr", Scope: 'I', Name: "Todrah"},
+	{Code: "tds", Scope: 'I', Name: "Doutai"},
+	{Code: "tdt", Scope: 'I', Name: "Tetun Dili"},
+	{Code: "tdv", Scope: 'I', Name: "Toro"},
+	{Code: "tdx", Scope: 'I', Name: "Tandroy-Mahafaly Malagasy"},
+	{Code: "tdy", Scope: 'I', Name: "Tadyawan"},
+	{Code: "tea", Scope: 'I', Name: "Temiar"},
+	{Code: "teb", Scope: 'I', Name: "Tetete"},
+	{Code: "tec", Scope: 'I', Name: "Terik"},
+	{Code: "ted", Scope: 'I', Name: "Tepo Krumen"},
+	{Code: "tee", Scope: 'I', Name: "Huehuetla Tepehua"},
+	{Code: "tef", Scope: 'I', Name: "Teressa"},
+	{Code: "teg", Scope: 'I', Name: "Teke-Tege"},
+	{Code: "teh", Scope: 'I', Name: "Tehuelche"},
+	{Code: "tei", Scope: 'I', Name: "Torricelli"},
+	{Code: "tek", Scope: 'I', Name: "Ibali Teke"},
+	{Code: "tel", Part2: "tel", Part1: "te", Scope: 'I', Name: "Telugu"},
+	{Code: "tem", Part2: "tem", Scope: 'I', Name: "Timne"},
+	{Code: "ten", Scope: 'I', Name: "Tama (Colombia)"},
+	{Code: "teo", Scope: 'I', Name: "Teso"},
+	{Code: "tep", Scope: 'I', Name: "Tepecano"},
+	{Code: "teq", Scope: 'I', Name: "Temein"},
+	{Code: "ter", Part2: "ter", Scope: 'I', Name: "Tereno"},
+	{Code: "tes", Scope: 'I', Name: "Tengger"},
+	{Code: "tet", Part2: "tet", Scope: 'I', Name: "Tetum"},
+	{Code: "teu", Scope: 'I', Name: "Soo"},
+	{Code: "tev", Scope: 'I', Name: "Teor"},
+	{Code: "tew", Scope: 'I', Name: "Tewa (USA)"},
+	{Code: "tex", Scope: 'I', Name: "Tennet"},
+	{Code: "tey", Scope: 'I', Name: "Tulishi"},
+	{Code: "tez", Scope: 'I', Name: "Tetserret"},
+	{Code: "tfi", Scope: 'I', Name: "Tofin Gbe"},
+	{Code: "tfn", Scope: 'I', Name: "Tanaina"},
+	{Code: "tfo", Scope: 'I', Name: "Tefaro"},
+	{Code: "tfr", Scope: 'I', Name: "Teribe"},
+	{Code: "tft", Scope: 'I', Name: "Ternate"},
+	{Code: "tga", Scope: 'I', Name: "Sagalla"},
+	{Code: "tgb", Scope: 'I', Name: "Tobilung"},
+	{Code: "tgc", Scope: 'I', Name: "Tigak"},
+	{Code: "tgd", Scope: 'I', Name: "Ciwogai"},
+	{Code: "tge", Scope: 'I', Name: "Eastern Gorkha Tamang"},
+	{Code: "tgf", Scope: 'I', Name: "Chalikha"},
+	{Code: "tgh", Scope: 'I', Name: "Tobagonian Creole English"},
+	{Code: "tgi", Scope: 'I', Name: "Lawunuia"},
+	{Code: "tgj", Scope: 'I', Name: "Tagin"},
+	{Code: "tgk", Part2: "tgk", Part1: "tg", Scope: 'I', Name: "Tajik"},
+	{Code: "tgl", Part2: "tgl", Part1: "tl", Scope: 'I', Name: "Tagalog"},
+	{Code: "tgn", Scope: 'I', Name: "Tandaganon"},
+	{Code: "tgo", Scope: 'I', Name: "Sudest"},
+	{Code: "tgp", Scope: 'I', Name: "Tangoa"},
+	{Code: "tgq", Scope: 'I', Name: "Tring"},
+	{Code: "tgr", Scope: 'I', Name: "Tareng"},
+	{Code: "tgs", Scope: 'I', Name: "Nume"},
+	{Code: "tgt", Scope: 'I', Name: "Central Tagbanwa"},
+	{Code: "tgu", Scope: 'I', Name: "Tanggu"},
+	{Code: "tgv", Scope: 'I', Name: "Tingui-Boto"},
+	{Code: "tgw", Scope: 'I', Name: "Tagwana Senoufo"},
+	{Code: "tgx", Scope: 'I', Name: "Tagish"},
+	{Code: "tgy", Scope: 'I', Name: "Togoyo"},
+	{Code: "tgz", Scope: 'I', Name: "Tagalaka"},
+	{Code: "tha", Part2: "tha", Part1: "th", Scope: 'I', Name: "Thai"},
+	{Code: "thd", Scope: 'I', Name: "Kuuk Thaayorre"},
+	{Code: "the", Scope: 'I', Name: "Chitwania Tharu"},
+	{Code: "thf", Scope: 'I', Name: "Thangmi"},
+	{Code: "thh", Scope: 'I', Name: "Northern Tarahumara"},
+	{Code: "thi", Scope: 'I', Name: "Tai Long"},
+	{Code: "thk", Scope: 'I', Name: "Tharaka"},
+	{Code: "thl", Scope: 'I', Name: "Dangaura Tharu"},
+	{Code: "thm", Scope: 'I', Name: "Aheu"},
+	{Code: "thn", Scope: 'I', Name: "Thachanadan"},
+	{Code: "thp", Scope: 'I', Name: "Thompson"},
+	{Code: "thq", Scope: 'I', Name: "Kochila Tharu"},
+	{Code: "thr", Scope: 'I', Name: "Rana Tharu"},
+	{Code: "ths", Scope: 'I', Name: "Thakali"},
+	{Code: "tht", Scope: 'I', Name: "Tahltan"},
+	{Code: "thu", Scope: 'I', Name: "Thuri"},
+	{Code: "thv", Scope: 'I', Name: "Tahaggart Tamahaq"},
+	{Code: "thy", Scope: 'I', Name: "Tha"},
+	{Code: "thz", Scope: 'I', Name: "Tayart Tamajeq"},
+	{Code: "tia", Scope: 'I', Name: "Tidikelt Tamazight"},
+	{Code: "tic", Scope: 'I', Name: "Tira"},
+	{Code: "tif", Scope: 'I', Name: "Tifal"},
+	{Code: "tig", Part2: "tig", Scope: 'I', Name: "Tigre"},
+	{Code: "tih", Scope: 'I', Name: "Timugon Murut"},
+	{Code: "tii", Scope: 'I', Name: "Tiene"},
+	{Code: "tij", Scope: 'I', Name: "Tilung"},
+	{Code: "tik", Scope: 'I', Name: "Tikar"},
+	{Code: "til", Scope: 'I', Name: "Tillamook"},
+	{Code: "tim", Scope: 'I', Name: "Timbe"},
+	{Code: "tin", Scope: 'I', Name: "Tindi"},
+	{Code: "tio", Scope: 'I', Name: "Teop"},
+	{Code: "tip", Scope: 'I', Name: "Trimuris"},
+	{Code: "tiq", Scope: 'I', Name: "Tiéfo"},
+	{Code: "tir", Part2: "tir", Part1: "ti", Scope: 'I', Name: "Tigrinya"},
+	{Code: "tis", Scope: 'I', Name: "Masadiit Itneg"},
+	{Code: "tit", Scope: 'I', Name: "Tinigua"},
+	{Code: "tiu", Scope: 'I', Name: "Adasen"},
+	{Code: "tiv", Part2: "tiv", Scope: 'I', Name: "Tiv"},
+	{Code: "tiw", Scope: 'I', Name: "Tiwi"},
+	{Code: "tix", Scope: 'I', Name: "Southern Tiwa"},
+	{Code: "tiy", Scope: 'I', Name: "Tiruray"},
+	{Code: "tiz", Scope: 'I', Name: "Tai Hongjin"},
+	{Code: "tja", Scope: 'I', Name: "Tajuasohn"},
+	{Code: "tjg", Scope: 'I', Name: "Tunjung"},
+	{Code: "tji", Scope: 'I', Name: "Northern Tujia"},
+	{Code: "tjj", Scope: 'I', Name: "Tjungundji"},
+	{Code: "tjl", Scope: 'I', Name: "Tai Laing"},
+	{Code: "tjm", Scope: 'I', Name: "Timucua"},
+	{Code: "tjn", Scope: 'I', Name: "Tonjon"},
+	{Code: "tjo", Scope: 'I', Name: "Temacine Tamazight"},
+	{Code: "tjp", Scope: 'I', Name: "Tjupany"},
+	{Code: "tjs", Scope: 'I', Name: "Southern Tujia"},
+	{Code: "tju", Scope: 'I', Name: "Tjurruru"},
+	{Code: "tjw", Scope: 'I', Name: "Djabwurrung"},
+	{Code: "tka", Scope: 'I', Name: "Truká"},
+	{Code: "tkb", Scope: 'I', Name: "Buksa"},
+	{Code: "tkd", Scope: 'I', Name: "Tukudede"},
+	{Code: "tke", Scope: 'I', Name: "Takwane"},
+	{Code: "tkf", Scope: 'I', Name: "Tukumanféd"},
+	{Code: "tkg", Scope: 'I', Name: "Tesaka Malagasy"},
+	{Code: "tkl", Part2: "tkl", Scope: 'I', Name: "Tokelau"},
+	{Code: "tkm", Scope: 'I', Name: "Takelma"},
+	{Code: "tkn", Scope: 'I', Name: "Toku-No-Shima"},
+	{Code: "tkp", Scope: 'I', Name: "Tikopia"},
+	{Code: "tkq", Scope: 'I', Name: "Tee"},
+	{Code: "tkr", Scope: 'I', Name: "Tsakhur"},
+	{Code: "tks", Scope: 'I', Name: "Takestani"},
+	{Code: "tkt", Scope: 'I', Name: "Kathoriya Tharu"},
+	{Code: "tku", Scope: 'I', Name: "Upper Necaxa Totonac"},
+	{Code: "tkv", Scope: 'I', Name: "Mur Pano"},
+	{Code: "tkw", Scope: 'I', Name: "Teanu"},
+	{Code: "tkx", Scope: 'I', Name: "Tangko"},
+	{Code: "tkz", Scope: 'I', Name: "Takua"},
+	{Code: "tla", Scope: 'I', Name: "Southwestern Tepehuan"},
+	{Code: "tlb", Scope: 'I', Name: "Tobelo"},
+	{Code: "tlc", Scope: 'I', Name: "Yecuatla Totonac"},
+	{Code: "tld", Scope: 'I', Name: "Talaud"},
+	{Code: "tlf", Scope: 'I', Name: "Telefol"},
+	{Code: "tlg", Scope: 'I', Name: "Tofanma"},
+	{Code: "tlh", Part2: "tlh", Scope: 'I', Name: "Klingon"},
+	{Code: "tli", Part2: "tli", Scope: 'I', Name: "Tlingit"},
+	{Code: "tlj", Scope: 'I', Name: "Talinga-Bwisi"},
+	{Code: "tlk", Scope: 'I', Name: "Taloki"},
+	{Code: "tll", Scope: 'I', Name: "Tetela"},
+	{Code: "tlm", Scope: 'I', Name: "Tolomako"},
+	{Code: "tln", Scope: 'I', Name: "Talondo'"},
+	{Code: "tlo", Scope: 'I', Name: "Talodi"},
+	{Code: "tlp", Scope: 'I', Name: "Filomena Mata-Coahuitlán Totonac"},
+	{Code: "tlq", Scope: 'I', Name: "Tai Loi"},
+	{Code: "tlr", Scope: 'I', Name: "Talise"},
+	{Code: "tls", Scope: 'I', Name: "Tambotalo"},
+	{Code: "tlt", Scope: 'I', Name: "Sou Nama"},
+	{Code: "tlu", Scope: 'I', Name: "Tulehu"},
+	{Code: "tlv", Scope: 'I', Name: "Taliabu"},
+	{Code: "tlx", Scope: 'I', Name: "Khehek"},
+	{Code: "tly", Scope: 'I', Name: "Talysh"},
+	{Code: "tma", Scope: 'I', Name: "Tama (Chad)"},
+	{Code: "tmb", Scope: 'I', Name: "Katbol"},
+	{Code: "tmc", Scope: 'I', Name: "Tumak"},
+	{Code: "tmd", Scope: 'I', Name: "Haruai"},
+	{Code: "tme", Scope: 'I', Name: "Tremembé"},
+	{Code: "tmf", Scope: 'I', Name: "Toba-Maskoy"},
+	{Code: "tmg", Scope: 'I', Name: "Ternateño"},
+	{Code: "tmh", Part2: "tmh", Scope: 'M', Name: "Tamashek"},
+	{Code: "tmi", Scope: 'I', Name: "Tutuba"},
+	{Code: "tmj", Scope: 'I', Name: "Samarokena"},
+	{Code: "tmk", Scope: 'I', Name: "Northwestern Tamang"},
+	{Code: "tml", Scope: 'I', Name: "Tamnim Citak"},
+	{Code: "tmm", Scope: 'I', Name: "Tai Thanh"},
+	{Code: "tmn", Scope: 'I', Name: "Taman (Indonesia)"},
+	{Code: "tmo", Scope: 'I', Name: "Temoq"},
+	{Code: "tmq", Scope: 'I', Name: "Tumleo"},
+	{Code: "tmr", Scope: 'I', Name: "Jewish Babylonian Aramaic (ca. 200-1200 CE)"},
+	{Code: "tms", Scope: 'I', Name: "Tima"},
+	{Code: "tmt", Scope: 'I', Name: "Tasmate"},
+	{Code: "tmu", Scope: 'I', Name: "Iau"},
+	{Code: "tmv", Scope: 'I', Name: "Tembo (Motembo)"},
+	{Code: "tmw", Scope: 'I', Macro: "ms", Name: "Temuan"},
+	{Code: "tmy", Scope: 'I', Name: "Tami"},
+	{Code: "tmz", Scope: 'I', Name: "Tamanaku"},
+	{Code: "tna", Scope: 'I', Name: "Tacana"},
+	{Code: "tnb", Scope: 'I', Name: "Western Tunebo"},
+	{Code: "tnc", Scope: 'I', Name: "Tanimuca-Retuarã"},
+	{Code: "tnd", Scope: 'I', Name: "Angosturas Tunebo"},
+	{Code: "tng", Scope: 'I', Name: "Tobanga"},
+	{Code: "tnh", Scope: 'I', Name: "Maiani"},
+	{Code: "tni", Scope: 'I', Name: "Tandia"},
+	{Code: "tnk", Scope: 'I', Name: "Kwamera"},
+	{Code: "tnl", Scope: 'I', Name: "Lenakel"},
+	{Code: "tnm", Scope: 'I', Name: "Tabla"},
+	{Code: "tnn", Scope: 'I', Name: "North Tanna"},
+	{Code: "tno", Scope: 'I', Name: "Toromono"},
+	{Code: "tnp", Scope: 'I', Name: "Whitesands"},
+	{Code: "tnq", Scope: 'I', Name: "Taino"},
+	{Code: "tnr", Scope: 'I', Name: "Ménik"},
+	{Code: "tns", Scope: 'I', Name: "Tenis"},
+	{Code: "tnt", Scope: 'I', Name: "Tontemboan"},
+	{Code: "tnu", Scope: 'I', Name: "Tay Khang"},
+	{Code: "tnv", Scope: 'I', Name: "Tangchangya"},
+	{Code: "tnw", Scope: 'I', Name: "Tonsawang"},
+	{Code: "tnx", Scope: 'I', Name: "Tanema"},
+	{Code: "tny", Scope: 'I', Name: "Tongwe"},
+	{Code: "tnz", Scope: 'I', Name: "Ten'edn"},
+	{Code: "tob", Scope: 'I', Name: "Toba"},
+	{Code: "toc", Scope: 'I', Name: "Coyutla Totonac"},
+	{Code: "tod", Scope: 'I', Name: "Toma"},
+	{Code: "tof", Scope: 'I', Name: "Gizrra"},
+	{Code: "tog", Part2: "tog", Scope: 'I', Name: "Tonga (Nyasa)"},
+	{Code: "toh", Scope: 'I', Name: "Gitonga"},
+	{Code: "toi", Scope: 'I', Name: "Tonga (Zambia)"},
+	{Code: "toj", Scope: 'I', Name: "Tojolabal"},
+	{Code: "tok", Scope: 'I', Name: "Toki Pona"},
+	{Code: "tol", Scope: 'I', Name: "Tolowa"},
+	{Code: "tom", Scope: 'I', Name: "Tombulu"},
+	{Code: "ton", Part2: "ton", Part1: "to", Scope: 'I', Name: "Tonga (Tonga Islands)"},
+	{Code: "too", Scope: 'I', Name: "Xicotepec De Juárez Totonac"},
+	{Code: "top", Scope: 'I', Name: "Papantla Totonac"},
+	{Code: "toq", Scope: 'I', Name: "Toposa"},
+	{Code: "tor", Scope: 'I', Name: "Togbo-Vara Banda"},
+	{Code: "tos", Scope: 'I', Name: "Highland Totonac"},
+	{Code: "tou", Scope: 'I', Name: "Tho"},
+	{Code: "tov", Scope: 'I', Name: "Upper Taromi"},
+	{Code: "tow", Scope: 'I', Name: "Jemez"},
+	{Code: "tox", Scope: 'I', Name: "Tobian"},
+	{Code: "toy", Scope: 'I', Name: "Topoiyo"},
+	{Code: "toz", Scope: 'I', Name: "To"},
+	{Code: "tpa", Scope: 'I', Name: "Taupota"},
+	{Code: "tpc", Scope: 'I', Name: "Azoyú Me'phaa"},
+	{Code: "tpe", Scope: 'I', Name: "Tippera"},
+	{Code: "tpf", Scope: 'I', Name: "Tarpia"},
+	{Code: "tpg", Scope: 'I', Name: "Kula"},
+	{Code: "tpi", Part2: "tpi", Scope: 'I', Name: "Tok Pisin"},
+	{Code: "tpj", Scope: 'I', Name: "Tapieté"},
+	{Code: "tpk", Scope: 'I', Name: "Tupinikin"},
+	{Code: "tpl", Scope: 'I', Name: "Tlacoapa Me'phaa"},
+	{Code: "tpm", Scope: 'I', Name: "Tampulma"},
+	{Code: "tpn", Scope: 'I', Name: "Tupinambá"},
+	{Code: "tpo", Scope: 'I', Name: "Tai Pao"},
+	{Code: "tpp", Scope: 'I', Name: "Pisaflores Tepehua"},
+	{Code: "tpq", Scope: 'I', Name: "Tukpa"},
+	{Code: "tpr", Scope: 'I', Name: "Tuparí"},
+	{Code: "tpt", Scope: 'I', Name: "Tlachichilco Tepehua"},
+	{Code: "tpu", Scope: 'I', Name: "Tampuan"},
+	{Code: "tpv", Scope: 'I', Name: "Tanapag"},
+	{Code: "tpw", Scope: 'I', Name: "Tupí"},
+	{Code: "tpx", Scope: 'I', Name: "Acatepec Me'phaa"},
+	{Code: "tpy", Scope: 'I', Name: "Trumai"},
+	{Code: "tpz", Scope: 'I', Name: "Tinputz"},
+	{Code: "tqb", Scope: 'I', Name: "Tembé"},
+	{Code: "tql", Scope: 'I', Name: "Lehali"},
+	{Code: "tqm", Scope: 'I', Name: "Turumsa"},
+	{Code: "tqn", Scope: 'I', Name: "Tenino"},
+	{Code: "tqo", Scope: 'I', Name: "Toaripi"},
+	{Code: "tqp", Scope: 'I', Name: "Tomoip"},
+	{Code: "tqq", Scope: 'I', Name: "Tunni"},
+	{Code: "tqr", Scope: 'I', Name: "Torona"},
+	{Code: "tqt", Scope: 'I', Name: "Western Totonac"},
+	{Code: "tqu", Scope: 'I', Name: "Touo"},
+	{Code: "tqw", Scope: 'I', Name: "Tonkawa"},
+	{Code: "tra", Scope: 'I', Name: "Tirahi"},
+	{Code: "trb", Scope: 'I', Name: "Terebu"},
+	{Code: "trc", Scope: 'I', Name: "Copala Triqui"},
+	{Code: "trd", Scope: 'I', Name: "Turi"},
+	{Code: "tre", Scope: 'I', Name: "East Tarangan"},
+	{Code: "trf", Scope: 'I', Name: "Trinidadian Creole English"},
+	{Code: "trg", Scope: 'I', Name: "Lishán Didán"},
+	{Code: "trh", Scope: 'I', Name: "Turaka"},
+	{Code: "tri", Scope: 'I', Name: "Trió"},
+	{Code: "trj", Scope: 'I', Name: "Toram"},
+	{Code: "trl", Scope: 'I', Name: "Traveller Scottish"},
+	{Code: "trm", Scope: 'I', Name: "Tregami"},
+	{Code: "trn", Scope: 'I', Name: "Trinitario"},
+	{Code: "tro", Scope: 'I', Name: "Tarao Naga"},
+	{Code: "trp", Scope: 'I', Name: "Kok Borok"},
+	{Code: "trq", Scope: 'I', Name: "San Martín Itunyoso Triqui"},
+	{Code: "trr", Scope: 'I', Name: "Taushiro"},
+	{Code: "trs", Scope: 'I', Name: "Chicahuaxtla Triqui"},
+	{Code: "trt", Scope: 'I', Name: "Tunggare"},
+	{Code: "tru", Scope: 'I', Name: "Turoyo"},
+	{Code: "trv", Scope: 'I', Name: "Sediq"},
+	{Code: "trw", Scope: 'I', Name: "Torwali"},
+	{Code: "trx", Scope: 'I', Name: "Tringgus-Sembaan Bidayuh"},
+	{Code: "try", Scope: 'I', Name: "Turung"},
+	{Code: "trz", Scope: 'I', Name: "Torá"},
+	{Code: "tsa", Scope: 'I', Name: "Tsaangi"},
+	{Code: "tsb", Scope: 'I', Name: "Tsamai"},
+	{Code: "tsc", Scope: 'I', Name: "Tswa"},
+	{Code: "tsd", Scope: 'I', Name: "Tsakonian"},
+	{Code: "tse", Scope: 'I', Name: "Tunisian Sign Language"},
+	{Code: "tsg", Scope: 'I', Name: "Tausug"},
+	{Code: "tsh", Scope: 'I', Name: "Tsuvan"},
+	{Code: "tsi", Part2: "tsi", Scope: 'I', Name: "Tsimshian"},
+	{Code: "tsj", Scope: 'I', Name: "Tshangla"},
+	{Code: "tsk", Scope: 'I', Name: "Tseku"},
+	{Code: "tsl", Scope: 'I', Name: "Ts'ün-Lao"},
+	{Code: "tsm", Scope: 'I', Name: "Turkish Sign Language"},
+	{Code: "tsn", Part2: "tsn", Part1: "tn", Scope: 'I', Name: "Tswana"},
+	{Code: "tso", Part2: "tso", Part1: "ts", Scope: 'I', Name: "Tsonga"},
+	{Code: "tsp", Scope: 'I', Name: "Northern Toussian"},
+	{Code: "tsq", Scope: 'I', Name: "Thai Sign Language"},
+	{Code: "tsr", Scope: 'I', Name: "Akei"},
+	{Code: "tss", Scope: 'I', Name: "Taiwan Sign Language"},
+	{Code: "tst", Scope: 'I', Name: "Tondi Songway Kiini"},
+	{Code: "tsu", Scope: 'I', Name: "Tsou"},
+	{Code: "tsv", Scope: 'I', Name: "Tsogo"},
+	{Code: "tsw", Scope: 'I', Name: "Tsishingini"},
+	{Code: "tsx", Scope: 'I', Name: "Mubami"},
+	{Code: "tsy", Scope: 'I', Name: "Tebul Sign Language"},
+	{Code: "tsz", Scope: 'I', Name: "Purepecha"},
+	{Code: "tta", Scope: 'I', Name: "Tutelo"},
+	{Code: "ttb", Scope: 'I', Name: "Gaa"},
+	{Code: "ttc", Scope: 'I', Name: "Tektiteko"},
+	{Code: "ttd", Scope: 'I', Name: "Tauade"},
+	{Code: "tte", Scope: 'I', Name: "Bwanabwana"},
+	{Code: "ttf", Scope: 'I', Name: "Tuotomb"},
+	{Code: "ttg", Scope: 'I', Name: "Tutong"},
+	{Code: "tth", Scope: 'I', Name: "Upper Ta'oih"},
+	{Code: "tti", Scope: 'I', Name: "Tobati"},
+	{Code: "ttj", Scope: 'I', Name: "Tooro"},
+	{Code: "ttk", Scope: 'I', Name: "Totoro"},
+	{Code: "ttl", Scope: 'I', Name: "Totela"},
+	{Code: "ttm", Scope: 'I', Name: "Northern Tutchone"},
+	{Code: "ttn", Scope: 'I', Name: "Towei"},
+	{Code: "tto", Scope: 'I', Name: "Lower Ta'oih"},
+	{Code: "ttp", Scope: 'I', Name: "Tombelala"},
+	{Code: "ttq", Scope: 'I', Macro: "tmh", Name: "Tawallammat Tamajaq"},
+	{Code: "ttr", Scope: 'I', Name: "Tera"},
+	{Code: "tts", Scope: 'I', Name: "Northeastern Thai"},
+	{Code: "ttt", Scope: 'I', Name: "Muslim Tat"},
+	{Code: "ttu", Scope: 'I', Name: "Torau"},
+	{Code: "ttv", Scope: 'I', Name: "Titan"},
+	{Code: "ttw", Scope: 'I', Name: "Long Wat"},
+	{Code: "tty", Scope: 'I', Name: "Sikaritai"},
+	{Code: "ttz", Scope: 'I', Name: "Tsum"},
+	{Code: "tua", Scope: 'I', Name: "Wiarumus"},
+	{Code: "tub", Scope: 'I', Name: "Tübatulabal"},
+	{Code: "tuc", Scope: 'I', Name: "Mutu"},
+	{Code: "tud", Scope: 'I', Name: "Tuxá"},
+	{Code: "tue", Scope: 'I', Name: "Tuyuca"},
+	{Code: "tuf", Scope: 'I', Name: "Central Tunebo"},
+	{Code: "tug", Scope: 'I', Name: "Tunia"},
+	{Code: "tuh", Scope: 'I', Name: "Taulil"},
+	{Code: "tui", Scope: 'I', Name: "Tupuri"},
+	{Code: "tuj", Scope: 'I', Name: "Tugutil"},
+	{Code: "tuk", Part2: "tuk", Part1: "tk", Scope: 'I', Name: "Turkmen"},
+	{Code: "tul", Scope: 'I', Name: "Tula"},
+	{Code: "tum", Part2: "tum", Scope: 'I', Name: "Tumbuka"},
+	{Code: "tun", Scope: 'I', Name: "Tunica"},
+	{Code: "tuo", Scope: 'I', Name: "Tucano"},
+	{Code: "tuq", Scope: 'I', Name: "Tedaga"},
+	{Code: "tur", Part2: "tur", Part1: "tr", Scope: 'I', Name: "Turkish"},
+	{Code: "tus", Scope: 'I', Name: "Tuscarora"},
+	{Code: "tuu", Scope: 'I', Name: "Tututni"},
+	{Code: "tuv", Scope: 'I', Name: "Turkana"},
+	{Code: "tux", Scope: 'I', Name: "Tuxináwa"},
+	{Code: "tuy", Scope: 'I', Name: "Tugen"},
+	{Code: "tuz", Scope: 'I', Name: "Turka"},
+	{Code: "tva", Scope: 'I', Name: "Vaghua"},
+	{Code: "tvd", Scope: 'I', Name: "Tsuvadi"},
+	{Code: "tve", Scope: 'I', Name: "Te'un"},
+	{Code: "tvk", Scope: 'I', Name: "Southeast Ambrym"},
+	{Code: "tvl", Part2: "tvl", Scope: 'I', Name: "Tuvalu"},
+	{Code: "tvm", Scope: 'I', Name: "Tela-Masbuar"},
+	{Code: "tvn", Scope: 'I', Name: "Tavoyan"},
+	{Code: "tvo", Scope: 'I', Name: "Tidore"},
+	{Code: "tvs", Scope: 'I', Name: "Taveta"},
+	{Code: "tvt", Scope: 'I', Name: "Tutsa Naga"},
+	{Code: "tvu", Scope: 'I', Name: "Tunen"},
+	{Code: "tvw", Scope: 'I', Name: "Sedoa"},
+	{Code: "tvx", Scope: 'I', Name: "Taivoan"},
+	{Code: "tvy", Scope: 'I', Name: "Timor Pidgin"},
+	{Code: "twa", Scope: 'I', Name: "Twana"},
+	{Code: "twb", Scope: 'I', Name: "Western Tawbuid"},
+	{Code: "twc", Scope: 'I', Name: "Teshenawa"},
+	{Code: "twd", Scope: 'I', Name: "Twents"},
+	{Code: "twe", Scope: 'I', Name: "Tewa (Indonesia)"},
+	{Code: "twf", Scope: 'I', Name: "Northern Tiwa"},
+	{Code: "twg", Scope: 'I', Name: "Tereweng"},
+	{Code: "twh", Scope: 'I', Name: "Tai Dón"},
+	{Code: "twi", Part2: "twi", Part1: "tw", Scope: 'I', Name: "Twi"},
+	{Code: "twl", Scope: 'I', Name: "Tawara"},
+	{Code: "twm", Scope: 'I', Name: "Tawang Monpa"},
+	{Code: "twn", Scope: 'I', Name: "Twendi"},
+	{Code: "two", Scope: 'I', Name: "Tswapong"},
+	{Code: "twp", Scope: 'I', Name: "Ere"},
+	{Code: "twq", Scope: 'I', Name: "Tasawaq"},
+	{Code: "twr", Scope: 'I', Name: "Southwestern Tarahumara"},
+	{Code: "twt", Scope: 'I', Name: "Turiwára"},
+	{Code: "twu", Scope: 'I', Name: "Termanu"},
+	{Code: "tww", Scope: 'I', Name: "Tuwari"},
+	{Code: "twx", Scope: 'I', Name: "Tewe"},
+	{Code: "twy", Scope: 'I', Name: "Tawoyan"},
+	{Code: "txa", Scope: 'I', Name: "Tombonuo"},
+	{Code: "txb", Scope: 'I', Name: "Tokharian B"},
+	{Code: "txc", Scope: 'I', Name: "Tsetsaut"},
+	{Code: "txe", Scope: 'I', Name: "Totoli"},
+	{Code: "txg", Scope: 'I', Name: "Tangut"},
+	{Code: "txh", Scope: 'I', Name: "Thracian"},
+	{Code: "txi", Scope: 'I', Name: "Ikpeng"},
+	{Code: "txj", Scope: 'I', Name: "Tarjumo"},
+	{Code: "txm", Scope: 'I', Name: "Tomini"},
+	{Code: "txn", Scope: 'I', Name: "West Tarangan"},
+	{Code: "txo", Scope: 'I', Name: "Toto"},
+	{Code: "txq", Scope: 'I', Name: "Tii"},
+	{Code: "txr", Scope: 'I', Name: "Tartessian"},
+	{Code: "txs", Scope: 'I', Name: "Tonsea"},
+	{Code: "txt", Scope: 'I', Name: "Citak"},
+	{Code: "txu", Scope: 'I', Name: "Kayapó"},
+	{Code: "txx", Scope: 'I', Name: "Tatana"},
+	{Code: "txy", Scope: 'I', Name: "Tanosy Malagasy"},
+	{Code: "tya", Scope: 'I', Name: "Tauya"},
+	{Code: "tye", Scope: 'I', Name: "Kyanga"},
+	{Code: "tyh", Scope: 'I', Name: "O'du"},
+	{Code: "tyi", Scope: 'I', Name: "Teke-Tsaayi"},
+	{Code: "tyj", Scope: 'I', Name: "Tai Do"},
+	{Code: "tyl", Scope: 'I', Name: "Thu Lao"},
+	{Code: "tyn", Scope: 'I', Name: "Kombai"},
+	{Code: "typ", Scope: 'I', Name: "Thaypan"},
+	{Code: "tyr", Scope: 'I', Name: "Tai Daeng"},
+	{Code: "tys", Scope: 'I', Name: "Tày Sa Pa"},
+	{Code: "tyt", Scope: 'I', Name: "Tày Tac"},
+	{Code: "tyu", Scope: 'I', Name: "Kua"},
+	{Code: "tyv", Part2: "tyv", Scope: 'I', Name: "Tuvinian"},
+	{Code: "tyx", Scope: 'I', Name: "Teke-Tyee"},
+	{Code: "tyy", Scope: 'I', Name: "Tiyaa"},
+	{Code: "tyz", Scope: 'I', Name: "Tày"},
+	{Code: "tza", Scope: 'I', Name: "Tanzanian Sign Language"},
+	{Code: "tzh", Scope: 'I', Name: "Tzeltal"},
+	{Code: "tzj", Scope: 'I', Name: "Tz'utujil"},
+	{Code: "tzl", Scope: 'I', Name: "Talossan"},
+	{Code: "tzm", Scope: 'I', Name: "Central Atlas Tamazight"},
+	{Code: "tzn", Scope: 'I', Name: "Tugun"},
+	{Code: "tzo", Scope: 'I', Name: "Tzotzil"},
+	{Code: "tzx", Scope: 'I', Name: "Tabriak"},
+	{Code: "uam", Scope: 'I', Name: "Uamué"},
+	{Code: "uan", Scope: 'I', Name: "Kuan"},
+	{Code: "uar", Scope: 'I', Name: "Tairuma"},
+	{Code: "uba", Scope: 'I', Name: "Ubang"},
+	{Code: "ubi", Scope: 'I', Name: "Ubi"},
+	{Code: "ubl", Scope: 'I', Name: "Buhi'non Bikol"},
+	{Code: "ubr", Scope: 'I', Name: "Ubir"},
+	{Code: "ubu", Scope: 'I', Name: "Umbu-Ungu"},
+	{Code: "uby", Scope: 'I', Name: "Ubykh"},
+	{Code: "uda", Scope: 'I', Name: "Uda"},
+	{Code: "ude", Scope: 'I', Name: "Udihe"},
+	{Code: "udg", Scope: 'I', Name: "Muduga"},
+	{Code: "udi", Scope: 'I', Name: "Udi"},
+	{Code: "udj", Scope: 'I', Name: "Ujir"},
+	{Code: "udl", Scope: 'I', Name: "Wuzlam"},
+	{Code: "udm", Part2: "udm", Scope: 'I', Name: "Udmurt"},
+	{Code: "udu", Scope: 'I', Name: "Uduk"},
+	{Code: "ues", Scope: 'I', Name: "Kioko"},
+	{Code: "ufi", Scope: 'I', Name: "Ufim"},
+	{Code: "uga", Part2: "uga", Scope: 'I', Name: "Ugaritic"},
+	{Code: "ugb", Scope: 'I', Name: "Kuku-Ugbanh"},
+	{Code: "uge", Scope: 'I', Name: "Ughele"},
+	{Code: "ugh", Scope: 'I', Name: "Kubachi"},
+	{Code: "ugn", Scope: 'I', Name: "Ugandan Sign Language"},
+	{Code: "ugo", Scope: 'I', Name: "Ugong"},
+	{Code: "ugy", Scope: 'I', Name: "Uruguayan Sign Language"},
+	{Code: "uha", Scope: 'I', Name: "Uhami"},
+	{Code: "uhn", Scope: 'I', Name: "Damal"},
+	{Code: "uig", Part2: "uig", Part1: "ug", Scope: 'I', Name: "Uighur"},
+	{Code: "uis", Scope: 'I', Name: "Uisai"},
+	{Code: "uiv", Scope: 'I', Name: "Iyive"},
+	{Code: "uji", Scope: 'I', Name: "Tanjijili"},
+	{Code: "uka", Scope: 'I', Name: "Kaburi"},
+	{Code: "ukg", Scope: 'I', Name: "Ukuriguma"},
+	{Code: "ukh", Scope: 'I', Name: "Ukhwejo"},
+	{Code: "uki", Scope: 'I', Name: "Kui (India)"},
+	{Code: "ukk", Scope: 'I', Name: "Muak Sa-aak"},
+	{Code: "ukl", Scope: 'I', Name: "Ukrainian Sign Language"},
+	{Code: "ukp", Scope: 'I', Name: "Ukpe-Bayobiri"},
+	{Code: "ukq", Scope: 'I', Name: "Ukwa"},
+	{Code: "ukr", Part2: "ukr", Part1: "uk", Scope: 'I', Name: "Ukrainian"},
+	{Code: "uks", Scope: 'I', Name: "Urubú-Kaapor Sign Language"},
+	{Code: "uku", Scope: 'I', Name: "Ukue"},
+	{Code: "ukv", Scope: 'I', Name: "Kuku"},
+	{Code: "ukw", Scope: 'I', Name: "Ukwuani-Aboh-Ndoni"},
+	{Code: "uky", Scope: 'I', Name: "Kuuk-Yak"},
+	{Code: "ula", Scope: 'I', Name: "Fungwa"},
+	{Code: "ulb", Scope: 'I', Name: "Ulukwumi"},
+	{Code: "ulc", Scope: 'I', Name: "Ulch"},
+	{Code: "ule", Scope: 'I', Name: "Lule"},
+	{Code: "ulf", Scope: 'I', Name: "Usku"},
+	{Code: "uli", Scope: 'I', Name: "Ulithian"},
+	{Code: "ulk", Scope: 'I', Name: "Meriam Mir"},
+	{Code: "ull", Scope: 'I', Name: "Ullatan"},
+	{Code: "ulm", Scope: 'I', Name: "Ulumanda'"},
+	{Code: "uln", Scope: 'I', Name: "Unserdeutsch"},
+	{Code: "ulu", Scope: 'I', Name: "Uma' Lung"},
+	{Code: "ulw", Scope: 'I', Name: "Ulwa"},
+	{Code: "uma", Scope: 'I', Name: "Umatilla"},
+	{Code: "umb", Part2: "umb", Scope: 'I', Name: "Umbundu"},
+	{Code: "umc", Scope: 'I', Name: "Marrucinian"},
+	{Code: "umd", Scope: 'I', Name: "Umbindhamu"},
+	{Code: "umg", Scope: 'I', Name: "Morrobalama"},
+	{Code: "umi", Scope: 'I', Name: "Ukit"},
+	{Code: "umm", Scope: 'I', Name: "Umon"},
+	{Code: "umn", Scope: 'I', Name: "Makyan Naga"},
+	{Code: "umo", Scope: 'I', Name: "Umotína"},
+	{Code: "ump", Scope: 'I', Name: "Umpila"},
+	{Code: "umr", Scope: 'I', Name: "Umbugarla"},
+	{Code: "ums", Scope: 'I', Name: "Pendau"},
+	{Code: "umu", Scope: 'I', Macro: "del", Name: "Munsee"},
+	{Code: "una", Scope: 'I', Name: "North Watut"},
+	{Code: "und", Part2: "und", Scope: 'S', Name: "Undetermined"},
+	{Code: "une", Scope: 'I', Name: "Uneme"},
+	{Code: "ung", Scope: 'I', Name: "Ngarinyin"},
+	{Code: "uni", Scope: 'I', Name: "Uni"},
+	{Code: "unk", Scope: 'I', Name: "Enawené-Nawé"},
+	{Code: "unm", Scope: 'I', Name: "Unami"},
+	{Code: "unn", Scope: 'I', Name: "Kurnai"},
+	{Code: "unr", Scope: 'I', Name: "Mundari"},
+	{Code: "unu", Scope: 'I', Name: "Unubahe"},
+	{Code: "unx", Scope: 'I', Name: "Munda"},
+	{Code: "unz", Scope: 'I', Name: "Unde Kaili"},
+	{Code: "uon", Scope: 'I', Name: "Kulon"},
+	{Code: "upi", Scope: 'I', Name: "Umeda"},
+	{Code: "upv", Scope: 'I', Name: "Uripiv-Wala-Rano-Atchin"},
+	{Code: "ura", Scope: 'I', Name: "Urarina"},
+	{Code: "urb", Scope: 'I', Name: "Urubú-Kaapor"},
+	{Code: "urc", Scope: 'I', Name: "Urningangg"},
+	{Code: "urd", Part2: "urd", Part1: "ur", Scope: 'I', Name: "Urdu"},
+	{Code: "ure", Scope: 'I', Name: "Uru"},
+	{Code: "urf", Scope: 'I', Name: "Uradhi"},
+	{Code: "urg", Scope: 'I', Name: "Urigina"},
+	{Code: "urh", Scope: 'I', Name: "Urhobo"},
+	{Code: "uri", Scope: 'I', Name: "Urim"},
+	{Code: "urk", Scope: 'I', Macro: "ms", Name: "Urak Lawoi'"},
+	{Code: "url", Scope: 'I', Name: "Urali"},
+	{Code: "urm", Scope: 'I', Name: "Urapmin"},
+	{Code: "urn", Scope: 'I', Name: "Uruangnirin"},
+	{Code: "uro", Scope: 'I', Name: "Ura (Papua New Guinea)"},
+	{Code: "urp", Scope: 'I', Name: "Uru-Pa-In"},
+	{Code: "urr", Scope: 'I', Name: "Lehalurup"},
+	{Code: "urt", Scope: 'I', Name: "Urat"},
+	{Code: "uru", Scope: 'I', Name: "Urumi"},
+	{Code: "urv", Scope: 'I', Name: "Uruava"},
+	{Code: "urw", Scope: 'I', Name: "Sop"},
+	{Code: "urx", Scope: 'I', Name: "Urimo"},
+	{Code: "ury", Scope: 'I', Name: "Orya"},
+	{Code: "urz", Scope: 'I', Name: "Uru-Eu-Wau-Wau"},
+	{Code: "usa", Scope: 'I', Name: "Usarufa"},
+	{Code: "ush", Scope: 'I', Name: "Ushojo"},
+	{Code: "usi", Scope: 'I', Name: "Usui"},
+	{Code: "usk", Scope: 'I', Name: "Usaghade"},
+	{Code: "usp", Scope: 'I', Name: "Uspanteco"},
+	{Code: "uss", Scope: 'I', Name: "us-Saare"},
+	{Code: "usu", Scope: 'I', Name: "Uya"},
+	{Code: "uta", Scope: 'I', Name: "Otank"},
+	{Code: "ute", Scope: 'I', Name: "Ute-Southern Paiute"},
+	{Code: "uth", Scope: 'I', Name: "ut-Hun"},
+	{Code: "utp", Scope: 'I', Name: "Amba (Solomon Islands)"},
+	{Code: "utr", Scope: 'I', Name: "Etulo"},
+	{Code: "utu", Scope: 'I', Name: "Utu"},
+	{Code: "uum", Scope: 'I', Name: "Urum"},
+	{Code: "uur", Scope: 'I', Name: "Ura (Vanuatu)"},
+	{Code: "uuu", Scope: 'I', Name: "U"},
+	{Code: "uve", Scope: 'I', Name: "West Uvean"},
+	{Code: "uvh", Scope: 'I', Name: "Uri"},
+	{Code: "uvl", Scope: 'I', Name: "Lote"},
+	{Code: "uwa", Scope: 'I', Name: "Kuku-Uwanh"},
+	{Code: "uya", Scope: 'I', Name: "Doko-Uyanga"},
+	{Code: "uzb", Part2: "uzb", Part1: "uz", Scope: 'M', Name: "Uzbek"},
+	{Code: "uzn", Scope: 'I', Macro: "uz", Name: "Northern Uzbek"},
+	{Code: "uzs", Scope: 'I', Macro: "uz", Name: "Southern Uzbek"},
+	{Code: "vaa", Scope: 'I', Name: "Vaagri Booli"},
+	{Code: "vae", Scope: 'I', Name: "Vale"},
+	{Code: "vaf", Scope: 'I', Name: "Vafsi"},
+	{Code: "vag", Scope: 'I', Name: "Vagla"},
+	{Code: "vah", Scope: 'I', Name: "Varhadi-Nagpuri"},
+	{Code: "vai", Part2: "vai", Scope: 'I', Name: "Vai"},
+	{Code: "vaj", Scope: 'I', Name: "Sekele"},
+	{Code: "val", Scope: 'I', Name: "Vehes"},
+	{Code: "vam", Scope: 'I', Name: "Vanimo"},
+	{Code: "van", Scope: 'I', Name: "Valman"},
+	{Code: "vao", Scope: 'I', Name: "Vao"},
+	{Code: "vap", Scope: 'I', Name: "Vaiphei"},
+	{Code: "var", Scope: 'I', Name: "Huarijio"},
+	{Code: "vas", Scope: 'I', Name: "Vasavi"},
+	{Code: "vau", Scope: 'I', Name: "Vanuma"},
+	{Code: "vav", Scope: 'I', Name: "Varli"},
+	{Code: "vay", Scope: 'I', Name: "Wayu"},
+	{Code: "vbb", Scope: 'I', Name: "Southeast Babar"},
+	{Code: "vbk", Scope: 'I', Name: "Southwestern Bontok"},
+	{Code: "vec", Scope: 'I', Name: "Venetian"},
+	{Code: "ved", Scope: 'I', Name: "Veddah"},
+	{Code: "vel", Scope: 'I', Name: "Veluws"},
+	{Code: "vem", Scope: 'I', Name: "Vemgo-Mabas"},
+	{Code: "ven", Part2: "ven", Part1: "ve", Scope: 'I', Name: "Venda"},
+	{Code: "veo", Scope: 'I', Name: "Ventureño"},
+	{Code: "vep", Scope: 'I', Name: "Veps"},
+	{Code: "ver", Scope: 'I', Name: "Mom Jango"},
+	{Code: "vgr", Scope: 'I', Name: "Vaghri"},
+	{Code: "vgt", Scope: 'I', Name: "Vlaamse Gebarentaal"},
+	{Code: "vic", Scope: 'I', Name: "Virgin Islands Creole English"},
+	{Code: "vid", Scope: 'I', Name: "Vidunda"},
+	{Code: "vie", Part2: "vie", Part1: "vi", Scope: 'I', Name: "Vietnamese"},
+	{Code: "vif", Scope: 'I', Name: "Vili"},
+	{Code: "vig", Scope: 'I', Name: "Viemo"},
+	{Code: "vil", Scope: 'I', Name: "Vilela"},
+	{Code: "vin", Scope: 'I', Name: "Vinza"},
+	{Code: "vis", Scope: 'I', Name: "Vishavan"},
+	{Code: "vit", Scope: 'I', Name: "Viti"},
+	{Code: "viv", Scope: 'I', Name: "Iduna"},
+	{Code: "vka", Scope: 'I', Name: "Kariyarra"},
+	{Code: "vkj", Scope: 'I', Name: "Kujarge"},
+	{Code: "vkk", Scope: 'I', Macro: "ms", Name: "Kaur"},
+	{Code: "vkl", Scope: 'I', Name: "Kulisusu"},
+	{Code: "vkm", Scope: 'I', Name: "Kamakan"},
+	{Code: "vkn", Scope: 'I', Name: "Koro Nulu"},
+	{Code: "vko", Scope: 'I', Name: "Kodeoha"},
+	{Code: "vkp", Scope: 'I', Name: "Korlai Creole Portuguese"},
+	{Code: "vkt", Scope: 'I', Macro: "ms", Name: "Tenggarong Kutai Malay"},
+	{Code: "vku", Scope: 'I', Name: "Kurrama"},
+	{Code: "vkz", Scope: 'I', Name: "Koro Zuba"},
+	{Code: "vlp", Scope: 'I', Name: "Valpei"},
+	{Code: "vls", Scope: 'I', Name: "Vlaams"},
+	{Code: "vma", Scope: 'I', Name: "Martuyhunira"},
+	{Code: "vmb", Scope: 'I', Name: "Barbaram"},
+	{Code: "vmc", Scope: 'I', Name: "Juxtlahuaca Mixtec"},
+	{Code: "vmd", Scope: 'I', Name: "Mudu Koraga"},
+	{Code: "vme", Scope: 'I', Name: "East Masela"},
+	{Code: "vmf", Scope: 'I', Name: "Mainfränkisch"},
+	{Code: "vmg", Scope: 'I', Name: "Lungalunga"},
+	{Code: "vmh", Scope: 'I', Name: "Maraghei"},
+	{Code: "vmi", Scope: 'I', Name: "Miwa"},
+	{Code: "vmj", Scope: 'I', Name: "Ixtayutla Mixtec"},
+	{Code: "vmk", Scope: 'I', Name: "Makhuwa-Shirima"},
+	{Code: "vml", Scope: 'I', Name: "Malgana"},
+	{Code: "vmm", Scope: 'I', Name: "Mitlatongo Mixtec"},
+	{Code: "vmp", Scope: 'I', Name: "Soyaltepec Mazatec"},
+	{Code: "vmq", Scope: 'I', Name: "Soyaltepec Mixtec"},
+	{Code: "vmr", Scope: 'I', Name: "Marenje"},
+	{Code: "vms", Scope: 'I', Name: "Moksela"},
+	{Code: "vmu", Scope: 'I', Name: "Muluridyi"},
+	{Code: "vmv", Scope: 'I', Name: "Valley Maidu"},
+	{Code: "vmw", Scope: 'I', Name: "Makhuwa"},
+	{Code: "vmx", Scope: 'I', Name: "Tamazola Mixtec"},
+	{Code: "vmy", Scope: 'I', Name: "Ayautla Mazatec"},
+	{Code: "vmz", Scope: 'I', Name: "Mazatlán Mazatec"},
+	{Code: "vnk", Scope: 'I', Name: "Vano"},
+	{Code: "vnm", Scope: 'I', Name: "Vinmavis"},
+	{Code: "vnp", Scope: 'I', Name: "Vunapu"},
+	{Code: "vol", Part2: "vol", Part1: "vo", Scope: 'I', Name: "Volapük"},
+	{Code: "vor", Scope: 'I', Name: "Voro"},
+	{Code: "vot", Part2: "vot", Scope: 'I', Name: "Votic"},
+	{Code: "vra", Scope: 'I', Name: "Vera'a"},
+	{Code: "vro", Scope: 'I', Macro: "et", Name: "Võro"},
+	{Code: "vrs", Scope: 'I', Name: "Varisi"},
+	{Code: "vrt", Scope: 'I', Name: "Burmbar"},
+	{Code: "vsi", Scope: 'I', Name: "Moldova Sign Language"},
+	{Code: "vsl", Scope: 'I', Name: "Venezuelan Sign Language"},
+	{Code: "vsv", Scope: 'I', Name: "Valencian Sign Language"},
+	{Code: "vto", Scope: 'I', Name: "Vitou"},
+	{Code: "vum", Scope: 'I', Name: "Vumbu"},
+	{Code: "vun", Scope: 'I', Name: "Vunjo"},
+	{Code: "vut", Scope: 'I', Name: "Vute"},
+	{Code: "vwa", Scope: 'I', Name: "Awa (China)"},
+	{Code: "waa", Scope: 'I', Name: "Walla Walla"},
+	{Code: "wab", Scope: 'I', Name: "Wab"},
+	{Code: "wac", Scope: 'I', Name: "Wasco-Wishram"},
+	{Code: "wad", Scope: 'I', Name: "Wamesa"},
+	{Code: "wae", Scope: 'I', Name: "Walser"},
+	{Code: "waf", Scope: 'I', Name: "Wakoná"},
+	{Code: "wag", Scope: 'I', Name: "Wa'ema"},
+	{Code: "wah", Scope: 'I', Name: "Watubela"},
+	{Code: "wai", Scope: 'I', Name: "Wares"},
+	{Code: "waj", Scope: 'I', Name: "Waffa"},
+	{Code: "wal", Part2: "wal", Scope: 'I', Name: "Wolaytta"},
+	{Code: "wam", Scope: 'I', Name: "Wampanoag"},
+	{Code: "wan", Scope: 'I', Name: "Wan"},
+	{Code: "wao", Scope: 'I', Name: "Wappo"},
+	{Code: "wap", Scope: 'I', Name: "Wapishana"},
+	{Code: "waq", Scope: 'I', Name: "Wagiman"},
+	{Code: "war", Part2: "war", Scope: 'I', Name: "Waray (Philippines)"},
+	{Code: "was", Part2: "was", Scope: 'I', Name: "Washo"},
+	{Code: "wat", Scope: 'I', Name: "Kaninuwa"},
+	{Code: "wau", Scope: 'I', Name: "Waurá"},
+	{Code: "wav", Scope: 'I', Name: "Waka"},
+	{Code: "waw", Scope: 'I', Name: "Waiwai"},
+	{Code: "wax", Scope: 'I', Name: "Watam"},
+	{Code: "way", Scope: 'I', Name: "Wayana"},
+	{Code: "waz", Scope: 'I', Name: "Wampur"},
+	{Code: "wba", Scope: 'I', Name: "Warao"},
+	{Code: "wbb", Scope: 'I', Name: "Wabo"},
+	{Code: "wbe", Scope: 'I', Name: "Waritai"},
+	{Code: "wbf", Scope: 'I', Name: "Wara"},
+	{Code: "wbh", Scope: 'I', Name: "Wanda"},
+	{Code: "wbi", Scope: 'I', Name: "Vwanji"},
+	{Code: "wbj", Scope: 'I', Name: "Alagwa"},
+	{Code: "wbk", Scope: 'I', Name: "Waigali"},
+	{Code: "wbl", Scope: 'I', Name: "Wakhi"},
+	{Code: "wbm", Scope: 'I', Name: "Wa"},
+	{Code: "wbp", Scope: 'I', Name: "Warlpiri"},
+	{Code: "wbq", Scope: 'I', Name: "Waddar"},
+	{Code: "wbr", Scope: 'I', Name: "Wagdi"},
+	{Code: "wbs", Scope: 'I', Name: "West Bengal Sign Language"},
+	{Code: "wbt", Scope: 'I', Name: "Warnman"},
+	{Code: "wbv", Scope: 'I', Name: "Wajarri"},
+	{Code: "wbw", Scope: 'I', Name: "Woi"},
+	{Code: "wca", Scope: 'I', Name: "Yanomámi"},
+	{Code: "wci", Scope: 'I', Name: "Waci Gbe"},
+	{Code: "wdd", Scope: 'I', Name: "Wandji"},
+	{Code: "wdg", Scope: 'I', Name: "Wadaginam"},
+	{Code: "wdj", Scope: 'I', Name: "Wadjiginy"},
+	{Code: "wdk", Scope: 'I', Name: "Wadikali"},
+	{Code: "wdt", Scope: 'I', Name: "Wendat"},
+	{Code: "wdu", Scope: 'I', Name: "Wadjigu"},
+	{Code: "wdy", Scope: 'I', Name: "Wadjabangayi"},
+	{Code: "wea", Scope: 'I', Name: "Wewaw"},
+	{Code: "wec", Scope: 'I', Name: "Wè Western"},
+	{Code: "wed", Scope: 'I', Name: "Wedau"},
+	{Code: "weg", Scope: 'I', Name: "Wergaia"},
+	{Code: "weh", Scope: 'I', Name: "Weh"},
+	{Code: "wei", Scope: 'I', Name: "Kiunum"},
+	{Code: "wem", Scope: 'I', Name: "Weme Gbe"},
+	{Code: "weo", Scope: 'I', Name: "Wemale"},
+	{Code: "wep", Scope: 'I', Name: "Westphalien"},
+	{Code: "wer", Scope: 'I', Name: "Weri"},
+	{Code: "wes", Scope: 'I', Name: "Cameroon Pidgin"},
+	{Code: "wet", Scope: 'I', Name: "Perai"},
+	{Code: "weu", Scope: 'I', Name: "Rawngtu Chin"},
+	{Code: "wew", Scope: 'I', Name: "Wejewa"},
+	{Code: "wfg", Scope: 'I', Name: "Yafi"},
+	{Code: "wga", Scope: 'I', Name: "Wagaya"},
+	{Code: "wgb", Scope: 'I', Name: "Wagawaga"},
+	{Code: "wgg", Scope: 'I', Name: "Wangkangurru"},
+	{Code: "wgi", Scope: 'I', Name: "Wahgi"},
+	{Code: "wgo", Scope: 'I', Name: "Waigeo"},
+	{Code: "wgu", Scope: 'I', Name: "Wirangu"},
+	{Code: "wgy", Scope: 'I', Name: "Warrgamay"},
+	{Code: "wha", Scope: 'I', Name: "Sou Upaa"},
+	{Code: "whg", Scope: 'I', Name: "North Wahgi"},
+	{Code: "whk", Scope: 'I', Name: "Wahau Kenyah"},
+	{Code: "whu", Scope: 'I', Name: "Wahau Kayan"},
+	{Code: "wib", Scope: 'I', Name: "Southern Toussian"},
+	{Code: "wic", Scope: 'I', Name: "Wichita"},
+	{Code: "wie", Scope: 'I', Name: "Wik-Epa"},
+	{Code: "wif", Scope: 'I', Name: "Wik-Keyangan"},
+	{Code: "wig", Scope: 'I', Name: "Wik Ngathan"},
+	{Code: "wih", Scope: 'I', Name: "Wik-Me'anha"},
+	{Code: "wii", Scope: 'I', Name: "Minidien"},
+	{Code: "wij", Scope: 'I', Name: "Wik-Iiyanh"},
+	{Code: "wik", Scope: 'I', Name: "Wikalkan"},
+	{Code: "wil", Scope: 'I', Name: "Wilawila"},
+	{Code: "wim", Scope: 'I', Name: "Wik-Mungkan"},
+	{Code: "win", Scope: 'I', Name: "Ho-Chunk"},
+	{Code: "wir", Scope: 'I', Name: "Wiraféd"},
+	{Code: "wiu", Scope: 'I', Name: "Wiru"},
+	{Code: "wiv", Scope: 'I', Name: "Vitu"},
+	{Code: "wiy", Scope: 'I', Name: "Wiyot"},
+	{Code: "wja", Scope: 'I', Name: "Waja"},
+	{Code: "wji", Scope: 'I', Name: "Warji"},
+	{Code: "wka", Scope: 'I', Name: "Kw'adza"},
+	{Code: "wkb", Scope: 'I', Name: "Kumbaran"},
+	{Code: "wkd", Scope: 'I', Name: "Wakde"},
+	{Code: "wkl", Scope: 'I', Name: "Kalanadi"},
+	{Code: "wkr", Scope: 'I', Name: "Keerray-Woorroong"},
+	{Code: "wku", Scope: 'I', Name: "Kunduvadi"},
+	{Code: "wkw", Scope: 'I', Name: "Wakawaka"},
+	{Code: "wky", Scope: 'I', Name: "Wangkayutyuru"},
+	{Code: "wla", Scope: 'I', Name: "Walio"},
+	{Code: "wlc", Scope: 'I', Name: "Mwali Comorian"},
+	{Code: "wle", Scope: 'I', Name: "Wolane"},
+	{Code: "wlg", Scope: 'I', Name: "Kunbarlang"},
+	{Code: "wlh", Scope: 'I', Name: "Welaun"},
+	{Code: "wli", Scope: 'I', Name: "Waioli"},
+	{Code: "wlk", Scope: 'I', Name: "Wailaki"},
+	{Code: "wll", Scope: 'I', Name: "Wali (Sudan)"},
+	{Code: "wlm", Scope: 'I', Name: "Middle Welsh"},
+	{Code: "wln", Part2: "wln", Part1: "wa", Scope: 'I', Name: "Walloon"},
+	{Code: "wlo", Scope: 'I', Name: "Wolio"},
+	{Code: "wlr", Scope: 'I', Name: "Wailapa"},
+	{Code: "wls", Scope: 'I', Name: "Wallisian"},
+	{Code: "wlu", Scope: 'I', Name: "Wuliwuli"},
+	{Code: "wlv", Scope: 'I', Name: "Wichí Lhamtés Vejoz"},
+	{Code: "wlw", Scope: 'I', Name: "Walak"},
+	{Code: "wlx", Scope: 'I', Name: "Wali (Ghana)"},
+	{Code: "wly", Scope: 'I', Name: "Waling"},
+	{Code: "wma", Scope: 'I', Name: "Mawa (Nigeria)"},
+	{Code: "wmb", Scope: 'I', Name: "Wambaya"},
+	{Code: "wmc", Scope: 'I', Name: "Wamas"},
+	{Code: "wmd", Scope: 'I', Name: "Mamaindé"},
+	{Code: "wme", Scope: 'I', Name: "Wambule"},
+	{Code: "wmg", Scope: 'I', Name: "Western Minyag"},
+	{Code: "wmh", Scope: 'I', Name: "Waima'a"},
+	{Code: "wmi", Scope: 'I', Name: "Wamin"},
+	{Code: "wmm", Scope: 'I', Name: "Maiwa (Indonesia)"},
+	{Code: "wmn", Scope: 'I', Name: "Waamwang"},
+	{Code: "wmo", Scope: 'I', Name: "Wom (Papua New Guinea)"},
+	{Code: "wms", Scope: 'I', Name: "Wambon"},
+	{Code: "wmt", Scope: 'I', Name: "Walmajarri"},
+	{Code: "wmw", Scope: 'I', Name: "Mwani"},
+	{Code: "wmx", Scope: 'I', Name: "Womo"},
+	{Code: "wnb", Scope: 'I', Name: "Wanambre"},
+	{Code: "wnc", Scope: 'I', Name: "Wantoat"},
+	{Code: "wnd", Scope: 'I', Name: "Wandarang"},
+	{Code: "wne", Scope: 'I', Name: "Waneci"},
+	{Code: "wng", Scope: 'I', Name: "Wanggom"},
+	{Code: "wni", Scope: 'I', Name: "Ndzwani Comorian"},
+	{Code: "wnk", Scope: 'I', Name: "Wanukaka"},
+	{Code: "wnm", Scope: 'I', Name: "Wanggamala"},
+	{Code: "wnn", Scope: 'I', Name: "Wunumara"},
+	{Code: "wno", Scope: 'I', Name: "Wano"},
+	{Code: "wnp", Scope: 'I', Name: "Wanap"},
+	{Code: "wnu", Scope: 'I', Name: "Usan"},
+	{Code: "wnw", Scope: 'I', Name: "Wintu"},
+	{Code: "wny", Scope: 'I', Name: "Wanyi"},
+	{Code: "woa", Scope: 'I', Name: "Kuwema"},
+	{Code: "wob", Scope: 'I', Name: "Wè Northern"},
+	{Code: "woc", Scope: 'I', Name: "Wogeo"},
+	{Code: "wod", Scope: 'I', Name: "Wolani"},
+	{Code: "woe", Scope: 'I', Name: "Woleaian"},
+	{Code: "wof", Scope: 'I', Name: "Gambian Wolof"},
+	{Code: "wog", Scope: 'I', Name: "Wogamusin"},
+	{Code: "woi", Scope: 'I', Name: "Kamang"},
+	{Code: "wok", Scope: 'I', Name: "Longto"},
+	{Code: "wol", Part2: "wol", Part1: "wo", Scope: 'I', Name: "Wolof"},
+	{Code: "wom", Scope: 'I', Name: "Wom (Nigeria)"},
+	{Code: "won", Scope: 'I', Name: "Wongo"},
+	{Code: "woo", Scope: 'I', Name: "Manombai"},
+	{Code: "wor", Scope: 'I', Name: "Woria"},
+	{Code: "wos", Scope: 'I', Name: "Hanga Hundi"},
+	{Code: "wow", Scope: 'I', Name: "Wawonii"},
+	{Code: "woy", Scope: 'I', Name: "Weyto"},
+	{Code: "wpc", Scope: 'I', Name: "Maco"},
+	{Code: "wrb", Scope: 'I', Name: "Waluwarra"},
+	{Code: "wrg", Scope: 'I', Name: "Warungu"},
+	{Code: "wrh", Scope: 'I', Name: "Wiradjuri"},
+	{Code: "wri", Scope: 'I', Name: "Wariyangga"},
+	{Code: "wrk", Scope: 'I', Name: "Garrwa"},
+	{Code: "wrl", Scope: 'I', Name: "Warlmanpa"},
+	{Code: "wrm", Scope: 'I', Name: "Warumungu"},
+	{Code: "wrn", Scope: 'I', Name: "Warnang"},
+	{Code: "wro", Scope: 'I', Name: "Worrorra"},
+	{Code: "wrp", Scope: 'I', Name: "Waropen"},
+	{Code: "wrr", Scope: 'I', Name: "Wardaman"},
+	{Code: "wrs", Scope: 'I', Name: "Waris"},
+	{Code: "wru", Scope: 'I', Name: "Waru"},
+	{Code: "wrv", Scope: 'I', Name: "Waruna"},
+	{Code: "wrw", Scope: 'I', Name: "Gugu Warra"},
+	{Code: "wrx", Scope: 'I', Name: "Wae Rana"},
+	{Code: "wry", Scope: 'I', Name: "Merwari"},
+	{Code: "wrz", Scope: 'I', Name: "Waray (Australia)"},
+	{Code: "wsa", Scope: 'I', Name: "Warembori"},
+	{Code: "wsg", Scope: 'I', Name: "Adilabad Gondi"},
+	{Code: "wsi", Scope: 'I', Name: "Wusi"},
+	{Code: "wsk", Scope: 'I', Name: "Waskia"},
+	{Code: "wsr", Scope: 'I', Name: "Owenia"},
+	{Code: "wss", Scope: 'I', Name: "Wasa"},
+	{Code: "wsu", Scope: 'I', Name: "Wasu"},
+	{Code: "wsv", Scope: 'I', Name: "Wotapuri-Katarqalai"},
+	{Code: "wtf", Scope: 'I', Name: "Watiwa"},
+	{Code: "wth", Scope: 'I', Name: "Wathawurrung"},
+	{Code: "wti", Scope: 'I', Name: "Berta"},
+	{Code: "wtk", Scope: 'I', Name: "Watakataui"},
+	{Code: "wtm", Scope: 'I', Name: "Mewati"},
+	{Code: "wtw", Scope: 'I', Name: "Wotu"},
+	{Code: "wua", Scope: 'I', Name: "Wikngenchera"},
+	{Code: "wub", Scope: 'I', Name: "Wunambal"},
+	{Code: "wud", Scope: 'I', Name: "Wudu"},
+	{Code: "wuh", Scope: 'I', Name: "Wutunhua"},
+	{Code: "wul", Scope: 'I', Name: "Silimo"},
+	{Code: "wum", Scope: 'I', Name: "Wumbvu"},
+	{Code: "wun", Scope: 'I', Name: "Bungu"},
+	{Code: "wur", Scope: 'I', Name: "Wurrugu"},
+	{Code: "wut", Scope: 'I', Name: "Wutung"},
+	{Code: "wuu", Scope: 'I', Macro: "zh", Name: "Wu Chinese"},
+	{Code: "wuv", Scope: 'I', Name: "Wuvulu-Aua"},
+	{Code: "wux", Scope: 'I', Name: "Wulna"},
+	{Code: "wuy", Scope: 'I', Name: "Wauyai"},
+	{Code: "wwa", Scope: 'I', Name: "Waama"},
+	{Code: "wwb", Scope: 'I', Name: "Wakabunga"},
+	{Code: "wwo", Scope: 'I', Name: "Wetamut"},
+	{Code: "wwr", Scope: 'I', Name: "Warrwa"},
+	{Code: "www", Scope: 'I', Name: "Wawa"},
+	{Code: "wxa", Scope: 'I', Name: "Waxianghua"},
+	{Code: "wxw", Scope: 'I', Name: "Wardandi"},
+	{Code: "wyb", Scope: 'I', Name: "Wangaaybuwan-Ngiyambaa"},
+	{Code: "wyi", Scope: 'I', Name: "Woiwurrung"},
+	{Code: "wym", Scope: 'I', Name: "Wymysorys"},
+	{Code: "wyn", Scope: 'I', Name: "Wyandot"},
+	{Code: "wyr", Scope: 'I', Name: "Wayoró"},
+	{Code: "wyy", Scope: 'I', Name: "Western Fijian"},
+	{Code: "xaa", Scope: 'I', Name: "Andalusian Arabic"},
+	{Code: "xab", Scope: 'I', Name: "Sambe"},
+	{Code: "xac", Scope: 'I', Name: "Kachari"},
+	{Code: "xad", Scope: 'I', Name: "Adai"},
+	{Code: "xae", Scope: 'I', Name: "Aequian"},
+	{Code: "xag", Scope: 'I', Name: "Aghwan"},
+	{Code: "xai", Scope: 'I', Name: "Kaimbé"},
+	{Code: "xaj", Scope: 'I', Name: "Ararandewára"},
+	{Code: "xak", Scope: 'I', Name: "Máku"},
+	{Code: "xal", Part2: "xal", Scope: 'I', Name: "Kalmyk"},
+	{Code: "xam", Scope: 'I', Name: "ǀXam"},
+	{Code: "xan", Scope: 'I', Name: "Xamtanga"},
+	{Code: "xao", Scope: 'I', Name: "Khao"},
+	{Code: "xap", Scope: 'I', Name: "Apalachee"},
+	{Code: "xaq", Scope: 'I', Name: "Aquitanian"},
+	{Code: "xar", Scope: 'I', Name: "Karami"},
+	{Code: "xas", Scope: 'I', Name: "Kamas"},
+	{Code: "xat", Scope: 'I', Name: "Katawixi"},
+	{Code: "xau", Scope: 'I', Name: "Kauwera"},
+	{Code: "xav", Scope: 'I', Name: "Xavánte"},
+	{Code: "xaw", Scope: 'I', Name: "Kawaiisu"},
+	{Code: "xay", Scope: 'I', Name: "Kayan Mahakam"},
+	{Code: "xbb", Scope: 'I', Name: "Lower Burdekin"},
+	{Code: "xbc", Scope: 'I', Name: "Bactrian"},
+	{Code: "xbd", Scope: 'I', Name: "Bindal"},
+	{Code: "xbe", Scope: 'I', Name: "Bigambal"},
+	{Code: "xbg", Scope: 'I', Name: "Bunganditj"},
+	{Code: "xbi", Scope: 'I', Name: "Kombio"},
+	{Code: "xbj", Scope: 'I', Name: "Birrpayi"},
+	{Code: "xbm", Scope: 'I', Name: "Middle Breton"},
+	{Code: "xbn", Scope: 'I', Name: "Kenaboi"},
+	{Code: "xbo", Scope: 'I', Name: "Bolgarian"},
+	{Code: "xbp", Scope: 'I', Name: "Bibbulman"},
+	{Code: "xbr", Scope: 'I', Name: "Kambera"},
+	{Code: "xbw", Scope: 'I', Name: "Kambiwá"},
+	{Code: "xby", Scope: 'I', Name: "Batjala"},
+	{Code: "xcb", Scope: 'I', Name: "Cumbric"},
+	{Code: "xcc", Scope: 'I', Name: "Camunic"},
+	{Code: "xce", Scope: 'I', Name: "Celtiberian"},
+	{Code: "xcg", Scope: 'I', Name: "Cisalpine Gaulish"},
+	{Code: "xch", Scope: 'I', Name: "Chemakum"},
+	{Code: "xcl", Scope: 'I', Name: "Classical Armenian"},
+	{Code: "xcm", Scope: 'I', Name: "Comecrudo"},
+	{Code: "xcn", Scope: 'I', Name: "Cotoname"},
+	{Code: "xco", Scope: 'I', Name: "Chorasmian"},
+	{Code: "xcr", Scope: 'I', Name: "Carian"},
+	{Code: "xct", Scope: 'I', Name: "Classical Tibetan"},
+	{Code: "xcu", Scope: 'I', Name: "Curonian"},
+	{Code: "xcv", Scope: 'I', Name: "Chuvantsy"},
+	{Code: "xcw", Scope: 'I', Name: "Coahuilteco"},
+	{Code: "xcy", Scope: 'I', Name: "Cayuse"},
+	{Code: "xda", Scope: 'I', Name: "Darkinyung"},
+	{Code: "xdc", Scope: 'I', Name: "Dacian"},
+	{Code: "xdk", Scope: 'I', Name: "Dharuk"},
+	{Code: "xdm", Scope: 'I', Name: "Edomite"},
+	{Code: "xdo", Scope: 'I', Name: "Kwandu"},
+	{Code: "xdq", Scope: 'I', Name: "Kaitag"},
+	{Code: "xdy", Scope: 'I', Name: "Malayic Dayak"},
+	{Code: "xeb", Scope: 'I', Name: "Eblan"},
+	{Code: "xed", Scope: 'I', Name: "Hdi"},
+	{Code: "xeg", Scope: 'I', Name: "ǁXegwi"},
+	{Code: "xel", Scope: 'I', Name: "Kelo"},
+	{Code: "xem", Scope: 'I', Name: "Kembayan"},
+	{Code: "xep", Scope: 'I', Name: "Epi-Olmec"},
+	{Code: "xer", Scope: 'I', Name: "Xerénte"},
+	{Code: "xes", Scope: 'I', Name: "Kesawai"},
+	{Code: "xet", Scope: 'I', Name: "Xetá"},
+	{Code: "xeu", Scope: 'I', Name: "Keoru-Ahia"},
+	{Code: "xfa", Scope: 'I', Name: "Faliscan"},
+	{Code: "xga", Scope: 'I', Name: "Galatian"},
+	{Code: "xgb", Scope: 'I', Name: "Gbin"},
+	{Code: "xgd", Scope: 'I', Name: "Gudang"},
+	{Code: "xgf", Scope: 'I', Name: "Gabrielino-Fernandeño"},
+	{Code: "xgg", Scope: 'I', Name: "Goreng"},
+	{Code: "xgi", Scope: 'I', Name: "Garingbal"},
+	{Code: "xgl", Scope: 'I', Name: "Galindan"},
+	{Code: "xgm", Scope: 'I', Name: "Dharumbal"},
+	{Code: "xgr", Scope: 'I', Name: "Garza"},
+	{Code: "xgu", Scope: 'I', Name: "Unggumi"},
+	{Code: "xgw", Scope: 'I', Name: "Guwa"},
+	{Code: "xha", Scope: 'I', Name: "Harami"},
+	{Code: "xhc", Scope: 'I', Name: "Hunnic"},
+	{Code: "xhd", Scope: 'I', Name: "Hadrami"},
+	{Code: "xhe", Scope: 'I', Name: "Khetrani"},
+	{Code: "xhm", Scope: 'I', Name: "Middle Khmer (1400 to 1850 CE)"},
+	{Code: "xho", Part2: "xho", Part1: "xh", Scope: 'I', Name: "Xhosa"},
+	{Code: "xhr", Scope: 'I', Name: "Hernican"},
+	{Code: "xht", Scope: 'I', Name: "Hattic"},
+	{Code: "xhu", Scope: 'I', Name: "Hurrian"},
+	{Code: "xhv", Scope: 'I', Name: "Khua"},
+	{Code: "xib", Scope: 'I', Name: "Iberian"},
+	{Code: "xii", Scope: 'I', Name: "Xiri"},
+	{Code: "xil", Scope: 'I', Name: "Illyrian"},
+	{Code: "xin", Scope: 'I', Name: "Xinca"},
+	{Code: "xir", Scope: 'I', Name: "Xiriâna"},
+	{Code: "xis", Scope: 'I', Name: "Kisan"},
+	{Code: "xiv", Scope: 'I', Name: "Indus Valley Language"},
+	{Code: "xiy", Scope: 'I', Name: "Xipaya"},
+	{Code: "xjb", Scope: 'I', Name: "Minjungbal"},
+	{Code: "xjt", Scope: 'I', Name: "Jaitmatang"},
+	{Code: "xka", Scope: 'I', Name: "Kalkoti"},
+	{Code: "xkb", Scope: 'I', Name: "Northern Nago"},
+	{Code: "xkc", Scope: 'I', Name: "Kho'ini"},
+	{Code: "xkd", Scope: 'I', Name: "Mendalam Kayan"},
+	{Code: "xke", Scope: 'I', Name: "Kereho"},
+	{Code: "xkf", Scope: 'I', Name: "Khengkha"},
+	{Code: "xkg", Scope: 'I', Name: "Kagoro"},
+	{Code: "xki", Scope: 'I', Name: "Kenyan Sign Language"},
+	{Code: "xkj", Scope: 'I', Name: "Kajali"},
+	{Code: "xkk", Scope: 'I', Name: "Kachok"},
+	{Code: "xkl", Scope: 'I', Name: "Mainstream Kenyah"},
+	{Code: "xkn", Scope: 'I', Name: "Kayan River Kayan"},
+	{Code: "xko", Scope: 'I', Name: "Kiorr"},
+	{Code: "xkp", Scope: 'I', Name: "Kabatei"},
+	{Code: "xkq", Scope: 'I', Name: "Koroni"},
+	{Code: "xkr", Scope: 'I', Name: "Xakriabá"},
+	{Code: "xks", Scope: 'I', Name: "Kumbewaha"},
+	{Code: "xkt", Scope: 'I', Name: "Kantosi"},
+	{Code: "xku", Scope: 'I', Name: "Kaamba"},
+	{Code: "xkv", Scope: 'I', Name: "Kgalagadi"},
+	{Code: "xkw", Scope: 'I', Name: "Kembra"},
+	{Code: "xkx", Scope: 'I', Name: "Karore"},
+	{Code: "xky", Scope: 'I', Name: "Uma' Lasan"},
+	{Code: "xkz", Scope: 'I', Name: "Kurtokha"},
+	{Code: "xla", Scope: 'I', Name: "Kamula"},
+	{Code: "xlb", Scope: 'I', Name: "Loup B"},
+	{Code: "xlc", Scope: 'I', Name: "Lycian"},
+	{Code: "xld", Scope: 'I', Name: "Lydian"},
+	{Code: "xle", Scope: 'I', Name: "Lemnian"},
+	{Code: "xlg", Scope: 'I', Name: "Ligurian (Ancient)"},
+	{Code: "xli", Scope: 'I', Name: "Liburnian"},
+	{Code: "xln", Scope: 'I', Name: "Alanic"},
+	{Code: "xlo", Scope: 'I', Name: "Loup A"},
+	{Code: "xlp", Scope: 'I', Name: "Lepontic"},
+	{Code: "xls", Scope: 'I', Name: "Lusitanian"},
+	{Code: "xlu", Scope: 'I', Name: "Cuneiform Luwian"},
+	{Code: "xly", Scope: 'I', Name: "Elymian"},
+	{Code: "xma", Scope: 'I', Name: "Mushungulu"},
+	{Code: "xmb", Scope: 'I', Name: "Mbonga"},
+	{Code: "xmc", Scope: 'I', Name: "Makhuwa-Marrevone"},
+	{Code: "xmd", Scope: 'I', Name: "Mbudum"},
+	{Code: "xme", Scope: 'I', Name: "Median"},
+	{Code: "xmf", Scope: 'I', Name: "Mingrelian"},
+	{Code: "xmg", Scope: 'I', Name: "Mengaka"},
+	{Code: "xmh", Scope: 'I', Name: "Kugu-Muminh"},
+	{Code: "xmj", Scope: 'I', Name: "Majera"},
+	{Code: "xmk", Scope: 'I', Name: "Ancient Macedonian"},
+	{Code: "xml", Scope: 'I', Name: "Malaysian Sign Language"},
+	{Code: "xmm", Scope: 'I', Macro: "ms", Name: "Manado Malay"},
+	{Code: "xmn", Scope: 'I', Name: "Manichaean Middle Persian"},
+	{Code: "xmo", Scope: 'I', Name: "Morerebi"},
+	{Code: "xmp", Scope: 'I', Name: "Kuku-Mu'inh"},
+	{Code: "xmq", Scope: 'I', Name: "Kuku-Mangk"},
+	{Code: "xmr", Scope: 'I', Name: "Meroitic"},
+	{Code: "xms", Scope: 'I', Name: "Moroccan Sign Language"},
+	{Code: "xmt", Scope: 'I', Name: "Matbat"},
+	{Code: "xmu", Scope: 'I', Name: "Kamu"},
+	{Code: "xmv", Scope: 'I', Name: "Antankarana Malagasy"},
+	{Code: "xmw", Scope: 'I', Name: "Tsimihety Malagasy"},
+	{Code: "xmx", Scope: 'I', Name: "Salawati"},
+	{Code: "xmy", Scope: 'I', Name: "Mayaguduna"},
+	{Code: "xmz", Scope: 'I', Name: "Mori Bawah"},
+	{Code: "xna", Scope: 'I', Name: "Ancient North Arabian"},
+	{Code: "xnb", Scope: 'I', Name: "Kanakanabu"},
+	{Code: "xng", Scope: 'I', Name: "Middle Mongolian"},
+	{Code: "xnh", Scope: 'I', Name: "Kuanhua"},
+	{Code: "xni", Scope: 'I', Name: "Ngarigu"},
+	{Code: "xnj", Scope: 'I', Name: "Ngoni (Tanzania)"},
+	{Code: "xnk", Scope: 'I', Name: "Nganakarti"},
+	{Code: "xnm", Scope: 'I', Name: "Ngumbarl"},
+	{Code: "xnn", Scope: 'I', Name: "Northern Kankanay"},
+	{Code: "xno", Scope: 'I', Name: "Anglo-Norman"},
+	{Code: "xnq", Scope: 'I', Name: "Ngoni (Mozambique)"},
+	{Code: "xnr", Scope: 'I', Name: "Kangri"},
+	{Code: "xns", Scope: 'I', Name: "Kanashi"},
+	{Code: "xnt", Scope: 'I', Name: "Narragansett"},
+	{Code: "xnu", Scope: 'I', Name: "Nukunul"},
+	{Code: "xny", Scope: 'I', Name: "Nyiyaparli"},
+	{Code: "xnz", Scope: 'I', Name: "Kenzi"},
+	{Code: "xoc", Scope: 'I', Name: "O'chi'chi'"},
+	{Code: "xod", Scope: 'I', Name: "Kokoda"},
+	{Code: "xog", Scope: 'I', Name: "Soga"},
+	{Code: "xoi", Scope: 'I', Name: "Kominimung"},
+	{Code: "xok", Scope: 'I', Name: "Xokleng"},
+	{Code: "xom", Scope: 'I', Name: "Komo (Sudan)"},
+	{Code: "xon", Scope: 'I', Name: "Konkomba"},
+	{Code: "xoo", Scope: 'I', Name: "Xukurú"},
+	{Code: "xop", Scope: 'I', Name: "Kopar"},
+	{Code: "xor", Scope: 'I', Name: "Korubo"},
+	{Code: "xow", Scope: 'I', Name: "Kowaki"},
+	{Code: "xpa", Scope: 'I', Name: "Pirriya"},
+	{Code: "xpb", Scope: 'I', Name: "Northeastern Tasmanian"},
+	{Code: "xpc", Scope: 'I', Name: "Pecheneg"},
+	{Code: "xpd", Scope: 'I', Name: "Oyster Bay Tasmanian"},
+	{Code: "xpe", Scope: 'I', Macro: "kpe", Name: "Liberia Kpelle"},
+	{Code: "xpf", Scope: 'I', Name: "Southeast Tasmanian"},
+	{Code: "xpg", Scope: 'I', Name: "Phrygian"},
+	{Code: "xph", Scope: 'I', Name: "North Midlands Tasmanian"},
+	{Code: "xpi", Scope: 'I', Name: "Pictish"},
+	{Code: "xpj", Scope: 'I', Name: "Mpalitjanh"},
+	{Code: "xpk", Scope: 'I', Name: "Kulina Pano"},
+	{Code: "xpl", Scope: 'I', Name: "Port Sorell Tasmanian"},
+	{Code: "xpm", Scope: 'I', Name: "Pumpokol"},
+	{Code: "xpn", Scope: 'I', Name: "Kapinawá"},
+	{Code: "xpo", Scope: 'I', Name: "Pochutec"},
+	{Code: "xpp", Scope: 'I', Name: "Puyo-Paekche"},
+	{Code: "xpq", Scope: 'I', Name: "Mohegan-Pequot"},
+	{Code: "xpr", Scope: 'I', Name: "Parthian"},
+	{Code: "xps", Scope: 'I', Name: "Pisidian"},
+	{Code: "xpt", Scope: 'I', Name: "Punthamara"},
+	{Code: "xpu", Scope: 'I', Name: "Punic"},
+	{Code: "xpv", Scope: 'I', Name: "Northern Tasmanian"},
+	{Code: "xpw", Scope: 'I', Name: "Northwestern Tasmanian"},
+	{Code: "xpx", Scope: 'I', Name: "Southwestern Tasmanian"},
+	{Code: "xpy", Scope: 'I', Name: "Puyo"},
+	{Code: "xpz", Scope: 'I', Name: "Bruny Island Tasmanian"},
+	{Code: "xqa", Scope: 'I', Name: "Karakhanid"},
+	{Code: "xqt", Scope: 'I', Name: "Qatabanian"},
+	{Code: "xra", Scope: 'I', Name: "Krahô"},
+	{Code: "xrb", Scope: 'I', Name: "Eastern Karaboro"},
+	{Code: "xrd", Scope: 'I', Name: "Gundungurra"},
+	{Code: "xre", Scope: 'I', Name: "Kreye"},
+	{Code: "xrg", Scope: 'I', Name: "Minang"},
+	{Code: "xri", Scope: 'I', Name: "Krikati-Timbira"},
+	{Code: "xrm", Scope: 'I', Name: "Armazic"},
+	{Code: "xrn", Scope: 'I', Name: "Arin"},
+	{Code: "xrr", Scope: 'I', Name: "Raetic"},
+	{Code: "xrt", Scope: 'I', Name: "Aranama-Tamique"},
+	{Code: "xru", Scope: 'I', Name: "Marriammu"},
+	{Code: "xrw", Scope: 'I', Name: "Karawa"},
+	{Code: "xsa", Scope: 'I', Name: "Sabaean"},
+	{Code: "xsb", Scope: 'I', Name: "Sambal"},
+	{Code: "xsc", Scope: 'I', Name: "Scythian"},
+	{Code: "xsd", Scope: 'I', Name: "Sidetic"},
+	{Code: "xse", Scope: 'I', Name: "Sempan"},
+	{Code: "xsh", Scope: 'I', Name: "Shamang"},
+	{Code: "xsi", Scope: 'I', Name: "Sio"},
+	{Code: "xsj", Scope: 'I', Name: "Subi"},
+	{Code: "xsl", Scope: 'I', Macro: "den", Name: "South Slavey"},
+	{Code: "xsm", Scope: 'I', Name: "Kasem"},
+	{Code: "xsn", Scope: 'I', Name: "Sanga (Nigeria)"},
+	{Code: "xso", Scope: 'I', Name: "Solano"},
+	{Code: "xsp", Scope: 'I', Name: "Silopi"},
+	{Code: "xsq", Scope: 'I', Name: "Makhuwa-Saka"},
+	{Code: "xsr", Scope: 'I', Name: "Sherpa"},
+	{Code: "xss", Scope: 'I', Name: "Assan"},
+	{Code: "xsu", Scope: 'I', Name: "Sanumá"},
+	{Code: "xsv", Scope: 'I', Name: "Sudovian"},
+	{Code: "xsy", Scope: 'I', Name: "Saisiyat"},
+	{Code: "xta", Scope: 'I', Name: "Alcozauca Mixtec"},
+	{Code: "xtb", Scope: 'I', Name: "Chazumba Mixtec"},
+	{Code: "xtc", Scope: 'I', Name: "Katcha-Kadugli-Miri"},
+	{Code: "xtd", Scope: 'I', Name: "Diuxi-Tilantongo Mixtec"},
+	{Code: "xte", Scope: 'I', Name: "Ketengban"},
+	{Code: "xtg", Scope: 'I', Name: "Transalpine Gaulish"},
+	{Code: "xth", Scope: 'I', Name: "Yitha Yitha"},
+	{Code: "xti", Scope: 'I', Name: "Sinicahua Mixtec"},
+	{Code: "xtj", Scope: 'I', Name: "San Juan Teita Mixtec"},
+	{Code: "xtl", Scope: 'I', Name: "Tijaltepec Mixtec"},
+	{Code: "xtm", Scope: 'I', Name: "Magdalena Peñasco Mixtec"},
+	{Code: "xtn", Scope: 'I', Name: "Northern Tlaxiaco Mixtec"},
+	{Code: "xto", Scope: 'I', Name: "Tokharian A"},
+	{Code: "xtp", Scope: 'I', Name: "San Miguel Piedras Mixtec"},
+	{Code: "xtq", Scope: 'I', Name: "Tumshuqese"},
+	{Code: "xtr", Scope: 'I', Name: "Early Tripuri"},
+	{Code: "xts", Scope: 'I', Name: "Sindihui Mixtec"},
+	{Code: "xtt", Scope: 'I', Name: "Tacahua Mixtec"},
+	{Code: "xtu", Scope: 'I', Name: "Cuyamecalco Mixtec"},
+	{Code: "xtv", Scope: 'I', Name: "Thawa"},
+	{Code: "xtw", Scope: 'I', Name: "Tawandê"},
+	{Code: "xty", Scope: 'I', Name: "Yoloxochitl Mixtec"},
+	{Code: "xua", Scope: 'I', Name: "Alu Kurumba"},
+	{Code: "xub", Scope: 'I', Name: "Betta Kurumba"},
+	{Code: "xud", Scope: 'I', Name: "Umiida"},
+	{Code: "xug", Scope: 'I', Name: "Kunigami"},
+	{Code: "xuj", Scope: 'I', Name: "Jennu Kurumba"},
+	{Code: "xul", Scope: 'I', Name: "Ngunawal"},
+	{Code: "xum", Scope: 'I', Name: "Umbrian"},
+	{Code: "xun", Scope: 'I', Name: "Unggaranggu"},
+	{Code: "xuo", Scope: 'I', Name: "Kuo"},
+	{Code: "xup", Scope: 'I', Name: "Upper Umpqua"},
+	{Code: "xur", Scope: 'I', Name: "Urartian"},
+	{Code: "xut", Scope: 'I', Name: "Kuthant"},
+	{Code: "xuu", Scope: 'I', Name: "Kxoe"},
+	{Code: "xve", Scope: 'I', Name: "Venetic"},
+	{Code: "xvi", Scope: 'I', Name: "Kamviri"},
+	{Code: "xvn", Scope: 'I', Name: "Vandalic"},
+	{Code: "xvo", Scope: 'I', Name: "Volscian"},
+	{Code: "xvs", Scope: 'I', Name: "Vestinian"},
+	{Code: "xwa", Scope: 'I', Name: "Kwaza"},
+	{Code: "xwc", Scope: 'I', Name: "Woccon"},
+	{Code: "xwd", Scope: 'I', Name: "Wadi Wadi"},
+	{Code: "xwe", Scope: 'I', Name: "Xwela Gbe"},
+	{Code: "xwg", Scope: 'I', Name: "Kwegu"},
+	{Code: "xwj", Scope: 'I', Name: "Wajuk"},
+	{Code: "xwk", Scope: 'I', Name: "Wangkumara"},
+	{Code: "xwl", Scope: 'I', Name: "Western Xwla Gbe"},
+	{Code: "xwo", Scope: 'I', Name: "Written Oirat"},
+	{Code: "xwr", Scope: 'I', Name: "Kwerba Mamberamo"},
+	{Code: "xwt", Scope: 'I', Name: "Wotjobaluk"},
+	{Code: "xww", Scope: 'I', Name: "Wemba Wemba"},
+	{Code: "xxb", Scope: 'I', Name: "Boro (Ghana)"},
+	{Code: "xxk", Scope: 'I', Name: "Ke'o"},
+	{Code: "xxm", Scope: 'I', Name: "Minkin"},
+	{Code: "xxr", Scope: 'I', Name: "Koropó"},
+	{Code: "xxt", Scope: 'I', Name: "Tambora"},
+	{Code: "xya", Scope: 'I', Name: "Yaygir"},
+	{Code: "xyb", Scope: 'I', Name: "Yandjibara"},
+	{Code: "xyj", Scope: 'I', Name: "Mayi-Yapi"},
+	{Code: "xyk", Scope: 'I', Name: "Mayi-Kulan"},
+	{Code: "xyl", Scope: 'I', Name: "Yalakalore"},
+	{Code: "xyt", Scope: 'I', Name: "Mayi-Thakurti"},
+	{Code: "xyy", Scope: 'I', Name: "Yorta Yorta"},
+	{Code: "xzh", Scope: 'I', Name: "Zhang-Zhung"},
+	{Code: "xzm", Scope: 'I', Name: "Zemgalian"},
+	{Code: "xzp", Scope: 'I', Name: "Ancient Zapotec"},
+	{Code: "yaa", Scope: 'I', Name: "Yaminahua"},
+	{Code: "yab", Scope: 'I', Name: "Yuhup"},
+	{Code: "yac", Scope: 'I', Name: "Pass Valley Yali"},
+	{Code: "yad", Scope: 'I', Name: "Yagua"},
+	{Code: "yae", Scope: 'I', Name: "Pumé"},
+	{Code: "yaf", Scope: 'I', Name: "Yaka (Democratic Republic of Congo)"},
+	{Code: "yag", Scope: 'I', Name: "Yámana"},
+	{Code: "yah", Scope: 'I', Name: "Yazgulyam"},
+	{Code: "yai", Scope: 'I', Name: "Yagnobi"},
+	{Code: "yaj", Scope: 'I', Name: "Banda-Yangere"},
+	{Code: "yak", Scope: 'I', Name: "Yakama"},
+	{Code: "yal", Scope: 'I', Name: "Yalunka"},
+	{Code: "yam", Scope: 'I', Name: "Yamba"},
+	{Code: "yan", Scope: 'I', Name: "Mayangna"},
+	{Code: "yao", Part2: "yao", Scope: 'I', Name: "Yao"},
+	{Code: "yap", Part2: "yap", Scope: 'I', Name: "Yapese"},
+	{Code: "yaq", Scope: 'I', Name: "Yaqui"},
+	{Code: "yar", Scope: 'I', Name: "Yabarana"},
+	{Code: "yas", Scope: 'I', Name: "Nugunu (Cameroon)"},
+	{Code: "yat", Scope: 'I', Name: "Yambeta"},
+	{Code: "yau", Scope: 'I', Name: "Yuwana"},
+	{Code: "yav", Scope: 'I', Name: "Yangben"},
+	{Code: "yaw", Scope: 'I', Name: "Yawalapití"},
+	{Code: "yax", Scope: 'I', Name: "Yauma"},
+	{Code: "yay", Scope: 'I', Name: "Agwagwune"},
+	{Code: "yaz", Scope: 'I', Name: "Lokaa"},
+	{Code: "yba", Scope: 'I', Name: "Yala"},
+	{Code: "ybb", Scope: 'I', Name: "Yemba"},
+	{Code: "ybe", Scope: 'I', Name: "West Yugur"},
+	{Code: "ybh", Scope: 'I', Name: "Yakha"},
+	{Code: "ybi", Scope: 'I', Name: "Yamphu"},
+	{Code: "ybj", Scope: 'I', Name: "Hasha"},
+	{Code: "ybk", Scope: 'I', Name: "Bokha"},
+	{Code: "ybl", Scope: 'I', Name: "Yukuben"},
+	{Code: "ybm", Scope: 'I', Name: "Yaben"},
+	{Code: "ybn", Scope: 'I', Name: "Yabaâna"},
+	{Code: "ybo", Scope: 'I', Name: "Yabong"},
+	{Code: "ybx", Scope: 'I', Name: "Yawiyo"},
+	{Code: "yby", Scope: 'I', Name: "Yaweyuha"},
+	{Code: "ych", Scope: 'I', Name: "Chesu"},
+	{Code: "ycl", Scope: 'I', Name: "Lolopo"},
+	{Code: "ycn", Scope: 'I', Name: "Yucuna"},
+	{Code: "ycp", Scope: 'I', Name: "Chepya"},
+	{Code: "yda", Scope: 'I', Name: "Yanda"},
+	{Code: "ydd", Scope: 'I', Macro: "yi", Name: "Eastern Yiddish"},
+	{Code: "yde", Scope: 'I', Name: "Yangum Dey"},
+	{Code: "ydg", Scope: 'I', Name: "Yidgha"},
+	{Code: "ydk", Scope: 'I', Name: "Yoidik"},
+	{Code: "yea", Scope: 'I', Name: "Ravula"},
+	{Code: "yec", Scope: 'I', Name: "Yeniche"},
+	{Code: "yee", Scope: 'I', Name: "Yimas"},
+	{Code: "yei", Scope: 'I', Name: "Yeni"},
+	{Code: "yej", Scope: 'I', Name: "Yevanic"},
+	{Code: "yel", Scope: 'I', Name: "Yela"},
+	{Code: "yer", Scope: 'I', Name: "Tarok"},
+	{Code: "yes", Scope: 'I', Name: "Nyankpa"},
+	{Code: "yet", Scope: 'I', Name: "Yetfa"},
+	{Code: "yeu", Scope: 'I', Name: "Yerukula"},
+	{Code: "yev", Scope: 'I', Name: "Yapunda"},
+	{Code: "yey", Scope: 'I', Name: "Yeyi"},
+	{Code: "yga", Scope: 'I', Name: "Malyangapa"},
+	{Code: "ygi", Scope: 'I', Name: "Yiningayi"},
+	{Code: "ygl", Scope: 'I', Name: "Yangum Gel"},
+	{Code: "ygm", Scope: 'I', Name: "Yagomi"},
+	{Code: "ygp", Scope: 'I', Name: "Gepo"},
+	{Code: "ygr", Scope: 'I', Name: "Yagaria"},
+	{Code: "ygs", Scope: 'I', Name: "Yolŋu Sign Language"},
+	{Code: "ygu", Scope: 'I', Name: "Yugul"},
+	{Code: "ygw", Scope: 'I', Name: "Yagwoia"},
+	{Code: "yha", Scope: 'I', Name: "Baha Buyang"},
+	{Code: "yhd", Scope: 'I', Name: "Judeo-Iraqi Arabic"},
+	{Code: "yhl", Scope: 'I', Name: "Hlepho Phowa"},
+	{Code: "yhs", Scope: 'I', Name: "Yan-nhaŋu Sign Language"},
+	{Code: "yia", Scope: 'I', Name: "Yinggarda"},
+	{Code: "yid", Part2: "yid", Part1: "yi", Scope: 'M', Name: "Yiddish"},
+	{Code: "yif", Scope: 'I', Name: "Ache"},
+	{Code: "yig", Scope: 'I', Name: "Wusa Nasu"},
+	{Code: "yih", Scope: 'I', Macro: "yi", Name: "Western Yiddish"},
+	{Code: "yii", Scope: 'I', Name: "Yidiny"},
+	{Code: "yij", Scope: 'I', Name: "Yindjibarndi"},
+	{Code: "yik", Scope: 'I', Name: "Dongshanba Lalo"},
+	{Code: "yil", Scope: 'I', Name: "Yindjilandji"},
+	{Code: "yim", Scope: 'I', Name: "Yimchungru Naga"},
+	{Code: "yin", Scope: 'I', Name: "Riang Lai"},
+	{Code: "yip", Scope: 'I', Name: "Pholo"},
+	{Code: "yiq", Scope: 'I', Name: "Miqie"},
+	{Code: "yir", Scope: 'I', Name: "North Awyu"},
+	{Code: "yis", Scope: 'I', Name: "Yis"},
+	{Code: "yit", Scope: 'I', Name: "Eastern Lalu"},
+	{Code: "yiu", Scope: 'I', Name: "Awu"},
+	{Code: "yiv", Scope: 'I', Name: "Northern Nisu"},
+	{Code: "yix", Scope: 'I', Name: "Axi Yi"},
+	{Code: "yiz", Scope: 'I', Name: "Azhe"},
+	{Code: "yka", Scope: 'I', Name: "Yakan"},
+	{Code: "ykg", Scope: 'I', Name: "Northern Yukaghir"},
+	{Code: "yki", Scope: 'I', Name: "Yoke"},
+	{Code: "ykk", Scope: 'I', Name: "Yakaikeke"},
+	{Code: "ykl", Scope: 'I', Name: "Khlula"},
+	{Code: "ykm", Scope: 'I', Name: "Kap"},
+	{Code: "ykn", Scope: 'I', Name: "Kua-nsi"},
+	{Code: "yko", Scope: 'I', Name: "Yasa"},
+	{Code: "ykr", Scope: 'I', Name: "Yekora"},
+	{Code: "ykt", Scope: 'I', Name: "Kathu"},
+	{Code: "yku", Scope: 'I', Name: "Kuamasi"},
+	{Code: "yky", Scope: 'I', Name: "Yakoma"},
+	{Code: "yla", Scope: 'I', Name: "Yaul"},
+	{Code: "ylb", Scope: 'I', Name: "Yaleba"},
+	{Code: "yle", Scope: 'I', Name: "Yele"},
+	{Code: "ylg", Scope: 'I', Name: "Yelogu"},
+	{Code: "yli", Scope: 'I', Name: "Angguruk Yali"},
+	{Code: "yll", Scope: 'I', Name: "Yil"},
+	{Code: "ylm", Scope: 'I', Name: "Limi"},
+	{Code: "yln", Scope: 'I', Name: "Langnian Buyang"},
+	{Code: "ylo", Scope: 'I', Name: "Naluo Yi"},
+	{Code: "ylr", Scope: 'I', Name: "Yalarnnga"},
+	{Code: "ylu", Scope: 'I', Name: "Aribwaung"},
+	{Code: "yly", Scope: 'I', Name: "Nyâlayu"},
+	{Code: "ymb", Scope: 'I', Name: "Yambes"},
+	{Code: "ymc", Scope: 'I', Name: "Southern Muji"},
+	{Code: "ymd", Scope: 'I', Name: "Muda"},
+	{Code: "yme", Scope: 'I', Name: "Yameo"},
+	{Code: "ymg", Scope: 'I', Name: "Yamongeri"},
+	{Code: "ymh", Scope: 'I', Name: "Mili"},
+	{Code: "ymi", Scope: 'I', Name: "Moji"},
+	{Code: "ymk", Scope: 'I', Name: "Makwe"},
+	{Code: "yml", Scope: 'I', Name: "Iamalele"},
+	{Code: "ymm", Scope: 'I', Name: "Maay"},
+	{Code: "ymn", Scope: 'I', Name: "Yamna"},
+	{Code: "ymo", Scope: 'I', Name: "Yangum Mon"},
+	{Code: "ymp", Scope: 'I', Name: "Yamap"},
+	{Code: "ymq", Scope: 'I', Name: "Qila Muji"},
+	{Code: "ymr", Scope: 'I', Name: "Malasar"},
+	{Code: "yms", Scope: 'I', Name: "Mysian"},
+	{Code: "ymx", Scope: 'I', Name: "Northern Muji"},
+	{Code: "ymz", Scope: 'I', Name: "Muzi"},
+	{Code: "yna", Scope: 'I', Name: "Aluo"},
+	{Code: "ynd", Scope: 'I', Name: "Yandruwandha"},
+	{Code: "yne", Scope: 'I', Name: "Lang'e"},
+	{Code: "yng", Scope: 'I', Name: "Yango"},
+	{Code: "ynk", Scope: 'I', Name: "Naukan Yupik"},
+	{Code: "ynl", Scope: 'I', Name: "Yangulam"},
+	{Code: "ynn", Scope: 'I', Name: "Yana"},
+	{Code: "yno", Scope: 'I', Name: "Yong"},
+	{Code: "ynq", Scope: 'I', Name: "Yendang"},
+	{Code: "yns", Scope: 'I', Name: "Yansi"},
+	{Code: "ynu", Scope: 'I', Name: "Yahuna"},
+	{Code: "yob", Scope: 'I', Name: "Yoba"},
+	{Code: "yog", Scope: 'I', Name: "Yogad"},
+	{Code: "yoi", Scope: 'I', Name: "Yonaguni"},
+	{Code: "yok", Scope: 'I', Name: "Yokuts"},
+	{Code: "yol", Scope: 'I', Name: "Yola"},
+	{Code: "yom", Scope: 'I', Name: "Yombe"},
+	{Code: "yon", Scope: 'I', Name: "Yongkom"},
+	{Code: "yor", Part2: "yor", Part1: "yo", Scope: 'I', Name: "Yoruba"},
+	{Code: "yot", Scope: 'I', Name: "Yotti"},
+	{Code: "yox", Scope: 'I', Name: "Yoron"},
+	{Code: "yoy", Scope: 'I', Name: "Yoy"},
+	{Code: "ypa", Scope: 'I', Name: "Phala"},
+	{Code: "ypb", Scope: 'I', Name: "Labo Phowa"},
+	{Code: "ypg", Scope: 'I', Name: "Phola"},
+	{Code: "yph", Scope: 'I', Name: "Phupha"},
+	{Code: "ypm", Scope: 'I', Name: "Phuma"},
+	{Code: "ypn", Scope: 'I', Name: "Ani Phowa"},
+	{Code: "ypo", Scope: 'I', Name: "Alo Phola"},
+	{Code: "ypp", Scope: 'I', Name: "Phupa"},
+	{Code: "ypz", Scope: 'I', Name: "Phuza"},
+	{Code: "yra", Scope: 'I', Name: "Yerakai"},
+	{Code: "yrb", Scope: 'I', Name: "Yareba"},
+	{Code: "yre", Scope: 'I', Name: "Yaouré"},
+	{Code: "yrk", Scope: 'I', Name: "Nenets"},
+	{Code: "yrl", Scope: 'I', Name: "Nhengatu"},
+	{Code: "yrm", Scope: 'I', Name: "Yirrk-Mel"},
+	{Code: "yrn", Scope: 'I', Name: "Yerong"},
+	{Code: "yro", Scope: 'I', Name: "Yaroamë"},
+	{Code: "yrs", Scope: 'I', Name: "Yarsun"},
+	{Code: "yrw", Scope: 'I', Name: "Yarawata"},
+	{Code: "yry", Scope: 'I', Name: "Yarluyandi"},
+	{Code: "ysc", Scope: 'I', Name: "Yassic"},
+	{Code: "ysd", Scope: 'I', Name: "Samatao"},
+	{Code: "ysg", Scope: 'I', Name: "Sonaga"},
+	{Code: "ysl", Scope: 'I', Name: "Yugoslavian Sign Language"},
+	{Code: "ysm", Scope: 'I', Name: "Myanmar Sign Language"},
+	{Code: "ysn", Scope: 'I', Name: "Sani"},
+	{Code: "yso", Scope: 'I', Name: "Nisi (China)"},
+	{Code: "ysp", Scope: 'I', Name: "Southern Lolopo"},
+	{Code: "ysr", Scope: 'I', Name: "Sirenik Yupik"},
+	{Code: "yss", Scope: 'I', Name: "Yessan-Mayo"},
+	{Code: "ysy", Scope: 'I', Name: "Sanie"},
+	{Code: "yta", Scope: 'I', Name: "Talu"},
+	{Code: "ytl", Scope: 'I', Name: "Tanglang"},
+	{Code: "ytp", Scope: 'I', Name: "Thopho"},
+	{Code: "ytw", Scope: 'I', Name: "Yout Wam"},
+	{Code: "yty", Scope: 'I', Name: "Yatay"},
+	{Code: "yua", Scope: 'I', Name: "Yucateco"},
+	{Code: "yub", Scope: 'I', Name: "Yugambal"},
+	{Code: "yuc", Scope: 'I', Name: "Yuchi"},
+	{Code: "yud", Scope: 'I', Name: "Judeo-Tripolitanian Arabic"},
+	{Code: "yue", Scope: 'I', Macro: "zh", Name: "Yue Chinese"},
+	{Code: "yuf", Scope: 'I', Name: "Havasupai-Walapai-Yavapai"},
+	{Code: "yug", Scope: 'I', Name: "Yug"},
+	{Code: "yui", Scope: 'I', Name: "Yurutí"},
+	{Code: "yuj", Scope: 'I', Name: "Karkar-Yuri"},
+	{Code: "yuk", Scope: 'I', Name: "Yuki"},
+	{Code: "yul", Scope: 'I', Name: "Yulu"},
+	{Code: "yum", Scope: 'I', Name: "Quechan"},
+	{Code: "yun", Scope: 'I', Name: "Bena (Nigeria)"},
+	{Code: "yup", Scope: 'I', Name: "Yukpa"},
+	{Code: "yuq", Scope: 'I', Name: "Yuqui"},
+	{Code: "yur", Scope: 'I', Name: "Yurok"},
+	{Code: "yut", Scope: 'I', Name: "Yopno"},
+	{Code: "yuw", Scope: 'I', Name: "Yau (Morobe Province)"},
+	{Code: "yux", Scope: 'I', Name: "Southern Yukaghir"},
+	{Code: "yuy", Scope: 'I', Name: "East Yugur"},
+	{Code: "yuz", Scope: 'I', Name: "Yuracare"},
+	{Code: "yva", Scope: 'I', Name: "Yawa"},
+	{Code: "yvt", Scope: 'I', Name: "Yavitero"},
+	{Code: "ywa", Scope: 'I', Name: "Kalou"},
+	{Code: "ywg", Scope: 'I', Name: "Yinhawangka"},
+	{Code: "ywl", Scope: 'I', Name: "Western Lalu"},
+	{Code: "ywn", Scope: 'I', Name: "Yawanawa"},
+	{Code: "ywq", Scope: 'I', Name: "Wuding-Luquan Yi"},
+	{Code: "ywr", Scope: 'I', Name: "Yawuru"},
+	{Code: "ywt", Scope: 'I', Name: "Xishanba Lalo"},
+	{Code: "ywu", Scope: 'I', Name: "Wumeng Nasu"},
+	{Code: "yww", Scope: 'I', Name: "Yawarawarga"},
+	{Code: "yxa", Scope: 'I', Name: "Mayawali"},
+	{Code: "yxg", Scope: 'I', Name: "Yagara"},
+	{Code: "yxl", Scope: 'I', Name: "Yardliyawarra"},
+	{Code: "yxm", Scope: 'I', Name: "Yinwum"},
+	{Code: "yxu", Scope: 'I', Name: "Yuyu"},
+	{Code: "yxy", Scope: 'I', Name: "Yabula Yabula"},
+	{Code: "yyr", Scope: 'I', Name: "Yir Yoront"},
+	{Code: "yyu", Scope: 'I', Name: "Yau (Sandaun Province)"},
+	{Code: "yyz", Scope: 'I', Name: "Ayizi"},
+	{Code: "yzg", Scope: 'I', Name: "E'ma Buyang"},
+	{Code: "yzk", Scope: 'I', Name: "Zokhuo"},
+	{Code: "zaa", Scope: 'I', Name: "Sierra de Juárez Zapotec"},
+	{Code: "zab", Scope: 'I', Name: "Western Tlacolula Valley Zapotec"},
+	{Code: "zac", Scope: 'I', Name: "Ocotlán Zapotec"},
+	{Code: "zad", Scope: 'I', Name: "Cajonos Zapotec"},
+	{Code: "zae", Scope: 'I', Name: "Yareni Zapotec"},
+	{Code: "zaf", Scope: 'I', Name: "Ayoquesco Zapotec"},
+	{Code: "zag", Scope: 'I', Name: "Zaghawa"},
+	{Code: "zah", Scope: 'I', Name: "Zangwal"},
+	{Code: "zai", Scope: 'I', Macro: "zap", Name: "Isthmus Zapotec"},
+	{Code: "zaj", Scope: 'I', Name: "Zaramo"},
+	{Code: "zak", Scope: 'I', Name: "Zanaki"},
+	{Code: "zal", Scope: 'I', Name: "Zauzou"},
+	{Code: "zam", Scope: 'I', Name: "Miahuatlán Zapotec"},
+	{Code: "zao", Scope: 'I', Name: "Ozolotepec Zapotec"},
+	{Code: "zap", Part2: "zap", Scope: 'M', Name: "Zapotec"},
+	{Code: "zaq", Scope: 'I', Name: "Aloápam Zapotec"},
+	{Code: "zar", Scope: 'I', Name: "Rincón Zapotec"},
+	{Code: "zas", Scope: 'I', Name: "Santo Domingo Albarradas Zapotec"},
+	{Code: "zat", Scope: 'I', Name: "Tabaa Zapotec"},
+	{Code: "zau", Scope: 'I', Name: "Zangskari"},
+	{Code: "zav", Scope: 'I', Name: "Yatzachi Zapotec"},
+	{Code: "zaw", Scope: 'I', Name: "Mitla Zapotec"},
+	{Code: "zax", Scope: 'I', Name: "Xadani Zapotec"},
+	{Code: "zay", Scope: 'I', Name: "Zayse-Zergulla"},
+	{Code: "zaz", Scope: 'I', Name: "Zari"},
+	{Code: "zba", Scope: 'I', Name: "Balaibalan"},
+	{Code: "zbc", Scope: 'I', Name: "Central Berawan"},
+	{Code: "zbe", Scope: 'I', Name: "East Berawan"},
+	{Code: "zbl", Part2: "zbl", Scope: 'I', Name: "Blissymbols"},
+	{Code: "zbt", Scope: 'I', Name: "Batui"},
+	{Code: "zbu", Scope: 'I', Name: "Bu (Bauchi State)"},
+	{Code: "zbw", Scope: 'I', Name: "West Berawan"},
+	{Code: "zca", Scope: 'I', Name: "Coatecas Altas Zapotec"},
+	{Code: "zcd", Scope: 'I', Name: "Las Delicias Zapotec"},
+	{Code: "zch", Scope: 'I', Name: "Central Hongshuihe Zhuang"},
+	{Code: "zdj", Scope: 'I', Name: "Ngazidja Comorian"},
+	{Code: "zea", Scope: 'I', Name: "Zeeuws"},
+	{Code: "zeg", Scope: 'I', Name: "Zenag"},
+	{Code: "zeh", Scope: 'I', Name: "Eastern Hongshuihe Zhuang"},
+	{Code: "zen", Part2: "zen", Scope: 'I', Name: "Zenaga"},
+	{Code: "zga", Scope: 'I', Name: "Kinga"},
+	{Code: "zgb", Scope: 'I', Name: "Guibei Zhuang"},
+	{Code: "zgh", Part2: "zgh", Scope: 'I', Name: "Standard Moroccan Tamazight"},
+	{Code: "zgm", Scope: 'I', Name: "Minz Zhuang"},
+	{Code: "zgn", Scope: 'I', Name: "Guibian Zhuang"},
+	{Code: "zgr", Scope: 'I', Name: "Magori"},
+	{Code: "zha", Part2: "zha", Part1: "za", Scope: 'M', Name: "Zhuang"},
+	{Code: "zhb", Scope: 'I', Name: "Zhaba"},
+	{Code: "zhd", Scope: 'I', Name: "Dai Zhuang"},
+	{Code: "zhi", Scope: 'I', Name: "Zhire"},
+	{Code: "zhn", Scope: 'I', Name: "Nong Zhuang"},
+	{Code: "zho", Part2: "zho", Part1: "zh", Scope: 'M', Name: "Chinese"},
+	{Code: "zhw", Scope: 'I', Name: "Zhoa"},
+	{Code: "zia", Scope: 'I', Name: "Zia"},
+	{Code: "zib", Scope: 'I', Name: "Zimbabwe Sign Language"},
+	{Code: "zik", Scope: 'I', Name: "Zimakani"},
+	{Code: "zil", Scope: 'I', Name: "Zialo"},
+	{Code: "zim", Scope: 'I', Name: "Mesme"},
+	{Code: "zin", Scope: 'I', Name: "Zinza"},
+	{Code: "ziw", Scope: 'I', Name: "Zigula"},
+	{Code: "ziz", Scope: 'I', Name: "Zizilivakan"},
+	{Code: "zka", Scope: 'I', Name: "Kaimbulawa"},
+	{Code: "zkb", Scope: 'I', Name: "Koibal"},
+	{Code: "zkd", Scope: 'I', Name: "Kadu"},
+	{Code: "zkg", Scope: 'I', Name: "Koguryo"},
+	{Code: "zkh", Scope: 'I', Name: "Khorezmian"},
+	{Code: "zkk", Scope: 'I', Name: "Karankawa"},
+	{Code: "zkn", Scope: 'I', Name: "Kanan"},
+	{Code: "zko", Scope: 'I', Name: "Kott"},
+	{Code: "zkp", Scope: 'I', Name: "São Paulo Kaingáng"},
+	{Code: "zkr", Scope: 'I', Name: "Zakhring"},
+	{Code: "zkt", Scope: 'I', Name: "Kitan"},
+	{Code: "zku", Scope: 'I', Name: "Kaurna"},
+	{Code: "zkv", Scope: 'I', Name: "Krevinian"},
+	{Code: "zkz", Scope: 'I', Name: "Khazar"},
+	{Code: "zla", Scope: 'I', Name: "Zula"},
+	{Code: "zlj", Scope: 'I', Name: "Liujiang Zhuang"},
+	{Code: "zlm", Scope: 'I', Macro: "ms", Name: "Malay (individual language)"},
+	{Code: "zln", Scope: 'I', Name: "Lianshan Zhuang"},
+	{Code: "zlq", Scope: 'I', Name: "Liuqian Zhuang"},
+	{Code: "zma", Scope: 'I', Name: "Manda (Australia)"},
+	{Code: "zmb", Scope: 'I', Name: "Zimba"},
+	{Code: "zmc", Scope: 'I', Name: "Margany"},
+	{Code: "zmd", Scope: 'I', Name: "Maridan"},
+	{Code: "zme", Scope: 'I', Name: "Mangerr"},
+	{Code: "zmf", Scope: 'I', Name: "Mfinu"},
+	{Code: "zmg", Scope: 'I', Name: "Marti Ke"},
+	{Code: "zmh", Scope: 'I', Name: "Makolkol"},
+	{Code: "zmi", Scope: 'I', Macro: "ms", Name: "Negeri Sembilan Malay"},
+	{Code: "zmj", Scope: 'I', Name: "Maridjabin"},
+	{Code: "zmk", Scope: 'I', Name: "Mandandanyi"},
+	{Code: "zml", Scope: 'I', Name: "Matngala"},
+	{Code: "zmm", Scope: 'I', Name: "Marimanindji"},
+	{Code: "zmn", Scope: 'I', Name: "Mbangwe"},
+	{Code: "zmo", Scope: 'I', Name: "Molo"},
+	{Code: "zmp", Scope: 'I', Name: "Mpuono"},
+	{Code: "zmq", Scope: 'I', Name: "Mituku"},
+	{Code: "zmr", Scope: 'I', Name: "Maranunggu"},
+	{Code: "zms", Scope: 'I', Name: "Mbesa"},
+	{Code: "zmt", Scope: 'I', Name: "Maringarr"},
+	{Code: "zmu", Scope: 'I', Name: "Muruwari"},
+	{Code: "zmv", Scope: 'I', Name: "Mbariman-Gudhinma"},
+	{Code: "zmw", Scope: 'I', Name: "Mbo (Democratic Republic of Congo)"},
+	{Code: "zmx", Scope: 'I', Name: "Bomitaba"},
+	{Code: "zmy", Scope: 'I', Name: "Mariyedi"},
+	{Code: "zmz", Scope: 'I', Name: "Mbandja"},
+	{Code: "zna", Scope: 'I', Name: "Zan Gula"},
+	{Code: "zne", Scope: 'I', Name: "Zande (individual language)"},
+	{Code: "zng", Scope: 'I', Name: "Mang"},
+	{Code: "znk", Scope: 'I', Name: "Manangkari"},
+	{Code: "zns", Scope: 'I', Name: "Mangas"},
+	{Code: "zoc", Scope: 'I', Name: "Copainalá Zoque"},
+	{Code: "zoh", Scope: 'I', Name: "Chimalapa Zoque"},
+	{Code: "zom", Scope: 'I', Name: "Zou"},
+	{Code: "zoo", Scope: 'I', Name: "Asunción Mixtepec Zapotec"},
+	{Code: "zoq", Scope: 'I', Name: "Tabasco Zoque"},
+	{Code: "zor", Scope: 'I', Name: "Rayón Zoque"},
+	{Code: "zos", Scope: 'I', Name: "Francisco León Zoque"},
+	{Code: "zpa", Scope: 'I', Name: "Lachiguiri Zapotec"},
+	{Code: "zpb", Scope: 'I', Name: "Yautepec Zapotec"},
+	{Code: "zpc", Scope: 'I', Name: "Choapan Zapotec"},
+	{Code: "zpd", Scope: 'I', Name: "Southeastern Ixtlán Zapotec"},
+	{Code: "zpe", Scope: 'I', Name: "Petapa Zapotec"},
+	{Code: "zpf", Scope: 'I', Name: "San Pedro Quiatoni Zapotec"},
+	{Code: "zpg", Scope: 'I', Name: "Guevea De Humboldt Zapotec"},
+	{Code: "zph", Scope: 'I', Name: "Totomachapan Zapotec"},
+	{Code: "zpi", Scope: 'I', Name: "Santa María Quiegolani Zapotec"},
+	{Code: "zpj", Scope: 'I', Name: "Quiavicuzas Zapotec"},
+	{Code: "zpk", Scope: 'I', Name: "Tlacolulita Zapotec"},
+	{Code: "zpl", Scope: 'I', Name: "Lachixío Zapotec"},
+	{Code: "zpm", Scope: 'I', Name: "Mixtepec Zapotec"},
+	{Code: "zpn", Scope: 'I', Name: "Santa Inés Yatzechi Zapotec"},
+	{Code: "zpo", Scope: 'I', Name: "Amatlán Zapotec"},
+	{Code: "zpp", Scope: 'I', Name: "El Alto Zapotec"},
+	{Code: "zpq", Scope: 'I', Name: "Zoogocho Zapotec"},
+	{Code: "zpr", Scope: 'I', Name: "Santiago Xanica Zapotec"},
+	{Code: "zps", Scope: 'I', Name: "Coatlán Zapotec"},
+	{Code: "zpt", Scope: 'I', Name: "San Vicente Coatlán Zapotec"},
+	{Code: "zpu", Scope: 'I', Name: "Yalálag Zapotec"},
+	{Code: "zpv", Scope: 'I', Name: "Chichicapan Zapotec"},
+	{Code: "zpw", Scope: 'I', Name: "Zaniza Zapotec"},
+	{Code: "zpx", Scope: 'I', Name: "San Baltazar Loxicha Zapotec"},
+	{Code: "zpy", Scope: 'I', Name: "Mazaltepec Zapotec"},
+	{Code: "zpz", Scope: 'I', Name: "Texmelucan Zapotec"},
+	{Code: "zqe", Scope: 'I', Name: "Qiubei Zhuang"},
+	{Code: "zra", Scope: 'I', Name: "Kara (Korea)"},
+	{Code: "zrg", Scope: 'I', Name: "Mirgan"},
+	{Code: "zrn", Scope: 'I', Name: "Zerenkel"},
+	{Code: "zro", Scope: 'I', Name: "Záparo"},
+	{Code: "zrp", Scope: 'I', Name: "Zarphatic"},
+	{Code: "zrs", Scope: 'I', Name: "Mairasi"},
+	{Code: "zsa", Scope: 'I', Name: "Sarasira"},
+	{Code: "zsk", Scope: 'I', Name: "Kaskean"},
+	{Code: "zsl", Scope: 'I', Name: "Zambian Sign Language"},
+	{Code: "zsm", Scope: 'I', Macro: "ms", Name: "Standard Malay"},
+	{Code: "zsr", Scope: 'I', Name: "Southern Rincon Zapotec"},
+	{Code: "zsu", Scope: 'I', Name: "Sukurum"},
+	{Code: "zte", Scope: 'I', Name: "Elotepec Zapotec"},
+	{Code: "ztg", Scope: 'I', Name: "Xanaguía Zapotec"},
+	{Code: "ztl", Scope: 'I', Name: "Lapaguía-Guivini Zapotec"},
+	{Code: "ztm", Scope: 'I', Name: "San Agustín Mixtepec Zapotec"},
+	{Code: "ztn", Scope: 'I', Name: "Santa Catarina Albarradas Zapotec"},
+	{Code: "ztp", Scope: 'I', Name: "Loxicha Zapotec"},
+	{Code: "ztq", Scope: 'I', Name: "Quioquitani-Quierí Zapotec"},
+	{Code: "zts", Scope: 'I', Name: "Tilquiapan Zapotec"},
+	{Code: "ztt", Scope: 'I', Name: "Tejalapan Zapotec"},
+	{Code: "ztu", Scope: 'I', Name: "Güilá Zapotec"},
+	{Code: "ztx", Scope: 'I', Name: "Zaachila Zapotec"},
+	{Code: "zty", Scope: 'I', Name: "Yatee Zapotec"},
+	{Code: "zua", Scope: 'I', Name: "Zeem"},
+	{Code: "zuh", Scope: 'I', Name: "Tokano"},
+	{Code: "zul", Part2: "zul", Part1: "zu", Scope: 'I', Name: "Zulu"},
+	{Code: "zum", Scope: 'I', Name: "Kumzari"},
+	{Code: "zun", Part2: "zun", Scope: 'I', Name: "Zuni"},
+	{Code: "zuy", Scope: 'I', Name: "Zumaya"},
+	{Code: "zwa", Scope: 'I', Name: "Zay"},
+	{Code: "zxx", Part2: "zxx", Scope: 'S', Name: "No linguistic content"},
+	{Code: "zyb", Scope: 'I', Macro: "za", Name: "Yongbei Zhuang"},
+	{Code: "zyg", Scope: 'I', Name: "Yang Zhuang"},
+	{Code: "zyj", Scope: 'I', Name: "Youjiang Zhuang"},
+	{Code: "zyn", Scope: 'I', Name: "Yongnan Zhuang"},
+	{Code: "zyp", Scope: 'I', Name: "Zyphe Chin"},
+	{Code: "zza", Part2: "zza", Scope: 'M', Name: "Zaza"},
+	{Code: "zzj", Scope: 'I', Name: "Zuojiang Zhuang"},
+}
+
+var languages5 = []LanguageGroup{
+	{Code: "aav", Name: "Austro-Asiatic languages"},
+	{Code: "afa", Name: "Afro-Asiatic languages"},
+	{Code: "alg", Name: "Algonquian languages"},
+	{Code: "alv", Name: "Atlantic-Congo languages"},
+	{Code: "apa", Name: "Apache languages"},
+	{Code: "aqa", Name: "Alacalufan languages"},
+	{Code: "aql", Name: "Algic languages"},
+	{Code: "art", Name: "Artificial languages"},
+	{Code: "ath", Name: "Athapascan languages"},
+	{Code: "auf", Name: "Arauan languages"},
+	{Code: "aus", Name: "Australian languages"},
+	{Code: "awd", Name: "Arawakan languages"},
+	{Code: "azc", Name: "Uto-Aztecan languages"},
+	{Code: "bad", Name: "Banda languages"},
+	{Code: "bai", Name: "Bamileke languages"},
+	{Code: "bat", Name: "Baltic languages"},
+	{Code: "ber", Name: "Berber languages"},
+	{Code: "bih", Name: "Bihari languages"},
+	{Code: "bnt", Name: "Bantu languages"},
+	{Code: "btk", Name: "Batak languages"},
+	{Code: "cai", Name: "Central American Indian languages"},
+	{Code: "cau", Name: "Caucasian languages"},
+	{Code: "cba", Name: "Chibchan languages"},
+	{Code: "ccn", Name: "North Caucasian languages"},
+	{Code: "ccs", Name: "South Caucasian languages"},
+	{Code: "cdc", Name: "Chadic languages"},
+	{Code: "cdd", Name: "Caddoan languages"},
+	{Code: "cel", Name: "Celtic languages"},
+	{Code: "cmc", Name: "Chamic languages"},
+	{Code: "cpe", Name: "Creoles and pidgins, English‑based"},
+	{Code: "cpf", Name: "Creoles and pidgins, French‑based"},
+	{Code: "cpp", Name: "Creoles and pidgins, Portuguese-based"},
+	{Code: "crp", Name: "Creoles and pidgins"},
+	{Code: "csu", Name: "Central Sudanic languages"},
+	{Code: "cus", Name: "Cushitic languages"},
+	{Code: "day", Name: "Land Dayak languages"},
+	{Code: "dmn", Name: "Mande languages"},
+	{Code: "dra", Name: "Dravidian languages"},
+	{Code: "egx", Name: "Egyptian languages"},
+	{Code: "esx", Name: "Eskimo-Aleut languages"},
+	{Code: "euq", Name: "Basque (family)"},
+	{Code: "fiu", Name: "Finno-Ugrian languages"},
+	{Code: "fox", Name: "Formosan languages"},
+	{Code: "gem", Name: "Germanic languages"},
+	{Code: "gme", Name: "East Germanic languages"},
+	{Code: "gmq", Name: "North Germanic languages"},
+	{Code: "gmw", Name: "West Germanic languages"},
+	{Code: "grk", Name: "Greek languages"},
+	{Code: "hmx", Name: "Hmong-Mien languages"},
+	{Code: "hok", Name: "Hokan languages"},
+	{Code: "hyx", Name: "Armenian (family)"},
+	{Code: "iir", Name: "Indo-Iranian languages"},
+	{Code: "ijo", Name: "Ijo languages"},
+	{Code: "inc", Name: "Indic languages"},
+	{Code: "ine", Name: "Indo-European languages"},
+	{Code: "ira", Name: "Iranian languages"},
+	{Code: "iro", Name: "Iroquoian languages"},
+	{Code: "itc", Name: "Italic languages"},
+	{Code: "jpx", Name: "Japanese (family)"},
+	{Code: "kar", Name: "Karen languages"},
+	{Code: "kdo", Name: "Kordofanian languages"},
+	{Code: "khi", Name: "Khoisan languages"},
+	{Code: "kro", Name: "Kru languages"},
+	{Code: "map", Name: "Austronesian languages"},
+	{Code: "mkh", Name: "Mon-Khmer languages"},
+	{Code: "mno", Name: "Manobo languages"},
+	{Code: "mun", Name: "Munda languages"},
+	{Code: "myn", Name: "Mayan languages"},
+	{Code: "nah", Name: "Nahuatl languages"},
+	{Code: "nai", Name: "North American Indian languages"},
+	{Code: "ngf", Name: "Trans-New Guinea languages"},
+	{Code: "nic", Name: "Niger-Kordofanian languages"},
+	{Code: "nub", Name: "Nubian languages"},
+	{Code: "omq", Name: "Oto-Manguean languages"},
+	{Code: "omv", Name: "Omotic languages"},
+	{Code: "oto", Name: "Otomian languages"},
+	{Code: "paa", Name: "Papuan languages"},
+	{Code: "phi", Name: "Philippine languages"},
+	{Code: "plf", Name: "Central Malayo-Polynesian languages"},
+	{Code: "poz", Name: "Malayo-Polynesian languages"},
+	{Code: "pqe", Name: "Eastern Malayo-Polynesian languages"},
+	{Code: "pqw", Name: "Western Malayo-Polynesian languages"},
+	{Code: "pra", Name: "Prakrit languages"},
+	{Code: "qwe", Name: "Quechuan (family)"},
+	{Code: "roa", Name: "Romance languages"},
+	{Code: "sai", Name: "South American Indian languages"},
+	{Code: "sal", Name: "Salishan languages"},
+	{Code: "sdv", Name: "Eastern Sudanic languages"},
+	{Code: "sem", Name: "Semitic languages"},
+	{Code: "sgn", Name: "sign languages"},
+	{Code: "sio", Name: "Siouan languages"},
+	{Code: "sit", Name: "Sino-Tibetan languages"},
+	{Code: "sla", Name: "Slavic languages"},
+	{Code: "smi", Name: "Sami languages"},
+	{Code: "son", Name: "Songhai languages"},
+	{Code: "sqj", Name: "Albanian languages"},
+	{Code: "ssa", Name: "Nilo-Saharan languages"},
+	{Code: "syd", Name: "Samoyedic languages"},
+	{Code: "tai", Name: "Tai languages"},
+	{Code: "tbq", Name: "Tibeto-Burman languages"},
+	{Code: "trk", Name: "Turkic languages"},
+	{Code: "tup", Name: "Tupi languages"},
+	{Code: "tut", Name: "Altaic languages"},
+	{Code: "tuw", Name: "Tungus languages"},
+	{Code: "urj", Name: "Uralic languages"},
+	{Code: "wak", Name: "Wakashan languages"},
+	{Code: "wen", Name: "Sorbian languages"},
+	{Code: "xgn", Name: "Mongolian languages"},
+	{Code: "xnd", Name: "Na-Dene languages"},
+	{Code: "ypk", Name: "Yupik languages"},
+	{Code: "zhx", Name: "Chinese (family)"},
+	{Code: "zle", Name: "East Slavic languages"},
+	{Code: "zls", Name: "South Slavic languages"},
+	{Code: "zlw", Name: "West Slavic languages"},
+	{Code: "znd", Name: "Zande languages"},
+}
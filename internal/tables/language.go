@@ -7,10 +7,19 @@ import (
 
 var ISO639_1 = make(map[string]Language)
 var ISO639_2 = make(map[string]Language)
+var ISO639_3 = make(map[string]Language3)
+var ISO639_5 = make(map[string]LanguageGroup)
 var Alpha = make(map[string]*regexp.Regexp)
 var Alnum = make(map[string]*regexp.Regexp)
 
 func init() {
+	for _, lang := range languages3 {
+		ISO639_3[lang.Code] = lang
+	}
+	for _, group := range languages5 {
+		ISO639_5[group.Code] = group
+	}
+
 	for _, lang := range languages {
 		// set of ISO 639‑2 (two letter codes)
 		ISO639_2[lang.Code3] = lang
@@ -52,6 +61,24 @@ type Language struct {
 	Decimal  rune   // the decimal separator of the language
 }
 
+// Language3 represents a language of the ISO 639-3 standard.
+type Language3 struct {
+	Code  string // ISO 639-3 (three letter codes)
+	Part2 string // ISO 639-2/T code, if any
+	Part1 string // ISO 639-1 code, if any
+	Scope byte   // 'I' individual, 'M' macrolanguage, or 'S' special
+	// The macrolanguage of an individual language represented by its
+	// ISO 639-1 code, if it has one, otherwise by its ISO 639-2 code.
+	Macro string
+	Name  string // english name of the language
+}
+
+// LanguageGroup represents a language family or group of the ISO 639-5 standard.
+type LanguageGroup struct {
+	Code string // ISO 639-5 (three letter codes)
+	Name string // english name of the group
+}
+
 var languages = []Language{
 	{Code3: "aar", Code2: "aa", Name: "Afar",
 		Alphabet: `A B C D E F G H I J K L M N O P Q R S T U V W X Y Z`,
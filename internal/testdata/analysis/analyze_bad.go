@@ -1,6 +1,8 @@
 package analysis
 
 import (
+	"time"
+
 	"github.com/frk/isvalid/internal/testdata/mypkg"
 )
 
@@ -85,8 +87,8 @@ type AnalysisTestBAD_TypeKindStringURLValidator struct {
 	F int `is:"url"`
 }
 
-type AnalysisTestBAD_RuleOptionNumPANValidator struct {
-	F string `is:"pan:foo"`
+type AnalysisTestBAD_RuleOptionValueCardNetworkPANValidator struct {
+	F string `is:"pan:visa:foo"`
 }
 
 type AnalysisTestBAD_TypeKindStringPANValidator struct {
@@ -789,3 +791,188 @@ type AnalysisTestBAD_RuleOptionCountSubfieldValidator struct {
 		F string `is:"email:foo"`
 	}
 }
+
+type AnalysisTestBAD_RuleFuncTypeArgValidator struct {
+	F []string `is:"myoneof"`
+}
+
+type AnalysisTestBAD_RuleFuncTypeArg2Validator struct {
+	F bool `is:"myrange:1:2"`
+}
+
+type AnalysisTestBAD_RuleFuncTypeArg3Validator[T any] struct {
+	F T `is:"myoneof"`
+}
+
+type AnalysisTestBAD_RuleFuncOptionTypeTypeParamValidator[T ~int | ~string] struct {
+	F T `is:"myrange:1:10"`
+}
+
+type AnalysisTestBAD_RuleFieldTypeParamValidator[T comparable] struct {
+	F T `is:"required"`
+}
+
+type AnalysisTestBAD_RuleFieldNonTimeValidator struct {
+	F string `is:"before:@now"`
+}
+
+type AnalysisTestBAD_RuleFieldNonTime2Validator struct {
+	F time.Duration `is:"future"`
+}
+
+type AnalysisTestBAD_RuleOptionTypeTimeValidator struct {
+	F time.Time `is:"after:2006"`
+}
+
+type AnalysisTestBAD_RuleOptionTypeTime2Validator struct {
+	F time.Time `is:"before:&G"`
+	G *time.Time
+}
+
+type AnalysisTestBAD_RuleOptionTypeTime3Validator struct {
+	F time.Duration `is:"within:1h:@now"`
+}
+
+type AnalysisTestBAD_RuleOptionValueDurationValidator struct {
+	F time.Time `is:"within:-1h"`
+}
+
+type AnalysisTestBAD_RuleOptionValueDuration2Validator struct {
+	F time.Time `is:"within:&G"`
+	G int64
+}
+
+type AnalysisTestBAD_RuleOptionValueWeekdayValidator struct {
+	F time.Time `is:"weekday:mon:fun"`
+}
+
+type AnalysisTestBAD_RuleOptionValueWeekday2Validator struct {
+	F time.Time `is:"weekday:mon:Monday"`
+}
+
+type AnalysisTestBAD_RuleOptionValueHoursValidator struct {
+	F time.Time `is:"businesshours:9"`
+}
+
+type AnalysisTestBAD_RuleOptionValueHours2Validator struct {
+	F time.Time `is:"businesshours:17:9"`
+}
+
+type AnalysisTestBAD_RuleOptionValueTimeZoneValidator struct {
+	F string `is:"iso8601:Mars/Olympus"`
+}
+
+type AnalysisTestBAD_RuleOptionValueTimeZone2Validator struct {
+	F string `is:"datetime:\"2006-01-02 15:04 -0700\":UTC:\"+25:00\""`
+}
+
+type AnalysisTestBAD_RuleFieldNonUniqueValidator struct {
+	F string `is:"unique"`
+}
+
+type AnalysisTestBAD_RuleFieldNonUnique2Validator struct {
+	F [][]string `is:"unique"`
+}
+
+type AnalysisTestBAD_RuleOptionValueUniqueKeyValidator struct {
+	F []struct{ ID int } `is:"unique:Name"`
+}
+
+type AnalysisTestBAD_RuleFieldNonCollectionValidator struct {
+	F string `is:"any(email)"`
+}
+
+type AnalysisTestBAD_RuleInnerRulesValidator struct {
+	F []string `is:"any"`
+}
+
+type AnalysisTestBAD_RuleInnerRules2Validator struct {
+	F [][]string `is:"all([]email)"`
+}
+
+type AnalysisTestBAD_RuleInnerRules3Validator struct {
+	F [][]string `is:"any(none(email))"`
+}
+
+type AnalysisTestBAD_RuleTagGroupUnclosedValidator struct {
+	F []string `is:"atleast:1(required"`
+}
+
+type AnalysisTestBAD_RuleOptionValueElemFieldValidator struct {
+	F []struct{ ID int } `is:"exactly:1:Name(required)"`
+}
+
+type AnalysisTestBAD_RuleQuantOptionTypeValidator struct {
+	F []string `is:"atleast:foo(email)"`
+}
+
+type AnalysisTestBAD_RuleGroupNonBlankValidator struct {
+	F1 string `is:"oneof:F1:F2"`
+	F2 string
+}
+
+type AnalysisTestBAD_RuleGroupFieldTypeValidator struct {
+	F1 string
+	F2 struct{ ID int }
+
+	_ struct{} `is:"anyof:F1:F2"`
+}
+
+type AnalysisTestBAD_RuleGroupOptionFieldUnknownValidator struct {
+	F1 string
+	F2 string
+
+	_ struct{} `is:"allornone:F1:F3"`
+}
+
+type AnalysisTestBAD_RuleBlankNonGroupValidator struct {
+	F1 string
+	F2 string
+
+	_ struct{} `is:"oneof:F1:F2,required"`
+}
+
+// isvalid:rule F3 lt:&F2
+type AnalysisTestBAD_StructRuleFieldUnknownValidator struct {
+	F1 int
+	F2 int
+}
+
+// isvalid:rule F1 []gt:&F2
+type AnalysisTestBAD_StructRuleTagValidator struct {
+	F1 []int
+	F2 int
+}
+
+// isvalid:rule F1
+type AnalysisTestBAD_StructRuleTag2Validator struct {
+	F1 int
+	F2 int
+}
+
+// isvalid:rule F1 lt:&F3
+type AnalysisTestBAD_StructRuleOptionFieldUnknownValidator struct {
+	F1 int
+	F2 int
+}
+
+type AnalysisTestBAD_RuleNumberStringOptionTypeValidator struct {
+	F string `is:"int,gt:abc"`
+}
+
+type AnalysisTestBAD_RuleDecimalScaleFieldValidator struct {
+	F string `is:"scale:2"`
+}
+
+type AnalysisTestBAD_RuleDecimalPrecisionFieldValidator struct {
+	F float64 `is:"precision:10"`
+}
+
+type AnalysisTestBAD_RuleDecimalScaleOptionTypeValidator struct {
+	F string `is:"decimal,scale:-1"`
+}
+
+type AnalysisTestBAD_RuleDecimalOptionFieldTypeValidator struct {
+	S string
+	F string `is:"decimal,lte:&S"`
+}
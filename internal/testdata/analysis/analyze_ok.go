@@ -37,3 +37,8 @@ type AnalysisTestOK_Context2Validator struct {
 	F       string `is:"required"`
 	Context string
 }
+
+type AnalysisTestOK_GenericValidator[T ~int | ~string] struct {
+	F1 T `is:"myoneof:&F2"`
+	F2 T
+}
@@ -0,0 +1,10 @@
+package testdata
+
+import (
+	"github.com/frk/isvalid/l10n/address"
+)
+
+type AddressValidator struct {
+	F1 address.Address  `is:"address"`
+	F2 *address.Address `is:"address"`
+}
@@ -0,0 +1,9 @@
+package testdata
+
+type BICValidator struct {
+	IBAN string `is:"iban"`
+
+	F1 string  `is:"bic"`
+	F2 string  `is:"bictest"`
+	F3 *string `is:"biciban:&IBAN"`
+}
@@ -0,0 +1,12 @@
+package testdata
+
+type DateTimeValidator struct {
+	Zone string
+
+	F1 string  `is:"iso8601"`
+	F2 *string `is:"iso8601:Z:\"+02:00\":Europe/Berlin"`
+	F3 string  `is:"rfc3339"`
+	F4 string  `is:"rfc3339:UTC:&Zone"`
+	F5 string  `is:"datetime:\"2006-01-02\""`
+	F6 string  `is:"required,datetime:\"02 Jan 06 15:04 MST\":CET:America/New_York"`
+}
@@ -0,0 +1,16 @@
+package testdata
+
+type DecimalValidator struct {
+	Limit float64
+	Count int
+	Small int64
+	Big   uint64
+
+	F1 string  `is:"decimal,rng:0.01:1000000.00,scale:2"`
+	F2 string  `is:"decimal:de,gt:0,precision:18"`
+	F3 *string `is:"decimal,lte:&Limit,gte:&Count"`
+	F4 string  `is:"scale:4,decimal:en,min:-0.0001,max:12345678901234567890.1234"`
+	F5 string  `is:"decimal,rng:&Count:&Limit"`
+	F6 string  `is:"decimal,rng:&Small:&Big"`
+	F7 string  `is:"decimal,gte:0.01"`
+}
@@ -0,0 +1,25 @@
+package testdata
+
+type GenericPage[T any] struct {
+	Items []T
+	Total int `is:"min:0"`
+}
+
+type GenericItem struct {
+	Name string
+}
+
+type GenericValidator struct {
+	F1 int     `is:"myrange:1:10"`
+	F2 *string `is:"myrange:a:z"`
+	F3 []int   `is:"myunique"`
+	F4 string  `is:"myoneof:foo:bar:baz"`
+	F5 GenericPage[GenericItem]
+}
+
+type GenericTypeParamValidator[T ~int | ~int64, U comparable] struct {
+	F1 T `is:"myrange:1:10"`
+	F2 U `is:"myoneof:&F3"`
+	F3 U
+	F4 []T `is:"[]myrange:0:100"`
+}
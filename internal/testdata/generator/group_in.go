@@ -0,0 +1,32 @@
+package testdata
+
+import (
+	"time"
+)
+
+type GroupValidator struct {
+	Email    string
+	Phone    *string
+	Username []byte
+	Address  *groupAddress
+	Since    time.Time
+	Age      uint8
+	context  string
+
+	_ struct{} `is:"oneof:Email:Phone:Username"`
+	_ struct{} `is:"anyof:&Since:Age,allornone:Address.City:Address.Zip:@create"`
+}
+
+type groupAddress struct {
+	City string
+	Zip  *int
+}
+
+type GroupErrorValidator struct {
+	F1 string `is:"email"`
+	F2 *int
+	F3 map[string]string
+
+	_  struct{} `is:"oneof:F1:F2:F3"`
+	ec errorConstructor
+}
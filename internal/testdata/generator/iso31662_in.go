@@ -0,0 +1,9 @@
+package testdata
+
+type ISO31662Validator struct {
+	Country string
+
+	F1 string  `is:"iso31662"`
+	F2 string  `is:"iso31662:&Country"`
+	F3 *string `is:"iso31662:us:ca"`
+}
@@ -0,0 +1,7 @@
+package testdata
+
+type ISO639Validator struct {
+	F1 string  `is:"iso639"`
+	F2 string  `is:"iso639:3"`
+	F3 *string `is:"iso639:5"`
+}
@@ -0,0 +1,15 @@
+package testdata
+
+type NumberStringValidator struct {
+	Max int32
+
+	F1 string   `is:"int,gt:10"`
+	F2 *string  `is:"lte:&Max,uint"`
+	F3 string   `is:"float,rng:-1.5:1.5,len::8"`
+	F4 string   `is:"decimal:de,min:0.01,max:1000"`
+	F5 []string `is:"[]required,int,gte:0"`
+	F6 numStr   `is:"uint,lt:100"`
+	F7 string   `is:"int"`
+}
+
+type numStr string
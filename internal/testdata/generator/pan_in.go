@@ -4,4 +4,6 @@ type PANValidator struct {
 	F1 string   `is:"pan"`
 	F2 **string `is:"pan"`
 	F3 **string `is:"required,pan"`
+	F4 string   `is:"pan:visa:mastercard"`
+	F5 string   `is:"cardexpiry"`
 }
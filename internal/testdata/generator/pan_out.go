@@ -20,5 +20,11 @@ func (v PANValidator) Validate() error {
 	} else if !isvalid.PAN(**v.F3) {
 		return errors.New("F3 must be a valid PAN")
 	}
+	if !isvalid.PAN(v.F4, "visa", "mastercard") {
+		return errors.New("F4 must be a valid PAN")
+	}
+	if !isvalid.CardExpiry(v.F5) {
+		return errors.New("F5 must be a valid, unexpired card expiry date")
+	}
 	return nil
 }
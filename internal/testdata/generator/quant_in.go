@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"time"
+)
+
+type QuantValidator struct {
+	Min uint
+
+	F1  []string       `is:"any(email)"`
+	F2  []*string      `is:"all(required,email)"`
+	F3  map[string]int `is:"none(gt:100)"`
+	F4  []int          `is:"atleast:2(rng:1:10)"`
+	F5  *[]int         `is:"atmost:3(eq:0)"`
+	F6  []quantElem    `is:"exactly:1:IsPrimary(eq:true)"`
+	F7  []*quantElem   `is:"atleast:1:Name(prefix:foo:bar)"`
+	F8  []string       `is:"required,atleast:&Min(len:2:)"`
+	F9  [][]string     `is:"any(notnil),none(len:0),[][]email"`
+	F10 []string       `is:"len::10,unique,none(eq:\"a,b\")"`
+	F11 []string       `is:"any(int,gt:5)"`
+	F12 []string       `is:"all(decimal,lte:10)"`
+	F13 []time.Time    `is:"any(within:1h:@now)"`
+}
+
+type quantElem struct {
+	IsPrimary bool
+	Name      string
+}
@@ -0,0 +1,32 @@
+package testdata
+
+import (
+	"time"
+)
+
+// isvalid:rule StartDate before:&EndDate
+// isvalid:rule MinAge lte:&MaxAge
+// isvalid:rule Limits.Min required,lt:&Limits.Max
+type StructRulesValidator struct {
+	StartDate time.Time `is:"required"`
+	EndDate   time.Time `is:"required"`
+	MinAge    *int
+	MaxAge    int
+	Limits    *structRulesLimits
+}
+
+type structRulesLimits struct {
+	Min int
+	Max int
+}
+
+// isvalid:rule Password eq:&Confirm
+// isvalid:rule Tags atleast:&Min(ne:&Exclude)
+type StructRulesErrorValidator struct {
+	Password string `is:"len:8:"`
+	Confirm  string
+	Tags     []string
+	Min      int
+	Exclude  string
+	ec       errorConstructor
+}
@@ -0,0 +1,25 @@
+package testdata
+
+import (
+	"time"
+)
+
+type TimeValidator struct {
+	Start  time.Time
+	MaxAge time.Duration
+
+	F1  time.Time      `is:"before:@now"`
+	F2  *time.Time     `is:"after:&Start"`
+	F3  time.Time      `is:"future"`
+	F4  *time.Time     `is:"required,past"`
+	F5  time.Time      `is:"within:24h"`
+	F6  time.Time      `is:"within:90m:&Start"`
+	F7  time.Duration  `is:"within:&MaxAge"`
+	F8  *time.Duration `is:"within:1500ms"`
+	F9  time.Time      `is:"weekday"`
+	F10 time.Time      `is:"weekday:sat:Sunday"`
+	F11 time.Time      `is:"businesshours"`
+	F12 *time.Time     `is:"businesshours:8:20"`
+	F13 time.Duration  `is:"max:2h30m"`
+	F14 []time.Time    `is:"[]before:&Start"`
+}
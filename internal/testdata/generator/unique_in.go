@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	"time"
+)
+
+type UniqueValidator struct {
+	F1  []string            `is:"unique"`
+	F2  *[]int              `is:"unique"`
+	F3  [4]time.Duration    `is:"unique"`
+	F4  map[string]int      `is:"unique"`
+	F5  []string            `is:"required,unique,[]email"`
+	F6  *[]string           `is:"len:1:10,unique"`
+	F7  []uniqueElem        `is:"unique:ID"`
+	F8  []*uniqueElem       `is:"unique:Name"`
+	F9  map[int]*uniqueElem `is:"unique:ID"`
+	F10 map[string]string   `is:"unique,[]required"`
+}
+
+type uniqueElem struct {
+	ID   int
+	Name string
+}
@@ -0,0 +1,9 @@
+package testdata
+
+type ZipInValidator struct {
+	Country string
+	State   string
+
+	F1 string  `is:"zipin:&State:&Country"`
+	F2 *string `is:"zipin:CA:us"`
+}
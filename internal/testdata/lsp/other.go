@@ -0,0 +1,6 @@
+package lsp
+
+type AdminValidator struct {
+	Name    string `is:"required:@create"`
+	context string
+}
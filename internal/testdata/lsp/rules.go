@@ -0,0 +1,16 @@
+package lsp
+
+import (
+	"strings"
+)
+
+// UserID reports whether or not v is a valid user id.
+//
+//	isvalid:rule
+//	{
+//		"name": "userid",
+//		"err": { "text": "must be a valid user id" }
+//	}
+func UserID(v string) bool {
+	return strings.HasPrefix(v, "u_")
+}
@@ -0,0 +1,16 @@
+package lsp
+
+import (
+	"time"
+)
+
+type UserValidator struct {
+	Name    string    `is:"required,len:1:32"`
+	Email   string    `is:"email,len::64:@update"`
+	Start   time.Time `is:"before:&Finish"`
+	Finish  time.Time
+	Address struct {
+		City string `is:"required"`
+	}
+	context string
+}
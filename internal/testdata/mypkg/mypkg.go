@@ -1,5 +1,9 @@
 package mypkg
 
+import (
+	"cmp"
+)
+
 // rules
 
 func MyRule(v string) bool {
@@ -32,6 +36,28 @@ func MyBadRule3(v int64, i int, f float64, s string, b bool) (bool, error) {
 	return false, nil
 }
 
+func MyBadRule4[T, U any](v T) bool {
+	// ...
+	return false
+}
+
+// generic rules
+
+func MyRange[T cmp.Ordered](v T, min, max T) bool {
+	// ...
+	return false
+}
+
+func MyOneOf[T comparable](v T, opts ...T) bool {
+	// ...
+	return false
+}
+
+func MyUnique[S ~[]E, E comparable](v S) bool {
+	// ...
+	return false
+}
+
 // error handlers
 
 type MyErrorConstructor struct{}
@@ -3,3 +3,14 @@ package search
 func IsFoo(v string) bool {
 	return false
 }
+
+// IsBar is a rule func with config.
+//
+//	isvalid:rule
+//	{
+//		"name": "bar",
+//		"err": { "text": "must be bar" }
+//	}
+func IsBar(v string) bool {
+	return false
+}
@@ -0,0 +1,42 @@
+package search
+
+type FooValidator struct {
+	F string
+}
+
+// isvalid:ignore
+type BarValidator struct {
+	F string
+}
+
+// isvalid:target
+type CreateFooRequest struct {
+	F string
+}
+
+type UpdateFooRequest struct {
+	F string
+}
+
+type (
+	// isvalid:target
+	DeleteFooRequest struct {
+		F string
+	}
+
+	// isvalid:ignore
+	BazValidator struct {
+		F string
+	}
+)
+
+// not a struct, not a target
+type NotStructValidator string
+
+// generic, matched as is, i.e. uninstantiated
+type PageValidator[T any] struct {
+	Items []T
+}
+
+// generic but not a struct, not a target
+type ListValidator[T any] []T
@@ -9,17 +9,24 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"math/big"
 	"net"
 	"net/mail"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
 	"github.com/frk/isvalid/internal/algo"
+	"github.com/frk/isvalid/internal/bcp47"
 	"github.com/frk/isvalid/internal/cldr"
 	"github.com/frk/isvalid/internal/tables"
+	"github.com/frk/isvalid/l10n/address"
 	"github.com/frk/isvalid/l10n/country"
 )
 
@@ -108,9 +115,54 @@ func Alnum(v string, lang string) bool {
 	return false
 }
 
-var rxBIC = regexp.MustCompile(`^[A-z]{4}[A-z]{2}\w{2}(\w{3})?$`)
+// Address reports whether or not v is a valid postal address according to
+// the address format of its country. For details on what is checked see the
+// documentation of the l10n/address package's Validate function. The addresses
+// of the countries whose address format is not known are not valid.
+//
+//	isvalid:rule
+//	{
+//		"name": "address",
+//		"err": { "text": "must be a valid address" }
+//	}
+func Address(v address.Address) bool {
+	return address.Valid(v)
+}
+
+// BCP47 reports whether or not v is a valid BCP 47 language tag. The tag's
+// subtags are checked against the IANA Language Subtag Registry, that is,
+// v must not only be well-formed but its language, extlang, script, region,
+// and variant subtags, and its extension singletons, must be registered.
+// Grandfathered and private use tags are also accepted. The check is
+// case-insensitive.
+//
+//	isvalid:rule
+//	{
+//		"name": "bcp47",
+//		"err": { "text": "must be a valid BCP 47 language tag" }
+//	}
+func BCP47(v string) bool {
+	return bcp47.Valid(v)
+}
+
+// CanonicalBCP47 returns the canonical form of the BCP 47 language tag v,
+// e.g. "zh-cmn-hant-tw" is canonicalized to "cmn-Hant-TW" and "iw" to "he".
+// CanonicalBCP47 reports false if v is not a valid BCP 47 language tag.
+func CanonicalBCP47(v string) (string, bool) {
+	return bcp47.Canonical(v)
+}
+
+var rxBIC = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z2-9][A-NP-Z0-9](?:XXX|[A-WYZ0-9][A-Z0-9]{2})?$`)
 
-// BIC reports whether or not v represents a valid Bank Identification Code or SWIFT code.
+// BIC reports whether or not v represents a valid Bank Identification Code
+// or SWIFT code as defined by ISO 9362. The code must be in upper case, its
+// country code must be a known ISO 3166-1 alpha-2 code (or "XK" for Kosovo),
+// the first character of the location code must not be "0" or "1", and its
+// second character must not be the letter "O". If present, the branch code
+// must not start with "X" unless it is "XXX", the code of the primary office.
+//
+// The BICs whose location code ends with "0" are test & training BICs, which
+// are not used for real transactions, BIC rejects them, see BICTest.
 //
 //	isvalid:rule
 //	{
@@ -118,7 +170,70 @@ var rxBIC = regexp.MustCompile(`^[A-z]{4}[A-z]{2}\w{2}(\w{3})?$`)
 //		"err": { "text": "must be a valid BIC or SWIFT code" }
 //	}
 func BIC(v string) bool {
-	return rxBIC.MatchString(v)
+	return bic(v) && v[7] != '0'
+}
+
+// BICTest is like BIC but it also accepts the test & training BICs,
+// i.e. the BICs whose location code ends with "0".
+//
+//	isvalid:rule
+//	{
+//		"name": "bictest",
+//		"err": { "text": "must be a valid BIC or SWIFT code" }
+//	}
+func BICTest(v string) bool {
+	return bic(v)
+}
+
+// bic reports whether or not v is a valid BIC, test & training BICs included.
+func bic(v string) bool {
+	if !rxBIC.MatchString(v) {
+		return false
+	}
+	if cc := v[4:6]; cc != "XK" {
+		if _, ok := country.ISO31661A_2[cc]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// the countries whose banks, in addition to the banks of the country
+// itself, may use the country's IBAN; keyed by the IBAN's country code
+var ibanBICCountries = map[string][]string{
+	"FR": {"BL", "GF", "GP", "MF", "MQ", "NC", "PF", "PM", "RE", "TF", "WF", "YT"},
+	"GB": {"GG", "IM", "JE"},
+}
+
+// BICIBAN reports whether or not v is a valid BIC, see BIC, whose country code
+// matches the country code of the given IBAN. The IBAN itself is not validated,
+// only its first two characters are compared, case-insensitively, against the
+// BIC's country code. A French or British IBAN is also matched by the BICs of
+// the territories that use French and British IBANs respectively.
+//
+//	isvalid:rule
+//	{
+//		"name": "biciban",
+//		"err": { "text": "must be a BIC of the IBAN's country" }
+//	}
+func BICIBAN(v string, iban string) bool {
+	if !BIC(v) {
+		return false
+	}
+
+	iban = strings.ToUpper(strings.TrimSpace(iban))
+	if len(iban) < 2 {
+		return false
+	}
+	if iban[:2] == v[4:6] {
+		return true
+	}
+	for _, cc := range ibanBICCountries[iban[:2]] {
+		if cc == v[4:6] {
+			return true
+		}
+	}
+	return false
 }
 
 var rxBTC = regexp.MustCompile(`^(bc1|[13])[a-zA-HJ-NP-Z0-9]{25,39}$`)
@@ -243,14 +358,31 @@ func CVV(v string) bool {
 }
 
 type CurrencyOpts struct {
+	// If set, the amount must include the currency's symbol or code.
 	NeedSym bool
+	// If set, the amount must be a valid cash amount, i.e. it must conform
+	// to the currency's cash digits and cash rounding rather than the
+	// standard ones, e.g. the cash amounts of CHF are rounded to 0.05.
+	Cash bool
 }
 
 var CurrencyOptsDefault = CurrencyOpts{
 	NeedSym: false,
+	Cash:    false,
 }
 
-// Currency reports whether or not v represents a valid Currency amount.
+// Currency reports whether or not v represents a valid amount of the currency
+// with the given ISO 4217 code, formatted according to the given locale, e.g.
+// "1.234,56 €" is a valid "eur" amount for the "de_DE" locale.
+//
+// The format is driven by the CLDR data of the locale and the currency. The
+// locale's currency pattern determines on which side of the number the currency
+// symbol goes, the symbol being the currency's symbol, its narrow symbol, or its
+// code, and whether or not it's separated from the number by a space. The locale's
+// number symbols determine the digits and the separators, and the pattern also
+// determines the size of the digit groups. If the amount has a fraction, it must
+// have exactly as many digits as the currency uses and it must conform to the
+// currency's rounding increment.
 //
 //	isvalid:rule
 //	{
@@ -258,132 +390,199 @@ var CurrencyOptsDefault = CurrencyOpts{
 //		"opts": [[
 //			{ "key": null, "value": "usd" }
 //		], [
+//			{ "key": null, "value": "en" }
+//		], [
 //			{ "key": null, "value": "nil" }
 //		]],
 //		"err": { "text": "must be a valid currency amount" }
-// 	}
-func Currency(v string, code string, opts *CurrencyOpts) bool {
+//	}
+func Currency(v string, code string, locale string, opts *CurrencyOpts) bool {
 	if len(v) == 0 || len(code) != 3 {
 		return false
 	}
 	code = strings.ToUpper(code)
-	ccy, ok := tables.ISO4217[code]
+	if _, ok := tables.ISO4217[code]; !ok {
+		return false
+	}
+	ccy, ok := cldr.Currency(code)
 	if !ok {
 		return false
 	}
+	loc, ok := cldr.Locale(locale)
+	if !ok || len(loc.CurrencyFormat) == 0 {
+		return false
+	}
 
 	if opts == nil {
 		opts = &CurrencyOptsDefault
 	}
 
-	// check for leading currency symbol
-	if ccy.Left && len(ccy.Sym) > 0 {
-		hasSym := false
-		for _, sym := range ccy.Sym {
-			if len(v) >= len(sym) && v[:len(sym)] == sym {
-				v = v[len(sym):]
-				hasSym = true
-				break
-			}
-		}
-		if !hasSym && opts.NeedSym {
-			return false
+	// split the pattern into its prefix, number, and suffix
+	pat := loc.CurrencyFormat
+	i, j := strings.IndexAny(pat, "#0"), strings.LastIndexAny(pat, "#0.")+1
+	prefix, number, suffix := pat[:i], pat[i:j], pat[j:]
+
+	if len(v) > 1 && (v[0] == '+' || v[0] == '-') {
+		v = v[1:]
+	}
+
+	// the currency symbols, longest first
+	syms := []string{code}
+	for _, sym := range []string{ccy.Symbol, ccy.NarrowSymbol} {
+		if len(sym) > 0 {
+			syms = append(syms, sym)
 		}
-		if hasSym {
-			for _, sep := range ccy.Sep {
-				if len(v) >= len(sep) && v[:len(sep)] == sep {
-					v = v[len(sep):]
-					break
+	}
+	sort.Slice(syms, func(i, j int) bool { return len(syms[i]) > len(syms[j]) })
+
+	hasSym := false
+	if strings.ContainsRune(prefix, '¤') {
+		v, hasSym = trimCurrencySymbol(v, syms, prefix, true)
+	} else if strings.ContainsRune(suffix, '¤') {
+		v, hasSym = trimCurrencySymbol(v, syms, suffix, false)
+	}
+	if !hasSym && opts.NeedSym {
+		return false
+	}
+
+	digits, rounding := ccy.Digits, ccy.Rounding
+	if opts.Cash {
+		digits, rounding = ccy.CashDigits, ccy.CashRounding
+	}
+	return currencyNumber(v, loc, number, digits, rounding)
+}
+
+// trimCurrencySymbol removes one of the currency symbols from the start, or
+// the end, of v, together with the bidi marks that may surround the symbol and
+// the space that separates it from the number. The space is accepted only if
+// the pattern's affix contains one or if the symbol is adjacent to the number
+// with a letter, e.g. "CHF 10.00", which is how CLDR's currency spacing works.
+func trimCurrencySymbol(v string, syms []string, affix string, prefix bool) (string, bool) {
+	isbidi := func(r rune) bool { return r == '\u200e' || r == '\u200f' || r == '\u061c' }
+	spaced := strings.IndexFunc(affix, isCurrencySpace) > -1
+
+	if prefix {
+		v = strings.TrimLeftFunc(v, isbidi)
+		for _, sym := range syms {
+			if strings.HasPrefix(v, sym) {
+				v = strings.TrimLeftFunc(v[len(sym):], isbidi)
+				last, _ := utf8.DecodeLastRuneInString(sym)
+				if r, size := utf8.DecodeRuneInString(v); isCurrencySpace(r) && (spaced || unicode.IsLetter(last)) {
+					v = v[size:]
 				}
+				return v, true
 			}
 		}
+		return v, false
 	}
 
-	// check for traling currency symbol
-	if ccy.Right && len(ccy.Sym) > 0 {
-		hasSym := false
-		for _, sym := range ccy.Sym {
-			if len(v) >= len(sym) && v[len(v)-len(sym):] == sym {
-				v = v[:len(v)-len(sym)]
-				hasSym = true
-				break
+	v = strings.TrimRightFunc(v, isbidi)
+	for _, sym := range syms {
+		if strings.HasSuffix(v, sym) {
+			v = strings.TrimRightFunc(v[:len(v)-len(sym)], isbidi)
+			first, _ := utf8.DecodeRuneInString(sym)
+			if r, size := utf8.DecodeLastRuneInString(v); isCurrencySpace(r) && (spaced || unicode.IsLetter(first)) {
+				v = v[:len(v)-size]
 			}
+			return v, true
 		}
-		if !hasSym && opts.NeedSym {
-			return false
+	}
+	return v, false
+}
+
+// currencyNumber reports whether or not v is a number formatted according to
+// the locale and the number part of its currency pattern, e.g. "#,##,##0.00",
+// with a fraction, if any, of the given number of digits and rounding increment.
+func currencyNumber(v string, loc cldr.LocaleInfo, number string, digits, rounding int) bool {
+	// the sizes of the primary and secondary digit groups, 0 if ungrouped
+	var primary, secondary int
+	if i := strings.LastIndexByte(number, ','); i > -1 {
+		intpart := number
+		if j := strings.IndexByte(number, '.'); j > -1 {
+			intpart = number[:j]
 		}
-		if hasSym {
-			for _, sep := range ccy.Sep {
-				if len(v) >= len(sep) && v[len(v)-len(sep):] == sep {
-					v = v[:len(v)-len(sep)]
-					break
-				}
-			}
+		primary = len(intpart) - i - 1
+		secondary = primary
+		if j := strings.LastIndexByte(intpart[:i], ','); j > -1 {
+			secondary = i - j - 1
 		}
 	}
 
-	// split into number groups
-	ngs, last := []string{}, 0
-	var thoSep rune
+	isdigit := func(r rune) bool { return r >= loc.DigitZero && r <= loc.DigitNine }
+	isgroup := func(r rune) bool {
+		return r == loc.SepGroup || (isCurrencySpace(loc.SepGroup) && isCurrencySpace(r))
+	}
 
-numLoop:
+	// split into digit groups and the fraction
+	var groups []string
+	var fraction string
+	var groupSep rune
+	var hasFraction bool
+	last := 0
 	for i, r := range v {
-		if r >= '0' && r <= '9' {
-			continue numLoop
+		if isdigit(r) {
+			continue
 		}
-		for _, sep := range ccy.ThoSep {
-			if r == sep {
-				// mixed thousands separators; fail
-				if thoSep > 0 && sep != thoSep {
-					return false
-				}
-
-				ngs = append(ngs, v[last:i])
-				last = i + utf8.RuneLen(r)
-				thoSep = sep
-				continue numLoop
+		if loc.SepGroup > 0 && primary > 0 && isgroup(r) {
+			// mixed group separators; fail
+			if groupSep > 0 && r != groupSep {
+				return false
 			}
+			groupSep = r
+			groups = append(groups, v[last:i])
+			last = i + utf8.RuneLen(r)
+			continue
 		}
-		for _, sep := range ccy.DecSep {
-			if r == sep {
-				ngs = append(ngs, v[last:i])
-				last = i + utf8.RuneLen(r)
-
-				decimal := v[last:]
-				// incorrect number of decimal digits; fail
-				if len(decimal) != ccy.DecNum {
-					return false
-				}
-				// make sure the rest is all digit
-				for _, d := range decimal {
-					if d < '0' || d > '9' {
-						return false
-					}
-				}
-				last = len(v) // no need to add decimal to ngs
-				break numLoop
+		if loc.SepDecimal > 0 && r == loc.SepDecimal {
+			groups = append(groups, v[last:i])
+			fraction = v[i+utf8.RuneLen(r):]
+			if len(fraction) == 0 || strings.IndexFunc(fraction, func(r rune) bool { return !isdigit(r) }) > -1 {
+				return false
 			}
+			hasFraction = true
+			break
 		}
 
 		// unacceptable rune; fail
 		return false
 	}
-	if last < len(v)-1 {
-		ngs = append(ngs, v[last:])
+	if !hasFraction {
+		groups = append(groups, v[last:])
 	}
 
-	for i, ng := range ngs {
-		if len(ng) == 0 {
+	for i, g := range groups {
+		n := utf8.RuneCountInString(g)
+		if n == 0 {
+			return false
+		}
+		if len(groups) > 1 {
+			if i == len(groups)-1 && n != primary {
+				return false
+			}
+			if i > 0 && i < len(groups)-1 && n != secondary {
+				return false
+			}
+			if i == 0 && n > secondary {
+				return false
+			}
+		}
+
+		// leading zero; fail
+		if r, _ := utf8.DecodeRuneInString(g); i == 0 && r == loc.DigitZero && (n > 1 || len(groups) > 1) {
 			return false
 		}
-		if i > 0 && len(ng) != 3 {
+	}
+
+	if hasFraction {
+		if utf8.RuneCountInString(fraction) != digits {
 			return false
 		}
-		if i == 0 {
-			if len(ng) > 3 && thoSep != 0 {
-				return false
+		if rounding > 0 {
+			f := 0
+			for _, r := range fraction {
+				f = f*10 + int(r-loc.DigitZero)
 			}
-			if ng[0] == '0' && (len(ng) > 1 || thoSep != 0) {
+			if f%rounding != 0 {
 				return false
 			}
 		}
@@ -391,6 +590,12 @@ numLoop:
 	return true
 }
 
+// isCurrencySpace reports whether or not r is one of the
+// spaces that can separate the parts of a currency amount.
+func isCurrencySpace(r rune) bool {
+	return r == ' ' || r == '\u00a0' || r == '\u2009' || r == '\u202f'
+}
+
 var rxDataURIMediaType = regexp.MustCompile(`^(?i)[a-z]+\/[a-z0-9\-\+]+$`)
 var rxDataURIAttribute = regexp.MustCompile(`^(?i)^[a-z\-]+=[a-z0-9\-]+$`)
 var rxDataURIData = regexp.MustCompile(`^(?i)[a-z0-9!\$&'\(\)\*\+,;=\-\._~:@\/\?%\s]*$`)
@@ -437,6 +642,26 @@ func DataURI(v string) bool {
 	return true
 }
 
+// DateTime reports whether or not v is a valid date-time string formatted according
+// to the given layout. The layout is interpreted the same way as the layout argument
+// of time.Parse, i.e. it's the reference time formatted the same way as v, and the
+// date must exist in the calendar, e.g. "2006-02-29" is not valid. If tz is not
+// empty then v must include time zone information that matches at least one of
+// the time zones in tz, see ISO8601 for the accepted time zone values.
+//
+//	isvalid:rule
+//	{
+//		"name": "datetime",
+//		"err": { "text": "must be a valid date-time string" }
+//	}
+func DateTime(v string, layout string, tz ...string) bool {
+	t, err := time.ParseInLocation(layout, v, tzNone)
+	if err != nil {
+		return false
+	}
+	return len(tz) == 0 || (t.Location() != tzNone && inTimeZone(t, tz))
+}
+
 // Decimal reports whether or not v represents a valid decimal number.
 //
 //	isvalid:rule
@@ -444,7 +669,7 @@ func DataURI(v string) bool {
 //		"name": "decimal",
 //		"opts": [[
 //			{ "key": null, "value": "en" }
-//		]]
+//		]],
 //		"err": { "text": "string content must match a decimal number" }
 //	}
 func Decimal(v string, locale string) bool {
@@ -524,6 +749,158 @@ func Decimal(v string, locale string) bool {
 	return true
 }
 
+// Dec is an arbitrary-precision decimal number. A Dec is represented by an
+// unscaled integer coefficient and a scale, the number of digits after the
+// decimal point, such that the value of the Dec is coefficient × 10^-scale.
+// Unlike a float64 a Dec represents every decimal number, e.g. a monetary
+// amount, exactly and it retains the trailing zeros of the fraction.
+type Dec struct {
+	coef  big.Int
+	scale int
+}
+
+// ParseDec parses v, a decimal number formatted according to the given locale,
+// and returns the Dec that v represents. ParseDec returns a *strconv.NumError
+// if v is not a valid decimal number as reported by Decimal.
+func ParseDec(v string, locale string) (*Dec, error) {
+	if !Decimal(v, locale) {
+		return nil, &strconv.NumError{Func: "ParseDec", Num: v, Err: strconv.ErrSyntax}
+	}
+	loc, _ := cldr.Locale(locale)
+
+	// collect the digits of the coefficient, the group separators are dropped
+	d := new(Dec)
+	b := make([]byte, 0, len(v))
+	frac := false
+	for _, r := range v {
+		if r >= loc.DigitZero && r <= loc.DigitNine {
+			b = append(b, byte('0'+(r-loc.DigitZero)))
+			if frac {
+				d.scale += 1
+			}
+		} else if loc.SepDecimal > 0 && r == loc.SepDecimal {
+			frac = true
+		} else if r == '+' || r == '-' {
+			b = append(b, byte(r))
+		}
+	}
+	if _, ok := d.coef.SetString(string(b), 10); !ok {
+		return nil, &strconv.NumError{Func: "ParseDec", Num: v, Err: strconv.ErrSyntax}
+	}
+	return d, nil
+}
+
+// MustParseDec is like ParseDec but it parses s using the "en" locale and it
+// panics if s cannot be parsed. It simplifies safe initialization of Dec values
+// from decimal literals, e.g. MustParseDec("0.01").
+func MustParseDec(s string) *Dec {
+	d, err := ParseDec(s, "en")
+	if err != nil {
+		panic(`isvalid: MustParseDec(` + strconv.Quote(s) + `): ` + err.Error())
+	}
+	return d
+}
+
+// FloatDec returns the Dec with the shortest decimal representation that
+// rounds to f. FloatDec panics if f is an infinity or NaN.
+func FloatDec(f float64) *Dec {
+	return MustParseDec(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// Cmp compares d and y and returns:
+//
+//	-1 if d <  y
+//	 0 if d == y
+//	+1 if d >  y
+//
+// The scales of d and y are irrelevant to the comparison, i.e. 1.5 == 1.50.
+func (d *Dec) Cmp(y *Dec) int {
+	if d.scale == y.scale {
+		return d.coef.Cmp(&y.coef)
+	}
+
+	// bring both coefficients to the larger of the two scales
+	x, z := new(big.Int).Set(&d.coef), new(big.Int).Set(&y.coef)
+	if d.scale < y.scale {
+		x.Mul(x, pow10(y.scale-d.scale))
+	} else {
+		z.Mul(z, pow10(d.scale-y.scale))
+	}
+	return x.Cmp(z)
+}
+
+// CmpFloat compares d and f like Cmp does. An infinite f compares greater,
+// or less, than any d. CmpFloat panics if f is NaN, a NaN cannot be ordered
+// and so the caller must check for it before the comparison.
+func (d *Dec) CmpFloat(f float64) int {
+	if math.IsNaN(f) {
+		panic("isvalid: Dec.CmpFloat(NaN)")
+	}
+	if math.IsInf(f, 1) {
+		return -1
+	}
+	if math.IsInf(f, -1) {
+		return +1
+	}
+	return d.Cmp(FloatDec(f))
+}
+
+// CmpInt compares d and i like Cmp does.
+func (d *Dec) CmpInt(i int64) int {
+	var y Dec
+	y.coef.SetInt64(i)
+	return d.Cmp(&y)
+}
+
+// CmpUint compares d and u like Cmp does.
+func (d *Dec) CmpUint(u uint64) int {
+	var y Dec
+	y.coef.SetUint64(u)
+	return d.Cmp(&y)
+}
+
+// Scale returns the number of digits after the decimal point of d,
+// including trailing zeros, e.g. the scale of 1.50 is 2.
+func (d *Dec) Scale() int {
+	return d.scale
+}
+
+// Precision returns the number of digits of the unscaled coefficient of d,
+// e.g. the precision of 123.45 is 5, and the precision of 0.01 is 1.
+func (d *Dec) Precision() int {
+	if d.coef.Sign() == 0 {
+		return 1
+	}
+	s := d.coef.Text(10)
+	if s[0] == '-' {
+		return len(s) - 1
+	}
+	return len(s)
+}
+
+// String returns the decimal representation of d using "." as the decimal
+// separator and without any group separators, e.g. "-1234.50".
+func (d *Dec) String() string {
+	s := d.coef.Text(10)
+	if d.scale == 0 {
+		return s
+	}
+
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= d.scale {
+		s = strings.Repeat("0", d.scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-d.scale] + "." + s[len(s)-d.scale:]
+}
+
+// pow10 returns 10^n as a *big.Int.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
 var rxDigits = regexp.MustCompile(`^[0-9]+$`)
 
 // Digits reports whether or not v is a string of digits.
@@ -729,6 +1106,12 @@ func HexColor(v string) bool {
 }
 
 // IBAN reports whether or not v is an International Bank Account Number.
+// The IBAN's format and length are checked against the country's format, the
+// IBAN check digits are verified, and, for the countries that have them, the
+// national check digits of the BBAN are verified as well. Both the electronic
+// format, e.g. "DE89370400440532013000", and the print format, in which the
+// characters are grouped by spaces, e.g. "DE89 3704 0044 0532 0130 00", are
+// accepted.
 //
 //	isvalid:rule
 //	{
@@ -745,6 +1128,9 @@ func IBAN(v string) bool {
 	if rx, ok := tables.IBANRegexp[v[:2]]; !ok || !rx.MatchString(v) {
 		return false
 	}
+	if check, ok := tables.BBANChecksum[v[:2]]; ok && !check(v[4:]) {
+		return false
+	}
 
 	// rearrange by moving the four initial characters to the end of the string
 	v = v[4:] + v[:4]
@@ -1102,18 +1488,20 @@ func ISIN(v string) bool {
 }
 
 // ISO639 reports whether or not v is a valid language code as defined by the
-// ISO 639 set of standards. Currently only standards 639-1 & 639-2 are supported.
-// The num argument specifies which of the supported standards should be tested.
-// The num argument can be one of the following three values:
-//	0 tests against both 639-1 & 639-2
+// ISO 639 set of standards. Currently the standards 639-1, 639-2, 639-3, and
+// 639-5 are supported. The num argument specifies which of the supported
+// standards should be tested. The num argument can be one of the following values:
+//	0 tests against all of the supported standards
 //	1 tests against 639-1 only
 //	2 tests against 639-2 only
+//	3 tests against 639-3 only
+//	5 tests against 639-5 only
 //
 // ---
 //
 //	isvalid:rule
 //	{
-//		"name": "iso369",
+//		"name": "iso639",
 //		"opts": [[ { "key": null, "value": "0" } ]],
 //		"err": { "text": "must be a valid ISO 639 value" }
 //	}
@@ -1122,7 +1510,7 @@ func ISO639(v string, num int) bool {
 		if len(v) == 2 && ISO639(v, 1) {
 			return true
 		}
-		if len(v) == 3 && ISO639(v, 2) {
+		if len(v) == 3 && (ISO639(v, 2) || ISO639(v, 3) || ISO639(v, 5)) {
 			return true
 		}
 		return false
@@ -1140,6 +1528,18 @@ func ISO639(v string, num int) bool {
 		return ok
 	}
 
+	if num == 3 && len(v) == 3 {
+		v = strings.ToLower(v)
+		_, ok := tables.ISO639_3[v]
+		return ok
+	}
+
+	if num == 5 && len(v) == 3 {
+		v = strings.ToLower(v)
+		_, ok := tables.ISO639_5[v]
+		return ok
+	}
+
 	return false
 }
 
@@ -1185,6 +1585,58 @@ func ISO31661A(v string, num int) bool {
 	return false
 }
 
+// ISO31661N reports whether or not v is a valid country code as defined by the
+// ISO 3166-1 Numeric standard, e.g. "840". The code must consist of exactly
+// three digits.
+//
+//	isvalid:rule
+//	{
+//		"name": "iso31661n",
+//		"err": { "text": "must be a valid ISO 3166-1 Numeric value" }
+//	}
+func ISO31661N(v string) bool {
+	if len(v) != 3 {
+		return false
+	}
+	_, ok := country.ISO31661N[v]
+	return ok
+}
+
+// ISO31662 reports whether or not v is a valid country subdivision code as
+// defined by the ISO 3166-2 standard, e.g. "US-CA". If cc is not empty then
+// v must be a subdivision of one of the countries identified by the ISO 3166-1
+// alpha-2 or alpha-3 codes in cc, in which case v may also be specified without
+// the country code prefix, e.g. "CA".
+//
+//	isvalid:rule
+//	{
+//		"name": "iso31662",
+//		"err": { "text": "must be a valid ISO 3166-2 value" }
+//	}
+func ISO31662(v string, cc ...string) bool {
+	v = strings.ToUpper(v)
+	if len(cc) == 0 {
+		_, ok := country.ISO31662[v]
+		return ok
+	}
+
+	for _, c := range cc {
+		if cty, ok := country.Get(c); ok {
+			c = cty.A2
+		}
+		if !strings.HasPrefix(v, c+"-") {
+			if _, ok := country.ISO31662[c+"-"+v]; ok {
+				return true
+			}
+			continue
+		}
+		if _, ok := country.ISO31662[v]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // ISO4217 reports whether or not v is a valid currency code as defined by the ISO 4217 standard.
 //
 //	isvalid:rule
@@ -1202,6 +1654,245 @@ func ISO4217(v string) bool {
 	return false
 }
 
+// ISO8601 reports whether or not v is a valid date, or date and time, representation
+// as defined by the ISO 8601 standard. Accepted are calendar dates (2006-01-02,
+// 20060102, 2006-01, 2006), ordinal dates (2006-002, 2006002), and week dates
+// (2006-W01-1, 2006W011, 2006-W01, 2006W01). A complete date can be followed by
+// the time of day (T15, T15:04, T15:04:05, T1504, T150405) whose last element
+// can have a decimal fraction (T15:04:05.999), and by a time zone designator
+// (Z, +07, +07:00, +0700). The date must exist in the calendar, which means
+// that, e.g., "2006-02-29", "2006-366", and "2006-W53" are not valid.
+//
+// If tz is not empty then v must include a time zone designator that matches
+// at least one of the time zones in tz. A time zone can be specified as "Z"
+// or "UTC", as a UTC offset in one of the forms "±hh", "±hhmm", or "±hh:mm",
+// or as the name of a location in the IANA Time Zone database, e.g.
+// "Europe/Berlin", in which case the offset of v must match the offset
+// of the location at the time represented by v.
+//
+//	isvalid:rule
+//	{
+//		"name": "iso8601",
+//		"err": { "text": "must be a valid ISO 8601 date or date-time" }
+//	}
+func ISO8601(v string, tz ...string) bool {
+	date, tod, hasTime := strings.Cut(v, "T")
+	y, m, d, complete, ok := parseISO8601Date(date)
+	if !ok || (hasTime && !complete) {
+		return false
+	}
+
+	var hh, mm, ss, offset int
+	var zoned bool
+	if hasTime {
+		if i := strings.IndexAny(tod, "Z+-"); i > -1 {
+			if tod[i:] == "Z" {
+				zoned = true
+			} else if offset, zoned = parseUTCOffset(tod[i:]); !zoned {
+				return false
+			}
+			tod = tod[:i]
+		}
+		if hh, mm, ss, ok = parseISO8601Time(tod); !ok {
+			return false
+		}
+	}
+
+	if len(tz) == 0 {
+		return true
+	}
+	t := time.Date(y, time.Month(m), d, hh, mm, ss, 0, time.FixedZone("", offset))
+	return zoned && inTimeZone(t, tz)
+}
+
+// parseISO8601Date parses the date part of an ISO 8601 date-time string. The week
+// and ordinal dates are converted to their calendar date equivalent. The result
+// complete reports whether the date is complete, i.e. if it specifies the day.
+func parseISO8601Date(v string) (y, m, d int, complete, ok bool) {
+	// the digits without the separators
+	digits := rmchar(v, func(r rune) bool { return r == '-' || r == 'W' })
+	if len(digits) < 4 || !Digits(digits) {
+		return 0, 0, 0, false, false
+	}
+	y = atoi(digits[:4])
+
+	switch {
+	case v == digits && len(v) == 4: // YYYY
+		return y, 1, 1, false, true
+	case matchDigits(v, "dddd-dd"): // YYYY-MM
+		m = atoi(digits[4:6])
+		return y, m, 1, false, 1 <= m && m <= 12
+	case matchDigits(v, "dddd-dd-dd"), matchDigits(v, "dddddddd"): // YYYY-MM-DD
+		m, d = atoi(digits[4:6]), atoi(digits[6:8])
+		return y, m, d, true, 1 <= m && m <= 12 && 1 <= d && d <= daysIn(m, y)
+	case matchDigits(v, "dddd-ddd"), matchDigits(v, "ddddddd"): // YYYY-DDD
+		yday, ydays := atoi(digits[4:7]), 365
+		if daysIn(2, y) == 29 {
+			ydays = 366
+		}
+		if yday < 1 || yday > ydays {
+			return 0, 0, 0, false, false
+		}
+		t := time.Date(y, 1, yday, 0, 0, 0, 0, time.UTC)
+		return y, int(t.Month()), t.Day(), true, true
+	case matchDigits(v, "dddd-Wdd"), matchDigits(v, "ddddWdd"), // YYYY-Www
+		matchDigits(v, "dddd-Wdd-d"), matchDigits(v, "ddddWddd"): // YYYY-Www-D
+		week, wday := atoi(digits[4:6]), 1
+		if complete = len(digits) == 7; complete {
+			wday = atoi(digits[6:])
+		}
+		// the year has 53 weeks if December 28th falls in the 53rd week
+		_, weeks := time.Date(y, 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+		if week < 1 || week > weeks || wday < 1 || wday > 7 {
+			return 0, 0, 0, false, false
+		}
+		// the 1st week is the week with January 4th in it
+		jan4 := time.Date(y, 1, 4, 0, 0, 0, 0, time.UTC)
+		days := (week-1)*7 + (wday - 1) - (int(jan4.Weekday())+6)%7
+		t := jan4.AddDate(0, 0, days)
+		return t.Year(), int(t.Month()), t.Day(), complete, true
+	}
+	return 0, 0, 0, false, false
+}
+
+// parseISO8601Time parses the time of day part, excluding the time
+// zone designator, of an ISO 8601 date-time string.
+func parseISO8601Time(v string) (hh, mm, ss int, ok bool) {
+	// the decimal fraction of the last element
+	frac := ""
+	if i := strings.IndexAny(v, ".,"); i > -1 {
+		if v, frac = v[:i], v[i+1:]; len(frac) == 0 || !Digits(frac) {
+			return 0, 0, 0, false
+		}
+	}
+
+	digits := strings.ReplaceAll(v, ":", "")
+	switch {
+	case matchDigits(v, "dd"), matchDigits(v, "dd:dd"), matchDigits(v, "dddd"),
+		matchDigits(v, "dd:dd:dd"), matchDigits(v, "dddddd"):
+	default:
+		return 0, 0, 0, false
+	}
+
+	hh = atoi(digits[:2])
+	if len(digits) > 2 {
+		mm = atoi(digits[2:4])
+	}
+	if len(digits) > 4 {
+		ss = atoi(digits[4:])
+	}
+
+	// 24:00:00 denotes the end of the day and it's the only valid time with the hour 24
+	if hh == 24 && (mm > 0 || ss > 0 || strings.Trim(frac, "0") != "") {
+		return 0, 0, 0, false
+	}
+	// a second of 60 is allowed to represent a leap second
+	return hh, mm, ss, hh <= 24 && mm <= 59 && ss <= 60
+}
+
+// matchDigits reports whether or not v matches the pattern p in which
+// the character 'd' represents a single digit and every other character
+// represents itself.
+func matchDigits(v string, p string) bool {
+	if len(v) != len(p) {
+		return false
+	}
+	for i := 0; i < len(p); i++ {
+		if p[i] == 'd' {
+			if v[i] < '0' || v[i] > '9' {
+				return false
+			}
+		} else if v[i] != p[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// daysIn returns the number of days in the month m of the year y.
+func daysIn(m, y int) int {
+	return time.Date(y, time.Month(m)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// parseUTCOffset parses v as a UTC offset in one of the forms "±hh",
+// "±hhmm", or "±hh:mm" and returns the offset in seconds east of UTC.
+func parseUTCOffset(v string) (offset int, ok bool) {
+	if len(v) < 3 || (v[0] != '+' && v[0] != '-') {
+		return 0, false
+	}
+	if !matchDigits(v[1:], "dd") && !matchDigits(v[1:], "dddd") && !matchDigits(v[1:], "dd:dd") {
+		return 0, false
+	}
+
+	digits := strings.ReplaceAll(v[1:], ":", "")
+	hh, mm := atoi(digits[:2]), 0
+	if len(digits) > 2 {
+		mm = atoi(digits[2:])
+	}
+	if hh > 23 || mm > 59 {
+		return 0, false
+	}
+
+	offset = hh*3600 + mm*60
+	if v[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
+
+// tzNone is used as the default location for parsing date-time strings so that
+// those without time zone information can be detected, the 1 second offset
+// ensures that no actual time zone will be mistaken for it.
+var tzNone = time.FixedZone("", 1)
+
+// inTimeZone reports whether or not the time t is expressed in
+// one of the given time zones, see ISO8601 for the accepted values.
+func inTimeZone(t time.Time, tz []string) bool {
+	name, offset := t.Zone()
+	for _, z := range tz {
+		if strings.EqualFold(z, "Z") || strings.EqualFold(z, "UTC") {
+			if offset == 0 {
+				return true
+			}
+			continue
+		}
+		if off, ok := parseUTCOffset(z); ok {
+			if off == offset {
+				return true
+			}
+			continue
+		}
+
+		// an abbreviation as parsed by time.Parse, e.g. "MST"
+		if len(name) > 0 && strings.EqualFold(z, name) {
+			return true
+		}
+		if loc, err := loadLocation(z); err == nil {
+			if _, off := t.In(loc).Zone(); off == offset {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadLocation returns the Location with the given name. The result
+// is cached so as to avoid reading the time zone database every time.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := tzcache.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	tzcache.Store(name, loc)
+	return loc, nil
+}
+
+var tzcache sync.Map
+
 var rxISRC = regexp.MustCompile(`^[A-Z]{2}[0-9A-Z]{3}\d{2}\d{5}$`)
 
 // ISRC reports whether or not v is a valid International Standard Recording Code.
@@ -1346,17 +2037,27 @@ func LatLong(v string, dms bool) bool {
 
 var rxLocale = regexp.MustCompile(`^[A-z]{2,4}(?:[_-](?:[A-z]{4}|[\d]{3}))?(?:[_-](?:[A-z]{2}|[\d]{3}))?$`)
 
-// Locale reports whether or not v is a valid locale.
+// Locale reports whether or not v is a valid locale. With strict=false only
+// the locale's format is checked. With strict=true the locale is validated
+// as a BCP 47 language tag, in which case the subtags may be separated by
+// an underscore as well, see BCP47 for more details.
 //
 //	isvalid:rule
 //	{
 //		"name": "locale",
+//		"opts": [[
+//			{ "key": null, "value": "false" },
+//			{ "key": "strict", "value": "true" }
+//		]],
 //		"err": { "text": "must be a valid locale" }
 //	}
-func Locale(v string) bool {
+func Locale(v string, strict bool) bool {
 	if v == "en_US_POSIX" || v == "ca_ES_VALENCIA" {
 		return true
 	}
+	if strict {
+		return bcp47.Valid(strings.Replace(v, "_", "-", -1))
+	}
 	return rxLocale.MatchString(v)
 }
 
@@ -1535,22 +2236,135 @@ func Octal(v string) bool {
 	return rxOctal.MatchString(v)
 }
 
-var rxPAN = regexp.MustCompile(`^(?:4[0-9]{12}(?:[0-9]{3,6})?|5[1-5][0-9]{14}|(?:222[1-9]|22[3-9][0-9]|2[3-6][0-9]{2}|27[01][0-9]|2720)[0-9]{12}|6(?:011|5[0-9][0-9])[0-9]{12,15}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|(?:2131|1800|35\d{3})\d{11}|6[27][0-9]{14})$`)
-
-// PAN reports whether or not v is a valid Primary Account Number or Credit Card number.
+// PAN reports whether or not v is a valid Primary Account Number or Credit
+// Card number. The number's IIN and length are checked against the card
+// network table and its check digit is verified with the Luhn algorithm.
+// If networks are provided, e.g. "visa" or "mastercard", then v must also
+// belong to one of those networks, see CardNetwork for the network names.
+// A number in a co-branded range belongs to each of the range's networks.
+// The digits of v may be grouped by spaces or hyphens.
 //
 //	isvalid:rule
 //	{
 //		"name": "pan",
 //		"err": { "text": "must be a valid PAN" }
 //	}
-func PAN(v string) bool {
+func PAN(v string, networks ...string) bool {
 	v = rmchar(v, func(r rune) bool { return r == ' ' || r == '-' })
-	if !rxPAN.MatchString(v) {
+	list := cardNetworks(v)
+	if len(list) == 0 || !algo.Luhn(v) {
+		return false
+	}
+
+	// a number in a co-branded range is valid if it's
+	// valid for any of the networks that share the range
+	for _, n := range list {
+		var validLen bool
+		for _, l := range n.Lengths {
+			if l == len(v) {
+				validLen = true
+				break
+			}
+		}
+		if !validLen {
+			continue
+		}
+
+		if len(networks) == 0 {
+			return true
+		}
+		for _, name := range networks {
+			if strings.EqualFold(name, n.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CardNetwork returns the name of the payment card network to which the card
+// number v belongs, as identified by the number's IIN prefix. The names are
+// "amex", "diners", "discover", "jcb", "maestro", "mastercard", "mir",
+// "rupay", "unionpay", and "visa". The digits of v may be grouped by spaces
+// or hyphens. CardNetwork does not validate the number, use PAN for that.
+//
+// If v is in a co-branded range, i.e. a range shared by more than one network,
+// the network with the more specific range is returned, e.g. "discover" for
+// the range 622126-622925 which Discover shares with UnionPay. Use
+// CardNetworks to get all of the networks.
+func CardNetwork(v string) (string, bool) {
+	if list := CardNetworks(v); len(list) > 0 {
+		return list[0], true
+	}
+	return "", false
+}
+
+// CardNetworks returns the names of all the payment card networks to which the
+// card number v belongs, see CardNetwork. The names are ordered by the length of
+// the matching IIN prefix, the most specific first. If v doesn't belong to any
+// network, nil is returned.
+func CardNetworks(v string) (names []string) {
+	v = rmchar(v, func(r rune) bool { return r == ' ' || r == '-' })
+	for _, n := range cardNetworks(v) {
+		names = append(names, n.Name)
+	}
+	return names
+}
+
+// cardNetworks returns the networks with an IIN range that matches the card
+// number v, ordered by the length of the matching prefix, the longest first.
+func cardNetworks(v string) (list []tables.CardNetwork) {
+	if len(v) == 0 || strings.Trim(v, "0123456789") != "" {
+		return nil
+	}
+
+	prefixes := map[string]int{}
+	for _, cn := range tables.CardNetworks {
+		for _, r := range cn.IIN {
+			if len(r.From) > len(v) || len(r.From) <= prefixes[cn.Name] {
+				continue
+			}
+			if p := v[:len(r.From)]; p >= r.From && p <= r.To {
+				prefixes[cn.Name] = len(r.From)
+			}
+		}
+		if prefixes[cn.Name] > 0 {
+			list = append(list, cn)
+		}
+	}
+	sort.SliceStable(list, func(i, j int) bool {
+		return prefixes[list[i].Name] > prefixes[list[j].Name]
+	})
+	return list
+}
+
+var rxCardExpiry = regexp.MustCompile(`^(0[1-9]|1[0-2])/([0-9]{2})$`)
+
+// CardExpiry reports whether or not v is a payment card expiry date in the
+// "MM/YY" format that has not yet passed. A card expires at the end of its
+// expiry month, i.e. v is valid up until and including the last day of the
+// month, in UTC.
+//
+//	isvalid:rule
+//	{
+//		"name": "cardexpiry",
+//		"err": { "text": "must be a valid, unexpired card expiry date" }
+//	}
+func CardExpiry(v string) bool {
+	return cardExpiry(v, time.Now())
+}
+
+// cardExpiry reports whether or not v is a card expiry date that has not yet
+// passed at the given time, see CardExpiry.
+func cardExpiry(v string, now time.Time) bool {
+	m := rxCardExpiry.FindStringSubmatch(v)
+	if m == nil {
 		return false
 	}
 
-	return algo.Luhn(v)
+	// the first day of the month after the expiry month
+	end := time.Date(2000+atoi(m[2]), time.Month(atoi(m[1]))+1, 1, 0, 0, 0, 0, time.UTC)
+	return now.UTC().Before(end)
 }
 
 // PassportNumber reports whether or not v is a valid passport number.
@@ -1593,6 +2407,46 @@ func Port(v string) bool {
 	return err == nil
 }
 
+var rxRFC3339 = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}[Tt ][0-9]{2}:[0-9]{2}:[0-9]{2}(?:\.[0-9]+)?(?:[Zz]|[+-][0-9]{2}:[0-9]{2})$`)
+
+// RFC3339 reports whether or not v is a valid date-time string as defined
+// by RFC 3339, e.g. "2006-01-02T15:04:05Z" or "2006-01-02T15:04:05.999+07:00".
+// The date must exist in the calendar, e.g. "2006-02-29" is not valid, and
+// a second of 60 is accepted as a leap second. If tz is not empty then the
+// time zone of v must match at least one of the time zones in tz, see
+// ISO8601 for the accepted time zone values.
+//
+//	isvalid:rule
+//	{
+//		"name": "rfc3339",
+//		"err": { "text": "must be a valid RFC 3339 date-time" }
+//	}
+func RFC3339(v string, tz ...string) bool {
+	if !rxRFC3339.MatchString(v) {
+		return false
+	}
+
+	y, m, d := atoi(v[0:4]), atoi(v[5:7]), atoi(v[8:10])
+	hh, mm, ss := atoi(v[11:13]), atoi(v[14:16]), atoi(v[17:19])
+	if m < 1 || m > 12 || d < 1 || d > daysIn(m, y) || hh > 23 || mm > 59 || ss > 60 {
+		return false
+	}
+
+	offset := 0
+	if z := v[len(v)-1]; z != 'Z' && z != 'z' {
+		var ok bool
+		if offset, ok = parseUTCOffset(v[len(v)-6:]); !ok {
+			return false
+		}
+	}
+
+	if len(tz) == 0 {
+		return true
+	}
+	t := time.Date(y, time.Month(m), d, hh, mm, ss, 0, time.FixedZone("", offset))
+	return inTimeZone(t, tz)
+}
+
 var rxRGB = regexp.MustCompile(`^rgb\((?:(?:[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5]),){2}(?:[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5])\)$`)
 var rxRGBA = regexp.MustCompile(`^rgba\((?:(?:[0-9]|[1-9][0-9]|1[0-9][0-9]|2[0-4][0-9]|25[0-5]),){3}(?:0?\.\d|1(?:\.0)?|0(?:\.0)?)\)$`)
 var rxRGBPercent = regexp.MustCompile(`^rgb\((?:(?:[0-9]%|[1-9][0-9]%|100%),){2}(?:[0-9]%|[1-9][0-9]%|100%)\)`)
@@ -1821,7 +2675,10 @@ func VAT(v string, cc string) bool {
 }
 
 // Zip reports whether or not v is a valid zip / postal code for the country
-// identified by the given country code cc.
+// identified by the given country code cc. If the country doesn't use postal
+// codes then any value, including the empty string, is accepted. If cc is not
+// a known country code, or if the country's package wasn't imported, then
+// Zip reports false.
 //
 //	isvalid:rule
 //	{
@@ -1835,3 +2692,46 @@ func Zip(v string, cc string) bool {
 	}
 	return false
 }
+
+// ZipIn reports whether or not v is a valid zip / postal code for the country
+// identified by the country code cc and whether or not it belongs to the
+// subdivision identified by sc. The subdivision code can be specified with
+// or without the country code prefix, e.g. "US-CA" or "CA". A postal code
+// belongs to a subdivision if it falls into one of the subdivision's postal
+// code ranges, or into a range of one of the subdivision's descendants.
+//
+// If the country has no postal code ranges then ZipIn only checks that sc
+// is a known subdivision of the country and that v is a valid postal code.
+//
+//	isvalid:rule
+//	{
+//		"name": "zipin",
+//		"err": { "text": "must be a valid zip code of the subdivision" }
+//	}
+func ZipIn(v string, sc string, cc string) bool {
+	c, ok := country.Get(cc)
+	if !ok || c.Zip == nil || !c.Zip.MatchString(v) {
+		return false
+	}
+
+	sc = strings.ToUpper(sc)
+	if !strings.HasPrefix(sc, c.A2+"-") {
+		sc = c.A2 + "-" + sc
+	}
+	if _, ok := country.ISO31662[sc]; !ok {
+		return false
+	}
+	if len(c.ZipRanges) == 0 {
+		return true
+	}
+
+	for _, code := range c.ZipSubdivisions(v) {
+		for len(code) > 0 {
+			if code == sc {
+				return true
+			}
+			code = country.ISO31662[code].Parent
+		}
+	}
+	return false
+}
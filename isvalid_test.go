@@ -2,8 +2,13 @@ package isvalid
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
+	"time"
+
+	"github.com/frk/isvalid/internal/tables"
+	"github.com/frk/isvalid/l10n/address"
 )
 
 func Test(t *testing.T) {
@@ -752,6 +757,88 @@ func Test(t *testing.T) {
 				"ยินดีต้อนรับทั้ง 2 คน",
 			},
 		}},
+	}, {
+		Name: "Address", Func: Address, Cases: Cases{{
+			pass: vals{
+				address.Address{
+					Country:       "US",
+					StreetAddress: []string{"1600 Amphitheatre Pkwy"},
+					Locality:      "Mountain View",
+					Subdivision:   "CA",
+					PostalCode:    "94043",
+				},
+				address.Address{
+					Country:       "GBR",
+					Name:          "Jane Doe",
+					StreetAddress: []string{"10 Downing Street"},
+					Locality:      "London",
+					PostalCode:    "SW1A 2AA",
+				},
+			},
+			fail: vals{
+				address.Address{},
+				address.Address{Country: "US"},
+				address.Address{
+					Country:       "US",
+					StreetAddress: []string{"1600 Amphitheatre Pkwy"},
+					Locality:      "Mountain View",
+					PostalCode:    "94043",
+				},
+				address.Address{
+					Country:       "GB",
+					StreetAddress: []string{"10 Downing Street"},
+					Locality:      "London",
+				},
+				// the format of the country is not known
+				address.Address{
+					Country:       "TV",
+					StreetAddress: []string{"Vaiaku"},
+					Locality:      "Funafuti",
+				},
+			},
+		}},
+	}, {
+		Name: "BCP47", Func: BCP47, Cases: Cases{{
+			pass: vals{
+				"en",
+				"en-US",
+				"EN-us",
+				"de-CH-1996",
+				"zh-Hant-TW",
+				"zh-cmn-Hans-CN",
+				"sr-Latn-RS",
+				"es-419",
+				"gsw",
+				"ca-ES-valencia",
+				"sl-rozaj-biske",
+				"en-US-u-ca-gregory-nu-latn",
+				"de-DE-u-co-phonebk-t-en",
+				"en-x-private",
+				"x-whatever",
+				"qaa-Qaaa-QM",
+				"i-klingon",
+				"art-lojban",
+				"iw",
+			},
+			fail: vals{
+				"",
+				"xx",
+				"xx-QQ",
+				"en-OO",
+				"en-Xxxx",
+				"en-US-foobar",
+				"en-US-1996-1996",
+				"en-a-foo",
+				"en-u-ca-gregory-u-nu-latn",
+				"en-u",
+				"en-x",
+				"de-cmn",
+				"zh-cmn-yue",
+				"en_US",
+				"en--US",
+				"toolongsubtag",
+			},
+		}},
 	}, {
 		Name: "BIC", Func: BIC, Cases: Cases{{
 			pass: vals{
@@ -759,6 +846,12 @@ func Test(t *testing.T) {
 				"SBICKEN1",
 				"SBICKENY",
 				"SBICKEN1YYP",
+				"DEUTDEFF",
+				"DEUTDEFF500",
+				"NEDSZAJJXXX",
+				"BNPAFRPPXXX",
+				"CHASUS33",
+				"RBKOXKPR",
 			},
 
 			fail: vals{
@@ -768,6 +861,70 @@ func Test(t *testing.T) {
 				"SBICKENXX9",
 				"SBICKEN13458",
 				"SBICKEN",
+				"deutdeff",
+				"DeutDEFF",
+				"DEUTZZFF",
+				"DEUT[EFF",
+				"DEUTDE0F",
+				"DEUTDE1F",
+				"DEUTDEFO",
+				"DEUTDEFFXAB",
+				"DEUTDEFF_50",
+				"DEUTDEF0",
+				"DEUTDEF0XXX",
+			},
+		}},
+	}, {
+		Name: "BICTest", Func: BICTest, Cases: Cases{{
+			pass: vals{
+				"DEUTDEF0",
+				"DEUTDEF0XXX",
+				"DEUTDEFF",
+				"CHASUS33",
+			},
+			fail: vals{
+				"DEUTDE0F",
+				"DEUTDEFO",
+				"DEUTZZF0",
+				"deutdef0",
+			},
+		}},
+	}, {
+		Name: "BICIBAN", Func: BICIBAN, Cases: Cases{{
+			args: args{{"DE89370400440532013000"}, {"de89 3704 0044 0532 0130 00"}},
+			pass: vals{
+				"DEUTDEFF",
+				"COBADEFFXXX",
+			},
+			fail: vals{
+				"BNPAFRPP",
+				"DEUTDEF0",
+				"deutdeff",
+			},
+		}, {
+			args: args{{"FR1420041010050500013M02606"}},
+			pass: vals{
+				"BNPAFRPP",
+				"BNPAGPGP",
+				"BNPARERX",
+			},
+			fail: vals{
+				"DEUTDEFF",
+				"BNPAMCMC",
+			},
+		}, {
+			args: args{{"GB29NWBK60161331926819"}},
+			pass: vals{
+				"NWBKGB2L",
+				"RBOSJESH",
+			},
+			fail: vals{
+				"BOFIIE2D",
+			},
+		}, {
+			args: args{{""}, {"X"}},
+			fail: vals{
+				"DEUTDEFF",
 			},
 		}},
 	}, {
@@ -969,33 +1126,32 @@ func Test(t *testing.T) {
 		}},
 	}, {
 		Name: "Currency", Func: Currency, Cases: Cases{{
-			args: args{{"gbp", (*CurrencyOpts)(nil)}},
+			args: args{{"gbp", "en", (*CurrencyOpts)(nil)}},
 			pass: vals{
 				"£1",
-				"1234·05",
-				"1,234·05",
-				"1 234·05",
-				"£1234·05",
-				"£1,234·05",
-				"£1 234·05",
-				"£\u20091234·05",
-				"£\u20091,234·05",
-				"£\u20091 234·05",
-				"£\u202F1234·05",
-				"£\u202F1,234·05",
-				"£\u202F1 234·05",
+				"1234.05",
+				"1,234.05",
+				"£1234.05",
+				"£1,234.05",
+				"£1,234,567.05",
 				"£2.99",
+				"-£2.99",
+				"GBP 1,234.05",
+				"GBP1,234.05",
 			},
 			fail: vals{
 				"",
 				"$1",
 				"£1,00",
-				"£ 1,234·07",
-				"1,234·05£",
-				"£1,234·0",
-				"£1,234·999",
-				"£1,234·9a",
-				"£1,2E4·99",
+				"£ 1,234.07",
+				"£\u20091,234.05",
+				"1 234.05",
+				"1,234.05£",
+				"£1,234·05",
+				"£1,234.0",
+				"£1,234.999",
+				"£1,234.9a",
+				"£1,2E4.99",
 				"£2.",
 				"£.99",
 				"£02.99",
@@ -1003,6 +1159,79 @@ func Test(t *testing.T) {
 				"£1,23,456,789.99",
 				"£1,234,,567.99",
 				"£,234.99",
+				"£1,234,",
+			},
+		}, {
+			args: args{{"eur", "de_DE", (*CurrencyOpts)(nil)}},
+			pass: vals{
+				"1.234,56 €",
+				"1.234,56\u00a0€",
+				"1234,56 €",
+				"1.234,56",
+				"-5,00 €",
+				"1.234,56 EUR",
+			},
+			fail: vals{
+				"€1.234,56",
+				"€ 1.234,56",
+				"1,234.56 €",
+				"1.234,5 €",
+				"1.23,56 €",
+				"1.234,56 $",
+			},
+		}, {
+			args: args{{"EUR", "de-DE", &CurrencyOpts{NeedSym: true}}},
+			pass: vals{
+				"1.234,56 €",
+				"1.234,56 EUR",
+			},
+			fail: vals{
+				"1.234,56",
+			},
+		}, {
+			args: args{{"eur", "fr_FR", (*CurrencyOpts)(nil)}},
+			pass: vals{
+				"1 234,56 €",
+				"1\u202f234,56\u00a0€",
+			},
+			fail: vals{
+				"1.234,56 €",
+				"1 234.56 €",
+			},
+		}, {
+			args: args{{"inr", "en_IN", (*CurrencyOpts)(nil)}},
+			pass: vals{
+				"₹12,34,567.89",
+				"₹1,234.00",
+				"₹999",
+			},
+			fail: vals{
+				"₹1,234,567.89",
+				"₹12,34,56.89",
+			},
+		}, {
+			args: args{{"jpy", "ja", (*CurrencyOpts)(nil)}},
+			pass: vals{
+				"¥1,234",
+				"JP¥1,234",
+			},
+			fail: vals{
+				"¥1,234.50",
+			},
+		}, {
+			args: args{{"chf", "de_CH", &CurrencyOpts{Cash: true}}},
+			pass: vals{
+				"CHF 1’234.05",
+				"CHF 1.00",
+			},
+			fail: vals{
+				"CHF 1’234.03",
+				"CHF 1.001",
+			},
+		}, {
+			args: args{{"usd", "xx", (*CurrencyOpts)(nil)}},
+			fail: vals{
+				"$1.00",
 			},
 		}},
 	}, {
@@ -1033,6 +1262,52 @@ func Test(t *testing.T) {
 				"iVBORw0KGgoAAAANSUhEUgAAABAAAAAQAQMAAAAlPW0iAAAABlBMVEUAAAD///+l2Z/dAAAAM0lEQVR4nGP4/5/h/1+G/58ZDrAz3D/McH8yw83NDDeNGe4Ug9C9zwz3gVLMDA/A6P9/AFGGFyjOXZtQAAAAAElFTkSuQmCC",
 			},
 		}},
+	}, {
+		Name: "DateTime", Func: DateTime, Cases: Cases{{
+			args: args{{"2006-01-02"}},
+			pass: vals{
+				"2021-12-31",
+				"2020-02-29",
+				"2000-02-29",
+			},
+			fail: vals{
+				"",
+				"2021-02-29",
+				"1900-02-29",
+				"2021-04-31",
+				"2021-13-01",
+				"2021-1-1",
+				"2021-01-01T00:00:00Z",
+			},
+		}, {
+			args: args{{"2006-01-02T15:04:05Z07:00", "UTC", "+02:00", "America/New_York"}},
+			pass: vals{
+				"2021-06-15T10:30:00Z",
+				"2021-06-15T10:30:00+02:00",
+				"2021-06-15T10:30:00-04:00",
+				"2021-12-15T10:30:00-05:00",
+			},
+			fail: vals{
+				"2021-06-15T10:30:00+01:00",
+				"2021-06-15T10:30:00-05:00",
+				"2021-12-15T10:30:00-04:00",
+				"2021-06-15T25:30:00Z",
+			},
+		}, {
+			args: args{{"2006-01-02 15:04", "UTC"}},
+			pass: vals{},
+			fail: vals{
+				"2021-06-15 10:30",
+			},
+		}, {
+			args: args{{"02 Jan 06 15:04 MST", "CET"}},
+			pass: vals{
+				"15 Jun 21 10:30 CET",
+			},
+			fail: vals{
+				"15 Jun 21 10:30 EST",
+			},
+		}},
 	}, {
 		Name: "Decimal", Func: Decimal, Cases: Cases{{
 			args: args{{"en"}},
@@ -1583,6 +1858,64 @@ func Test(t *testing.T) {
 				"BR1500000000000010932840814P2",
 				"LB92000700000000123123456123",
 				"IR200170000000339545727003",
+				"AL47 2121 1009 0000 0002 3569 8741",
+				"BA39 1290 0794 0102 8494",
+				"BE68 5390 0754 7034",
+				"CZ65 0800 0000 1920 0014 5399",
+				"EE38 2200 2210 2014 5685",
+				"ES91 2100 0418 4502 0005 1332",
+				"FI21 1234 5600 0007 85",
+				"FR14 2004 1010 0505 0001 3M02 606",
+				"HR12 1001 0051 8630 0016 0",
+				"HU42 1177 3016 1111 1018 0000 0000",
+				"IS14 0159 2600 7654 5510 7303 39",
+				"IT60 X054 2811 1010 0000 0123 456",
+				"ME25 5050 0001 2345 6789 51",
+				"MK07 2501 2000 0058 984",
+				"NO93 8601 1117 947",
+				"PL61 1090 1014 0000 0712 1981 2874",
+				"PT50 0002 0123 1234 5678 9015 4",
+				"RS35 2600 0560 1001 6113 79",
+				"SI56 2633 0001 2039 086",
+				"SI56 1910 0000 0123 438",
+				"SK31 1200 0000 1987 4263 7541",
+				"SM86 U032 2509 8000 0000 0270 100",
+				"MC58 1122 2000 0101 2345 6789 030",
+				"DJ21 0001 0000 0001 5400 0100 186",
+				"FK88 SC12 3456 7890 12",
+				"LY83 0020 4800 0020 1001 2036 1",
+				"MN12 1234 1234 5678 9123",
+				"NI45 BAPR 0000 0013 0000 0355 8124",
+				"OM81 0180 0000 0129 9123 456",
+				"RU02 0445 2560 0407 0281 0412 3456 7890 1",
+				"SD21 2901 0501 2340 01",
+				"SO21 1000 0010 0100 0100 141",
+				"ST23 0001 0001 0051 8453 1014 6",
+				"ST32 0002 0001 0192 1942 1011 2",
+				"YE15 CBYE 0001 0188 6123 4567 8912 34",
+				"DZ58 0002 1000 0111 3000 0005 70",
+				"AO06 0044 0000 6729 5030 1010 2",
+				"BJ66 BJ06 1010 0100 1443 9000 0769",
+				"BF42 BF08 4010 1300 4635 7400 0390",
+				"CI93 CI00 8011 1301 1342 9120 0589",
+				"CM21 1000 3001 0005 0000 0605 306",
+				"CV64 0003 0000 4547 0691 1017 6",
+				"MA64 0115 1900 0001 2050 0053 4921",
+				"MZ59 0003 0108 0016 3671 0237 1",
+				"SN08 SN01 0015 2000 0485 0000 3035",
+				"KM46 0000 5000 0100 1090 4400 137",
+				"TD89 6000 2000 0102 7109 1600 153",
+				"CG39 3001 1000 1010 1345 1300 019",
+				"GA21 4002 1010 0320 0189 0020 126",
+				"GQ70 5000 2001 0037 1522 8190 196",
+				"BI23 1000 1000 0100 0033 2045 181",
+				"HN05 CABF 0000 0000 0000 0025 0005",
+				"ML78 D008 9017 0001 0025 0500 0536",
+				"GW72 GW14 3001 0181 8000 6378 6",
+				"NE09 NE03 8010 0100 1303 0520 0000",
+				"TG60 TG01 9000 0420 1000 0003 4560",
+				"CF42 2000 1000 0102 3452 3890 151",
+				"MG46 0000 5030 0101 0191 4016 056",
 			},
 			fail: vals{
 				"XX22YYY1234567890123",
@@ -1590,6 +1923,26 @@ func Test(t *testing.T) {
 				"FR7630006000011234567890189@",
 				"FR7630006000011234567890189😅",
 				"FR763000600001123456!!🤨7890189@",
+				"BE41 5390 0754 7035",
+				"FR84 2004 1010 0505 0001 3M02 607",
+				"ES29 2100 0418 4602 0005 1332",
+				"IT64 Y054 2811 1010 0000 0123 456",
+				"NO66 8601 1117 948",
+				"PT23 0002 0123 1234 5678 9015 5",
+				"FI91 1234 5600 0007 86",
+				"PL36 1090 1015 0000 0712 1981 2874",
+				"HR82 1001 0051 8630 0016 1",
+				"SI29 2633 0001 2039 087",
+				"EE11 2200 2210 2014 5686",
+				"CZ92 0800 0000 1920 0014 5398",
+				"HU15 1177 3016 1111 1018 0000 0001",
+				"IS35 0159 2600 7654 5510 7303 49",
+				"AL72 2121 1008 0000 0002 3569 8741",
+				"RS62 2600 0560 1001 6113 78",
+				"MC31 1122 2000 0101 2345 6789 031",
+				"SM90 V032 2509 8000 0000 0270 100",
+				"DE89 3704 0044 0532 0130 0",
+				"RU02 0445 2560 0407 0281 0412 3456 7890",
 			},
 		}},
 	}, {
@@ -1780,6 +2133,28 @@ func Test(t *testing.T) {
 				"5398228707871528",
 			},
 		}},
+	}, {
+		Name: "ISO639", Func: ISO639, Cases: Cases{{
+			args: args{{0}},
+			pass: vals{"en", "DE", "eng", "deu", "cmn", "yue", "gsw", "sla", "aav"},
+			fail: vals{"", "xx", "xxx", "engl", "e"},
+		}, {
+			args: args{{1}},
+			pass: vals{"en", "zh", "sh"},
+			fail: vals{"eng", "cmn", "xx"},
+		}, {
+			args: args{{2}},
+			pass: vals{"eng", "zho", "sla"},
+			fail: vals{"en", "cmn", "yue", "xxx"},
+		}, {
+			args: args{{3}},
+			pass: vals{"eng", "zho", "cmn", "YUE", "aaa"},
+			fail: vals{"en", "sla", "aav", "xxx"},
+		}, {
+			args: args{{5}},
+			pass: vals{"sla", "aav", "gem"},
+			fail: vals{"en", "eng", "cmn", "xxx"},
+		}},
 	}, {
 		Name: "ISO31661A", Func: ISO31661A, Cases: Cases{{
 			args: args{{0}},
@@ -1856,6 +2231,28 @@ func Test(t *testing.T) {
 				"ZW",
 			},
 		}},
+	}, {
+		Name: "ISO31661N", Func: ISO31661N, Cases: Cases{{
+			pass: vals{"840", "276", "004", "020", "894"},
+			fail: vals{"", "40", "0840", "999", "000", "US", "abc"},
+		}},
+	}, {
+		Name: "ISO31662", Func: ISO31662, Cases: Cases{{
+			pass: vals{"US-CA", "us-ny", "GB-ENG", "DE-BY", "FR-75", "CH-ZH", "JP-13"},
+			fail: vals{"", "US", "CA", "US-XX", "XX-CA", "USCA", "US-"},
+		}, {
+			args: args{{"US"}},
+			pass: vals{"US-CA", "CA", "ny", "DC"},
+			fail: vals{"", "DE-BY", "BY", "XX"},
+		}, {
+			args: args{{"de", "at"}},
+			pass: vals{"DE-BY", "BY", "AT-9", "9"},
+			fail: vals{"US-CA", "CA", "CH-ZH"},
+		}, {
+			args: args{{"CAN"}},
+			pass: vals{"CA-ON", "QC"},
+			fail: vals{"US-CA", "CA"},
+		}},
 	}, {
 		Name: "ISO4217", Func: ISO4217, Cases: Cases{{
 			pass: vals{
@@ -1865,6 +2262,99 @@ func Test(t *testing.T) {
 				// TODO
 			},
 		}},
+	}, {
+		Name: "ISO8601", Func: ISO8601, Cases: Cases{{
+			pass: vals{
+				"2009",
+				"2009-12",
+				"2009-12-31",
+				"20091231",
+				"2008-02-29",
+				"2009-001",
+				"2009365",
+				"2008-366",
+				"2009-W01",
+				"2009W53",
+				"2009-W53-7",
+				"2009W011",
+				"2020-W53-1",
+				"2009-05-19T14",
+				"2009-05-19T14:39",
+				"2009-05-19T14:39:22",
+				"2009-05-19T143922",
+				"20090519T143922",
+				"2009-05-19T14:39:22.5",
+				"2009-05-19T14:39:22,500",
+				"2009-05-19T14.5",
+				"2009-05-19T24:00",
+				"2009-05-19T24:00:00.000",
+				"2009-12-31T23:59:60Z",
+				"2009-05-19T14:39Z",
+				"2009-05-19T14:39:22+06:00",
+				"2009-05-19T14:39:22-0600",
+				"2009-05-19T14:39:22-01",
+				"2009-139T14:39:22Z",
+				"2009-W21-2T14:39:22Z",
+			},
+			fail: vals{
+				"",
+				"200",
+				"20091",
+				"200912",
+				"2009-13",
+				"2009-00",
+				"2009-02-29",
+				"1900-02-29",
+				"2009-04-31",
+				"2009-12-00",
+				"2009-000",
+				"2009-366",
+				"2009-W00",
+				"2009-W54",
+				"2010-W53",
+				"2009-W01-0",
+				"2009-W01-8",
+				"2009-1-1",
+				"2009-05-19T",
+				"2009-05T14:39",
+				"2009-W21T14:39",
+				"2009-05-19T25:00",
+				"2009-05-19T24:01",
+				"2009-05-19T24:00:00.1",
+				"2009-05-19T14:60",
+				"2009-05-19T14:39:61",
+				"2009-05-19T14:39:22.",
+				"2009-05-19T1:39",
+				"2009-05-19T14:39:22+24:00",
+				"2009-05-19T14:39:22+06:0",
+				"2009-05-19T14:39:22ZZ",
+				"2009-05-19 14:39:22",
+				"2009-05-19t14:39:22",
+			},
+		}, {
+			args: args{{"Z", "+02:00"}},
+			pass: vals{
+				"2009-05-19T14:39:22Z",
+				"2009-05-19T14:39:22+00",
+				"2009-05-19T14:39:22+0200",
+			},
+			fail: vals{
+				"2009-05-19",
+				"2009-05-19T14:39:22",
+				"2009-05-19T14:39:22+01:00",
+			},
+		}, {
+			args: args{{"Europe/Berlin"}},
+			pass: vals{
+				"2021-01-15T12:00:00+01:00",
+				"2021-07-15T12:00:00+02:00",
+			},
+			fail: vals{
+				"2021-01-15T12:00:00+02:00",
+				"2021-07-15T12:00:00+01:00",
+				"2021-07-15T12:00:00Z",
+			},
+		}},
 	}, {
 		Name: "ISRC", Func: ISRC, Cases: Cases{{
 			pass: vals{
@@ -2097,6 +2587,7 @@ func Test(t *testing.T) {
 		}},
 	}, {
 		Name: "Locale", Func: Locale, Cases: Cases{{
+			args: args{{false}},
 			pass: vals{
 				"uz_Latn_UZ",
 				"en",
@@ -2112,6 +2603,26 @@ func Test(t *testing.T) {
 				"12",
 				"12_DD",
 			},
+		}, {
+			args: args{{true}},
+			pass: vals{
+				"uz_Latn_UZ",
+				"en",
+				"gsw",
+				"es_ES",
+				"sw-KE",
+				"zh_Hant_TW",
+				"ca_ES_VALENCIA",
+				"en_US_POSIX",
+				"de_DE_u_co_phonebk",
+			},
+			fail: vals{
+				"xx_QQ",
+				"en_OO",
+				"lo_POP",
+				"12",
+				"12_DD",
+			},
 		}},
 	}, {
 		Name: "LowerCase", Func: LowerCase, Cases: Cases{{
@@ -2387,7 +2898,6 @@ func Test(t *testing.T) {
 				"2225855203075256",
 				"2720428011723762",
 				"2718760626256570",
-				"6765780016990268",
 				"4716989580001715211",
 			},
 			fail: vals{
@@ -2403,6 +2913,61 @@ func Test(t *testing.T) {
 				"623491788middle2863855",
 				"6234917882863855suffix",
 				"4716989580001715213",
+				"41111111111111113",
+				"3782822463100052",
+				"6765780016990268", // no network has the IIN 6765
+			},
+		}, {
+			pass: vals{
+				"378282246310005",
+				"2200123456789019",
+				"6521501234567893",
+				"3530111333300000",
+				"501812345673",
+				"6759649826438453",
+				"4000000000006",
+				"4111111111111111110",
+				"2221000000000009",
+				"2720999999999996",
+				"6221261234567897",
+			},
+		}, {
+			args: args{{"visa"}, {"VISA", "mastercard"}},
+			pass: vals{
+				"4111111111111111",
+				"4111 1111 1111 1111",
+			},
+			fail: vals{
+				"378282246310005",
+				"2200123456789019",
+				"4111111111111112",
+			},
+		}, {
+			args: args{{"mastercard", "mir"}},
+			pass: vals{
+				"5555555555554444",
+				"2221000000000009",
+				"2200123456789019",
+			},
+			fail: vals{
+				"4111111111111111",
+				"6521501234567893",
+			},
+		}, {
+			// co-branded ranges
+			args: args{{"unionpay"}, {"discover"}},
+			pass: vals{
+				"6221260000000000",
+				"6221261234567897",
+			},
+		}, {
+			args: args{{"rupay"}, {"discover"}},
+			pass: vals{
+				"6521500000000006",
+				"6521501234567893",
+			},
+			fail: vals{
+				"4111111111111111",
 			},
 		}},
 	}, {
@@ -2427,6 +2992,48 @@ func Test(t *testing.T) {
 				"65536",
 			},
 		}},
+	}, {
+		Name: "RFC3339", Func: RFC3339, Cases: Cases{{
+			pass: vals{
+				"2009-05-19T14:39:22Z",
+				"2009-05-19T14:39:22z",
+				"2009-05-19t14:39:22Z",
+				"2009-05-19 14:39:22Z",
+				"2009-05-19T14:39:22.123456789Z",
+				"2009-05-19T14:39:22+06:00",
+				"2009-05-19T14:39:22-23:59",
+				"2008-02-29T00:00:00Z",
+				"2008-12-31T23:59:60Z",
+			},
+			fail: vals{
+				"",
+				"2009-05-19",
+				"2009-05-19T14:39Z",
+				"2009-05-19T14:39:22",
+				"2009-05-19T14:39:22.Z",
+				"2009-05-19T14:39:22+0600",
+				"2009-05-19T14:39:22+24:00",
+				"2009-05-19T14:39:22+06:60",
+				"2009-02-29T14:39:22Z",
+				"2009-04-31T14:39:22Z",
+				"2009-13-01T14:39:22Z",
+				"2009-00-01T14:39:22Z",
+				"2009-05-19T24:00:00Z",
+				"2009-05-19T14:60:00Z",
+				"2009-05-19T14:39:61Z",
+				"2009-W21-2T14:39:22Z",
+			},
+		}, {
+			args: args{{"utc", "-05:00"}},
+			pass: vals{
+				"2009-05-19T14:39:22Z",
+				"2009-05-19T14:39:22+00:00",
+				"2009-05-19T14:39:22-05:00",
+			},
+			fail: vals{
+				"2009-05-19T14:39:22+05:00",
+			},
+		}},
 	}, {
 		Name: "RGB", Func: RGB, Cases: Cases{{
 			pass: vals{
@@ -2757,3 +3364,395 @@ func Test(t *testing.T) {
 		})
 	}
 }
+
+func TestParseDec(t *testing.T) {
+	tests := []struct {
+		v, locale string
+		want      string
+		err       bool
+	}{
+		{v: "123", locale: "en", want: "123"},
+		{v: "-.25", locale: "en", want: "-0.25"},
+		{v: "+1,234.50", locale: "en", want: "1234.50"},
+		{v: "-1\u00a0234,5", locale: "cs_CZ", want: "-1234.5"},
+		{v: "٠٫٠١", locale: "ar_EG", want: "0.01"},
+		{v: "12345678901234567890.123456789", locale: "en", want: "12345678901234567890.123456789"},
+		{v: "1,0", locale: "en", err: true},
+		{v: "", locale: "en", err: true},
+		{v: "1.5", locale: "xx", err: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDec(tt.v, tt.locale)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseDec(%q, %q) error=%v; want error=%t", tt.v, tt.locale, err, tt.err)
+		} else if err == nil && got.String() != tt.want {
+			t.Errorf("ParseDec(%q, %q) got=%s; want=%s", tt.v, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestDec(t *testing.T) {
+	tests := []struct {
+		x, y      string
+		cmp       int
+		scale     int
+		precision int
+	}{
+		{x: "0", y: "0.00", cmp: 0, scale: 0, precision: 1},
+		{x: "1.50", y: "1.5", cmp: 0, scale: 2, precision: 3},
+		{x: "0.01", y: "0.1", cmp: -1, scale: 2, precision: 1},
+		{x: "-123.45", y: "-123.4", cmp: -1, scale: 2, precision: 5},
+		{x: "1000000.00", y: "999999.999", cmp: 1, scale: 2, precision: 9},
+		{x: "0.30000000000000000001", y: "0.3", cmp: 1, scale: 20, precision: 20},
+	}
+
+	for _, tt := range tests {
+		x, y := MustParseDec(tt.x), MustParseDec(tt.y)
+		if got := x.Cmp(y); got != tt.cmp {
+			t.Errorf("%s.Cmp(%s) got=%d; want=%d", tt.x, tt.y, got, tt.cmp)
+		}
+		if got := y.Cmp(x); got != -tt.cmp {
+			t.Errorf("%s.Cmp(%s) got=%d; want=%d", tt.y, tt.x, got, -tt.cmp)
+		}
+		if got := x.Scale(); got != tt.scale {
+			t.Errorf("%s.Scale() got=%d; want=%d", tt.x, got, tt.scale)
+		}
+		if got := x.Precision(); got != tt.precision {
+			t.Errorf("%s.Precision() got=%d; want=%d", tt.x, got, tt.precision)
+		}
+	}
+
+	if got := FloatDec(0.1).Cmp(MustParseDec("0.1")); got != 0 {
+		t.Errorf("FloatDec(0.1).Cmp(0.1) got=%d; want=0", got)
+	}
+
+	floats := []struct {
+		x   string
+		f   float64
+		cmp int
+	}{
+		{x: "0.1", f: 0.1, cmp: 0},
+		{x: "0.10", f: 0.2, cmp: -1},
+		{x: "-1000000", f: -1e6, cmp: 0},
+		{x: "12345678901234567890.1234", f: 1e19, cmp: 1},
+		{x: "12345678901234567890.1234", f: math.Inf(1), cmp: -1},
+		{x: "-12345678901234567890.1234", f: math.Inf(-1), cmp: 1},
+	}
+	for _, tt := range floats {
+		if got := MustParseDec(tt.x).CmpFloat(tt.f); got != tt.cmp {
+			t.Errorf("%s.CmpFloat(%v) got=%d; want=%d", tt.x, tt.f, got, tt.cmp)
+		}
+	}
+
+	// integers beyond 2^53 cannot be represented exactly by a float64
+	ints := []struct {
+		x   string
+		i   int64
+		cmp int
+	}{
+		{x: "9007199254740993", i: 9007199254740993, cmp: 0},
+		{x: "9007199254740992.5", i: 9007199254740993, cmp: -1},
+		{x: "-9223372036854775808", i: math.MinInt64, cmp: 0},
+		{x: "-9223372036854775808.01", i: math.MinInt64, cmp: -1},
+	}
+	for _, tt := range ints {
+		if got := MustParseDec(tt.x).CmpInt(tt.i); got != tt.cmp {
+			t.Errorf("%s.CmpInt(%d) got=%d; want=%d", tt.x, tt.i, got, tt.cmp)
+		}
+	}
+
+	uints := []struct {
+		x   string
+		u   uint64
+		cmp int
+	}{
+		{x: "18446744073709551615", u: math.MaxUint64, cmp: 0},
+		{x: "18446744073709551614.99", u: math.MaxUint64, cmp: -1},
+		{x: "-1", u: 0, cmp: -1},
+	}
+	for _, tt := range uints {
+		if got := MustParseDec(tt.x).CmpUint(tt.u); got != tt.cmp {
+			t.Errorf("%s.CmpUint(%d) got=%d; want=%d", tt.x, tt.u, got, tt.cmp)
+		}
+	}
+}
+
+func TestCanonicalBCP47(t *testing.T) {
+	tests := []struct {
+		v, want string
+		ok      bool
+	}{
+		{v: "en-us", want: "en-US", ok: true},
+		{v: "ZH-HANT-tw", want: "zh-Hant-TW", ok: true},
+		{v: "zh-cmn-Hant-TW", want: "cmn-Hant-TW", ok: true},
+		{v: "zh-yue", want: "yue", ok: true},
+		{v: "iw-IL", want: "he-IL", ok: true},
+		{v: "de-DD", want: "de-DE", ok: true},
+		{v: "i-klingon", want: "tlh", ok: true},
+		{v: "i-default", want: "i-default", ok: true},
+		{v: "sgn-BR-x-foo", want: "bzs-x-foo", ok: true},
+		{v: "en-u-nu-latn-t-de", want: "en-t-de-u-nu-latn", ok: true},
+		{v: "en-US-x-Private", want: "en-US-x-private", ok: true},
+		{v: "xx-QQ", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := CanonicalBCP47(tt.v)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("CanonicalBCP47(%q) got=(%q, %t); want=(%q, %t)", tt.v, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestISO639Macrolanguage(t *testing.T) {
+	tests := []struct {
+		code, want string
+	}{
+		{code: "cmn", want: "zh"},
+		{code: "yue", want: "zh"},
+		{code: "arz", want: "ar"},
+		{code: "pes", want: "fa"},
+		{code: "eng", want: ""},
+		{code: "zho", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := tables.ISO639_3[tt.code].Macro; got != tt.want {
+			t.Errorf("ISO639_3[%q].Macro got=%q; want=%q", tt.code, got, tt.want)
+		}
+	}
+}
+
+// The example IBANs of the SWIFT IBAN registry. The countries that are not
+// part of the registry use a published example of their format, the French
+// territories use the registry's French example with their own country code.
+var ibanExamples = map[string]string{
+	"AD": "AD1200012030200359100100",
+	"AE": "AE070331234567890123456",
+	"AL": "AL47212110090000000235698741",
+	"AO": "AO06004400006729503010102",
+	"AT": "AT611904300234573201",
+	"AZ": "AZ21NABZ00000000137010001944",
+	"BA": "BA391290079401028494",
+	"BE": "BE68539007547034",
+	"BF": "BF42BF0840101300463574000390",
+	"BG": "BG80BNBG96611020345678",
+	"BH": "BH67BMAG00001299123456",
+	"BI": "BI4210000100010000332045181",
+	"BJ": "BJ66BJ0610100100144390000769",
+	"BL": "BL6820041010050500013M02606",
+	"BR": "BR1800360305000010009795493C1",
+	"BY": "BY13NBRB3600900000002Z00AB00",
+	"CF": "CF4220001000010234523890151",
+	"CG": "CG3930011000101013451300019",
+	"CH": "CH9300762011623852957",
+	"CI": "CI93CI0080111301134291200589",
+	"CM": "CM2110003001000500000605306",
+	"CR": "CR05015202001026284066",
+	"CV": "CV64000300004547069110176",
+	"CY": "CY17002001280000001200527600",
+	"CZ": "CZ6508000000192000145399",
+	"DE": "DE89370400440532013000",
+	"DJ": "DJ2100010000000154000100186",
+	"DK": "DK5000400440116243",
+	"DO": "DO28BAGR00000001212453611324",
+	"DZ": "DZ580002100001113000000570",
+	"EE": "EE382200221020145685",
+	"EG": "EG380019000500000000263180002",
+	"ES": "ES9121000418450200051332",
+	"FI": "FI2112345600000785",
+	"FK": "FK88SC123456789012",
+	"FO": "FO6264600001631634",
+	"FR": "FR1420041010050500013M02606",
+	"GA": "GA2140021010032001890020126",
+	"GB": "GB29NWBK60161331926819",
+	"GE": "GE29NB0000000101904917",
+	"GF": "GF4120041010050500013M02606",
+	"GI": "GI75NWBK000000007099453",
+	"GL": "GL8964710001000206",
+	"GP": "GP1120041010050500013M02606",
+	"GQ": "GQ7050002001003715228190196",
+	"GR": "GR1601101250000000012300695",
+	"GT": "GT82TRAJ01020000001210029690",
+	"GW": "GW72GW1430010181800063786",
+	"HN": "HN05CABF00000000000000250005",
+	"HR": "HR1210010051863000160",
+	"HU": "HU42117730161111101800000000",
+	"IE": "IE29AIBK93115212345678",
+	"IL": "IL620108000000099999999",
+	"IQ": "IQ98NBIQ850123456789012",
+	"IR": "IR200170000000339545727003",
+	"IS": "IS140159260076545510730339",
+	"IT": "IT60X0542811101000000123456",
+	"JO": "JO94CBJO0010000000000131000302",
+	"KM": "KM4600005000010010904400137",
+	"KW": "KW81CBKU0000000000001234560101",
+	"KZ": "KZ86125KZT5004100100",
+	"LB": "LB62099900000001001901229114",
+	"LC": "LC55HEMM000100010012001200023015",
+	"LI": "LI21088100002324013AA",
+	"LT": "LT121000011101001000",
+	"LU": "LU280019400644750000",
+	"LV": "LV80BANK0000435195001",
+	"LY": "LY83002048000020100120361",
+	"MA": "MA64011519000001205000534921",
+	"MC": "MC5811222000010123456789030",
+	"MD": "MD24AG000225100013104168",
+	"ME": "ME25505000012345678951",
+	"MF": "MF8420041010050500013M02606",
+	"MG": "MG4600005030010101914016056",
+	"MK": "MK07250120000058984",
+	"ML": "ML78D00890170001002505000536",
+	"MN": "MN121234123456789123",
+	"MQ": "MQ5120041010050500013M02606",
+	"MR": "MR1300020001010000123456753",
+	"MT": "MT84MALT011000012345MTLCAST001S",
+	"MU": "MU17BOMM0101101030300200000MUR",
+	"MZ": "MZ59000301080016367102371",
+	"NC": "NC8420041010050500013M02606",
+	"NE": "NE09NE0380100100130305200000",
+	"NI": "NI45BAPR00000013000003558124",
+	"NL": "NL91ABNA0417164300",
+	"NO": "NO9386011117947",
+	"OM": "OM810180000001299123456",
+	"PF": "PF5720041010050500013M02606",
+	"PK": "PK36SCBL0000001123456702",
+	"PL": "PL61109010140000071219812874",
+	"PM": "PM3620041010050500013M02606",
+	"PS": "PS92PALS000000000400123456702",
+	"PT": "PT50000201231234567890154",
+	"QA": "QA58DOHB00001234567890ABCDEFG",
+	"RE": "RE4220041010050500013M02606",
+	"RO": "RO49AAAA1B31007593840000",
+	"RS": "RS35260005601001611379",
+	"RU": "RU0304452522540817810538091310419",
+	"SA": "SA0380000000608010167519",
+	"SC": "SC18SSCB11010000000000001497USD",
+	"SD": "SD2129010501234001",
+	"SE": "SE4550000000058398257466",
+	"SI": "SI56263300012039086",
+	"SK": "SK3112000000198742637541",
+	"SM": "SM86U0322509800000000270100",
+	"SN": "SN08SN0100152000048500003035",
+	"SO": "SO211000001001000100141",
+	"ST": "ST68000100010051845310112",
+	"SV": "SV62CENR00000000000000700025",
+	"TD": "TD8960002000010271091600153",
+	"TF": "TF2120041010050500013M02606",
+	"TG": "TG60TG0190000420100000034560",
+	"TL": "TL380080012345678910157",
+	"TN": "TN5910006035183598478831",
+	"TR": "TR330006100519786457841326",
+	"UA": "UA213223130000026007233566001",
+	"VA": "VA59001123000012345678",
+	"VG": "VG96VPVG0000012345678901",
+	"WF": "WF9120041010050500013M02606",
+	"XK": "XK051212012345678906",
+	"YE": "YE15CBYE0001018861234567891234",
+	"YT": "YT3120041010050500013M02606",
+}
+
+func TestIBANExamples(t *testing.T) {
+	for cc := range tables.IBANRegexp {
+		if _, ok := ibanExamples[cc]; !ok {
+			t.Errorf("ibanExamples[%q] missing", cc)
+		}
+	}
+	for cc, v := range ibanExamples {
+		if !IBAN(v) {
+			t.Errorf("IBAN(%q) got=false; want=true", v)
+		}
+		if fn, ok := tables.BBANChecksum[cc]; ok && !fn(v[4:]) {
+			t.Errorf("BBANChecksum[%q](%q) got=false; want=true", cc, v[4:])
+		}
+	}
+}
+
+func TestCardNetwork(t *testing.T) {
+	tests := []struct {
+		v    string
+		want string
+		ok   bool
+	}{
+		{v: "4111111111111111", want: "visa", ok: true},
+		{v: "4111-1111-1111-1111", want: "visa", ok: true},
+		{v: "5555555555554444", want: "mastercard", ok: true},
+		{v: "2720999999999996", want: "mastercard", ok: true},
+		{v: "378282246310005", want: "amex", ok: true},
+		{v: "6011111111111117", want: "discover", ok: true},
+		{v: "6221261234567897", want: "discover", ok: true},
+		{v: "6226050967750613", want: "discover", ok: true},
+		{v: "6246281879460688", want: "unionpay", ok: true},
+		{v: "3530111333300000", want: "jcb", ok: true},
+		{v: "30569309025904", want: "diners", ok: true},
+		{v: "501812345673", want: "maestro", ok: true},
+		{v: "6759649826438453", want: "maestro", ok: true},
+		{v: "6763123456789012", want: "maestro", ok: true},
+		{v: "6767700000000009", want: "maestro", ok: true},
+		{v: "2200123456789019", want: "mir", ok: true},
+		{v: "6521501234567893", want: "rupay", ok: true},
+		{v: "4", want: "visa", ok: true},
+		{v: "", want: "", ok: false},
+		{v: "9999999999999995", want: "", ok: false},
+		{v: "6765780016990268", want: "", ok: false},
+		{v: "5612345678901234", want: "", ok: false},
+		{v: "2720999999999996x", want: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := CardNetwork(tt.v)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("CardNetwork(%q) got=(%q, %t); want=(%q, %t)", tt.v, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestCardNetworks(t *testing.T) {
+	tests := []struct {
+		v    string
+		want []string
+	}{
+		{v: "4111111111111111", want: []string{"visa"}},
+		{v: "6221260000000000", want: []string{"discover", "unionpay"}},
+		{v: "6221-2600-0000-0000", want: []string{"discover", "unionpay"}},
+		{v: "6521500000000006", want: []string{"rupay", "discover"}},
+		{v: "6246281879460688", want: []string{"unionpay"}},
+		{v: "9999999999999995", want: nil},
+		{v: "", want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := CardNetworks(tt.v); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CardNetworks(%q) got=%q; want=%q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestCardExpiry(t *testing.T) {
+	now := time.Date(2024, 5, 31, 23, 59, 59, 0, time.UTC)
+
+	tests := []struct {
+		v    string
+		want bool
+	}{
+		{v: "05/24", want: true},
+		{v: "06/24", want: true},
+		{v: "12/99", want: true},
+		{v: "01/25", want: true},
+		{v: "04/24", want: false},
+		{v: "12/23", want: false},
+		{v: "00/25", want: false},
+		{v: "13/25", want: false},
+		{v: "5/25", want: false},
+		{v: "05/2025", want: false},
+		{v: "05-25", want: false},
+		{v: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := cardExpiry(tt.v, now); got != tt.want {
+			t.Errorf("CardExpiry(%q) got=%t; want=%t", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Package address validates postal addresses against the address formats
+// of their countries. The formats are based on the metadata of Google's
+// libaddressinput, however only a subset of the countries, the ones in the
+// formats table of format_table.go, have a format. The addresses of the other
+// countries, the ones in the noFormat table of the same file, are not
+// validated, for those Validate reports ErrNoFormat.
+package address
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// References:
+// - https://github.com/google/libaddressinput/wiki/AddressValidationMetadata
+// - https://chromium-i18n.appspot.com/ssl-address
+// ...
+
+// Address represents a postal address.
+type Address struct {
+	// The ISO 3166-1 alpha-2 or alpha-3 code of the country.
+	Country string
+	// The name of the recipient.
+	Name string
+	// The name of the recipient's organization.
+	Organization string
+	// The street address lines, e.g. the street name, the house number,
+	// the apartment number, etc.
+	StreetAddress []string
+	// The dependent locality, e.g. a neighborhood, a district, or a suburb.
+	DependentLocality string
+	// The locality, i.e. the city or the town.
+	Locality string
+	// The top-level administrative area of the country, e.g. a state, a
+	// province, or a prefecture. The subdivision can be specified by its
+	// ISO 3166-2 code, with or without the country code prefix, or by its
+	// name, e.g. "US-CA", "CA", and "California" are all accepted.
+	Subdivision string
+	// The zip / postal code.
+	PostalCode string
+	// The sorting code, e.g. the French CEDEX.
+	SortingCode string
+}
+
+// Field identifies a field of an address, the values are the same as the
+// ones used in the address formats of Google's libaddressinput metadata.
+type Field byte
+
+const (
+	Country           Field = 'R'
+	Name              Field = 'N'
+	Organization      Field = 'O'
+	StreetAddress     Field = 'A'
+	DependentLocality Field = 'D'
+	Locality          Field = 'C'
+	Subdivision       Field = 'S'
+	PostalCode        Field = 'Z'
+	SortingCode       Field = 'X'
+)
+
+// the fields of an Address, excluding the Country
+var allFields = []Field{
+	Name,
+	Organization,
+	StreetAddress,
+	DependentLocality,
+	Locality,
+	Subdivision,
+	PostalCode,
+	SortingCode,
+}
+
+var fieldNames = map[Field]string{
+	Country:           "country",
+	Name:              "name",
+	Organization:      "organization",
+	StreetAddress:     "street address",
+	DependentLocality: "dependent locality",
+	Locality:          "locality",
+	Subdivision:       "subdivision",
+	PostalCode:        "postal code",
+	SortingCode:       "sorting code",
+}
+
+func (f Field) String() string {
+	if name, ok := fieldNames[f]; ok {
+		return name
+	}
+	return "Field(" + string(rune(f)) + ")"
+}
+
+// Error describes the field of an address that failed validation.
+type Error struct {
+	// The field that failed validation.
+	Field Field
+	// Missing is true if the field is required but empty,
+	// otherwise the field's value is invalid.
+	Missing bool
+}
+
+// ErrNoFormat is returned by Validate if the address format
+// of the address's country is not known.
+var ErrNoFormat = errors.New("address: unknown address format of country")
+
+func (e *Error) Error() string {
+	if e.Missing {
+		return "address: missing " + e.Field.String()
+	}
+	return "address: invalid " + e.Field.String()
+}
+
+// Validate validates the address a against the address format of its
+// country and returns an *Error describing the first invalid field, or nil
+// if the address is valid. If the address format of the country is not
+// known, Validate returns ErrNoFormat. Validate checks that:
+//   - the country is a known ISO 3166-1 country,
+//   - the fields that are required by the format are not empty,
+//   - the fields contain only the allowed characters,
+//   - the postal code, if present, is valid for the country,
+//   - the subdivision, if present, is a subdivision of the country.
+//
+// The postal code is checked only if the country's package, e.g.
+// "github.com/frk/isvalid/l10n/country/us", was imported. The subdivision
+// is checked only if the country's format has SubdivisionCodes set.
+func Validate(a Address) error {
+	c, ok := country.Get(a.Country)
+	if !ok {
+		return &Error{Field: Country, Missing: len(a.Country) == 0}
+	}
+	f, ok := Get(c.A2)
+	if !ok {
+		return ErrNoFormat
+	}
+
+	// the fields are checked in the order of the format's layout,
+	// followed by the fields that are not part of the layout
+	fields := f.Fields()
+	for _, fd := range allFields {
+		if !f.has(fd) {
+			fields = append(fields, fd)
+		}
+	}
+
+	for _, fd := range fields {
+		v := a.get(fd)
+		if len(v) == 0 {
+			if f.IsRequired(fd) {
+				return &Error{Field: fd, Missing: true}
+			}
+			continue
+		}
+		if !f.validChars(v) {
+			return &Error{Field: fd}
+		}
+	}
+
+	if len(a.PostalCode) > 0 && c.Zip != nil && !c.Zip.MatchString(a.PostalCode) {
+		return &Error{Field: PostalCode}
+	}
+	if len(a.Subdivision) > 0 && f.SubdivisionCodes && !isSubdivision(c.A2, a.Subdivision) {
+		return &Error{Field: Subdivision}
+	}
+	return nil
+}
+
+// Valid reports whether or not the address a is valid, see Validate.
+func Valid(a Address) bool {
+	return Validate(a) == nil
+}
+
+// get returns the value of the field fd, the street
+// address lines are joined and returned as one value.
+func (a Address) get(fd Field) string {
+	switch fd {
+	case Name:
+		return strings.TrimSpace(a.Name)
+	case Organization:
+		return strings.TrimSpace(a.Organization)
+	case StreetAddress:
+		return strings.TrimSpace(strings.Join(a.StreetAddress, "\n"))
+	case DependentLocality:
+		return strings.TrimSpace(a.DependentLocality)
+	case Locality:
+		return strings.TrimSpace(a.Locality)
+	case Subdivision:
+		return strings.TrimSpace(a.Subdivision)
+	case PostalCode:
+		return strings.TrimSpace(a.PostalCode)
+	case SortingCode:
+		return strings.TrimSpace(a.SortingCode)
+	}
+	return ""
+}
+
+// validChars reports whether or not v contains only the characters allowed
+// by the format. The letters, marks, numbers, punctuation, and spaces are
+// allowed, in addition, if the format specifies the scripts, the letters
+// must belong to one of those scripts. The line breaks are allowed only
+// because the street address lines are validated as one value.
+func (f Format) validChars(v string) bool {
+	for _, r := range v {
+		switch {
+		case r == '\n':
+			continue
+		case unicode.IsLetter(r):
+			if len(f.Scripts) > 0 && !unicode.In(r, f.Scripts...) {
+				return false
+			}
+		case unicode.IsMark(r), unicode.IsNumber(r), unicode.IsPunct(r):
+		case r == ' ' || unicode.Is(unicode.Zs, r):
+		case r == '+' || r == '°' || r == '〒':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isSubdivision reports whether or not v is the ISO 3166-2 code, with or
+// without the country code prefix, or the name of a subdivision of the country
+// identified by the alpha-2 code a2.
+func isSubdivision(a2, v string) bool {
+	v = strings.TrimSpace(v)
+	code := strings.ToUpper(v)
+	if !strings.HasPrefix(code, a2+"-") {
+		code = a2 + "-" + code
+	}
+	for _, s := range country.Subdivisions(a2) {
+		if s.Code == code || strings.EqualFold(s.Name, v) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,164 @@
+package address
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/frk/isvalid/l10n/country"
+	_ "github.com/frk/isvalid/l10n/country/de"
+	_ "github.com/frk/isvalid/l10n/country/jp"
+	_ "github.com/frk/isvalid/l10n/country/us"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		a    Address
+		want error
+	}{{
+		a: Address{
+			Country:       "US",
+			Name:          "John Doe",
+			StreetAddress: []string{"1600 Amphitheatre Pkwy", "Suite #100"},
+			Locality:      "Mountain View",
+			Subdivision:   "CA",
+			PostalCode:    "94043",
+		},
+		want: nil,
+	}, {
+		a: Address{
+			Country:       "USA",
+			StreetAddress: []string{"1600 Amphitheatre Pkwy"},
+			Locality:      "Mountain View",
+			Subdivision:   "California",
+			PostalCode:    "94043-1351",
+		},
+		want: nil,
+	}, {
+		a: Address{
+			Country:       "DE",
+			Organization:  "Müller GmbH",
+			StreetAddress: []string{"Straße des 17. Juni 135"},
+			Locality:      "Berlin",
+			PostalCode:    "10623",
+		},
+		want: nil,
+	}, {
+		a: Address{
+			Country:       "JP",
+			StreetAddress: []string{"千代田区丸の内1-1-1"},
+			Subdivision:   "東京都",
+			PostalCode:    "100-0005",
+		},
+		want: nil,
+	}, {
+		// known country without a known format
+		a: Address{
+			Country:       "TV",
+			StreetAddress: []string{"Vaiaku"},
+			Locality:      "Funafuti",
+		},
+		want: ErrNoFormat,
+	}, {
+		a:    Address{StreetAddress: []string{"1600 Amphitheatre Pkwy"}},
+		want: &Error{Field: Country, Missing: true},
+	}, {
+		a:    Address{Country: "XX"},
+		want: &Error{Field: Country},
+	}, {
+		a: Address{
+			Country:       "US",
+			StreetAddress: []string{"1600 Amphitheatre Pkwy"},
+			Subdivision:   "CA",
+			PostalCode:    "94043",
+		},
+		want: &Error{Field: Locality, Missing: true},
+	}, {
+		a: Address{
+			Country:       "US",
+			StreetAddress: []string{"1600 Amphitheatre Pkwy"},
+			Locality:      "Mountain View",
+			Subdivision:   "CA",
+			PostalCode:    "9404",
+		},
+		want: &Error{Field: PostalCode},
+	}, {
+		a: Address{
+			Country:       "US",
+			StreetAddress: []string{"1600 Amphitheatre Pkwy"},
+			Locality:      "Mountain View",
+			Subdivision:   "XY",
+			PostalCode:    "94043",
+		},
+		want: &Error{Field: Subdivision},
+	}, {
+		a: Address{
+			Country:       "DE",
+			StreetAddress: []string{"Straße des 17. Juni 135"},
+			Locality:      "Berlin",
+		},
+		want: &Error{Field: PostalCode, Missing: true},
+	}, {
+		a: Address{
+			Country:       "DE",
+			StreetAddress: []string{"Straße des 17. Juni <135>"},
+			Locality:      "Berlin",
+			PostalCode:    "10623",
+		},
+		want: &Error{Field: StreetAddress},
+	}, {
+		a: Address{
+			Country:       "JP",
+			StreetAddress: []string{"千代田区丸の内1-1-1"},
+			Subdivision:   "Москва",
+			PostalCode:    "100-0005",
+		},
+		want: &Error{Field: Subdivision},
+	}}
+
+	for _, tt := range tests {
+		got := Validate(tt.a)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Validate(%+v) got=%v; want=%v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFields(t *testing.T) {
+	tests := []struct {
+		cc   string
+		want []Field
+	}{
+		{cc: "US", want: []Field{Name, Organization, StreetAddress, Locality, Subdivision, PostalCode}},
+		{cc: "FRA", want: []Field{Organization, Name, StreetAddress, PostalCode, Locality, SortingCode}},
+		{cc: "CH", want: []Field{Organization, Name, StreetAddress, PostalCode, Locality}},
+	}
+
+	for _, tt := range tests {
+		f, ok := Get(tt.cc)
+		if !ok {
+			t.Errorf("Get(%q) got=false; want=true", tt.cc)
+		} else if got := f.Fields(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Get(%q).Fields() got=%q; want=%q", tt.cc, got, tt.want)
+		}
+	}
+
+	for _, cc := range []string{"XX", "TV", "TUV"} {
+		if _, ok := Get(cc); ok {
+			t.Errorf("Get(%q) got=true; want=false", cc)
+		}
+	}
+}
+
+// every country must either have a format or be listed as one without it
+func TestFormatTables(t *testing.T) {
+	for cc := range country.ISO31661A_2 {
+		if _, ok := formats[cc]; ok == noFormat[cc] {
+			t.Errorf("country %q: in formats=%t; in noFormat=%t", cc, ok, noFormat[cc])
+		}
+	}
+	for cc := range noFormat {
+		if _, ok := country.ISO31661A_2[cc]; !ok {
+			t.Errorf("noFormat[%q]: unknown country", cc)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package address
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// Format describes the address format of a country.
+type Format struct {
+	// The layout of the address, in the format used by Google's
+	// libaddressinput, e.g. "%N%n%O%n%A%n%C, %S %Z". The "%" followed
+	// by a Field's value marks the position of that field, "%n" marks
+	// a line break, and the rest of the text is literal.
+	Layout string
+	// The fields that are required, e.g. "ACSZ".
+	Required string
+	// The name of the country's postal code, e.g. "zip" or "postal".
+	PostalCodeType string
+	// The name of the country's top-level subdivisions, e.g. "state" or "province".
+	SubdivisionType string
+	// SubdivisionCodes is true if the country's subdivisions are commonly
+	// written as their ISO 3166-2 codes, e.g. "CA" for California, in which
+	// case the address's subdivision is validated against the ISO 3166-2
+	// subdivisions of the country.
+	SubdivisionCodes bool
+	// The scripts whose letters are allowed in the address, if nil
+	// then the letters of any script are allowed.
+	Scripts []*unicode.RangeTable
+}
+
+// Get returns the address format of the country identified by the ISO 3166-1
+// alpha-2 or alpha-3 code cc. If cc is not a known country code, or if the
+// country's format is not known, see format_table.go, Get returns false.
+func Get(cc string) (Format, bool) {
+	if c, ok := country.Get(cc); ok {
+		if f, ok := formats[c.A2]; ok {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+// Fields returns the fields of the format's layout in the order
+// in which they appear in the layout.
+func (f Format) Fields() (fields []Field) {
+	for i := 0; i < len(f.Layout)-1; i++ {
+		if f.Layout[i] != '%' {
+			continue
+		}
+		i += 1
+		if fd := Field(f.Layout[i]); fd != 'n' {
+			fields = append(fields, fd)
+		}
+	}
+	return fields
+}
+
+// IsRequired reports whether or not the field fd is required by the format.
+func (f Format) IsRequired(fd Field) bool {
+	return strings.IndexByte(f.Required, byte(fd)) > -1
+}
+
+// has reports whether or not the field fd is part of the format's layout.
+func (f Format) has(fd Field) bool {
+	return strings.Contains(f.Layout, "%"+string(rune(fd)))
+}
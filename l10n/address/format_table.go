@@ -0,0 +1,273 @@
+package address
+
+import (
+	"unicode"
+)
+
+var (
+	cyrillic = []*unicode.RangeTable{unicode.Cyrillic, unicode.Latin}
+	arabic   = []*unicode.RangeTable{unicode.Arabic, unicode.Latin}
+)
+
+// The address formats of the countries, keyed by ISO 3166-1 alpha-2 code.
+// The formats are based on the metadata of Google's libaddressinput, for the
+// countries that have more than one format the Latin script one is used.
+var formats = map[string]Format{
+	"AE": {Layout: "%N%n%O%n%A%n%S", Required: "AS", PostalCodeType: "postal", SubdivisionType: "emirate", Scripts: arabic},
+	"AR": {Layout: "%N%n%O%n%A%n%Z %C%n%S", Required: "AC", PostalCodeType: "postal", SubdivisionType: "province"},
+	"AT": {Layout: "%O%n%N%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "state"},
+	"AU": {Layout: "%O%n%N%n%A%n%C %S %Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "state", SubdivisionCodes: true},
+	"BE": {Layout: "%O%n%N%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "province"},
+	"BG": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "province", Scripts: cyrillic},
+	"BR": {Layout: "%O%n%N%n%A%n%D%n%C-%S%n%Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "state", SubdivisionCodes: true},
+	"BY": {Layout: "%O%n%N%n%A%n%Z, %C%n%S", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "oblast", Scripts: cyrillic},
+	"CA": {Layout: "%N%n%O%n%A%n%C %S %Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "province", SubdivisionCodes: true},
+	"CH": {Layout: "%O%n%N%n%A%nCH-%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "canton"},
+	"CL": {Layout: "%N%n%O%n%A%n%Z %C%n%S", Required: "AC", PostalCodeType: "postal", SubdivisionType: "region"},
+	"CN": {Layout: "%N%n%O%n%A%n%D%n%C%n%S, %Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "province", Scripts: []*unicode.RangeTable{unicode.Han, unicode.Latin}},
+	"CO": {Layout: "%N%n%O%n%A%n%D%n%C, %S, %Z", Required: "AS", PostalCodeType: "postal", SubdivisionType: "department"},
+	"CZ": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"DE": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "state"},
+	"DK": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"EE": {Layout: "%N%n%O%n%A%n%Z %C %S", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"EG": {Layout: "%N%n%O%n%A%n%C%n%S%n%Z", Required: "AS", PostalCodeType: "postal", SubdivisionType: "governorate", Scripts: arabic},
+	"ES": {Layout: "%N%n%O%n%A%n%Z %C %S", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "province"},
+	"FI": {Layout: "%O%n%N%n%A%nFI-%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"FR": {Layout: "%O%n%N%n%A%n%Z %C %X", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"GB": {Layout: "%N%n%O%n%A%n%C%n%Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"GR": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region", Scripts: []*unicode.RangeTable{unicode.Greek, unicode.Latin}},
+	"HK": {Layout: "%N%n%O%n%A%n%C%n%S", Required: "AS", PostalCodeType: "postal", SubdivisionType: "area"},
+	"HR": {Layout: "%N%n%O%n%A%nHR-%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"HU": {Layout: "%N%n%O%n%C%n%A%n%Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"ID": {Layout: "%N%n%O%n%A%n%C%n%S %Z", Required: "AS", PostalCodeType: "postal", SubdivisionType: "province"},
+	"IE": {Layout: "%N%n%O%n%A%n%D%n%C%n%S%n%Z", Required: "AC", PostalCodeType: "eircode", SubdivisionType: "county"},
+	"IL": {Layout: "%N%n%O%n%A%n%C %Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "district", Scripts: []*unicode.RangeTable{unicode.Hebrew, unicode.Latin}},
+	"IN": {Layout: "%N%n%O%n%A%n%D%n%C %Z%n%S", Required: "ACSZ", PostalCodeType: "pin", SubdivisionType: "state"},
+	"IT": {Layout: "%N%n%O%n%A%n%Z %C %S", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "province", SubdivisionCodes: true},
+	"JP": {Layout: "%N%n%O%n%A, %S%n%Z", Required: "ASZ", PostalCodeType: "postal", SubdivisionType: "prefecture", Scripts: []*unicode.RangeTable{unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Latin}},
+	"KR": {Layout: "%N%n%O%n%A%n%D%n%C%n%S%n%Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "do_si", Scripts: []*unicode.RangeTable{unicode.Hangul, unicode.Han, unicode.Latin}},
+	"KZ": {Layout: "%Z%n%S%n%C%n%A%n%O%n%N", Required: "AC", PostalCodeType: "postal", SubdivisionType: "region", Scripts: cyrillic},
+	"LT": {Layout: "%O%n%N%n%A%nLT-%Z %C %S", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"LU": {Layout: "%O%n%N%n%A%nL-%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "canton"},
+	"LV": {Layout: "%N%n%O%n%A%n%S%n%C, %Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "municipality"},
+	"MX": {Layout: "%N%n%O%n%A%n%D%n%Z %C, %S", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "state"},
+	"MY": {Layout: "%N%n%O%n%A%n%D%n%Z %C%n%S", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "state"},
+	"NL": {Layout: "%O%n%N%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "province"},
+	"NO": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"NZ": {Layout: "%N%n%O%n%A%n%D%n%C %Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"PH": {Layout: "%N%n%O%n%A%n%D, %C%n%Z %S", Required: "AC", PostalCodeType: "postal", SubdivisionType: "province"},
+	"PL": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "voivodeship"},
+	"PT": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "district"},
+	"RO": {Layout: "%N%n%O%n%A%n%Z %S %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"RS": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "district", Scripts: cyrillic},
+	"RU": {Layout: "%N%n%O%n%A%n%C%n%S%n%Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "oblast", Scripts: cyrillic},
+	"SA": {Layout: "%N%n%O%n%A%n%C %Z", Required: "AC", PostalCodeType: "postal", SubdivisionType: "province", Scripts: arabic},
+	"SE": {Layout: "%O%n%N%n%A%nSE-%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "county"},
+	"SG": {Layout: "%N%n%O%n%A%nSINGAPORE %Z", Required: "AZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"SI": {Layout: "%N%n%O%n%A%nSI-%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "municipality"},
+	"SK": {Layout: "%N%n%O%n%A%n%Z %C", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "region"},
+	"TH": {Layout: "%N%n%O%n%A%n%D, %C%n%S %Z", Required: "ACS", PostalCodeType: "postal", SubdivisionType: "province", Scripts: []*unicode.RangeTable{unicode.Thai, unicode.Latin}},
+	"TR": {Layout: "%N%n%O%n%A%n%Z %C/%S", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "province"},
+	"TW": {Layout: "%N%n%O%n%A%n%C, %S %Z", Required: "ACSZ", PostalCodeType: "postal", SubdivisionType: "county", Scripts: []*unicode.RangeTable{unicode.Han, unicode.Latin}},
+	"UA": {Layout: "%N%n%O%n%A%n%C%n%S%n%Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "oblast", Scripts: cyrillic},
+	"US": {Layout: "%N%n%O%n%A%n%C, %S %Z", Required: "ACSZ", PostalCodeType: "zip", SubdivisionType: "state", SubdivisionCodes: true},
+	"VN": {Layout: "%N%n%O%n%A%n%C%n%S %Z", Required: "AC", PostalCodeType: "postal", SubdivisionType: "province"},
+	"ZA": {Layout: "%N%n%O%n%A%n%D%n%C%n%Z", Required: "ACZ", PostalCodeType: "postal", SubdivisionType: "province"},
+}
+
+// The countries whose address formats are not part of the formats table.
+// Validate reports ErrNoFormat for the addresses of these countries rather
+// than validating them against a loose format that fits none of them. A
+// country's format can be added by moving the country to the formats table
+// with the format taken from libaddressinput's metadata for the country.
+var noFormat = map[string]bool{
+	"AD": true, // Andorra
+	"AF": true, // Afghanistan
+	"AG": true, // Antigua and Barbuda
+	"AI": true, // Anguilla
+	"AL": true, // Albania
+	"AM": true, // Armenia
+	"AO": true, // Angola
+	"AQ": true, // Antarctica
+	"AS": true, // American Samoa
+	"AW": true, // Aruba
+	"AX": true, // Åland Islands
+	"AZ": true, // Azerbaijan
+	"BA": true, // Bosnia and Herzegovina
+	"BB": true, // Barbados
+	"BD": true, // Bangladesh
+	"BF": true, // Burkina Faso
+	"BH": true, // Bahrain
+	"BI": true, // Burundi
+	"BJ": true, // Benin
+	"BL": true, // Saint Barthélemy
+	"BM": true, // Bermuda
+	"BN": true, // Brunei Darussalam
+	"BO": true, // Bolivia, Plurinational State of
+	"BQ": true, // Bonaire, Sint Eustatius and Saba
+	"BS": true, // Bahamas
+	"BT": true, // Bhutan
+	"BV": true, // Bouvet Island
+	"BW": true, // Botswana
+	"BZ": true, // Belize
+	"CC": true, // Cocos (Keeling) Islands
+	"CD": true, // Congo, The Democratic Republic of the
+	"CF": true, // Central African Republic
+	"CG": true, // Congo
+	"CI": true, // Côte d'Ivoire
+	"CK": true, // Cook Islands
+	"CM": true, // Cameroon
+	"CR": true, // Costa Rica
+	"CU": true, // Cuba
+	"CV": true, // Cabo Verde
+	"CW": true, // Curaçao
+	"CX": true, // Christmas Island
+	"CY": true, // Cyprus
+	"DJ": true, // Djibouti
+	"DM": true, // Dominica
+	"DO": true, // Dominican Republic
+	"DZ": true, // Algeria
+	"EC": true, // Ecuador
+	"EH": true, // Western Sahara
+	"ER": true, // Eritrea
+	"ET": true, // Ethiopia
+	"FJ": true, // Fiji
+	"FK": true, // Falkland Islands (Malvinas)
+	"FM": true, // Micronesia, Federated States of
+	"FO": true, // Faroe Islands
+	"GA": true, // Gabon
+	"GD": true, // Grenada
+	"GE": true, // Georgia
+	"GF": true, // French Guiana
+	"GG": true, // Guernsey
+	"GH": true, // Ghana
+	"GI": true, // Gibraltar
+	"GL": true, // Greenland
+	"GM": true, // Gambia
+	"GN": true, // Guinea
+	"GP": true, // Guadeloupe
+	"GQ": true, // Equatorial Guinea
+	"GS": true, // South Georgia and the South Sandwich Islands
+	"GT": true, // Guatemala
+	"GU": true, // Guam
+	"GW": true, // Guinea-Bissau
+	"GY": true, // Guyana
+	"HM": true, // Heard Island and McDonald Islands
+	"HN": true, // Honduras
+	"HT": true, // Haiti
+	"IM": true, // Isle of Man
+	"IO": true, // British Indian Ocean Territory
+	"IQ": true, // Iraq
+	"IR": true, // Iran, Islamic Republic of
+	"IS": true, // Iceland
+	"JE": true, // Jersey
+	"JM": true, // Jamaica
+	"JO": true, // Jordan
+	"KE": true, // Kenya
+	"KG": true, // Kyrgyzstan
+	"KH": true, // Cambodia
+	"KI": true, // Kiribati
+	"KM": true, // Comoros
+	"KN": true, // Saint Kitts and Nevis
+	"KP": true, // Korea, Democratic People's Republic of
+	"KW": true, // Kuwait
+	"KY": true, // Cayman Islands
+	"LA": true, // Lao People's Democratic Republic
+	"LB": true, // Lebanon
+	"LC": true, // Saint Lucia
+	"LI": true, // Liechtenstein
+	"LK": true, // Sri Lanka
+	"LR": true, // Liberia
+	"LS": true, // Lesotho
+	"LY": true, // Libya
+	"MA": true, // Morocco
+	"MC": true, // Monaco
+	"MD": true, // Moldova, Republic of
+	"ME": true, // Montenegro
+	"MF": true, // Saint Martin (French part)
+	"MG": true, // Madagascar
+	"MH": true, // Marshall Islands
+	"MK": true, // North Macedonia
+	"ML": true, // Mali
+	"MM": true, // Myanmar
+	"MN": true, // Mongolia
+	"MO": true, // Macao
+	"MP": true, // Northern Mariana Islands
+	"MQ": true, // Martinique
+	"MR": true, // Mauritania
+	"MS": true, // Montserrat
+	"MT": true, // Malta
+	"MU": true, // Mauritius
+	"MV": true, // Maldives
+	"MW": true, // Malawi
+	"MZ": true, // Mozambique
+	"NA": true, // Namibia
+	"NC": true, // New Caledonia
+	"NE": true, // Niger
+	"NF": true, // Norfolk Island
+	"NG": true, // Nigeria
+	"NI": true, // Nicaragua
+	"NP": true, // Nepal
+	"NR": true, // Nauru
+	"NU": true, // Niue
+	"OM": true, // Oman
+	"PA": true, // Panama
+	"PE": true, // Peru
+	"PF": true, // French Polynesia
+	"PG": true, // Papua New Guinea
+	"PK": true, // Pakistan
+	"PM": true, // Saint Pierre and Miquelon
+	"PN": true, // Pitcairn
+	"PR": true, // Puerto Rico
+	"PS": true, // Palestine, State of
+	"PW": true, // Palau
+	"PY": true, // Paraguay
+	"QA": true, // Qatar
+	"RE": true, // Réunion
+	"RW": true, // Rwanda
+	"SB": true, // Solomon Islands
+	"SC": true, // Seychelles
+	"SD": true, // Sudan
+	"SH": true, // Saint Helena, Ascension and Tristan da Cunha
+	"SJ": true, // Svalbard and Jan Mayen
+	"SL": true, // Sierra Leone
+	"SM": true, // San Marino
+	"SN": true, // Senegal
+	"SO": true, // Somalia
+	"SR": true, // Suriname
+	"SS": true, // South Sudan
+	"ST": true, // Sao Tome and Principe
+	"SV": true, // El Salvador
+	"SX": true, // Sint Maarten (Dutch part)
+	"SY": true, // Syrian Arab Republic
+	"SZ": true, // Eswatini
+	"TC": true, // Turks and Caicos Islands
+	"TD": true, // Chad
+	"TF": true, // French Southern Territories
+	"TG": true, // Togo
+	"TJ": true, // Tajikistan
+	"TK": true, // Tokelau
+	"TL": true, // Timor-Leste
+	"TM": true, // Turkmenistan
+	"TN": true, // Tunisia
+	"TO": true, // Tonga
+	"TT": true, // Trinidad and Tobago
+	"TV": true, // Tuvalu
+	"TZ": true, // Tanzania, United Republic of
+	"UG": true, // Uganda
+	"UM": true, // United States Minor Outlying Islands
+	"UY": true, // Uruguay
+	"UZ": true, // Uzbekistan
+	"VA": true, // Holy See (Vatican City State)
+	"VC": true, // Saint Vincent and the Grenadines
+	"VE": true, // Venezuela, Bolivarian Republic of
+	"VG": true, // Virgin Islands, British
+	"VI": true, // Virgin Islands, U.S.
+	"VU": true, // Vanuatu
+	"WF": true, // Wallis and Futuna
+	"WS": true, // Samoa
+	"YE": true, // Yemen
+	"YT": true, // Mayotte
+	"ZM": true, // Zambia
+	"ZW": true, // Zimbabwe
+}
@@ -15,7 +15,7 @@ func init() {
 		// postcodes allocated to each group of 50 boxes - e.g., boxes 1001 to 1050
 		// have a code of AD551, 1051 to 1100 a code of AD552 etc."
 		// (from: https://en.wikipedia.org/wiki/Postal_codes_in_Andorra)
-		Zip:   regexp.MustCompile(`^AD(?:[1-7]00|5[0-9]{2})$`),
+		Zip:   regexp.MustCompile(`^AD[1-7]0[0-9]$`),
 		Phone: regexp.MustCompile(`^(?:\+376)?[346][0-9]{5}$`),
 	})
 }
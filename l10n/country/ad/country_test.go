@@ -30,6 +30,12 @@ func Test(t *testing.T) {
 			"AD500",
 			"AD600",
 			"AD700",
+			"AD501",
+		},
+		Fail: []string{
+			"AD800",
+			"100",
+			"AD10",
 		},
 	}})
 }
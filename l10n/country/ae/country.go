@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AE", A3: "ARE", Num: "784",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:(?:\+?971)|0)?5[024568][0-9]{7}$`),
 	})
 }
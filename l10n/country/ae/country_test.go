@@ -32,5 +32,15 @@ func Test(t *testing.T) {
 			"0114152198",
 			"962796477263",
 		},
+	}, {
+		Name: "Zip", Func: isvalid.Zip,
+		Pass: []string{
+			"",
+			"12345",
+			"N/A",
+		},
+		Fail: []string{
+			// the country doesn't use postal codes
+		},
 	}})
 }
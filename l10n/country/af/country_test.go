@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"AF", ""}, testutil.List{{
+	testutil.Run(t, []string{"AF", "AFG"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,14 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1001",
+			"4301",
+			"2052",
 		},
 		Fail: []string{
-			//
+			"5001",
+			"1000",
+			"100",
 		},
 	}})
 }
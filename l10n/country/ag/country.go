@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AG", A3: "ATG", Num: "028",
+		Zip: country.NoZip,
 	})
 }
@@ -14,7 +14,13 @@ func Test(t *testing.T) {
 		Fail: []string{},
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
-		Pass: []string{},
-		Fail: []string{},
+		Pass: []string{
+			"",
+			"12345",
+			"N/A",
+		},
+		Fail: []string{
+			// the country doesn't use postal codes
+		},
 	}})
 }
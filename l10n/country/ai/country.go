@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AI", A3: "AIA", Num: "660",
-		Zip: regexp.MustCompile(`^AI-2640$`),
+		Zip: regexp.MustCompile(`^(?:AI-)?2640$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"AI", ""}, testutil.List{{
+	testutil.Run(t, []string{"AI", "AIA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"AI-2640",
+			"2640",
 		},
 		Fail: []string{
-			//
+			"AI-2641",
+			"2641",
+			"AI2640",
 		},
 	}})
 }
@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"0010",
+			"2201",
 		},
 		Fail: []string{
-			//
+			"375010",
+			"001",
 		},
 	}})
 }
@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AO", A3: "AGO", Num: "024",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+244)[0-9]{9}$`),
 	})
 }
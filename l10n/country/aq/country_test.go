@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"AQ", ""}, testutil.List{{
+	testutil.Run(t, []string{"AQ", "ATA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"BIQQ 1ZZ",
 		},
 		Fail: []string{
-			//
+			"BIQQ1ZZ",
+			"FIQQ 1ZZ",
 		},
 	}})
 }
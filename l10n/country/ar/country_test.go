@@ -30,10 +30,14 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1425",
+			"C1425DKF",
+			"B1636FDA",
 		},
 		Fail: []string{
-			//
+			"12345",
+			"C1425",
+			"1425DKF",
 		},
 	}})
 }
@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AS", A3: "ASM", Num: "016",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-?[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^96799(?:-?[0-9]{4})?$`),
 	})
 }
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96799",
+			"96799-1234",
 		},
 		Fail: []string{
-			//
+			"96800",
+			"9679",
 		},
 	}})
 }
@@ -9,7 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AT", A3: "AUT", Num: "040",
-		Zip:   country.RxZip3Digits,
+		Zip:   regexp.MustCompile(`^[1-9][0-9]{3}$`),
 		Phone: regexp.MustCompile(`^(?:\+43|0)[0-9]{1,4}[0-9]{3,12}$`),
 		// 'AT'+U+8 digits, – e.g. ATU99999999
 		VAT: regexp.MustCompile(`^ATU[0-9]{8}$`),
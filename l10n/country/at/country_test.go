@@ -27,10 +27,14 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1010",
+			"6020",
+			"9992",
 		},
 		Fail: []string{
-			//
+			"0123",
+			"101",
+			"10100",
 		},
 	}})
 }
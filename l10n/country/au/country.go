@@ -17,8 +17,10 @@ func init() {
 	weights := []int{10, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19}
 
 	country.Add(country.Country{
-		A2: "AU", A3: "AUS", Num: "036", Zip: country.RxZip4Digits,
-		Phone: regexp.MustCompile(`^(?:\+?61|0)4[0-9]{8}$`),
+		A2: "AU", A3: "AUS", Num: "036",
+		Zip:       country.RxZip4Digits,
+		ZipRanges: zipRanges,
+		Phone:     regexp.MustCompile(`^(?:\+?61|0)4[0-9]{8}$`),
 		VAT: country.StringMatcherFunc(func(v string) bool {
 			if !rxvat.MatchString(v) {
 				return false
@@ -31,9 +31,37 @@ func Test(t *testing.T) {
 			"3000",
 			"2017",
 			"0800",
+			"2000",
+			"6000",
 		},
 		Fail: []string{
 			//
+			"200",
+			"20000",
+			"A200",
+		},
+	}, {
+		Name: "ZipIn/ACT", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "ACT", cc) },
+		Pass: []string{
+			"2600",
+			"2913",
+			"0200",
+		},
+		Fail: []string{
+			"2000",
+			"2619",
+			"260",
+		},
+	}, {
+		Name: "ZipIn/AU-NSW", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "AU-NSW", cc) },
+		Pass: []string{
+			"2000",
+			"2650",
+			"2999",
+		},
+		Fail: []string{
+			"2600",
+			"3000",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
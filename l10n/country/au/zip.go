@@ -0,0 +1,23 @@
+package au
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The postcode ranges of the states and territories.
+var zipRanges = []country.ZipRange{
+	{From: "0200", To: "0299", Subdivision: "AU-ACT"},
+	{From: "0800", To: "0999", Subdivision: "AU-NT"},
+	{From: "1000", To: "2599", Subdivision: "AU-NSW"},
+	{From: "2600", To: "2618", Subdivision: "AU-ACT"},
+	{From: "2619", To: "2899", Subdivision: "AU-NSW"},
+	{From: "2900", To: "2920", Subdivision: "AU-ACT"},
+	{From: "2921", To: "2999", Subdivision: "AU-NSW"},
+	{From: "3000", To: "3999", Subdivision: "AU-VIC"},
+	{From: "4000", To: "4999", Subdivision: "AU-QLD"},
+	{From: "5000", To: "5999", Subdivision: "AU-SA"},
+	{From: "6000", To: "6999", Subdivision: "AU-WA"},
+	{From: "7000", To: "7999", Subdivision: "AU-TAS"},
+	{From: "8000", To: "8999", Subdivision: "AU-VIC"},
+	{From: "9000", To: "9999", Subdivision: "AU-QLD"},
+}
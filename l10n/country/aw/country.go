@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AW", A3: "ABW", Num: "533",
+		Zip: country.NoZip,
 	})
 }
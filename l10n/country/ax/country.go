@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "AX", A3: "ALA", Num: "248",
-		Zip: regexp.MustCompile(`^(?:AX-)?[0-9]{5}$`),
+		Zip: regexp.MustCompile(`^(?:AX-)?22[0-9]{3}$`),
 	})
 }
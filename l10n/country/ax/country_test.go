@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"22100",
+			"AX-22550",
 		},
 		Fail: []string{
-			//
+			"00100",
+			"AX22100",
+			"2210",
 		},
 	}})
 }
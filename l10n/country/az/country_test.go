@@ -34,6 +34,8 @@ func Test(t *testing.T) {
 			"AZ0100",
 			"AZ0121",
 			"AZ3500",
+			"AZ1000",
+			"AZ5201",
 		},
 		Fail: []string{
 			"",
@@ -42,6 +44,7 @@ func Test(t *testing.T) {
 			"AZ34340",
 			"EN2020",
 			"AY3030",
+			"1000",
 		},
 	}})
 }
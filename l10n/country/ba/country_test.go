@@ -49,10 +49,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"71000",
+			"88000",
 		},
 		Fail: []string{
-			//
+			"7100",
+			"710000",
 		},
 	}})
 }
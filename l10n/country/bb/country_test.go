@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"BB11000",
+			"BB23026",
 		},
 		Fail: []string{
-			//
+			"11000",
+			"BB1100",
 		},
 	}})
 }
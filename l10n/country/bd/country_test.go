@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"1207",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
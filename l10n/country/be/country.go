@@ -13,7 +13,7 @@ func init() {
 
 	country.Add(country.Country{
 		A2: "BE", A3: "BEL", Num: "056",
-		Zip:   country.RxZip4Digits,
+		Zip:   regexp.MustCompile(`^[1-9][0-9]{3}$`),
 		Phone: regexp.MustCompile(`^(?:\+?32|0)4?[0-9]{8}$`),
 		VAT: country.StringMatcherFunc(func(v string) bool {
 			if !rxvat.MatchString(v) {
@@ -32,10 +32,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"9990",
 		},
 		Fail: []string{
-			//
+			"0999",
+			"100",
+			"10000",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BF", A3: "BFA", Num: "854",
+		Zip: country.NoZip,
 	})
 }
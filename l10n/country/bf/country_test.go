@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"",
+			"12345",
+			"N/A",
 		},
 		Fail: []string{
-			//
+			// the country doesn't use postal codes
 		},
 	}})
 }
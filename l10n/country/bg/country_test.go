@@ -26,9 +26,12 @@ func Test(t *testing.T) {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
 			"1000",
+			"9000",
 		},
 		Fail: []string{
 			//
+			"100",
+			"10000",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -37,10 +37,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"317",
+			"1216",
 		},
 		Fail: []string{
-			//
+			"12",
+			"12345",
 		},
 	}})
 }
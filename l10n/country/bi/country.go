@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BI", A3: "BDI", Num: "108",
+		Zip: country.NoZip,
 	})
 }
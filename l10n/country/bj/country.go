@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BJ", A3: "BEN", Num: "204",
+		Zip: country.NoZip,
 	})
 }
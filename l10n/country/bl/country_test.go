@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"BL", ""}, testutil.List{{
+	testutil.Run(t, []string{"BL", "BLM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97133",
 		},
 		Fail: []string{
-			//
+			"97134",
+			"97100",
 		},
 	}})
 }
@@ -1,11 +1,14 @@
 package bm
 
 import (
+	"regexp"
+
 	"github.com/frk/isvalid/l10n/country"
 )
 
 func init() {
 	country.Add(country.Country{
 		A2: "BM", A3: "BMU", Num: "060",
+		Zip: regexp.MustCompile(`^[A-Z]{2} ?(?:[0-9]{2}|[A-Z]{2})$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"BM", ""}, testutil.List{{
+	testutil.Run(t, []string{"BM", "BMU"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,14 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"HM 12",
+			"FL07",
+			"HM BX",
 		},
 		Fail: []string{
-			//
+			"HM 123",
+			"H 12",
+			"12345",
 		},
 	}})
 }
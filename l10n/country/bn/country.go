@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BN", A3: "BRN", Num: "096",
-		Zip: regexp.MustCompile(`^[A-Z]{2}[0-9]{4}$`),
+		Zip: regexp.MustCompile(`^[A-Z]{2} ?[0-9]{4}$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"BN", ""}, testutil.List{{
+	testutil.Run(t, []string{"BN", "BRN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"BS8811",
+			"KB 2333",
 		},
 		Fail: []string{
-			//
+			"8811",
+			"BS881",
+			"B8811",
 		},
 	}})
 }
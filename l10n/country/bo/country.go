@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BO", A3: "BOL", Num: "068",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+?591)?(?:6|7)[0-9]{7}$`),
 		VAT:   regexp.MustCompile(`^[0-9]{7}$`),
 	})
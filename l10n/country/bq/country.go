@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BQ", A3: "BES", Num: "535",
+		Zip: country.NoZip,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"BQ", ""}, testutil.List{{
+	testutil.Run(t, []string{"BQ", "BES"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"",
+			"12345",
+			"N/A",
 		},
 		Fail: []string{
-			//
+			// the country doesn't use postal codes
 		},
 	}})
 }
@@ -57,6 +57,8 @@ func Test(t *testing.T) {
 			"39100-000",
 			"22040-020",
 			"39400-152",
+			"01310-100",
+			"70040-010",
 		},
 		Fail: []string{
 			"79800A12",
@@ -65,6 +67,29 @@ func Test(t *testing.T) {
 			"81470-2763",
 			"78908",
 			"13010|111",
+			"01310100",
+			"0131-100",
+		},
+	}, {
+		Name: "ZipIn/SP", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "SP", cc) },
+		Pass: []string{
+			"01310-100",
+			"13010-111",
+			"19999-999",
+		},
+		Fail: []string{
+			"22040-020",
+			"01310100",
+		},
+	}, {
+		Name: "ZipIn/BR-AM", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "BR-AM", cc) },
+		Pass: []string{
+			"69005-040",
+			"69400-000",
+		},
+		Fail: []string{
+			"69301-000",
+			"69900-000",
 		},
 	}})
 }
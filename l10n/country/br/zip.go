@@ -0,0 +1,39 @@
+package br
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The CEP ranges of the states and the Federal District.
+var zipRanges = []country.ZipRange{
+	{From: "01000", To: "19999", Subdivision: "BR-SP"},
+	{From: "20000", To: "28999", Subdivision: "BR-RJ"},
+	{From: "29000", To: "29999", Subdivision: "BR-ES"},
+	{From: "30000", To: "39999", Subdivision: "BR-MG"},
+	{From: "40000", To: "48999", Subdivision: "BR-BA"},
+	{From: "49000", To: "49999", Subdivision: "BR-SE"},
+	{From: "50000", To: "56999", Subdivision: "BR-PE"},
+	{From: "57000", To: "57999", Subdivision: "BR-AL"},
+	{From: "58000", To: "58999", Subdivision: "BR-PB"},
+	{From: "59000", To: "59999", Subdivision: "BR-RN"},
+	{From: "60000", To: "63999", Subdivision: "BR-CE"},
+	{From: "64000", To: "64999", Subdivision: "BR-PI"},
+	{From: "65000", To: "65999", Subdivision: "BR-MA"},
+	{From: "66000", To: "68899", Subdivision: "BR-PA"},
+	{From: "68900", To: "68999", Subdivision: "BR-AP"},
+	{From: "69000", To: "69299", Subdivision: "BR-AM"},
+	{From: "69300", To: "69399", Subdivision: "BR-RR"},
+	{From: "69400", To: "69899", Subdivision: "BR-AM"},
+	{From: "69900", To: "69999", Subdivision: "BR-AC"},
+	{From: "70000", To: "72799", Subdivision: "BR-DF"},
+	{From: "72800", To: "72999", Subdivision: "BR-GO"},
+	{From: "73000", To: "73699", Subdivision: "BR-DF"},
+	{From: "73700", To: "76799", Subdivision: "BR-GO"},
+	{From: "76800", To: "76999", Subdivision: "BR-RO"},
+	{From: "77000", To: "77999", Subdivision: "BR-TO"},
+	{From: "78000", To: "78899", Subdivision: "BR-MT"},
+	{From: "79000", To: "79999", Subdivision: "BR-MS"},
+	{From: "80000", To: "87999", Subdivision: "BR-PR"},
+	{From: "88000", To: "89999", Subdivision: "BR-SC"},
+	{From: "90000", To: "99999", Subdivision: "BR-RS"},
+}
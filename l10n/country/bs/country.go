@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BS", A3: "BHS", Num: "044",
+		Zip: country.NoZip,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"BT", ""}, testutil.List{{
+	testutil.Run(t, []string{"BT", "BTN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11001",
+			"31002",
 		},
 		Fail: []string{
-			//
+			"1100",
+			"110011",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BV", A3: "BVT", Num: "074",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BW", A3: "BWA", Num: "072",
+		Zip: country.NoZip,
 	})
 }
@@ -40,9 +40,13 @@ func Test(t *testing.T) {
 			"211120",
 			"247710",
 			"231960",
+			"220050",
+			"246000",
 		},
 		Fail: []string{
 			//
+			"22005",
+			"120050",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "BZ", A3: "BLZ", Num: "084",
+		Zip: country.NoZip,
 	})
 }
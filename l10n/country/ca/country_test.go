@@ -30,9 +30,36 @@ func Test(t *testing.T) {
 			"A1A 1A1",
 			"X0A-0H0",
 			"V5K 0A1",
+			"K1A 0B1",
+			"M5V3L9",
 		},
 		Fail: []string{
 			//
+			"K1A 0D1",
+			"12345",
+		},
+	}, {
+		Name: "ZipIn/ON", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "ON", cc) },
+		Pass: []string{
+			"K1A 0B1",
+			"M5V3L9",
+			"L4T 0A5",
+			"P3E 2C6",
+		},
+		Fail: []string{
+			"G1A-0A2",
+			"V5K 0A1",
+		},
+	}, {
+		Name: "ZipIn/CA-NU", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "CA-NU", cc) },
+		Pass: []string{
+			"X0A-0H0",
+			"X0C 0A1",
+		},
+		Fail: []string{
+			"X0E 0A1",
+			"X1A 2P6",
+			"A1A 1A1",
 		},
 	}})
 }
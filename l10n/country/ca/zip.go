@@ -0,0 +1,29 @@
+package ca
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The first letter of the postal code, the forward sortation area's district,
+// identifies the province or territory. The Northwest Territories and Nunavut
+// share the "X" district and are told apart by the first three characters.
+var zipRanges = []country.ZipRange{
+	{From: "A", To: "A", Subdivision: "CA-NL"},
+	{From: "B", To: "B", Subdivision: "CA-NS"},
+	{From: "C", To: "C", Subdivision: "CA-PE"},
+	{From: "E", To: "E", Subdivision: "CA-NB"},
+	{From: "G", To: "H", Subdivision: "CA-QC"},
+	{From: "J", To: "J", Subdivision: "CA-QC"},
+	{From: "K", To: "N", Subdivision: "CA-ON"},
+	{From: "P", To: "P", Subdivision: "CA-ON"},
+	{From: "R", To: "R", Subdivision: "CA-MB"},
+	{From: "S", To: "S", Subdivision: "CA-SK"},
+	{From: "T", To: "T", Subdivision: "CA-AB"},
+	{From: "V", To: "V", Subdivision: "CA-BC"},
+	{From: "X", To: "X", Subdivision: "CA-NT"},
+	{From: "X", To: "X", Subdivision: "CA-NU"},
+	{From: "X0A", To: "X0C", Subdivision: "CA-NU"},
+	{From: "X0E", To: "X0G", Subdivision: "CA-NT"},
+	{From: "X1A", To: "X1A", Subdivision: "CA-NT"},
+	{From: "Y", To: "Y", Subdivision: "CA-YT"},
+}
@@ -1,12 +1,14 @@
 package cc
 
 import (
+	"regexp"
+
 	"github.com/frk/isvalid/l10n/country"
 )
 
 func init() {
 	country.Add(country.Country{
 		A2: "CC", A3: "CCK", Num: "166",
-		Zip: country.RxZip4Digits,
+		Zip: regexp.MustCompile(`^6799$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"CC", ""}, testutil.List{{
+	testutil.Run(t, []string{"CC", "CCK"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"6799",
 		},
 		Fail: []string{
-			//
+			"6798",
+			"0799",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CD", A3: "COD", Num: "180",
+		Zip: country.NoZip,
 	})
 }
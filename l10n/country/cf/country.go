@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CF", A3: "CAF", Num: "140",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CG", A3: "COG", Num: "178",
+		Zip: country.NoZip,
 	})
 }
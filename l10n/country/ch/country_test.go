@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"CH", ""}, testutil.List{{
+	testutil.Run(t, []string{"CH", "CHE"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"8001",
+			"1200",
+			"3000",
 		},
 		Fail: []string{
-			//
+			"800",
+			"80001",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CI", A3: "CIV", Num: "384",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CK", A3: "COK", Num: "184",
+		Zip: country.NoZip,
 	})
 }
@@ -26,10 +26,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"8320000",
+			"832-0000",
 		},
 		Fail: []string{
-			//
+			"832000",
+			"83200000",
 		},
 	}})
 }
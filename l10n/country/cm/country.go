@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CM", A3: "CMR", Num: "120",
+		Zip: country.NoZip,
 	})
 }
@@ -53,11 +53,14 @@ func Test(t *testing.T) {
 		Pass: []string{
 			"150237",
 			"100000",
+			"200120",
 		},
 		Fail: []string{
 			"141234",
 			"386789",
 			"ab1234",
+			"10000",
+			"800000",
 		},
 	}})
 }
@@ -41,10 +41,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"110111",
+			"050001",
 		},
 		Fail: []string{
-			//
+			"11011",
+			"1101111",
 		},
 	}})
 }
@@ -15,67 +15,27 @@ import (
 
 var ISO31661A_2 = make(map[string]Country)
 var ISO31661A_3 = make(map[string]Country)
+var ISO31661N = make(map[string]Country)
 
-func init() {
-	// NOTE only map's keys are populated here, the fields of the Country
-	// value are populated individually by the country/internal/xx packages.
+// the lower-cased short, common, and official names mapped to alpha-2 codes
+var names = make(map[string]string)
 
-	for _, a2 := range alpha2 {
-		ISO31661A_2[a2] = Country{}
+func init() {
+	// NOTE only the codes and names of the Country values are populated
+	// here, the rest of the fields are populated individually by the
+	// country/xx packages.
+
+	for _, c := range countries {
+		ISO31661A_2[c.A2] = c
+		ISO31661A_3[c.A3] = c
+		ISO31661N[c.Num] = c
+		names[strings.ToLower(c.Name)] = c.A2
 	}
-	for _, a3 := range alpha3 {
-		ISO31661A_3[a3] = Country{}
+	for name, a2 := range altNames {
+		names[strings.ToLower(name)] = a2
 	}
 }
 
-var alpha2 = []string{"AD", "AE", "AF", "AG", "AI", "AL", "AM", "AO", "AQ", "AR",
-	"AS", "AT", "AU", "AW", "AX", "AZ", "BA", "BB", "BD", "BE", "BF", "BG",
-	"BH", "BI", "BJ", "BL", "BM", "BN", "BO", "BQ", "BR", "BS", "BT", "BV",
-	"BW", "BY", "BZ", "CA", "CC", "CD", "CF", "CG", "CH", "CI", "CK", "CL",
-	"CM", "CN", "CO", "CR", "CU", "CV", "CW", "CX", "CY", "CZ", "DE", "DJ",
-	"DK", "DM", "DO", "DZ", "EC", "EE", "EG", "EH", "ER", "ES", "ET", "FI",
-	"FJ", "FK", "FM", "FO", "FR", "GA", "GB", "GD", "GE", "GF", "GG", "GH",
-	"GI", "GL", "GM", "GN", "GP", "GQ", "GR", "GS", "GT", "GU", "GW", "GY",
-	"HK", "HM", "HN", "HR", "HT", "HU", "ID", "IE", "IL", "IM", "IN", "IO",
-	"IQ", "IR", "IS", "IT", "JE", "JM", "JO", "JP", "KE", "KG", "KH", "KI",
-	"KM", "KN", "KP", "KR", "KW", "KY", "KZ", "LA", "LB", "LC", "LI", "LK",
-	"LR", "LS", "LT", "LU", "LV", "LY", "MA", "MC", "MD", "ME", "MF", "MG",
-	"MH", "MK", "ML", "MM", "MN", "MO", "MP", "MQ", "MR", "MS", "MT", "MU",
-	"MV", "MW", "MX", "MY", "MZ", "NA", "NC", "NE", "NF", "NG", "NI", "NL",
-	"NO", "NP", "NR", "NU", "NZ", "OM", "PA", "PE", "PF", "PG", "PH", "PK",
-	"PL", "PM", "PN", "PR", "PS", "PT", "PW", "PY", "QA", "RE", "RO", "RS",
-	"RU", "RW", "SA", "SB", "SC", "SD", "SE", "SG", "SH", "SI", "SJ", "SK",
-	"SL", "SM", "SN", "SO", "SR", "SS", "ST", "SV", "SX", "SY", "SZ", "TC",
-	"TD", "TF", "TG", "TH", "TJ", "TK", "TL", "TM", "TN", "TO", "TR", "TT",
-	"TV", "TW", "TZ", "UA", "UG", "UM", "US", "UY", "UZ", "VA", "VC", "VE",
-	"VG", "VI", "VN", "VU", "WF", "WS", "YE", "YT", "ZA", "ZM", "ZW",
-}
-
-var alpha3 = []string{"ABW", "AFG", "AGO", "AIA", "ALA", "ALB", "AND", "ARE", "ARG",
-	"ARM", "ASM", "ATA", "ATF", "ATG", "AUS", "AUT", "AZE", "BDI", "BEL", "BEN",
-	"BES", "BFA", "BGD", "BGR", "BHR", "BHS", "BIH", "BLM", "BLR", "BLZ", "BMU",
-	"BOL", "BRA", "BRB", "BRN", "BTN", "BVT", "BWA", "CAF", "CAN", "CCK", "CHE",
-	"CHL", "CHN", "CIV", "CMR", "COD", "COG", "COK", "COL", "COM", "CPV", "CRI",
-	"CUB", "CUW", "CXR", "CYM", "CYP", "CZE", "DEU", "DJI", "DMA", "DNK", "DOM",
-	"DZA", "ECU", "EGY", "ERI", "ESH", "ESP", "EST", "ETH", "FIN", "FJI", "FLK",
-	"FRA", "FRO", "FSM", "GAB", "GBR", "GEO", "GGY", "GHA", "GIB", "GIN", "GLP",
-	"GMB", "GNB", "GNQ", "GRC", "GRD", "GRL", "GTM", "GUF", "GUM", "GUY", "HKG",
-	"HMD", "HND", "HRV", "HTI", "HUN", "IDN", "IMN", "IND", "IOT", "IRL", "IRN",
-	"IRQ", "ISL", "ISR", "ITA", "JAM", "JEY", "JOR", "JPN", "KAZ", "KEN", "KGZ",
-	"KHM", "KIR", "KNA", "KOR", "KWT", "LAO", "LBN", "LBR", "LBY", "LCA", "LIE",
-	"LKA", "LSO", "LTU", "LUX", "LVA", "MAC", "MAF", "MAR", "MCO", "MDA", "MDG",
-	"MDV", "MEX", "MHL", "MKD", "MLI", "MLT", "MMR", "MNE", "MNG", "MNP", "MOZ",
-	"MRT", "MSR", "MTQ", "MUS", "MWI", "MYS", "MYT", "NAM", "NCL", "NER", "NFK",
-	"NGA", "NIC", "NIU", "NLD", "NOR", "NPL", "NRU", "NZL", "OMN", "PAK", "PAN",
-	"PCN", "PER", "PHL", "PLW", "PNG", "POL", "PRI", "PRK", "PRT", "PRY", "PSE",
-	"PYF", "QAT", "REU", "ROU", "RUS", "RWA", "SAU", "SDN", "SEN", "SGP", "SGS",
-	"SHN", "SJM", "SLB", "SLE", "SLV", "SMR", "SOM", "SPM", "SRB", "SSD", "STP",
-	"SUR", "SVK", "SVN", "SWE", "SWZ", "SXM", "SYC", "SYR", "TCA", "TCD", "TGO",
-	"THA", "TJK", "TKL", "TKM", "TLS", "TON", "TTO", "TUN", "TUR", "TUV", "TWN",
-	"TZA", "UGA", "UKR", "UMI", "URY", "USA", "UZB", "VAT", "VCT", "VEN", "VGB",
-	"VIR", "VNM", "VUT", "WLF", "WSM", "YEM", "ZAF", "ZMB", "ZWE",
-}
-
 type Country struct {
 	// ISO 3166-1 Alpha-2
 	A2 string
@@ -83,9 +43,15 @@ type Country struct {
 	A3 string
 	// ISO 3166-1 numeric
 	Num string
-	// The validator for the country's zip / postal code, will be nil
-	// for countries that don't use postal codes.
+	// The English short name of the country as defined by ISO 3166-1.
+	Name string
+	// The validator for the country's zip / postal code. The validator
+	// is NoZip for countries that don't use postal codes, and nil if the
+	// country's postal code format is unknown.
 	Zip StringMatcher
+	// The zip / postal code prefix ranges of the country's subdivisions,
+	// may be nil.
+	ZipRanges []ZipRange
 	// The validator for the country's phone numbers, may be nil.
 	Phone StringMatcher
 	//
@@ -93,8 +59,12 @@ type Country struct {
 }
 
 func Add(c Country) {
+	if len(c.Name) == 0 {
+		c.Name = ISO31661A_2[c.A2].Name
+	}
 	ISO31661A_2[c.A2] = c
 	ISO31661A_3[c.A3] = c
+	ISO31661N[c.Num] = c
 }
 
 func Get(cc string) (c Country, ok bool) {
@@ -111,6 +81,94 @@ func Get(cc string) (c Country, ok bool) {
 	return c, false
 }
 
+// GetByNum returns the country identified by the ISO 3166-1 numeric code num.
+// The code may be specified without the leading zeros, e.g. "40" for Austria.
+func GetByNum(num string) (c Country, ok bool) {
+	if len(num) > 0 && len(num) < 3 {
+		num = strings.Repeat("0", 3-len(num)) + num
+	}
+	c, ok = ISO31661N[num]
+	return c, ok
+}
+
+// GetByName returns the country with the given English name. The name is
+// matched case-insensitively against the ISO 3166-1 short name, and against
+// the common and official names of the country, e.g. "Bolivia, Plurinational
+// State of", "Bolivia", and "Plurinational State of Bolivia" are all accepted.
+func GetByName(name string) (c Country, ok bool) {
+	if a2, ok := names[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return ISO31661A_2[a2], true
+	}
+	return c, false
+}
+
+// Lookup returns the country identified by v, which can be an ISO 3166-1
+// alpha-2, alpha-3, or numeric code, or an English name of the country, see
+// GetByName. Lookup can be used to normalize country values of mixed formats.
+func Lookup(v string) (c Country, ok bool) {
+	v = strings.TrimSpace(v)
+	if c, ok = Get(v); ok {
+		return c, true
+	}
+	if len(v) > 0 && len(v) <= 3 && strings.Trim(v, "0123456789") == "" {
+		return GetByNum(v)
+	}
+	return GetByName(v)
+}
+
+// A2ToA3 returns the ISO 3166-1 alpha-3 code of the country identified
+// by the ISO 3166-1 alpha-2 code a2.
+func A2ToA3(a2 string) (a3 string, ok bool) {
+	c, ok := ISO31661A_2[strings.ToUpper(a2)]
+	return c.A3, ok
+}
+
+// A3ToA2 returns the ISO 3166-1 alpha-2 code of the country identified
+// by the ISO 3166-1 alpha-3 code a3.
+func A3ToA2(a3 string) (a2 string, ok bool) {
+	c, ok := ISO31661A_3[strings.ToUpper(a3)]
+	return c.A2, ok
+}
+
+// ZipRange represents a range of zip / postal code prefixes
+// that belong to a subdivision of a country.
+type ZipRange struct {
+	// The first and the last prefix of the range, inclusive. The
+	// two prefixes must be of the same length, e.g. "350" and "369".
+	From, To string
+	// The ISO 3166-2 code of the subdivision, e.g. "US-AL".
+	Subdivision string
+}
+
+// ZipSubdivisions returns the ISO 3166-2 codes of the subdivisions to which
+// the zip / postal code belongs according to the country's ZipRanges. Only the
+// ranges with the longest matching prefix are considered, this allows for more
+// specific ranges to override less specific ones. The zip code is matched
+// case-insensitively and with any spaces and hyphens removed.
+func (c Country) ZipSubdivisions(zip string) (codes []string) {
+	zip = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(zip))
+
+	n := 0
+	for _, r := range c.ZipRanges {
+		if len(r.From) > len(zip) || len(r.From) < n {
+			continue
+		}
+		if p := zip[:len(r.From)]; p < r.From || p > r.To {
+			continue
+		}
+		if len(r.From) > n {
+			n, codes = len(r.From), codes[:0]
+		}
+		codes = append(codes, r.Subdivision)
+	}
+	return codes
+}
+
 type StringMatcher interface {
 	MatchString(v string) bool
 }
@@ -121,6 +179,10 @@ func (f StringMatcherFunc) MatchString(v string) bool {
 	return f(v)
 }
 
+// NoZip is the zip / postal code validator of the countries that don't
+// use postal codes, it accepts any value, including the empty string.
+var NoZip = StringMatcherFunc(func(v string) bool { return true })
+
 var (
 	RxZip3Digits = regexp.MustCompile(`^[0-9]{3}$`)
 	RxZip4Digits = regexp.MustCompile(`^[0-9]{4}$`)
@@ -0,0 +1,432 @@
+package country
+
+var countries = []Country{
+	{A2: "AD", A3: "AND", Num: "020", Name: "Andorra"},
+	{A2: "AE", A3: "ARE", Num: "784", Name: "United Arab Emirates"},
+	{A2: "AF", A3: "AFG", Num: "004", Name: "Afghanistan"},
+	{A2: "AG", A3: "ATG", Num: "028", Name: "Antigua and Barbuda"},
+	{A2: "AI", A3: "AIA", Num: "660", Name: "Anguilla"},
+	{A2: "AL", A3: "ALB", Num: "008", Name: "Albania"},
+	{A2: "AM", A3: "ARM", Num: "051", Name: "Armenia"},
+	{A2: "AO", A3: "AGO", Num: "024", Name: "Angola"},
+	{A2: "AQ", A3: "ATA", Num: "010", Name: "Antarctica"},
+	{A2: "AR", A3: "ARG", Num: "032", Name: "Argentina"},
+	{A2: "AS", A3: "ASM", Num: "016", Name: "American Samoa"},
+	{A2: "AT", A3: "AUT", Num: "040", Name: "Austria"},
+	{A2: "AU", A3: "AUS", Num: "036", Name: "Australia"},
+	{A2: "AW", A3: "ABW", Num: "533", Name: "Aruba"},
+	{A2: "AX", A3: "ALA", Num: "248", Name: "Åland Islands"},
+	{A2: "AZ", A3: "AZE", Num: "031", Name: "Azerbaijan"},
+	{A2: "BA", A3: "BIH", Num: "070", Name: "Bosnia and Herzegovina"},
+	{A2: "BB", A3: "BRB", Num: "052", Name: "Barbados"},
+	{A2: "BD", A3: "BGD", Num: "050", Name: "Bangladesh"},
+	{A2: "BE", A3: "BEL", Num: "056", Name: "Belgium"},
+	{A2: "BF", A3: "BFA", Num: "854", Name: "Burkina Faso"},
+	{A2: "BG", A3: "BGR", Num: "100", Name: "Bulgaria"},
+	{A2: "BH", A3: "BHR", Num: "048", Name: "Bahrain"},
+	{A2: "BI", A3: "BDI", Num: "108", Name: "Burundi"},
+	{A2: "BJ", A3: "BEN", Num: "204", Name: "Benin"},
+	{A2: "BL", A3: "BLM", Num: "652", Name: "Saint Barthélemy"},
+	{A2: "BM", A3: "BMU", Num: "060", Name: "Bermuda"},
+	{A2: "BN", A3: "BRN", Num: "096", Name: "Brunei Darussalam"},
+	{A2: "BO", A3: "BOL", Num: "068", Name: "Bolivia, Plurinational State of"},
+	{A2: "BQ", A3: "BES", Num: "535", Name: "Bonaire, Sint Eustatius and Saba"},
+	{A2: "BR", A3: "BRA", Num: "076", Name: "Brazil"},
+	{A2: "BS", A3: "BHS", Num: "044", Name: "Bahamas"},
+	{A2: "BT", A3: "BTN", Num: "064", Name: "Bhutan"},
+	{A2: "BV", A3: "BVT", Num: "074", Name: "Bouvet Island"},
+	{A2: "BW", A3: "BWA", Num: "072", Name: "Botswana"},
+	{A2: "BY", A3: "BLR", Num: "112", Name: "Belarus"},
+	{A2: "BZ", A3: "BLZ", Num: "084", Name: "Belize"},
+	{A2: "CA", A3: "CAN", Num: "124", Name: "Canada"},
+	{A2: "CC", A3: "CCK", Num: "166", Name: "Cocos (Keeling) Islands"},
+	{A2: "CD", A3: "COD", Num: "180", Name: "Congo, The Democratic Republic of the"},
+	{A2: "CF", A3: "CAF", Num: "140", Name: "Central African Republic"},
+	{A2: "CG", A3: "COG", Num: "178", Name: "Congo"},
+	{A2: "CH", A3: "CHE", Num: "756", Name: "Switzerland"},
+	{A2: "CI", A3: "CIV", Num: "384", Name: "Côte d'Ivoire"},
+	{A2: "CK", A3: "COK", Num: "184", Name: "Cook Islands"},
+	{A2: "CL", A3: "CHL", Num: "152", Name: "Chile"},
+	{A2: "CM", A3: "CMR", Num: "120", Name: "Cameroon"},
+	{A2: "CN", A3: "CHN", Num: "156", Name: "China"},
+	{A2: "CO", A3: "COL", Num: "170", Name: "Colombia"},
+	{A2: "CR", A3: "CRI", Num: "188", Name: "Costa Rica"},
+	{A2: "CU", A3: "CUB", Num: "192", Name: "Cuba"},
+	{A2: "CV", A3: "CPV", Num: "132", Name: "Cabo Verde"},
+	{A2: "CW", A3: "CUW", Num: "531", Name: "Curaçao"},
+	{A2: "CX", A3: "CXR", Num: "162", Name: "Christmas Island"},
+	{A2: "CY", A3: "CYP", Num: "196", Name: "Cyprus"},
+	{A2: "CZ", A3: "CZE", Num: "203", Name: "Czechia"},
+	{A2: "DE", A3: "DEU", Num: "276", Name: "Germany"},
+	{A2: "DJ", A3: "DJI", Num: "262", Name: "Djibouti"},
+	{A2: "DK", A3: "DNK", Num: "208", Name: "Denmark"},
+	{A2: "DM", A3: "DMA", Num: "212", Name: "Dominica"},
+	{A2: "DO", A3: "DOM", Num: "214", Name: "Dominican Republic"},
+	{A2: "DZ", A3: "DZA", Num: "012", Name: "Algeria"},
+	{A2: "EC", A3: "ECU", Num: "218", Name: "Ecuador"},
+	{A2: "EE", A3: "EST", Num: "233", Name: "Estonia"},
+	{A2: "EG", A3: "EGY", Num: "818", Name: "Egypt"},
+	{A2: "EH", A3: "ESH", Num: "732", Name: "Western Sahara"},
+	{A2: "ER", A3: "ERI", Num: "232", Name: "Eritrea"},
+	{A2: "ES", A3: "ESP", Num: "724", Name: "Spain"},
+	{A2: "ET", A3: "ETH", Num: "231", Name: "Ethiopia"},
+	{A2: "FI", A3: "FIN", Num: "246", Name: "Finland"},
+	{A2: "FJ", A3: "FJI", Num: "242", Name: "Fiji"},
+	{A2: "FK", A3: "FLK", Num: "238", Name: "Falkland Islands (Malvinas)"},
+	{A2: "FM", A3: "FSM", Num: "583", Name: "Micronesia, Federated States of"},
+	{A2: "FO", A3: "FRO", Num: "234", Name: "Faroe Islands"},
+	{A2: "FR", A3: "FRA", Num: "250", Name: "France"},
+	{A2: "GA", A3: "GAB", Num: "266", Name: "Gabon"},
+	{A2: "GB", A3: "GBR", Num: "826", Name: "United Kingdom"},
+	{A2: "GD", A3: "GRD", Num: "308", Name: "Grenada"},
+	{A2: "GE", A3: "GEO", Num: "268", Name: "Georgia"},
+	{A2: "GF", A3: "GUF", Num: "254", Name: "French Guiana"},
+	{A2: "GG", A3: "GGY", Num: "831", Name: "Guernsey"},
+	{A2: "GH", A3: "GHA", Num: "288", Name: "Ghana"},
+	{A2: "GI", A3: "GIB", Num: "292", Name: "Gibraltar"},
+	{A2: "GL", A3: "GRL", Num: "304", Name: "Greenland"},
+	{A2: "GM", A3: "GMB", Num: "270", Name: "Gambia"},
+	{A2: "GN", A3: "GIN", Num: "324", Name: "Guinea"},
+	{A2: "GP", A3: "GLP", Num: "312", Name: "Guadeloupe"},
+	{A2: "GQ", A3: "GNQ", Num: "226", Name: "Equatorial Guinea"},
+	{A2: "GR", A3: "GRC", Num: "300", Name: "Greece"},
+	{A2: "GS", A3: "SGS", Num: "239", Name: "South Georgia and the South Sandwich Islands"},
+	{A2: "GT", A3: "GTM", Num: "320", Name: "Guatemala"},
+	{A2: "GU", A3: "GUM", Num: "316", Name: "Guam"},
+	{A2: "GW", A3: "GNB", Num: "624", Name: "Guinea-Bissau"},
+	{A2: "GY", A3: "GUY", Num: "328", Name: "Guyana"},
+	{A2: "HK", A3: "HKG", Num: "344", Name: "Hong Kong"},
+	{A2: "HM", A3: "HMD", Num: "334", Name: "Heard Island and McDonald Islands"},
+	{A2: "HN", A3: "HND", Num: "340", Name: "Honduras"},
+	{A2: "HR", A3: "HRV", Num: "191", Name: "Croatia"},
+	{A2: "HT", A3: "HTI", Num: "332", Name: "Haiti"},
+	{A2: "HU", A3: "HUN", Num: "348", Name: "Hungary"},
+	{A2: "ID", A3: "IDN", Num: "360", Name: "Indonesia"},
+	{A2: "IE", A3: "IRL", Num: "372", Name: "Ireland"},
+	{A2: "IL", A3: "ISR", Num: "376", Name: "Israel"},
+	{A2: "IM", A3: "IMN", Num: "833", Name: "Isle of Man"},
+	{A2: "IN", A3: "IND", Num: "356", Name: "India"},
+	{A2: "IO", A3: "IOT", Num: "086", Name: "British Indian Ocean Territory"},
+	{A2: "IQ", A3: "IRQ", Num: "368", Name: "Iraq"},
+	{A2: "IR", A3: "IRN", Num: "364", Name: "Iran, Islamic Republic of"},
+	{A2: "IS", A3: "ISL", Num: "352", Name: "Iceland"},
+	{A2: "IT", A3: "ITA", Num: "380", Name: "Italy"},
+	{A2: "JE", A3: "JEY", Num: "832", Name: "Jersey"},
+	{A2: "JM", A3: "JAM", Num: "388", Name: "Jamaica"},
+	{A2: "JO", A3: "JOR", Num: "400", Name: "Jordan"},
+	{A2: "JP", A3: "JPN", Num: "392", Name: "Japan"},
+	{A2: "KE", A3: "KEN", Num: "404", Name: "Kenya"},
+	{A2: "KG", A3: "KGZ", Num: "417", Name: "Kyrgyzstan"},
+	{A2: "KH", A3: "KHM", Num: "116", Name: "Cambodia"},
+	{A2: "KI", A3: "KIR", Num: "296", Name: "Kiribati"},
+	{A2: "KM", A3: "COM", Num: "174", Name: "Comoros"},
+	{A2: "KN", A3: "KNA", Num: "659", Name: "Saint Kitts and Nevis"},
+	{A2: "KP", A3: "PRK", Num: "408", Name: "Korea, Democratic People's Republic of"},
+	{A2: "KR", A3: "KOR", Num: "410", Name: "Korea, Republic of"},
+	{A2: "KW", A3: "KWT", Num: "414", Name: "Kuwait"},
+	{A2: "KY", A3: "CYM", Num: "136", Name: "Cayman Islands"},
+	{A2: "KZ", A3: "KAZ", Num: "398", Name: "Kazakhstan"},
+	{A2: "LA", A3: "LAO", Num: "418", Name: "Lao People's Democratic Republic"},
+	{A2: "LB", A3: "LBN", Num: "422", Name: "Lebanon"},
+	{A2: "LC", A3: "LCA", Num: "662", Name: "Saint Lucia"},
+	{A2: "LI", A3: "LIE", Num: "438", Name: "Liechtenstein"},
+	{A2: "LK", A3: "LKA", Num: "144", Name: "Sri Lanka"},
+	{A2: "LR", A3: "LBR", Num: "430", Name: "Liberia"},
+	{A2: "LS", A3: "LSO", Num: "426", Name: "Lesotho"},
+	{A2: "LT", A3: "LTU", Num: "440", Name: "Lithuania"},
+	{A2: "LU", A3: "LUX", Num: "442", Name: "Luxembourg"},
+	{A2: "LV", A3: "LVA", Num: "428", Name: "Latvia"},
+	{A2: "LY", A3: "LBY", Num: "434", Name: "Libya"},
+	{A2: "MA", A3: "MAR", Num: "504", Name: "Morocco"},
+	{A2: "MC", A3: "MCO", Num: "492", Name: "Monaco"},
+	{A2: "MD", A3: "MDA", Num: "498", Name: "Moldova, Republic of"},
+	{A2: "ME", A3: "MNE", Num: "499", Name: "Montenegro"},
+	{A2: "MF", A3: "MAF", Num: "663", Name: "Saint Martin (French part)"},
+	{A2: "MG", A3: "MDG", Num: "450", Name: "Madagascar"},
+	{A2: "MH", A3: "MHL", Num: "584", Name: "Marshall Islands"},
+	{A2: "MK", A3: "MKD", Num: "807", Name: "North Macedonia"},
+	{A2: "ML", A3: "MLI", Num: "466", Name: "Mali"},
+	{A2: "MM", A3: "MMR", Num: "104", Name: "Myanmar"},
+	{A2: "MN", A3: "MNG", Num: "496", Name: "Mongolia"},
+	{A2: "MO", A3: "MAC", Num: "446", Name: "Macao"},
+	{A2: "MP", A3: "MNP", Num: "580", Name: "Northern Mariana Islands"},
+	{A2: "MQ", A3: "MTQ", Num: "474", Name: "Martinique"},
+	{A2: "MR", A3: "MRT", Num: "478", Name: "Mauritania"},
+	{A2: "MS", A3: "MSR", Num: "500", Name: "Montserrat"},
+	{A2: "MT", A3: "MLT", Num: "470", Name: "Malta"},
+	{A2: "MU", A3: "MUS", Num: "480", Name: "Mauritius"},
+	{A2: "MV", A3: "MDV", Num: "462", Name: "Maldives"},
+	{A2: "MW", A3: "MWI", Num: "454", Name: "Malawi"},
+	{A2: "MX", A3: "MEX", Num: "484", Name: "Mexico"},
+	{A2: "MY", A3: "MYS", Num: "458", Name: "Malaysia"},
+	{A2: "MZ", A3: "MOZ", Num: "508", Name: "Mozambique"},
+	{A2: "NA", A3: "NAM", Num: "516", Name: "Namibia"},
+	{A2: "NC", A3: "NCL", Num: "540", Name: "New Caledonia"},
+	{A2: "NE", A3: "NER", Num: "562", Name: "Niger"},
+	{A2: "NF", A3: "NFK", Num: "574", Name: "Norfolk Island"},
+	{A2: "NG", A3: "NGA", Num: "566", Name: "Nigeria"},
+	{A2: "NI", A3: "NIC", Num: "558", Name: "Nicaragua"},
+	{A2: "NL", A3: "NLD", Num: "528", Name: "Netherlands"},
+	{A2: "NO", A3: "NOR", Num: "578", Name: "Norway"},
+	{A2: "NP", A3: "NPL", Num: "524", Name: "Nepal"},
+	{A2: "NR", A3: "NRU", Num: "520", Name: "Nauru"},
+	{A2: "NU", A3: "NIU", Num: "570", Name: "Niue"},
+	{A2: "NZ", A3: "NZL", Num: "554", Name: "New Zealand"},
+	{A2: "OM", A3: "OMN", Num: "512", Name: "Oman"},
+	{A2: "PA", A3: "PAN", Num: "591", Name: "Panama"},
+	{A2: "PE", A3: "PER", Num: "604", Name: "Peru"},
+	{A2: "PF", A3: "PYF", Num: "258", Name: "French Polynesia"},
+	{A2: "PG", A3: "PNG", Num: "598", Name: "Papua New Guinea"},
+	{A2: "PH", A3: "PHL", Num: "608", Name: "Philippines"},
+	{A2: "PK", A3: "PAK", Num: "586", Name: "Pakistan"},
+	{A2: "PL", A3: "POL", Num: "616", Name: "Poland"},
+	{A2: "PM", A3: "SPM", Num: "666", Name: "Saint Pierre and Miquelon"},
+	{A2: "PN", A3: "PCN", Num: "612", Name: "Pitcairn"},
+	{A2: "PR", A3: "PRI", Num: "630", Name: "Puerto Rico"},
+	{A2: "PS", A3: "PSE", Num: "275", Name: "Palestine, State of"},
+	{A2: "PT", A3: "PRT", Num: "620", Name: "Portugal"},
+	{A2: "PW", A3: "PLW", Num: "585", Name: "Palau"},
+	{A2: "PY", A3: "PRY", Num: "600", Name: "Paraguay"},
+	{A2: "QA", A3: "QAT", Num: "634", Name: "Qatar"},
+	{A2: "RE", A3: "REU", Num: "638", Name: "Réunion"},
+	{A2: "RO", A3: "ROU", Num: "642", Name: "Romania"},
+	{A2: "RS", A3: "SRB", Num: "688", Name: "Serbia"},
+	{A2: "RU", A3: "RUS", Num: "643", Name: "Russian Federation"},
+	{A2: "RW", A3: "RWA", Num: "646", Name: "Rwanda"},
+	{A2: "SA", A3: "SAU", Num: "682", Name: "Saudi Arabia"},
+	{A2: "SB", A3: "SLB", Num: "090", Name: "Solomon Islands"},
+	{A2: "SC", A3: "SYC", Num: "690", Name: "Seychelles"},
+	{A2: "SD", A3: "SDN", Num: "729", Name: "Sudan"},
+	{A2: "SE", A3: "SWE", Num: "752", Name: "Sweden"},
+	{A2: "SG", A3: "SGP", Num: "702", Name: "Singapore"},
+	{A2: "SH", A3: "SHN", Num: "654", Name: "Saint Helena, Ascension and Tristan da Cunha"},
+	{A2: "SI", A3: "SVN", Num: "705", Name: "Slovenia"},
+	{A2: "SJ", A3: "SJM", Num: "744", Name: "Svalbard and Jan Mayen"},
+	{A2: "SK", A3: "SVK", Num: "703", Name: "Slovakia"},
+	{A2: "SL", A3: "SLE", Num: "694", Name: "Sierra Leone"},
+	{A2: "SM", A3: "SMR", Num: "674", Name: "San Marino"},
+	{A2: "SN", A3: "SEN", Num: "686", Name: "Senegal"},
+	{A2: "SO", A3: "SOM", Num: "706", Name: "Somalia"},
+	{A2: "SR", A3: "SUR", Num: "740", Name: "Suriname"},
+	{A2: "SS", A3: "SSD", Num: "728", Name: "South Sudan"},
+	{A2: "ST", A3: "STP", Num: "678", Name: "Sao Tome and Principe"},
+	{A2: "SV", A3: "SLV", Num: "222", Name: "El Salvador"},
+	{A2: "SX", A3: "SXM", Num: "534", Name: "Sint Maarten (Dutch part)"},
+	{A2: "SY", A3: "SYR", Num: "760", Name: "Syrian Arab Republic"},
+	{A2: "SZ", A3: "SWZ", Num: "748", Name: "Eswatini"},
+	{A2: "TC", A3: "TCA", Num: "796", Name: "Turks and Caicos Islands"},
+	{A2: "TD", A3: "TCD", Num: "148", Name: "Chad"},
+	{A2: "TF", A3: "ATF", Num: "260", Name: "French Southern Territories"},
+	{A2: "TG", A3: "TGO", Num: "768", Name: "Togo"},
+	{A2: "TH", A3: "THA", Num: "764", Name: "Thailand"},
+	{A2: "TJ", A3: "TJK", Num: "762", Name: "Tajikistan"},
+	{A2: "TK", A3: "TKL", Num: "772", Name: "Tokelau"},
+	{A2: "TL", A3: "TLS", Num: "626", Name: "Timor-Leste"},
+	{A2: "TM", A3: "TKM", Num: "795", Name: "Turkmenistan"},
+	{A2: "TN", A3: "TUN", Num: "788", Name: "Tunisia"},
+	{A2: "TO", A3: "TON", Num: "776", Name: "Tonga"},
+	{A2: "TR", A3: "TUR", Num: "792", Name: "Türkiye"},
+	{A2: "TT", A3: "TTO", Num: "780", Name: "Trinidad and Tobago"},
+	{A2: "TV", A3: "TUV", Num: "798", Name: "Tuvalu"},
+	{A2: "TW", A3: "TWN", Num: "158", Name: "Taiwan, Province of China"},
+	{A2: "TZ", A3: "TZA", Num: "834", Name: "Tanzania, United Republic of"},
+	{A2: "UA", A3: "UKR", Num: "804", Name: "Ukraine"},
+	{A2: "UG", A3: "UGA", Num: "800", Name: "Uganda"},
+	{A2: "UM", A3: "UMI", Num: "581", Name: "United States Minor Outlying Islands"},
+	{A2: "US", A3: "USA", Num: "840", Name: "United States"},
+	{A2: "UY", A3: "URY", Num: "858", Name: "Uruguay"},
+	{A2: "UZ", A3: "UZB", Num: "860", Name: "Uzbekistan"},
+	{A2: "VA", A3: "VAT", Num: "336", Name: "Holy See (Vatican City State)"},
+	{A2: "VC", A3: "VCT", Num: "670", Name: "Saint Vincent and the Grenadines"},
+	{A2: "VE", A3: "VEN", Num: "862", Name: "Venezuela, Bolivarian Republic of"},
+	{A2: "VG", A3: "VGB", Num: "092", Name: "Virgin Islands, British"},
+	{A2: "VI", A3: "VIR", Num: "850", Name: "Virgin Islands, U.S."},
+	{A2: "VN", A3: "VNM", Num: "704", Name: "Viet Nam"},
+	{A2: "VU", A3: "VUT", Num: "548", Name: "Vanuatu"},
+	{A2: "WF", A3: "WLF", Num: "876", Name: "Wallis and Futuna"},
+	{A2: "WS", A3: "WSM", Num: "882", Name: "Samoa"},
+	{A2: "YE", A3: "YEM", Num: "887", Name: "Yemen"},
+	{A2: "YT", A3: "MYT", Num: "175", Name: "Mayotte"},
+	{A2: "ZA", A3: "ZAF", Num: "710", Name: "South Africa"},
+	{A2: "ZM", A3: "ZMB", Num: "894", Name: "Zambia"},
+	{A2: "ZW", A3: "ZWE", Num: "716", Name: "Zimbabwe"},
+}
+
+var altNames = map[string]string{
+	"Principality of Andorra":                              "AD",
+	"Islamic Republic of Afghanistan":                      "AF",
+	"Republic of Albania":                                  "AL",
+	"Republic of Armenia":                                  "AM",
+	"Republic of Angola":                                   "AO",
+	"Argentine Republic":                                   "AR",
+	"Republic of Austria":                                  "AT",
+	"Republic of Azerbaijan":                               "AZ",
+	"Republic of Bosnia and Herzegovina":                   "BA",
+	"People's Republic of Bangladesh":                      "BD",
+	"Kingdom of Belgium":                                   "BE",
+	"Republic of Bulgaria":                                 "BG",
+	"Kingdom of Bahrain":                                   "BH",
+	"Republic of Burundi":                                  "BI",
+	"Republic of Benin":                                    "BJ",
+	"Bolivia":                                              "BO",
+	"Plurinational State of Bolivia":                       "BO",
+	"Federative Republic of Brazil":                        "BR",
+	"Commonwealth of the Bahamas":                          "BS",
+	"Kingdom of Bhutan":                                    "BT",
+	"Republic of Botswana":                                 "BW",
+	"Republic of Belarus":                                  "BY",
+	"Republic of the Congo":                                "CG",
+	"Swiss Confederation":                                  "CH",
+	"Republic of Côte d'Ivoire":                            "CI",
+	"Republic of Chile":                                    "CL",
+	"Republic of Cameroon":                                 "CM",
+	"People's Republic of China":                           "CN",
+	"Republic of Colombia":                                 "CO",
+	"Republic of Costa Rica":                               "CR",
+	"Republic of Cuba":                                     "CU",
+	"Republic of Cabo Verde":                               "CV",
+	"Republic of Cyprus":                                   "CY",
+	"Czech Republic":                                       "CZ",
+	"Federal Republic of Germany":                          "DE",
+	"Republic of Djibouti":                                 "DJ",
+	"Kingdom of Denmark":                                   "DK",
+	"Commonwealth of Dominica":                             "DM",
+	"People's Democratic Republic of Algeria":              "DZ",
+	"Republic of Ecuador":                                  "EC",
+	"Republic of Estonia":                                  "EE",
+	"Arab Republic of Egypt":                               "EG",
+	"the State of Eritrea":                                 "ER",
+	"Kingdom of Spain":                                     "ES",
+	"Federal Democratic Republic of Ethiopia":              "ET",
+	"Republic of Finland":                                  "FI",
+	"Republic of Fiji":                                     "FJ",
+	"Federated States of Micronesia":                       "FM",
+	"French Republic":                                      "FR",
+	"Gabonese Republic":                                    "GA",
+	"United Kingdom of Great Britain and Northern Ireland": "GB",
+	"Republic of Ghana":                                    "GH",
+	"Republic of the Gambia":                               "GM",
+	"Republic of Guinea":                                   "GN",
+	"Republic of Equatorial Guinea":                        "GQ",
+	"Hellenic Republic":                                    "GR",
+	"Republic of Guatemala":                                "GT",
+	"Republic of Guinea-Bissau":                            "GW",
+	"Republic of Guyana":                                   "GY",
+	"Hong Kong Special Administrative Region of China":     "HK",
+	"Republic of Honduras":                                 "HN",
+	"Republic of Croatia":                                  "HR",
+	"Republic of Haiti":                                    "HT",
+	"Republic of Indonesia":                                "ID",
+	"State of Israel":                                      "IL",
+	"Republic of India":                                    "IN",
+	"Republic of Iraq":                                     "IQ",
+	"Iran":                                                 "IR",
+	"Islamic Republic of Iran":                             "IR",
+	"Republic of Iceland":                                  "IS",
+	"Italian Republic":                                     "IT",
+	"Hashemite Kingdom of Jordan":                          "JO",
+	"Republic of Kenya":                                    "KE",
+	"Kyrgyz Republic":                                      "KG",
+	"Kingdom of Cambodia":                                  "KH",
+	"Republic of Kiribati":                                 "KI",
+	"Union of the Comoros":                                 "KM",
+	"North Korea":                                          "KP",
+	"Democratic People's Republic of Korea":                "KP",
+	"South Korea":                                          "KR",
+	"State of Kuwait":                                      "KW",
+	"Republic of Kazakhstan":                               "KZ",
+	"Laos":                                                 "LA",
+	"Lebanese Republic":                                    "LB",
+	"Principality of Liechtenstein":                        "LI",
+	"Democratic Socialist Republic of Sri Lanka":           "LK",
+	"Republic of Liberia":                                  "LR",
+	"Kingdom of Lesotho":                                   "LS",
+	"Republic of Lithuania":                                "LT",
+	"Grand Duchy of Luxembourg":                            "LU",
+	"Republic of Latvia":                                   "LV",
+	"Kingdom of Morocco":                                   "MA",
+	"Principality of Monaco":                               "MC",
+	"Moldova":                                              "MD",
+	"Republic of Moldova":                                  "MD",
+	"Republic of Madagascar":                               "MG",
+	"Republic of the Marshall Islands":                     "MH",
+	"Republic of North Macedonia":                          "MK",
+	"Republic of Mali":                                     "ML",
+	"Republic of Myanmar":                                  "MM",
+	"Macao Special Administrative Region of China":         "MO",
+	"Commonwealth of the Northern Mariana Islands":         "MP",
+	"Islamic Republic of Mauritania":                       "MR",
+	"Republic of Malta":                                    "MT",
+	"Republic of Mauritius":                                "MU",
+	"Republic of Maldives":                                 "MV",
+	"Republic of Malawi":                                   "MW",
+	"United Mexican States":                                "MX",
+	"Republic of Mozambique":                               "MZ",
+	"Republic of Namibia":                                  "NA",
+	"Republic of the Niger":                                "NE",
+	"Federal Republic of Nigeria":                          "NG",
+	"Republic of Nicaragua":                                "NI",
+	"Kingdom of the Netherlands":                           "NL",
+	"Kingdom of Norway":                                    "NO",
+	"Federal Democratic Republic of Nepal":                 "NP",
+	"Republic of Nauru":                                    "NR",
+	"Sultanate of Oman":                                    "OM",
+	"Republic of Panama":                                   "PA",
+	"Republic of Peru":                                     "PE",
+	"Independent State of Papua New Guinea":                "PG",
+	"Republic of the Philippines":                          "PH",
+	"Islamic Republic of Pakistan":                         "PK",
+	"Republic of Poland":                                   "PL",
+	"the State of Palestine":                               "PS",
+	"Portuguese Republic":                                  "PT",
+	"Republic of Palau":                                    "PW",
+	"Republic of Paraguay":                                 "PY",
+	"State of Qatar":                                       "QA",
+	"Republic of Serbia":                                   "RS",
+	"Rwandese Republic":                                    "RW",
+	"Kingdom of Saudi Arabia":                              "SA",
+	"Republic of Seychelles":                               "SC",
+	"Republic of the Sudan":                                "SD",
+	"Kingdom of Sweden":                                    "SE",
+	"Republic of Singapore":                                "SG",
+	"Republic of Slovenia":                                 "SI",
+	"Slovak Republic":                                      "SK",
+	"Republic of Sierra Leone":                             "SL",
+	"Republic of San Marino":                               "SM",
+	"Republic of Senegal":                                  "SN",
+	"Federal Republic of Somalia":                          "SO",
+	"Republic of Suriname":                                 "SR",
+	"Republic of South Sudan":                              "SS",
+	"Democratic Republic of Sao Tome and Principe":         "ST",
+	"Republic of El Salvador":                              "SV",
+	"Syria":                                                "SY",
+	"Kingdom of Eswatini":                                  "SZ",
+	"Republic of Chad":                                     "TD",
+	"Togolese Republic":                                    "TG",
+	"Kingdom of Thailand":                                  "TH",
+	"Republic of Tajikistan":                               "TJ",
+	"Democratic Republic of Timor-Leste":                   "TL",
+	"Republic of Tunisia":                                  "TN",
+	"Kingdom of Tonga":                                     "TO",
+	"Republic of Türkiye":                                  "TR",
+	"Republic of Trinidad and Tobago":                      "TT",
+	"Taiwan":                                               "TW",
+	"Tanzania":                                             "TZ",
+	"United Republic of Tanzania":                          "TZ",
+	"Republic of Uganda":                                   "UG",
+	"United States of America":                             "US",
+	"Eastern Republic of Uruguay":                          "UY",
+	"Republic of Uzbekistan":                               "UZ",
+	"Venezuela":                                            "VE",
+	"Bolivarian Republic of Venezuela":                     "VE",
+	"British Virgin Islands":                               "VG",
+	"Virgin Islands of the United States":                  "VI",
+	"Vietnam":                                              "VN",
+	"Socialist Republic of Viet Nam":                       "VN",
+	"Republic of Vanuatu":                                  "VU",
+	"Independent State of Samoa":                           "WS",
+	"Republic of Yemen":                                    "YE",
+	"Republic of South Africa":                             "ZA",
+	"Republic of Zambia":                                   "ZM",
+	"Republic of Zimbabwe":                                 "ZW",
+}
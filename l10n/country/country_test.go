@@ -0,0 +1,67 @@
+package country
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		v    string
+		want string
+		ok   bool
+	}{
+		{v: "us", want: "US", ok: true},
+		{v: "USA", want: "US", ok: true},
+		{v: "840", want: "US", ok: true},
+		{v: "40", want: "AT", ok: true},
+		{v: "040", want: "AT", ok: true},
+		{v: "Germany", want: "DE", ok: true},
+		{v: " germany ", want: "DE", ok: true},
+		{v: "Bolivia", want: "BO", ok: true},
+		{v: "Plurinational State of Bolivia", want: "BO", ok: true},
+		{v: "Korea, Republic of", want: "KR", ok: true},
+		{v: "South Korea", want: "KR", ok: true},
+		{v: "", ok: false},
+		{v: "XX", ok: false},
+		{v: "999", ok: false},
+		{v: "Atlantis", ok: false},
+	}
+
+	for _, tt := range tests {
+		c, ok := Lookup(tt.v)
+		if ok != tt.ok || c.A2 != tt.want {
+			t.Errorf("Lookup(%q) got=(%q, %t); want=(%q, %t)", tt.v, c.A2, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestA2ToA3(t *testing.T) {
+	tests := []struct {
+		a2, a3 string
+		ok     bool
+	}{
+		{a2: "US", a3: "USA", ok: true},
+		{a2: "gb", a3: "GBR", ok: true},
+		{a2: "XX", a3: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		if a3, ok := A2ToA3(tt.a2); ok != tt.ok || a3 != tt.a3 {
+			t.Errorf("A2ToA3(%q) got=(%q, %t); want=(%q, %t)", tt.a2, a3, ok, tt.a3, tt.ok)
+		}
+		if !tt.ok {
+			continue
+		}
+		if a2, ok := A3ToA2(tt.a3); !ok || a2 != strings.ToUpper(tt.a2) {
+			t.Errorf("A3ToA2(%q) got=(%q, %t); want=(%q, true)", tt.a3, a2, ok, tt.a2)
+		}
+	}
+}
+
+func TestGetByNum(t *testing.T) {
+	c, ok := GetByNum("826")
+	if !ok || c.A2 != "GB" || c.Name != "United Kingdom" {
+		t.Errorf("GetByNum(%q) got=(%+v, %t)", "826", c, ok)
+	}
+}
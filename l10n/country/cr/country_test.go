@@ -32,10 +32,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10101",
+			"40104",
 		},
 		Fail: []string{
-			//
+			"1010",
+			"101010",
 		},
 	}})
 }
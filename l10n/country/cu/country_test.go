@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"CU", ""}, testutil.List{{
+	testutil.Run(t, []string{"CU", "CUB"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10400",
+			"80100",
 		},
 		Fail: []string{
-			//
+			"1040",
+			"104000",
 		},
 	}})
 }
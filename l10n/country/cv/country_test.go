@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"CV", ""}, testutil.List{{
+	testutil.Run(t, []string{"CV", "CPV"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"7600",
+			"7110",
 		},
 		Fail: []string{
-			//
+			"760",
+			"76000",
 		},
 	}})
 }
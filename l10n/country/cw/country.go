@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "CW", A3: "CUW", Num: "531",
+		Zip: country.NoZip,
 	})
 }
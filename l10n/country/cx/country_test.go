@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"CX", ""}, testutil.List{{
+	testutil.Run(t, []string{"CX", "CXR"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"6798",
 		},
 		Fail: []string{
-			//
+			"6799",
+			"0798",
 		},
 	}})
 }
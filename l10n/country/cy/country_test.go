@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"CY", ""}, testutil.List{{
+	testutil.Run(t, []string{"CY", "CYP"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1010",
+			"8047",
 		},
 		Fail: []string{
-			//
+			"101",
+			"10100",
 		},
 	}})
 }
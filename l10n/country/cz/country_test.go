@@ -32,9 +32,13 @@ func Test(t *testing.T) {
 			"39919",
 			"938 29",
 			"39949",
+			"110 00",
+			"11000",
 		},
 		Fail: []string{
 			//
+			"1100",
+			"110 000",
 		},
 	}})
 }
@@ -39,10 +39,24 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10115",
+			"80331",
+			"01067",
 		},
 		Fail: []string{
-			//
+			"1011",
+			"101155",
+			"D-10115",
+		},
+	}, {
+		Name: "ZipIn/BY", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "BY", cc) },
+		Pass: []string{
+			"80331",
+			"90402",
+		},
+		Fail: []string{
+			"10115",
+			"20095",
 		},
 	}})
 }
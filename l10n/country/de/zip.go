@@ -0,0 +1,127 @@
+package de
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The postal code regions, i.e. the first two digits of the postal code,
+// and the states that have postal codes in those regions. The regions don't
+// follow the state borders, therefore most of them map to more than one state.
+var zipRanges = []country.ZipRange{
+	{From: "01", To: "02", Subdivision: "DE-SN"},
+	{From: "03", To: "03", Subdivision: "DE-BB"},
+	{From: "03", To: "03", Subdivision: "DE-SN"},
+	{From: "04", To: "04", Subdivision: "DE-SN"},
+	{From: "04", To: "04", Subdivision: "DE-ST"},
+	{From: "04", To: "04", Subdivision: "DE-TH"},
+	{From: "06", To: "06", Subdivision: "DE-ST"},
+	{From: "06", To: "06", Subdivision: "DE-SN"},
+	{From: "06", To: "06", Subdivision: "DE-TH"},
+	{From: "07", To: "07", Subdivision: "DE-TH"},
+	{From: "07", To: "07", Subdivision: "DE-SN"},
+	{From: "08", To: "08", Subdivision: "DE-SN"},
+	{From: "08", To: "08", Subdivision: "DE-TH"},
+	{From: "09", To: "09", Subdivision: "DE-SN"},
+	{From: "10", To: "10", Subdivision: "DE-BE"},
+	{From: "12", To: "13", Subdivision: "DE-BE"},
+	{From: "14", To: "14", Subdivision: "DE-BE"},
+	{From: "14", To: "14", Subdivision: "DE-BB"},
+	{From: "15", To: "16", Subdivision: "DE-BB"},
+	{From: "17", To: "17", Subdivision: "DE-MV"},
+	{From: "17", To: "17", Subdivision: "DE-BB"},
+	{From: "18", To: "18", Subdivision: "DE-MV"},
+	{From: "19", To: "19", Subdivision: "DE-MV"},
+	{From: "19", To: "19", Subdivision: "DE-BB"},
+	{From: "19", To: "19", Subdivision: "DE-NI"},
+	{From: "19", To: "19", Subdivision: "DE-SH"},
+	{From: "20", To: "20", Subdivision: "DE-HH"},
+	{From: "21", To: "21", Subdivision: "DE-HH"},
+	{From: "21", To: "21", Subdivision: "DE-NI"},
+	{From: "21", To: "21", Subdivision: "DE-SH"},
+	{From: "22", To: "22", Subdivision: "DE-HH"},
+	{From: "22", To: "22", Subdivision: "DE-SH"},
+	{From: "23", To: "23", Subdivision: "DE-SH"},
+	{From: "23", To: "23", Subdivision: "DE-MV"},
+	{From: "23", To: "23", Subdivision: "DE-NI"},
+	{From: "24", To: "25", Subdivision: "DE-SH"},
+	{From: "26", To: "26", Subdivision: "DE-NI"},
+	{From: "27", To: "27", Subdivision: "DE-NI"},
+	{From: "27", To: "27", Subdivision: "DE-HB"},
+	{From: "28", To: "28", Subdivision: "DE-HB"},
+	{From: "28", To: "28", Subdivision: "DE-NI"},
+	{From: "29", To: "29", Subdivision: "DE-NI"},
+	{From: "29", To: "29", Subdivision: "DE-ST"},
+	{From: "30", To: "31", Subdivision: "DE-NI"},
+	{From: "32", To: "33", Subdivision: "DE-NW"},
+	{From: "32", To: "33", Subdivision: "DE-NI"},
+	{From: "34", To: "34", Subdivision: "DE-HE"},
+	{From: "34", To: "34", Subdivision: "DE-NI"},
+	{From: "34", To: "34", Subdivision: "DE-NW"},
+	{From: "35", To: "35", Subdivision: "DE-HE"},
+	{From: "35", To: "35", Subdivision: "DE-NW"},
+	{From: "36", To: "36", Subdivision: "DE-HE"},
+	{From: "36", To: "36", Subdivision: "DE-TH"},
+	{From: "36", To: "36", Subdivision: "DE-BY"},
+	{From: "37", To: "37", Subdivision: "DE-NI"},
+	{From: "37", To: "37", Subdivision: "DE-HE"},
+	{From: "37", To: "37", Subdivision: "DE-TH"},
+	{From: "37", To: "37", Subdivision: "DE-NW"},
+	{From: "38", To: "38", Subdivision: "DE-NI"},
+	{From: "38", To: "38", Subdivision: "DE-ST"},
+	{From: "39", To: "39", Subdivision: "DE-ST"},
+	{From: "39", To: "39", Subdivision: "DE-NI"},
+	{From: "40", To: "42", Subdivision: "DE-NW"},
+	{From: "44", To: "47", Subdivision: "DE-NW"},
+	{From: "48", To: "48", Subdivision: "DE-NW"},
+	{From: "48", To: "48", Subdivision: "DE-NI"},
+	{From: "49", To: "49", Subdivision: "DE-NI"},
+	{From: "49", To: "49", Subdivision: "DE-NW"},
+	{From: "50", To: "52", Subdivision: "DE-NW"},
+	{From: "53", To: "53", Subdivision: "DE-NW"},
+	{From: "53", To: "53", Subdivision: "DE-RP"},
+	{From: "54", To: "54", Subdivision: "DE-RP"},
+	{From: "54", To: "54", Subdivision: "DE-SL"},
+	{From: "55", To: "56", Subdivision: "DE-RP"},
+	{From: "57", To: "57", Subdivision: "DE-NW"},
+	{From: "57", To: "57", Subdivision: "DE-RP"},
+	{From: "57", To: "57", Subdivision: "DE-HE"},
+	{From: "58", To: "59", Subdivision: "DE-NW"},
+	{From: "60", To: "61", Subdivision: "DE-HE"},
+	{From: "63", To: "63", Subdivision: "DE-HE"},
+	{From: "63", To: "63", Subdivision: "DE-BY"},
+	{From: "64", To: "64", Subdivision: "DE-HE"},
+	{From: "64", To: "64", Subdivision: "DE-BY"},
+	{From: "65", To: "65", Subdivision: "DE-HE"},
+	{From: "65", To: "65", Subdivision: "DE-RP"},
+	{From: "66", To: "66", Subdivision: "DE-SL"},
+	{From: "66", To: "66", Subdivision: "DE-RP"},
+	{From: "67", To: "67", Subdivision: "DE-RP"},
+	{From: "68", To: "68", Subdivision: "DE-BW"},
+	{From: "68", To: "68", Subdivision: "DE-HE"},
+	{From: "68", To: "68", Subdivision: "DE-RP"},
+	{From: "69", To: "69", Subdivision: "DE-BW"},
+	{From: "69", To: "69", Subdivision: "DE-HE"},
+	{From: "70", To: "73", Subdivision: "DE-BW"},
+	{From: "74", To: "74", Subdivision: "DE-BW"},
+	{From: "74", To: "74", Subdivision: "DE-BY"},
+	{From: "75", To: "75", Subdivision: "DE-BW"},
+	{From: "76", To: "76", Subdivision: "DE-BW"},
+	{From: "76", To: "76", Subdivision: "DE-RP"},
+	{From: "77", To: "79", Subdivision: "DE-BW"},
+	{From: "80", To: "85", Subdivision: "DE-BY"},
+	{From: "86", To: "86", Subdivision: "DE-BY"},
+	{From: "86", To: "86", Subdivision: "DE-BW"},
+	{From: "87", To: "87", Subdivision: "DE-BY"},
+	{From: "87", To: "87", Subdivision: "DE-BW"},
+	{From: "88", To: "88", Subdivision: "DE-BW"},
+	{From: "88", To: "88", Subdivision: "DE-BY"},
+	{From: "89", To: "89", Subdivision: "DE-BW"},
+	{From: "89", To: "89", Subdivision: "DE-BY"},
+	{From: "90", To: "95", Subdivision: "DE-BY"},
+	{From: "96", To: "96", Subdivision: "DE-BY"},
+	{From: "96", To: "96", Subdivision: "DE-TH"},
+	{From: "97", To: "97", Subdivision: "DE-BY"},
+	{From: "97", To: "97", Subdivision: "DE-BW"},
+	{From: "97", To: "97", Subdivision: "DE-HE"},
+	{From: "98", To: "99", Subdivision: "DE-TH"},
+}
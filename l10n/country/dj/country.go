@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "DJ", A3: "DJI", Num: "262",
+		Zip: country.NoZip,
 	})
 }
@@ -29,10 +29,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1050",
+			"DK-8000",
 		},
 		Fail: []string{
-			//
+			"105",
+			"10500",
+			"DK8000",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "DM", A3: "DMA", Num: "212",
+		Zip: country.NoZip,
 	})
 }
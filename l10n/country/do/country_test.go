@@ -33,11 +33,15 @@ func Test(t *testing.T) {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
 			"12345",
+			"10101",
+			"51000",
 		},
 		Fail: []string{
 			"A1234",
 			"123",
 			"123456",
+			"1010",
+			"101010",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"DZ", ""}, testutil.List{{
+	testutil.Run(t, []string{"DZ", "DZA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"16000",
+			"31000",
 		},
 		Fail: []string{
-			//
+			"1600",
+			"160000",
 		},
 	}})
 }
@@ -32,10 +32,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"170150",
+			"090112",
 		},
 		Fail: []string{
-			//
+			"17015",
+			"EC170150",
 		},
 	}})
 }
@@ -32,10 +32,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10111",
+			"51014",
 		},
 		Fail: []string{
-			//
+			"1011",
+			"101110",
 		},
 	}})
 }
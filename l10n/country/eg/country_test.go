@@ -43,10 +43,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11511",
+			"21599",
 		},
 		Fail: []string{
-			//
+			"1151",
+			"115111",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "EH", A3: "ESH", Num: "732",
+		Zip: country.RxZip5Digits,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"EH", ""}, testutil.List{{
+	testutil.Run(t, []string{"EH", "ESH"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"70000",
+			"73000",
 		},
 		Fail: []string{
-			//
+			"7000",
+			"700000",
 		},
 	}})
 }
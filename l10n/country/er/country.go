@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "ER", A3: "ERI", Num: "232",
+		Zip: country.NoZip,
 	})
 }
@@ -37,6 +37,9 @@ func Test(t *testing.T) {
 			"01001",
 			"52999",
 			"27880",
+			"28001",
+			"08080",
+			"52001",
 		},
 		Fail: []string{
 			"123",
@@ -45,6 +48,31 @@ func Test(t *testing.T) {
 			"052999",
 			"0123",
 			"abcde",
+			"53001",
+			"2800",
+			"280011",
+		},
+	}, {
+		Name: "ZipIn/M", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "M", cc) },
+		Pass: []string{
+			"28001",
+			"28990",
+		},
+		Fail: []string{
+			"08080",
+			"2800",
+		},
+	}, {
+		Name: "ZipIn/ES-CT", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "ES-CT", cc) },
+		Pass: []string{
+			"08080",
+			"17001",
+			"25001",
+			"43001",
+		},
+		Fail: []string{
+			"28001",
+			"52001",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -0,0 +1,61 @@
+package es
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The first two digits of the postal code are the province's number.
+var zipRanges = []country.ZipRange{
+	{From: "01", To: "01", Subdivision: "ES-VI"},
+	{From: "02", To: "02", Subdivision: "ES-AB"},
+	{From: "03", To: "03", Subdivision: "ES-A"},
+	{From: "04", To: "04", Subdivision: "ES-AL"},
+	{From: "05", To: "05", Subdivision: "ES-AV"},
+	{From: "06", To: "06", Subdivision: "ES-BA"},
+	{From: "07", To: "07", Subdivision: "ES-PM"},
+	{From: "08", To: "08", Subdivision: "ES-B"},
+	{From: "09", To: "09", Subdivision: "ES-BU"},
+	{From: "10", To: "10", Subdivision: "ES-CC"},
+	{From: "11", To: "11", Subdivision: "ES-CA"},
+	{From: "12", To: "12", Subdivision: "ES-CS"},
+	{From: "13", To: "13", Subdivision: "ES-CR"},
+	{From: "14", To: "14", Subdivision: "ES-CO"},
+	{From: "15", To: "15", Subdivision: "ES-C"},
+	{From: "16", To: "16", Subdivision: "ES-CU"},
+	{From: "17", To: "17", Subdivision: "ES-GI"},
+	{From: "18", To: "18", Subdivision: "ES-GR"},
+	{From: "19", To: "19", Subdivision: "ES-GU"},
+	{From: "20", To: "20", Subdivision: "ES-SS"},
+	{From: "21", To: "21", Subdivision: "ES-H"},
+	{From: "22", To: "22", Subdivision: "ES-HU"},
+	{From: "23", To: "23", Subdivision: "ES-J"},
+	{From: "24", To: "24", Subdivision: "ES-LE"},
+	{From: "25", To: "25", Subdivision: "ES-L"},
+	{From: "26", To: "26", Subdivision: "ES-LO"},
+	{From: "27", To: "27", Subdivision: "ES-LU"},
+	{From: "28", To: "28", Subdivision: "ES-M"},
+	{From: "29", To: "29", Subdivision: "ES-MA"},
+	{From: "30", To: "30", Subdivision: "ES-MU"},
+	{From: "31", To: "31", Subdivision: "ES-NA"},
+	{From: "32", To: "32", Subdivision: "ES-OR"},
+	{From: "33", To: "33", Subdivision: "ES-O"},
+	{From: "34", To: "34", Subdivision: "ES-P"},
+	{From: "35", To: "35", Subdivision: "ES-GC"},
+	{From: "36", To: "36", Subdivision: "ES-PO"},
+	{From: "37", To: "37", Subdivision: "ES-SA"},
+	{From: "38", To: "38", Subdivision: "ES-TF"},
+	{From: "39", To: "39", Subdivision: "ES-S"},
+	{From: "40", To: "40", Subdivision: "ES-SG"},
+	{From: "41", To: "41", Subdivision: "ES-SE"},
+	{From: "42", To: "42", Subdivision: "ES-SO"},
+	{From: "43", To: "43", Subdivision: "ES-T"},
+	{From: "44", To: "44", Subdivision: "ES-TE"},
+	{From: "45", To: "45", Subdivision: "ES-TO"},
+	{From: "46", To: "46", Subdivision: "ES-V"},
+	{From: "47", To: "47", Subdivision: "ES-VA"},
+	{From: "48", To: "48", Subdivision: "ES-BI"},
+	{From: "49", To: "49", Subdivision: "ES-ZA"},
+	{From: "50", To: "50", Subdivision: "ES-Z"},
+	{From: "51", To: "51", Subdivision: "ES-CE"},
+	{From: "52", To: "52", Subdivision: "ES-ML"},
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"ET", ""}, testutil.List{{
+	testutil.Run(t, []string{"ET", "ETH"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"3020",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
@@ -38,10 +38,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"00100",
+			"99999",
 		},
 		Fail: []string{
-			//
+			"0010",
+			"FI-00100",
 		},
 	}})
 }
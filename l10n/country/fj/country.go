@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "FJ", A3: "FJI", Num: "242",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+?679)?[ ]?[0-9]{3}[ ]?[0-9]{4}$`),
 	})
 }
@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "FM", A3: "FSM", Num: "583",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^9694[1-4](?:-?[0-9]{4})?$`),
 	})
 }
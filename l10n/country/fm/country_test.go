@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"FM", ""}, testutil.List{{
+	testutil.Run(t, []string{"FM", "FSM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96941",
+			"96944",
+			"96941-0001",
 		},
 		Fail: []string{
-			//
+			"96945",
+			"96799",
 		},
 	}})
 }
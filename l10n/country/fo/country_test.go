@@ -28,10 +28,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"FO-100",
+			"100",
 		},
 		Fail: []string{
-			//
+			"1000",
+			"FO100",
 		},
 	}})
 }
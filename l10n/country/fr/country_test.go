@@ -40,9 +40,13 @@ func Test(t *testing.T) {
 			"98025",
 			"38 499",
 			"39940",
+			"75001",
+			"75 001",
 		},
 		Fail: []string{
 			//
+			"7500",
+			"750011",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -59,5 +63,26 @@ func Test(t *testing.T) {
 			"FR428134547171",
 			"FR84323140391",
 		},
+	}, {
+		Name: "ZipIn/75", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "75", cc) },
+		Pass: []string{
+			"75001",
+			"75116",
+		},
+		Fail: []string{
+			"77000",
+			"13001",
+		},
+	}, {
+		Name: "ZipIn/IDF", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "FR-IDF", cc) },
+		Pass: []string{
+			"75001",
+			"77000",
+			"92100",
+		},
+		Fail: []string{
+			"13001",
+			"69001",
+		},
 	}})
 }
@@ -7,8 +7,9 @@ import (
 	"github.com/frk/isvalid/internal/algo"
 )
 
-// - 'FR'+ 2 digits (as validation key ) + 9 digits (as SIREN), the first and/or the
-//   second value can also be a character (any except O or I) - e.g. FRXX999999999
+//   - 'FR'+ 2 digits (as validation key ) + 9 digits (as SIREN), the first and/or the
+//     second value can also be a character (any except O or I) - e.g. FRXX999999999
+//
 // References:
 // - https://en.wikipedia.org/wiki/VAT_identification_number
 // - https://www.gov.uk/guidance/vat-eu-country-codes-vat-numbers-and-vat-in-other-languages
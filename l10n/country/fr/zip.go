@@ -0,0 +1,112 @@
+package fr
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The first two digits of the postal code are those of the department, with
+// the exception of Corsica (20) and of the overseas departments (97) for which
+// the first three digits are used.
+var zipRanges = []country.ZipRange{
+	{From: "01", To: "01", Subdivision: "FR-01"},
+	{From: "02", To: "02", Subdivision: "FR-02"},
+	{From: "03", To: "03", Subdivision: "FR-03"},
+	{From: "04", To: "04", Subdivision: "FR-04"},
+	{From: "05", To: "05", Subdivision: "FR-05"},
+	{From: "06", To: "06", Subdivision: "FR-06"},
+	{From: "07", To: "07", Subdivision: "FR-07"},
+	{From: "08", To: "08", Subdivision: "FR-08"},
+	{From: "09", To: "09", Subdivision: "FR-09"},
+	{From: "10", To: "10", Subdivision: "FR-10"},
+	{From: "11", To: "11", Subdivision: "FR-11"},
+	{From: "12", To: "12", Subdivision: "FR-12"},
+	{From: "13", To: "13", Subdivision: "FR-13"},
+	{From: "14", To: "14", Subdivision: "FR-14"},
+	{From: "15", To: "15", Subdivision: "FR-15"},
+	{From: "16", To: "16", Subdivision: "FR-16"},
+	{From: "17", To: "17", Subdivision: "FR-17"},
+	{From: "18", To: "18", Subdivision: "FR-18"},
+	{From: "19", To: "19", Subdivision: "FR-19"},
+	{From: "21", To: "21", Subdivision: "FR-21"},
+	{From: "22", To: "22", Subdivision: "FR-22"},
+	{From: "23", To: "23", Subdivision: "FR-23"},
+	{From: "24", To: "24", Subdivision: "FR-24"},
+	{From: "25", To: "25", Subdivision: "FR-25"},
+	{From: "26", To: "26", Subdivision: "FR-26"},
+	{From: "27", To: "27", Subdivision: "FR-27"},
+	{From: "28", To: "28", Subdivision: "FR-28"},
+	{From: "29", To: "29", Subdivision: "FR-29"},
+	{From: "30", To: "30", Subdivision: "FR-30"},
+	{From: "31", To: "31", Subdivision: "FR-31"},
+	{From: "32", To: "32", Subdivision: "FR-32"},
+	{From: "33", To: "33", Subdivision: "FR-33"},
+	{From: "34", To: "34", Subdivision: "FR-34"},
+	{From: "35", To: "35", Subdivision: "FR-35"},
+	{From: "36", To: "36", Subdivision: "FR-36"},
+	{From: "37", To: "37", Subdivision: "FR-37"},
+	{From: "38", To: "38", Subdivision: "FR-38"},
+	{From: "39", To: "39", Subdivision: "FR-39"},
+	{From: "40", To: "40", Subdivision: "FR-40"},
+	{From: "41", To: "41", Subdivision: "FR-41"},
+	{From: "42", To: "42", Subdivision: "FR-42"},
+	{From: "43", To: "43", Subdivision: "FR-43"},
+	{From: "44", To: "44", Subdivision: "FR-44"},
+	{From: "45", To: "45", Subdivision: "FR-45"},
+	{From: "46", To: "46", Subdivision: "FR-46"},
+	{From: "47", To: "47", Subdivision: "FR-47"},
+	{From: "48", To: "48", Subdivision: "FR-48"},
+	{From: "49", To: "49", Subdivision: "FR-49"},
+	{From: "50", To: "50", Subdivision: "FR-50"},
+	{From: "51", To: "51", Subdivision: "FR-51"},
+	{From: "52", To: "52", Subdivision: "FR-52"},
+	{From: "53", To: "53", Subdivision: "FR-53"},
+	{From: "54", To: "54", Subdivision: "FR-54"},
+	{From: "55", To: "55", Subdivision: "FR-55"},
+	{From: "56", To: "56", Subdivision: "FR-56"},
+	{From: "57", To: "57", Subdivision: "FR-57"},
+	{From: "58", To: "58", Subdivision: "FR-58"},
+	{From: "59", To: "59", Subdivision: "FR-59"},
+	{From: "60", To: "60", Subdivision: "FR-60"},
+	{From: "61", To: "61", Subdivision: "FR-61"},
+	{From: "62", To: "62", Subdivision: "FR-62"},
+	{From: "63", To: "63", Subdivision: "FR-63"},
+	{From: "64", To: "64", Subdivision: "FR-64"},
+	{From: "65", To: "65", Subdivision: "FR-65"},
+	{From: "66", To: "66", Subdivision: "FR-66"},
+	{From: "67", To: "67", Subdivision: "FR-67"},
+	{From: "68", To: "68", Subdivision: "FR-68"},
+	{From: "69", To: "69", Subdivision: "FR-69"},
+	{From: "70", To: "70", Subdivision: "FR-70"},
+	{From: "71", To: "71", Subdivision: "FR-71"},
+	{From: "72", To: "72", Subdivision: "FR-72"},
+	{From: "73", To: "73", Subdivision: "FR-73"},
+	{From: "74", To: "74", Subdivision: "FR-74"},
+	{From: "75", To: "75", Subdivision: "FR-75"},
+	{From: "76", To: "76", Subdivision: "FR-76"},
+	{From: "77", To: "77", Subdivision: "FR-77"},
+	{From: "78", To: "78", Subdivision: "FR-78"},
+	{From: "79", To: "79", Subdivision: "FR-79"},
+	{From: "80", To: "80", Subdivision: "FR-80"},
+	{From: "81", To: "81", Subdivision: "FR-81"},
+	{From: "82", To: "82", Subdivision: "FR-82"},
+	{From: "83", To: "83", Subdivision: "FR-83"},
+	{From: "84", To: "84", Subdivision: "FR-84"},
+	{From: "85", To: "85", Subdivision: "FR-85"},
+	{From: "86", To: "86", Subdivision: "FR-86"},
+	{From: "87", To: "87", Subdivision: "FR-87"},
+	{From: "88", To: "88", Subdivision: "FR-88"},
+	{From: "89", To: "89", Subdivision: "FR-89"},
+	{From: "90", To: "90", Subdivision: "FR-90"},
+	{From: "91", To: "91", Subdivision: "FR-91"},
+	{From: "92", To: "92", Subdivision: "FR-92"},
+	{From: "93", To: "93", Subdivision: "FR-93"},
+	{From: "94", To: "94", Subdivision: "FR-94"},
+	{From: "95", To: "95", Subdivision: "FR-95"},
+	{From: "200", To: "201", Subdivision: "FR-2A"},
+	{From: "202", To: "206", Subdivision: "FR-2B"},
+	{From: "971", To: "971", Subdivision: "FR-971"},
+	{From: "972", To: "972", Subdivision: "FR-972"},
+	{From: "973", To: "973", Subdivision: "FR-973"},
+	{From: "974", To: "974", Subdivision: "FR-974"},
+	{From: "976", To: "976", Subdivision: "FR-976"},
+}
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GA", A3: "GAB", Num: "266",
+		Zip: country.NoZip,
 	})
 }
@@ -42,9 +42,14 @@ func Test(t *testing.T) {
 			"AA99 9AA",
 			"BS98 1TL",
 			"DE993GG",
+			"SW1A 1AA",
+			"EC1A1BB",
+			"GIR 0AA",
 		},
 		Fail: []string{
 			//
+			"12345",
+			"SW1A-1AA",
 		},
 	}})
 }
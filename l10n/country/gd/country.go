@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GD", A3: "GRD", Num: "308",
+		Zip: country.NoZip,
 	})
 }
@@ -31,10 +31,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"0100",
+			"6000",
 		},
 		Fail: []string{
-			//
+			"010",
+			"01000",
 		},
 	}})
 }
@@ -35,10 +35,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97300",
+			"97390",
 		},
 		Fail: []string{
-			//
+			"97391",
+			"97200",
 		},
 	}})
 }
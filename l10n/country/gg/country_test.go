@@ -24,10 +24,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"GY1 1AA",
+			"GY10 1AB",
+			"GY11AA",
 		},
 		Fail: []string{
-			//
+			"GY1",
+			"JE1 1AA",
 		},
 	}})
 }
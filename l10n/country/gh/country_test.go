@@ -41,10 +41,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"GA-492-5236",
+			"AK-0395-5028",
 		},
 		Fail: []string{
-			//
+			"GA4925236",
+			"GA-49-5236",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"GI", ""}, testutil.List{{
+	testutil.Run(t, []string{"GI", "GIB"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"GX11 1AA",
 		},
 		Fail: []string{
-			//
+			"GX111AA",
+			"GX11 1AB",
 		},
 	}})
 }
@@ -9,7 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GL", A3: "GRL", Num: "304",
-		Zip:   country.RxZip4Digits,
+		Zip:   regexp.MustCompile(`^39[0-9]{2}$`),
 		Phone: regexp.MustCompile(`^(?:\+?299)?(?:[ ]?[0-9]{2}){3}$`),
 	})
 }
@@ -28,10 +28,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"3900",
+			"3992",
 		},
 		Fail: []string{
-			//
+			"2900",
+			"390",
 		},
 	}})
 }
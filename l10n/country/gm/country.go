@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GM", A3: "GMB", Num: "270",
+		Zip: country.NoZip,
 	})
 }
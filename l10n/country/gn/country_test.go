@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"GN", ""}, testutil.List{{
+	testutil.Run(t, []string{"GN", "GIN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"001",
+			"050",
 		},
 		Fail: []string{
-			//
+			"0010",
+			"01",
 		},
 	}})
 }
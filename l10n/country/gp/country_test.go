@@ -35,10 +35,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97100",
+			"97190",
 		},
 		Fail: []string{
-			//
+			"97191",
+			"97200",
 		},
 	}})
 }
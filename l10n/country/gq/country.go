@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GQ", A3: "GNQ", Num: "226",
+		Zip: country.NoZip,
 	})
 }
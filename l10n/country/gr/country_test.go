@@ -32,9 +32,13 @@ func Test(t *testing.T) {
 			"90293",
 			"299 42",
 			"94944",
+			"104 31",
+			"10431",
 		},
 		Fail: []string{
 			//
+			"1043",
+			"104 311",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"GS", ""}, testutil.List{{
+	testutil.Run(t, []string{"GS", "SGS"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"SIQQ 1ZZ",
 		},
 		Fail: []string{
-			//
+			"SIQQ1ZZ",
+			"BIQQ 1ZZ",
 		},
 	}})
 }
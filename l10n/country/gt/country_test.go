@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"GT", ""}, testutil.List{{
+	testutil.Run(t, []string{"GT", "GTM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"01001",
+			"09001",
 		},
 		Fail: []string{
-			//
+			"0100",
+			"010010",
 		},
 	}})
 }
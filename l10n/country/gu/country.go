@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GU", A3: "GUM", Num: "316",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^969(?:1[0-9]|2[0-9]|3[0-2])(?:-?[0-9]{4})?$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"GU", ""}, testutil.List{{
+	testutil.Run(t, []string{"GU", "GUM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96910",
+			"96932",
+			"96913-1234",
 		},
 		Fail: []string{
-			//
+			"96933",
+			"96799",
 		},
 	}})
 }
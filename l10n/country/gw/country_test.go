@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"GW", ""}, testutil.List{{
+	testutil.Run(t, []string{"GW", "GNB"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"1011",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
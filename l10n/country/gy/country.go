@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "GY", A3: "GUY", Num: "328",
+		Zip: country.NoZip,
 	})
 }
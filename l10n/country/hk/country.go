@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "HK", A3: "HKG", Num: "344",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+?852[\- ]?)?[456789][0-9]{3}[\- ]?[0-9]{4}$`),
 	})
 }
@@ -1,11 +1,14 @@
 package hm
 
 import (
+	"regexp"
+
 	"github.com/frk/isvalid/l10n/country"
 )
 
 func init() {
 	country.Add(country.Country{
 		A2: "HM", A3: "HMD", Num: "334",
+		Zip: regexp.MustCompile(`^7151$`),
 	})
 }
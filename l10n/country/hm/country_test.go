@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"HM", ""}, testutil.List{{
+	testutil.Run(t, []string{"HM", "HMD"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"7151",
 		},
 		Fail: []string{
-			//
+			"7150",
+			"715",
 		},
 	}})
 }
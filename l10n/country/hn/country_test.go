@@ -30,10 +30,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11101",
+			"FM1101",
 		},
 		Fail: []string{
-			//
+			"1110",
+			"111011",
 		},
 	}})
 }
@@ -12,7 +12,7 @@ func init() {
 
 	country.Add(country.Country{
 		A2: "HR", A3: "HRV", Num: "191",
-		Zip: regexp.MustCompile(`^(?:[1-5][0-9]{4}$)`),
+		Zip: regexp.MustCompile(`^[1-5][0-9]{4}$`),
 		VAT: country.StringMatcherFunc(func(v string) bool {
 			if !rxvat.MatchString(v) {
 				return false
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"HR", ""}, testutil.List{{
+	testutil.Run(t, []string{"HR", "HRV"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10000",
+			"51000",
 		},
 		Fail: []string{
-			//
+			"60000",
+			"1000",
 		},
 	}})
 }
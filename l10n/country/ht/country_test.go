@@ -20,11 +20,15 @@ func Test(t *testing.T) {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
 			"HT1234",
+			"HT6110",
+			"HT1110",
 		},
 		Fail: []string{
 			"HT123",
 			"HT12345",
 			"AA1234",
+			"6110",
+			"HT611",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"HU", ""}, testutil.List{{
+	testutil.Run(t, []string{"HU", "HUN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1011",
+			"9999",
 		},
 		Fail: []string{
-			//
+			"0999",
+			"10111",
 		},
 	}})
 }
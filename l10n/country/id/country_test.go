@@ -89,9 +89,13 @@ func Test(t *testing.T) {
 			"40181",
 			"55161",
 			"60233",
+			"10110",
+			"40115",
 		},
 		Fail: []string{
 			//
+			"1011",
+			"101100",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -33,6 +33,8 @@ func Test(t *testing.T) {
 		Pass: []string{
 			"A65 TF12",
 			"D02 AF30",
+			"D02 X285",
+			"A65F4E2",
 		},
 		Fail: []string{
 			"123",
@@ -43,6 +45,8 @@ func Test(t *testing.T) {
 			"756  90HG",
 			"A65T F12",
 			"O62 O1O2",
+			"D02",
+			"12345",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -27,6 +27,8 @@ func Test(t *testing.T) {
 			"4290500",
 			"4286000",
 			"7080000",
+			"6100000",
+			"94142",
 		},
 		Fail: []string{
 			"123",
@@ -43,6 +45,8 @@ func Test(t *testing.T) {
 			"871123",
 			"881123",
 			"891123",
+			"610000",
+			"9414",
 		},
 	}})
 }
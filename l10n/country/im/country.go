@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "IM", A3: "IMN", Num: "833",
-		Zip: regexp.MustCompile(`^IM[0-9]{1,2} [0-9][A-Z]{2}$`),
+		Zip: regexp.MustCompile(`^IM[0-9]{1,2} ?[0-9][A-Z]{2}$`),
 	})
 }
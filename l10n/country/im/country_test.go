@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"IM", ""}, testutil.List{{
+	testutil.Run(t, []string{"IM", "IMN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"IM1 1AA",
+			"IM99 1PS",
 		},
 		Fail: []string{
-			//
+			"IM1",
+			"GY1 1AA",
 		},
 	}})
 }
@@ -21,6 +21,8 @@ func Test(t *testing.T) {
 		Pass: []string{
 			"364240",
 			"360005",
+			"110001",
+			"400050",
 		},
 		Fail: []string{
 			"123",
@@ -37,6 +39,8 @@ func Test(t *testing.T) {
 			"871123",
 			"881123",
 			"891123",
+			"11000",
+			"010001",
 		},
 	}})
 }
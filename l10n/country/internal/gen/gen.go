@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+
+	GO "github.com/frk/ast/golang"
+)
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Println("usage: go run gen.go <path/to/iso_3166-1.json> <path/to/iso_3166-2.json>")
+		return
+	}
+
+	countries, err := readCountries(os.Args[1])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+	subs, err := readSubdivisions(os.Args[2])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	if err := writeTableFile(buildCountryTableFile(countries), "../../country_table.go"); err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+	if err := writeTableFile(buildSubdivisionTableFile(subs), "../../subdivision_table.go"); err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+}
+
+type country struct {
+	A2           string `json:"alpha_2"`
+	A3           string `json:"alpha_3"`
+	Num          string `json:"numeric"`
+	Name         string `json:"name"`
+	CommonName   string `json:"common_name"`
+	OfficialName string `json:"official_name"`
+}
+
+func readCountries(path string) ([]country, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		List []country `json:"3166-1"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(v.List, func(i, j int) bool {
+		return v.List[i].A2 < v.List[j].A2
+	})
+	return v.List, nil
+}
+
+type subdivision struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Parent string `json:"parent"`
+}
+
+func readSubdivisions(path string) ([]subdivision, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		List []subdivision `json:"3166-2"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+
+	for i, s := range v.List {
+		// the parent is specified without the country code prefix
+		if len(s.Parent) > 0 {
+			v.List[i].Parent = s.Code[:3] + s.Parent
+		}
+	}
+	sort.Slice(v.List, func(i, j int) bool {
+		return v.List[i].Code < v.List[j].Code
+	})
+	return v.List, nil
+}
+
+func buildCountryTableFile(countries []country) *GO.File {
+	elems := GO.ExprList{}
+	for _, c := range countries {
+		elems = append(elems, GO.StructLit{Elems: []GO.FieldElement{
+			{Field: "A2", Value: GO.StringLit(c.A2)},
+			{Field: "A3", Value: GO.StringLit(c.A3)},
+			{Field: "Num", Value: GO.StringLit(c.Num)},
+			{Field: "Name", Value: GO.StringLit(c.Name)},
+		}, Compact: true})
+	}
+	slice := GO.SliceLit{Type: GO.SliceType{Elem: GO.Ident{Name: "Country"}}, Elems: elems}
+
+	// the common and official names of the
+	// countries, in addition to the short names
+	names := GO.MapLit{Type: GO.MapType{Key: GO.Ident{Name: "string"}, Value: GO.Ident{Name: "string"}}}
+	for _, c := range countries {
+		for _, name := range []string{c.CommonName, c.OfficialName} {
+			if len(name) > 0 && name != c.Name {
+				names.Elems = append(names.Elems, GO.KeyElement{
+					Key:   GO.StringLit(name),
+					Value: GO.StringLit(c.A2),
+				})
+			}
+		}
+	}
+
+	file := new(GO.File)
+	file.PkgName = "country"
+	file.Decls = append(file.Decls, GO.VarDecl{Spec: GO.ValueSpec{
+		Names:  GO.Ident{Name: "countries"},
+		Values: slice,
+	}})
+	file.Decls = append(file.Decls, GO.VarDecl{Spec: GO.ValueSpec{
+		Names:  GO.Ident{Name: "altNames"},
+		Values: names,
+	}})
+	return file
+}
+
+func buildSubdivisionTableFile(subs []subdivision) *GO.File {
+	elems := GO.ExprList{}
+	for _, s := range subs {
+		fields := []GO.FieldElement{
+			{Field: "Code", Value: GO.StringLit(s.Code)},
+			{Field: "Name", Value: GO.StringLit(s.Name)},
+			{Field: "Type", Value: GO.StringLit(s.Type)},
+		}
+		if len(s.Parent) > 0 {
+			fields = append(fields, GO.FieldElement{Field: "Parent", Value: GO.StringLit(s.Parent)})
+		}
+		elems = append(elems, GO.StructLit{Elems: fields, Compact: true})
+	}
+
+	slice := GO.SliceLit{Type: GO.SliceType{Elem: GO.Ident{Name: "Subdivision"}}, Elems: elems}
+
+	file := new(GO.File)
+	file.PkgName = "country"
+	file.Decls = append(file.Decls, GO.VarDecl{Spec: GO.ValueSpec{
+		Names:  GO.Ident{Name: "subdivisions"},
+		Values: slice,
+	}})
+	return file
+}
+
+func writeTableFile(file *GO.File, path string) (err error) {
+	buf := &bytes.Buffer{}
+	if err := GO.Write(file, buf); err != nil {
+		return err
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	// make it look pretty
+	bs, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	buf = bytes.NewBuffer(bs)
+	if _, err := io.Copy(f, buf); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"IO", ""}, testutil.List{{
+	testutil.Run(t, []string{"IO", "IOT"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"BBND 1ZZ",
 		},
 		Fail: []string{
-			//
+			"BBND1ZZ",
+			"BIQQ 1ZZ",
 		},
 	}})
 }
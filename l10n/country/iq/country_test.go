@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"IQ", ""}, testutil.List{{
+	testutil.Run(t, []string{"IQ", "IRQ"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10001",
+			"61002",
 		},
 		Fail: []string{
-			//
+			"1000",
+			"100011",
 		},
 	}})
 }
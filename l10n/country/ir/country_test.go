@@ -37,11 +37,15 @@ func Test(t *testing.T) {
 		Pass: []string{
 			"4351666456",
 			"5614736867",
+			"1193653471",
+			"9177948974",
 		},
 		Fail: []string{
 			"43516 6456",
 			"123443516 6456",
 			"891123",
+			"119365347",
+			"11936534711",
 		},
 	}})
 }
@@ -15,8 +15,9 @@ func init() {
 
 	country.Add(country.Country{
 		A2: "IT", A3: "ITA", Num: "380",
-		Zip:   country.RxZip5Digits,
-		Phone: regexp.MustCompile(`^(?:\+?39)?[ ]?3[0-9]{2}[ ]?[0-9]{6,7}$`),
+		Zip:       country.RxZip5Digits,
+		ZipRanges: zipRanges,
+		Phone:     regexp.MustCompile(`^(?:\+?39)?[ ]?3[0-9]{2}[ ]?[0-9]{6,7}$`),
 		VAT: country.StringMatcherFunc(func(v string) bool {
 			if !rxvat.MatchString(v) {
 				return false
@@ -24,10 +24,33 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"00144",
+			"20121",
 		},
 		Fail: []string{
-			//
+			"0014",
+			"001444",
+		},
+	}, {
+		Name: "ZipIn/RM", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "RM", cc) },
+		Pass: []string{
+			"00144",
+			"00010",
+		},
+		Fail: []string{
+			"20121",
+			"0014",
+		},
+	}, {
+		Name: "ZipIn/IT-25", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "IT-25", cc) },
+		Pass: []string{
+			"20121",
+			"20900",
+			"24121",
+		},
+		Fail: []string{
+			"00144",
+			"10121",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
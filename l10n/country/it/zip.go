@@ -0,0 +1,120 @@
+package it
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The first two digits of the CAP and the provinces that use them. Aosta
+// Valley has no provinces, its CAP prefix is mapped to the region.
+var zipRanges = []country.ZipRange{
+	{From: "00", To: "00", Subdivision: "IT-RM"},
+	{From: "01", To: "01", Subdivision: "IT-VT"},
+	{From: "02", To: "02", Subdivision: "IT-RI"},
+	{From: "03", To: "03", Subdivision: "IT-FR"},
+	{From: "04", To: "04", Subdivision: "IT-LT"},
+	{From: "05", To: "05", Subdivision: "IT-TR"},
+	{From: "06", To: "06", Subdivision: "IT-PG"},
+	{From: "07", To: "07", Subdivision: "IT-SS"},
+	{From: "08", To: "08", Subdivision: "IT-NU"},
+	{From: "09", To: "09", Subdivision: "IT-CA"},
+	{From: "09", To: "09", Subdivision: "IT-OR"},
+	{From: "09", To: "09", Subdivision: "IT-SU"},
+	{From: "10", To: "10", Subdivision: "IT-TO"},
+	{From: "11", To: "11", Subdivision: "IT-23"},
+	{From: "12", To: "12", Subdivision: "IT-CN"},
+	{From: "13", To: "13", Subdivision: "IT-VC"},
+	{From: "13", To: "13", Subdivision: "IT-BI"},
+	{From: "14", To: "14", Subdivision: "IT-AT"},
+	{From: "15", To: "15", Subdivision: "IT-AL"},
+	{From: "16", To: "16", Subdivision: "IT-GE"},
+	{From: "17", To: "17", Subdivision: "IT-SV"},
+	{From: "18", To: "18", Subdivision: "IT-IM"},
+	{From: "19", To: "19", Subdivision: "IT-SP"},
+	{From: "20", To: "20", Subdivision: "IT-MI"},
+	{From: "20", To: "20", Subdivision: "IT-MB"},
+	{From: "20", To: "20", Subdivision: "IT-LO"},
+	{From: "21", To: "21", Subdivision: "IT-VA"},
+	{From: "22", To: "22", Subdivision: "IT-CO"},
+	{From: "22", To: "22", Subdivision: "IT-LC"},
+	{From: "23", To: "23", Subdivision: "IT-SO"},
+	{From: "23", To: "23", Subdivision: "IT-LC"},
+	{From: "24", To: "24", Subdivision: "IT-BG"},
+	{From: "25", To: "25", Subdivision: "IT-BS"},
+	{From: "26", To: "26", Subdivision: "IT-CR"},
+	{From: "26", To: "26", Subdivision: "IT-LO"},
+	{From: "27", To: "27", Subdivision: "IT-PV"},
+	{From: "28", To: "28", Subdivision: "IT-NO"},
+	{From: "28", To: "28", Subdivision: "IT-VB"},
+	{From: "29", To: "29", Subdivision: "IT-PC"},
+	{From: "30", To: "30", Subdivision: "IT-VE"},
+	{From: "31", To: "31", Subdivision: "IT-TV"},
+	{From: "32", To: "32", Subdivision: "IT-BL"},
+	{From: "33", To: "33", Subdivision: "IT-UD"},
+	{From: "33", To: "33", Subdivision: "IT-PN"},
+	{From: "34", To: "34", Subdivision: "IT-TS"},
+	{From: "34", To: "34", Subdivision: "IT-GO"},
+	{From: "35", To: "35", Subdivision: "IT-PD"},
+	{From: "36", To: "36", Subdivision: "IT-VI"},
+	{From: "37", To: "37", Subdivision: "IT-VR"},
+	{From: "38", To: "38", Subdivision: "IT-TN"},
+	{From: "39", To: "39", Subdivision: "IT-BZ"},
+	{From: "40", To: "40", Subdivision: "IT-BO"},
+	{From: "41", To: "41", Subdivision: "IT-MO"},
+	{From: "42", To: "42", Subdivision: "IT-RE"},
+	{From: "43", To: "43", Subdivision: "IT-PR"},
+	{From: "44", To: "44", Subdivision: "IT-FE"},
+	{From: "45", To: "45", Subdivision: "IT-RO"},
+	{From: "46", To: "46", Subdivision: "IT-MN"},
+	{From: "47", To: "47", Subdivision: "IT-FC"},
+	{From: "47", To: "47", Subdivision: "IT-RN"},
+	{From: "48", To: "48", Subdivision: "IT-RA"},
+	{From: "50", To: "50", Subdivision: "IT-FI"},
+	{From: "51", To: "51", Subdivision: "IT-PT"},
+	{From: "52", To: "52", Subdivision: "IT-AR"},
+	{From: "53", To: "53", Subdivision: "IT-SI"},
+	{From: "54", To: "54", Subdivision: "IT-MS"},
+	{From: "55", To: "55", Subdivision: "IT-LU"},
+	{From: "56", To: "56", Subdivision: "IT-PI"},
+	{From: "57", To: "57", Subdivision: "IT-LI"},
+	{From: "58", To: "58", Subdivision: "IT-GR"},
+	{From: "59", To: "59", Subdivision: "IT-PO"},
+	{From: "60", To: "60", Subdivision: "IT-AN"},
+	{From: "61", To: "61", Subdivision: "IT-PU"},
+	{From: "62", To: "62", Subdivision: "IT-MC"},
+	{From: "63", To: "63", Subdivision: "IT-AP"},
+	{From: "63", To: "63", Subdivision: "IT-FM"},
+	{From: "64", To: "64", Subdivision: "IT-TE"},
+	{From: "65", To: "65", Subdivision: "IT-PE"},
+	{From: "66", To: "66", Subdivision: "IT-CH"},
+	{From: "67", To: "67", Subdivision: "IT-AQ"},
+	{From: "70", To: "70", Subdivision: "IT-BA"},
+	{From: "71", To: "71", Subdivision: "IT-FG"},
+	{From: "72", To: "72", Subdivision: "IT-BR"},
+	{From: "73", To: "73", Subdivision: "IT-LE"},
+	{From: "74", To: "74", Subdivision: "IT-TA"},
+	{From: "75", To: "75", Subdivision: "IT-MT"},
+	{From: "76", To: "76", Subdivision: "IT-BT"},
+	{From: "80", To: "80", Subdivision: "IT-NA"},
+	{From: "81", To: "81", Subdivision: "IT-CE"},
+	{From: "82", To: "82", Subdivision: "IT-BN"},
+	{From: "83", To: "83", Subdivision: "IT-AV"},
+	{From: "84", To: "84", Subdivision: "IT-SA"},
+	{From: "85", To: "85", Subdivision: "IT-PZ"},
+	{From: "86", To: "86", Subdivision: "IT-CB"},
+	{From: "86", To: "86", Subdivision: "IT-IS"},
+	{From: "87", To: "87", Subdivision: "IT-CS"},
+	{From: "88", To: "88", Subdivision: "IT-CZ"},
+	{From: "88", To: "88", Subdivision: "IT-KR"},
+	{From: "88", To: "88", Subdivision: "IT-VV"},
+	{From: "89", To: "89", Subdivision: "IT-RC"},
+	{From: "89", To: "89", Subdivision: "IT-VV"},
+	{From: "90", To: "90", Subdivision: "IT-PA"},
+	{From: "91", To: "91", Subdivision: "IT-TP"},
+	{From: "92", To: "92", Subdivision: "IT-AG"},
+	{From: "93", To: "93", Subdivision: "IT-CL"},
+	{From: "94", To: "94", Subdivision: "IT-EN"},
+	{From: "95", To: "95", Subdivision: "IT-CT"},
+	{From: "96", To: "96", Subdivision: "IT-SR"},
+	{From: "97", To: "97", Subdivision: "IT-RG"},
+	{From: "98", To: "98", Subdivision: "IT-ME"},
+}
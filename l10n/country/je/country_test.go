@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"JE", ""}, testutil.List{{
+	testutil.Run(t, []string{"JE", "JEY"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"JE2 3AB",
+			"JE23AB",
 		},
 		Fail: []string{
-			//
+			"JE2",
+			"GY1 1AA",
 		},
 	}})
 }
@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "JM", A3: "JAM", Num: "388",
-		Zip: regexp.MustCompile(`^[1-9]|1[0-9]|20$`),
+		Zip: regexp.MustCompile(`^(?:[1-9]|1[0-9]|20)$`),
 	})
 }
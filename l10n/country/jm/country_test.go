@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"JM", ""}, testutil.List{{
+	testutil.Run(t, []string{"JM", "JAM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,14 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1",
+			"5",
+			"20",
 		},
 		Fail: []string{
-			//
+			"0",
+			"21",
+			"JMAKN01",
 		},
 	}})
 }
@@ -33,10 +33,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11937",
+			"11181",
 		},
 		Fail: []string{
-			//
+			"1193",
+			"119370",
 		},
 	}})
 }
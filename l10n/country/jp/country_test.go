@@ -54,9 +54,13 @@ func Test(t *testing.T) {
 			"669-1161",
 			"470-0156",
 			"672-8031",
+			"100-0001",
+			"154-0023",
 		},
 		Fail: []string{
 			//
+			"1000001",
+			"100-001",
 		},
 	}})
 }
@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"00100",
+			"80100",
 		},
 		Fail: []string{
-			//
+			"0010",
+			"001000",
 		},
 	}})
 }
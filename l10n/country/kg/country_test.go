@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"KG", ""}, testutil.List{{
+	testutil.Run(t, []string{"KG", "KGZ"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"720001",
+			"715600",
 		},
 		Fail: []string{
-			//
+			"72000",
+			"7200011",
 		},
 	}})
 }
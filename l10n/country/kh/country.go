@@ -1,12 +1,14 @@
 package kh
 
 import (
+	"regexp"
+
 	"github.com/frk/isvalid/l10n/country"
 )
 
 func init() {
 	country.Add(country.Country{
 		A2: "KH", A3: "KHM", Num: "116",
-		Zip: country.RxZip6Digits,
+		Zip: regexp.MustCompile(`^[0-9]{5,6}$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"KH", ""}, testutil.List{{
+	testutil.Run(t, []string{"KH", "KHM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"12000",
+			"120101",
 		},
 		Fail: []string{
-			//
+			"1200",
+			"1201011",
 		},
 	}})
 }
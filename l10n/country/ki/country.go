@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "KI", A3: "KIR", Num: "296",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "KM", A3: "COM", Num: "174",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "KN", A3: "KNA", Num: "659",
+		Zip: country.NoZip,
 	})
 }
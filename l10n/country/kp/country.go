@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "KP", A3: "PRK", Num: "408",
+		Zip: country.NoZip,
 	})
 }
@@ -39,10 +39,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"03187",
+			"06236",
 		},
 		Fail: []string{
-			//
+			"0318",
+			"031870",
 		},
 	}})
 }
@@ -31,10 +31,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"13001",
+			"54541",
 		},
 		Fail: []string{
-			//
+			"1300",
+			"130011",
 		},
 	}})
 }
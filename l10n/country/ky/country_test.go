@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"KY", ""}, testutil.List{{
+	testutil.Run(t, []string{"KY", "CYM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"KY1-1100",
+			"KY2-2001",
 		},
 		Fail: []string{
-			//
+			"KY11100",
+			"KY-1100",
 		},
 	}})
 }
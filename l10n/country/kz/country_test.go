@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"050000",
+			"010000",
 		},
 		Fail: []string{
-			//
+			"05000",
+			"0500000",
 		},
 	}})
 }
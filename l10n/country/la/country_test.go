@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"LA", ""}, testutil.List{{
+	testutil.Run(t, []string{"LA", "LAO"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"01000",
+			"13000",
 		},
 		Fail: []string{
-			//
+			"0100",
+			"010000",
 		},
 	}})
 }
@@ -34,10 +34,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"2038 3054",
+			"11072810",
+			"2038",
 		},
 		Fail: []string{
-			//
+			"20383",
+			"203 3054",
 		},
 	}})
 }
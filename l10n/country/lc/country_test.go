@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"LC", ""}, testutil.List{{
+	testutil.Run(t, []string{"LC", "LCA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"LC04 101",
+			"LC05  201",
 		},
 		Fail: []string{
-			//
+			"LC4 101",
+			"LC04101A",
 		},
 	}})
 }
@@ -24,9 +24,12 @@ func Test(t *testing.T) {
 			"9491",
 			"9489",
 			"9496",
+			"9490",
 		},
 		Fail: []string{
 			//
+			"9484",
+			"9498",
 		},
 	}})
 }
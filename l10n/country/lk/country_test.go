@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"LK", ""}, testutil.List{{
+	testutil.Run(t, []string{"LK", "LKA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"00100",
+			"20000",
 		},
 		Fail: []string{
-			//
+			"0010",
+			"001000",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"LR", ""}, testutil.List{{
+	testutil.Run(t, []string{"LR", "LBR"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"1020",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"LS", ""}, testutil.List{{
+	testutil.Run(t, []string{"LS", "LSO"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"100",
+			"600",
 		},
 		Fail: []string{
-			//
+			"1000",
+			"10",
 		},
 	}})
 }
@@ -26,10 +26,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"LT-01100",
+			"01100",
 		},
 		Fail: []string{
-			//
+			"LT01100",
+			"0110",
 		},
 	}})
 }
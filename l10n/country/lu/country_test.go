@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1111",
+			"L-1111",
 		},
 		Fail: []string{
-			//
+			"111",
+			"11111",
 		},
 	}})
 }
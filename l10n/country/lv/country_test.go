@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"LV", ""}, testutil.List{{
+	testutil.Run(t, []string{"LV", "LVA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"LV-1050",
+			"LV-3601",
 		},
 		Fail: []string{
-			//
+			"1050",
+			"LV1050",
 		},
 	}})
 }
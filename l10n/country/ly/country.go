@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "LY", A3: "LBY", Num: "434",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:(?:\+?218)|0)?(?:9[1-6][0-9]{7}|[1-8][0-9]{7,9})$`),
 	})
 }
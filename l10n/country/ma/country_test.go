@@ -40,10 +40,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10000",
+			"20250",
 		},
 		Fail: []string{
-			//
+			"1000",
+			"100000",
 		},
 	}})
 }
@@ -10,7 +10,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MC", A3: "MCO", Num: "492",
-		Zip: regexp.MustCompile(`^MC980(?:[0-9]{2})$`),
+		Zip: regexp.MustCompile(`^(?:MC-?)?980[0-9]{2}$`),
 		VAT: country.StringMatcherFunc(fr.VAT),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MC", ""}, testutil.List{{
+	testutil.Run(t, []string{"MC", "MCO"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"MC98000",
+			"98000",
+			"MC-98000",
 		},
 		Fail: []string{
-			//
+			"98100",
+			"MC9800",
 		},
 	}})
 }
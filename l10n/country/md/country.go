@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MD", A3: "MDA", Num: "498",
-		Zip: regexp.MustCompile(`^MD-?[0-9]{4}$`),
+		Zip: regexp.MustCompile(`^(?:MD-?)?[0-9]{4}$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MD", ""}, testutil.List{{
+	testutil.Run(t, []string{"MD", "MDA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"MD-2001",
+			"MD2001",
+			"2001",
 		},
 		Fail: []string{
-			//
+			"200",
+			"MD-20011",
 		},
 	}})
 }
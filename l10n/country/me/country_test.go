@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"ME", ""}, testutil.List{{
+	testutil.Run(t, []string{"ME", "MNE"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"81000",
+			"85310",
 		},
 		Fail: []string{
-			//
+			"8100",
+			"810000",
 		},
 	}})
 }
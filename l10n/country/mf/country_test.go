@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MF", ""}, testutil.List{{
+	testutil.Run(t, []string{"MF", "MAF"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97150",
 		},
 		Fail: []string{
-			//
+			"97151",
+			"97100",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MG", ""}, testutil.List{{
+	testutil.Run(t, []string{"MG", "MDG"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"101",
+			"501",
 		},
 		Fail: []string{
-			//
+			"1010",
+			"10",
 		},
 	}})
 }
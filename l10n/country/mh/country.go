@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MH", A3: "MHL", Num: "584",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^969[67][0-9](?:-?[0-9]{4})?$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MH", ""}, testutil.List{{
+	testutil.Run(t, []string{"MH", "MHL"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96960",
+			"96970",
+			"96960-1234",
 		},
 		Fail: []string{
-			//
+			"96950",
+			"9696",
 		},
 	}})
 }
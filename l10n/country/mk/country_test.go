@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MK", ""}, testutil.List{{
+	testutil.Run(t, []string{"MK", "MKD"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"7500",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "ML", A3: "MLI", Num: "466",
+		Zip: country.NoZip,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MM", ""}, testutil.List{{
+	testutil.Run(t, []string{"MM", "MMR"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11181",
+			"05011",
 		},
 		Fail: []string{
-			//
+			"1118",
+			"111811",
 		},
 	}})
 }
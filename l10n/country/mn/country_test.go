@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MN", ""}, testutil.List{{
+	testutil.Run(t, []string{"MN", "MNG"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"14200",
+			"16040",
 		},
 		Fail: []string{
-			//
+			"1420",
+			"142000",
 		},
 	}})
 }
@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MO", A3: "MAC", Num: "446",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+?853[\- ]?)?[6][0-9]{3}[\- ]?[0-9]{4}$`),
 	})
 }
@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MP", A3: "MNP", Num: "580",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^9695[0-2](?:-?[0-9]{4})?$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MP", ""}, testutil.List{{
+	testutil.Run(t, []string{"MP", "MNP"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96950",
+			"96952",
+			"96950-1234",
 		},
 		Fail: []string{
-			//
+			"96953",
+			"96799",
 		},
 	}})
 }
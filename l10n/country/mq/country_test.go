@@ -35,10 +35,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97200",
+			"97290",
 		},
 		Fail: []string{
-			//
+			"97291",
+			"97300",
 		},
 	}})
 }
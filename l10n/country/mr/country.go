@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MR", A3: "MRT", Num: "478",
+		Zip: country.NoZip,
 	})
 }
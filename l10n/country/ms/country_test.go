@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MS", ""}, testutil.List{{
+	testutil.Run(t, []string{"MS", "MSR"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"MSR 1110",
+			"MSR 1350",
 		},
 		Fail: []string{
-			//
+			"MSR1110",
+			"MSR 1410",
 		},
 	}})
 }
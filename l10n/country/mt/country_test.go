@@ -27,9 +27,13 @@ func Test(t *testing.T) {
 			"VLT 2345",
 			"ATD1234",
 			"MSK8723",
+			"VLT 1117",
+			"VLT1117",
 		},
 		Fail: []string{
 			//
+			"VLT 117",
+			"1117",
 		},
 	}})
 }
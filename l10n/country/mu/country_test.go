@@ -33,10 +33,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"42602",
+			"11302",
 		},
 		Fail: []string{
-			//
+			"4260",
+			"426020",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MV", ""}, testutil.List{{
+	testutil.Run(t, []string{"MV", "MDV"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"20026",
+			"20194",
 		},
 		Fail: []string{
-			//
+			"2002",
+			"200260",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "MW", A3: "MWI", Num: "454",
+		Zip: country.NoZip,
 	})
 }
@@ -38,10 +38,32 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"06600",
+			"44100",
 		},
 		Fail: []string{
-			//
+			"0660",
+			"066000",
+		},
+	}, {
+		Name: "ZipIn/CMX", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "CMX", cc) },
+		Pass: []string{
+			"06600",
+			"16000",
+		},
+		Fail: []string{
+			"44100",
+			"17000",
+		},
+	}, {
+		Name: "ZipIn/MX-JAL", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "MX-JAL", cc) },
+		Pass: []string{
+			"44100",
+			"49999",
+		},
+		Fail: []string{
+			"06600",
+			"50000",
 		},
 	}})
 }
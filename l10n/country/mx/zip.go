@@ -0,0 +1,41 @@
+package mx
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The first two digits of the postal code identify the state.
+var zipRanges = []country.ZipRange{
+	{From: "01", To: "16", Subdivision: "MX-CMX"},
+	{From: "20", To: "20", Subdivision: "MX-AGU"},
+	{From: "21", To: "22", Subdivision: "MX-BCN"},
+	{From: "23", To: "23", Subdivision: "MX-BCS"},
+	{From: "24", To: "24", Subdivision: "MX-CAM"},
+	{From: "25", To: "27", Subdivision: "MX-COA"},
+	{From: "28", To: "28", Subdivision: "MX-COL"},
+	{From: "29", To: "30", Subdivision: "MX-CHP"},
+	{From: "31", To: "33", Subdivision: "MX-CHH"},
+	{From: "34", To: "35", Subdivision: "MX-DUR"},
+	{From: "36", To: "38", Subdivision: "MX-GUA"},
+	{From: "39", To: "41", Subdivision: "MX-GRO"},
+	{From: "42", To: "43", Subdivision: "MX-HID"},
+	{From: "44", To: "49", Subdivision: "MX-JAL"},
+	{From: "50", To: "57", Subdivision: "MX-MEX"},
+	{From: "58", To: "61", Subdivision: "MX-MIC"},
+	{From: "62", To: "62", Subdivision: "MX-MOR"},
+	{From: "63", To: "63", Subdivision: "MX-NAY"},
+	{From: "64", To: "67", Subdivision: "MX-NLE"},
+	{From: "68", To: "71", Subdivision: "MX-OAX"},
+	{From: "72", To: "75", Subdivision: "MX-PUE"},
+	{From: "76", To: "76", Subdivision: "MX-QUE"},
+	{From: "77", To: "77", Subdivision: "MX-ROO"},
+	{From: "78", To: "79", Subdivision: "MX-SLP"},
+	{From: "80", To: "82", Subdivision: "MX-SIN"},
+	{From: "83", To: "85", Subdivision: "MX-SON"},
+	{From: "86", To: "86", Subdivision: "MX-TAB"},
+	{From: "87", To: "89", Subdivision: "MX-TAM"},
+	{From: "90", To: "90", Subdivision: "MX-TLA"},
+	{From: "91", To: "96", Subdivision: "MX-VER"},
+	{From: "97", To: "97", Subdivision: "MX-YUC"},
+	{From: "98", To: "99", Subdivision: "MX-ZAC"},
+}
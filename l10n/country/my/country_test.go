@@ -41,9 +41,13 @@ func Test(t *testing.T) {
 			"56000",
 			"12000",
 			"79502",
+			"50050",
+			"88450",
 		},
 		Fail: []string{
 			//
+			"5005",
+			"500500",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"MZ", ""}, testutil.List{{
+	testutil.Run(t, []string{"MZ", "MOZ"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1100",
+			"1102",
 		},
 		Fail: []string{
-			//
+			"110",
+			"11000",
 		},
 	}})
 }
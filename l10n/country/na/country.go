@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "NA", A3: "NAM", Num: "516",
+		Zip: country.RxZip5Digits,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"NA", ""}, testutil.List{{
+	testutil.Run(t, []string{"NA", "NAM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10001",
+			"10017",
 		},
 		Fail: []string{
-			//
+			"1000",
+			"100010",
 		},
 	}})
 }
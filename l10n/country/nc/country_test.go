@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"NC", ""}, testutil.List{{
+	testutil.Run(t, []string{"NC", "NCL"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"98800",
+			"98890",
 		},
 		Fail: []string{
-			//
+			"98891",
+			"98700",
 		},
 	}})
 }
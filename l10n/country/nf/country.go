@@ -1,12 +1,14 @@
 package nf
 
 import (
+	"regexp"
+
 	"github.com/frk/isvalid/l10n/country"
 )
 
 func init() {
 	country.Add(country.Country{
 		A2: "NF", A3: "NFK", Num: "574",
-		Zip: country.RxZip4Digits,
+		Zip: regexp.MustCompile(`^2899$`),
 	})
 }
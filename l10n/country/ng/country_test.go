@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"NG", ""}, testutil.List{{
+	testutil.Run(t, []string{"NG", "NGA"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"100001",
+			"930283",
 		},
 		Fail: []string{
-			//
+			"10000",
+			"1000011",
 		},
 	}})
 }
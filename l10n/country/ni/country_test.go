@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"NI", ""}, testutil.List{{
+	testutil.Run(t, []string{"NI", "NIC"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11001",
+			"22000",
 		},
 		Fail: []string{
-			//
+			"1100",
+			"110010",
 		},
 	}})
 }
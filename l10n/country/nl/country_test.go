@@ -44,9 +44,13 @@ func Test(t *testing.T) {
 			"1118 BH",
 			"3950IO",
 			"3997 GH",
+			"1012 AB",
+			"1012AB",
 		},
 		Fail: []string{
 			//
+			"1012",
+			"1012 A",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
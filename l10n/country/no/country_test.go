@@ -31,10 +31,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"0150",
+			"9990",
 		},
 		Fail: []string{
-			//
+			"150",
+			"01500",
 		},
 	}})
 }
@@ -33,6 +33,7 @@ func Test(t *testing.T) {
 			"32600",
 			"56806",
 			"977",
+			"44600",
 		},
 		Fail: []string{
 			"11977",
@@ -40,6 +41,8 @@ func Test(t *testing.T) {
 			"13 32",
 			"-977",
 			"97765",
+			"4460",
+			"99999",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "NR", A3: "NRU", Num: "520",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "NU", A3: "NIU", Num: "570",
+		Zip: country.NoZip,
 	})
 }
@@ -35,9 +35,13 @@ func Test(t *testing.T) {
 			"0449",
 			"0984",
 			"4144",
+			"6011",
+			"1010",
 		},
 		Fail: []string{
 			//
+			"601",
+			"60111",
 		},
 	}})
 }
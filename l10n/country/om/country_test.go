@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"100",
+			"133",
 		},
 		Fail: []string{
-			//
+			"1000",
+			"10",
 		},
 	}})
 }
@@ -26,10 +26,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"0801",
+			"0816",
 		},
 		Fail: []string{
-			//
+			"080",
+			"08010",
 		},
 	}})
 }
@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"15001",
+			"PE 0101",
 		},
 		Fail: []string{
-			//
+			"1500",
+			"150011",
 		},
 	}})
 }
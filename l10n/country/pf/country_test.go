@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"PF", ""}, testutil.List{{
+	testutil.Run(t, []string{"PF", "PYF"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"98709",
+			"98714",
 		},
 		Fail: []string{
-			//
+			"98791",
+			"98600",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"PG", ""}, testutil.List{{
+	testutil.Run(t, []string{"PG", "PNG"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"111",
+			"211",
 		},
 		Fail: []string{
-			//
+			"11",
+			"1111",
 		},
 	}})
 }
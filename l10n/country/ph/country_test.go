@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"6000",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"PK", ""}, testutil.List{{
+	testutil.Run(t, []string{"PK", "PAK"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"44000",
+			"74200",
 		},
 		Fail: []string{
-			//
+			"4400",
+			"440000",
 		},
 	}})
 }
@@ -39,6 +39,8 @@ func Test(t *testing.T) {
 			"78-399",
 			"39-490",
 			"38-483",
+			"00-950",
+			"05-077",
 		},
 		Fail: []string{
 			"360",
@@ -46,6 +48,8 @@ func Test(t *testing.T) {
 			"399",
 			"935",
 			"38842",
+			"00950",
+			"00-95",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
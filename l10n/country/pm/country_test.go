@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"PM", ""}, testutil.List{{
+	testutil.Run(t, []string{"PM", "SPM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97500",
 		},
 		Fail: []string{
-			//
+			"97501",
+			"97400",
 		},
 	}})
 }
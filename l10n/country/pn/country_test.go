@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"PN", ""}, testutil.List{{
+	testutil.Run(t, []string{"PN", "PCN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"PCRN 1ZZ",
 		},
 		Fail: []string{
-			//
+			"PCRN1ZZ",
+			"BIQQ 1ZZ",
 		},
 	}})
 }
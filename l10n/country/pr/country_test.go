@@ -23,9 +23,13 @@ func Test(t *testing.T) {
 			"00631",
 			"00786",
 			"00987",
+			"00901",
+			"00936-1234",
 		},
 		Fail: []string{
 			//
+			"00801",
+			"0090",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "PS", A3: "PSE", Num: "275",
+		Zip: country.NoZip,
 	})
 }
@@ -22,9 +22,13 @@ func Test(t *testing.T) {
 			"4829-489",
 			"0294-348",
 			"8156-392",
+			"1000-001",
+			"4050-235",
 		},
 		Fail: []string{
 			//
+			"1000001",
+			"1000-01",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
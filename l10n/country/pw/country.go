@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "PW", A3: "PLW", Num: "585",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^96940(?:-?[0-9]{4})?$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"PW", ""}, testutil.List{{
+	testutil.Run(t, []string{"PW", "PLW"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96940",
+			"96940-1234",
 		},
 		Fail: []string{
-			//
+			"96941",
+			"9694",
 		},
 	}})
 }
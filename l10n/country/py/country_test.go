@@ -45,10 +45,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1209",
+			"2160",
 		},
 		Fail: []string{
-			//
+			"120",
+			"12090",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "QA", A3: "QAT", Num: "634",
+		Zip: country.NoZip,
 	})
 }
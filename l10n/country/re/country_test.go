@@ -35,10 +35,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97400",
+			"97490",
 		},
 		Fail: []string{
-			//
+			"97491",
+			"97300",
 		},
 	}})
 }
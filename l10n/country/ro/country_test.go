@@ -38,10 +38,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"010011",
+			"400001",
 		},
 		Fail: []string{
-			//
+			"01001",
+			"0100111",
 		},
 	}})
 }
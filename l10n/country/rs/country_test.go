@@ -31,10 +31,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11000",
+			"21000",
 		},
 		Fail: []string{
-			//
+			"1100",
+			"110000",
 		},
 	}})
 }
@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"101000",
+			"190000",
 		},
 		Fail: []string{
-			//
+			"10100",
+			"1010000",
 		},
 	}})
 }
@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "RW", A3: "RWA", Num: "646",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+?250|0)?[7][0-9]{8}$`),
 	})
 }
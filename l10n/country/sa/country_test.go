@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11564",
+			"21577",
 		},
 		Fail: []string{
-			//
+			"1156",
+			"115640",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "SB", A3: "SLB", Num: "090",
+		Zip: country.NoZip,
 	})
 }
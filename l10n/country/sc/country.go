@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "SC", A3: "SYC", Num: "690",
+		Zip: country.NoZip,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SD", ""}, testutil.List{{
+	testutil.Run(t, []string{"SD", "SDN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11111",
+			"11113",
 		},
 		Fail: []string{
-			//
+			"1111",
+			"111111",
 		},
 	}})
 }
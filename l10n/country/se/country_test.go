@@ -35,9 +35,13 @@ func Test(t *testing.T) {
 			"39556",
 			"489 39",
 			"499 49",
+			"113 51",
+			"11351",
 		},
 		Fail: []string{
 			//
+			"013 51",
+			"1135",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -34,9 +34,13 @@ func Test(t *testing.T) {
 		Pass: []string{
 			"308215",
 			"546080",
+			"018956",
+			"238859",
 		},
 		Fail: []string{
 			//
+			"01895",
+			"0189566",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SH", ""}, testutil.List{{
+	testutil.Run(t, []string{"SH", "SHN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"STHL 1ZZ",
+			"ASCN 1ZZ",
+			"TDCU 1ZZ",
 		},
 		Fail: []string{
-			//
+			"STHL1ZZ",
+			"BIQQ 1ZZ",
 		},
 	}})
 }
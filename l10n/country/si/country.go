@@ -38,7 +38,7 @@ func init() {
 
 	country.Add(country.Country{
 		A2: "SI", A3: "SVN", Num: "705",
-		Zip:   country.RxZip4Digits,
+		Zip:   regexp.MustCompile(`^(?:SI-)?[0-9]{4}$`),
 		Phone: regexp.MustCompile(`^(?:\+386[ ]?|0)(?:(?:[0-9]{1}[ ]?[0-9]{3}(?:[ ]?[0-9]{2}){2})|(?:[0-9]{2}(?:[ ]?[0-9]{3}){2}))$`),
 		VAT: country.StringMatcherFunc(func(v string) bool {
 			if !rxvat.MatchString(v) {
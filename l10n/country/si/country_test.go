@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"SI-1000",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}, {
 		Name: "VAT", Func: isvalid.VAT,
@@ -1,11 +1,13 @@
 package sj
 
 import (
+	"regexp"
+
 	"github.com/frk/isvalid/l10n/country"
 )
 
 func init() {
 	country.Add(country.Country{
-		A2: "SJ", A3: "SJM", Num: "744", Zip: country.RxZip4Digits,
+		A2: "SJ", A3: "SJM", Num: "744", Zip: regexp.MustCompile(`^(?:8099|917[01])$`),
 	})
 }
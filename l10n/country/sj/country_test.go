@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SJ", ""}, testutil.List{{
+	testutil.Run(t, []string{"SJ", "SJM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,13 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"9170",
+			"9171",
+			"8099",
 		},
 		Fail: []string{
-			//
+			"0150",
+			"9172",
 		},
 	}})
 }
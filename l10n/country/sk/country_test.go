@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"811 01",
+			"81101",
 		},
 		Fail: []string{
-			//
+			"8110",
+			"811 011",
 		},
 	}})
 }
@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"47890",
+			"47899",
 		},
 		Fail: []string{
-			//
+			"47880",
+			"4789",
 		},
 	}})
 }
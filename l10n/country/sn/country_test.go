@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SN", ""}, testutil.List{{
+	testutil.Run(t, []string{"SN", "SEN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"12500",
+			"10200",
 		},
 		Fail: []string{
-			//
+			"1250",
+			"125000",
 		},
 	}})
 }
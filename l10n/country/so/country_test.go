@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SO", ""}, testutil.List{{
+	testutil.Run(t, []string{"SO", "SOM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"JH 09010",
+			"AW 22011",
 		},
 		Fail: []string{
-			//
+			"JH09010",
+			"09010",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "SR", A3: "SUR", Num: "740",
+		Zip: country.NoZip,
 	})
 }
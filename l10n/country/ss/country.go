@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "SS", A3: "SSD", Num: "728",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "ST", A3: "STP", Num: "678",
+		Zip: country.NoZip,
 	})
 }
@@ -0,0 +1,46 @@
+package country
+
+import (
+	"strings"
+)
+
+// ISO31662 maps the ISO 3166-2 subdivision codes to their subdivisions.
+var ISO31662 = make(map[string]Subdivision)
+
+// the subdivisions of each country, keyed by ISO 3166-1 alpha-2 code
+var subdivisionsByCountry = make(map[string][]Subdivision)
+
+func init() {
+	for _, s := range subdivisions {
+		ISO31662[s.Code] = s
+		subdivisionsByCountry[s.Code[:2]] = append(subdivisionsByCountry[s.Code[:2]], s)
+	}
+}
+
+// Subdivision represents a country subdivision as defined by ISO 3166-2.
+type Subdivision struct {
+	// ISO 3166-2 code, e.g. "US-CA"
+	Code string
+	// The subdivision's name, e.g. "California"
+	Name string
+	// The subdivision's type, e.g. "State"
+	Type string
+	// The ISO 3166-2 code of the parent subdivision, if any
+	Parent string
+}
+
+// Subdivisions returns the ISO 3166-2 subdivisions of the country identified
+// by the ISO 3166-1 alpha-2 or alpha-3 code cc. Subdivisions returns nil if
+// the country has no subdivisions or if cc is not a known country code.
+func Subdivisions(cc string) []Subdivision {
+	if c, ok := Get(cc); ok {
+		return subdivisionsByCountry[c.A2]
+	}
+	return nil
+}
+
+// GetSubdivision returns the subdivision identified by the ISO 3166-2 code sc.
+func GetSubdivision(sc string) (s Subdivision, ok bool) {
+	s, ok = ISO31662[strings.ToUpper(sc)]
+	return s, ok
+}
@@ -0,0 +1,5131 @@
+package country
+
+var subdivisions = []Subdivision{
+	{Code: "AD-02", Name: "Canillo", Type: "Parish"},
+	{Code: "AD-03", Name: "Encamp", Type: "Parish"},
+	{Code: "AD-04", Name: "La Massana", Type: "Parish"},
+	{Code: "AD-05", Name: "Ordino", Type: "Parish"},
+	{Code: "AD-06", Name: "Sant Julià de Lòria", Type: "Parish"},
+	{Code: "AD-07", Name: "Andorra la Vella", Type: "Parish"},
+	{Code: "AD-08", Name: "Escaldes-Engordany", Type: "Parish"},
+	{Code: "AE-AJ", Name: "‘Ajmān", Type: "Emirate"},
+	{Code: "AE-AZ", Name: "Abū Z̧aby", Type: "Emirate"},
+	{Code: "AE-DU", Name: "Dubayy", Type: "Emirate"},
+	{Code: "AE-FU", Name: "Al Fujayrah", Type: "Emirate"},
+	{Code: "AE-RK", Name: "Ra’s al Khaymah", Type: "Emirate"},
+	{Code: "AE-SH", Name: "Ash Shāriqah", Type: "Emirate"},
+	{Code: "AE-UQ", Name: "Umm al Qaywayn", Type: "Emirate"},
+	{Code: "AF-BAL", Name: "Balkh", Type: "Province"},
+	{Code: "AF-BAM", Name: "Bāmyān", Type: "Province"},
+	{Code: "AF-BDG", Name: "Bādghīs", Type: "Province"},
+	{Code: "AF-BDS", Name: "Badakhshān", Type: "Province"},
+	{Code: "AF-BGL", Name: "Baghlān", Type: "Province"},
+	{Code: "AF-DAY", Name: "Dāykundī", Type: "Province"},
+	{Code: "AF-FRA", Name: "Farāh", Type: "Province"},
+	{Code: "AF-FYB", Name: "Fāryāb", Type: "Province"},
+	{Code: "AF-GHA", Name: "Ghaznī", Type: "Province"},
+	{Code: "AF-GHO", Name: "Ghōr", Type: "Province"},
+	{Code: "AF-HEL", Name: "Helmand", Type: "Province"},
+	{Code: "AF-HER", Name: "Herāt", Type: "Province"},
+	{Code: "AF-JOW", Name: "Jowzjān", Type: "Province"},
+	{Code: "AF-KAB", Name: "Kābul", Type: "Province"},
+	{Code: "AF-KAN", Name: "Kandahār", Type: "Province"},
+	{Code: "AF-KAP", Name: "Kāpīsā", Type: "Province"},
+	{Code: "AF-KDZ", Name: "Kunduz", Type: "Province"},
+	{Code: "AF-KHO", Name: "Khōst", Type: "Province"},
+	{Code: "AF-KNR", Name: "Kunaṟ", Type: "Province"},
+	{Code: "AF-LAG", Name: "Laghmān", Type: "Province"},
+	{Code: "AF-LOG", Name: "Lōgar", Type: "Province"},
+	{Code: "AF-NAN", Name: "Nangarhār", Type: "Province"},
+	{Code: "AF-NIM", Name: "Nīmrōz", Type: "Province"},
+	{Code: "AF-NUR", Name: "Nūristān", Type: "Province"},
+	{Code: "AF-PAN", Name: "Panjshayr", Type: "Province"},
+	{Code: "AF-PAR", Name: "Parwān", Type: "Province"},
+	{Code: "AF-PIA", Name: "Paktiyā", Type: "Province"},
+	{Code: "AF-PKA", Name: "Paktīkā", Type: "Province"},
+	{Code: "AF-SAM", Name: "Samangān", Type: "Province"},
+	{Code: "AF-SAR", Name: "Sar-e Pul", Type: "Province"},
+	{Code: "AF-TAK", Name: "Takhār", Type: "Province"},
+	{Code: "AF-URU", Name: "Uruzgān", Type: "Province"},
+	{Code: "AF-WAR", Name: "Wardak", Type: "Province"},
+	{Code: "AF-ZAB", Name: "Zābul", Type: "Province"},
+	{Code: "AG-03", Name: "Saint George", Type: "Parish"},
+	{Code: "AG-04", Name: "Saint John", Type: "Parish"},
+	{Code: "AG-05", Name: "Saint Mary", Type: "Parish"},
+	{Code: "AG-06", Name: "Saint Paul", Type: "Parish"},
+	{Code: "AG-07", Name: "Saint Peter", Type: "Parish"},
+	{Code: "AG-08", Name: "Saint Philip", Type: "Parish"},
+	{Code: "AG-10", Name: "Barbuda", Type: "Dependency"},
+	{Code: "AG-11", Name: "Redonda", Type: "Dependency"},
+	{Code: "AL-01", Name: "Berat", Type: "County"},
+	{Code: "AL-02", Name: "Durrës", Type: "County"},
+	{Code: "AL-03", Name: "Elbasan", Type: "County"},
+	{Code: "AL-04", Name: "Fier", Type: "County"},
+	{Code: "AL-05", Name: "Gjirokastër", Type: "County"},
+	{Code: "AL-06", Name: "Korçë", Type: "County"},
+	{Code: "AL-07", Name: "Kukës", Type: "County"},
+	{Code: "AL-08", Name: "Lezhë", Type: "County"},
+	{Code: "AL-09", Name: "Dibër", Type: "County"},
+	{Code: "AL-10", Name: "Shkodër", Type: "County"},
+	{Code: "AL-11", Name: "Tiranë", Type: "County"},
+	{Code: "AL-12", Name: "Vlorë", Type: "County"},
+	{Code: "AM-AG", Name: "Aragac̣otn", Type: "Region"},
+	{Code: "AM-AR", Name: "Ararat", Type: "Region"},
+	{Code: "AM-AV", Name: "Armavir", Type: "Region"},
+	{Code: "AM-ER", Name: "Erevan", Type: "City"},
+	{Code: "AM-GR", Name: "Geġark'unik'", Type: "Region"},
+	{Code: "AM-KT", Name: "Kotayk'", Type: "Region"},
+	{Code: "AM-LO", Name: "Loṙi", Type: "Region"},
+	{Code: "AM-SH", Name: "Širak", Type: "Region"},
+	{Code: "AM-SU", Name: "Syunik'", Type: "Region"},
+	{Code: "AM-TV", Name: "Tavuš", Type: "Region"},
+	{Code: "AM-VD", Name: "Vayoć Jor", Type: "Region"},
+	{Code: "AO-BGO", Name: "Bengo", Type: "Province"},
+	{Code: "AO-BGU", Name: "Benguela", Type: "Province"},
+	{Code: "AO-BIE", Name: "Bié", Type: "Province"},
+	{Code: "AO-CAB", Name: "Cabinda", Type: "Province"},
+	{Code: "AO-CCU", Name: "Cuando Cubango", Type: "Province"},
+	{Code: "AO-CNN", Name: "Cunene", Type: "Province"},
+	{Code: "AO-CNO", Name: "Cuanza-Norte", Type: "Province"},
+	{Code: "AO-CUS", Name: "Cuanza-Sul", Type: "Province"},
+	{Code: "AO-HUA", Name: "Huambo", Type: "Province"},
+	{Code: "AO-HUI", Name: "Huíla", Type: "Province"},
+	{Code: "AO-LNO", Name: "Lunda-Norte", Type: "Province"},
+	{Code: "AO-LSU", Name: "Lunda-Sul", Type: "Province"},
+	{Code: "AO-LUA", Name: "Luanda", Type: "Province"},
+	{Code: "AO-MAL", Name: "Malange", Type: "Province"},
+	{Code: "AO-MOX", Name: "Moxico", Type: "Province"},
+	{Code: "AO-NAM", Name: "Namibe", Type: "Province"},
+	{Code: "AO-UIG", Name: "Uíge", Type: "Province"},
+	{Code: "AO-ZAI", Name: "Zaire", Type: "Province"},
+	{Code: "AR-A", Name: "Salta", Type: "Province"},
+	{Code: "AR-B", Name: "Buenos Aires", Type: "Province"},
+	{Code: "AR-C", Name: "Ciudad Autónoma de Buenos Aires", Type: "City"},
+	{Code: "AR-D", Name: "San Luis", Type: "Province"},
+	{Code: "AR-E", Name: "Entre Ríos", Type: "Province"},
+	{Code: "AR-F", Name: "La Rioja", Type: "Province"},
+	{Code: "AR-G", Name: "Santiago del Estero", Type: "Province"},
+	{Code: "AR-H", Name: "Chaco", Type: "Province"},
+	{Code: "AR-J", Name: "San Juan", Type: "Province"},
+	{Code: "AR-K", Name: "Catamarca", Type: "Province"},
+	{Code: "AR-L", Name: "La Pampa", Type: "Province"},
+	{Code: "AR-M", Name: "Mendoza", Type: "Province"},
+	{Code: "AR-N", Name: "Misiones", Type: "Province"},
+	{Code: "AR-P", Name: "Formosa", Type: "Province"},
+	{Code: "AR-Q", Name: "Neuquén", Type: "Province"},
+	{Code: "AR-R", Name: "Río Negro", Type: "Province"},
+	{Code: "AR-S", Name: "Santa Fe", Type: "Province"},
+	{Code: "AR-T", Name: "Tucumán", Type: "Province"},
+	{Code: "AR-U", Name: "Chubut", Type: "Province"},
+	{Code: "AR-V", Name: "Tierra del Fuego", Type: "Province"},
+	{Code: "AR-W", Name: "Corrientes", Type: "Province"},
+	{Code: "AR-X", Name: "Córdoba", Type: "Province"},
+	{Code: "AR-Y", Name: "Jujuy", Type: "Province"},
+	{Code: "AR-Z", Name: "Santa Cruz", Type: "Province"},
+	{Code: "AT-1", Name: "Burgenland", Type: "State"},
+	{Code: "AT-2", Name: "Kärnten", Type: "State"},
+	{Code: "AT-3", Name: "Niederösterreich", Type: "State"},
+	{Code: "AT-4", Name: "Oberösterreich", Type: "State"},
+	{Code: "AT-5", Name: "Salzburg", Type: "State"},
+	{Code: "AT-6", Name: "Steiermark", Type: "State"},
+	{Code: "AT-7", Name: "Tirol", Type: "State"},
+	{Code: "AT-8", Name: "Vorarlberg", Type: "State"},
+	{Code: "AT-9", Name: "Wien", Type: "State"},
+	{Code: "AU-ACT", Name: "Australian Capital Territory", Type: "Territory"},
+	{Code: "AU-NSW", Name: "New South Wales", Type: "State"},
+	{Code: "AU-NT", Name: "Northern Territory", Type: "Territory"},
+	{Code: "AU-QLD", Name: "Queensland", Type: "State"},
+	{Code: "AU-SA", Name: "South Australia", Type: "State"},
+	{Code: "AU-TAS", Name: "Tasmania", Type: "State"},
+	{Code: "AU-VIC", Name: "Victoria", Type: "State"},
+	{Code: "AU-WA", Name: "Western Australia", Type: "State"},
+	{Code: "AZ-ABS", Name: "Abşeron", Type: "Rayon"},
+	{Code: "AZ-AGA", Name: "Ağstafa", Type: "Rayon"},
+	{Code: "AZ-AGC", Name: "Ağcabədi", Type: "Rayon"},
+	{Code: "AZ-AGM", Name: "Ağdam", Type: "Rayon"},
+	{Code: "AZ-AGS", Name: "Ağdaş", Type: "Rayon"},
+	{Code: "AZ-AGU", Name: "Ağsu", Type: "Rayon"},
+	{Code: "AZ-AST", Name: "Astara", Type: "Rayon"},
+	{Code: "AZ-BA", Name: "Bakı", Type: "Municipality"},
+	{Code: "AZ-BAB", Name: "Babək", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-BAL", Name: "Balakən", Type: "Rayon"},
+	{Code: "AZ-BAR", Name: "Bərdə", Type: "Rayon"},
+	{Code: "AZ-BEY", Name: "Beyləqan", Type: "Rayon"},
+	{Code: "AZ-BIL", Name: "Biləsuvar", Type: "Rayon"},
+	{Code: "AZ-CAB", Name: "Cəbrayıl", Type: "Rayon"},
+	{Code: "AZ-CAL", Name: "Cəlilabad", Type: "Rayon"},
+	{Code: "AZ-CUL", Name: "Culfa", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-DAS", Name: "Daşkəsən", Type: "Rayon"},
+	{Code: "AZ-FUZ", Name: "Füzuli", Type: "Rayon"},
+	{Code: "AZ-GA", Name: "Gəncə", Type: "Municipality"},
+	{Code: "AZ-GAD", Name: "Gədəbəy", Type: "Rayon"},
+	{Code: "AZ-GOR", Name: "Goranboy", Type: "Rayon"},
+	{Code: "AZ-GOY", Name: "Göyçay", Type: "Rayon"},
+	{Code: "AZ-GYG", Name: "Göygöl", Type: "Rayon"},
+	{Code: "AZ-HAC", Name: "Hacıqabul", Type: "Rayon"},
+	{Code: "AZ-IMI", Name: "İmişli", Type: "Rayon"},
+	{Code: "AZ-ISM", Name: "İsmayıllı", Type: "Rayon"},
+	{Code: "AZ-KAL", Name: "Kəlbəcər", Type: "Rayon"},
+	{Code: "AZ-KAN", Name: "Kǝngǝrli", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-KUR", Name: "Kürdəmir", Type: "Rayon"},
+	{Code: "AZ-LA", Name: "Lənkəran", Type: "Municipality"},
+	{Code: "AZ-LAC", Name: "Laçın", Type: "Rayon"},
+	{Code: "AZ-LAN", Name: "Lənkəran", Type: "Rayon"},
+	{Code: "AZ-LER", Name: "Lerik", Type: "Rayon"},
+	{Code: "AZ-MAS", Name: "Masallı", Type: "Rayon"},
+	{Code: "AZ-MI", Name: "Mingəçevir", Type: "Municipality"},
+	{Code: "AZ-NA", Name: "Naftalan", Type: "Municipality"},
+	{Code: "AZ-NEF", Name: "Neftçala", Type: "Rayon"},
+	{Code: "AZ-NV", Name: "Naxçıvan", Type: "Municipality", Parent: "AZ-NX"},
+	{Code: "AZ-NX", Name: "Naxçıvan", Type: "Autonomous republic"},
+	{Code: "AZ-OGU", Name: "Oğuz", Type: "Rayon"},
+	{Code: "AZ-ORD", Name: "Ordubad", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-QAB", Name: "Qəbələ", Type: "Rayon"},
+	{Code: "AZ-QAX", Name: "Qax", Type: "Rayon"},
+	{Code: "AZ-QAZ", Name: "Qazax", Type: "Rayon"},
+	{Code: "AZ-QBA", Name: "Quba", Type: "Rayon"},
+	{Code: "AZ-QBI", Name: "Qubadlı", Type: "Rayon"},
+	{Code: "AZ-QOB", Name: "Qobustan", Type: "Rayon"},
+	{Code: "AZ-QUS", Name: "Qusar", Type: "Rayon"},
+	{Code: "AZ-SA", Name: "Şəki", Type: "Municipality"},
+	{Code: "AZ-SAB", Name: "Sabirabad", Type: "Rayon"},
+	{Code: "AZ-SAD", Name: "Sədərək", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-SAH", Name: "Şahbuz", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-SAK", Name: "Şəki", Type: "Rayon"},
+	{Code: "AZ-SAL", Name: "Salyan", Type: "Rayon"},
+	{Code: "AZ-SAR", Name: "Şərur", Type: "Rayon", Parent: "AZ-NX"},
+	{Code: "AZ-SAT", Name: "Saatlı", Type: "Rayon"},
+	{Code: "AZ-SBN", Name: "Şabran", Type: "Rayon"},
+	{Code: "AZ-SIY", Name: "Siyəzən", Type: "Rayon"},
+	{Code: "AZ-SKR", Name: "Şəmkir", Type: "Rayon"},
+	{Code: "AZ-SM", Name: "Sumqayıt", Type: "Municipality"},
+	{Code: "AZ-SMI", Name: "Şamaxı", Type: "Rayon"},
+	{Code: "AZ-SMX", Name: "Samux", Type: "Rayon"},
+	{Code: "AZ-SR", Name: "Şirvan", Type: "Municipality"},
+	{Code: "AZ-SUS", Name: "Şuşa", Type: "Rayon"},
+	{Code: "AZ-TAR", Name: "Tərtər", Type: "Rayon"},
+	{Code: "AZ-TOV", Name: "Tovuz", Type: "Rayon"},
+	{Code: "AZ-UCA", Name: "Ucar", Type: "Rayon"},
+	{Code: "AZ-XA", Name: "Xankəndi", Type: "Municipality"},
+	{Code: "AZ-XAC", Name: "Xaçmaz", Type: "Rayon"},
+	{Code: "AZ-XCI", Name: "Xocalı", Type: "Rayon"},
+	{Code: "AZ-XIZ", Name: "Xızı", Type: "Rayon"},
+	{Code: "AZ-XVD", Name: "Xocavənd", Type: "Rayon"},
+	{Code: "AZ-YAR", Name: "Yardımlı", Type: "Rayon"},
+	{Code: "AZ-YE", Name: "Yevlax", Type: "Municipality"},
+	{Code: "AZ-YEV", Name: "Yevlax", Type: "Rayon"},
+	{Code: "AZ-ZAN", Name: "Zəngilan", Type: "Rayon"},
+	{Code: "AZ-ZAQ", Name: "Zaqatala", Type: "Rayon"},
+	{Code: "AZ-ZAR", Name: "Zərdab", Type: "Rayon"},
+	{Code: "BA-BIH", Name: "Federacija Bosne i Hercegovine", Type: "Entity"},
+	{Code: "BA-BRC", Name: "Brčko distrikt", Type: "District with special status"},
+	{Code: "BA-SRP", Name: "Republika Srpska", Type: "Entity"},
+	{Code: "BB-01", Name: "Christ Church", Type: "Parish"},
+	{Code: "BB-02", Name: "Saint Andrew", Type: "Parish"},
+	{Code: "BB-03", Name: "Saint George", Type: "Parish"},
+	{Code: "BB-04", Name: "Saint James", Type: "Parish"},
+	{Code: "BB-05", Name: "Saint John", Type: "Parish"},
+	{Code: "BB-06", Name: "Saint Joseph", Type: "Parish"},
+	{Code: "BB-07", Name: "Saint Lucy", Type: "Parish"},
+	{Code: "BB-08", Name: "Saint Michael", Type: "Parish"},
+	{Code: "BB-09", Name: "Saint Peter", Type: "Parish"},
+	{Code: "BB-10", Name: "Saint Philip", Type: "Parish"},
+	{Code: "BB-11", Name: "Saint Thomas", Type: "Parish"},
+	{Code: "BD-01", Name: "Bandarban", Type: "District", Parent: "BD-B"},
+	{Code: "BD-02", Name: "Barguna", Type: "District", Parent: "BD-A"},
+	{Code: "BD-03", Name: "Bogura", Type: "District", Parent: "BD-E"},
+	{Code: "BD-04", Name: "Brahmanbaria", Type: "District", Parent: "BD-B"},
+	{Code: "BD-05", Name: "Bagerhat", Type: "District", Parent: "BD-D"},
+	{Code: "BD-06", Name: "Barishal", Type: "District", Parent: "BD-A"},
+	{Code: "BD-07", Name: "Bhola", Type: "District", Parent: "BD-A"},
+	{Code: "BD-08", Name: "Cumilla", Type: "District", Parent: "BD-B"},
+	{Code: "BD-09", Name: "Chandpur", Type: "District", Parent: "BD-B"},
+	{Code: "BD-10", Name: "Chattogram", Type: "District", Parent: "BD-B"},
+	{Code: "BD-11", Name: "Cox's Bazar", Type: "District", Parent: "BD-B"},
+	{Code: "BD-12", Name: "Chuadanga", Type: "District", Parent: "BD-D"},
+	{Code: "BD-13", Name: "Dhaka", Type: "District", Parent: "BD-C"},
+	{Code: "BD-14", Name: "Dinajpur", Type: "District", Parent: "BD-F"},
+	{Code: "BD-15", Name: "Faridpur", Type: "District", Parent: "BD-C"},
+	{Code: "BD-16", Name: "Feni", Type: "District", Parent: "BD-B"},
+	{Code: "BD-17", Name: "Gopalganj", Type: "District", Parent: "BD-C"},
+	{Code: "BD-18", Name: "Gazipur", Type: "District", Parent: "BD-C"},
+	{Code: "BD-19", Name: "Gaibandha", Type: "District", Parent: "BD-F"},
+	{Code: "BD-20", Name: "Habiganj", Type: "District", Parent: "BD-G"},
+	{Code: "BD-21", Name: "Jamalpur", Type: "District", Parent: "BD-H"},
+	{Code: "BD-22", Name: "Jashore", Type: "District", Parent: "BD-D"},
+	{Code: "BD-23", Name: "Jhenaidah", Type: "District", Parent: "BD-D"},
+	{Code: "BD-24", Name: "Joypurhat", Type: "District", Parent: "BD-E"},
+	{Code: "BD-25", Name: "Jhalakathi", Type: "District", Parent: "BD-A"},
+	{Code: "BD-26", Name: "Kishoreganj", Type: "District", Parent: "BD-C"},
+	{Code: "BD-27", Name: "Khulna", Type: "District", Parent: "BD-D"},
+	{Code: "BD-28", Name: "Kurigram", Type: "District", Parent: "BD-F"},
+	{Code: "BD-29", Name: "Khagrachhari", Type: "District", Parent: "BD-B"},
+	{Code: "BD-30", Name: "Kushtia", Type: "District", Parent: "BD-D"},
+	{Code: "BD-31", Name: "Lakshmipur", Type: "District", Parent: "BD-B"},
+	{Code: "BD-32", Name: "Lalmonirhat", Type: "District", Parent: "BD-F"},
+	{Code: "BD-33", Name: "Manikganj", Type: "District", Parent: "BD-C"},
+	{Code: "BD-34", Name: "Mymensingh", Type: "District", Parent: "BD-H"},
+	{Code: "BD-35", Name: "Munshiganj", Type: "District", Parent: "BD-C"},
+	{Code: "BD-36", Name: "Madaripur", Type: "District", Parent: "BD-C"},
+	{Code: "BD-37", Name: "Magura", Type: "District", Parent: "BD-D"},
+	{Code: "BD-38", Name: "Moulvibazar", Type: "District", Parent: "BD-G"},
+	{Code: "BD-39", Name: "Meherpur", Type: "District", Parent: "BD-D"},
+	{Code: "BD-40", Name: "Narayanganj", Type: "District", Parent: "BD-C"},
+	{Code: "BD-41", Name: "Netrakona", Type: "District", Parent: "BD-H"},
+	{Code: "BD-42", Name: "Narsingdi", Type: "District", Parent: "BD-C"},
+	{Code: "BD-43", Name: "Narail", Type: "District", Parent: "BD-D"},
+	{Code: "BD-44", Name: "Natore", Type: "District", Parent: "BD-E"},
+	{Code: "BD-45", Name: "Chapai Nawabganj", Type: "District", Parent: "BD-E"},
+	{Code: "BD-46", Name: "Nilphamari", Type: "District", Parent: "BD-F"},
+	{Code: "BD-47", Name: "Noakhali", Type: "District", Parent: "BD-B"},
+	{Code: "BD-48", Name: "Naogaon", Type: "District", Parent: "BD-E"},
+	{Code: "BD-49", Name: "Pabna", Type: "District", Parent: "BD-E"},
+	{Code: "BD-50", Name: "Pirojpur", Type: "District", Parent: "BD-A"},
+	{Code: "BD-51", Name: "Patuakhali", Type: "District", Parent: "BD-A"},
+	{Code: "BD-52", Name: "Panchagarh", Type: "District", Parent: "BD-F"},
+	{Code: "BD-53", Name: "Rajbari", Type: "District", Parent: "BD-C"},
+	{Code: "BD-54", Name: "Rajshahi", Type: "District", Parent: "BD-E"},
+	{Code: "BD-55", Name: "Rangpur", Type: "District", Parent: "BD-F"},
+	{Code: "BD-56", Name: "Rangamati", Type: "District", Parent: "BD-B"},
+	{Code: "BD-57", Name: "Sherpur", Type: "District", Parent: "BD-H"},
+	{Code: "BD-58", Name: "Satkhira", Type: "District", Parent: "BD-D"},
+	{Code: "BD-59", Name: "Sirajganj", Type: "District", Parent: "BD-E"},
+	{Code: "BD-60", Name: "Sylhet", Type: "District", Parent: "BD-G"},
+	{Code: "BD-61", Name: "Sunamganj", Type: "District", Parent: "BD-G"},
+	{Code: "BD-62", Name: "Shariatpur", Type: "District", Parent: "BD-C"},
+	{Code: "BD-63", Name: "Tangail", Type: "District", Parent: "BD-C"},
+	{Code: "BD-64", Name: "Thakurgaon", Type: "District", Parent: "BD-F"},
+	{Code: "BD-A", Name: "Barishal", Type: "Division"},
+	{Code: "BD-B", Name: "Chattogram", Type: "Division"},
+	{Code: "BD-C", Name: "Dhaka", Type: "Division"},
+	{Code: "BD-D", Name: "Khulna", Type: "Division"},
+	{Code: "BD-E", Name: "Rajshahi", Type: "Division"},
+	{Code: "BD-F", Name: "Rangpur", Type: "Division"},
+	{Code: "BD-G", Name: "Sylhet", Type: "Division"},
+	{Code: "BD-H", Name: "Mymensingh", Type: "Division"},
+	{Code: "BE-BRU", Name: "Brussels Hoofdstedelijk Gewest", Type: "Region"},
+	{Code: "BE-VAN", Name: "Antwerpen", Type: "Province", Parent: "BE-VLG"},
+	{Code: "BE-VBR", Name: "Vlaams-Brabant", Type: "Province", Parent: "BE-VLG"},
+	{Code: "BE-VLG", Name: "Vlaams Gewest", Type: "Region"},
+	{Code: "BE-VLI", Name: "Limburg", Type: "Province", Parent: "BE-VLG"},
+	{Code: "BE-VOV", Name: "Oost-Vlaanderen", Type: "Province", Parent: "BE-VLG"},
+	{Code: "BE-VWV", Name: "West-Vlaanderen", Type: "Province", Parent: "BE-VLG"},
+	{Code: "BE-WAL", Name: "wallonne, Région", Type: "Region"},
+	{Code: "BE-WBR", Name: "Brabant wallon", Type: "Province", Parent: "BE-WAL"},
+	{Code: "BE-WHT", Name: "Hainaut", Type: "Province", Parent: "BE-WAL"},
+	{Code: "BE-WLG", Name: "Liège", Type: "Province", Parent: "BE-WAL"},
+	{Code: "BE-WLX", Name: "Luxembourg", Type: "Province", Parent: "BE-WAL"},
+	{Code: "BE-WNA", Name: "Namur", Type: "Province", Parent: "BE-WAL"},
+	{Code: "BF-01", Name: "Boucle du Mouhoun", Type: "Region"},
+	{Code: "BF-02", Name: "Cascades", Type: "Region"},
+	{Code: "BF-03", Name: "Centre", Type: "Region"},
+	{Code: "BF-04", Name: "Centre-Est", Type: "Region"},
+	{Code: "BF-05", Name: "Centre-Nord", Type: "Region"},
+	{Code: "BF-06", Name: "Centre-Ouest", Type: "Region"},
+	{Code: "BF-07", Name: "Centre-Sud", Type: "Region"},
+	{Code: "BF-08", Name: "Est", Type: "Region"},
+	{Code: "BF-09", Name: "Hauts-Bassins", Type: "Region"},
+	{Code: "BF-10", Name: "Nord", Type: "Region"},
+	{Code: "BF-11", Name: "Plateau-Central", Type: "Region"},
+	{Code: "BF-12", Name: "Sahel", Type: "Region"},
+	{Code: "BF-13", Name: "Sud-Ouest", Type: "Region"},
+	{Code: "BF-BAL", Name: "Balé", Type: "Province", Parent: "BF-01"},
+	{Code: "BF-BAM", Name: "Bam", Type: "Province", Parent: "BF-05"},
+	{Code: "BF-BAN", Name: "Banwa", Type: "Province", Parent: "BF-01"},
+	{Code: "BF-BAZ", Name: "Bazèga", Type: "Province", Parent: "BF-07"},
+	{Code: "BF-BGR", Name: "Bougouriba", Type: "Province", Parent: "BF-13"},
+	{Code: "BF-BLG", Name: "Boulgou", Type: "Province", Parent: "BF-04"},
+	{Code: "BF-BLK", Name: "Boulkiemdé", Type: "Province", Parent: "BF-06"},
+	{Code: "BF-COM", Name: "Comoé", Type: "Province", Parent: "BF-02"},
+	{Code: "BF-GAN", Name: "Ganzourgou", Type: "Province", Parent: "BF-11"},
+	{Code: "BF-GNA", Name: "Gnagna", Type: "Province", Parent: "BF-08"},
+	{Code: "BF-GOU", Name: "Gourma", Type: "Province", Parent: "BF-08"},
+	{Code: "BF-HOU", Name: "Houet", Type: "Province", Parent: "BF-09"},
+	{Code: "BF-IOB", Name: "Ioba", Type: "Province", Parent: "BF-13"},
+	{Code: "BF-KAD", Name: "Kadiogo", Type: "Province", Parent: "BF-03"},
+	{Code: "BF-KEN", Name: "Kénédougou", Type: "Province", Parent: "BF-09"},
+	{Code: "BF-KMD", Name: "Komondjari", Type: "Province", Parent: "BF-08"},
+	{Code: "BF-KMP", Name: "Kompienga", Type: "Province", Parent: "BF-08"},
+	{Code: "BF-KOP", Name: "Koulpélogo", Type: "Province", Parent: "BF-04"},
+	{Code: "BF-KOS", Name: "Kossi", Type: "Province", Parent: "BF-01"},
+	{Code: "BF-KOT", Name: "Kouritenga", Type: "Province", Parent: "BF-04"},
+	{Code: "BF-KOW", Name: "Kourwéogo", Type: "Province", Parent: "BF-11"},
+	{Code: "BF-LER", Name: "Léraba", Type: "Province", Parent: "BF-02"},
+	{Code: "BF-LOR", Name: "Loroum", Type: "Province", Parent: "BF-10"},
+	{Code: "BF-MOU", Name: "Mouhoun", Type: "Province", Parent: "BF-01"},
+	{Code: "BF-NAM", Name: "Namentenga", Type: "Province", Parent: "BF-05"},
+	{Code: "BF-NAO", Name: "Nahouri", Type: "Province", Parent: "BF-07"},
+	{Code: "BF-NAY", Name: "Nayala", Type: "Province", Parent: "BF-01"},
+	{Code: "BF-NOU", Name: "Noumbiel", Type: "Province", Parent: "BF-13"},
+	{Code: "BF-OUB", Name: "Oubritenga", Type: "Province", Parent: "BF-11"},
+	{Code: "BF-OUD", Name: "Oudalan", Type: "Province", Parent: "BF-12"},
+	{Code: "BF-PAS", Name: "Passoré", Type: "Province", Parent: "BF-10"},
+	{Code: "BF-PON", Name: "Poni", Type: "Province", Parent: "BF-13"},
+	{Code: "BF-SEN", Name: "Séno", Type: "Province", Parent: "BF-12"},
+	{Code: "BF-SIS", Name: "Sissili", Type: "Province", Parent: "BF-06"},
+	{Code: "BF-SMT", Name: "Sanmatenga", Type: "Province", Parent: "BF-05"},
+	{Code: "BF-SNG", Name: "Sanguié", Type: "Province", Parent: "BF-06"},
+	{Code: "BF-SOM", Name: "Soum", Type: "Province", Parent: "BF-12"},
+	{Code: "BF-SOR", Name: "Sourou", Type: "Province", Parent: "BF-01"},
+	{Code: "BF-TAP", Name: "Tapoa", Type: "Province", Parent: "BF-08"},
+	{Code: "BF-TUI", Name: "Tuy", Type: "Province", Parent: "BF-09"},
+	{Code: "BF-YAG", Name: "Yagha", Type: "Province", Parent: "BF-12"},
+	{Code: "BF-YAT", Name: "Yatenga", Type: "Province", Parent: "BF-10"},
+	{Code: "BF-ZIR", Name: "Ziro", Type: "Province", Parent: "BF-06"},
+	{Code: "BF-ZON", Name: "Zondoma", Type: "Province", Parent: "BF-10"},
+	{Code: "BF-ZOU", Name: "Zoundwéogo", Type: "Province", Parent: "BF-07"},
+	{Code: "BG-01", Name: "Blagoevgrad", Type: "District"},
+	{Code: "BG-02", Name: "Burgas", Type: "District"},
+	{Code: "BG-03", Name: "Varna", Type: "District"},
+	{Code: "BG-04", Name: "Veliko Tarnovo", Type: "District"},
+	{Code: "BG-05", Name: "Vidin", Type: "District"},
+	{Code: "BG-06", Name: "Vratsa", Type: "District"},
+	{Code: "BG-07", Name: "Gabrovo", Type: "District"},
+	{Code: "BG-08", Name: "Dobrich", Type: "District"},
+	{Code: "BG-09", Name: "Kardzhali", Type: "District"},
+	{Code: "BG-10", Name: "Kyustendil", Type: "District"},
+	{Code: "BG-11", Name: "Lovech", Type: "District"},
+	{Code: "BG-12", Name: "Montana", Type: "District"},
+	{Code: "BG-13", Name: "Pazardzhik", Type: "District"},
+	{Code: "BG-14", Name: "Pernik", Type: "District"},
+	{Code: "BG-15", Name: "Pleven", Type: "District"},
+	{Code: "BG-16", Name: "Plovdiv", Type: "District"},
+	{Code: "BG-17", Name: "Razgrad", Type: "District"},
+	{Code: "BG-18", Name: "Ruse", Type: "District"},
+	{Code: "BG-19", Name: "Silistra", Type: "District"},
+	{Code: "BG-20", Name: "Sliven", Type: "District"},
+	{Code: "BG-21", Name: "Smolyan", Type: "District"},
+	{Code: "BG-22", Name: "Sofia (stolitsa)", Type: "District"},
+	{Code: "BG-23", Name: "Sofia", Type: "District"},
+	{Code: "BG-24", Name: "Stara Zagora", Type: "District"},
+	{Code: "BG-25", Name: "Targovishte", Type: "District"},
+	{Code: "BG-26", Name: "Haskovo", Type: "District"},
+	{Code: "BG-27", Name: "Shumen", Type: "District"},
+	{Code: "BG-28", Name: "Yambol", Type: "District"},
+	{Code: "BH-13", Name: "Al ‘Āşimah", Type: "Governorate"},
+	{Code: "BH-14", Name: "Al Janūbīyah", Type: "Governorate"},
+	{Code: "BH-15", Name: "Al Muḩarraq", Type: "Governorate"},
+	{Code: "BH-17", Name: "Ash Shamālīyah", Type: "Governorate"},
+	{Code: "BI-BB", Name: "Bubanza", Type: "Province"},
+	{Code: "BI-BL", Name: "Bujumbura Rural", Type: "Province"},
+	{Code: "BI-BM", Name: "Bujumbura Mairie", Type: "Province"},
+	{Code: "BI-BR", Name: "Bururi", Type: "Province"},
+	{Code: "BI-CA", Name: "Cankuzo", Type: "Province"},
+	{Code: "BI-CI", Name: "Cibitoke", Type: "Province"},
+	{Code: "BI-GI", Name: "Gitega", Type: "Province"},
+	{Code: "BI-KI", Name: "Kirundo", Type: "Province"},
+	{Code: "BI-KR", Name: "Karuzi", Type: "Province"},
+	{Code: "BI-KY", Name: "Kayanza", Type: "Province"},
+	{Code: "BI-MA", Name: "Makamba", Type: "Province"},
+	{Code: "BI-MU", Name: "Muramvya", Type: "Province"},
+	{Code: "BI-MW", Name: "Mwaro", Type: "Province"},
+	{Code: "BI-MY", Name: "Muyinga", Type: "Province"},
+	{Code: "BI-NG", Name: "Ngozi", Type: "Province"},
+	{Code: "BI-RM", Name: "Rumonge", Type: "Province"},
+	{Code: "BI-RT", Name: "Rutana", Type: "Province"},
+	{Code: "BI-RY", Name: "Ruyigi", Type: "Province"},
+	{Code: "BJ-AK", Name: "Atacora", Type: "Department"},
+	{Code: "BJ-AL", Name: "Alibori", Type: "Department"},
+	{Code: "BJ-AQ", Name: "Atlantique", Type: "Department"},
+	{Code: "BJ-BO", Name: "Borgou", Type: "Department"},
+	{Code: "BJ-CO", Name: "Collines", Type: "Department"},
+	{Code: "BJ-DO", Name: "Donga", Type: "Department"},
+	{Code: "BJ-KO", Name: "Couffo", Type: "Department"},
+	{Code: "BJ-LI", Name: "Littoral", Type: "Department"},
+	{Code: "BJ-MO", Name: "Mono", Type: "Department"},
+	{Code: "BJ-OU", Name: "Ouémé", Type: "Department"},
+	{Code: "BJ-PL", Name: "Plateau", Type: "Department"},
+	{Code: "BJ-ZO", Name: "Zou", Type: "Department"},
+	{Code: "BN-BE", Name: "Belait", Type: "District"},
+	{Code: "BN-BM", Name: "Brunei-Muara", Type: "District"},
+	{Code: "BN-TE", Name: "Temburong", Type: "District"},
+	{Code: "BN-TU", Name: "Tutong", Type: "District"},
+	{Code: "BO-B", Name: "El Beni", Type: "Department"},
+	{Code: "BO-C", Name: "Cochabamba", Type: "Department"},
+	{Code: "BO-H", Name: "Chuquisaca", Type: "Department"},
+	{Code: "BO-L", Name: "La Paz", Type: "Department"},
+	{Code: "BO-N", Name: "Pando", Type: "Department"},
+	{Code: "BO-O", Name: "Oruro", Type: "Department"},
+	{Code: "BO-P", Name: "Potosí", Type: "Department"},
+	{Code: "BO-S", Name: "Santa Cruz", Type: "Department"},
+	{Code: "BO-T", Name: "Tarija", Type: "Department"},
+	{Code: "BQ-BO", Name: "Bonaire", Type: "Special municipality"},
+	{Code: "BQ-SA", Name: "Saba", Type: "Special municipality"},
+	{Code: "BQ-SE", Name: "Sint Eustatius", Type: "Special municipality"},
+	{Code: "BR-AC", Name: "Acre", Type: "State"},
+	{Code: "BR-AL", Name: "Alagoas", Type: "State"},
+	{Code: "BR-AM", Name: "Amazonas", Type: "State"},
+	{Code: "BR-AP", Name: "Amapá", Type: "State"},
+	{Code: "BR-BA", Name: "Bahia", Type: "State"},
+	{Code: "BR-CE", Name: "Ceará", Type: "State"},
+	{Code: "BR-DF", Name: "Distrito Federal", Type: "Federal district"},
+	{Code: "BR-ES", Name: "Espírito Santo", Type: "State"},
+	{Code: "BR-GO", Name: "Goiás", Type: "State"},
+	{Code: "BR-MA", Name: "Maranhão", Type: "State"},
+	{Code: "BR-MG", Name: "Minas Gerais", Type: "State"},
+	{Code: "BR-MS", Name: "Mato Grosso do Sul", Type: "State"},
+	{Code: "BR-MT", Name: "Mato Grosso", Type: "State"},
+	{Code: "BR-PA", Name: "Pará", Type: "State"},
+	{Code: "BR-PB", Name: "Paraíba", Type: "State"},
+	{Code: "BR-PE", Name: "Pernambuco", Type: "State"},
+	{Code: "BR-PI", Name: "Piauí", Type: "State"},
+	{Code: "BR-PR", Name: "Paraná", Type: "State"},
+	{Code: "BR-RJ", Name: "Rio de Janeiro", Type: "State"},
+	{Code: "BR-RN", Name: "Rio Grande do Norte", Type: "State"},
+	{Code: "BR-RO", Name: "Rondônia", Type: "State"},
+	{Code: "BR-RR", Name: "Roraima", Type: "State"},
+	{Code: "BR-RS", Name: "Rio Grande do Sul", Type: "State"},
+	{Code: "BR-SC", Name: "Santa Catarina", Type: "State"},
+	{Code: "BR-SE", Name: "Sergipe", Type: "State"},
+	{Code: "BR-SP", Name: "São Paulo", Type: "State"},
+	{Code: "BR-TO", Name: "Tocantins", Type: "State"},
+	{Code: "BS-AK", Name: "Acklins", Type: "District"},
+	{Code: "BS-BI", Name: "Bimini", Type: "District"},
+	{Code: "BS-BP", Name: "Black Point", Type: "District"},
+	{Code: "BS-BY", Name: "Berry Islands", Type: "District"},
+	{Code: "BS-CE", Name: "Central Eleuthera", Type: "District"},
+	{Code: "BS-CI", Name: "Cat Island", Type: "District"},
+	{Code: "BS-CK", Name: "Crooked Island and Long Cay", Type: "District"},
+	{Code: "BS-CO", Name: "Central Abaco", Type: "District"},
+	{Code: "BS-CS", Name: "Central Andros", Type: "District"},
+	{Code: "BS-EG", Name: "East Grand Bahama", Type: "District"},
+	{Code: "BS-EX", Name: "Exuma", Type: "District"},
+	{Code: "BS-FP", Name: "City of Freeport", Type: "District"},
+	{Code: "BS-GC", Name: "Grand Cay", Type: "District"},
+	{Code: "BS-HI", Name: "Harbour Island", Type: "District"},
+	{Code: "BS-HT", Name: "Hope Town", Type: "District"},
+	{Code: "BS-IN", Name: "Inagua", Type: "District"},
+	{Code: "BS-LI", Name: "Long Island", Type: "District"},
+	{Code: "BS-MC", Name: "Mangrove Cay", Type: "District"},
+	{Code: "BS-MG", Name: "Mayaguana", Type: "District"},
+	{Code: "BS-MI", Name: "Moore's Island", Type: "District"},
+	{Code: "BS-NE", Name: "North Eleuthera", Type: "District"},
+	{Code: "BS-NO", Name: "North Abaco", Type: "District"},
+	{Code: "BS-NP", Name: "New Providence", Type: "Island"},
+	{Code: "BS-NS", Name: "North Andros", Type: "District"},
+	{Code: "BS-RC", Name: "Rum Cay", Type: "District"},
+	{Code: "BS-RI", Name: "Ragged Island", Type: "District"},
+	{Code: "BS-SA", Name: "South Andros", Type: "District"},
+	{Code: "BS-SE", Name: "South Eleuthera", Type: "District"},
+	{Code: "BS-SO", Name: "South Abaco", Type: "District"},
+	{Code: "BS-SS", Name: "San Salvador", Type: "District"},
+	{Code: "BS-SW", Name: "Spanish Wells", Type: "District"},
+	{Code: "BS-WG", Name: "West Grand Bahama", Type: "District"},
+	{Code: "BT-11", Name: "Paro", Type: "District"},
+	{Code: "BT-12", Name: "Chhukha", Type: "District"},
+	{Code: "BT-13", Name: "Haa", Type: "District"},
+	{Code: "BT-14", Name: "Samtse", Type: "District"},
+	{Code: "BT-15", Name: "Thimphu", Type: "District"},
+	{Code: "BT-21", Name: "Tsirang", Type: "District"},
+	{Code: "BT-22", Name: "Dagana", Type: "District"},
+	{Code: "BT-23", Name: "Punakha", Type: "District"},
+	{Code: "BT-24", Name: "Wangdue Phodrang", Type: "District"},
+	{Code: "BT-31", Name: "Sarpang", Type: "District"},
+	{Code: "BT-32", Name: "Trongsa", Type: "District"},
+	{Code: "BT-33", Name: "Bumthang", Type: "District"},
+	{Code: "BT-34", Name: "Zhemgang", Type: "District"},
+	{Code: "BT-41", Name: "Trashigang", Type: "District"},
+	{Code: "BT-42", Name: "Monggar", Type: "District"},
+	{Code: "BT-43", Name: "Pema Gatshel", Type: "District"},
+	{Code: "BT-44", Name: "Lhuentse", Type: "District"},
+	{Code: "BT-45", Name: "Samdrup Jongkhar", Type: "District"},
+	{Code: "BT-GA", Name: "Gasa", Type: "District"},
+	{Code: "BT-TY", Name: "Trashi Yangtse", Type: "District"},
+	{Code: "BW-CE", Name: "Central", Type: "District"},
+	{Code: "BW-CH", Name: "Chobe", Type: "District"},
+	{Code: "BW-FR", Name: "Francistown", Type: "City"},
+	{Code: "BW-GA", Name: "Gaborone", Type: "City"},
+	{Code: "BW-GH", Name: "Ghanzi", Type: "District"},
+	{Code: "BW-JW", Name: "Jwaneng", Type: "Town"},
+	{Code: "BW-KG", Name: "Kgalagadi", Type: "District"},
+	{Code: "BW-KL", Name: "Kgatleng", Type: "District"},
+	{Code: "BW-KW", Name: "Kweneng", Type: "District"},
+	{Code: "BW-LO", Name: "Lobatse", Type: "Town"},
+	{Code: "BW-NE", Name: "North East", Type: "District"},
+	{Code: "BW-NW", Name: "North West", Type: "District"},
+	{Code: "BW-SE", Name: "South East", Type: "District"},
+	{Code: "BW-SO", Name: "Southern", Type: "District"},
+	{Code: "BW-SP", Name: "Selibe Phikwe", Type: "Town"},
+	{Code: "BW-ST", Name: "Sowa Town", Type: "Town"},
+	{Code: "BY-BR", Name: "Bresckaja voblasć", Type: "Oblast"},
+	{Code: "BY-HM", Name: "Gorod Minsk", Type: "City"},
+	{Code: "BY-HO", Name: "Gomel'skaja oblast'", Type: "Oblast"},
+	{Code: "BY-HR", Name: "Grodnenskaja oblast'", Type: "Oblast"},
+	{Code: "BY-MA", Name: "Mahilioŭskaja voblasć", Type: "Oblast"},
+	{Code: "BY-MI", Name: "Minskaja oblast'", Type: "Oblast"},
+	{Code: "BY-VI", Name: "Viciebskaja voblasć", Type: "Oblast"},
+	{Code: "BZ-BZ", Name: "Belize", Type: "District"},
+	{Code: "BZ-CY", Name: "Cayo", Type: "District"},
+	{Code: "BZ-CZL", Name: "Corozal", Type: "District"},
+	{Code: "BZ-OW", Name: "Orange Walk", Type: "District"},
+	{Code: "BZ-SC", Name: "Stann Creek", Type: "District"},
+	{Code: "BZ-TOL", Name: "Toledo", Type: "District"},
+	{Code: "CA-AB", Name: "Alberta", Type: "Province"},
+	{Code: "CA-BC", Name: "British Columbia", Type: "Province"},
+	{Code: "CA-MB", Name: "Manitoba", Type: "Province"},
+	{Code: "CA-NB", Name: "New Brunswick", Type: "Province"},
+	{Code: "CA-NL", Name: "Newfoundland and Labrador", Type: "Province"},
+	{Code: "CA-NS", Name: "Nova Scotia", Type: "Province"},
+	{Code: "CA-NT", Name: "Northwest Territories", Type: "Territory"},
+	{Code: "CA-NU", Name: "Nunavut", Type: "Territory"},
+	{Code: "CA-ON", Name: "Ontario", Type: "Province"},
+	{Code: "CA-PE", Name: "Prince Edward Island", Type: "Province"},
+	{Code: "CA-QC", Name: "Quebec", Type: "Province"},
+	{Code: "CA-SK", Name: "Saskatchewan", Type: "Province"},
+	{Code: "CA-YT", Name: "Yukon", Type: "Territory"},
+	{Code: "CD-BC", Name: "Kongo Central", Type: "Province"},
+	{Code: "CD-BU", Name: "Bas-Uélé", Type: "Province"},
+	{Code: "CD-EQ", Name: "Équateur", Type: "Province"},
+	{Code: "CD-HK", Name: "Haut-Katanga", Type: "Province"},
+	{Code: "CD-HL", Name: "Haut-Lomami", Type: "Province"},
+	{Code: "CD-HU", Name: "Haut-Uélé", Type: "Province"},
+	{Code: "CD-IT", Name: "Ituri", Type: "Province"},
+	{Code: "CD-KC", Name: "Kasaï Central", Type: "Province"},
+	{Code: "CD-KE", Name: "Kasaï Oriental", Type: "Province"},
+	{Code: "CD-KG", Name: "Kwango", Type: "Province"},
+	{Code: "CD-KL", Name: "Kwilu", Type: "Province"},
+	{Code: "CD-KN", Name: "Kinshasa", Type: "City"},
+	{Code: "CD-KS", Name: "Kasaï", Type: "Province"},
+	{Code: "CD-LO", Name: "Lomami", Type: "Province"},
+	{Code: "CD-LU", Name: "Lualaba", Type: "Province"},
+	{Code: "CD-MA", Name: "Maniema", Type: "Province"},
+	{Code: "CD-MN", Name: "Mai-Ndombe", Type: "Province"},
+	{Code: "CD-MO", Name: "Mongala", Type: "Province"},
+	{Code: "CD-NK", Name: "Nord-Kivu", Type: "Province"},
+	{Code: "CD-NU", Name: "Nord-Ubangi", Type: "Province"},
+	{Code: "CD-SA", Name: "Sankuru", Type: "Province"},
+	{Code: "CD-SK", Name: "Sud-Kivu", Type: "Province"},
+	{Code: "CD-SU", Name: "Sud-Ubangi", Type: "Province"},
+	{Code: "CD-TA", Name: "Tanganyika", Type: "Province"},
+	{Code: "CD-TO", Name: "Tshopo", Type: "Province"},
+	{Code: "CD-TU", Name: "Tshuapa", Type: "Province"},
+	{Code: "CF-AC", Name: "Ouham", Type: "Prefecture"},
+	{Code: "CF-BB", Name: "Bamingui-Bangoran", Type: "Prefecture"},
+	{Code: "CF-BGF", Name: "Bangui", Type: "Commune"},
+	{Code: "CF-BK", Name: "Basse-Kotto", Type: "Prefecture"},
+	{Code: "CF-HK", Name: "Haute-Kotto", Type: "Prefecture"},
+	{Code: "CF-HM", Name: "Haut-Mbomou", Type: "Prefecture"},
+	{Code: "CF-HS", Name: "Haute-Sangha / Mambéré-Kadéï", Type: "Prefecture"},
+	{Code: "CF-KB", Name: "Gribingui", Type: "Economic prefecture"},
+	{Code: "CF-KG", Name: "Kemö-Gïrïbïngï", Type: "Prefecture"},
+	{Code: "CF-LB", Name: "Lobaye", Type: "Prefecture"},
+	{Code: "CF-MB", Name: "Mbomou", Type: "Prefecture"},
+	{Code: "CF-MP", Name: "Ombella-Mpoko", Type: "Prefecture"},
+	{Code: "CF-NM", Name: "Nana-Mambéré", Type: "Prefecture"},
+	{Code: "CF-OP", Name: "Ouham-Pendé", Type: "Prefecture"},
+	{Code: "CF-SE", Name: "Sangha", Type: "Economic prefecture"},
+	{Code: "CF-UK", Name: "Ouaka", Type: "Prefecture"},
+	{Code: "CF-VK", Name: "Vakaga", Type: "Prefecture"},
+	{Code: "CG-11", Name: "Bouenza", Type: "Department"},
+	{Code: "CG-12", Name: "Pool", Type: "Department"},
+	{Code: "CG-13", Name: "Sangha", Type: "Department"},
+	{Code: "CG-14", Name: "Plateaux", Type: "Department"},
+	{Code: "CG-15", Name: "Cuvette-Ouest", Type: "Department"},
+	{Code: "CG-16", Name: "Pointe-Noire", Type: "Department"},
+	{Code: "CG-2", Name: "Lékoumou", Type: "Department"},
+	{Code: "CG-5", Name: "Kouilou", Type: "Department"},
+	{Code: "CG-7", Name: "Likouala", Type: "Department"},
+	{Code: "CG-8", Name: "Cuvette", Type: "Department"},
+	{Code: "CG-9", Name: "Niari", Type: "Department"},
+	{Code: "CG-BZV", Name: "Brazzaville", Type: "Department"},
+	{Code: "CH-AG", Name: "Aargau", Type: "Canton"},
+	{Code: "CH-AI", Name: "Appenzell Innerrhoden", Type: "Canton"},
+	{Code: "CH-AR", Name: "Appenzell Ausserrhoden", Type: "Canton"},
+	{Code: "CH-BE", Name: "Bern", Type: "Canton"},
+	{Code: "CH-BL", Name: "Basel-Landschaft", Type: "Canton"},
+	{Code: "CH-BS", Name: "Basel-Stadt", Type: "Canton"},
+	{Code: "CH-FR", Name: "Freiburg", Type: "Canton"},
+	{Code: "CH-GE", Name: "Genève", Type: "Canton"},
+	{Code: "CH-GL", Name: "Glarus", Type: "Canton"},
+	{Code: "CH-GR", Name: "Graubünden", Type: "Canton"},
+	{Code: "CH-JU", Name: "Jura", Type: "Canton"},
+	{Code: "CH-LU", Name: "Luzern", Type: "Canton"},
+	{Code: "CH-NE", Name: "Neuchâtel", Type: "Canton"},
+	{Code: "CH-NW", Name: "Nidwalden", Type: "Canton"},
+	{Code: "CH-OW", Name: "Obwalden", Type: "Canton"},
+	{Code: "CH-SG", Name: "Sankt Gallen", Type: "Canton"},
+	{Code: "CH-SH", Name: "Schaffhausen", Type: "Canton"},
+	{Code: "CH-SO", Name: "Solothurn", Type: "Canton"},
+	{Code: "CH-SZ", Name: "Schwyz", Type: "Canton"},
+	{Code: "CH-TG", Name: "Thurgau", Type: "Canton"},
+	{Code: "CH-TI", Name: "Ticino", Type: "Canton"},
+	{Code: "CH-UR", Name: "Uri", Type: "Canton"},
+	{Code: "CH-VD", Name: "Vaud", Type: "Canton"},
+	{Code: "CH-VS", Name: "Valais", Type: "Canton"},
+	{Code: "CH-ZG", Name: "Zug", Type: "Canton"},
+	{Code: "CH-ZH", Name: "Zürich", Type: "Canton"},
+	{Code: "CI-AB", Name: "Abidjan", Type: "Autonomous district"},
+	{Code: "CI-BS", Name: "Bas-Sassandra", Type: "District"},
+	{Code: "CI-CM", Name: "Comoé", Type: "District"},
+	{Code: "CI-DN", Name: "Denguélé", Type: "District"},
+	{Code: "CI-GD", Name: "Gôh-Djiboua", Type: "District"},
+	{Code: "CI-LC", Name: "Lacs", Type: "District"},
+	{Code: "CI-LG", Name: "Lagunes", Type: "District"},
+	{Code: "CI-MG", Name: "Montagnes", Type: "District"},
+	{Code: "CI-SM", Name: "Sassandra-Marahoué", Type: "District"},
+	{Code: "CI-SV", Name: "Savanes", Type: "District"},
+	{Code: "CI-VB", Name: "Vallée du Bandama", Type: "District"},
+	{Code: "CI-WR", Name: "Woroba", Type: "District"},
+	{Code: "CI-YM", Name: "Yamoussoukro", Type: "Autonomous district"},
+	{Code: "CI-ZZ", Name: "Zanzan", Type: "District"},
+	{Code: "CL-AI", Name: "Aisén del General Carlos Ibañez del Campo", Type: "Region"},
+	{Code: "CL-AN", Name: "Antofagasta", Type: "Region"},
+	{Code: "CL-AP", Name: "Arica y Parinacota", Type: "Region"},
+	{Code: "CL-AR", Name: "La Araucanía", Type: "Region"},
+	{Code: "CL-AT", Name: "Atacama", Type: "Region"},
+	{Code: "CL-BI", Name: "Biobío", Type: "Region"},
+	{Code: "CL-CO", Name: "Coquimbo", Type: "Region"},
+	{Code: "CL-LI", Name: "Libertador General Bernardo O'Higgins", Type: "Region"},
+	{Code: "CL-LL", Name: "Los Lagos", Type: "Region"},
+	{Code: "CL-LR", Name: "Los Ríos", Type: "Region"},
+	{Code: "CL-MA", Name: "Magallanes", Type: "Region"},
+	{Code: "CL-ML", Name: "Maule", Type: "Region"},
+	{Code: "CL-NB", Name: "Ñuble", Type: "Region"},
+	{Code: "CL-RM", Name: "Región Metropolitana de Santiago", Type: "Region"},
+	{Code: "CL-TA", Name: "Tarapacá", Type: "Region"},
+	{Code: "CL-VS", Name: "Valparaíso", Type: "Region"},
+	{Code: "CM-AD", Name: "Adamaoua", Type: "Region"},
+	{Code: "CM-CE", Name: "Centre", Type: "Region"},
+	{Code: "CM-EN", Name: "Far North", Type: "Region"},
+	{Code: "CM-ES", Name: "East", Type: "Region"},
+	{Code: "CM-LT", Name: "Littoral", Type: "Region"},
+	{Code: "CM-NO", Name: "North", Type: "Region"},
+	{Code: "CM-NW", Name: "North-West", Type: "Region"},
+	{Code: "CM-OU", Name: "West", Type: "Region"},
+	{Code: "CM-SU", Name: "South", Type: "Region"},
+	{Code: "CM-SW", Name: "South-West", Type: "Region"},
+	{Code: "CN-AH", Name: "Anhui Sheng", Type: "Province"},
+	{Code: "CN-BJ", Name: "Beijing Shi", Type: "Municipality"},
+	{Code: "CN-CQ", Name: "Chongqing Shi", Type: "Municipality"},
+	{Code: "CN-FJ", Name: "Fujian Sheng", Type: "Province"},
+	{Code: "CN-GD", Name: "Guangdong Sheng", Type: "Province"},
+	{Code: "CN-GS", Name: "Gansu Sheng", Type: "Province"},
+	{Code: "CN-GX", Name: "Guangxi Zhuangzu Zizhiqu", Type: "Autonomous region"},
+	{Code: "CN-GZ", Name: "Guizhou Sheng", Type: "Province"},
+	{Code: "CN-HA", Name: "Henan Sheng", Type: "Province"},
+	{Code: "CN-HB", Name: "Hubei Sheng", Type: "Province"},
+	{Code: "CN-HE", Name: "Hebei Sheng", Type: "Province"},
+	{Code: "CN-HI", Name: "Hainan Sheng", Type: "Province"},
+	{Code: "CN-HK", Name: "Hong Kong SAR", Type: "Special administrative region"},
+	{Code: "CN-HL", Name: "Heilongjiang Sheng", Type: "Province"},
+	{Code: "CN-HN", Name: "Hunan Sheng", Type: "Province"},
+	{Code: "CN-JL", Name: "Jilin Sheng", Type: "Province"},
+	{Code: "CN-JS", Name: "Jiangsu Sheng", Type: "Province"},
+	{Code: "CN-JX", Name: "Jiangxi Sheng", Type: "Province"},
+	{Code: "CN-LN", Name: "Liaoning Sheng", Type: "Province"},
+	{Code: "CN-MO", Name: "Macao SAR", Type: "Special administrative region"},
+	{Code: "CN-NM", Name: "Nei Mongol Zizhiqu", Type: "Autonomous region"},
+	{Code: "CN-NX", Name: "Ningxia Huizi Zizhiqu", Type: "Autonomous region"},
+	{Code: "CN-QH", Name: "Qinghai Sheng", Type: "Province"},
+	{Code: "CN-SC", Name: "Sichuan Sheng", Type: "Province"},
+	{Code: "CN-SD", Name: "Shandong Sheng", Type: "Province"},
+	{Code: "CN-SH", Name: "Shanghai Shi", Type: "Municipality"},
+	{Code: "CN-SN", Name: "Shaanxi Sheng", Type: "Province"},
+	{Code: "CN-SX", Name: "Shanxi Sheng", Type: "Province"},
+	{Code: "CN-TJ", Name: "Tianjin Shi", Type: "Municipality"},
+	{Code: "CN-TW", Name: "Taiwan Sheng", Type: "Province"},
+	{Code: "CN-XJ", Name: "Xinjiang Uygur Zizhiqu", Type: "Autonomous region"},
+	{Code: "CN-XZ", Name: "Xizang Zizhiqu", Type: "Autonomous region"},
+	{Code: "CN-YN", Name: "Yunnan Sheng", Type: "Province"},
+	{Code: "CN-ZJ", Name: "Zhejiang Sheng", Type: "Province"},
+	{Code: "CO-AMA", Name: "Amazonas", Type: "Department"},
+	{Code: "CO-ANT", Name: "Antioquia", Type: "Department"},
+	{Code: "CO-ARA", Name: "Arauca", Type: "Department"},
+	{Code: "CO-ATL", Name: "Atlántico", Type: "Department"},
+	{Code: "CO-BOL", Name: "Bolívar", Type: "Department"},
+	{Code: "CO-BOY", Name: "Boyacá", Type: "Department"},
+	{Code: "CO-CAL", Name: "Caldas", Type: "Department"},
+	{Code: "CO-CAQ", Name: "Caquetá", Type: "Department"},
+	{Code: "CO-CAS", Name: "Casanare", Type: "Department"},
+	{Code: "CO-CAU", Name: "Cauca", Type: "Department"},
+	{Code: "CO-CES", Name: "Cesar", Type: "Department"},
+	{Code: "CO-CHO", Name: "Chocó", Type: "Department"},
+	{Code: "CO-COR", Name: "Córdoba", Type: "Department"},
+	{Code: "CO-CUN", Name: "Cundinamarca", Type: "Department"},
+	{Code: "CO-DC", Name: "Distrito Capital de Bogotá", Type: "Capital district"},
+	{Code: "CO-GUA", Name: "Guainía", Type: "Department"},
+	{Code: "CO-GUV", Name: "Guaviare", Type: "Department"},
+	{Code: "CO-HUI", Name: "Huila", Type: "Department"},
+	{Code: "CO-LAG", Name: "La Guajira", Type: "Department"},
+	{Code: "CO-MAG", Name: "Magdalena", Type: "Department"},
+	{Code: "CO-MET", Name: "Meta", Type: "Department"},
+	{Code: "CO-NAR", Name: "Nariño", Type: "Department"},
+	{Code: "CO-NSA", Name: "Norte de Santander", Type: "Department"},
+	{Code: "CO-PUT", Name: "Putumayo", Type: "Department"},
+	{Code: "CO-QUI", Name: "Quindío", Type: "Department"},
+	{Code: "CO-RIS", Name: "Risaralda", Type: "Department"},
+	{Code: "CO-SAN", Name: "Santander", Type: "Department"},
+	{Code: "CO-SAP", Name: "San Andrés, Providencia y Santa Catalina", Type: "Department"},
+	{Code: "CO-SUC", Name: "Sucre", Type: "Department"},
+	{Code: "CO-TOL", Name: "Tolima", Type: "Department"},
+	{Code: "CO-VAC", Name: "Valle del Cauca", Type: "Department"},
+	{Code: "CO-VAU", Name: "Vaupés", Type: "Department"},
+	{Code: "CO-VID", Name: "Vichada", Type: "Department"},
+	{Code: "CR-A", Name: "Alajuela", Type: "Province"},
+	{Code: "CR-C", Name: "Cartago", Type: "Province"},
+	{Code: "CR-G", Name: "Guanacaste", Type: "Province"},
+	{Code: "CR-H", Name: "Heredia", Type: "Province"},
+	{Code: "CR-L", Name: "Limón", Type: "Province"},
+	{Code: "CR-P", Name: "Puntarenas", Type: "Province"},
+	{Code: "CR-SJ", Name: "San José", Type: "Province"},
+	{Code: "CU-01", Name: "Pinar del Río", Type: "Province"},
+	{Code: "CU-03", Name: "La Habana", Type: "Province"},
+	{Code: "CU-04", Name: "Matanzas", Type: "Province"},
+	{Code: "CU-05", Name: "Villa Clara", Type: "Province"},
+	{Code: "CU-06", Name: "Cienfuegos", Type: "Province"},
+	{Code: "CU-07", Name: "Sancti Spíritus", Type: "Province"},
+	{Code: "CU-08", Name: "Ciego de Ávila", Type: "Province"},
+	{Code: "CU-09", Name: "Camagüey", Type: "Province"},
+	{Code: "CU-10", Name: "Las Tunas", Type: "Province"},
+	{Code: "CU-11", Name: "Holguín", Type: "Province"},
+	{Code: "CU-12", Name: "Granma", Type: "Province"},
+	{Code: "CU-13", Name: "Santiago de Cuba", Type: "Province"},
+	{Code: "CU-14", Name: "Guantánamo", Type: "Province"},
+	{Code: "CU-15", Name: "Artemisa", Type: "Province"},
+	{Code: "CU-16", Name: "Mayabeque", Type: "Province"},
+	{Code: "CU-99", Name: "Isla de la Juventud", Type: "Special municipality"},
+	{Code: "CV-B", Name: "Ilhas de Barlavento", Type: "Geographical region"},
+	{Code: "CV-BR", Name: "Brava", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-BV", Name: "Boa Vista", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-CA", Name: "Santa Catarina", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-CF", Name: "Santa Catarina do Fogo", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-CR", Name: "Santa Cruz", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-MA", Name: "Maio", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-MO", Name: "Mosteiros", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-PA", Name: "Paul", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-PN", Name: "Porto Novo", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-PR", Name: "Praia", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-RB", Name: "Ribeira Brava", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-RG", Name: "Ribeira Grande", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-RS", Name: "Ribeira Grande de Santiago", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-S", Name: "Ilhas de Sotavento", Type: "Geographical region"},
+	{Code: "CV-SD", Name: "São Domingos", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-SF", Name: "São Filipe", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-SL", Name: "Sal", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-SM", Name: "São Miguel", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-SO", Name: "São Lourenço dos Órgãos", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-SS", Name: "São Salvador do Mundo", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-SV", Name: "São Vicente", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CV-TA", Name: "Tarrafal", Type: "Municipality", Parent: "CV-S"},
+	{Code: "CV-TS", Name: "Tarrafal de São Nicolau", Type: "Municipality", Parent: "CV-B"},
+	{Code: "CY-01", Name: "Lefkosia", Type: "District"},
+	{Code: "CY-02", Name: "Lemesos", Type: "District"},
+	{Code: "CY-03", Name: "Larnaka", Type: "District"},
+	{Code: "CY-04", Name: "Ammochostos", Type: "District"},
+	{Code: "CY-05", Name: "Baf", Type: "District"},
+	{Code: "CY-06", Name: "Girne", Type: "District"},
+	{Code: "CZ-10", Name: "Praha, Hlavní město", Type: "Capital city"},
+	{Code: "CZ-20", Name: "Středočeský kraj", Type: "Region"},
+	{Code: "CZ-201", Name: "Benešov", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-202", Name: "Beroun", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-203", Name: "Kladno", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-204", Name: "Kolín", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-205", Name: "Kutná Hora", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-206", Name: "Mělník", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-207", Name: "Mladá Boleslav", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-208", Name: "Nymburk", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-209", Name: "Praha-východ", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-20A", Name: "Praha-západ", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-20B", Name: "Příbram", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-20C", Name: "Rakovník", Type: "District", Parent: "CZ-20"},
+	{Code: "CZ-31", Name: "Jihočeský kraj", Type: "Region"},
+	{Code: "CZ-311", Name: "České Budějovice", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-312", Name: "Český Krumlov", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-313", Name: "Jindřichův Hradec", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-314", Name: "Písek", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-315", Name: "Prachatice", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-316", Name: "Strakonice", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-317", Name: "Tábor", Type: "District", Parent: "CZ-31"},
+	{Code: "CZ-32", Name: "Plzeňský kraj", Type: "Region"},
+	{Code: "CZ-321", Name: "Domažlice", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-322", Name: "Klatovy", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-323", Name: "Plzeň-město", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-324", Name: "Plzeň-jih", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-325", Name: "Plzeň-sever", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-326", Name: "Rokycany", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-327", Name: "Tachov", Type: "District", Parent: "CZ-32"},
+	{Code: "CZ-41", Name: "Karlovarský kraj", Type: "Region"},
+	{Code: "CZ-411", Name: "Cheb", Type: "District", Parent: "CZ-41"},
+	{Code: "CZ-412", Name: "Karlovy Vary", Type: "District", Parent: "CZ-41"},
+	{Code: "CZ-413", Name: "Sokolov", Type: "District", Parent: "CZ-41"},
+	{Code: "CZ-42", Name: "Ústecký kraj", Type: "Region"},
+	{Code: "CZ-421", Name: "Děčín", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-422", Name: "Chomutov", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-423", Name: "Litoměřice", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-424", Name: "Louny", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-425", Name: "Most", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-426", Name: "Teplice", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-427", Name: "Ústí nad Labem", Type: "District", Parent: "CZ-42"},
+	{Code: "CZ-51", Name: "Liberecký kraj", Type: "Region"},
+	{Code: "CZ-511", Name: "Česká Lípa", Type: "District", Parent: "CZ-51"},
+	{Code: "CZ-512", Name: "Jablonec nad Nisou", Type: "District", Parent: "CZ-51"},
+	{Code: "CZ-513", Name: "Liberec", Type: "District", Parent: "CZ-51"},
+	{Code: "CZ-514", Name: "Semily", Type: "District", Parent: "CZ-51"},
+	{Code: "CZ-52", Name: "Královéhradecký kraj", Type: "Region"},
+	{Code: "CZ-521", Name: "Hradec Králové", Type: "District", Parent: "CZ-52"},
+	{Code: "CZ-522", Name: "Jičín", Type: "District", Parent: "CZ-52"},
+	{Code: "CZ-523", Name: "Náchod", Type: "District", Parent: "CZ-52"},
+	{Code: "CZ-524", Name: "Rychnov nad Kněžnou", Type: "District", Parent: "CZ-52"},
+	{Code: "CZ-525", Name: "Trutnov", Type: "District", Parent: "CZ-52"},
+	{Code: "CZ-53", Name: "Pardubický kraj", Type: "Region"},
+	{Code: "CZ-531", Name: "Chrudim", Type: "District", Parent: "CZ-53"},
+	{Code: "CZ-532", Name: "Pardubice", Type: "District", Parent: "CZ-53"},
+	{Code: "CZ-533", Name: "Svitavy", Type: "District", Parent: "CZ-53"},
+	{Code: "CZ-534", Name: "Ústí nad Orlicí", Type: "District", Parent: "CZ-53"},
+	{Code: "CZ-63", Name: "Kraj Vysočina", Type: "Region"},
+	{Code: "CZ-631", Name: "Havlíčkův Brod", Type: "District", Parent: "CZ-63"},
+	{Code: "CZ-632", Name: "Jihlava", Type: "District", Parent: "CZ-63"},
+	{Code: "CZ-633", Name: "Pelhřimov", Type: "District", Parent: "CZ-63"},
+	{Code: "CZ-634", Name: "Třebíč", Type: "District", Parent: "CZ-63"},
+	{Code: "CZ-635", Name: "Žďár nad Sázavou", Type: "District", Parent: "CZ-63"},
+	{Code: "CZ-64", Name: "Jihomoravský kraj", Type: "Region"},
+	{Code: "CZ-641", Name: "Blansko", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-642", Name: "Brno-město", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-643", Name: "Brno-venkov", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-644", Name: "Břeclav", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-645", Name: "Hodonín", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-646", Name: "Vyškov", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-647", Name: "Znojmo", Type: "District", Parent: "CZ-64"},
+	{Code: "CZ-71", Name: "Olomoucký kraj", Type: "Region"},
+	{Code: "CZ-711", Name: "Jeseník", Type: "District", Parent: "CZ-71"},
+	{Code: "CZ-712", Name: "Olomouc", Type: "District", Parent: "CZ-71"},
+	{Code: "CZ-713", Name: "Prostějov", Type: "District", Parent: "CZ-71"},
+	{Code: "CZ-714", Name: "Přerov", Type: "District", Parent: "CZ-71"},
+	{Code: "CZ-715", Name: "Šumperk", Type: "District", Parent: "CZ-71"},
+	{Code: "CZ-72", Name: "Zlínský kraj", Type: "Region"},
+	{Code: "CZ-721", Name: "Kroměříž", Type: "District", Parent: "CZ-72"},
+	{Code: "CZ-722", Name: "Uherské Hradiště", Type: "District", Parent: "CZ-72"},
+	{Code: "CZ-723", Name: "Vsetín", Type: "District", Parent: "CZ-72"},
+	{Code: "CZ-724", Name: "Zlín", Type: "District", Parent: "CZ-72"},
+	{Code: "CZ-80", Name: "Moravskoslezský kraj", Type: "Region"},
+	{Code: "CZ-801", Name: "Bruntál", Type: "District", Parent: "CZ-80"},
+	{Code: "CZ-802", Name: "Frýdek-Místek", Type: "District", Parent: "CZ-80"},
+	{Code: "CZ-803", Name: "Karviná", Type: "District", Parent: "CZ-80"},
+	{Code: "CZ-804", Name: "Nový Jičín", Type: "District", Parent: "CZ-80"},
+	{Code: "CZ-805", Name: "Opava", Type: "District", Parent: "CZ-80"},
+	{Code: "CZ-806", Name: "Ostrava-město", Type: "District", Parent: "CZ-80"},
+	{Code: "DE-BB", Name: "Brandenburg", Type: "Land"},
+	{Code: "DE-BE", Name: "Berlin", Type: "Land"},
+	{Code: "DE-BW", Name: "Baden-Württemberg", Type: "Land"},
+	{Code: "DE-BY", Name: "Bayern", Type: "Land"},
+	{Code: "DE-HB", Name: "Bremen", Type: "Land"},
+	{Code: "DE-HE", Name: "Hessen", Type: "Land"},
+	{Code: "DE-HH", Name: "Hamburg", Type: "Land"},
+	{Code: "DE-MV", Name: "Mecklenburg-Vorpommern", Type: "Land"},
+	{Code: "DE-NI", Name: "Niedersachsen", Type: "Land"},
+	{Code: "DE-NW", Name: "Nordrhein-Westfalen", Type: "Land"},
+	{Code: "DE-RP", Name: "Rheinland-Pfalz", Type: "Land"},
+	{Code: "DE-SH", Name: "Schleswig-Holstein", Type: "Land"},
+	{Code: "DE-SL", Name: "Saarland", Type: "Land"},
+	{Code: "DE-SN", Name: "Sachsen", Type: "Land"},
+	{Code: "DE-ST", Name: "Sachsen-Anhalt", Type: "Land"},
+	{Code: "DE-TH", Name: "Thüringen", Type: "Land"},
+	{Code: "DJ-AR", Name: "Arta", Type: "Region"},
+	{Code: "DJ-AS", Name: "Ali Sabieh", Type: "Region"},
+	{Code: "DJ-DI", Name: "Dikhil", Type: "Region"},
+	{Code: "DJ-DJ", Name: "Djibouti", Type: "City"},
+	{Code: "DJ-OB", Name: "Awbūk", Type: "Region"},
+	{Code: "DJ-TA", Name: "Tadjourah", Type: "Region"},
+	{Code: "DK-81", Name: "Nordjylland", Type: "Region"},
+	{Code: "DK-82", Name: "Midtjylland", Type: "Region"},
+	{Code: "DK-83", Name: "Syddanmark", Type: "Region"},
+	{Code: "DK-84", Name: "Hovedstaden", Type: "Region"},
+	{Code: "DK-85", Name: "Sjælland", Type: "Region"},
+	{Code: "DM-02", Name: "Saint Andrew", Type: "Parish"},
+	{Code: "DM-03", Name: "Saint David", Type: "Parish"},
+	{Code: "DM-04", Name: "Saint George", Type: "Parish"},
+	{Code: "DM-05", Name: "Saint John", Type: "Parish"},
+	{Code: "DM-06", Name: "Saint Joseph", Type: "Parish"},
+	{Code: "DM-07", Name: "Saint Luke", Type: "Parish"},
+	{Code: "DM-08", Name: "Saint Mark", Type: "Parish"},
+	{Code: "DM-09", Name: "Saint Patrick", Type: "Parish"},
+	{Code: "DM-10", Name: "Saint Paul", Type: "Parish"},
+	{Code: "DM-11", Name: "Saint Peter", Type: "Parish"},
+	{Code: "DO-01", Name: "Distrito Nacional (Santo Domingo)", Type: "District", Parent: "DO-40"},
+	{Code: "DO-02", Name: "Azua", Type: "Province", Parent: "DO-41"},
+	{Code: "DO-03", Name: "Baoruco", Type: "Province", Parent: "DO-38"},
+	{Code: "DO-04", Name: "Barahona", Type: "Province", Parent: "DO-38"},
+	{Code: "DO-05", Name: "Dajabón", Type: "Province", Parent: "DO-34"},
+	{Code: "DO-06", Name: "Duarte", Type: "Province", Parent: "DO-33"},
+	{Code: "DO-07", Name: "Elías Piña", Type: "Province", Parent: "DO-37"},
+	{Code: "DO-08", Name: "El Seibo", Type: "Province", Parent: "DO-42"},
+	{Code: "DO-09", Name: "Espaillat", Type: "Province", Parent: "DO-35"},
+	{Code: "DO-10", Name: "Independencia", Type: "Province", Parent: "DO-38"},
+	{Code: "DO-11", Name: "La Altagracia", Type: "Province", Parent: "DO-42"},
+	{Code: "DO-12", Name: "La Romana", Type: "Province", Parent: "DO-42"},
+	{Code: "DO-13", Name: "La Vega", Type: "Province", Parent: "DO-36"},
+	{Code: "DO-14", Name: "María Trinidad Sánchez", Type: "Province", Parent: "DO-33"},
+	{Code: "DO-15", Name: "Monte Cristi", Type: "Province", Parent: "DO-34"},
+	{Code: "DO-16", Name: "Pedernales", Type: "Province", Parent: "DO-38"},
+	{Code: "DO-17", Name: "Peravia", Type: "Province", Parent: "DO-41"},
+	{Code: "DO-18", Name: "Puerto Plata", Type: "Province", Parent: "DO-35"},
+	{Code: "DO-19", Name: "Hermanas Mirabal", Type: "Province", Parent: "DO-33"},
+	{Code: "DO-20", Name: "Samaná", Type: "Province", Parent: "DO-33"},
+	{Code: "DO-21", Name: "San Cristóbal", Type: "Province", Parent: "DO-41"},
+	{Code: "DO-22", Name: "San Juan", Type: "Province", Parent: "DO-37"},
+	{Code: "DO-23", Name: "San Pedro de Macorís", Type: "Province", Parent: "DO-39"},
+	{Code: "DO-24", Name: "Sánchez Ramírez", Type: "Province", Parent: "DO-36"},
+	{Code: "DO-25", Name: "Santiago", Type: "Province", Parent: "DO-35"},
+	{Code: "DO-26", Name: "Santiago Rodríguez", Type: "Province", Parent: "DO-34"},
+	{Code: "DO-27", Name: "Valverde", Type: "Province", Parent: "DO-34"},
+	{Code: "DO-28", Name: "Monseñor Nouel", Type: "Province", Parent: "DO-36"},
+	{Code: "DO-29", Name: "Monte Plata", Type: "Province", Parent: "DO-39"},
+	{Code: "DO-30", Name: "Hato Mayor", Type: "Province", Parent: "DO-39"},
+	{Code: "DO-31", Name: "San José de Ocoa", Type: "Province", Parent: "DO-41"},
+	{Code: "DO-32", Name: "Santo Domingo", Type: "Province", Parent: "DO-40"},
+	{Code: "DO-33", Name: "Cibao Nordeste", Type: "Region"},
+	{Code: "DO-34", Name: "Cibao Noroeste", Type: "Region"},
+	{Code: "DO-35", Name: "Cibao Norte", Type: "Region"},
+	{Code: "DO-36", Name: "Cibao Sur", Type: "Region"},
+	{Code: "DO-37", Name: "El Valle", Type: "Region"},
+	{Code: "DO-38", Name: "Enriquillo", Type: "Region"},
+	{Code: "DO-39", Name: "Higuamo", Type: "Region"},
+	{Code: "DO-40", Name: "Ozama", Type: "Region"},
+	{Code: "DO-41", Name: "Valdesia", Type: "Region"},
+	{Code: "DO-42", Name: "Yuma", Type: "Region"},
+	{Code: "DZ-01", Name: "Adrar", Type: "Province"},
+	{Code: "DZ-02", Name: "Chlef", Type: "Province"},
+	{Code: "DZ-03", Name: "Laghouat", Type: "Province"},
+	{Code: "DZ-04", Name: "Oum el Bouaghi", Type: "Province"},
+	{Code: "DZ-05", Name: "Batna", Type: "Province"},
+	{Code: "DZ-06", Name: "Béjaïa", Type: "Province"},
+	{Code: "DZ-07", Name: "Biskra", Type: "Province"},
+	{Code: "DZ-08", Name: "Béchar", Type: "Province"},
+	{Code: "DZ-09", Name: "Blida", Type: "Province"},
+	{Code: "DZ-10", Name: "Bouira", Type: "Province"},
+	{Code: "DZ-11", Name: "Tamanrasset", Type: "Province"},
+	{Code: "DZ-12", Name: "Tébessa", Type: "Province"},
+	{Code: "DZ-13", Name: "Tlemcen", Type: "Province"},
+	{Code: "DZ-14", Name: "Tiaret", Type: "Province"},
+	{Code: "DZ-15", Name: "Tizi Ouzou", Type: "Province"},
+	{Code: "DZ-16", Name: "Alger", Type: "Province"},
+	{Code: "DZ-17", Name: "Djelfa", Type: "Province"},
+	{Code: "DZ-18", Name: "Jijel", Type: "Province"},
+	{Code: "DZ-19", Name: "Sétif", Type: "Province"},
+	{Code: "DZ-20", Name: "Saïda", Type: "Province"},
+	{Code: "DZ-21", Name: "Skikda", Type: "Province"},
+	{Code: "DZ-22", Name: "Sidi Bel Abbès", Type: "Province"},
+	{Code: "DZ-23", Name: "Annaba", Type: "Province"},
+	{Code: "DZ-24", Name: "Guelma", Type: "Province"},
+	{Code: "DZ-25", Name: "Constantine", Type: "Province"},
+	{Code: "DZ-26", Name: "Médéa", Type: "Province"},
+	{Code: "DZ-27", Name: "Mostaganem", Type: "Province"},
+	{Code: "DZ-28", Name: "M'sila", Type: "Province"},
+	{Code: "DZ-29", Name: "Mascara", Type: "Province"},
+	{Code: "DZ-30", Name: "Ouargla", Type: "Province"},
+	{Code: "DZ-31", Name: "Oran", Type: "Province"},
+	{Code: "DZ-32", Name: "El Bayadh", Type: "Province"},
+	{Code: "DZ-33", Name: "Illizi", Type: "Province"},
+	{Code: "DZ-34", Name: "Bordj Bou Arréridj", Type: "Province"},
+	{Code: "DZ-35", Name: "Boumerdès", Type: "Province"},
+	{Code: "DZ-36", Name: "El Tarf", Type: "Province"},
+	{Code: "DZ-37", Name: "Tindouf", Type: "Province"},
+	{Code: "DZ-38", Name: "Tissemsilt", Type: "Province"},
+	{Code: "DZ-39", Name: "El Oued", Type: "Province"},
+	{Code: "DZ-40", Name: "Khenchela", Type: "Province"},
+	{Code: "DZ-41", Name: "Souk Ahras", Type: "Province"},
+	{Code: "DZ-42", Name: "Tipaza", Type: "Province"},
+	{Code: "DZ-43", Name: "Mila", Type: "Province"},
+	{Code: "DZ-44", Name: "Aïn Defla", Type: "Province"},
+	{Code: "DZ-45", Name: "Naama", Type: "Province"},
+	{Code: "DZ-46", Name: "Aïn Témouchent", Type: "Province"},
+	{Code: "DZ-47", Name: "Ghardaïa", Type: "Province"},
+	{Code: "DZ-48", Name: "Relizane", Type: "Province"},
+	{Code: "EC-A", Name: "Azuay", Type: "Province"},
+	{Code: "EC-B", Name: "Bolívar", Type: "Province"},
+	{Code: "EC-C", Name: "Carchi", Type: "Province"},
+	{Code: "EC-D", Name: "Orellana", Type: "Province"},
+	{Code: "EC-E", Name: "Esmeraldas", Type: "Province"},
+	{Code: "EC-F", Name: "Cañar", Type: "Province"},
+	{Code: "EC-G", Name: "Guayas", Type: "Province"},
+	{Code: "EC-H", Name: "Chimborazo", Type: "Province"},
+	{Code: "EC-I", Name: "Imbabura", Type: "Province"},
+	{Code: "EC-L", Name: "Loja", Type: "Province"},
+	{Code: "EC-M", Name: "Manabí", Type: "Province"},
+	{Code: "EC-N", Name: "Napo", Type: "Province"},
+	{Code: "EC-O", Name: "El Oro", Type: "Province"},
+	{Code: "EC-P", Name: "Pichincha", Type: "Province"},
+	{Code: "EC-R", Name: "Los Ríos", Type: "Province"},
+	{Code: "EC-S", Name: "Morona Santiago", Type: "Province"},
+	{Code: "EC-SD", Name: "Santo Domingo de los Tsáchilas", Type: "Province"},
+	{Code: "EC-SE", Name: "Santa Elena", Type: "Province"},
+	{Code: "EC-T", Name: "Tungurahua", Type: "Province"},
+	{Code: "EC-U", Name: "Sucumbíos", Type: "Province"},
+	{Code: "EC-W", Name: "Galápagos", Type: "Province"},
+	{Code: "EC-X", Name: "Cotopaxi", Type: "Province"},
+	{Code: "EC-Y", Name: "Pastaza", Type: "Province"},
+	{Code: "EC-Z", Name: "Zamora Chinchipe", Type: "Province"},
+	{Code: "EE-130", Name: "Alutaguse", Type: "Rural municipality", Parent: "EE-45"},
+	{Code: "EE-141", Name: "Anija", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-142", Name: "Antsla", Type: "Rural municipality", Parent: "EE-87"},
+	{Code: "EE-171", Name: "Elva", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-184", Name: "Haapsalu", Type: "Urban municipality", Parent: "EE-56"},
+	{Code: "EE-191", Name: "Haljala", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EE-198", Name: "Harku", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-205", Name: "Hiiumaa", Type: "Rural municipality", Parent: "EE-39"},
+	{Code: "EE-214", Name: "Häädemeeste", Type: "Rural municipality", Parent: "EE-68"},
+	{Code: "EE-245", Name: "Jõelähtme", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-247", Name: "Jõgeva", Type: "Rural municipality", Parent: "EE-50"},
+	{Code: "EE-251", Name: "Jõhvi", Type: "Rural municipality", Parent: "EE-45"},
+	{Code: "EE-255", Name: "Järva", Type: "Rural municipality", Parent: "EE-52"},
+	{Code: "EE-272", Name: "Kadrina", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EE-283", Name: "Kambja", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-284", Name: "Kanepi", Type: "Rural municipality", Parent: "EE-64"},
+	{Code: "EE-291", Name: "Kastre", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-293", Name: "Kehtna", Type: "Rural municipality", Parent: "EE-71"},
+	{Code: "EE-296", Name: "Keila", Type: "Urban municipality", Parent: "EE-37"},
+	{Code: "EE-303", Name: "Kihnu", Type: "Rural municipality", Parent: "EE-68"},
+	{Code: "EE-305", Name: "Kiili", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-317", Name: "Kohila", Type: "Rural municipality", Parent: "EE-71"},
+	{Code: "EE-321", Name: "Kohtla-Järve", Type: "Urban municipality", Parent: "EE-45"},
+	{Code: "EE-338", Name: "Kose", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-353", Name: "Kuusalu", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-37", Name: "Harjumaa", Type: "County"},
+	{Code: "EE-39", Name: "Hiiumaa", Type: "County"},
+	{Code: "EE-424", Name: "Loksa", Type: "Urban municipality", Parent: "EE-37"},
+	{Code: "EE-430", Name: "Lääneranna", Type: "Rural municipality", Parent: "EE-68"},
+	{Code: "EE-431", Name: "Lääne-Harju", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-432", Name: "Luunja", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-441", Name: "Lääne-Nigula", Type: "Rural municipality", Parent: "EE-56"},
+	{Code: "EE-442", Name: "Lüganuse", Type: "Rural municipality", Parent: "EE-45"},
+	{Code: "EE-446", Name: "Maardu", Type: "Urban municipality", Parent: "EE-37"},
+	{Code: "EE-45", Name: "Ida-Virumaa", Type: "County"},
+	{Code: "EE-478", Name: "Muhu", Type: "Rural municipality", Parent: "EE-74"},
+	{Code: "EE-480", Name: "Mulgi", Type: "Rural municipality", Parent: "EE-84"},
+	{Code: "EE-486", Name: "Mustvee", Type: "Rural municipality", Parent: "EE-50"},
+	{Code: "EE-50", Name: "Jõgevamaa", Type: "County"},
+	{Code: "EE-503", Name: "Märjamaa", Type: "Rural municipality", Parent: "EE-71"},
+	{Code: "EE-511", Name: "Narva", Type: "Urban municipality", Parent: "EE-45"},
+	{Code: "EE-514", Name: "Narva-Jõesuu", Type: "Urban municipality", Parent: "EE-45"},
+	{Code: "EE-52", Name: "Järvamaa", Type: "County"},
+	{Code: "EE-528", Name: "Nõo", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-557", Name: "Otepää", Type: "Rural municipality", Parent: "EE-81"},
+	{Code: "EE-56", Name: "Läänemaa", Type: "County"},
+	{Code: "EE-567", Name: "Paide", Type: "Urban municipality", Parent: "EE-52"},
+	{Code: "EE-586", Name: "Peipsiääre", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-60", Name: "Lääne-Virumaa", Type: "County"},
+	{Code: "EE-615", Name: "Põhja-Sakala", Type: "Rural municipality", Parent: "EE-84"},
+	{Code: "EE-618", Name: "Põltsamaa", Type: "Rural municipality", Parent: "EE-50"},
+	{Code: "EE-622", Name: "Põlva", Type: "Rural municipality", Parent: "EE-64"},
+	{Code: "EE-624", Name: "Pärnu", Type: "Urban municipality", Parent: "EE-68"},
+	{Code: "EE-638", Name: "Põhja-Pärnumaa", Type: "Rural municipality", Parent: "EE-68"},
+	{Code: "EE-64", Name: "Põlvamaa", Type: "County"},
+	{Code: "EE-651", Name: "Raasiku", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-653", Name: "Rae", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-661", Name: "Rakvere", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EE-663", Name: "Rakvere", Type: "Urban municipality", Parent: "EE-60"},
+	{Code: "EE-668", Name: "Rapla", Type: "Rural municipality", Parent: "EE-71"},
+	{Code: "EE-68", Name: "Pärnumaa", Type: "County"},
+	{Code: "EE-689", Name: "Ruhnu", Type: "Rural municipality", Parent: "EE-74"},
+	{Code: "EE-698", Name: "Rõuge", Type: "Rural municipality", Parent: "EE-87"},
+	{Code: "EE-708", Name: "Räpina", Type: "Rural municipality", Parent: "EE-64"},
+	{Code: "EE-71", Name: "Raplamaa", Type: "County"},
+	{Code: "EE-712", Name: "Saarde", Type: "Rural municipality", Parent: "EE-68"},
+	{Code: "EE-714", Name: "Saaremaa", Type: "Rural municipality", Parent: "EE-74"},
+	{Code: "EE-719", Name: "Saku", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-726", Name: "Saue", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-732", Name: "Setomaa", Type: "Rural municipality", Parent: "EE-87"},
+	{Code: "EE-735", Name: "Sillamäe", Type: "Urban municipality", Parent: "EE-45"},
+	{Code: "EE-74", Name: "Saaremaa", Type: "County"},
+	{Code: "EE-784", Name: "Tallinn", Type: "Urban municipality", Parent: "EE-37"},
+	{Code: "EE-79", Name: "Tartumaa", Type: "County"},
+	{Code: "EE-792", Name: "Tapa", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EE-793", Name: "Tartu", Type: "Urban municipality", Parent: "EE-79"},
+	{Code: "EE-796", Name: "Tartu", Type: "Rural municipality", Parent: "EE-79"},
+	{Code: "EE-803", Name: "Toila", Type: "Rural municipality", Parent: "EE-45"},
+	{Code: "EE-809", Name: "Tori", Type: "Rural municipality", Parent: "EE-68"},
+	{Code: "EE-81", Name: "Valgamaa", Type: "County"},
+	{Code: "EE-824", Name: "Tõrva", Type: "Rural municipality", Parent: "EE-81"},
+	{Code: "EE-834", Name: "Türi", Type: "Rural municipality", Parent: "EE-52"},
+	{Code: "EE-84", Name: "Viljandimaa", Type: "County"},
+	{Code: "EE-855", Name: "Valga", Type: "Rural municipality", Parent: "EE-81"},
+	{Code: "EE-87", Name: "Võrumaa", Type: "County"},
+	{Code: "EE-890", Name: "Viimsi", Type: "Rural municipality", Parent: "EE-37"},
+	{Code: "EE-897", Name: "Viljandi", Type: "Urban municipality", Parent: "EE-84"},
+	{Code: "EE-899", Name: "Viljandi", Type: "Rural municipality", Parent: "EE-84"},
+	{Code: "EE-901", Name: "Vinni", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EE-903", Name: "Viru-Nigula", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EE-907", Name: "Vormsi", Type: "Rural municipality", Parent: "EE-56"},
+	{Code: "EE-917", Name: "Võru", Type: "Rural municipality", Parent: "EE-87"},
+	{Code: "EE-919", Name: "Võru", Type: "Urban municipality", Parent: "EE-87"},
+	{Code: "EE-928", Name: "Väike-Maarja", Type: "Rural municipality", Parent: "EE-60"},
+	{Code: "EG-ALX", Name: "Al Iskandarīyah", Type: "Governorate"},
+	{Code: "EG-ASN", Name: "Aswān", Type: "Governorate"},
+	{Code: "EG-AST", Name: "Asyūţ", Type: "Governorate"},
+	{Code: "EG-BA", Name: "Al Baḩr al Aḩmar", Type: "Governorate"},
+	{Code: "EG-BH", Name: "Al Buḩayrah", Type: "Governorate"},
+	{Code: "EG-BNS", Name: "Banī Suwayf", Type: "Governorate"},
+	{Code: "EG-C", Name: "Al Qāhirah", Type: "Governorate"},
+	{Code: "EG-DK", Name: "Ad Daqahlīyah", Type: "Governorate"},
+	{Code: "EG-DT", Name: "Dumyāţ", Type: "Governorate"},
+	{Code: "EG-FYM", Name: "Al Fayyūm", Type: "Governorate"},
+	{Code: "EG-GH", Name: "Al Gharbīyah", Type: "Governorate"},
+	{Code: "EG-GZ", Name: "Al Jīzah", Type: "Governorate"},
+	{Code: "EG-IS", Name: "Al Ismā'īlīyah", Type: "Governorate"},
+	{Code: "EG-JS", Name: "Janūb Sīnā'", Type: "Governorate"},
+	{Code: "EG-KB", Name: "Al Qalyūbīyah", Type: "Governorate"},
+	{Code: "EG-KFS", Name: "Kafr ash Shaykh", Type: "Governorate"},
+	{Code: "EG-KN", Name: "Qinā", Type: "Governorate"},
+	{Code: "EG-LX", Name: "Al Uqşur", Type: "Governorate"},
+	{Code: "EG-MN", Name: "Al Minyā", Type: "Governorate"},
+	{Code: "EG-MNF", Name: "Al Minūfīyah", Type: "Governorate"},
+	{Code: "EG-MT", Name: "Maţrūḩ", Type: "Governorate"},
+	{Code: "EG-PTS", Name: "Būr Sa‘īd", Type: "Governorate"},
+	{Code: "EG-SHG", Name: "Sūhāj", Type: "Governorate"},
+	{Code: "EG-SHR", Name: "Ash Sharqīyah", Type: "Governorate"},
+	{Code: "EG-SIN", Name: "Shamāl Sīnā'", Type: "Governorate"},
+	{Code: "EG-SUZ", Name: "As Suways", Type: "Governorate"},
+	{Code: "EG-WAD", Name: "Al Wādī al Jadīd", Type: "Governorate"},
+	{Code: "ER-AN", Name: "Ansabā", Type: "Region"},
+	{Code: "ER-DK", Name: "Debubawi K’eyyĭḥ Baḥri", Type: "Region"},
+	{Code: "ER-DU", Name: "Al Janūbī", Type: "Region"},
+	{Code: "ER-GB", Name: "Gash-Barka", Type: "Region"},
+	{Code: "ER-MA", Name: "Al Awsaţ", Type: "Region"},
+	{Code: "ER-SK", Name: "Semienawi K’eyyĭḥ Baḥri", Type: "Region"},
+	{Code: "ES-A", Name: "Alacant*", Type: "Province", Parent: "ES-VC"},
+	{Code: "ES-AB", Name: "Albacete", Type: "Province", Parent: "ES-CM"},
+	{Code: "ES-AL", Name: "Almería", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-AN", Name: "Andalucía", Type: "Autonomous community"},
+	{Code: "ES-AR", Name: "Aragón", Type: "Autonomous community"},
+	{Code: "ES-AS", Name: "Asturias, Principado de", Type: "Autonomous community"},
+	{Code: "ES-AV", Name: "Ávila", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-B", Name: "Barcelona [Barcelona]", Type: "Province", Parent: "ES-CT"},
+	{Code: "ES-BA", Name: "Badajoz", Type: "Province", Parent: "ES-EX"},
+	{Code: "ES-BI", Name: "Bizkaia", Type: "Province", Parent: "ES-PV"},
+	{Code: "ES-BU", Name: "Burgos", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-C", Name: "A Coruña [La Coruña]", Type: "Province", Parent: "ES-GA"},
+	{Code: "ES-CA", Name: "Cádiz", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-CB", Name: "Cantabria", Type: "Autonomous community"},
+	{Code: "ES-CC", Name: "Cáceres", Type: "Province", Parent: "ES-EX"},
+	{Code: "ES-CE", Name: "Ceuta", Type: "Autonomous city in north africa"},
+	{Code: "ES-CL", Name: "Castilla y León", Type: "Autonomous community"},
+	{Code: "ES-CM", Name: "Castilla-La Mancha", Type: "Autonomous community"},
+	{Code: "ES-CN", Name: "Canarias", Type: "Autonomous community"},
+	{Code: "ES-CO", Name: "Córdoba", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-CR", Name: "Ciudad Real", Type: "Province", Parent: "ES-CM"},
+	{Code: "ES-CS", Name: "Castelló*", Type: "Province", Parent: "ES-VC"},
+	{Code: "ES-CT", Name: "Catalunya [Cataluña]", Type: "Autonomous community"},
+	{Code: "ES-CU", Name: "Cuenca", Type: "Province", Parent: "ES-CM"},
+	{Code: "ES-EX", Name: "Extremadura", Type: "Autonomous community"},
+	{Code: "ES-GA", Name: "Galicia [Galicia]", Type: "Autonomous community"},
+	{Code: "ES-GC", Name: "Las Palmas", Type: "Province", Parent: "ES-CN"},
+	{Code: "ES-GI", Name: "Girona [Gerona]", Type: "Province", Parent: "ES-CT"},
+	{Code: "ES-GR", Name: "Granada", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-GU", Name: "Guadalajara", Type: "Province", Parent: "ES-CM"},
+	{Code: "ES-H", Name: "Huelva", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-HU", Name: "Huesca", Type: "Province", Parent: "ES-AR"},
+	{Code: "ES-IB", Name: "Illes Balears [Islas Baleares]", Type: "Autonomous community"},
+	{Code: "ES-J", Name: "Jaén", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-L", Name: "Lleida [Lérida]", Type: "Province", Parent: "ES-CT"},
+	{Code: "ES-LE", Name: "León", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-LO", Name: "La Rioja", Type: "Province", Parent: "ES-RI"},
+	{Code: "ES-LU", Name: "Lugo [Lugo]", Type: "Province", Parent: "ES-GA"},
+	{Code: "ES-M", Name: "Madrid", Type: "Province", Parent: "ES-MD"},
+	{Code: "ES-MA", Name: "Málaga", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-MC", Name: "Murcia, Región de", Type: "Autonomous community"},
+	{Code: "ES-MD", Name: "Madrid, Comunidad de", Type: "Autonomous community"},
+	{Code: "ES-ML", Name: "Melilla", Type: "Autonomous city in north africa"},
+	{Code: "ES-MU", Name: "Murcia", Type: "Province", Parent: "ES-MC"},
+	{Code: "ES-NA", Name: "Nafarroa*", Type: "Province", Parent: "ES-NC"},
+	{Code: "ES-NC", Name: "Nafarroako Foru Komunitatea*", Type: "Autonomous community"},
+	{Code: "ES-O", Name: "Asturias", Type: "Province", Parent: "ES-AS"},
+	{Code: "ES-OR", Name: "Ourense [Orense]", Type: "Province", Parent: "ES-GA"},
+	{Code: "ES-P", Name: "Palencia", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-PM", Name: "Illes Balears [Islas Baleares]", Type: "Province", Parent: "ES-IB"},
+	{Code: "ES-PO", Name: "Pontevedra [Pontevedra]", Type: "Province", Parent: "ES-GA"},
+	{Code: "ES-PV", Name: "Euskal Herria", Type: "Autonomous community"},
+	{Code: "ES-RI", Name: "La Rioja", Type: "Autonomous community"},
+	{Code: "ES-S", Name: "Cantabria", Type: "Province", Parent: "ES-CB"},
+	{Code: "ES-SA", Name: "Salamanca", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-SE", Name: "Sevilla", Type: "Province", Parent: "ES-AN"},
+	{Code: "ES-SG", Name: "Segovia", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-SO", Name: "Soria", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-SS", Name: "Gipuzkoa", Type: "Province", Parent: "ES-PV"},
+	{Code: "ES-T", Name: "Tarragona [Tarragona]", Type: "Province", Parent: "ES-CT"},
+	{Code: "ES-TE", Name: "Teruel", Type: "Province", Parent: "ES-AR"},
+	{Code: "ES-TF", Name: "Santa Cruz de Tenerife", Type: "Province", Parent: "ES-CN"},
+	{Code: "ES-TO", Name: "Toledo", Type: "Province", Parent: "ES-CM"},
+	{Code: "ES-V", Name: "Valencia", Type: "Province", Parent: "ES-VC"},
+	{Code: "ES-VA", Name: "Valladolid", Type: "Province", Parent: "ES-CL"},
+	{Code: "ES-VC", Name: "Valenciana, Comunidad", Type: "Autonomous community"},
+	{Code: "ES-VI", Name: "Araba*", Type: "Province", Parent: "ES-PV"},
+	{Code: "ES-Z", Name: "Zaragoza", Type: "Province", Parent: "ES-AR"},
+	{Code: "ES-ZA", Name: "Zamora", Type: "Province", Parent: "ES-CL"},
+	{Code: "ET-AA", Name: "Addis Ababa", Type: "Administration"},
+	{Code: "ET-AF", Name: "Afar", Type: "Regional state"},
+	{Code: "ET-AM", Name: "Amara", Type: "Regional state"},
+	{Code: "ET-BE", Name: "Benshangul-Gumaz", Type: "Regional state"},
+	{Code: "ET-DD", Name: "Dire Dawa", Type: "Administration"},
+	{Code: "ET-GA", Name: "Gambela Peoples", Type: "Regional state"},
+	{Code: "ET-HA", Name: "Harari People", Type: "Regional state"},
+	{Code: "ET-OR", Name: "Oromia", Type: "Regional state"},
+	{Code: "ET-SN", Name: "Southern Nations, Nationalities and Peoples", Type: "Regional state"},
+	{Code: "ET-SO", Name: "Somali", Type: "Regional state"},
+	{Code: "ET-TI", Name: "Tigrai", Type: "Regional state"},
+	{Code: "FI-01", Name: "Åland", Type: "Region"},
+	{Code: "FI-02", Name: "Etelä-Karjala", Type: "Region"},
+	{Code: "FI-03", Name: "Etelä-Pohjanmaa", Type: "Region"},
+	{Code: "FI-04", Name: "Etelä-Savo", Type: "Region"},
+	{Code: "FI-05", Name: "Kainuu", Type: "Region"},
+	{Code: "FI-06", Name: "Kanta-Häme", Type: "Region"},
+	{Code: "FI-07", Name: "Keski-Pohjanmaa", Type: "Region"},
+	{Code: "FI-08", Name: "Keski-Suomi", Type: "Region"},
+	{Code: "FI-09", Name: "Kymenlaakso", Type: "Region"},
+	{Code: "FI-10", Name: "Lappi", Type: "Region"},
+	{Code: "FI-11", Name: "Pirkanmaa", Type: "Region"},
+	{Code: "FI-12", Name: "Pohjanmaa", Type: "Region"},
+	{Code: "FI-13", Name: "Pohjois-Karjala", Type: "Region"},
+	{Code: "FI-14", Name: "Pohjois-Pohjanmaa", Type: "Region"},
+	{Code: "FI-15", Name: "Pohjois-Savo", Type: "Region"},
+	{Code: "FI-16", Name: "Päijät-Häme", Type: "Region"},
+	{Code: "FI-17", Name: "Satakunta", Type: "Region"},
+	{Code: "FI-18", Name: "Uusimaa", Type: "Region"},
+	{Code: "FI-19", Name: "Varsinais-Suomi", Type: "Region"},
+	{Code: "FJ-01", Name: "Ba", Type: "Province", Parent: "FJ-W"},
+	{Code: "FJ-02", Name: "Bua", Type: "Province", Parent: "FJ-N"},
+	{Code: "FJ-03", Name: "Cakaudrove", Type: "Province", Parent: "FJ-N"},
+	{Code: "FJ-04", Name: "Kadavu", Type: "Province", Parent: "FJ-E"},
+	{Code: "FJ-05", Name: "Lau", Type: "Province", Parent: "FJ-E"},
+	{Code: "FJ-06", Name: "Lomaiviti", Type: "Province", Parent: "FJ-E"},
+	{Code: "FJ-07", Name: "Macuata", Type: "Province", Parent: "FJ-N"},
+	{Code: "FJ-08", Name: "Nadroga and Navosa", Type: "Province", Parent: "FJ-W"},
+	{Code: "FJ-09", Name: "Naitasiri", Type: "Province", Parent: "FJ-C"},
+	{Code: "FJ-10", Name: "Namosi", Type: "Province", Parent: "FJ-C"},
+	{Code: "FJ-11", Name: "Ra", Type: "Province", Parent: "FJ-W"},
+	{Code: "FJ-12", Name: "Rewa", Type: "Province", Parent: "FJ-C"},
+	{Code: "FJ-13", Name: "Serua", Type: "Province", Parent: "FJ-C"},
+	{Code: "FJ-14", Name: "Tailevu", Type: "Province", Parent: "FJ-C"},
+	{Code: "FJ-C", Name: "Central", Type: "Division"},
+	{Code: "FJ-E", Name: "Eastern", Type: "Division"},
+	{Code: "FJ-N", Name: "Northern", Type: "Division"},
+	{Code: "FJ-R", Name: "Rotuma", Type: "Dependency"},
+	{Code: "FJ-W", Name: "Western", Type: "Division"},
+	{Code: "FM-KSA", Name: "Kosrae", Type: "State"},
+	{Code: "FM-PNI", Name: "Pohnpei", Type: "State"},
+	{Code: "FM-TRK", Name: "Chuuk", Type: "State"},
+	{Code: "FM-YAP", Name: "Yap", Type: "State"},
+	{Code: "FR-01", Name: "Ain", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-02", Name: "Aisne", Type: "Metropolitan department", Parent: "FR-HDF"},
+	{Code: "FR-03", Name: "Allier", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-04", Name: "Alpes-de-Haute-Provence", Type: "Metropolitan department", Parent: "FR-PAC"},
+	{Code: "FR-05", Name: "Hautes-Alpes", Type: "Metropolitan department", Parent: "FR-PAC"},
+	{Code: "FR-06", Name: "Alpes-Maritimes", Type: "Metropolitan department", Parent: "FR-PAC"},
+	{Code: "FR-07", Name: "Ardèche", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-08", Name: "Ardennes", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-09", Name: "Ariège", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-10", Name: "Aube", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-11", Name: "Aude", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-12", Name: "Aveyron", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-13", Name: "Bouches-du-Rhône", Type: "Metropolitan department", Parent: "FR-PAC"},
+	{Code: "FR-14", Name: "Calvados", Type: "Metropolitan department", Parent: "FR-NOR"},
+	{Code: "FR-15", Name: "Cantal", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-16", Name: "Charente", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-17", Name: "Charente-Maritime", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-18", Name: "Cher", Type: "Metropolitan department", Parent: "FR-CVL"},
+	{Code: "FR-19", Name: "Corrèze", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-20R", Name: "Corse", Type: "Metropolitan collectivity with special status"},
+	{Code: "FR-21", Name: "Côte-d'Or", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-22", Name: "Côtes-d'Armor", Type: "Metropolitan department", Parent: "FR-BRE"},
+	{Code: "FR-23", Name: "Creuse", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-24", Name: "Dordogne", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-25", Name: "Doubs", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-26", Name: "Drôme", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-27", Name: "Eure", Type: "Metropolitan department", Parent: "FR-NOR"},
+	{Code: "FR-28", Name: "Eure-et-Loir", Type: "Metropolitan department", Parent: "FR-CVL"},
+	{Code: "FR-29", Name: "Finistère", Type: "Metropolitan department", Parent: "FR-BRE"},
+	{Code: "FR-2A", Name: "Corse-du-Sud", Type: "Metropolitan department", Parent: "FR-20R"},
+	{Code: "FR-2B", Name: "Haute-Corse", Type: "Metropolitan department", Parent: "FR-20R"},
+	{Code: "FR-30", Name: "Gard", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-31", Name: "Haute-Garonne", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-32", Name: "Gers", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-33", Name: "Gironde", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-34", Name: "Hérault", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-35", Name: "Ille-et-Vilaine", Type: "Metropolitan department", Parent: "FR-BRE"},
+	{Code: "FR-36", Name: "Indre", Type: "Metropolitan department", Parent: "FR-CVL"},
+	{Code: "FR-37", Name: "Indre-et-Loire", Type: "Metropolitan department", Parent: "FR-CVL"},
+	{Code: "FR-38", Name: "Isère", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-39", Name: "Jura", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-40", Name: "Landes", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-41", Name: "Loir-et-Cher", Type: "Metropolitan department", Parent: "FR-CVL"},
+	{Code: "FR-42", Name: "Loire", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-43", Name: "Haute-Loire", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-44", Name: "Loire-Atlantique", Type: "Metropolitan department", Parent: "FR-PDL"},
+	{Code: "FR-45", Name: "Loiret", Type: "Metropolitan department", Parent: "FR-CVL"},
+	{Code: "FR-46", Name: "Lot", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-47", Name: "Lot-et-Garonne", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-48", Name: "Lozère", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-49", Name: "Maine-et-Loire", Type: "Metropolitan department", Parent: "FR-PDL"},
+	{Code: "FR-50", Name: "Manche", Type: "Metropolitan department", Parent: "FR-NOR"},
+	{Code: "FR-51", Name: "Marne", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-52", Name: "Haute-Marne", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-53", Name: "Mayenne", Type: "Metropolitan department", Parent: "FR-PDL"},
+	{Code: "FR-54", Name: "Meurthe-et-Moselle", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-55", Name: "Meuse", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-56", Name: "Morbihan", Type: "Metropolitan department", Parent: "FR-BRE"},
+	{Code: "FR-57", Name: "Moselle", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-58", Name: "Nièvre", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-59", Name: "Nord", Type: "Metropolitan department", Parent: "FR-HDF"},
+	{Code: "FR-60", Name: "Oise", Type: "Metropolitan department", Parent: "FR-HDF"},
+	{Code: "FR-61", Name: "Orne", Type: "Metropolitan department", Parent: "FR-NOR"},
+	{Code: "FR-62", Name: "Pas-de-Calais", Type: "Metropolitan department", Parent: "FR-HDF"},
+	{Code: "FR-63", Name: "Puy-de-Dôme", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-64", Name: "Pyrénées-Atlantiques", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-65", Name: "Hautes-Pyrénées", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-66", Name: "Pyrénées-Orientales", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-67", Name: "Bas-Rhin", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-68", Name: "Haut-Rhin", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-69", Name: "Rhône", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-70", Name: "Haute-Saône", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-71", Name: "Saône-et-Loire", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-72", Name: "Sarthe", Type: "Metropolitan department", Parent: "FR-PDL"},
+	{Code: "FR-73", Name: "Savoie", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-74", Name: "Haute-Savoie", Type: "Metropolitan department", Parent: "FR-ARA"},
+	{Code: "FR-75", Name: "Paris", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-76", Name: "Seine-Maritime", Type: "Metropolitan department", Parent: "FR-NOR"},
+	{Code: "FR-77", Name: "Seine-et-Marne", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-78", Name: "Yvelines", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-79", Name: "Deux-Sèvres", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-80", Name: "Somme", Type: "Metropolitan department", Parent: "FR-HDF"},
+	{Code: "FR-81", Name: "Tarn", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-82", Name: "Tarn-et-Garonne", Type: "Metropolitan department", Parent: "FR-OCC"},
+	{Code: "FR-83", Name: "Var", Type: "Metropolitan department", Parent: "FR-PAC"},
+	{Code: "FR-84", Name: "Vaucluse", Type: "Metropolitan department", Parent: "FR-PAC"},
+	{Code: "FR-85", Name: "Vendée", Type: "Metropolitan department", Parent: "FR-PDL"},
+	{Code: "FR-86", Name: "Vienne", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-87", Name: "Haute-Vienne", Type: "Metropolitan department", Parent: "FR-NAQ"},
+	{Code: "FR-88", Name: "Vosges", Type: "Metropolitan department", Parent: "FR-GES"},
+	{Code: "FR-89", Name: "Yonne", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-90", Name: "Territoire de Belfort", Type: "Metropolitan department", Parent: "FR-BFC"},
+	{Code: "FR-91", Name: "Essonne", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-92", Name: "Hauts-de-Seine", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-93", Name: "Seine-Saint-Denis", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-94", Name: "Val-de-Marne", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-95", Name: "Val-d'Oise", Type: "Metropolitan department", Parent: "FR-IDF"},
+	{Code: "FR-971", Name: "Guadeloupe", Type: "Overseas department", Parent: "FR-GP"},
+	{Code: "FR-972", Name: "Martinique", Type: "Overseas department", Parent: "FR-MQ"},
+	{Code: "FR-973", Name: "Guyane (française)", Type: "Overseas department", Parent: "FR-GF"},
+	{Code: "FR-974", Name: "La Réunion", Type: "Overseas department", Parent: "FR-RE"},
+	{Code: "FR-976", Name: "Mayotte", Type: "Overseas department", Parent: "FR-YT"},
+	{Code: "FR-ARA", Name: "Auvergne-Rhône-Alpes", Type: "Metropolitan region"},
+	{Code: "FR-BFC", Name: "Bourgogne-Franche-Comté", Type: "Metropolitan region"},
+	{Code: "FR-BL", Name: "Saint-Barthélemy", Type: "Overseas collectivity"},
+	{Code: "FR-BRE", Name: "Bretagne", Type: "Metropolitan region"},
+	{Code: "FR-CP", Name: "Clipperton", Type: "Dependency"},
+	{Code: "FR-CVL", Name: "Centre-Val de Loire", Type: "Metropolitan region"},
+	{Code: "FR-GES", Name: "Grand-Est", Type: "Metropolitan region"},
+	{Code: "FR-GF", Name: "Guyane (française)", Type: "Overseas region"},
+	{Code: "FR-GP", Name: "Guadeloupe", Type: "Overseas region"},
+	{Code: "FR-HDF", Name: "Hauts-de-France", Type: "Metropolitan region"},
+	{Code: "FR-IDF", Name: "Île-de-France", Type: "Metropolitan region"},
+	{Code: "FR-MF", Name: "Saint-Martin", Type: "Overseas collectivity"},
+	{Code: "FR-MQ", Name: "Martinique", Type: "Overseas region"},
+	{Code: "FR-NAQ", Name: "Nouvelle-Aquitaine", Type: "Metropolitan region"},
+	{Code: "FR-NC", Name: "Nouvelle-Calédonie", Type: "Overseas collectivity with special status"},
+	{Code: "FR-NOR", Name: "Normandie", Type: "Metropolitan region"},
+	{Code: "FR-OCC", Name: "Occitanie", Type: "Metropolitan region"},
+	{Code: "FR-PAC", Name: "Provence-Alpes-Côte-d’Azur", Type: "Metropolitan region"},
+	{Code: "FR-PDL", Name: "Pays-de-la-Loire", Type: "Metropolitan region"},
+	{Code: "FR-PF", Name: "Polynésie française", Type: "Overseas collectivity"},
+	{Code: "FR-PM", Name: "Saint-Pierre-et-Miquelon", Type: "Overseas collectivity"},
+	{Code: "FR-RE", Name: "La Réunion", Type: "Overseas region"},
+	{Code: "FR-TF", Name: "Terres australes françaises", Type: "Overseas territory"},
+	{Code: "FR-WF", Name: "Wallis-et-Futuna", Type: "Overseas collectivity"},
+	{Code: "FR-YT", Name: "Mayotte", Type: "Overseas region"},
+	{Code: "GA-1", Name: "Estuaire", Type: "Province"},
+	{Code: "GA-2", Name: "Haut-Ogooué", Type: "Province"},
+	{Code: "GA-3", Name: "Moyen-Ogooué", Type: "Province"},
+	{Code: "GA-4", Name: "Ngounié", Type: "Province"},
+	{Code: "GA-5", Name: "Nyanga", Type: "Province"},
+	{Code: "GA-6", Name: "Ogooué-Ivindo", Type: "Province"},
+	{Code: "GA-7", Name: "Ogooué-Lolo", Type: "Province"},
+	{Code: "GA-8", Name: "Ogooué-Maritime", Type: "Province"},
+	{Code: "GA-9", Name: "Woleu-Ntem", Type: "Province"},
+	{Code: "GB-ABC", Name: "Armagh City, Banbridge and Craigavon", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-ABD", Name: "Aberdeenshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-ABE", Name: "Aberdeen City", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-AGB", Name: "Argyll and Bute", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-AGY", Name: "Isle of Anglesey [Sir Ynys Môn GB-YNM]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-AND", Name: "Ards and North Down", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-ANN", Name: "Antrim and Newtownabbey", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-ANS", Name: "Angus", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-BAS", Name: "Bath and North East Somerset", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BBD", Name: "Blackburn with Darwen", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BCP", Name: "Bournemouth, Christchurch and Poole", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BDF", Name: "Bedford", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BDG", Name: "Barking and Dagenham", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-BEN", Name: "Brent", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-BEX", Name: "Bexley", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-BFS", Name: "Belfast City", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-BGE", Name: "Bridgend [Pen-y-bont ar Ogwr GB-POG]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-BGW", Name: "Blaenau Gwent", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-BIR", Name: "Birmingham", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-BKM", Name: "Buckinghamshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-BNE", Name: "Barnet", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-BNH", Name: "Brighton and Hove", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BNS", Name: "Barnsley", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-BOL", Name: "Bolton", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-BPL", Name: "Blackpool", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BRC", Name: "Bracknell Forest", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BRD", Name: "Bradford", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-BRY", Name: "Bromley", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-BST", Name: "Bristol, City of", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-BUR", Name: "Bury", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-CAM", Name: "Cambridgeshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-CAY", Name: "Caerphilly [Caerffili GB-CAF]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-CBF", Name: "Central Bedfordshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-CCG", Name: "Causeway Coast and Glens", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-CGN", Name: "Ceredigion [Sir Ceredigion]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-CHE", Name: "Cheshire East", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-CHW", Name: "Cheshire West and Chester", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-CLD", Name: "Calderdale", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-CLK", Name: "Clackmannanshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-CMA", Name: "Cumbria", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-CMD", Name: "Camden", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-CMN", Name: "Carmarthenshire [Sir Gaerfyrddin GB-GFY]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-CON", Name: "Cornwall", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-COV", Name: "Coventry", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-CRF", Name: "Cardiff [Caerdydd GB-CRD]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-CRY", Name: "Croydon", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-CWY", Name: "Conwy", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-DAL", Name: "Darlington", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-DBY", Name: "Derbyshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-DEN", Name: "Denbighshire [Sir Ddinbych GB-DDB]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-DER", Name: "Derby", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-DEV", Name: "Devon", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-DGY", Name: "Dumfries and Galloway", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-DNC", Name: "Doncaster", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-DND", Name: "Dundee City", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-DOR", Name: "Dorset", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-DRS", Name: "Derry and Strabane", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-DUD", Name: "Dudley", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-DUR", Name: "Durham, County", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-EAL", Name: "Ealing", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-EAY", Name: "East Ayrshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-EDH", Name: "Edinburgh, City of", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-EDU", Name: "East Dunbartonshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-ELN", Name: "East Lothian", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-ELS", Name: "Eilean Siar", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-ENF", Name: "Enfield", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-ENG", Name: "England", Type: "Country"},
+	{Code: "GB-ERW", Name: "East Renfrewshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-ERY", Name: "East Riding of Yorkshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-ESS", Name: "Essex", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-ESX", Name: "East Sussex", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-FAL", Name: "Falkirk", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-FIF", Name: "Fife", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-FLN", Name: "Flintshire [Sir y Fflint GB-FFL]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-FMO", Name: "Fermanagh and Omagh", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-GAT", Name: "Gateshead", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-GLG", Name: "Glasgow City", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-GLS", Name: "Gloucestershire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-GRE", Name: "Greenwich", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-GWN", Name: "Gwynedd", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-HAL", Name: "Halton", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-HAM", Name: "Hampshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-HAV", Name: "Havering", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-HCK", Name: "Hackney", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-HEF", Name: "Herefordshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-HIL", Name: "Hillingdon", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-HLD", Name: "Highland", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-HMF", Name: "Hammersmith and Fulham", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-HNS", Name: "Hounslow", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-HPL", Name: "Hartlepool", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-HRT", Name: "Hertfordshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-HRW", Name: "Harrow", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-HRY", Name: "Haringey", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-IOS", Name: "Isles of Scilly", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-IOW", Name: "Isle of Wight", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-ISL", Name: "Islington", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-IVC", Name: "Inverclyde", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-KEC", Name: "Kensington and Chelsea", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-KEN", Name: "Kent", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-KHL", Name: "Kingston upon Hull", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-KIR", Name: "Kirklees", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-KTT", Name: "Kingston upon Thames", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-KWL", Name: "Knowsley", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-LAN", Name: "Lancashire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-LBC", Name: "Lisburn and Castlereagh", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-LBH", Name: "Lambeth", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-LCE", Name: "Leicester", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-LDS", Name: "Leeds", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-LEC", Name: "Leicestershire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-LEW", Name: "Lewisham", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-LIN", Name: "Lincolnshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-LIV", Name: "Liverpool", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-LND", Name: "London, City of", Type: "City corporation", Parent: "GB-GB-ENG"},
+	{Code: "GB-LUT", Name: "Luton", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-MAN", Name: "Manchester", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-MDB", Name: "Middlesbrough", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-MDW", Name: "Medway", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-MEA", Name: "Mid and East Antrim", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-MIK", Name: "Milton Keynes", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-MLN", Name: "Midlothian", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-MON", Name: "Monmouthshire [Sir Fynwy GB-FYN]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-MRT", Name: "Merton", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-MRY", Name: "Moray", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-MTY", Name: "Merthyr Tydfil [Merthyr Tudful GB-MTU]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-MUL", Name: "Mid-Ulster", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-NAY", Name: "North Ayrshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-NBL", Name: "Northumberland", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-NEL", Name: "North East Lincolnshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-NET", Name: "Newcastle upon Tyne", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-NFK", Name: "Norfolk", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-NGM", Name: "Nottingham", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-NIR", Name: "Northern Ireland", Type: "Province"},
+	{Code: "GB-NLK", Name: "North Lanarkshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-NLN", Name: "North Lincolnshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-NMD", Name: "Newry, Mourne and Down", Type: "District", Parent: "GB-GB-NIR"},
+	{Code: "GB-NSM", Name: "North Somerset", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-NTH", Name: "Northamptonshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-NTL", Name: "Neath Port Talbot [Castell-nedd Port Talbot GB-CTL]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-NTT", Name: "Nottinghamshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-NTY", Name: "North Tyneside", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-NWM", Name: "Newham", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-NWP", Name: "Newport [Casnewydd GB-CNW]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-NYK", Name: "North Yorkshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-OLD", Name: "Oldham", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-ORK", Name: "Orkney Islands", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-OXF", Name: "Oxfordshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-PEM", Name: "Pembrokeshire [Sir Benfro GB-BNF]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-PKN", Name: "Perth and Kinross", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-PLY", Name: "Plymouth", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-POR", Name: "Portsmouth", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-POW", Name: "Powys", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-PTE", Name: "Peterborough", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-RCC", Name: "Redcar and Cleveland", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-RCH", Name: "Rochdale", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-RCT", Name: "Rhondda Cynon Taff [Rhondda CynonTaf]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-RDB", Name: "Redbridge", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-RDG", Name: "Reading", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-RFW", Name: "Renfrewshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-RIC", Name: "Richmond upon Thames", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-ROT", Name: "Rotherham", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-RUT", Name: "Rutland", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-SAW", Name: "Sandwell", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SAY", Name: "South Ayrshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-SCB", Name: "Scottish Borders", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-SCT", Name: "Scotland", Type: "Country"},
+	{Code: "GB-SFK", Name: "Suffolk", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-SFT", Name: "Sefton", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SGC", Name: "South Gloucestershire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-SHF", Name: "Sheffield", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SHN", Name: "St. Helens", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SHR", Name: "Shropshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-SKP", Name: "Stockport", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SLF", Name: "Salford", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SLG", Name: "Slough", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-SLK", Name: "South Lanarkshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-SND", Name: "Sunderland", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SOL", Name: "Solihull", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SOM", Name: "Somerset", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-SOS", Name: "Southend-on-Sea", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-SRY", Name: "Surrey", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-STE", Name: "Stoke-on-Trent", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-STG", Name: "Stirling", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-STH", Name: "Southampton", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-STN", Name: "Sutton", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-STS", Name: "Staffordshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-STT", Name: "Stockton-on-Tees", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-STY", Name: "South Tyneside", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-SWA", Name: "Swansea [Abertawe GB-ATA]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-SWD", Name: "Swindon", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-SWK", Name: "Southwark", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-TAM", Name: "Tameside", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-TFW", Name: "Telford and Wrekin", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-THR", Name: "Thurrock", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-TOB", Name: "Torbay", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-TOF", Name: "Torfaen [Tor-faen]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-TRF", Name: "Trafford", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-TWH", Name: "Tower Hamlets", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-VGL", Name: "Vale of Glamorgan, The [Bro Morgannwg GB-BMG]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-WAR", Name: "Warwickshire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-WBK", Name: "West Berkshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-WDU", Name: "West Dunbartonshire", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-WFT", Name: "Waltham Forest", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-WGN", Name: "Wigan", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-WIL", Name: "Wiltshire", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-WKF", Name: "Wakefield", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-WLL", Name: "Walsall", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-WLN", Name: "West Lothian", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GB-WLS", Name: "Wales [Cymru GB-CYM]", Type: "Country"},
+	{Code: "GB-WLV", Name: "Wolverhampton", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-WND", Name: "Wandsworth", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-WNM", Name: "Windsor and Maidenhead", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-WOK", Name: "Wokingham", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-WOR", Name: "Worcestershire", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-WRL", Name: "Wirral", Type: "Metropolitan district", Parent: "GB-GB-ENG"},
+	{Code: "GB-WRT", Name: "Warrington", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-WRX", Name: "Wrexham [Wrecsam GB-WRC]", Type: "Unitary authority", Parent: "GB-GB-WLS"},
+	{Code: "GB-WSM", Name: "Westminster", Type: "London borough", Parent: "GB-GB-ENG"},
+	{Code: "GB-WSX", Name: "West Sussex", Type: "Two-tier county", Parent: "GB-GB-ENG"},
+	{Code: "GB-YOR", Name: "York", Type: "Unitary authority", Parent: "GB-GB-ENG"},
+	{Code: "GB-ZET", Name: "Shetland Islands", Type: "Council area", Parent: "GB-GB-SCT"},
+	{Code: "GD-01", Name: "Saint Andrew", Type: "Parish"},
+	{Code: "GD-02", Name: "Saint David", Type: "Parish"},
+	{Code: "GD-03", Name: "Saint George", Type: "Parish"},
+	{Code: "GD-04", Name: "Saint John", Type: "Parish"},
+	{Code: "GD-05", Name: "Saint Mark", Type: "Parish"},
+	{Code: "GD-06", Name: "Saint Patrick", Type: "Parish"},
+	{Code: "GD-10", Name: "Southern Grenadine Islands", Type: "Dependency"},
+	{Code: "GE-AB", Name: "Abkhazia", Type: "Autonomous republic"},
+	{Code: "GE-AJ", Name: "Ajaria", Type: "Autonomous republic"},
+	{Code: "GE-GU", Name: "Guria", Type: "Region"},
+	{Code: "GE-IM", Name: "Imereti", Type: "Region"},
+	{Code: "GE-KA", Name: "K'akheti", Type: "Region"},
+	{Code: "GE-KK", Name: "Kvemo Kartli", Type: "Region"},
+	{Code: "GE-MM", Name: "Mtskheta-Mtianeti", Type: "Region"},
+	{Code: "GE-RL", Name: "Rach'a-Lechkhumi-Kvemo Svaneti", Type: "Region"},
+	{Code: "GE-SJ", Name: "Samtskhe-Javakheti", Type: "Region"},
+	{Code: "GE-SK", Name: "Shida Kartli", Type: "Region"},
+	{Code: "GE-SZ", Name: "Samegrelo-Zemo Svaneti", Type: "Region"},
+	{Code: "GE-TB", Name: "Tbilisi", Type: "City"},
+	{Code: "GH-AA", Name: "Greater Accra", Type: "Region"},
+	{Code: "GH-AF", Name: "Ahafo", Type: "Region"},
+	{Code: "GH-AH", Name: "Ashanti", Type: "Region"},
+	{Code: "GH-BE", Name: "Bono East", Type: "Region"},
+	{Code: "GH-BO", Name: "Bono", Type: "Region"},
+	{Code: "GH-CP", Name: "Central", Type: "Region"},
+	{Code: "GH-EP", Name: "Eastern", Type: "Region"},
+	{Code: "GH-NE", Name: "North East", Type: "Region"},
+	{Code: "GH-NP", Name: "Northern", Type: "Region"},
+	{Code: "GH-OT", Name: "Oti", Type: "Region"},
+	{Code: "GH-SV", Name: "Savannah", Type: "Region"},
+	{Code: "GH-TV", Name: "Volta", Type: "Region"},
+	{Code: "GH-UE", Name: "Upper East", Type: "Region"},
+	{Code: "GH-UW", Name: "Upper West", Type: "Region"},
+	{Code: "GH-WN", Name: "Western North", Type: "Region"},
+	{Code: "GH-WP", Name: "Western", Type: "Region"},
+	{Code: "GL-AV", Name: "Avannaata Kommunia", Type: "Municipality"},
+	{Code: "GL-KU", Name: "Kommune Kujalleq", Type: "Municipality"},
+	{Code: "GL-QE", Name: "Qeqqata Kommunia", Type: "Municipality"},
+	{Code: "GL-QT", Name: "Kommune Qeqertalik", Type: "Municipality"},
+	{Code: "GL-SM", Name: "Kommuneqarfik Sermersooq", Type: "Municipality"},
+	{Code: "GM-B", Name: "Banjul", Type: "City"},
+	{Code: "GM-L", Name: "Lower River", Type: "Division"},
+	{Code: "GM-M", Name: "Central River", Type: "Division"},
+	{Code: "GM-N", Name: "North Bank", Type: "Division"},
+	{Code: "GM-U", Name: "Upper River", Type: "Division"},
+	{Code: "GM-W", Name: "Western", Type: "Division"},
+	{Code: "GN-B", Name: "Boké", Type: "Administrative region"},
+	{Code: "GN-BE", Name: "Beyla", Type: "Prefecture", Parent: "GN-N"},
+	{Code: "GN-BF", Name: "Boffa", Type: "Prefecture", Parent: "GN-B"},
+	{Code: "GN-BK", Name: "Boké", Type: "Prefecture", Parent: "GN-B"},
+	{Code: "GN-C", Name: "Conakry", Type: "Governorate"},
+	{Code: "GN-CO", Name: "Coyah", Type: "Prefecture", Parent: "GN-D"},
+	{Code: "GN-D", Name: "Kindia", Type: "Administrative region"},
+	{Code: "GN-DB", Name: "Dabola", Type: "Prefecture", Parent: "GN-F"},
+	{Code: "GN-DI", Name: "Dinguiraye", Type: "Prefecture", Parent: "GN-F"},
+	{Code: "GN-DL", Name: "Dalaba", Type: "Prefecture", Parent: "GN-M"},
+	{Code: "GN-DU", Name: "Dubréka", Type: "Prefecture", Parent: "GN-D"},
+	{Code: "GN-F", Name: "Faranah", Type: "Administrative region"},
+	{Code: "GN-FA", Name: "Faranah", Type: "Prefecture", Parent: "GN-F"},
+	{Code: "GN-FO", Name: "Forécariah", Type: "Prefecture", Parent: "GN-D"},
+	{Code: "GN-FR", Name: "Fria", Type: "Prefecture", Parent: "GN-B"},
+	{Code: "GN-GA", Name: "Gaoual", Type: "Prefecture", Parent: "GN-B"},
+	{Code: "GN-GU", Name: "Guékédou", Type: "Prefecture", Parent: "GN-N"},
+	{Code: "GN-K", Name: "Kankan", Type: "Administrative region"},
+	{Code: "GN-KA", Name: "Kankan", Type: "Prefecture", Parent: "GN-K"},
+	{Code: "GN-KB", Name: "Koubia", Type: "Prefecture", Parent: "GN-L"},
+	{Code: "GN-KD", Name: "Kindia", Type: "Prefecture", Parent: "GN-D"},
+	{Code: "GN-KE", Name: "Kérouané", Type: "Prefecture", Parent: "GN-K"},
+	{Code: "GN-KN", Name: "Koundara", Type: "Prefecture", Parent: "GN-B"},
+	{Code: "GN-KO", Name: "Kouroussa", Type: "Prefecture", Parent: "GN-K"},
+	{Code: "GN-KS", Name: "Kissidougou", Type: "Prefecture", Parent: "GN-F"},
+	{Code: "GN-L", Name: "Labé", Type: "Administrative region"},
+	{Code: "GN-LA", Name: "Labé", Type: "Prefecture", Parent: "GN-L"},
+	{Code: "GN-LE", Name: "Lélouma", Type: "Prefecture", Parent: "GN-L"},
+	{Code: "GN-LO", Name: "Lola", Type: "Prefecture", Parent: "GN-N"},
+	{Code: "GN-M", Name: "Mamou", Type: "Administrative region"},
+	{Code: "GN-MC", Name: "Macenta", Type: "Prefecture", Parent: "GN-N"},
+	{Code: "GN-MD", Name: "Mandiana", Type: "Prefecture", Parent: "GN-K"},
+	{Code: "GN-ML", Name: "Mali", Type: "Prefecture", Parent: "GN-L"},
+	{Code: "GN-MM", Name: "Mamou", Type: "Prefecture", Parent: "GN-M"},
+	{Code: "GN-N", Name: "Nzérékoré", Type: "Administrative region"},
+	{Code: "GN-NZ", Name: "Nzérékoré", Type: "Prefecture", Parent: "GN-N"},
+	{Code: "GN-PI", Name: "Pita", Type: "Prefecture", Parent: "GN-M"},
+	{Code: "GN-SI", Name: "Siguiri", Type: "Prefecture", Parent: "GN-K"},
+	{Code: "GN-TE", Name: "Télimélé", Type: "Prefecture", Parent: "GN-D"},
+	{Code: "GN-TO", Name: "Tougué", Type: "Prefecture", Parent: "GN-L"},
+	{Code: "GN-YO", Name: "Yomou", Type: "Prefecture", Parent: "GN-N"},
+	{Code: "GQ-AN", Name: "Annobon", Type: "Province", Parent: "GQ-I"},
+	{Code: "GQ-BN", Name: "Bioko Nord", Type: "Province", Parent: "GQ-I"},
+	{Code: "GQ-BS", Name: "Bioko Sud", Type: "Province", Parent: "GQ-I"},
+	{Code: "GQ-C", Name: "Região Continental", Type: "Region"},
+	{Code: "GQ-CS", Name: "Centro Sud", Type: "Province", Parent: "GQ-C"},
+	{Code: "GQ-DJ", Name: "Djibloho", Type: "Province", Parent: "GQ-C"},
+	{Code: "GQ-I", Name: "Região Insular", Type: "Region"},
+	{Code: "GQ-KN", Name: "Kié-Ntem", Type: "Province", Parent: "GQ-C"},
+	{Code: "GQ-LI", Name: "Litoral", Type: "Province", Parent: "GQ-C"},
+	{Code: "GQ-WN", Name: "Wele-Nzas", Type: "Province", Parent: "GQ-C"},
+	{Code: "GR-69", Name: "Ágion Óros", Type: "Self-governed part"},
+	{Code: "GR-A", Name: "Anatolikí Makedonía kai Thráki", Type: "Administrative region"},
+	{Code: "GR-B", Name: "Kentrikí Makedonía", Type: "Administrative region"},
+	{Code: "GR-C", Name: "Dytikí Makedonía", Type: "Administrative region"},
+	{Code: "GR-D", Name: "Ípeiros", Type: "Administrative region"},
+	{Code: "GR-E", Name: "Thessalía", Type: "Administrative region"},
+	{Code: "GR-F", Name: "Ionía Nísia", Type: "Administrative region"},
+	{Code: "GR-G", Name: "Dytikí Elláda", Type: "Administrative region"},
+	{Code: "GR-H", Name: "Stereá Elláda", Type: "Administrative region"},
+	{Code: "GR-I", Name: "Attikí", Type: "Administrative region"},
+	{Code: "GR-J", Name: "Pelopónnisos", Type: "Administrative region"},
+	{Code: "GR-K", Name: "Vóreio Aigaío", Type: "Administrative region"},
+	{Code: "GR-L", Name: "Nótio Aigaío", Type: "Administrative region"},
+	{Code: "GR-M", Name: "Kríti", Type: "Administrative region"},
+	{Code: "GT-AV", Name: "Alta Verapaz", Type: "Department"},
+	{Code: "GT-BV", Name: "Baja Verapaz", Type: "Department"},
+	{Code: "GT-CM", Name: "Chimaltenango", Type: "Department"},
+	{Code: "GT-CQ", Name: "Chiquimula", Type: "Department"},
+	{Code: "GT-ES", Name: "Escuintla", Type: "Department"},
+	{Code: "GT-GU", Name: "Guatemala", Type: "Department"},
+	{Code: "GT-HU", Name: "Huehuetenango", Type: "Department"},
+	{Code: "GT-IZ", Name: "Izabal", Type: "Department"},
+	{Code: "GT-JA", Name: "Jalapa", Type: "Department"},
+	{Code: "GT-JU", Name: "Jutiapa", Type: "Department"},
+	{Code: "GT-PE", Name: "Petén", Type: "Department"},
+	{Code: "GT-PR", Name: "El Progreso", Type: "Department"},
+	{Code: "GT-QC", Name: "Quiché", Type: "Department"},
+	{Code: "GT-QZ", Name: "Quetzaltenango", Type: "Department"},
+	{Code: "GT-RE", Name: "Retalhuleu", Type: "Department"},
+	{Code: "GT-SA", Name: "Sacatepéquez", Type: "Department"},
+	{Code: "GT-SM", Name: "San Marcos", Type: "Department"},
+	{Code: "GT-SO", Name: "Sololá", Type: "Department"},
+	{Code: "GT-SR", Name: "Santa Rosa", Type: "Department"},
+	{Code: "GT-SU", Name: "Suchitepéquez", Type: "Department"},
+	{Code: "GT-TO", Name: "Totonicapán", Type: "Department"},
+	{Code: "GT-ZA", Name: "Zacapa", Type: "Department"},
+	{Code: "GW-BA", Name: "Bafatá", Type: "Region", Parent: "GW-L"},
+	{Code: "GW-BL", Name: "Bolama / Bijagós", Type: "Region", Parent: "GW-S"},
+	{Code: "GW-BM", Name: "Biombo", Type: "Region", Parent: "GW-N"},
+	{Code: "GW-BS", Name: "Bissau", Type: "Autonomous sector"},
+	{Code: "GW-CA", Name: "Cacheu", Type: "Region", Parent: "GW-N"},
+	{Code: "GW-GA", Name: "Gabú", Type: "Region", Parent: "GW-L"},
+	{Code: "GW-L", Name: "Leste", Type: "Province"},
+	{Code: "GW-N", Name: "Norte", Type: "Province"},
+	{Code: "GW-OI", Name: "Oio", Type: "Region", Parent: "GW-N"},
+	{Code: "GW-QU", Name: "Quinara", Type: "Region", Parent: "GW-S"},
+	{Code: "GW-S", Name: "Sul", Type: "Province"},
+	{Code: "GW-TO", Name: "Tombali", Type: "Region", Parent: "GW-S"},
+	{Code: "GY-BA", Name: "Barima-Waini", Type: "Region"},
+	{Code: "GY-CU", Name: "Cuyuni-Mazaruni", Type: "Region"},
+	{Code: "GY-DE", Name: "Demerara-Mahaica", Type: "Region"},
+	{Code: "GY-EB", Name: "East Berbice-Corentyne", Type: "Region"},
+	{Code: "GY-ES", Name: "Essequibo Islands-West Demerara", Type: "Region"},
+	{Code: "GY-MA", Name: "Mahaica-Berbice", Type: "Region"},
+	{Code: "GY-PM", Name: "Pomeroon-Supenaam", Type: "Region"},
+	{Code: "GY-PT", Name: "Potaro-Siparuni", Type: "Region"},
+	{Code: "GY-UD", Name: "Upper Demerara-Berbice", Type: "Region"},
+	{Code: "GY-UT", Name: "Upper Takutu-Upper Essequibo", Type: "Region"},
+	{Code: "HN-AT", Name: "Atlántida", Type: "Department"},
+	{Code: "HN-CH", Name: "Choluteca", Type: "Department"},
+	{Code: "HN-CL", Name: "Colón", Type: "Department"},
+	{Code: "HN-CM", Name: "Comayagua", Type: "Department"},
+	{Code: "HN-CP", Name: "Copán", Type: "Department"},
+	{Code: "HN-CR", Name: "Cortés", Type: "Department"},
+	{Code: "HN-EP", Name: "El Paraíso", Type: "Department"},
+	{Code: "HN-FM", Name: "Francisco Morazán", Type: "Department"},
+	{Code: "HN-GD", Name: "Gracias a Dios", Type: "Department"},
+	{Code: "HN-IB", Name: "Islas de la Bahía", Type: "Department"},
+	{Code: "HN-IN", Name: "Intibucá", Type: "Department"},
+	{Code: "HN-LE", Name: "Lempira", Type: "Department"},
+	{Code: "HN-LP", Name: "La Paz", Type: "Department"},
+	{Code: "HN-OC", Name: "Ocotepeque", Type: "Department"},
+	{Code: "HN-OL", Name: "Olancho", Type: "Department"},
+	{Code: "HN-SB", Name: "Santa Bárbara", Type: "Department"},
+	{Code: "HN-VA", Name: "Valle", Type: "Department"},
+	{Code: "HN-YO", Name: "Yoro", Type: "Department"},
+	{Code: "HR-01", Name: "Zagrebačka županija", Type: "County"},
+	{Code: "HR-02", Name: "Krapinsko-zagorska županija", Type: "County"},
+	{Code: "HR-03", Name: "Sisačko-moslavačka županija", Type: "County"},
+	{Code: "HR-04", Name: "Karlovačka županija", Type: "County"},
+	{Code: "HR-05", Name: "Varaždinska županija", Type: "County"},
+	{Code: "HR-06", Name: "Koprivničko-križevačka županija", Type: "County"},
+	{Code: "HR-07", Name: "Bjelovarsko-bilogorska županija", Type: "County"},
+	{Code: "HR-08", Name: "Primorsko-goranska županija", Type: "County"},
+	{Code: "HR-09", Name: "Ličko-senjska županija", Type: "County"},
+	{Code: "HR-10", Name: "Virovitičko-podravska županija", Type: "County"},
+	{Code: "HR-11", Name: "Požeško-slavonska županija", Type: "County"},
+	{Code: "HR-12", Name: "Brodsko-posavska županija", Type: "County"},
+	{Code: "HR-13", Name: "Zadarska županija", Type: "County"},
+	{Code: "HR-14", Name: "Osječko-baranjska županija", Type: "County"},
+	{Code: "HR-15", Name: "Šibensko-kninska županija", Type: "County"},
+	{Code: "HR-16", Name: "Vukovarsko-srijemska županija", Type: "County"},
+	{Code: "HR-17", Name: "Splitsko-dalmatinska županija", Type: "County"},
+	{Code: "HR-18", Name: "Istarska županija", Type: "County"},
+	{Code: "HR-19", Name: "Dubrovačko-neretvanska županija", Type: "County"},
+	{Code: "HR-20", Name: "Međimurska županija", Type: "County"},
+	{Code: "HR-21", Name: "Grad Zagreb", Type: "City"},
+	{Code: "HT-AR", Name: "Artibonite", Type: "Department"},
+	{Code: "HT-CE", Name: "Centre", Type: "Department"},
+	{Code: "HT-GA", Name: "Grandans", Type: "Department"},
+	{Code: "HT-ND", Name: "Nord", Type: "Department"},
+	{Code: "HT-NE", Name: "Nord-Est", Type: "Department"},
+	{Code: "HT-NI", Name: "Nip", Type: "Department"},
+	{Code: "HT-NO", Name: "Nord-Ouest", Type: "Department"},
+	{Code: "HT-OU", Name: "Lwès", Type: "Department"},
+	{Code: "HT-SD", Name: "Sid", Type: "Department"},
+	{Code: "HT-SE", Name: "Sidès", Type: "Department"},
+	{Code: "HU-BA", Name: "Baranya", Type: "County"},
+	{Code: "HU-BC", Name: "Békéscsaba", Type: "City with county rights"},
+	{Code: "HU-BE", Name: "Békés", Type: "County"},
+	{Code: "HU-BK", Name: "Bács-Kiskun", Type: "County"},
+	{Code: "HU-BU", Name: "Budapest", Type: "Capital city"},
+	{Code: "HU-BZ", Name: "Borsod-Abaúj-Zemplén", Type: "County"},
+	{Code: "HU-CS", Name: "Csongrád", Type: "County"},
+	{Code: "HU-DE", Name: "Debrecen", Type: "City with county rights"},
+	{Code: "HU-DU", Name: "Dunaújváros", Type: "City with county rights"},
+	{Code: "HU-EG", Name: "Eger", Type: "City with county rights"},
+	{Code: "HU-ER", Name: "Érd", Type: "City with county rights"},
+	{Code: "HU-FE", Name: "Fejér", Type: "County"},
+	{Code: "HU-GS", Name: "Győr-Moson-Sopron", Type: "County"},
+	{Code: "HU-GY", Name: "Győr", Type: "City with county rights"},
+	{Code: "HU-HB", Name: "Hajdú-Bihar", Type: "County"},
+	{Code: "HU-HE", Name: "Heves", Type: "County"},
+	{Code: "HU-HV", Name: "Hódmezővásárhely", Type: "City with county rights"},
+	{Code: "HU-JN", Name: "Jász-Nagykun-Szolnok", Type: "County"},
+	{Code: "HU-KE", Name: "Komárom-Esztergom", Type: "County"},
+	{Code: "HU-KM", Name: "Kecskemét", Type: "City with county rights"},
+	{Code: "HU-KV", Name: "Kaposvár", Type: "City with county rights"},
+	{Code: "HU-MI", Name: "Miskolc", Type: "City with county rights"},
+	{Code: "HU-NK", Name: "Nagykanizsa", Type: "City with county rights"},
+	{Code: "HU-NO", Name: "Nógrád", Type: "County"},
+	{Code: "HU-NY", Name: "Nyíregyháza", Type: "City with county rights"},
+	{Code: "HU-PE", Name: "Pest", Type: "County"},
+	{Code: "HU-PS", Name: "Pécs", Type: "City with county rights"},
+	{Code: "HU-SD", Name: "Szeged", Type: "City with county rights"},
+	{Code: "HU-SF", Name: "Székesfehérvár", Type: "City with county rights"},
+	{Code: "HU-SH", Name: "Szombathely", Type: "City with county rights"},
+	{Code: "HU-SK", Name: "Szolnok", Type: "City with county rights"},
+	{Code: "HU-SN", Name: "Sopron", Type: "City with county rights"},
+	{Code: "HU-SO", Name: "Somogy", Type: "County"},
+	{Code: "HU-SS", Name: "Szekszárd", Type: "City with county rights"},
+	{Code: "HU-ST", Name: "Salgótarján", Type: "City with county rights"},
+	{Code: "HU-SZ", Name: "Szabolcs-Szatmár-Bereg", Type: "County"},
+	{Code: "HU-TB", Name: "Tatabánya", Type: "City with county rights"},
+	{Code: "HU-TO", Name: "Tolna", Type: "County"},
+	{Code: "HU-VA", Name: "Vas", Type: "County"},
+	{Code: "HU-VE", Name: "Veszprém", Type: "County"},
+	{Code: "HU-VM", Name: "Veszprém", Type: "City with county rights"},
+	{Code: "HU-ZA", Name: "Zala", Type: "County"},
+	{Code: "HU-ZE", Name: "Zalaegerszeg", Type: "City with county rights"},
+	{Code: "ID-AC", Name: "Aceh", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-BA", Name: "Bali", Type: "Province", Parent: "ID-NU"},
+	{Code: "ID-BB", Name: "Kepulauan Bangka Belitung", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-BE", Name: "Bengkulu", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-BT", Name: "Banten", Type: "Province", Parent: "ID-JW"},
+	{Code: "ID-GO", Name: "Gorontalo", Type: "Province", Parent: "ID-SL"},
+	{Code: "ID-JA", Name: "Jambi", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-JB", Name: "Jawa Barat", Type: "Province", Parent: "ID-JW"},
+	{Code: "ID-JI", Name: "Jawa Timur", Type: "Province", Parent: "ID-JW"},
+	{Code: "ID-JK", Name: "Jakarta Raya", Type: "Capital district", Parent: "ID-JW"},
+	{Code: "ID-JT", Name: "Jawa Tengah", Type: "Province", Parent: "ID-JW"},
+	{Code: "ID-JW", Name: "Jawa", Type: "Geographical unit"},
+	{Code: "ID-KA", Name: "Kalimantan", Type: "Geographical unit"},
+	{Code: "ID-KB", Name: "Kalimantan Barat", Type: "Province", Parent: "ID-KA"},
+	{Code: "ID-KI", Name: "Kalimantan Timur", Type: "Province", Parent: "ID-KA"},
+	{Code: "ID-KR", Name: "Kepulauan Riau", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-KS", Name: "Kalimantan Selatan", Type: "Province", Parent: "ID-KA"},
+	{Code: "ID-KT", Name: "Kalimantan Tengah", Type: "Province", Parent: "ID-KA"},
+	{Code: "ID-KU", Name: "Kalimantan Utara", Type: "Province", Parent: "ID-KA"},
+	{Code: "ID-LA", Name: "Lampung", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-MA", Name: "Maluku", Type: "Province", Parent: "ID-ML"},
+	{Code: "ID-ML", Name: "Maluku", Type: "Geographical unit"},
+	{Code: "ID-MU", Name: "Maluku Utara", Type: "Province", Parent: "ID-ML"},
+	{Code: "ID-NB", Name: "Nusa Tenggara Barat", Type: "Province", Parent: "ID-NU"},
+	{Code: "ID-NT", Name: "Nusa Tenggara Timur", Type: "Province", Parent: "ID-NU"},
+	{Code: "ID-NU", Name: "Nusa Tenggara", Type: "Geographical unit"},
+	{Code: "ID-PA", Name: "Papua", Type: "Province", Parent: "ID-PP"},
+	{Code: "ID-PB", Name: "Papua Barat", Type: "Province", Parent: "ID-PP"},
+	{Code: "ID-PP", Name: "Papua", Type: "Geographical unit"},
+	{Code: "ID-RI", Name: "Riau", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-SA", Name: "Sulawesi Utara", Type: "Province", Parent: "ID-SL"},
+	{Code: "ID-SB", Name: "Sumatera Barat", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-SG", Name: "Sulawesi Tenggara", Type: "Province", Parent: "ID-SL"},
+	{Code: "ID-SL", Name: "Sulawesi", Type: "Geographical unit"},
+	{Code: "ID-SM", Name: "Sumatera", Type: "Geographical unit"},
+	{Code: "ID-SN", Name: "Sulawesi Selatan", Type: "Province", Parent: "ID-SL"},
+	{Code: "ID-SR", Name: "Sulawesi Barat", Type: "Province", Parent: "ID-SL"},
+	{Code: "ID-SS", Name: "Sumatera Selatan", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-ST", Name: "Sulawesi Tengah", Type: "Province", Parent: "ID-SL"},
+	{Code: "ID-SU", Name: "Sumatera Utara", Type: "Province", Parent: "ID-SM"},
+	{Code: "ID-YO", Name: "Yogyakarta", Type: "Special region", Parent: "ID-JW"},
+	{Code: "IE-C", Name: "Connaught", Type: "Province"},
+	{Code: "IE-CE", Name: "Clare", Type: "County", Parent: "IE-M"},
+	{Code: "IE-CN", Name: "Cavan", Type: "County", Parent: "IE-U"},
+	{Code: "IE-CO", Name: "Cork", Type: "County", Parent: "IE-M"},
+	{Code: "IE-CW", Name: "Carlow", Type: "County", Parent: "IE-L"},
+	{Code: "IE-D", Name: "Dublin", Type: "County", Parent: "IE-L"},
+	{Code: "IE-DL", Name: "Donegal", Type: "County", Parent: "IE-U"},
+	{Code: "IE-G", Name: "Galway", Type: "County", Parent: "IE-C"},
+	{Code: "IE-KE", Name: "Kildare", Type: "County", Parent: "IE-L"},
+	{Code: "IE-KK", Name: "Kilkenny", Type: "County", Parent: "IE-L"},
+	{Code: "IE-KY", Name: "Kerry", Type: "County", Parent: "IE-M"},
+	{Code: "IE-L", Name: "Leinster", Type: "Province"},
+	{Code: "IE-LD", Name: "Longford", Type: "County", Parent: "IE-L"},
+	{Code: "IE-LH", Name: "Louth", Type: "County", Parent: "IE-L"},
+	{Code: "IE-LK", Name: "Limerick", Type: "County", Parent: "IE-M"},
+	{Code: "IE-LM", Name: "Leitrim", Type: "County", Parent: "IE-C"},
+	{Code: "IE-LS", Name: "Laois", Type: "County", Parent: "IE-L"},
+	{Code: "IE-M", Name: "Munster", Type: "Province"},
+	{Code: "IE-MH", Name: "Meath", Type: "County", Parent: "IE-L"},
+	{Code: "IE-MN", Name: "Monaghan", Type: "County", Parent: "IE-U"},
+	{Code: "IE-MO", Name: "Mayo", Type: "County", Parent: "IE-C"},
+	{Code: "IE-OY", Name: "Offaly", Type: "County", Parent: "IE-L"},
+	{Code: "IE-RN", Name: "Roscommon", Type: "County", Parent: "IE-C"},
+	{Code: "IE-SO", Name: "Sligo", Type: "County", Parent: "IE-C"},
+	{Code: "IE-TA", Name: "Tipperary", Type: "County", Parent: "IE-M"},
+	{Code: "IE-U", Name: "Ulster", Type: "Province"},
+	{Code: "IE-WD", Name: "Waterford", Type: "County", Parent: "IE-M"},
+	{Code: "IE-WH", Name: "Westmeath", Type: "County", Parent: "IE-L"},
+	{Code: "IE-WW", Name: "Wicklow", Type: "County", Parent: "IE-L"},
+	{Code: "IE-WX", Name: "Wexford", Type: "County", Parent: "IE-L"},
+	{Code: "IL-D", Name: "Al Janūbī", Type: "District"},
+	{Code: "IL-HA", Name: "H̱efa", Type: "District"},
+	{Code: "IL-JM", Name: "Al Quds", Type: "District"},
+	{Code: "IL-M", Name: "Al Awsaţ", Type: "District"},
+	{Code: "IL-TA", Name: "Tall Abīb", Type: "District"},
+	{Code: "IL-Z", Name: "Ash Shamālī", Type: "District"},
+	{Code: "IN-AN", Name: "Andaman and Nicobar Islands", Type: "Union territory"},
+	{Code: "IN-AP", Name: "Andhra Pradesh", Type: "State"},
+	{Code: "IN-AR", Name: "Arunāchal Pradesh", Type: "State"},
+	{Code: "IN-AS", Name: "Assam", Type: "State"},
+	{Code: "IN-BR", Name: "Bihār", Type: "State"},
+	{Code: "IN-CH", Name: "Chandīgarh", Type: "Union territory"},
+	{Code: "IN-CT", Name: "Chhattīsgarh", Type: "State"},
+	{Code: "IN-DH", Name: "Dādra and Nagar Haveli and Damān and Diu", Type: "Union territory"},
+	{Code: "IN-DL", Name: "Delhi", Type: "Union territory"},
+	{Code: "IN-GA", Name: "Goa", Type: "State"},
+	{Code: "IN-GJ", Name: "Gujarāt", Type: "State"},
+	{Code: "IN-HP", Name: "Himāchal Pradesh", Type: "State"},
+	{Code: "IN-HR", Name: "Haryāna", Type: "State"},
+	{Code: "IN-JH", Name: "Jhārkhand", Type: "State"},
+	{Code: "IN-JK", Name: "Jammu and Kashmīr", Type: "Union territory"},
+	{Code: "IN-KA", Name: "Karnātaka", Type: "State"},
+	{Code: "IN-KL", Name: "Kerala", Type: "State"},
+	{Code: "IN-LA", Name: "Ladākh", Type: "Union territory"},
+	{Code: "IN-LD", Name: "Lakshadweep", Type: "Union territory"},
+	{Code: "IN-MH", Name: "Mahārāshtra", Type: "State"},
+	{Code: "IN-ML", Name: "Meghālaya", Type: "State"},
+	{Code: "IN-MN", Name: "Manipur", Type: "State"},
+	{Code: "IN-MP", Name: "Madhya Pradesh", Type: "State"},
+	{Code: "IN-MZ", Name: "Mizoram", Type: "State"},
+	{Code: "IN-NL", Name: "Nāgāland", Type: "State"},
+	{Code: "IN-OR", Name: "Odisha", Type: "State"},
+	{Code: "IN-PB", Name: "Punjab", Type: "State"},
+	{Code: "IN-PY", Name: "Puducherry", Type: "Union territory"},
+	{Code: "IN-RJ", Name: "Rājasthān", Type: "State"},
+	{Code: "IN-SK", Name: "Sikkim", Type: "State"},
+	{Code: "IN-TG", Name: "Telangāna", Type: "State"},
+	{Code: "IN-TN", Name: "Tamil Nādu", Type: "State"},
+	{Code: "IN-TR", Name: "Tripura", Type: "State"},
+	{Code: "IN-UP", Name: "Uttar Pradesh", Type: "State"},
+	{Code: "IN-UT", Name: "Uttarākhand", Type: "State"},
+	{Code: "IN-WB", Name: "West Bengal", Type: "State"},
+	{Code: "IQ-AN", Name: "Al Anbār", Type: "Governorate"},
+	{Code: "IQ-AR", Name: "Arbīl", Type: "Governorate"},
+	{Code: "IQ-BA", Name: "Al Başrah", Type: "Governorate"},
+	{Code: "IQ-BB", Name: "Bābil", Type: "Governorate"},
+	{Code: "IQ-BG", Name: "Baghdād", Type: "Governorate"},
+	{Code: "IQ-DA", Name: "Dahūk", Type: "Governorate"},
+	{Code: "IQ-DI", Name: "Diyālá", Type: "Governorate"},
+	{Code: "IQ-DQ", Name: "Dhī Qār", Type: "Governorate"},
+	{Code: "IQ-KA", Name: "Karbalā’", Type: "Governorate"},
+	{Code: "IQ-KI", Name: "Kirkūk", Type: "Governorate"},
+	{Code: "IQ-MA", Name: "Maysān", Type: "Governorate"},
+	{Code: "IQ-MU", Name: "Al Muthanná", Type: "Governorate"},
+	{Code: "IQ-NA", Name: "An Najaf", Type: "Governorate"},
+	{Code: "IQ-NI", Name: "Nīnawá", Type: "Governorate"},
+	{Code: "IQ-QA", Name: "Al Qādisīyah", Type: "Governorate"},
+	{Code: "IQ-SD", Name: "Şalāḩ ad Dīn", Type: "Governorate"},
+	{Code: "IQ-SU", Name: "As Sulaymānīyah", Type: "Governorate"},
+	{Code: "IQ-WA", Name: "Wāsiţ", Type: "Governorate"},
+	{Code: "IR-00", Name: "Markazī", Type: "Province"},
+	{Code: "IR-01", Name: "Gīlān", Type: "Province"},
+	{Code: "IR-02", Name: "Māzandarān", Type: "Province"},
+	{Code: "IR-03", Name: "Āz̄ārbāyjān-e Shārqī", Type: "Province"},
+	{Code: "IR-04", Name: "Āz̄ārbāyjān-e Ghārbī", Type: "Province"},
+	{Code: "IR-05", Name: "Kermānshāh", Type: "Province"},
+	{Code: "IR-06", Name: "Khūzestān", Type: "Province"},
+	{Code: "IR-07", Name: "Fārs", Type: "Province"},
+	{Code: "IR-08", Name: "Kermān", Type: "Province"},
+	{Code: "IR-09", Name: "Khorāsān-e Raẕavī", Type: "Province"},
+	{Code: "IR-10", Name: "Eşfahān", Type: "Province"},
+	{Code: "IR-11", Name: "Sīstān va Balūchestān", Type: "Province"},
+	{Code: "IR-12", Name: "Kordestān", Type: "Province"},
+	{Code: "IR-13", Name: "Hamadān", Type: "Province"},
+	{Code: "IR-14", Name: "Chahār Maḩāl va Bakhtīārī", Type: "Province"},
+	{Code: "IR-15", Name: "Lorestān", Type: "Province"},
+	{Code: "IR-16", Name: "Īlām", Type: "Province"},
+	{Code: "IR-17", Name: "Kohgīlūyeh va Bowyer Aḩmad", Type: "Province"},
+	{Code: "IR-18", Name: "Būshehr", Type: "Province"},
+	{Code: "IR-19", Name: "Zanjān", Type: "Province"},
+	{Code: "IR-20", Name: "Semnān", Type: "Province"},
+	{Code: "IR-21", Name: "Yazd", Type: "Province"},
+	{Code: "IR-22", Name: "Hormozgān", Type: "Province"},
+	{Code: "IR-23", Name: "Tehrān", Type: "Province"},
+	{Code: "IR-24", Name: "Ardabīl", Type: "Province"},
+	{Code: "IR-25", Name: "Qom", Type: "Province"},
+	{Code: "IR-26", Name: "Qazvīn", Type: "Province"},
+	{Code: "IR-27", Name: "Golestān", Type: "Province"},
+	{Code: "IR-28", Name: "Khorāsān-e Shomālī", Type: "Province"},
+	{Code: "IR-29", Name: "Khorāsān-e Jonūbī", Type: "Province"},
+	{Code: "IR-30", Name: "Alborz", Type: "Province"},
+	{Code: "IS-1", Name: "Höfuðborgarsvæði", Type: "Region"},
+	{Code: "IS-2", Name: "Suðurnes", Type: "Region"},
+	{Code: "IS-3", Name: "Vesturland", Type: "Region"},
+	{Code: "IS-4", Name: "Vestfirðir", Type: "Region"},
+	{Code: "IS-5", Name: "Norðurland vestra", Type: "Region"},
+	{Code: "IS-6", Name: "Norðurland eystra", Type: "Region"},
+	{Code: "IS-7", Name: "Austurland", Type: "Region"},
+	{Code: "IS-8", Name: "Suðurland", Type: "Region"},
+	{Code: "IS-AKH", Name: "Akrahreppur", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-AKN", Name: "Akraneskaupstaður", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-AKU", Name: "Akureyrarbær", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-ARN", Name: "Árneshreppur", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-ASA", Name: "Ásahreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-BFJ", Name: "Borgarfjarðarhreppur", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-BLA", Name: "Bláskógabyggð", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-BLO", Name: "Blönduósbær", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-BOG", Name: "Borgarbyggð", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-BOL", Name: "Bolungarvíkurkaupstaður", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-DAB", Name: "Dalabyggð", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-DAV", Name: "Dalvíkurbyggð", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-DJU", Name: "Djúpavogshreppur", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-EOM", Name: "Eyja- og Miklaholtshreppur", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-EYF", Name: "Eyjafjarðarsveit", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-FJD", Name: "Fjarðabyggð", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-FJL", Name: "Fjallabyggð", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-FLA", Name: "Flóahreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-FLD", Name: "Fljótsdalshérað", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-FLR", Name: "Fljótsdalshreppur", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-GAR", Name: "Garðabær", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-GOG", Name: "Grímsnes- og Grafningshreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-GRN", Name: "Grindavíkurbær", Type: "Municipality", Parent: "IS-2"},
+	{Code: "IS-GRU", Name: "Grundarfjarðarbær", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-GRY", Name: "Grýtubakkahreppur", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-HAF", Name: "Hafnarfjarðarkaupstaður", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-HEL", Name: "Helgafellssveit", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-HRG", Name: "Hörgársveit", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-HRU", Name: "Hrunamannahreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-HUT", Name: "Húnavatnshreppur", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-HUV", Name: "Húnaþing vestra", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-HVA", Name: "Hvalfjarðarsveit", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-HVE", Name: "Hveragerðisbær", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-ISA", Name: "Ísafjarðarbær", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-KAL", Name: "Kaldrananeshreppur", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-KJO", Name: "Kjósarhreppur", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-KOP", Name: "Kópavogsbær", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-LAN", Name: "Langanesbyggð", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-MOS", Name: "Mosfellsbær", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-MYR", Name: "Mýrdalshreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-NOR", Name: "Norðurþing", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-RGE", Name: "Rangárþing eystra", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-RGY", Name: "Rangárþing ytra", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-RHH", Name: "Reykhólahreppur", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-RKN", Name: "Reykjanesbær", Type: "Municipality", Parent: "IS-2"},
+	{Code: "IS-RKV", Name: "Reykjavíkurborg", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-SBH", Name: "Svalbarðshreppur", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-SBT", Name: "Svalbarðsstrandarhreppur", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-SDN", Name: "Suðurnesjabær", Type: "Municipality", Parent: "IS-2"},
+	{Code: "IS-SDV", Name: "Súðavíkurhreppur", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-SEL", Name: "Seltjarnarnesbær", Type: "Municipality", Parent: "IS-1"},
+	{Code: "IS-SEY", Name: "Seyðisfjarðarkaupstaður", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-SFA", Name: "Sveitarfélagið Árborg", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-SHF", Name: "Sveitarfélagið Hornafjörður", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IS-SKF", Name: "Skaftárhreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-SKG", Name: "Skagabyggð", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-SKO", Name: "Skorradalshreppur", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-SKU", Name: "Skútustaðahreppur", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-SNF", Name: "Snæfellsbær", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-SOG", Name: "Skeiða- og Gnúpverjahreppur", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-SOL", Name: "Sveitarfélagið Ölfus", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-SSF", Name: "Sveitarfélagið Skagafjörður", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-SSS", Name: "Sveitarfélagið Skagaströnd", Type: "Municipality", Parent: "IS-5"},
+	{Code: "IS-STR", Name: "Strandabyggð", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-STY", Name: "Stykkishólmsbær", Type: "Municipality", Parent: "IS-3"},
+	{Code: "IS-SVG", Name: "Sveitarfélagið Vogar", Type: "Municipality", Parent: "IS-2"},
+	{Code: "IS-TAL", Name: "Tálknafjarðarhreppur", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-THG", Name: "Þingeyjarsveit", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-TJO", Name: "Tjörneshreppur", Type: "Municipality", Parent: "IS-6"},
+	{Code: "IS-VEM", Name: "Vestmannaeyjabær", Type: "Municipality", Parent: "IS-8"},
+	{Code: "IS-VER", Name: "Vesturbyggð", Type: "Municipality", Parent: "IS-4"},
+	{Code: "IS-VOP", Name: "Vopnafjarðarhreppur", Type: "Municipality", Parent: "IS-7"},
+	{Code: "IT-21", Name: "Piemonte", Type: "Region"},
+	{Code: "IT-23", Name: "Val d'Aoste", Type: "Autonomous region"},
+	{Code: "IT-25", Name: "Lombardia", Type: "Region"},
+	{Code: "IT-32", Name: "Trentino-Alto Adige", Type: "Autonomous region"},
+	{Code: "IT-34", Name: "Veneto", Type: "Region"},
+	{Code: "IT-36", Name: "Friuli Venezia Giulia", Type: "Autonomous region"},
+	{Code: "IT-42", Name: "Liguria", Type: "Region"},
+	{Code: "IT-45", Name: "Emilia-Romagna", Type: "Region"},
+	{Code: "IT-52", Name: "Toscana", Type: "Region"},
+	{Code: "IT-55", Name: "Umbria", Type: "Region"},
+	{Code: "IT-57", Name: "Marche", Type: "Region"},
+	{Code: "IT-62", Name: "Lazio", Type: "Region"},
+	{Code: "IT-65", Name: "Abruzzo", Type: "Region"},
+	{Code: "IT-67", Name: "Molise", Type: "Region"},
+	{Code: "IT-72", Name: "Campania", Type: "Region"},
+	{Code: "IT-75", Name: "Puglia", Type: "Region"},
+	{Code: "IT-77", Name: "Basilicata", Type: "Region"},
+	{Code: "IT-78", Name: "Calabria", Type: "Region"},
+	{Code: "IT-82", Name: "Sicilia", Type: "Autonomous region"},
+	{Code: "IT-88", Name: "Sardegna", Type: "Autonomous region"},
+	{Code: "IT-AG", Name: "Agrigento", Type: "Free municipal consortium", Parent: "IT-82"},
+	{Code: "IT-AL", Name: "Alessandria", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-AN", Name: "Ancona", Type: "Province", Parent: "IT-57"},
+	{Code: "IT-AP", Name: "Ascoli Piceno", Type: "Province", Parent: "IT-57"},
+	{Code: "IT-AQ", Name: "L'Aquila", Type: "Province", Parent: "IT-65"},
+	{Code: "IT-AR", Name: "Arezzo", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-AT", Name: "Asti", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-AV", Name: "Avellino", Type: "Province", Parent: "IT-72"},
+	{Code: "IT-BA", Name: "Bari", Type: "Metropolitan city", Parent: "IT-75"},
+	{Code: "IT-BG", Name: "Bergamo", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-BI", Name: "Biella", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-BL", Name: "Belluno", Type: "Province", Parent: "IT-34"},
+	{Code: "IT-BN", Name: "Benevento", Type: "Province", Parent: "IT-72"},
+	{Code: "IT-BO", Name: "Bologna", Type: "Metropolitan city", Parent: "IT-45"},
+	{Code: "IT-BR", Name: "Brindisi", Type: "Province", Parent: "IT-75"},
+	{Code: "IT-BS", Name: "Brescia", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-BT", Name: "Barletta-Andria-Trani", Type: "Province", Parent: "IT-75"},
+	{Code: "IT-BZ", Name: "Bolzano", Type: "Autonomous province", Parent: "IT-32"},
+	{Code: "IT-CA", Name: "Cagliari", Type: "Metropolitan city", Parent: "IT-88"},
+	{Code: "IT-CB", Name: "Campobasso", Type: "Province", Parent: "IT-67"},
+	{Code: "IT-CE", Name: "Caserta", Type: "Province", Parent: "IT-72"},
+	{Code: "IT-CH", Name: "Chieti", Type: "Province", Parent: "IT-65"},
+	{Code: "IT-CL", Name: "Caltanissetta", Type: "Free municipal consortium", Parent: "IT-82"},
+	{Code: "IT-CN", Name: "Cuneo", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-CO", Name: "Como", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-CR", Name: "Cremona", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-CS", Name: "Cosenza", Type: "Province", Parent: "IT-78"},
+	{Code: "IT-CT", Name: "Catania", Type: "Metropolitan city", Parent: "IT-82"},
+	{Code: "IT-CZ", Name: "Catanzaro", Type: "Province", Parent: "IT-78"},
+	{Code: "IT-EN", Name: "Enna", Type: "Free municipal consortium", Parent: "IT-82"},
+	{Code: "IT-FC", Name: "Forlì-Cesena", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-FE", Name: "Ferrara", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-FG", Name: "Foggia", Type: "Province", Parent: "IT-75"},
+	{Code: "IT-FI", Name: "Firenze", Type: "Metropolitan city", Parent: "IT-52"},
+	{Code: "IT-FM", Name: "Fermo", Type: "Province", Parent: "IT-57"},
+	{Code: "IT-FR", Name: "Frosinone", Type: "Province", Parent: "IT-62"},
+	{Code: "IT-GE", Name: "Genova", Type: "Metropolitan city", Parent: "IT-42"},
+	{Code: "IT-GO", Name: "Gorizia", Type: "Decentralized regional entity", Parent: "IT-36"},
+	{Code: "IT-GR", Name: "Grosseto", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-IM", Name: "Imperia", Type: "Province", Parent: "IT-42"},
+	{Code: "IT-IS", Name: "Isernia", Type: "Province", Parent: "IT-67"},
+	{Code: "IT-KR", Name: "Crotone", Type: "Province", Parent: "IT-78"},
+	{Code: "IT-LC", Name: "Lecco", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-LE", Name: "Lecce", Type: "Province", Parent: "IT-75"},
+	{Code: "IT-LI", Name: "Livorno", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-LO", Name: "Lodi", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-LT", Name: "Latina", Type: "Province", Parent: "IT-62"},
+	{Code: "IT-LU", Name: "Lucca", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-MB", Name: "Monza e Brianza", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-MC", Name: "Macerata", Type: "Province", Parent: "IT-57"},
+	{Code: "IT-ME", Name: "Messina", Type: "Metropolitan city", Parent: "IT-82"},
+	{Code: "IT-MI", Name: "Milano", Type: "Metropolitan city", Parent: "IT-25"},
+	{Code: "IT-MN", Name: "Mantova", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-MO", Name: "Modena", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-MS", Name: "Massa-Carrara", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-MT", Name: "Matera", Type: "Province", Parent: "IT-77"},
+	{Code: "IT-NA", Name: "Napoli", Type: "Metropolitan city", Parent: "IT-72"},
+	{Code: "IT-NO", Name: "Novara", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-NU", Name: "Nuoro", Type: "Province", Parent: "IT-88"},
+	{Code: "IT-OR", Name: "Oristano", Type: "Province", Parent: "IT-88"},
+	{Code: "IT-PA", Name: "Palermo", Type: "Metropolitan city", Parent: "IT-82"},
+	{Code: "IT-PC", Name: "Piacenza", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-PD", Name: "Padova", Type: "Province", Parent: "IT-34"},
+	{Code: "IT-PE", Name: "Pescara", Type: "Province", Parent: "IT-65"},
+	{Code: "IT-PG", Name: "Perugia", Type: "Province", Parent: "IT-55"},
+	{Code: "IT-PI", Name: "Pisa", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-PN", Name: "Pordenone", Type: "Decentralized regional entity", Parent: "IT-36"},
+	{Code: "IT-PO", Name: "Prato", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-PR", Name: "Parma", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-PT", Name: "Pistoia", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-PU", Name: "Pesaro e Urbino", Type: "Province", Parent: "IT-57"},
+	{Code: "IT-PV", Name: "Pavia", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-PZ", Name: "Potenza", Type: "Province", Parent: "IT-77"},
+	{Code: "IT-RA", Name: "Ravenna", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-RC", Name: "Reggio Calabria", Type: "Metropolitan city", Parent: "IT-78"},
+	{Code: "IT-RE", Name: "Reggio Emilia", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-RG", Name: "Ragusa", Type: "Free municipal consortium", Parent: "IT-82"},
+	{Code: "IT-RI", Name: "Rieti", Type: "Province", Parent: "IT-62"},
+	{Code: "IT-RM", Name: "Roma", Type: "Metropolitan city", Parent: "IT-62"},
+	{Code: "IT-RN", Name: "Rimini", Type: "Province", Parent: "IT-45"},
+	{Code: "IT-RO", Name: "Rovigo", Type: "Province", Parent: "IT-34"},
+	{Code: "IT-SA", Name: "Salerno", Type: "Province", Parent: "IT-72"},
+	{Code: "IT-SI", Name: "Siena", Type: "Province", Parent: "IT-52"},
+	{Code: "IT-SO", Name: "Sondrio", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-SP", Name: "La Spezia", Type: "Province", Parent: "IT-42"},
+	{Code: "IT-SR", Name: "Siracusa", Type: "Free municipal consortium", Parent: "IT-82"},
+	{Code: "IT-SS", Name: "Sassari", Type: "Province", Parent: "IT-88"},
+	{Code: "IT-SU", Name: "Sud Sardegna", Type: "Province", Parent: "IT-88"},
+	{Code: "IT-SV", Name: "Savona", Type: "Province", Parent: "IT-42"},
+	{Code: "IT-TA", Name: "Taranto", Type: "Province", Parent: "IT-75"},
+	{Code: "IT-TE", Name: "Teramo", Type: "Province", Parent: "IT-65"},
+	{Code: "IT-TN", Name: "Trento", Type: "Autonomous province", Parent: "IT-32"},
+	{Code: "IT-TO", Name: "Torino", Type: "Metropolitan city", Parent: "IT-21"},
+	{Code: "IT-TP", Name: "Trapani", Type: "Free municipal consortium", Parent: "IT-82"},
+	{Code: "IT-TR", Name: "Terni", Type: "Province", Parent: "IT-55"},
+	{Code: "IT-TS", Name: "Trieste", Type: "Decentralized regional entity", Parent: "IT-36"},
+	{Code: "IT-TV", Name: "Treviso", Type: "Province", Parent: "IT-34"},
+	{Code: "IT-UD", Name: "Udine", Type: "Decentralized regional entity", Parent: "IT-36"},
+	{Code: "IT-VA", Name: "Varese", Type: "Province", Parent: "IT-25"},
+	{Code: "IT-VB", Name: "Verbano-Cusio-Ossola", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-VC", Name: "Vercelli", Type: "Province", Parent: "IT-21"},
+	{Code: "IT-VE", Name: "Venezia", Type: "Metropolitan city", Parent: "IT-34"},
+	{Code: "IT-VI", Name: "Vicenza", Type: "Province", Parent: "IT-34"},
+	{Code: "IT-VR", Name: "Verona", Type: "Province", Parent: "IT-34"},
+	{Code: "IT-VT", Name: "Viterbo", Type: "Province", Parent: "IT-62"},
+	{Code: "IT-VV", Name: "Vibo Valentia", Type: "Province", Parent: "IT-78"},
+	{Code: "JM-01", Name: "Kingston", Type: "Parish"},
+	{Code: "JM-02", Name: "Saint Andrew", Type: "Parish"},
+	{Code: "JM-03", Name: "Saint Thomas", Type: "Parish"},
+	{Code: "JM-04", Name: "Portland", Type: "Parish"},
+	{Code: "JM-05", Name: "Saint Mary", Type: "Parish"},
+	{Code: "JM-06", Name: "Saint Ann", Type: "Parish"},
+	{Code: "JM-07", Name: "Trelawny", Type: "Parish"},
+	{Code: "JM-08", Name: "Saint James", Type: "Parish"},
+	{Code: "JM-09", Name: "Hanover", Type: "Parish"},
+	{Code: "JM-10", Name: "Westmoreland", Type: "Parish"},
+	{Code: "JM-11", Name: "Saint Elizabeth", Type: "Parish"},
+	{Code: "JM-12", Name: "Manchester", Type: "Parish"},
+	{Code: "JM-13", Name: "Clarendon", Type: "Parish"},
+	{Code: "JM-14", Name: "Saint Catherine", Type: "Parish"},
+	{Code: "JO-AJ", Name: "‘Ajlūn", Type: "Governorate"},
+	{Code: "JO-AM", Name: "Al ‘A̅şimah", Type: "Governorate"},
+	{Code: "JO-AQ", Name: "Al ‘Aqabah", Type: "Governorate"},
+	{Code: "JO-AT", Name: "Aţ Ţafīlah", Type: "Governorate"},
+	{Code: "JO-AZ", Name: "Az Zarqā’", Type: "Governorate"},
+	{Code: "JO-BA", Name: "Al Balqā’", Type: "Governorate"},
+	{Code: "JO-IR", Name: "Irbid", Type: "Governorate"},
+	{Code: "JO-JA", Name: "Jarash", Type: "Governorate"},
+	{Code: "JO-KA", Name: "Al Karak", Type: "Governorate"},
+	{Code: "JO-MA", Name: "Al Mafraq", Type: "Governorate"},
+	{Code: "JO-MD", Name: "Mādabā", Type: "Governorate"},
+	{Code: "JO-MN", Name: "Ma‘ān", Type: "Governorate"},
+	{Code: "JP-01", Name: "Hokkaido", Type: "Prefecture"},
+	{Code: "JP-02", Name: "Aomori", Type: "Prefecture"},
+	{Code: "JP-03", Name: "Iwate", Type: "Prefecture"},
+	{Code: "JP-04", Name: "Miyagi", Type: "Prefecture"},
+	{Code: "JP-05", Name: "Akita", Type: "Prefecture"},
+	{Code: "JP-06", Name: "Yamagata", Type: "Prefecture"},
+	{Code: "JP-07", Name: "Fukushima", Type: "Prefecture"},
+	{Code: "JP-08", Name: "Ibaraki", Type: "Prefecture"},
+	{Code: "JP-09", Name: "Tochigi", Type: "Prefecture"},
+	{Code: "JP-10", Name: "Gunma", Type: "Prefecture"},
+	{Code: "JP-11", Name: "Saitama", Type: "Prefecture"},
+	{Code: "JP-12", Name: "Chiba", Type: "Prefecture"},
+	{Code: "JP-13", Name: "Tokyo", Type: "Prefecture"},
+	{Code: "JP-14", Name: "Kanagawa", Type: "Prefecture"},
+	{Code: "JP-15", Name: "Niigata", Type: "Prefecture"},
+	{Code: "JP-16", Name: "Toyama", Type: "Prefecture"},
+	{Code: "JP-17", Name: "Ishikawa", Type: "Prefecture"},
+	{Code: "JP-18", Name: "Fukui", Type: "Prefecture"},
+	{Code: "JP-19", Name: "Yamanashi", Type: "Prefecture"},
+	{Code: "JP-20", Name: "Nagano", Type: "Prefecture"},
+	{Code: "JP-21", Name: "Gifu", Type: "Prefecture"},
+	{Code: "JP-22", Name: "Shizuoka", Type: "Prefecture"},
+	{Code: "JP-23", Name: "Aichi", Type: "Prefecture"},
+	{Code: "JP-24", Name: "Mie", Type: "Prefecture"},
+	{Code: "JP-25", Name: "Shiga", Type: "Prefecture"},
+	{Code: "JP-26", Name: "Kyoto", Type: "Prefecture"},
+	{Code: "JP-27", Name: "Osaka", Type: "Prefecture"},
+	{Code: "JP-28", Name: "Hyogo", Type: "Prefecture"},
+	{Code: "JP-29", Name: "Nara", Type: "Prefecture"},
+	{Code: "JP-30", Name: "Wakayama", Type: "Prefecture"},
+	{Code: "JP-31", Name: "Tottori", Type: "Prefecture"},
+	{Code: "JP-32", Name: "Shimane", Type: "Prefecture"},
+	{Code: "JP-33", Name: "Okayama", Type: "Prefecture"},
+	{Code: "JP-34", Name: "Hiroshima", Type: "Prefecture"},
+	{Code: "JP-35", Name: "Yamaguchi", Type: "Prefecture"},
+	{Code: "JP-36", Name: "Tokushima", Type: "Prefecture"},
+	{Code: "JP-37", Name: "Kagawa", Type: "Prefecture"},
+	{Code: "JP-38", Name: "Ehime", Type: "Prefecture"},
+	{Code: "JP-39", Name: "Kochi", Type: "Prefecture"},
+	{Code: "JP-40", Name: "Fukuoka", Type: "Prefecture"},
+	{Code: "JP-41", Name: "Saga", Type: "Prefecture"},
+	{Code: "JP-42", Name: "Nagasaki", Type: "Prefecture"},
+	{Code: "JP-43", Name: "Kumamoto", Type: "Prefecture"},
+	{Code: "JP-44", Name: "Oita", Type: "Prefecture"},
+	{Code: "JP-45", Name: "Miyazaki", Type: "Prefecture"},
+	{Code: "JP-46", Name: "Kagoshima", Type: "Prefecture"},
+	{Code: "JP-47", Name: "Okinawa", Type: "Prefecture"},
+	{Code: "KE-01", Name: "Baringo", Type: "County"},
+	{Code: "KE-02", Name: "Bomet", Type: "County"},
+	{Code: "KE-03", Name: "Bungoma", Type: "County"},
+	{Code: "KE-04", Name: "Busia", Type: "County"},
+	{Code: "KE-05", Name: "Elgeyo/Marakwet", Type: "County"},
+	{Code: "KE-06", Name: "Embu", Type: "County"},
+	{Code: "KE-07", Name: "Garissa", Type: "County"},
+	{Code: "KE-08", Name: "Homa Bay", Type: "County"},
+	{Code: "KE-09", Name: "Isiolo", Type: "County"},
+	{Code: "KE-10", Name: "Kajiado", Type: "County"},
+	{Code: "KE-11", Name: "Kakamega", Type: "County"},
+	{Code: "KE-12", Name: "Kericho", Type: "County"},
+	{Code: "KE-13", Name: "Kiambu", Type: "County"},
+	{Code: "KE-14", Name: "Kilifi", Type: "County"},
+	{Code: "KE-15", Name: "Kirinyaga", Type: "County"},
+	{Code: "KE-16", Name: "Kisii", Type: "County"},
+	{Code: "KE-17", Name: "Kisumu", Type: "County"},
+	{Code: "KE-18", Name: "Kitui", Type: "County"},
+	{Code: "KE-19", Name: "Kwale", Type: "County"},
+	{Code: "KE-20", Name: "Laikipia", Type: "County"},
+	{Code: "KE-21", Name: "Lamu", Type: "County"},
+	{Code: "KE-22", Name: "Machakos", Type: "County"},
+	{Code: "KE-23", Name: "Makueni", Type: "County"},
+	{Code: "KE-24", Name: "Mandera", Type: "County"},
+	{Code: "KE-25", Name: "Marsabit", Type: "County"},
+	{Code: "KE-26", Name: "Meru", Type: "County"},
+	{Code: "KE-27", Name: "Migori", Type: "County"},
+	{Code: "KE-28", Name: "Mombasa", Type: "County"},
+	{Code: "KE-29", Name: "Murang'a", Type: "County"},
+	{Code: "KE-30", Name: "Nairobi City", Type: "County"},
+	{Code: "KE-31", Name: "Nakuru", Type: "County"},
+	{Code: "KE-32", Name: "Nandi", Type: "County"},
+	{Code: "KE-33", Name: "Narok", Type: "County"},
+	{Code: "KE-34", Name: "Nyamira", Type: "County"},
+	{Code: "KE-35", Name: "Nyandarua", Type: "County"},
+	{Code: "KE-36", Name: "Nyeri", Type: "County"},
+	{Code: "KE-37", Name: "Samburu", Type: "County"},
+	{Code: "KE-38", Name: "Siaya", Type: "County"},
+	{Code: "KE-39", Name: "Taita/Taveta", Type: "County"},
+	{Code: "KE-40", Name: "Tana River", Type: "County"},
+	{Code: "KE-41", Name: "Tharaka-Nithi", Type: "County"},
+	{Code: "KE-42", Name: "Trans Nzoia", Type: "County"},
+	{Code: "KE-43", Name: "Turkana", Type: "County"},
+	{Code: "KE-44", Name: "Uasin Gishu", Type: "County"},
+	{Code: "KE-45", Name: "Vihiga", Type: "County"},
+	{Code: "KE-46", Name: "Wajir", Type: "County"},
+	{Code: "KE-47", Name: "West Pokot", Type: "County"},
+	{Code: "KG-B", Name: "Batken", Type: "Region"},
+	{Code: "KG-C", Name: "Chuyskaya oblast'", Type: "Region"},
+	{Code: "KG-GB", Name: "Bishkek Shaary", Type: "City"},
+	{Code: "KG-GO", Name: "Gorod Osh", Type: "City"},
+	{Code: "KG-J", Name: "Dzhalal-Abadskaya oblast'", Type: "Region"},
+	{Code: "KG-N", Name: "Naryn", Type: "Region"},
+	{Code: "KG-O", Name: "Osh", Type: "Region"},
+	{Code: "KG-T", Name: "Talas", Type: "Region"},
+	{Code: "KG-Y", Name: "Issyk-Kul'skaja oblast'", Type: "Region"},
+	{Code: "KH-1", Name: "Banteay Mean Choăy", Type: "Province"},
+	{Code: "KH-10", Name: "Kracheh", Type: "Province"},
+	{Code: "KH-11", Name: "Mondol Kiri", Type: "Province"},
+	{Code: "KH-12", Name: "Phnom Penh", Type: "Autonomous municipality"},
+	{Code: "KH-13", Name: "Preah Vihear", Type: "Province"},
+	{Code: "KH-14", Name: "Prey Veaeng", Type: "Province"},
+	{Code: "KH-15", Name: "Pousaat", Type: "Province"},
+	{Code: "KH-16", Name: "Rotanak Kiri", Type: "Province"},
+	{Code: "KH-17", Name: "Siem Reab", Type: "Province"},
+	{Code: "KH-18", Name: "Preah Sihanouk", Type: "Province"},
+	{Code: "KH-19", Name: "Stoĕng Trêng", Type: "Province"},
+	{Code: "KH-2", Name: "Baat Dambang", Type: "Province"},
+	{Code: "KH-20", Name: "Svaay Rieng", Type: "Province"},
+	{Code: "KH-21", Name: "Taakaev", Type: "Province"},
+	{Code: "KH-22", Name: "Otdar Mean Chey", Type: "Province"},
+	{Code: "KH-23", Name: "Kaeb", Type: "Province"},
+	{Code: "KH-24", Name: "Pailin", Type: "Province"},
+	{Code: "KH-25", Name: "Tbong Khmum", Type: "Province"},
+	{Code: "KH-3", Name: "Kampong Chaam", Type: "Province"},
+	{Code: "KH-4", Name: "Kampong Chhnang", Type: "Province"},
+	{Code: "KH-5", Name: "Kampong Spueu", Type: "Province"},
+	{Code: "KH-6", Name: "Kampong Thum", Type: "Province"},
+	{Code: "KH-7", Name: "Kampot", Type: "Province"},
+	{Code: "KH-8", Name: "Kandaal", Type: "Province"},
+	{Code: "KH-9", Name: "Kaoh Kong", Type: "Province"},
+	{Code: "KI-G", Name: "Gilbert Islands", Type: "Group of islands (20 inhabited islands)"},
+	{Code: "KI-L", Name: "Line Islands", Type: "Group of islands (20 inhabited islands)"},
+	{Code: "KI-P", Name: "Phoenix Islands", Type: "Group of islands (20 inhabited islands)"},
+	{Code: "KM-A", Name: "Andjouân", Type: "Island"},
+	{Code: "KM-G", Name: "Andjazîdja", Type: "Island"},
+	{Code: "KM-M", Name: "Mohéli", Type: "Island"},
+	{Code: "KN-01", Name: "Christ Church Nichola Town", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-02", Name: "Saint Anne Sandy Point", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-03", Name: "Saint George Basseterre", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-04", Name: "Saint George Gingerland", Type: "Parish", Parent: "KN-N"},
+	{Code: "KN-05", Name: "Saint James Windward", Type: "Parish", Parent: "KN-N"},
+	{Code: "KN-06", Name: "Saint John Capisterre", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-07", Name: "Saint John Figtree", Type: "Parish", Parent: "KN-N"},
+	{Code: "KN-08", Name: "Saint Mary Cayon", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-09", Name: "Saint Paul Capisterre", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-10", Name: "Saint Paul Charlestown", Type: "Parish", Parent: "KN-N"},
+	{Code: "KN-11", Name: "Saint Peter Basseterre", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-12", Name: "Saint Thomas Lowland", Type: "Parish", Parent: "KN-N"},
+	{Code: "KN-13", Name: "Saint Thomas Middle Island", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-15", Name: "Trinity Palmetto Point", Type: "Parish", Parent: "KN-K"},
+	{Code: "KN-K", Name: "Saint Kitts", Type: "State"},
+	{Code: "KN-N", Name: "Nevis", Type: "State"},
+	{Code: "KP-01", Name: "P'yǒngyang", Type: "Capital city"},
+	{Code: "KP-02", Name: "P'yǒngan-namdo", Type: "Province"},
+	{Code: "KP-03", Name: "P'yǒngan-bukto", Type: "Province"},
+	{Code: "KP-04", Name: "Chagang-do", Type: "Province"},
+	{Code: "KP-05", Name: "Hwanghae-namdo", Type: "Province"},
+	{Code: "KP-06", Name: "Hwanghae-bukto", Type: "Province"},
+	{Code: "KP-07", Name: "Kangweonto", Type: "Province"},
+	{Code: "KP-08", Name: "Hamgyǒng-namdo", Type: "Province"},
+	{Code: "KP-09", Name: "Hamgyǒng-bukto", Type: "Province"},
+	{Code: "KP-10", Name: "Ryanggang-do", Type: "Province"},
+	{Code: "KP-13", Name: "Raseon", Type: "Special city"},
+	{Code: "KP-14", Name: "Nampho", Type: "Metropolitan city"},
+	{Code: "KR-11", Name: "Seoul-teukbyeolsi", Type: "Special city"},
+	{Code: "KR-26", Name: "Busan-gwangyeoksi", Type: "Metropolitan city"},
+	{Code: "KR-27", Name: "Daegu-gwangyeoksi", Type: "Metropolitan city"},
+	{Code: "KR-28", Name: "Incheon-gwangyeoksi", Type: "Metropolitan city"},
+	{Code: "KR-29", Name: "Gwangju-gwangyeoksi", Type: "Metropolitan city"},
+	{Code: "KR-30", Name: "Daejeon-gwangyeoksi", Type: "Metropolitan city"},
+	{Code: "KR-31", Name: "Ulsan-gwangyeoksi", Type: "Metropolitan city"},
+	{Code: "KR-41", Name: "Gyeonggi-do", Type: "Province"},
+	{Code: "KR-42", Name: "Gangwon-do", Type: "Province"},
+	{Code: "KR-43", Name: "Chungcheongbuk-do", Type: "Province"},
+	{Code: "KR-44", Name: "Chungcheongnam-do", Type: "Province"},
+	{Code: "KR-45", Name: "Jeollabuk-do", Type: "Province"},
+	{Code: "KR-46", Name: "Jeollanam-do", Type: "Province"},
+	{Code: "KR-47", Name: "Gyeongsangbuk-do", Type: "Province"},
+	{Code: "KR-48", Name: "Gyeongsangnam-do", Type: "Province"},
+	{Code: "KR-49", Name: "Jeju-teukbyeoljachido", Type: "Special self-governing province"},
+	{Code: "KR-50", Name: "Sejong", Type: "Special self-governing city"},
+	{Code: "KW-AH", Name: "Al Aḩmadī", Type: "Governorate"},
+	{Code: "KW-FA", Name: "Al Farwānīyah", Type: "Governorate"},
+	{Code: "KW-HA", Name: "Ḩawallī", Type: "Governorate"},
+	{Code: "KW-JA", Name: "Al Jahrā’", Type: "Governorate"},
+	{Code: "KW-KU", Name: "Al ‘Āşimah", Type: "Governorate"},
+	{Code: "KW-MU", Name: "Mubārak al Kabīr", Type: "Governorate"},
+	{Code: "KZ-AKM", Name: "Akmolinskaja oblast'", Type: "Region"},
+	{Code: "KZ-AKT", Name: "Aktjubinskaja oblast'", Type: "Region"},
+	{Code: "KZ-ALA", Name: "Almaty", Type: "City"},
+	{Code: "KZ-ALM", Name: "Almatinskaja oblast'", Type: "Region"},
+	{Code: "KZ-AST", Name: "Nur-Sultan", Type: "City"},
+	{Code: "KZ-ATY", Name: "Atyrauskaja oblast'", Type: "Region"},
+	{Code: "KZ-KAR", Name: "Karagandinskaja oblast'", Type: "Region"},
+	{Code: "KZ-KUS", Name: "Kostanajskaja oblast'", Type: "Region"},
+	{Code: "KZ-KZY", Name: "Kyzylordinskaja oblast'", Type: "Region"},
+	{Code: "KZ-MAN", Name: "Mangghystaū oblysy", Type: "Region"},
+	{Code: "KZ-PAV", Name: "Pavlodar oblysy", Type: "Region"},
+	{Code: "KZ-SEV", Name: "Severo-Kazahstanskaja oblast'", Type: "Region"},
+	{Code: "KZ-SHY", Name: "Shymkent", Type: "City"},
+	{Code: "KZ-VOS", Name: "Shyghys Qazaqstan oblysy", Type: "Region"},
+	{Code: "KZ-YUZ", Name: "Turkestankaya oblast'", Type: "Region"},
+	{Code: "KZ-ZAP", Name: "Batys Qazaqstan oblysy", Type: "Region"},
+	{Code: "KZ-ZHA", Name: "Zhambyl oblysy", Type: "Region"},
+	{Code: "LA-AT", Name: "Attapu", Type: "Province"},
+	{Code: "LA-BK", Name: "Bokèo", Type: "Province"},
+	{Code: "LA-BL", Name: "Bolikhamxai", Type: "Province"},
+	{Code: "LA-CH", Name: "Champasak", Type: "Province"},
+	{Code: "LA-HO", Name: "Houaphan", Type: "Province"},
+	{Code: "LA-KH", Name: "Khammouan", Type: "Province"},
+	{Code: "LA-LM", Name: "Louang Namtha", Type: "Province"},
+	{Code: "LA-LP", Name: "Louangphabang", Type: "Province"},
+	{Code: "LA-OU", Name: "Oudômxai", Type: "Province"},
+	{Code: "LA-PH", Name: "Phôngsali", Type: "Province"},
+	{Code: "LA-SL", Name: "Salavan", Type: "Province"},
+	{Code: "LA-SV", Name: "Savannakhét", Type: "Province"},
+	{Code: "LA-VI", Name: "Viangchan", Type: "Province"},
+	{Code: "LA-VT", Name: "Viangchan", Type: "Prefecture"},
+	{Code: "LA-XA", Name: "Xaignabouli", Type: "Province"},
+	{Code: "LA-XE", Name: "Xékong", Type: "Province"},
+	{Code: "LA-XI", Name: "Xiangkhouang", Type: "Province"},
+	{Code: "LA-XS", Name: "Xaisômboun", Type: "Province"},
+	{Code: "LB-AK", Name: "Aakkâr", Type: "Governorate"},
+	{Code: "LB-AS", Name: "Ash Shimāl", Type: "Governorate"},
+	{Code: "LB-BA", Name: "Bayrūt", Type: "Governorate"},
+	{Code: "LB-BH", Name: "Baalbek-Hermel", Type: "Governorate"},
+	{Code: "LB-BI", Name: "Al Biqā‘", Type: "Governorate"},
+	{Code: "LB-JA", Name: "Al Janūb", Type: "Governorate"},
+	{Code: "LB-JL", Name: "Jabal Lubnān", Type: "Governorate"},
+	{Code: "LB-NA", Name: "An Nabaţīyah", Type: "Governorate"},
+	{Code: "LC-01", Name: "Anse la Raye", Type: "District"},
+	{Code: "LC-02", Name: "Castries", Type: "District"},
+	{Code: "LC-03", Name: "Choiseul", Type: "District"},
+	{Code: "LC-05", Name: "Dennery", Type: "District"},
+	{Code: "LC-06", Name: "Gros Islet", Type: "District"},
+	{Code: "LC-07", Name: "Laborie", Type: "District"},
+	{Code: "LC-08", Name: "Micoud", Type: "District"},
+	{Code: "LC-10", Name: "Soufrière", Type: "District"},
+	{Code: "LC-11", Name: "Vieux Fort", Type: "District"},
+	{Code: "LC-12", Name: "Canaries", Type: "District"},
+	{Code: "LI-01", Name: "Balzers", Type: "Commune"},
+	{Code: "LI-02", Name: "Eschen", Type: "Commune"},
+	{Code: "LI-03", Name: "Gamprin", Type: "Commune"},
+	{Code: "LI-04", Name: "Mauren", Type: "Commune"},
+	{Code: "LI-05", Name: "Planken", Type: "Commune"},
+	{Code: "LI-06", Name: "Ruggell", Type: "Commune"},
+	{Code: "LI-07", Name: "Schaan", Type: "Commune"},
+	{Code: "LI-08", Name: "Schellenberg", Type: "Commune"},
+	{Code: "LI-09", Name: "Triesen", Type: "Commune"},
+	{Code: "LI-10", Name: "Triesenberg", Type: "Commune"},
+	{Code: "LI-11", Name: "Vaduz", Type: "Commune"},
+	{Code: "LK-1", Name: "Western Province", Type: "Province"},
+	{Code: "LK-11", Name: "Colombo", Type: "District", Parent: "LK-1"},
+	{Code: "LK-12", Name: "Gampaha", Type: "District", Parent: "LK-1"},
+	{Code: "LK-13", Name: "Kalutara", Type: "District", Parent: "LK-1"},
+	{Code: "LK-2", Name: "Central Province", Type: "Province"},
+	{Code: "LK-21", Name: "Kandy", Type: "District", Parent: "LK-2"},
+	{Code: "LK-22", Name: "Matale", Type: "District", Parent: "LK-2"},
+	{Code: "LK-23", Name: "Nuwara Eliya", Type: "District", Parent: "LK-2"},
+	{Code: "LK-3", Name: "Southern Province", Type: "Province"},
+	{Code: "LK-31", Name: "Galle", Type: "District", Parent: "LK-3"},
+	{Code: "LK-32", Name: "Matara", Type: "District", Parent: "LK-3"},
+	{Code: "LK-33", Name: "Hambantota", Type: "District", Parent: "LK-3"},
+	{Code: "LK-4", Name: "Northern Province", Type: "Province"},
+	{Code: "LK-41", Name: "Jaffna", Type: "District", Parent: "LK-4"},
+	{Code: "LK-42", Name: "Kilinochchi", Type: "District", Parent: "LK-4"},
+	{Code: "LK-43", Name: "Mannar", Type: "District", Parent: "LK-4"},
+	{Code: "LK-44", Name: "Vavuniya", Type: "District", Parent: "LK-4"},
+	{Code: "LK-45", Name: "Mullaittivu", Type: "District", Parent: "LK-4"},
+	{Code: "LK-5", Name: "Eastern Province", Type: "Province"},
+	{Code: "LK-51", Name: "Batticaloa", Type: "District", Parent: "LK-5"},
+	{Code: "LK-52", Name: "Ampara", Type: "District", Parent: "LK-5"},
+	{Code: "LK-53", Name: "Trincomalee", Type: "District", Parent: "LK-5"},
+	{Code: "LK-6", Name: "North Western Province", Type: "Province"},
+	{Code: "LK-61", Name: "Kurunegala", Type: "District", Parent: "LK-6"},
+	{Code: "LK-62", Name: "Puttalam", Type: "District", Parent: "LK-6"},
+	{Code: "LK-7", Name: "North Central Province", Type: "Province"},
+	{Code: "LK-71", Name: "Anuradhapura", Type: "District", Parent: "LK-7"},
+	{Code: "LK-72", Name: "Polonnaruwa", Type: "District", Parent: "LK-7"},
+	{Code: "LK-8", Name: "Uva Province", Type: "Province"},
+	{Code: "LK-81", Name: "Badulla", Type: "District", Parent: "LK-8"},
+	{Code: "LK-82", Name: "Monaragala", Type: "District", Parent: "LK-8"},
+	{Code: "LK-9", Name: "Sabaragamuwa Province", Type: "Province"},
+	{Code: "LK-91", Name: "Ratnapura", Type: "District", Parent: "LK-9"},
+	{Code: "LK-92", Name: "Kegalla", Type: "District", Parent: "LK-9"},
+	{Code: "LR-BG", Name: "Bong", Type: "County"},
+	{Code: "LR-BM", Name: "Bomi", Type: "County"},
+	{Code: "LR-CM", Name: "Grand Cape Mount", Type: "County"},
+	{Code: "LR-GB", Name: "Grand Bassa", Type: "County"},
+	{Code: "LR-GG", Name: "Grand Gedeh", Type: "County"},
+	{Code: "LR-GK", Name: "Grand Kru", Type: "County"},
+	{Code: "LR-GP", Name: "Gbarpolu", Type: "County"},
+	{Code: "LR-LO", Name: "Lofa", Type: "County"},
+	{Code: "LR-MG", Name: "Margibi", Type: "County"},
+	{Code: "LR-MO", Name: "Montserrado", Type: "County"},
+	{Code: "LR-MY", Name: "Maryland", Type: "County"},
+	{Code: "LR-NI", Name: "Nimba", Type: "County"},
+	{Code: "LR-RG", Name: "River Gee", Type: "County"},
+	{Code: "LR-RI", Name: "River Cess", Type: "County"},
+	{Code: "LR-SI", Name: "Sinoe", Type: "County"},
+	{Code: "LS-A", Name: "Maseru", Type: "District"},
+	{Code: "LS-B", Name: "Botha-Bothe", Type: "District"},
+	{Code: "LS-C", Name: "Leribe", Type: "District"},
+	{Code: "LS-D", Name: "Berea", Type: "District"},
+	{Code: "LS-E", Name: "Mafeteng", Type: "District"},
+	{Code: "LS-F", Name: "Mohale's Hoek", Type: "District"},
+	{Code: "LS-G", Name: "Quthing", Type: "District"},
+	{Code: "LS-H", Name: "Qacha's Nek", Type: "District"},
+	{Code: "LS-J", Name: "Mokhotlong", Type: "District"},
+	{Code: "LS-K", Name: "Thaba-Tseka", Type: "District"},
+	{Code: "LT-01", Name: "Akmenė", Type: "District municipality"},
+	{Code: "LT-02", Name: "Alytaus miestas", Type: "City municipality"},
+	{Code: "LT-03", Name: "Alytus", Type: "District municipality"},
+	{Code: "LT-04", Name: "Anykščiai", Type: "District municipality"},
+	{Code: "LT-05", Name: "Birštono", Type: "Municipality"},
+	{Code: "LT-06", Name: "Biržai", Type: "District municipality"},
+	{Code: "LT-07", Name: "Druskininkai", Type: "Municipality"},
+	{Code: "LT-08", Name: "Elektrėnai", Type: "Municipality"},
+	{Code: "LT-09", Name: "Ignalina", Type: "District municipality"},
+	{Code: "LT-10", Name: "Jonava", Type: "District municipality"},
+	{Code: "LT-11", Name: "Joniškis", Type: "District municipality"},
+	{Code: "LT-12", Name: "Jurbarkas", Type: "District municipality"},
+	{Code: "LT-13", Name: "Kaišiadorys", Type: "District municipality"},
+	{Code: "LT-14", Name: "Kalvarijos", Type: "Municipality"},
+	{Code: "LT-15", Name: "Kauno miestas", Type: "City municipality"},
+	{Code: "LT-16", Name: "Kaunas", Type: "District municipality"},
+	{Code: "LT-17", Name: "Kazlų Rūdos", Type: "Municipality"},
+	{Code: "LT-18", Name: "Kėdainiai", Type: "District municipality"},
+	{Code: "LT-19", Name: "Kelmė", Type: "District municipality"},
+	{Code: "LT-20", Name: "Klaipėdos miestas", Type: "City municipality"},
+	{Code: "LT-21", Name: "Klaipėda", Type: "District municipality"},
+	{Code: "LT-22", Name: "Kretinga", Type: "District municipality"},
+	{Code: "LT-23", Name: "Kupiškis", Type: "District municipality"},
+	{Code: "LT-24", Name: "Lazdijai", Type: "District municipality"},
+	{Code: "LT-25", Name: "Marijampolė", Type: "District municipality"},
+	{Code: "LT-26", Name: "Mažeikiai", Type: "District municipality"},
+	{Code: "LT-27", Name: "Molėtai", Type: "District municipality"},
+	{Code: "LT-28", Name: "Neringa", Type: "Municipality"},
+	{Code: "LT-29", Name: "Pagėgiai", Type: "Municipality"},
+	{Code: "LT-30", Name: "Pakruojis", Type: "District municipality"},
+	{Code: "LT-31", Name: "Palangos miestas", Type: "City municipality"},
+	{Code: "LT-32", Name: "Panevėžio miestas", Type: "City municipality"},
+	{Code: "LT-33", Name: "Panevėžys", Type: "District municipality"},
+	{Code: "LT-34", Name: "Pasvalys", Type: "District municipality"},
+	{Code: "LT-35", Name: "Plungė", Type: "District municipality"},
+	{Code: "LT-36", Name: "Prienai", Type: "District municipality"},
+	{Code: "LT-37", Name: "Radviliškis", Type: "District municipality"},
+	{Code: "LT-38", Name: "Raseiniai", Type: "District municipality"},
+	{Code: "LT-39", Name: "Rietavo", Type: "Municipality"},
+	{Code: "LT-40", Name: "Rokiškis", Type: "District municipality"},
+	{Code: "LT-41", Name: "Šakiai", Type: "District municipality"},
+	{Code: "LT-42", Name: "Šalčininkai", Type: "District municipality"},
+	{Code: "LT-43", Name: "Šiaulių miestas", Type: "City municipality"},
+	{Code: "LT-44", Name: "Šiauliai", Type: "District municipality"},
+	{Code: "LT-45", Name: "Šilalė", Type: "District municipality"},
+	{Code: "LT-46", Name: "Šilutė", Type: "District municipality"},
+	{Code: "LT-47", Name: "Širvintos", Type: "District municipality"},
+	{Code: "LT-48", Name: "Skuodas", Type: "District municipality"},
+	{Code: "LT-49", Name: "Švenčionys", Type: "District municipality"},
+	{Code: "LT-50", Name: "Tauragė", Type: "District municipality"},
+	{Code: "LT-51", Name: "Telšiai", Type: "District municipality"},
+	{Code: "LT-52", Name: "Trakai", Type: "District municipality"},
+	{Code: "LT-53", Name: "Ukmergė", Type: "District municipality"},
+	{Code: "LT-54", Name: "Utena", Type: "District municipality"},
+	{Code: "LT-55", Name: "Varėna", Type: "District municipality"},
+	{Code: "LT-56", Name: "Vilkaviškis", Type: "District municipality"},
+	{Code: "LT-57", Name: "Vilniaus miestas", Type: "City municipality"},
+	{Code: "LT-58", Name: "Vilnius", Type: "District municipality"},
+	{Code: "LT-59", Name: "Visaginas", Type: "Municipality"},
+	{Code: "LT-60", Name: "Zarasai", Type: "District municipality"},
+	{Code: "LT-AL", Name: "Alytaus apskritis", Type: "County"},
+	{Code: "LT-KL", Name: "Klaipėdos apskritis", Type: "County"},
+	{Code: "LT-KU", Name: "Kauno apskritis", Type: "County"},
+	{Code: "LT-MR", Name: "Marijampolės apskritis", Type: "County"},
+	{Code: "LT-PN", Name: "Panevėžio apskritis", Type: "County"},
+	{Code: "LT-SA", Name: "Šiaulių apskritis", Type: "County"},
+	{Code: "LT-TA", Name: "Tauragės apskritis", Type: "County"},
+	{Code: "LT-TE", Name: "Telšių apskritis", Type: "County"},
+	{Code: "LT-UT", Name: "Utenos apskritis", Type: "County"},
+	{Code: "LT-VL", Name: "Vilniaus apskritis", Type: "County"},
+	{Code: "LU-CA", Name: "Capellen", Type: "Canton"},
+	{Code: "LU-CL", Name: "Clerf", Type: "Canton"},
+	{Code: "LU-DI", Name: "Diekirch", Type: "Canton"},
+	{Code: "LU-EC", Name: "Echternach", Type: "Canton"},
+	{Code: "LU-ES", Name: "Esch an der Alzette", Type: "Canton"},
+	{Code: "LU-GR", Name: "Grevenmacher", Type: "Canton"},
+	{Code: "LU-LU", Name: "Luxembourg", Type: "Canton"},
+	{Code: "LU-ME", Name: "Mersch", Type: "Canton"},
+	{Code: "LU-RD", Name: "Redange", Type: "Canton"},
+	{Code: "LU-RM", Name: "Remich", Type: "Canton"},
+	{Code: "LU-VD", Name: "Veianen", Type: "Canton"},
+	{Code: "LU-WI", Name: "Wiltz", Type: "Canton"},
+	{Code: "LV-001", Name: "Aglonas novads", Type: "Municipality"},
+	{Code: "LV-002", Name: "Aizkraukles novads", Type: "Municipality"},
+	{Code: "LV-003", Name: "Aizputes novads", Type: "Municipality"},
+	{Code: "LV-004", Name: "Aknīstes novads", Type: "Municipality"},
+	{Code: "LV-005", Name: "Alojas novads", Type: "Municipality"},
+	{Code: "LV-006", Name: "Alsungas novads", Type: "Municipality"},
+	{Code: "LV-007", Name: "Alūksnes novads", Type: "Municipality"},
+	{Code: "LV-008", Name: "Amatas novads", Type: "Municipality"},
+	{Code: "LV-009", Name: "Apes novads", Type: "Municipality"},
+	{Code: "LV-010", Name: "Auces novads", Type: "Municipality"},
+	{Code: "LV-011", Name: "Ādažu novads", Type: "Municipality"},
+	{Code: "LV-012", Name: "Babītes novads", Type: "Municipality"},
+	{Code: "LV-013", Name: "Baldones novads", Type: "Municipality"},
+	{Code: "LV-014", Name: "Baltinavas novads", Type: "Municipality"},
+	{Code: "LV-015", Name: "Balvu novads", Type: "Municipality"},
+	{Code: "LV-016", Name: "Bauskas novads", Type: "Municipality"},
+	{Code: "LV-017", Name: "Beverīnas novads", Type: "Municipality"},
+	{Code: "LV-018", Name: "Brocēnu novads", Type: "Municipality"},
+	{Code: "LV-019", Name: "Burtnieku novads", Type: "Municipality"},
+	{Code: "LV-020", Name: "Carnikavas novads", Type: "Municipality"},
+	{Code: "LV-021", Name: "Cesvaines novads", Type: "Municipality"},
+	{Code: "LV-022", Name: "Cēsu novads", Type: "Municipality"},
+	{Code: "LV-023", Name: "Ciblas novads", Type: "Municipality"},
+	{Code: "LV-024", Name: "Dagdas novads", Type: "Municipality"},
+	{Code: "LV-025", Name: "Daugavpils novads", Type: "Municipality"},
+	{Code: "LV-026", Name: "Dobeles novads", Type: "Municipality"},
+	{Code: "LV-027", Name: "Dundagas novads", Type: "Municipality"},
+	{Code: "LV-028", Name: "Durbes novads", Type: "Municipality"},
+	{Code: "LV-029", Name: "Engures novads", Type: "Municipality"},
+	{Code: "LV-030", Name: "Ērgļu novads", Type: "Municipality"},
+	{Code: "LV-031", Name: "Garkalnes novads", Type: "Municipality"},
+	{Code: "LV-032", Name: "Grobiņas novads", Type: "Municipality"},
+	{Code: "LV-033", Name: "Gulbenes novads", Type: "Municipality"},
+	{Code: "LV-034", Name: "Iecavas novads", Type: "Municipality"},
+	{Code: "LV-035", Name: "Ikšķiles novads", Type: "Municipality"},
+	{Code: "LV-036", Name: "Ilūkstes novads", Type: "Municipality"},
+	{Code: "LV-037", Name: "Inčukalna novads", Type: "Municipality"},
+	{Code: "LV-038", Name: "Jaunjelgavas novads", Type: "Municipality"},
+	{Code: "LV-039", Name: "Jaunpiebalgas novads", Type: "Municipality"},
+	{Code: "LV-040", Name: "Jaunpils novads", Type: "Municipality"},
+	{Code: "LV-041", Name: "Jelgavas novads", Type: "Municipality"},
+	{Code: "LV-042", Name: "Jēkabpils novads", Type: "Municipality"},
+	{Code: "LV-043", Name: "Kandavas novads", Type: "Municipality"},
+	{Code: "LV-044", Name: "Kārsavas novads", Type: "Municipality"},
+	{Code: "LV-045", Name: "Kocēnu novads", Type: "Municipality"},
+	{Code: "LV-046", Name: "Kokneses novads", Type: "Municipality"},
+	{Code: "LV-047", Name: "Krāslavas novads", Type: "Municipality"},
+	{Code: "LV-048", Name: "Krimuldas novads", Type: "Municipality"},
+	{Code: "LV-049", Name: "Krustpils novads", Type: "Municipality"},
+	{Code: "LV-050", Name: "Kuldīgas novads", Type: "Municipality"},
+	{Code: "LV-051", Name: "Ķeguma novads", Type: "Municipality"},
+	{Code: "LV-052", Name: "Ķekavas novads", Type: "Municipality"},
+	{Code: "LV-053", Name: "Lielvārdes novads", Type: "Municipality"},
+	{Code: "LV-054", Name: "Limbažu novads", Type: "Municipality"},
+	{Code: "LV-055", Name: "Līgatnes novads", Type: "Municipality"},
+	{Code: "LV-056", Name: "Līvānu novads", Type: "Municipality"},
+	{Code: "LV-057", Name: "Lubānas novads", Type: "Municipality"},
+	{Code: "LV-058", Name: "Ludzas novads", Type: "Municipality"},
+	{Code: "LV-059", Name: "Madonas novads", Type: "Municipality"},
+	{Code: "LV-060", Name: "Mazsalacas novads", Type: "Municipality"},
+	{Code: "LV-061", Name: "Mālpils novads", Type: "Municipality"},
+	{Code: "LV-062", Name: "Mārupes novads", Type: "Municipality"},
+	{Code: "LV-063", Name: "Mērsraga novads", Type: "Municipality"},
+	{Code: "LV-064", Name: "Naukšēnu novads", Type: "Municipality"},
+	{Code: "LV-065", Name: "Neretas novads", Type: "Municipality"},
+	{Code: "LV-066", Name: "Nīcas novads", Type: "Municipality"},
+	{Code: "LV-067", Name: "Ogres novads", Type: "Municipality"},
+	{Code: "LV-068", Name: "Olaines novads", Type: "Municipality"},
+	{Code: "LV-069", Name: "Ozolnieku novads", Type: "Municipality"},
+	{Code: "LV-070", Name: "Pārgaujas novads", Type: "Municipality"},
+	{Code: "LV-071", Name: "Pāvilostas novads", Type: "Municipality"},
+	{Code: "LV-072", Name: "Pļaviņu novads", Type: "Municipality"},
+	{Code: "LV-073", Name: "Preiļu novads", Type: "Municipality"},
+	{Code: "LV-074", Name: "Priekules novads", Type: "Municipality"},
+	{Code: "LV-075", Name: "Priekuļu novads", Type: "Municipality"},
+	{Code: "LV-076", Name: "Raunas novads", Type: "Municipality"},
+	{Code: "LV-077", Name: "Rēzeknes novads", Type: "Municipality"},
+	{Code: "LV-078", Name: "Riebiņu novads", Type: "Municipality"},
+	{Code: "LV-079", Name: "Rojas novads", Type: "Municipality"},
+	{Code: "LV-080", Name: "Ropažu novads", Type: "Municipality"},
+	{Code: "LV-081", Name: "Rucavas novads", Type: "Municipality"},
+	{Code: "LV-082", Name: "Rugāju novads", Type: "Municipality"},
+	{Code: "LV-083", Name: "Rundāles novads", Type: "Municipality"},
+	{Code: "LV-084", Name: "Rūjienas novads", Type: "Municipality"},
+	{Code: "LV-085", Name: "Salas novads", Type: "Municipality"},
+	{Code: "LV-086", Name: "Salacgrīvas novads", Type: "Municipality"},
+	{Code: "LV-087", Name: "Salaspils novads", Type: "Municipality"},
+	{Code: "LV-088", Name: "Saldus novads", Type: "Municipality"},
+	{Code: "LV-089", Name: "Saulkrastu novads", Type: "Municipality"},
+	{Code: "LV-090", Name: "Sējas novads", Type: "Municipality"},
+	{Code: "LV-091", Name: "Siguldas novads", Type: "Municipality"},
+	{Code: "LV-092", Name: "Skrīveru novads", Type: "Municipality"},
+	{Code: "LV-093", Name: "Skrundas novads", Type: "Municipality"},
+	{Code: "LV-094", Name: "Smiltenes novads", Type: "Municipality"},
+	{Code: "LV-095", Name: "Stopiņu novads", Type: "Municipality"},
+	{Code: "LV-096", Name: "Strenču novads", Type: "Municipality"},
+	{Code: "LV-097", Name: "Talsu novads", Type: "Municipality"},
+	{Code: "LV-098", Name: "Tērvetes novads", Type: "Municipality"},
+	{Code: "LV-099", Name: "Tukuma novads", Type: "Municipality"},
+	{Code: "LV-100", Name: "Vaiņodes novads", Type: "Municipality"},
+	{Code: "LV-101", Name: "Valkas novads", Type: "Municipality"},
+	{Code: "LV-102", Name: "Varakļānu novads", Type: "Municipality"},
+	{Code: "LV-103", Name: "Vārkavas novads", Type: "Municipality"},
+	{Code: "LV-104", Name: "Vecpiebalgas novads", Type: "Municipality"},
+	{Code: "LV-105", Name: "Vecumnieku novads", Type: "Municipality"},
+	{Code: "LV-106", Name: "Ventspils novads", Type: "Municipality"},
+	{Code: "LV-107", Name: "Viesītes novads", Type: "Municipality"},
+	{Code: "LV-108", Name: "Viļakas novads", Type: "Municipality"},
+	{Code: "LV-109", Name: "Viļānu novads", Type: "Municipality"},
+	{Code: "LV-110", Name: "Zilupes novads", Type: "Municipality"},
+	{Code: "LV-DGV", Name: "Daugavpils", Type: "Republican city"},
+	{Code: "LV-JEL", Name: "Jelgava", Type: "Republican city"},
+	{Code: "LV-JKB", Name: "Jēkabpils", Type: "Republican city"},
+	{Code: "LV-JUR", Name: "Jūrmala", Type: "Republican city"},
+	{Code: "LV-LPX", Name: "Liepāja", Type: "Republican city"},
+	{Code: "LV-REZ", Name: "Rēzekne", Type: "Republican city"},
+	{Code: "LV-RIX", Name: "Rīga", Type: "Republican city"},
+	{Code: "LV-VEN", Name: "Ventspils", Type: "Republican city"},
+	{Code: "LV-VMR", Name: "Valmiera", Type: "Republican city"},
+	{Code: "LY-BA", Name: "Banghāzī", Type: "Popularate"},
+	{Code: "LY-BU", Name: "Al Buţnān", Type: "Popularate"},
+	{Code: "LY-DR", Name: "Darnah", Type: "Popularate"},
+	{Code: "LY-GT", Name: "Ghāt", Type: "Popularate"},
+	{Code: "LY-JA", Name: "Al Jabal al Akhḑar", Type: "Popularate"},
+	{Code: "LY-JG", Name: "Al Jabal al Gharbī", Type: "Popularate"},
+	{Code: "LY-JI", Name: "Al Jafārah", Type: "Popularate"},
+	{Code: "LY-JU", Name: "Al Jufrah", Type: "Popularate"},
+	{Code: "LY-KF", Name: "Al Kufrah", Type: "Popularate"},
+	{Code: "LY-MB", Name: "Al Marqab", Type: "Popularate"},
+	{Code: "LY-MI", Name: "Mişrātah", Type: "Popularate"},
+	{Code: "LY-MJ", Name: "Al Marj", Type: "Popularate"},
+	{Code: "LY-MQ", Name: "Murzuq", Type: "Popularate"},
+	{Code: "LY-NL", Name: "Nālūt", Type: "Popularate"},
+	{Code: "LY-NQ", Name: "An Nuqāţ al Khams", Type: "Popularate"},
+	{Code: "LY-SB", Name: "Sabhā", Type: "Popularate"},
+	{Code: "LY-SR", Name: "Surt", Type: "Popularate"},
+	{Code: "LY-TB", Name: "Ţarābulus", Type: "Popularate"},
+	{Code: "LY-WA", Name: "Al Wāḩāt", Type: "Popularate"},
+	{Code: "LY-WD", Name: "Wādī al Ḩayāt", Type: "Popularate"},
+	{Code: "LY-WS", Name: "Wādī ash Shāţi’", Type: "Popularate"},
+	{Code: "LY-ZA", Name: "Az Zāwiyah", Type: "Popularate"},
+	{Code: "MA-01", Name: "Tanger-Tétouan-Al Hoceïma", Type: "Region"},
+	{Code: "MA-02", Name: "L'Oriental", Type: "Region"},
+	{Code: "MA-03", Name: "Fès-Meknès", Type: "Region"},
+	{Code: "MA-04", Name: "Rabat-Salé-Kénitra", Type: "Region"},
+	{Code: "MA-05", Name: "Béni Mellal-Khénifra", Type: "Region"},
+	{Code: "MA-06", Name: "Casablanca-Settat", Type: "Region"},
+	{Code: "MA-07", Name: "Marrakech-Safi", Type: "Region"},
+	{Code: "MA-08", Name: "Drâa-Tafilalet", Type: "Region"},
+	{Code: "MA-09", Name: "Souss-Massa", Type: "Region"},
+	{Code: "MA-10", Name: "Guelmim-Oued Noun (EH-partial)", Type: "Region"},
+	{Code: "MA-11", Name: "Laâyoune-Sakia El Hamra (EH-partial)", Type: "Region"},
+	{Code: "MA-12", Name: "Dakhla-Oued Ed-Dahab (EH)", Type: "Region"},
+	{Code: "MA-AGD", Name: "Agadir-Ida-Ou-Tanane", Type: "Prefecture", Parent: "MA-09"},
+	{Code: "MA-AOU", Name: "Aousserd (EH)", Type: "Province", Parent: "MA-12"},
+	{Code: "MA-ASZ", Name: "Assa-Zag (EH-partial)", Type: "Province", Parent: "MA-10"},
+	{Code: "MA-AZI", Name: "Azilal", Type: "Province", Parent: "MA-05"},
+	{Code: "MA-BEM", Name: "Béni Mellal", Type: "Province", Parent: "MA-05"},
+	{Code: "MA-BER", Name: "Berkane", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-BES", Name: "Benslimane", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-BOD", Name: "Boujdour (EH)", Type: "Province", Parent: "MA-11"},
+	{Code: "MA-BOM", Name: "Boulemane", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-BRR", Name: "Berrechid", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-CAS", Name: "Casablanca", Type: "Prefecture", Parent: "MA-06"},
+	{Code: "MA-CHE", Name: "Chefchaouen", Type: "Province", Parent: "MA-01"},
+	{Code: "MA-CHI", Name: "Chichaoua", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-CHT", Name: "Chtouka-Ait Baha", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-DRI", Name: "Driouch", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-ERR", Name: "Errachidia", Type: "Province", Parent: "MA-08"},
+	{Code: "MA-ESI", Name: "Essaouira", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-ESM", Name: "Es-Semara (EH-partial)", Type: "Province", Parent: "MA-11"},
+	{Code: "MA-FAH", Name: "Fahs-Anjra", Type: "Province", Parent: "MA-01"},
+	{Code: "MA-FES", Name: "Fès", Type: "Prefecture", Parent: "MA-03"},
+	{Code: "MA-FIG", Name: "Figuig", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-FQH", Name: "Fquih Ben Salah", Type: "Province", Parent: "MA-05"},
+	{Code: "MA-GUE", Name: "Guelmim", Type: "Province", Parent: "MA-10"},
+	{Code: "MA-GUF", Name: "Guercif", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-HAJ", Name: "El Hajeb", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-HAO", Name: "Al Haouz", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-HOC", Name: "Al Hoceïma", Type: "Province", Parent: "MA-01"},
+	{Code: "MA-IFR", Name: "Ifrane", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-INE", Name: "Inezgane-Ait Melloul", Type: "Prefecture", Parent: "MA-09"},
+	{Code: "MA-JDI", Name: "El Jadida", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-JRA", Name: "Jerada", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-KEN", Name: "Kénitra", Type: "Province", Parent: "MA-04"},
+	{Code: "MA-KES", Name: "El Kelâa des Sraghna", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-KHE", Name: "Khémisset", Type: "Province", Parent: "MA-04"},
+	{Code: "MA-KHN", Name: "Khénifra", Type: "Province", Parent: "MA-05"},
+	{Code: "MA-KHO", Name: "Khouribga", Type: "Province", Parent: "MA-05"},
+	{Code: "MA-LAA", Name: "Laâyoune (EH)", Type: "Province", Parent: "MA-11"},
+	{Code: "MA-LAR", Name: "Larache", Type: "Province", Parent: "MA-01"},
+	{Code: "MA-MAR", Name: "Marrakech", Type: "Prefecture", Parent: "MA-07"},
+	{Code: "MA-MDF", Name: "M’diq-Fnideq", Type: "Prefecture", Parent: "MA-01"},
+	{Code: "MA-MED", Name: "Médiouna", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-MEK", Name: "Meknès", Type: "Prefecture", Parent: "MA-03"},
+	{Code: "MA-MID", Name: "Midelt", Type: "Province", Parent: "MA-08"},
+	{Code: "MA-MOH", Name: "Mohammadia", Type: "Prefecture", Parent: "MA-06"},
+	{Code: "MA-MOU", Name: "Moulay Yacoub", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-NAD", Name: "Nador", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-NOU", Name: "Nouaceur", Type: "Province", Parent: "MA-04"},
+	{Code: "MA-OUA", Name: "Ouarzazate", Type: "Province", Parent: "MA-08"},
+	{Code: "MA-OUD", Name: "Oued Ed-Dahab (EH)", Type: "Province", Parent: "MA-12"},
+	{Code: "MA-OUJ", Name: "Oujda-Angad", Type: "Prefecture", Parent: "MA-02"},
+	{Code: "MA-OUZ", Name: "Ouezzane", Type: "Province", Parent: "MA-01"},
+	{Code: "MA-RAB", Name: "Rabat", Type: "Prefecture", Parent: "MA-04"},
+	{Code: "MA-REH", Name: "Rehamna", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-SAF", Name: "Safi", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-SAL", Name: "Salé", Type: "Prefecture", Parent: "MA-04"},
+	{Code: "MA-SEF", Name: "Sefrou", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-SET", Name: "Settat", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-SIB", Name: "Sidi Bennour", Type: "Province", Parent: "MA-06"},
+	{Code: "MA-SIF", Name: "Sidi Ifni", Type: "Province", Parent: "MA-10"},
+	{Code: "MA-SIK", Name: "Sidi Kacem", Type: "Province", Parent: "MA-04"},
+	{Code: "MA-SIL", Name: "Sidi Slimane", Type: "Province", Parent: "MA-04"},
+	{Code: "MA-SKH", Name: "Skhirate-Témara", Type: "Prefecture", Parent: "MA-04"},
+	{Code: "MA-TAF", Name: "Tarfaya (EH-partial)", Type: "Province", Parent: "MA-11"},
+	{Code: "MA-TAI", Name: "Taourirt", Type: "Province", Parent: "MA-02"},
+	{Code: "MA-TAO", Name: "Taounate", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-TAR", Name: "Taroudannt", Type: "Province", Parent: "MA-09"},
+	{Code: "MA-TAT", Name: "Tata", Type: "Province", Parent: "MA-09"},
+	{Code: "MA-TAZ", Name: "Taza", Type: "Province", Parent: "MA-03"},
+	{Code: "MA-TET", Name: "Tétouan", Type: "Province", Parent: "MA-01"},
+	{Code: "MA-TIN", Name: "Tinghir", Type: "Province", Parent: "MA-08"},
+	{Code: "MA-TIZ", Name: "Tiznit", Type: "Province", Parent: "MA-09"},
+	{Code: "MA-TNG", Name: "Tanger-Assilah", Type: "Prefecture", Parent: "MA-01"},
+	{Code: "MA-TNT", Name: "Tan-Tan (EH-partial)", Type: "Province", Parent: "MA-10"},
+	{Code: "MA-YUS", Name: "Youssoufia", Type: "Province", Parent: "MA-07"},
+	{Code: "MA-ZAG", Name: "Zagora", Type: "Province", Parent: "MA-08"},
+	{Code: "MC-CL", Name: "La Colle", Type: "Quarter"},
+	{Code: "MC-CO", Name: "La Condamine", Type: "Quarter"},
+	{Code: "MC-FO", Name: "Fontvieille", Type: "Quarter"},
+	{Code: "MC-GA", Name: "La Gare", Type: "Quarter"},
+	{Code: "MC-JE", Name: "Jardin Exotique", Type: "Quarter"},
+	{Code: "MC-LA", Name: "Larvotto", Type: "Quarter"},
+	{Code: "MC-MA", Name: "Malbousquet", Type: "Quarter"},
+	{Code: "MC-MC", Name: "Monte-Carlo", Type: "Quarter"},
+	{Code: "MC-MG", Name: "Moneghetti", Type: "Quarter"},
+	{Code: "MC-MO", Name: "Monaco-Ville", Type: "Quarter"},
+	{Code: "MC-MU", Name: "Moulins", Type: "Quarter"},
+	{Code: "MC-PH", Name: "Port-Hercule", Type: "Quarter"},
+	{Code: "MC-SD", Name: "Sainte-Dévote", Type: "Quarter"},
+	{Code: "MC-SO", Name: "La Source", Type: "Quarter"},
+	{Code: "MC-SP", Name: "Spélugues", Type: "Quarter"},
+	{Code: "MC-SR", Name: "Saint-Roman", Type: "Quarter"},
+	{Code: "MC-VR", Name: "Vallon de la Rousse", Type: "Quarter"},
+	{Code: "MD-AN", Name: "Anenii Noi", Type: "District"},
+	{Code: "MD-BA", Name: "Bălți", Type: "City"},
+	{Code: "MD-BD", Name: "Bender [Tighina]", Type: "City"},
+	{Code: "MD-BR", Name: "Briceni", Type: "District"},
+	{Code: "MD-BS", Name: "Basarabeasca", Type: "District"},
+	{Code: "MD-CA", Name: "Cahul", Type: "District"},
+	{Code: "MD-CL", Name: "Călărași", Type: "District"},
+	{Code: "MD-CM", Name: "Cimișlia", Type: "District"},
+	{Code: "MD-CR", Name: "Criuleni", Type: "District"},
+	{Code: "MD-CS", Name: "Căușeni", Type: "District"},
+	{Code: "MD-CT", Name: "Cantemir", Type: "District"},
+	{Code: "MD-CU", Name: "Chișinău", Type: "City"},
+	{Code: "MD-DO", Name: "Dondușeni", Type: "District"},
+	{Code: "MD-DR", Name: "Drochia", Type: "District"},
+	{Code: "MD-DU", Name: "Dubăsari", Type: "District"},
+	{Code: "MD-ED", Name: "Edineț", Type: "District"},
+	{Code: "MD-FA", Name: "Fălești", Type: "District"},
+	{Code: "MD-FL", Name: "Florești", Type: "District"},
+	{Code: "MD-GA", Name: "Găgăuzia, Unitatea teritorială autonomă (UTAG)", Type: "Autonomous territorial unit"},
+	{Code: "MD-GL", Name: "Glodeni", Type: "District"},
+	{Code: "MD-HI", Name: "Hîncești", Type: "District"},
+	{Code: "MD-IA", Name: "Ialoveni", Type: "District"},
+	{Code: "MD-LE", Name: "Leova", Type: "District"},
+	{Code: "MD-NI", Name: "Nisporeni", Type: "District"},
+	{Code: "MD-OC", Name: "Ocnița", Type: "District"},
+	{Code: "MD-OR", Name: "Orhei", Type: "District"},
+	{Code: "MD-RE", Name: "Rezina", Type: "District"},
+	{Code: "MD-RI", Name: "Rîșcani", Type: "District"},
+	{Code: "MD-SD", Name: "Șoldănești", Type: "District"},
+	{Code: "MD-SI", Name: "Sîngerei", Type: "District"},
+	{Code: "MD-SN", Name: "Stînga Nistrului, unitatea teritorială din", Type: "Territorial unit"},
+	{Code: "MD-SO", Name: "Soroca", Type: "District"},
+	{Code: "MD-ST", Name: "Strășeni", Type: "District"},
+	{Code: "MD-SV", Name: "Ștefan Vodă", Type: "District"},
+	{Code: "MD-TA", Name: "Taraclia", Type: "District"},
+	{Code: "MD-TE", Name: "Telenești", Type: "District"},
+	{Code: "MD-UN", Name: "Ungheni", Type: "District"},
+	{Code: "ME-01", Name: "Andrijevica", Type: "Municipality"},
+	{Code: "ME-02", Name: "Bar", Type: "Municipality"},
+	{Code: "ME-03", Name: "Berane", Type: "Municipality"},
+	{Code: "ME-04", Name: "Bijelo Polje", Type: "Municipality"},
+	{Code: "ME-05", Name: "Budva", Type: "Municipality"},
+	{Code: "ME-06", Name: "Cetinje", Type: "Municipality"},
+	{Code: "ME-07", Name: "Danilovgrad", Type: "Municipality"},
+	{Code: "ME-08", Name: "Herceg-Novi", Type: "Municipality"},
+	{Code: "ME-09", Name: "Kolašin", Type: "Municipality"},
+	{Code: "ME-10", Name: "Kotor", Type: "Municipality"},
+	{Code: "ME-11", Name: "Mojkovac", Type: "Municipality"},
+	{Code: "ME-12", Name: "Nikšić", Type: "Municipality"},
+	{Code: "ME-13", Name: "Plav", Type: "Municipality"},
+	{Code: "ME-14", Name: "Pljevlja", Type: "Municipality"},
+	{Code: "ME-15", Name: "Plužine", Type: "Municipality"},
+	{Code: "ME-16", Name: "Podgorica", Type: "Municipality"},
+	{Code: "ME-17", Name: "Rožaje", Type: "Municipality"},
+	{Code: "ME-18", Name: "Šavnik", Type: "Municipality"},
+	{Code: "ME-19", Name: "Tivat", Type: "Municipality"},
+	{Code: "ME-20", Name: "Ulcinj", Type: "Municipality"},
+	{Code: "ME-21", Name: "Žabljak", Type: "Municipality"},
+	{Code: "ME-22", Name: "Gusinje", Type: "Municipality"},
+	{Code: "ME-23", Name: "Petnjica", Type: "Municipality"},
+	{Code: "ME-24", Name: "Tuzi", Type: "Municipality"},
+	{Code: "MG-A", Name: "Toamasina", Type: "Province"},
+	{Code: "MG-D", Name: "Antsiranana", Type: "Province"},
+	{Code: "MG-F", Name: "Fianarantsoa", Type: "Province"},
+	{Code: "MG-M", Name: "Mahajanga", Type: "Province"},
+	{Code: "MG-T", Name: "Antananarivo", Type: "Province"},
+	{Code: "MG-U", Name: "Toliara", Type: "Province"},
+	{Code: "MH-ALK", Name: "Ailuk", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-ALL", Name: "Ailinglaplap", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-ARN", Name: "Arno", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-AUR", Name: "Aur", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-EBO", Name: "Ebon", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-ENI", Name: "Enewetak & Ujelang", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-JAB", Name: "Jabat", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-JAL", Name: "Jaluit", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-KIL", Name: "Bikini & Kili", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-KWA", Name: "Kwajalein", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-L", Name: "Ralik chain", Type: "Chain (of islands)"},
+	{Code: "MH-LAE", Name: "Lae", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-LIB", Name: "Lib", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-LIK", Name: "Likiep", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-MAJ", Name: "Majuro", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-MAL", Name: "Maloelap", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-MEJ", Name: "Mejit", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-MIL", Name: "Mili", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-NMK", Name: "Namdrik", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-NMU", Name: "Namu", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-RON", Name: "Rongelap", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-T", Name: "Ratak chain", Type: "Chain (of islands)"},
+	{Code: "MH-UJA", Name: "Ujae", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-UTI", Name: "Utrik", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MH-WTH", Name: "Wotho", Type: "Municipality", Parent: "MH-L"},
+	{Code: "MH-WTJ", Name: "Wotje", Type: "Municipality", Parent: "MH-T"},
+	{Code: "MK-101", Name: "Veles", Type: "Municipality"},
+	{Code: "MK-102", Name: "Gradsko", Type: "Municipality"},
+	{Code: "MK-103", Name: "Demir Kapija", Type: "Municipality"},
+	{Code: "MK-104", Name: "Kavadarci", Type: "Municipality"},
+	{Code: "MK-105", Name: "Lozovo", Type: "Municipality"},
+	{Code: "MK-106", Name: "Negotino", Type: "Municipality"},
+	{Code: "MK-107", Name: "Rosoman", Type: "Municipality"},
+	{Code: "MK-108", Name: "Sveti Nikole", Type: "Municipality"},
+	{Code: "MK-109", Name: "Čaška", Type: "Municipality"},
+	{Code: "MK-201", Name: "Berovo", Type: "Municipality"},
+	{Code: "MK-202", Name: "Vinica", Type: "Municipality"},
+	{Code: "MK-203", Name: "Delčevo", Type: "Municipality"},
+	{Code: "MK-204", Name: "Zrnovci", Type: "Municipality"},
+	{Code: "MK-205", Name: "Karbinci", Type: "Municipality"},
+	{Code: "MK-206", Name: "Kočani", Type: "Municipality"},
+	{Code: "MK-207", Name: "Makedonska Kamenica", Type: "Municipality"},
+	{Code: "MK-208", Name: "Pehčevo", Type: "Municipality"},
+	{Code: "MK-209", Name: "Probištip", Type: "Municipality"},
+	{Code: "MK-210", Name: "Češinovo-Obleševo", Type: "Municipality"},
+	{Code: "MK-211", Name: "Štip", Type: "Municipality"},
+	{Code: "MK-301", Name: "Vevčani", Type: "Municipality"},
+	{Code: "MK-303", Name: "Debar", Type: "Municipality"},
+	{Code: "MK-304", Name: "Debrca", Type: "Municipality"},
+	{Code: "MK-307", Name: "Kičevo", Type: "Municipality"},
+	{Code: "MK-308", Name: "Makedonski Brod", Type: "Municipality"},
+	{Code: "MK-310", Name: "Ohrid", Type: "Municipality"},
+	{Code: "MK-311", Name: "Plasnica", Type: "Municipality"},
+	{Code: "MK-312", Name: "Struga", Type: "Municipality"},
+	{Code: "MK-313", Name: "Centar Župa", Type: "Municipality"},
+	{Code: "MK-401", Name: "Bogdanci", Type: "Municipality"},
+	{Code: "MK-402", Name: "Bosilovo", Type: "Municipality"},
+	{Code: "MK-403", Name: "Valandovo", Type: "Municipality"},
+	{Code: "MK-404", Name: "Vasilevo", Type: "Municipality"},
+	{Code: "MK-405", Name: "Gevgelija", Type: "Municipality"},
+	{Code: "MK-406", Name: "Dojran", Type: "Municipality"},
+	{Code: "MK-407", Name: "Konče", Type: "Municipality"},
+	{Code: "MK-408", Name: "Novo Selo", Type: "Municipality"},
+	{Code: "MK-409", Name: "Radoviš", Type: "Municipality"},
+	{Code: "MK-410", Name: "Strumica", Type: "Municipality"},
+	{Code: "MK-501", Name: "Bitola", Type: "Municipality"},
+	{Code: "MK-502", Name: "Demir Hisar", Type: "Municipality"},
+	{Code: "MK-503", Name: "Dolneni", Type: "Municipality"},
+	{Code: "MK-504", Name: "Krivogaštani", Type: "Municipality"},
+	{Code: "MK-505", Name: "Kruševo", Type: "Municipality"},
+	{Code: "MK-506", Name: "Mogila", Type: "Municipality"},
+	{Code: "MK-507", Name: "Novaci", Type: "Municipality"},
+	{Code: "MK-508", Name: "Prilep", Type: "Municipality"},
+	{Code: "MK-509", Name: "Resen", Type: "Municipality"},
+	{Code: "MK-601", Name: "Bogovinje", Type: "Municipality"},
+	{Code: "MK-602", Name: "Brvenica", Type: "Municipality"},
+	{Code: "MK-603", Name: "Vrapčište", Type: "Municipality"},
+	{Code: "MK-604", Name: "Gostivar", Type: "Municipality"},
+	{Code: "MK-605", Name: "Želino", Type: "Municipality"},
+	{Code: "MK-606", Name: "Jegunovce", Type: "Municipality"},
+	{Code: "MK-607", Name: "Mavrovo i Rostuše", Type: "Municipality"},
+	{Code: "MK-608", Name: "Tearce", Type: "Municipality"},
+	{Code: "MK-609", Name: "Tetovo", Type: "Municipality"},
+	{Code: "MK-701", Name: "Kratovo", Type: "Municipality"},
+	{Code: "MK-702", Name: "Kriva Palanka", Type: "Municipality"},
+	{Code: "MK-703", Name: "Kumanovo", Type: "Municipality"},
+	{Code: "MK-704", Name: "Lipkovo", Type: "Municipality"},
+	{Code: "MK-705", Name: "Rankovce", Type: "Municipality"},
+	{Code: "MK-706", Name: "Staro Nagoričane", Type: "Municipality"},
+	{Code: "MK-801", Name: "Aerodrom †", Type: "Municipality"},
+	{Code: "MK-802", Name: "Aračinovo", Type: "Municipality"},
+	{Code: "MK-803", Name: "Butel †", Type: "Municipality"},
+	{Code: "MK-804", Name: "Gazi Baba †", Type: "Municipality"},
+	{Code: "MK-805", Name: "Gjorče Petrov †", Type: "Municipality"},
+	{Code: "MK-806", Name: "Zelenikovo", Type: "Municipality"},
+	{Code: "MK-807", Name: "Ilinden", Type: "Municipality"},
+	{Code: "MK-808", Name: "Karpoš †", Type: "Municipality"},
+	{Code: "MK-809", Name: "Kisela Voda †", Type: "Municipality"},
+	{Code: "MK-810", Name: "Petrovec", Type: "Municipality"},
+	{Code: "MK-811", Name: "Saraj †", Type: "Municipality"},
+	{Code: "MK-812", Name: "Sopište", Type: "Municipality"},
+	{Code: "MK-813", Name: "Studeničani", Type: "Municipality"},
+	{Code: "MK-814", Name: "Centar †", Type: "Municipality"},
+	{Code: "MK-815", Name: "Čair †", Type: "Municipality"},
+	{Code: "MK-816", Name: "Čučer-Sandevo", Type: "Municipality"},
+	{Code: "MK-817", Name: "Šuto Orizari †", Type: "Municipality"},
+	{Code: "ML-1", Name: "Kayes", Type: "Region"},
+	{Code: "ML-10", Name: "Taoudénit", Type: "Region"},
+	{Code: "ML-2", Name: "Koulikoro", Type: "Region"},
+	{Code: "ML-3", Name: "Sikasso", Type: "Region"},
+	{Code: "ML-4", Name: "Ségou", Type: "Region"},
+	{Code: "ML-5", Name: "Mopti", Type: "Region"},
+	{Code: "ML-6", Name: "Tombouctou", Type: "Region"},
+	{Code: "ML-7", Name: "Gao", Type: "Region"},
+	{Code: "ML-8", Name: "Kidal", Type: "Region"},
+	{Code: "ML-9", Name: "Ménaka", Type: "Region"},
+	{Code: "ML-BKO", Name: "Bamako", Type: "District"},
+	{Code: "MM-01", Name: "Sagaing", Type: "Region"},
+	{Code: "MM-02", Name: "Bago", Type: "Region"},
+	{Code: "MM-03", Name: "Magway", Type: "Region"},
+	{Code: "MM-04", Name: "Mandalay", Type: "Region"},
+	{Code: "MM-05", Name: "Tanintharyi", Type: "Region"},
+	{Code: "MM-06", Name: "Yangon", Type: "Region"},
+	{Code: "MM-07", Name: "Ayeyarwady", Type: "Region"},
+	{Code: "MM-11", Name: "Kachin", Type: "State"},
+	{Code: "MM-12", Name: "Kayah", Type: "State"},
+	{Code: "MM-13", Name: "Kayin", Type: "State"},
+	{Code: "MM-14", Name: "Chin", Type: "State"},
+	{Code: "MM-15", Name: "Mon", Type: "State"},
+	{Code: "MM-16", Name: "Rakhine", Type: "State"},
+	{Code: "MM-17", Name: "Shan", Type: "State"},
+	{Code: "MM-18", Name: "Nay Pyi Taw", Type: "Union territory"},
+	{Code: "MN-035", Name: "Orhon", Type: "Province"},
+	{Code: "MN-037", Name: "Darhan uul", Type: "Province"},
+	{Code: "MN-039", Name: "Hentiy", Type: "Province"},
+	{Code: "MN-041", Name: "Hövsgöl", Type: "Province"},
+	{Code: "MN-043", Name: "Hovd", Type: "Province"},
+	{Code: "MN-046", Name: "Uvs", Type: "Province"},
+	{Code: "MN-047", Name: "Töv", Type: "Province"},
+	{Code: "MN-049", Name: "Selenge", Type: "Province"},
+	{Code: "MN-051", Name: "Sühbaatar", Type: "Province"},
+	{Code: "MN-053", Name: "Ömnögovĭ", Type: "Province"},
+	{Code: "MN-055", Name: "Övörhangay", Type: "Province"},
+	{Code: "MN-057", Name: "Dzavhan", Type: "Province"},
+	{Code: "MN-059", Name: "Dundgovĭ", Type: "Province"},
+	{Code: "MN-061", Name: "Dornod", Type: "Province"},
+	{Code: "MN-063", Name: "Dornogovĭ", Type: "Province"},
+	{Code: "MN-064", Name: "Govĭ-Sümber", Type: "Province"},
+	{Code: "MN-065", Name: "Govĭ-Altay", Type: "Province"},
+	{Code: "MN-067", Name: "Bulgan", Type: "Province"},
+	{Code: "MN-069", Name: "Bayanhongor", Type: "Province"},
+	{Code: "MN-071", Name: "Bayan-Ölgiy", Type: "Province"},
+	{Code: "MN-073", Name: "Arhangay", Type: "Province"},
+	{Code: "MN-1", Name: "Ulaanbaatar", Type: "Capital city"},
+	{Code: "MR-01", Name: "Hodh ech Chargui", Type: "Region"},
+	{Code: "MR-02", Name: "Hodh el Gharbi", Type: "Region"},
+	{Code: "MR-03", Name: "Assaba", Type: "Region"},
+	{Code: "MR-04", Name: "Gorgol", Type: "Region"},
+	{Code: "MR-05", Name: "Brakna", Type: "Region"},
+	{Code: "MR-06", Name: "Trarza", Type: "Region"},
+	{Code: "MR-07", Name: "Adrar", Type: "Region"},
+	{Code: "MR-08", Name: "Dakhlet Nouâdhibou", Type: "Region"},
+	{Code: "MR-09", Name: "Tagant", Type: "Region"},
+	{Code: "MR-10", Name: "Guidimaka", Type: "Region"},
+	{Code: "MR-11", Name: "Tiris Zemmour", Type: "Region"},
+	{Code: "MR-12", Name: "Inchiri", Type: "Region"},
+	{Code: "MR-13", Name: "Nouakchott Ouest", Type: "Region"},
+	{Code: "MR-14", Name: "Nouakchott Nord", Type: "Region"},
+	{Code: "MR-15", Name: "Nouakchott Sud", Type: "Region"},
+	{Code: "MT-01", Name: "Attard", Type: "Local council"},
+	{Code: "MT-02", Name: "Balzan", Type: "Local council"},
+	{Code: "MT-03", Name: "Birgu", Type: "Local council"},
+	{Code: "MT-04", Name: "Birkirkara", Type: "Local council"},
+	{Code: "MT-05", Name: "Birżebbuġa", Type: "Local council"},
+	{Code: "MT-06", Name: "Bormla", Type: "Local council"},
+	{Code: "MT-07", Name: "Dingli", Type: "Local council"},
+	{Code: "MT-08", Name: "Fgura", Type: "Local council"},
+	{Code: "MT-09", Name: "Floriana", Type: "Local council"},
+	{Code: "MT-10", Name: "Fontana", Type: "Local council"},
+	{Code: "MT-11", Name: "Gudja", Type: "Local council"},
+	{Code: "MT-12", Name: "Gżira", Type: "Local council"},
+	{Code: "MT-13", Name: "Għajnsielem", Type: "Local council"},
+	{Code: "MT-14", Name: "Għarb", Type: "Local council"},
+	{Code: "MT-15", Name: "Għargħur", Type: "Local council"},
+	{Code: "MT-16", Name: "Għasri", Type: "Local council"},
+	{Code: "MT-17", Name: "Għaxaq", Type: "Local council"},
+	{Code: "MT-18", Name: "Ħamrun", Type: "Local council"},
+	{Code: "MT-19", Name: "Iklin", Type: "Local council"},
+	{Code: "MT-20", Name: "Isla", Type: "Local council"},
+	{Code: "MT-21", Name: "Kalkara", Type: "Local council"},
+	{Code: "MT-22", Name: "Kerċem", Type: "Local council"},
+	{Code: "MT-23", Name: "Kirkop", Type: "Local council"},
+	{Code: "MT-24", Name: "Lija", Type: "Local council"},
+	{Code: "MT-25", Name: "Luqa", Type: "Local council"},
+	{Code: "MT-26", Name: "Marsa", Type: "Local council"},
+	{Code: "MT-27", Name: "Marsaskala", Type: "Local council"},
+	{Code: "MT-28", Name: "Marsaxlokk", Type: "Local council"},
+	{Code: "MT-29", Name: "Mdina", Type: "Local council"},
+	{Code: "MT-30", Name: "Mellieħa", Type: "Local council"},
+	{Code: "MT-31", Name: "Mġarr", Type: "Local council"},
+	{Code: "MT-32", Name: "Mosta", Type: "Local council"},
+	{Code: "MT-33", Name: "Mqabba", Type: "Local council"},
+	{Code: "MT-34", Name: "Msida", Type: "Local council"},
+	{Code: "MT-35", Name: "Mtarfa", Type: "Local council"},
+	{Code: "MT-36", Name: "Munxar", Type: "Local council"},
+	{Code: "MT-37", Name: "Nadur", Type: "Local council"},
+	{Code: "MT-38", Name: "Naxxar", Type: "Local council"},
+	{Code: "MT-39", Name: "Paola", Type: "Local council"},
+	{Code: "MT-40", Name: "Pembroke", Type: "Local council"},
+	{Code: "MT-41", Name: "Pietà", Type: "Local council"},
+	{Code: "MT-42", Name: "Qala", Type: "Local council"},
+	{Code: "MT-43", Name: "Qormi", Type: "Local council"},
+	{Code: "MT-44", Name: "Qrendi", Type: "Local council"},
+	{Code: "MT-45", Name: "Rabat Gozo", Type: "Local council"},
+	{Code: "MT-46", Name: "Rabat Malta", Type: "Local council"},
+	{Code: "MT-47", Name: "Safi", Type: "Local council"},
+	{Code: "MT-48", Name: "Saint Julian's", Type: "Local council"},
+	{Code: "MT-49", Name: "Saint John", Type: "Local council"},
+	{Code: "MT-50", Name: "Saint Lawrence", Type: "Local council"},
+	{Code: "MT-51", Name: "Saint Paul's Bay", Type: "Local council"},
+	{Code: "MT-52", Name: "Sannat", Type: "Local council"},
+	{Code: "MT-53", Name: "Saint Lucia's", Type: "Local council"},
+	{Code: "MT-54", Name: "Santa Venera", Type: "Local council"},
+	{Code: "MT-55", Name: "Siġġiewi", Type: "Local council"},
+	{Code: "MT-56", Name: "Sliema", Type: "Local council"},
+	{Code: "MT-57", Name: "Swieqi", Type: "Local council"},
+	{Code: "MT-58", Name: "Ta' Xbiex", Type: "Local council"},
+	{Code: "MT-59", Name: "Tarxien", Type: "Local council"},
+	{Code: "MT-60", Name: "Valletta", Type: "Local council"},
+	{Code: "MT-61", Name: "Xagħra", Type: "Local council"},
+	{Code: "MT-62", Name: "Xewkija", Type: "Local council"},
+	{Code: "MT-63", Name: "Xgħajra", Type: "Local council"},
+	{Code: "MT-64", Name: "Żabbar", Type: "Local council"},
+	{Code: "MT-65", Name: "Żebbuġ Gozo", Type: "Local council"},
+	{Code: "MT-66", Name: "Żebbuġ Malta", Type: "Local council"},
+	{Code: "MT-67", Name: "Żejtun", Type: "Local council"},
+	{Code: "MT-68", Name: "Żurrieq", Type: "Local council"},
+	{Code: "MU-AG", Name: "Agalega Islands", Type: "Dependency"},
+	{Code: "MU-BL", Name: "Black River", Type: "District"},
+	{Code: "MU-CC", Name: "Cargados Carajos Shoals", Type: "Dependency"},
+	{Code: "MU-FL", Name: "Flacq", Type: "District"},
+	{Code: "MU-GP", Name: "Grand Port", Type: "District"},
+	{Code: "MU-MO", Name: "Moka", Type: "District"},
+	{Code: "MU-PA", Name: "Pamplemousses", Type: "District"},
+	{Code: "MU-PL", Name: "Port Louis", Type: "District"},
+	{Code: "MU-PW", Name: "Plaines Wilhems", Type: "District"},
+	{Code: "MU-RO", Name: "Rodrigues Island", Type: "Dependency"},
+	{Code: "MU-RR", Name: "Rivière du Rempart", Type: "District"},
+	{Code: "MU-SA", Name: "Savanne", Type: "District"},
+	{Code: "MV-00", Name: "South Ari Atoll", Type: "Administrative atoll"},
+	{Code: "MV-01", Name: "Addu City", Type: "City"},
+	{Code: "MV-02", Name: "North Ari Atoll", Type: "Administrative atoll"},
+	{Code: "MV-03", Name: "Faadhippolhu", Type: "Administrative atoll"},
+	{Code: "MV-04", Name: "Felidhu Atoll", Type: "Administrative atoll"},
+	{Code: "MV-05", Name: "Hahdhunmathi", Type: "Administrative atoll"},
+	{Code: "MV-07", Name: "North Thiladhunmathi", Type: "Administrative atoll"},
+	{Code: "MV-08", Name: "Kolhumadulu", Type: "Administrative atoll"},
+	{Code: "MV-12", Name: "Mulaku Atoll", Type: "Administrative atoll"},
+	{Code: "MV-13", Name: "North Maalhosmadulu", Type: "Administrative atoll"},
+	{Code: "MV-14", Name: "North Nilandhe Atoll", Type: "Administrative atoll"},
+	{Code: "MV-17", Name: "South Nilandhe Atoll", Type: "Administrative atoll"},
+	{Code: "MV-20", Name: "South Maalhosmadulu", Type: "Administrative atoll"},
+	{Code: "MV-23", Name: "South Thiladhunmathi", Type: "Administrative atoll"},
+	{Code: "MV-24", Name: "North Miladhunmadulu", Type: "Administrative atoll"},
+	{Code: "MV-25", Name: "South Miladhunmadulu", Type: "Administrative atoll"},
+	{Code: "MV-26", Name: "Male Atoll", Type: "Administrative atoll"},
+	{Code: "MV-27", Name: "North Huvadhu Atoll", Type: "Administrative atoll"},
+	{Code: "MV-28", Name: "South Huvadhu Atoll", Type: "Administrative atoll"},
+	{Code: "MV-29", Name: "Fuvammulah", Type: "Administrative atoll"},
+	{Code: "MV-MLE", Name: "Male", Type: "City"},
+	{Code: "MW-BA", Name: "Balaka", Type: "District", Parent: "MW-S"},
+	{Code: "MW-BL", Name: "Blantyre", Type: "District", Parent: "MW-S"},
+	{Code: "MW-C", Name: "Central Region", Type: "Region"},
+	{Code: "MW-CK", Name: "Chikwawa", Type: "District", Parent: "MW-S"},
+	{Code: "MW-CR", Name: "Chiradzulu", Type: "District", Parent: "MW-S"},
+	{Code: "MW-CT", Name: "Chitipa", Type: "District", Parent: "MW-N"},
+	{Code: "MW-DE", Name: "Dedza", Type: "District", Parent: "MW-C"},
+	{Code: "MW-DO", Name: "Dowa", Type: "District", Parent: "MW-C"},
+	{Code: "MW-KR", Name: "Karonga", Type: "District", Parent: "MW-N"},
+	{Code: "MW-KS", Name: "Kasungu", Type: "District", Parent: "MW-C"},
+	{Code: "MW-LI", Name: "Lilongwe", Type: "District", Parent: "MW-C"},
+	{Code: "MW-LK", Name: "Likoma", Type: "District", Parent: "MW-N"},
+	{Code: "MW-MC", Name: "Mchinji", Type: "District", Parent: "MW-C"},
+	{Code: "MW-MG", Name: "Mangochi", Type: "District", Parent: "MW-S"},
+	{Code: "MW-MH", Name: "Machinga", Type: "District", Parent: "MW-S"},
+	{Code: "MW-MU", Name: "Mulanje", Type: "District", Parent: "MW-S"},
+	{Code: "MW-MW", Name: "Mwanza", Type: "District", Parent: "MW-S"},
+	{Code: "MW-MZ", Name: "Mzimba", Type: "District", Parent: "MW-N"},
+	{Code: "MW-N", Name: "Northern Region", Type: "Region"},
+	{Code: "MW-NB", Name: "Nkhata Bay", Type: "District", Parent: "MW-N"},
+	{Code: "MW-NE", Name: "Neno", Type: "District", Parent: "MW-S"},
+	{Code: "MW-NI", Name: "Ntchisi", Type: "District", Parent: "MW-C"},
+	{Code: "MW-NK", Name: "Nkhotakota", Type: "District", Parent: "MW-C"},
+	{Code: "MW-NS", Name: "Nsanje", Type: "District", Parent: "MW-S"},
+	{Code: "MW-NU", Name: "Ntcheu", Type: "District", Parent: "MW-C"},
+	{Code: "MW-PH", Name: "Phalombe", Type: "District", Parent: "MW-S"},
+	{Code: "MW-RU", Name: "Rumphi", Type: "District", Parent: "MW-N"},
+	{Code: "MW-S", Name: "Southern Region", Type: "Region"},
+	{Code: "MW-SA", Name: "Salima", Type: "District", Parent: "MW-C"},
+	{Code: "MW-TH", Name: "Thyolo", Type: "District", Parent: "MW-S"},
+	{Code: "MW-ZO", Name: "Zomba", Type: "District", Parent: "MW-S"},
+	{Code: "MX-AGU", Name: "Aguascalientes", Type: "State"},
+	{Code: "MX-BCN", Name: "Baja California", Type: "State"},
+	{Code: "MX-BCS", Name: "Baja California Sur", Type: "State"},
+	{Code: "MX-CAM", Name: "Campeche", Type: "State"},
+	{Code: "MX-CHH", Name: "Chihuahua", Type: "State"},
+	{Code: "MX-CHP", Name: "Chiapas", Type: "State"},
+	{Code: "MX-CMX", Name: "Ciudad de México", Type: "Federal district"},
+	{Code: "MX-COA", Name: "Coahuila de Zaragoza", Type: "State"},
+	{Code: "MX-COL", Name: "Colima", Type: "State"},
+	{Code: "MX-DUR", Name: "Durango", Type: "State"},
+	{Code: "MX-GRO", Name: "Guerrero", Type: "State"},
+	{Code: "MX-GUA", Name: "Guanajuato", Type: "State"},
+	{Code: "MX-HID", Name: "Hidalgo", Type: "State"},
+	{Code: "MX-JAL", Name: "Jalisco", Type: "State"},
+	{Code: "MX-MEX", Name: "México", Type: "State"},
+	{Code: "MX-MIC", Name: "Michoacán de Ocampo", Type: "State"},
+	{Code: "MX-MOR", Name: "Morelos", Type: "State"},
+	{Code: "MX-NAY", Name: "Nayarit", Type: "State"},
+	{Code: "MX-NLE", Name: "Nuevo León", Type: "State"},
+	{Code: "MX-OAX", Name: "Oaxaca", Type: "State"},
+	{Code: "MX-PUE", Name: "Puebla", Type: "State"},
+	{Code: "MX-QUE", Name: "Querétaro", Type: "State"},
+	{Code: "MX-ROO", Name: "Quintana Roo", Type: "State"},
+	{Code: "MX-SIN", Name: "Sinaloa", Type: "State"},
+	{Code: "MX-SLP", Name: "San Luis Potosí", Type: "State"},
+	{Code: "MX-SON", Name: "Sonora", Type: "State"},
+	{Code: "MX-TAB", Name: "Tabasco", Type: "State"},
+	{Code: "MX-TAM", Name: "Tamaulipas", Type: "State"},
+	{Code: "MX-TLA", Name: "Tlaxcala", Type: "State"},
+	{Code: "MX-VER", Name: "Veracruz de Ignacio de la Llave", Type: "State"},
+	{Code: "MX-YUC", Name: "Yucatán", Type: "State"},
+	{Code: "MX-ZAC", Name: "Zacatecas", Type: "State"},
+	{Code: "MY-01", Name: "Johor", Type: "State"},
+	{Code: "MY-02", Name: "Kedah", Type: "State"},
+	{Code: "MY-03", Name: "Kelantan", Type: "State"},
+	{Code: "MY-04", Name: "Melaka", Type: "State"},
+	{Code: "MY-05", Name: "Negeri Sembilan", Type: "State"},
+	{Code: "MY-06", Name: "Pahang", Type: "State"},
+	{Code: "MY-07", Name: "Pulau Pinang", Type: "State"},
+	{Code: "MY-08", Name: "Perak", Type: "State"},
+	{Code: "MY-09", Name: "Perlis", Type: "State"},
+	{Code: "MY-10", Name: "Selangor", Type: "State"},
+	{Code: "MY-11", Name: "Terengganu", Type: "State"},
+	{Code: "MY-12", Name: "Sabah", Type: "State"},
+	{Code: "MY-13", Name: "Sarawak", Type: "State"},
+	{Code: "MY-14", Name: "Wilayah Persekutuan Kuala Lumpur", Type: "Federal territory"},
+	{Code: "MY-15", Name: "Wilayah Persekutuan Labuan", Type: "Federal territory"},
+	{Code: "MY-16", Name: "Wilayah Persekutuan Putrajaya", Type: "Federal territory"},
+	{Code: "MZ-A", Name: "Niassa", Type: "Province"},
+	{Code: "MZ-B", Name: "Manica", Type: "Province"},
+	{Code: "MZ-G", Name: "Gaza", Type: "Province"},
+	{Code: "MZ-I", Name: "Inhambane", Type: "Province"},
+	{Code: "MZ-L", Name: "Maputo", Type: "Province"},
+	{Code: "MZ-MPM", Name: "Maputo", Type: "City"},
+	{Code: "MZ-N", Name: "Nampula", Type: "Province"},
+	{Code: "MZ-P", Name: "Cabo Delgado", Type: "Province"},
+	{Code: "MZ-Q", Name: "Zambézia", Type: "Province"},
+	{Code: "MZ-S", Name: "Sofala", Type: "Province"},
+	{Code: "MZ-T", Name: "Tete", Type: "Province"},
+	{Code: "NA-CA", Name: "Zambezi", Type: "Region"},
+	{Code: "NA-ER", Name: "Erongo", Type: "Region"},
+	{Code: "NA-HA", Name: "Hardap", Type: "Region"},
+	{Code: "NA-KA", Name: "//Karas", Type: "Region"},
+	{Code: "NA-KE", Name: "Kavango East", Type: "Region"},
+	{Code: "NA-KH", Name: "Khomas", Type: "Region"},
+	{Code: "NA-KU", Name: "Kunene", Type: "Region"},
+	{Code: "NA-KW", Name: "Kavango West", Type: "Region"},
+	{Code: "NA-OD", Name: "Otjozondjupa", Type: "Region"},
+	{Code: "NA-OH", Name: "Omaheke", Type: "Region"},
+	{Code: "NA-ON", Name: "Oshana", Type: "Region"},
+	{Code: "NA-OS", Name: "Omusati", Type: "Region"},
+	{Code: "NA-OT", Name: "Oshikoto", Type: "Region"},
+	{Code: "NA-OW", Name: "Ohangwena", Type: "Region"},
+	{Code: "NE-1", Name: "Agadez", Type: "Region"},
+	{Code: "NE-2", Name: "Diffa", Type: "Region"},
+	{Code: "NE-3", Name: "Dosso", Type: "Region"},
+	{Code: "NE-4", Name: "Maradi", Type: "Region"},
+	{Code: "NE-5", Name: "Tahoua", Type: "Region"},
+	{Code: "NE-6", Name: "Tillabéri", Type: "Region"},
+	{Code: "NE-7", Name: "Zinder", Type: "Region"},
+	{Code: "NE-8", Name: "Niamey", Type: "Urban community"},
+	{Code: "NG-AB", Name: "Abia", Type: "State"},
+	{Code: "NG-AD", Name: "Adamawa", Type: "State"},
+	{Code: "NG-AK", Name: "Akwa Ibom", Type: "State"},
+	{Code: "NG-AN", Name: "Anambra", Type: "State"},
+	{Code: "NG-BA", Name: "Bauchi", Type: "State"},
+	{Code: "NG-BE", Name: "Benue", Type: "State"},
+	{Code: "NG-BO", Name: "Borno", Type: "State"},
+	{Code: "NG-BY", Name: "Bayelsa", Type: "State"},
+	{Code: "NG-CR", Name: "Cross River", Type: "State"},
+	{Code: "NG-DE", Name: "Delta", Type: "State"},
+	{Code: "NG-EB", Name: "Ebonyi", Type: "State"},
+	{Code: "NG-ED", Name: "Edo", Type: "State"},
+	{Code: "NG-EK", Name: "Ekiti", Type: "State"},
+	{Code: "NG-EN", Name: "Enugu", Type: "State"},
+	{Code: "NG-FC", Name: "Abuja Federal Capital Territory", Type: "Capital territory"},
+	{Code: "NG-GO", Name: "Gombe", Type: "State"},
+	{Code: "NG-IM", Name: "Imo", Type: "State"},
+	{Code: "NG-JI", Name: "Jigawa", Type: "State"},
+	{Code: "NG-KD", Name: "Kaduna", Type: "State"},
+	{Code: "NG-KE", Name: "Kebbi", Type: "State"},
+	{Code: "NG-KN", Name: "Kano", Type: "State"},
+	{Code: "NG-KO", Name: "Kogi", Type: "State"},
+	{Code: "NG-KT", Name: "Katsina", Type: "State"},
+	{Code: "NG-KW", Name: "Kwara", Type: "State"},
+	{Code: "NG-LA", Name: "Lagos", Type: "State"},
+	{Code: "NG-NA", Name: "Nasarawa", Type: "State"},
+	{Code: "NG-NI", Name: "Niger", Type: "State"},
+	{Code: "NG-OG", Name: "Ogun", Type: "State"},
+	{Code: "NG-ON", Name: "Ondo", Type: "State"},
+	{Code: "NG-OS", Name: "Osun", Type: "State"},
+	{Code: "NG-OY", Name: "Oyo", Type: "State"},
+	{Code: "NG-PL", Name: "Plateau", Type: "State"},
+	{Code: "NG-RI", Name: "Rivers", Type: "State"},
+	{Code: "NG-SO", Name: "Sokoto", Type: "State"},
+	{Code: "NG-TA", Name: "Taraba", Type: "State"},
+	{Code: "NG-YO", Name: "Yobe", Type: "State"},
+	{Code: "NG-ZA", Name: "Zamfara", Type: "State"},
+	{Code: "NI-AN", Name: "Costa Caribe Norte", Type: "Autonomous region"},
+	{Code: "NI-AS", Name: "Costa Caribe Sur", Type: "Autonomous region"},
+	{Code: "NI-BO", Name: "Boaco", Type: "Department"},
+	{Code: "NI-CA", Name: "Carazo", Type: "Department"},
+	{Code: "NI-CI", Name: "Chinandega", Type: "Department"},
+	{Code: "NI-CO", Name: "Chontales", Type: "Department"},
+	{Code: "NI-ES", Name: "Estelí", Type: "Department"},
+	{Code: "NI-GR", Name: "Granada", Type: "Department"},
+	{Code: "NI-JI", Name: "Jinotega", Type: "Department"},
+	{Code: "NI-LE", Name: "León", Type: "Department"},
+	{Code: "NI-MD", Name: "Madriz", Type: "Department"},
+	{Code: "NI-MN", Name: "Managua", Type: "Department"},
+	{Code: "NI-MS", Name: "Masaya", Type: "Department"},
+	{Code: "NI-MT", Name: "Matagalpa", Type: "Department"},
+	{Code: "NI-NS", Name: "Nueva Segovia", Type: "Department"},
+	{Code: "NI-RI", Name: "Rivas", Type: "Department"},
+	{Code: "NI-SJ", Name: "Río San Juan", Type: "Department"},
+	{Code: "NL-AW", Name: "Aruba", Type: "Country"},
+	{Code: "NL-BQ1", Name: "Bonaire", Type: "Special municipality"},
+	{Code: "NL-BQ2", Name: "Saba", Type: "Special municipality"},
+	{Code: "NL-BQ3", Name: "Sint Eustatius", Type: "Special municipality"},
+	{Code: "NL-CW", Name: "Curaçao", Type: "Country"},
+	{Code: "NL-DR", Name: "Drenthe", Type: "Province"},
+	{Code: "NL-FL", Name: "Flevoland", Type: "Province"},
+	{Code: "NL-FR", Name: "Fryslân", Type: "Province"},
+	{Code: "NL-GE", Name: "Gelderland", Type: "Province"},
+	{Code: "NL-GR", Name: "Groningen", Type: "Province"},
+	{Code: "NL-LI", Name: "Limburg", Type: "Province"},
+	{Code: "NL-NB", Name: "Noord-Brabant", Type: "Province"},
+	{Code: "NL-NH", Name: "Noord-Holland", Type: "Province"},
+	{Code: "NL-OV", Name: "Overijssel", Type: "Province"},
+	{Code: "NL-SX", Name: "Sint Maarten", Type: "Country"},
+	{Code: "NL-UT", Name: "Utrecht", Type: "Province"},
+	{Code: "NL-ZE", Name: "Zeeland", Type: "Province"},
+	{Code: "NL-ZH", Name: "Zuid-Holland", Type: "Province"},
+	{Code: "NO-03", Name: "Oslo", Type: "County"},
+	{Code: "NO-11", Name: "Rogaland", Type: "County"},
+	{Code: "NO-15", Name: "Møre og Romsdal", Type: "County"},
+	{Code: "NO-18", Name: "Nordland", Type: "County"},
+	{Code: "NO-21", Name: "Svalbard (Arctic Region)", Type: "Arctic region"},
+	{Code: "NO-22", Name: "Jan Mayen (Arctic Region)", Type: "Arctic region"},
+	{Code: "NO-30", Name: "Viken", Type: "County"},
+	{Code: "NO-34", Name: "Innlandet", Type: "County"},
+	{Code: "NO-38", Name: "Vestfold og Telemark", Type: "County"},
+	{Code: "NO-42", Name: "Agder", Type: "County"},
+	{Code: "NO-46", Name: "Vestland", Type: "County"},
+	{Code: "NO-50", Name: "Trööndelage", Type: "County"},
+	{Code: "NO-54", Name: "Romssa ja Finnmárkku", Type: "County"},
+	{Code: "NP-1", Name: "Central", Type: "Development region"},
+	{Code: "NP-2", Name: "Mid Western", Type: "Development region"},
+	{Code: "NP-3", Name: "Western", Type: "Development region"},
+	{Code: "NP-4", Name: "Eastern", Type: "Development region"},
+	{Code: "NP-5", Name: "Far Western", Type: "Development region"},
+	{Code: "NP-BA", Name: "Bagmati", Type: "Zone", Parent: "NP-1"},
+	{Code: "NP-BH", Name: "Bheri", Type: "Zone", Parent: "NP-2"},
+	{Code: "NP-DH", Name: "Dhawalagiri", Type: "Zone", Parent: "NP-3"},
+	{Code: "NP-GA", Name: "Gandaki", Type: "Zone", Parent: "NP-3"},
+	{Code: "NP-JA", Name: "Janakpur", Type: "Zone", Parent: "NP-1"},
+	{Code: "NP-KA", Name: "Karnali", Type: "Zone", Parent: "NP-2"},
+	{Code: "NP-KO", Name: "Kosi", Type: "Zone", Parent: "NP-4"},
+	{Code: "NP-LU", Name: "Lumbini", Type: "Zone", Parent: "NP-3"},
+	{Code: "NP-MA", Name: "Mahakali", Type: "Zone", Parent: "NP-5"},
+	{Code: "NP-ME", Name: "Mechi", Type: "Zone", Parent: "NP-4"},
+	{Code: "NP-NA", Name: "Narayani", Type: "Zone", Parent: "NP-1"},
+	{Code: "NP-P1", Name: "Province 1", Type: "Province"},
+	{Code: "NP-P2", Name: "Province 2", Type: "Province"},
+	{Code: "NP-P3", Name: "Bāgmatī", Type: "Province"},
+	{Code: "NP-P4", Name: "Gandaki", Type: "Province"},
+	{Code: "NP-P5", Name: "Province 5", Type: "Province"},
+	{Code: "NP-P6", Name: "Karnali", Type: "Province"},
+	{Code: "NP-P7", Name: "Sudūr Pashchim", Type: "Province"},
+	{Code: "NP-RA", Name: "Rapti", Type: "Zone", Parent: "NP-2"},
+	{Code: "NP-SA", Name: "Sagarmatha", Type: "Zone", Parent: "NP-4"},
+	{Code: "NP-SE", Name: "Seti", Type: "Zone", Parent: "NP-5"},
+	{Code: "NR-01", Name: "Aiwo", Type: "District"},
+	{Code: "NR-02", Name: "Anabar", Type: "District"},
+	{Code: "NR-03", Name: "Anetan", Type: "District"},
+	{Code: "NR-04", Name: "Anibare", Type: "District"},
+	{Code: "NR-05", Name: "Baitsi", Type: "District"},
+	{Code: "NR-06", Name: "Boe", Type: "District"},
+	{Code: "NR-07", Name: "Buada", Type: "District"},
+	{Code: "NR-08", Name: "Denigomodu", Type: "District"},
+	{Code: "NR-09", Name: "Ewa", Type: "District"},
+	{Code: "NR-10", Name: "Ijuw", Type: "District"},
+	{Code: "NR-11", Name: "Meneng", Type: "District"},
+	{Code: "NR-12", Name: "Nibok", Type: "District"},
+	{Code: "NR-13", Name: "Uaboe", Type: "District"},
+	{Code: "NR-14", Name: "Yaren", Type: "District"},
+	{Code: "NZ-AUK", Name: "Auckland", Type: "Region"},
+	{Code: "NZ-BOP", Name: "Bay of Plenty", Type: "Region"},
+	{Code: "NZ-CAN", Name: "Canterbury", Type: "Region"},
+	{Code: "NZ-CIT", Name: "Chatham Islands Territory", Type: "Special island authority"},
+	{Code: "NZ-GIS", Name: "Gisborne", Type: "Region"},
+	{Code: "NZ-HKB", Name: "Hawke's Bay", Type: "Region"},
+	{Code: "NZ-MBH", Name: "Marlborough", Type: "Region"},
+	{Code: "NZ-MWT", Name: "Manawatu-Wanganui", Type: "Region"},
+	{Code: "NZ-NSN", Name: "Nelson", Type: "Region"},
+	{Code: "NZ-NTL", Name: "Northland", Type: "Region"},
+	{Code: "NZ-OTA", Name: "Otago", Type: "Region"},
+	{Code: "NZ-STL", Name: "Southland", Type: "Region"},
+	{Code: "NZ-TAS", Name: "Tasman", Type: "Region"},
+	{Code: "NZ-TKI", Name: "Taranaki", Type: "Region"},
+	{Code: "NZ-WGN", Name: "Wellington", Type: "Region"},
+	{Code: "NZ-WKO", Name: "Waikato", Type: "Region"},
+	{Code: "NZ-WTC", Name: "West Coast", Type: "Region"},
+	{Code: "OM-BJ", Name: "Janūb al Bāţinah", Type: "Governorate"},
+	{Code: "OM-BS", Name: "Shamāl al Bāţinah", Type: "Governorate"},
+	{Code: "OM-BU", Name: "Al Buraymī", Type: "Governorate"},
+	{Code: "OM-DA", Name: "Ad Dākhilīyah", Type: "Governorate"},
+	{Code: "OM-MA", Name: "Masqaţ", Type: "Governorate"},
+	{Code: "OM-MU", Name: "Musandam", Type: "Governorate"},
+	{Code: "OM-SJ", Name: "Janūb ash Sharqīyah", Type: "Governorate"},
+	{Code: "OM-SS", Name: "Shamāl ash Sharqīyah", Type: "Governorate"},
+	{Code: "OM-WU", Name: "Al Wusţá", Type: "Governorate"},
+	{Code: "OM-ZA", Name: "Az̧ Z̧āhirah", Type: "Governorate"},
+	{Code: "OM-ZU", Name: "Z̧ufār", Type: "Governorate"},
+	{Code: "PA-1", Name: "Bocas del Toro", Type: "Province"},
+	{Code: "PA-10", Name: "Panamá Oeste", Type: "Province"},
+	{Code: "PA-2", Name: "Coclé", Type: "Province"},
+	{Code: "PA-3", Name: "Colón", Type: "Province"},
+	{Code: "PA-4", Name: "Chiriquí", Type: "Province"},
+	{Code: "PA-5", Name: "Darién", Type: "Province"},
+	{Code: "PA-6", Name: "Herrera", Type: "Province"},
+	{Code: "PA-7", Name: "Los Santos", Type: "Province"},
+	{Code: "PA-8", Name: "Panamá", Type: "Province"},
+	{Code: "PA-9", Name: "Veraguas", Type: "Province"},
+	{Code: "PA-EM", Name: "Emberá", Type: "Indigenous region"},
+	{Code: "PA-KY", Name: "Guna Yala", Type: "Indigenous region"},
+	{Code: "PA-NB", Name: "Ngöbe-Buglé", Type: "Indigenous region"},
+	{Code: "PE-AMA", Name: "Amarumayu", Type: "Region"},
+	{Code: "PE-ANC", Name: "Ancash", Type: "Region"},
+	{Code: "PE-APU", Name: "Apurimaq", Type: "Region"},
+	{Code: "PE-ARE", Name: "Arequipa", Type: "Region"},
+	{Code: "PE-AYA", Name: "Ayacucho", Type: "Region"},
+	{Code: "PE-CAJ", Name: "Cajamarca", Type: "Region"},
+	{Code: "PE-CAL", Name: "El Callao", Type: "Region"},
+	{Code: "PE-CUS", Name: "Cusco", Type: "Region"},
+	{Code: "PE-HUC", Name: "Huánuco", Type: "Region"},
+	{Code: "PE-HUV", Name: "Huancavelica", Type: "Region"},
+	{Code: "PE-ICA", Name: "Ica", Type: "Region"},
+	{Code: "PE-JUN", Name: "Hunin", Type: "Region"},
+	{Code: "PE-LAL", Name: "La Libertad", Type: "Region"},
+	{Code: "PE-LAM", Name: "Lambayeque", Type: "Region"},
+	{Code: "PE-LIM", Name: "Lima", Type: "Region"},
+	{Code: "PE-LMA", Name: "Lima hatun llaqta", Type: "Municipality"},
+	{Code: "PE-LOR", Name: "Loreto", Type: "Region"},
+	{Code: "PE-MDD", Name: "Madre de Dios", Type: "Region"},
+	{Code: "PE-MOQ", Name: "Moquegua", Type: "Region"},
+	{Code: "PE-PAS", Name: "Pasco", Type: "Region"},
+	{Code: "PE-PIU", Name: "Piura", Type: "Region"},
+	{Code: "PE-PUN", Name: "Puno", Type: "Region"},
+	{Code: "PE-SAM", Name: "San Martin", Type: "Region"},
+	{Code: "PE-TAC", Name: "Tacna", Type: "Region"},
+	{Code: "PE-TUM", Name: "Tumbes", Type: "Region"},
+	{Code: "PE-UCA", Name: "Ucayali", Type: "Region"},
+	{Code: "PG-CPK", Name: "Chimbu", Type: "Province"},
+	{Code: "PG-CPM", Name: "Central", Type: "Province"},
+	{Code: "PG-EBR", Name: "East New Britain", Type: "Province"},
+	{Code: "PG-EHG", Name: "Eastern Highlands", Type: "Province"},
+	{Code: "PG-EPW", Name: "Enga", Type: "Province"},
+	{Code: "PG-ESW", Name: "East Sepik", Type: "Province"},
+	{Code: "PG-GPK", Name: "Gulf", Type: "Province"},
+	{Code: "PG-HLA", Name: "Hela", Type: "Province"},
+	{Code: "PG-JWK", Name: "Jiwaka", Type: "Province"},
+	{Code: "PG-MBA", Name: "Milne Bay", Type: "Province"},
+	{Code: "PG-MPL", Name: "Morobe", Type: "Province"},
+	{Code: "PG-MPM", Name: "Madang", Type: "Province"},
+	{Code: "PG-MRL", Name: "Manus", Type: "Province"},
+	{Code: "PG-NCD", Name: "National Capital District (Port Moresby)", Type: "District"},
+	{Code: "PG-NIK", Name: "New Ireland", Type: "Province"},
+	{Code: "PG-NPP", Name: "Northern", Type: "Province"},
+	{Code: "PG-NSB", Name: "Bougainville", Type: "Autonomous region"},
+	{Code: "PG-SAN", Name: "West Sepik", Type: "Province"},
+	{Code: "PG-SHM", Name: "Southern Highlands", Type: "Province"},
+	{Code: "PG-WBK", Name: "West New Britain", Type: "Province"},
+	{Code: "PG-WHM", Name: "Western Highlands", Type: "Province"},
+	{Code: "PG-WPD", Name: "Western", Type: "Province"},
+	{Code: "PH-00", Name: "National Capital Region", Type: "Region"},
+	{Code: "PH-01", Name: "Ilocos (Region I)", Type: "Region"},
+	{Code: "PH-02", Name: "Cagayan Valley (Region II)", Type: "Region"},
+	{Code: "PH-03", Name: "Central Luzon (Region III)", Type: "Region"},
+	{Code: "PH-05", Name: "Bicol (Region V)", Type: "Region"},
+	{Code: "PH-06", Name: "Western Visayas (Region VI)", Type: "Region"},
+	{Code: "PH-07", Name: "Central Visayas (Region VII)", Type: "Region"},
+	{Code: "PH-08", Name: "Eastern Visayas (Region VIII)", Type: "Region"},
+	{Code: "PH-09", Name: "Zamboanga Peninsula (Region IX)", Type: "Region"},
+	{Code: "PH-10", Name: "Northern Mindanao (Region X)", Type: "Region"},
+	{Code: "PH-11", Name: "Davao (Region XI)", Type: "Region"},
+	{Code: "PH-12", Name: "Soccsksargen (Region XII)", Type: "Region"},
+	{Code: "PH-13", Name: "Caraga (Region XIII)", Type: "Region"},
+	{Code: "PH-14", Name: "Autonomous Region in Muslim Mindanao (ARMM)", Type: "Region"},
+	{Code: "PH-15", Name: "Cordillera Administrative Region (CAR)", Type: "Region"},
+	{Code: "PH-40", Name: "Calabarzon (Region IV-A)", Type: "Region"},
+	{Code: "PH-41", Name: "Mimaropa (Region IV-B)", Type: "Region"},
+	{Code: "PH-ABR", Name: "Abra", Type: "Province", Parent: "PH-15"},
+	{Code: "PH-AGN", Name: "Agusan del Norte", Type: "Province", Parent: "PH-13"},
+	{Code: "PH-AGS", Name: "Agusan del Sur", Type: "Province", Parent: "PH-13"},
+	{Code: "PH-AKL", Name: "Aklan", Type: "Province", Parent: "PH-06"},
+	{Code: "PH-ALB", Name: "Albay", Type: "Province", Parent: "PH-05"},
+	{Code: "PH-ANT", Name: "Antique", Type: "Province", Parent: "PH-06"},
+	{Code: "PH-APA", Name: "Apayao", Type: "Province", Parent: "PH-15"},
+	{Code: "PH-AUR", Name: "Aurora", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-BAN", Name: "Bataan", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-BAS", Name: "Basilan", Type: "Province", Parent: "PH-09"},
+	{Code: "PH-BEN", Name: "Benguet", Type: "Province", Parent: "PH-15"},
+	{Code: "PH-BIL", Name: "Biliran", Type: "Province", Parent: "PH-08"},
+	{Code: "PH-BOH", Name: "Bohol", Type: "Province", Parent: "PH-07"},
+	{Code: "PH-BTG", Name: "Batangas", Type: "Province", Parent: "PH-40"},
+	{Code: "PH-BTN", Name: "Batanes", Type: "Province", Parent: "PH-02"},
+	{Code: "PH-BUK", Name: "Bukidnon", Type: "Province", Parent: "PH-10"},
+	{Code: "PH-BUL", Name: "Bulacan", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-CAG", Name: "Cagayan", Type: "Province", Parent: "PH-02"},
+	{Code: "PH-CAM", Name: "Camiguin", Type: "Province", Parent: "PH-10"},
+	{Code: "PH-CAN", Name: "Camarines Norte", Type: "Province", Parent: "PH-05"},
+	{Code: "PH-CAP", Name: "Capiz", Type: "Province", Parent: "PH-06"},
+	{Code: "PH-CAS", Name: "Camarines Sur", Type: "Province", Parent: "PH-05"},
+	{Code: "PH-CAT", Name: "Catanduanes", Type: "Province", Parent: "PH-05"},
+	{Code: "PH-CAV", Name: "Cavite", Type: "Province", Parent: "PH-40"},
+	{Code: "PH-CEB", Name: "Cebu", Type: "Province", Parent: "PH-07"},
+	{Code: "PH-COM", Name: "Davao de Oro", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-DAO", Name: "Davao Oriental", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-DAS", Name: "Davao del Sur", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-DAV", Name: "Davao del Norte", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-DIN", Name: "Dinagat Islands", Type: "Province", Parent: "PH-13"},
+	{Code: "PH-DVO", Name: "Davao Occidental", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-EAS", Name: "Eastern Samar", Type: "Province", Parent: "PH-08"},
+	{Code: "PH-GUI", Name: "Guimaras", Type: "Province", Parent: "PH-06"},
+	{Code: "PH-IFU", Name: "Ifugao", Type: "Province", Parent: "PH-15"},
+	{Code: "PH-ILI", Name: "Iloilo", Type: "Province", Parent: "PH-06"},
+	{Code: "PH-ILN", Name: "Ilocos Norte", Type: "Province", Parent: "PH-01"},
+	{Code: "PH-ILS", Name: "Ilocos Sur", Type: "Province", Parent: "PH-01"},
+	{Code: "PH-ISA", Name: "Isabela", Type: "Province", Parent: "PH-02"},
+	{Code: "PH-KAL", Name: "Kalinga", Type: "Province", Parent: "PH-15"},
+	{Code: "PH-LAG", Name: "Laguna", Type: "Province", Parent: "PH-40"},
+	{Code: "PH-LAN", Name: "Lanao del Norte", Type: "Province", Parent: "PH-12"},
+	{Code: "PH-LAS", Name: "Lanao del Sur", Type: "Province", Parent: "PH-14"},
+	{Code: "PH-LEY", Name: "Leyte", Type: "Province", Parent: "PH-08"},
+	{Code: "PH-LUN", Name: "La Union", Type: "Province", Parent: "PH-01"},
+	{Code: "PH-MAD", Name: "Marinduque", Type: "Province", Parent: "PH-41"},
+	{Code: "PH-MAG", Name: "Maguindanao", Type: "Province", Parent: "PH-14"},
+	{Code: "PH-MAS", Name: "Masbate", Type: "Province", Parent: "PH-05"},
+	{Code: "PH-MDC", Name: "Mindoro Occidental", Type: "Province", Parent: "PH-41"},
+	{Code: "PH-MDR", Name: "Mindoro Oriental", Type: "Province", Parent: "PH-41"},
+	{Code: "PH-MOU", Name: "Mountain Province", Type: "Province", Parent: "PH-15"},
+	{Code: "PH-MSC", Name: "Misamis Occidental", Type: "Province", Parent: "PH-10"},
+	{Code: "PH-MSR", Name: "Misamis Oriental", Type: "Province", Parent: "PH-10"},
+	{Code: "PH-NCO", Name: "Cotabato", Type: "Province", Parent: "PH-12"},
+	{Code: "PH-NEC", Name: "Negros Occidental", Type: "Province", Parent: "PH-06"},
+	{Code: "PH-NER", Name: "Negros Oriental", Type: "Province", Parent: "PH-07"},
+	{Code: "PH-NSA", Name: "Northern Samar", Type: "Province", Parent: "PH-08"},
+	{Code: "PH-NUE", Name: "Nueva Ecija", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-NUV", Name: "Nueva Vizcaya", Type: "Province", Parent: "PH-02"},
+	{Code: "PH-PAM", Name: "Pampanga", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-PAN", Name: "Pangasinan", Type: "Province", Parent: "PH-01"},
+	{Code: "PH-PLW", Name: "Palawan", Type: "Province", Parent: "PH-41"},
+	{Code: "PH-QUE", Name: "Quezon", Type: "Province", Parent: "PH-40"},
+	{Code: "PH-QUI", Name: "Quirino", Type: "Province", Parent: "PH-02"},
+	{Code: "PH-RIZ", Name: "Rizal", Type: "Province", Parent: "PH-40"},
+	{Code: "PH-ROM", Name: "Romblon", Type: "Province", Parent: "PH-41"},
+	{Code: "PH-SAR", Name: "Sarangani", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-SCO", Name: "South Cotabato", Type: "Province", Parent: "PH-11"},
+	{Code: "PH-SIG", Name: "Siquijor", Type: "Province", Parent: "PH-07"},
+	{Code: "PH-SLE", Name: "Southern Leyte", Type: "Province", Parent: "PH-08"},
+	{Code: "PH-SLU", Name: "Sulu", Type: "Province", Parent: "PH-14"},
+	{Code: "PH-SOR", Name: "Sorsogon", Type: "Province", Parent: "PH-05"},
+	{Code: "PH-SUK", Name: "Sultan Kudarat", Type: "Province", Parent: "PH-12"},
+	{Code: "PH-SUN", Name: "Surigao del Norte", Type: "Province", Parent: "PH-13"},
+	{Code: "PH-SUR", Name: "Surigao del Sur", Type: "Province", Parent: "PH-13"},
+	{Code: "PH-TAR", Name: "Tarlac", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-TAW", Name: "Tawi-Tawi", Type: "Province", Parent: "PH-14"},
+	{Code: "PH-WSA", Name: "Samar", Type: "Province", Parent: "PH-08"},
+	{Code: "PH-ZAN", Name: "Zamboanga del Norte", Type: "Province", Parent: "PH-09"},
+	{Code: "PH-ZAS", Name: "Zamboanga del Sur", Type: "Province", Parent: "PH-09"},
+	{Code: "PH-ZMB", Name: "Zambales", Type: "Province", Parent: "PH-03"},
+	{Code: "PH-ZSI", Name: "Zamboanga Sibugay", Type: "Province", Parent: "PH-09"},
+	{Code: "PK-BA", Name: "Balochistan", Type: "Province"},
+	{Code: "PK-GB", Name: "Gilgit-Baltistan", Type: "Pakistan administered area"},
+	{Code: "PK-IS", Name: "Islamabad", Type: "Federal capital territory"},
+	{Code: "PK-JK", Name: "Azad Jammu and Kashmir", Type: "Pakistan administered area"},
+	{Code: "PK-KP", Name: "Khyber Pakhtunkhwa", Type: "Province"},
+	{Code: "PK-PB", Name: "Punjab", Type: "Province"},
+	{Code: "PK-SD", Name: "Sindh", Type: "Province"},
+	{Code: "PL-02", Name: "Dolnośląskie", Type: "Voivodship"},
+	{Code: "PL-04", Name: "Kujawsko-pomorskie", Type: "Voivodship"},
+	{Code: "PL-06", Name: "Lubelskie", Type: "Voivodship"},
+	{Code: "PL-08", Name: "Lubuskie", Type: "Voivodship"},
+	{Code: "PL-10", Name: "Łódzkie", Type: "Voivodship"},
+	{Code: "PL-12", Name: "Małopolskie", Type: "Voivodship"},
+	{Code: "PL-14", Name: "Mazowieckie", Type: "Voivodship"},
+	{Code: "PL-16", Name: "Opolskie", Type: "Voivodship"},
+	{Code: "PL-18", Name: "Podkarpackie", Type: "Voivodship"},
+	{Code: "PL-20", Name: "Podlaskie", Type: "Voivodship"},
+	{Code: "PL-22", Name: "Pomorskie", Type: "Voivodship"},
+	{Code: "PL-24", Name: "Śląskie", Type: "Voivodship"},
+	{Code: "PL-26", Name: "Świętokrzyskie", Type: "Voivodship"},
+	{Code: "PL-28", Name: "Warmińsko-mazurskie", Type: "Voivodship"},
+	{Code: "PL-30", Name: "Wielkopolskie", Type: "Voivodship"},
+	{Code: "PL-32", Name: "Zachodniopomorskie", Type: "Voivodship"},
+	{Code: "PS-BTH", Name: "Bethlehem", Type: "Governorate"},
+	{Code: "PS-DEB", Name: "Deir El Balah", Type: "Governorate"},
+	{Code: "PS-GZA", Name: "Gaza", Type: "Governorate"},
+	{Code: "PS-HBN", Name: "Hebron", Type: "Governorate"},
+	{Code: "PS-JEM", Name: "Jerusalem", Type: "Governorate"},
+	{Code: "PS-JEN", Name: "Jenin", Type: "Governorate"},
+	{Code: "PS-JRH", Name: "Jericho and Al Aghwar", Type: "Governorate"},
+	{Code: "PS-KYS", Name: "Khan Yunis", Type: "Governorate"},
+	{Code: "PS-NBS", Name: "Nablus", Type: "Governorate"},
+	{Code: "PS-NGZ", Name: "North Gaza", Type: "Governorate"},
+	{Code: "PS-QQA", Name: "Qalqilya", Type: "Governorate"},
+	{Code: "PS-RBH", Name: "Ramallah", Type: "Governorate"},
+	{Code: "PS-RFH", Name: "Rafah", Type: "Governorate"},
+	{Code: "PS-SLT", Name: "Salfit", Type: "Governorate"},
+	{Code: "PS-TBS", Name: "Tubas", Type: "Governorate"},
+	{Code: "PS-TKM", Name: "Tulkarm", Type: "Governorate"},
+	{Code: "PT-01", Name: "Aveiro", Type: "District"},
+	{Code: "PT-02", Name: "Beja", Type: "District"},
+	{Code: "PT-03", Name: "Braga", Type: "District"},
+	{Code: "PT-04", Name: "Bragança", Type: "District"},
+	{Code: "PT-05", Name: "Castelo Branco", Type: "District"},
+	{Code: "PT-06", Name: "Coimbra", Type: "District"},
+	{Code: "PT-07", Name: "Évora", Type: "District"},
+	{Code: "PT-08", Name: "Faro", Type: "District"},
+	{Code: "PT-09", Name: "Guarda", Type: "District"},
+	{Code: "PT-10", Name: "Leiria", Type: "District"},
+	{Code: "PT-11", Name: "Lisboa", Type: "District"},
+	{Code: "PT-12", Name: "Portalegre", Type: "District"},
+	{Code: "PT-13", Name: "Porto", Type: "District"},
+	{Code: "PT-14", Name: "Santarém", Type: "District"},
+	{Code: "PT-15", Name: "Setúbal", Type: "District"},
+	{Code: "PT-16", Name: "Viana do Castelo", Type: "District"},
+	{Code: "PT-17", Name: "Vila Real", Type: "District"},
+	{Code: "PT-18", Name: "Viseu", Type: "District"},
+	{Code: "PT-20", Name: "Região Autónoma dos Açores", Type: "Autonomous region"},
+	{Code: "PT-30", Name: "Região Autónoma da Madeira", Type: "Autonomous region"},
+	{Code: "PW-002", Name: "Aimeliik", Type: "State"},
+	{Code: "PW-004", Name: "Airai", Type: "State"},
+	{Code: "PW-010", Name: "Angaur", Type: "State"},
+	{Code: "PW-050", Name: "Hatohobei", Type: "State"},
+	{Code: "PW-100", Name: "Kayangel", Type: "State"},
+	{Code: "PW-150", Name: "Koror", Type: "State"},
+	{Code: "PW-212", Name: "Melekeok", Type: "State"},
+	{Code: "PW-214", Name: "Ngaraard", Type: "State"},
+	{Code: "PW-218", Name: "Ngarchelong", Type: "State"},
+	{Code: "PW-222", Name: "Ngardmau", Type: "State"},
+	{Code: "PW-224", Name: "Ngatpang", Type: "State"},
+	{Code: "PW-226", Name: "Ngchesar", Type: "State"},
+	{Code: "PW-227", Name: "Ngeremlengui", Type: "State"},
+	{Code: "PW-228", Name: "Ngiwal", Type: "State"},
+	{Code: "PW-350", Name: "Peleliu", Type: "State"},
+	{Code: "PW-370", Name: "Sonsorol", Type: "State"},
+	{Code: "PY-1", Name: "Concepción", Type: "Department"},
+	{Code: "PY-10", Name: "Alto Paraná", Type: "Department"},
+	{Code: "PY-11", Name: "Central", Type: "Department"},
+	{Code: "PY-12", Name: "Ñeembucú", Type: "Department"},
+	{Code: "PY-13", Name: "Amambay", Type: "Department"},
+	{Code: "PY-14", Name: "Canindeyú", Type: "Department"},
+	{Code: "PY-15", Name: "Presidente Hayes", Type: "Department"},
+	{Code: "PY-16", Name: "Alto Paraguay", Type: "Department"},
+	{Code: "PY-19", Name: "Boquerón", Type: "Department"},
+	{Code: "PY-2", Name: "San Pedro", Type: "Department"},
+	{Code: "PY-3", Name: "Cordillera", Type: "Department"},
+	{Code: "PY-4", Name: "Guairá", Type: "Department"},
+	{Code: "PY-5", Name: "Caaguazú", Type: "Department"},
+	{Code: "PY-6", Name: "Caazapá", Type: "Department"},
+	{Code: "PY-7", Name: "Itapúa", Type: "Department"},
+	{Code: "PY-8", Name: "Misiones", Type: "Department"},
+	{Code: "PY-9", Name: "Paraguarí", Type: "Department"},
+	{Code: "PY-ASU", Name: "Asunción", Type: "Capital"},
+	{Code: "QA-DA", Name: "Ad Dawḩah", Type: "Municipality"},
+	{Code: "QA-KH", Name: "Al Khawr wa adh Dhakhīrah", Type: "Municipality"},
+	{Code: "QA-MS", Name: "Ash Shamāl", Type: "Municipality"},
+	{Code: "QA-RA", Name: "Ar Rayyān", Type: "Municipality"},
+	{Code: "QA-SH", Name: "Ash Shīḩānīyah", Type: "Municipality"},
+	{Code: "QA-US", Name: "Umm Şalāl", Type: "Municipality"},
+	{Code: "QA-WA", Name: "Al Wakrah", Type: "Municipality"},
+	{Code: "QA-ZA", Name: "Az̧ Z̧a‘āyin", Type: "Municipality"},
+	{Code: "RO-AB", Name: "Alba", Type: "Department"},
+	{Code: "RO-AG", Name: "Argeș", Type: "Department"},
+	{Code: "RO-AR", Name: "Arad", Type: "Department"},
+	{Code: "RO-B", Name: "București", Type: "Municipality"},
+	{Code: "RO-BC", Name: "Bacău", Type: "Department"},
+	{Code: "RO-BH", Name: "Bihor", Type: "Department"},
+	{Code: "RO-BN", Name: "Bistrița-Năsăud", Type: "Department"},
+	{Code: "RO-BR", Name: "Brăila", Type: "Department"},
+	{Code: "RO-BT", Name: "Botoșani", Type: "Department"},
+	{Code: "RO-BV", Name: "Brașov", Type: "Department"},
+	{Code: "RO-BZ", Name: "Buzău", Type: "Department"},
+	{Code: "RO-CJ", Name: "Cluj", Type: "Department"},
+	{Code: "RO-CL", Name: "Călărași", Type: "Department"},
+	{Code: "RO-CS", Name: "Caraș-Severin", Type: "Department"},
+	{Code: "RO-CT", Name: "Constanța", Type: "Department"},
+	{Code: "RO-CV", Name: "Covasna", Type: "Department"},
+	{Code: "RO-DB", Name: "Dâmbovița", Type: "Department"},
+	{Code: "RO-DJ", Name: "Dolj", Type: "Department"},
+	{Code: "RO-GJ", Name: "Gorj", Type: "Department"},
+	{Code: "RO-GL", Name: "Galați", Type: "Department"},
+	{Code: "RO-GR", Name: "Giurgiu", Type: "Department"},
+	{Code: "RO-HD", Name: "Hunedoara", Type: "Department"},
+	{Code: "RO-HR", Name: "Harghita", Type: "Department"},
+	{Code: "RO-IF", Name: "Ilfov", Type: "Department"},
+	{Code: "RO-IL", Name: "Ialomița", Type: "Department"},
+	{Code: "RO-IS", Name: "Iași", Type: "Department"},
+	{Code: "RO-MH", Name: "Mehedinți", Type: "Department"},
+	{Code: "RO-MM", Name: "Maramureș", Type: "Department"},
+	{Code: "RO-MS", Name: "Mureș", Type: "Department"},
+	{Code: "RO-NT", Name: "Neamț", Type: "Department"},
+	{Code: "RO-OT", Name: "Olt", Type: "Department"},
+	{Code: "RO-PH", Name: "Prahova", Type: "Department"},
+	{Code: "RO-SB", Name: "Sibiu", Type: "Department"},
+	{Code: "RO-SJ", Name: "Sălaj", Type: "Department"},
+	{Code: "RO-SM", Name: "Satu Mare", Type: "Department"},
+	{Code: "RO-SV", Name: "Suceava", Type: "Department"},
+	{Code: "RO-TL", Name: "Tulcea", Type: "Department"},
+	{Code: "RO-TM", Name: "Timiș", Type: "Department"},
+	{Code: "RO-TR", Name: "Teleorman", Type: "Department"},
+	{Code: "RO-VL", Name: "Vâlcea", Type: "Department"},
+	{Code: "RO-VN", Name: "Vrancea", Type: "Department"},
+	{Code: "RO-VS", Name: "Vaslui", Type: "Department"},
+	{Code: "RS-00", Name: "Beograd", Type: "City"},
+	{Code: "RS-01", Name: "Severnobački okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-02", Name: "Srednjebanatski okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-03", Name: "Severnobanatski okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-04", Name: "Južnobanatski okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-05", Name: "Zapadnobački okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-06", Name: "Južnobački okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-07", Name: "Sremski okrug", Type: "District", Parent: "RS-VO"},
+	{Code: "RS-08", Name: "Mačvanski okrug", Type: "District"},
+	{Code: "RS-09", Name: "Kolubarski okrug", Type: "District"},
+	{Code: "RS-10", Name: "Podunavski okrug", Type: "District"},
+	{Code: "RS-11", Name: "Braničevski okrug", Type: "District"},
+	{Code: "RS-12", Name: "Šumadijski okrug", Type: "District"},
+	{Code: "RS-13", Name: "Pomoravski okrug", Type: "District"},
+	{Code: "RS-14", Name: "Borski okrug", Type: "District"},
+	{Code: "RS-15", Name: "Zaječarski okrug", Type: "District"},
+	{Code: "RS-16", Name: "Zlatiborski okrug", Type: "District"},
+	{Code: "RS-17", Name: "Moravički okrug", Type: "District"},
+	{Code: "RS-18", Name: "Raški okrug", Type: "District"},
+	{Code: "RS-19", Name: "Rasinski okrug", Type: "District"},
+	{Code: "RS-20", Name: "Nišavski okrug", Type: "District"},
+	{Code: "RS-21", Name: "Toplički okrug", Type: "District"},
+	{Code: "RS-22", Name: "Pirotski okrug", Type: "District"},
+	{Code: "RS-23", Name: "Jablanički okrug", Type: "District"},
+	{Code: "RS-24", Name: "Pčinjski okrug", Type: "District"},
+	{Code: "RS-25", Name: "Kosovski okrug", Type: "District", Parent: "RS-KM"},
+	{Code: "RS-26", Name: "Pećki okrug", Type: "District", Parent: "RS-KM"},
+	{Code: "RS-27", Name: "Prizrenski okrug", Type: "District", Parent: "RS-KM"},
+	{Code: "RS-28", Name: "Kosovsko-Mitrovački okrug", Type: "District", Parent: "RS-KM"},
+	{Code: "RS-29", Name: "Kosovsko-Pomoravski okrug", Type: "District", Parent: "RS-KM"},
+	{Code: "RS-KM", Name: "Kosovo-Metohija", Type: "Autonomous province"},
+	{Code: "RS-VO", Name: "Vojvodina", Type: "Autonomous province"},
+	{Code: "RU-AD", Name: "Adygeja, Respublika", Type: "Republic"},
+	{Code: "RU-AL", Name: "Altaj, Respublika", Type: "Republic"},
+	{Code: "RU-ALT", Name: "Altajskij kraj", Type: "Administrative territory"},
+	{Code: "RU-AMU", Name: "Amurskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-ARK", Name: "Arhangel'skaja oblast'", Type: "Administrative region"},
+	{Code: "RU-AST", Name: "Astrahanskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-BA", Name: "Bashkortostan, Respublika", Type: "Republic"},
+	{Code: "RU-BEL", Name: "Belgorodskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-BRY", Name: "Brjanskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-BU", Name: "Burjatija, Respublika", Type: "Republic"},
+	{Code: "RU-CE", Name: "Chechenskaya Respublika", Type: "Republic"},
+	{Code: "RU-CHE", Name: "Chelyabinskaya oblast'", Type: "Administrative region"},
+	{Code: "RU-CHU", Name: "Chukotskiy avtonomnyy okrug", Type: "Autonomous district"},
+	{Code: "RU-CU", Name: "Chuvashskaya Respublika", Type: "Republic"},
+	{Code: "RU-DA", Name: "Dagestan, Respublika", Type: "Republic"},
+	{Code: "RU-IN", Name: "Ingushetiya, Respublika", Type: "Republic"},
+	{Code: "RU-IRK", Name: "Irkutskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-IVA", Name: "Ivanovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KAM", Name: "Kamchatskiy kray", Type: "Administrative territory"},
+	{Code: "RU-KB", Name: "Kabardino-Balkarskaja Respublika", Type: "Republic"},
+	{Code: "RU-KC", Name: "Karachayevo-Cherkesskaya Respublika", Type: "Republic"},
+	{Code: "RU-KDA", Name: "Krasnodarskij kraj", Type: "Administrative territory"},
+	{Code: "RU-KEM", Name: "Kemerovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KGD", Name: "Kaliningradskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KGN", Name: "Kurganskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KHA", Name: "Habarovskij kraj", Type: "Administrative territory"},
+	{Code: "RU-KHM", Name: "Hanty-Mansijskij avtonomnyj okrug", Type: "Autonomous district"},
+	{Code: "RU-KIR", Name: "Kirovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KK", Name: "Hakasija, Respublika", Type: "Republic"},
+	{Code: "RU-KL", Name: "Kalmykija, Respublika", Type: "Republic"},
+	{Code: "RU-KLU", Name: "Kaluzhskaya oblast'", Type: "Administrative region"},
+	{Code: "RU-KO", Name: "Komi, Respublika", Type: "Republic"},
+	{Code: "RU-KOS", Name: "Kostromskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KR", Name: "Karelija, Respublika", Type: "Republic"},
+	{Code: "RU-KRS", Name: "Kurskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-KYA", Name: "Krasnojarskij kraj", Type: "Administrative territory"},
+	{Code: "RU-LEN", Name: "Leningradskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-LIP", Name: "Lipeckaja oblast'", Type: "Administrative region"},
+	{Code: "RU-MAG", Name: "Magadanskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-ME", Name: "Marij Èl, Respublika", Type: "Republic"},
+	{Code: "RU-MO", Name: "Mordovija, Respublika", Type: "Republic"},
+	{Code: "RU-MOS", Name: "Moskovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-MOW", Name: "Moskva", Type: "Autonomous city"},
+	{Code: "RU-MUR", Name: "Murmanskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-NEN", Name: "Neneckij avtonomnyj okrug", Type: "Autonomous district"},
+	{Code: "RU-NGR", Name: "Novgorodskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-NIZ", Name: "Nizhegorodskaya oblast'", Type: "Administrative region"},
+	{Code: "RU-NVS", Name: "Novosibirskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-OMS", Name: "Omskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-ORE", Name: "Orenburgskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-ORL", Name: "Orlovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-PER", Name: "Permskij kraj", Type: "Administrative territory"},
+	{Code: "RU-PNZ", Name: "Penzenskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-PRI", Name: "Primorskij kraj", Type: "Administrative territory"},
+	{Code: "RU-PSK", Name: "Pskovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-ROS", Name: "Rostovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-RYA", Name: "Rjazanskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-SA", Name: "Saha, Respublika", Type: "Republic"},
+	{Code: "RU-SAK", Name: "Sahalinskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-SAM", Name: "Samarskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-SAR", Name: "Saratovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-SE", Name: "Severnaja Osetija, Respublika", Type: "Republic"},
+	{Code: "RU-SMO", Name: "Smolenskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-SPE", Name: "Sankt-Peterburg", Type: "Autonomous city"},
+	{Code: "RU-STA", Name: "Stavropol'skij kraj", Type: "Administrative territory"},
+	{Code: "RU-SVE", Name: "Sverdlovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-TA", Name: "Tatarstan, Respublika", Type: "Republic"},
+	{Code: "RU-TAM", Name: "Tambovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-TOM", Name: "Tomskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-TUL", Name: "Tul'skaja oblast'", Type: "Administrative region"},
+	{Code: "RU-TVE", Name: "Tverskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-TY", Name: "Tyva, Respublika", Type: "Republic"},
+	{Code: "RU-TYU", Name: "Tjumenskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-UD", Name: "Udmurtskaja Respublika", Type: "Republic"},
+	{Code: "RU-ULY", Name: "Ul'janovskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-VGG", Name: "Volgogradskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-VLA", Name: "Vladimirskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-VLG", Name: "Vologodskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-VOR", Name: "Voronezhskaya oblast'", Type: "Administrative region"},
+	{Code: "RU-YAN", Name: "Jamalo-Neneckij avtonomnyj okrug", Type: "Autonomous district"},
+	{Code: "RU-YAR", Name: "Jaroslavskaja oblast'", Type: "Administrative region"},
+	{Code: "RU-YEV", Name: "Evrejskaja avtonomnaja oblast'", Type: "Autonomous region"},
+	{Code: "RU-ZAB", Name: "Zabajkal'skij kraj", Type: "Administrative territory"},
+	{Code: "RW-01", Name: "City of Kigali", Type: "City"},
+	{Code: "RW-02", Name: "Eastern", Type: "Province"},
+	{Code: "RW-03", Name: "Northern", Type: "Province"},
+	{Code: "RW-04", Name: "Western", Type: "Province"},
+	{Code: "RW-05", Name: "Southern", Type: "Province"},
+	{Code: "SA-01", Name: "Ar Riyāḑ", Type: "Region"},
+	{Code: "SA-02", Name: "Makkah al Mukarramah", Type: "Region"},
+	{Code: "SA-03", Name: "Al Madīnah al Munawwarah", Type: "Region"},
+	{Code: "SA-04", Name: "Ash Sharqīyah", Type: "Region"},
+	{Code: "SA-05", Name: "Al Qaşīm", Type: "Region"},
+	{Code: "SA-06", Name: "Ḩā'il", Type: "Region"},
+	{Code: "SA-07", Name: "Tabūk", Type: "Region"},
+	{Code: "SA-08", Name: "Al Ḩudūd ash Shamālīyah", Type: "Region"},
+	{Code: "SA-09", Name: "Jāzān", Type: "Region"},
+	{Code: "SA-10", Name: "Najrān", Type: "Region"},
+	{Code: "SA-11", Name: "Al Bāḩah", Type: "Region"},
+	{Code: "SA-12", Name: "Al Jawf", Type: "Region"},
+	{Code: "SA-14", Name: "'Asīr", Type: "Region"},
+	{Code: "SB-CE", Name: "Central", Type: "Province"},
+	{Code: "SB-CH", Name: "Choiseul", Type: "Province"},
+	{Code: "SB-CT", Name: "Capital Territory (Honiara)", Type: "Capital territory"},
+	{Code: "SB-GU", Name: "Guadalcanal", Type: "Province"},
+	{Code: "SB-IS", Name: "Isabel", Type: "Province"},
+	{Code: "SB-MK", Name: "Makira-Ulawa", Type: "Province"},
+	{Code: "SB-ML", Name: "Malaita", Type: "Province"},
+	{Code: "SB-RB", Name: "Rennell and Bellona", Type: "Province"},
+	{Code: "SB-TE", Name: "Temotu", Type: "Province"},
+	{Code: "SB-WE", Name: "Western", Type: "Province"},
+	{Code: "SC-01", Name: "Anse aux Pins", Type: "District"},
+	{Code: "SC-02", Name: "Anse Boileau", Type: "District"},
+	{Code: "SC-03", Name: "Anse Etoile", Type: "District"},
+	{Code: "SC-04", Name: "Au Cap", Type: "District"},
+	{Code: "SC-05", Name: "Anse Royale", Type: "District"},
+	{Code: "SC-06", Name: "Baie Lazare", Type: "District"},
+	{Code: "SC-07", Name: "Baie Sainte Anne", Type: "District"},
+	{Code: "SC-08", Name: "Beau Vallon", Type: "District"},
+	{Code: "SC-09", Name: "Bel Air", Type: "District"},
+	{Code: "SC-10", Name: "Bel Ombre", Type: "District"},
+	{Code: "SC-11", Name: "Cascade", Type: "District"},
+	{Code: "SC-12", Name: "Glacis", Type: "District"},
+	{Code: "SC-13", Name: "Grand Anse Mahe", Type: "District"},
+	{Code: "SC-14", Name: "Grand Anse Praslin", Type: "District"},
+	{Code: "SC-15", Name: "La Digue", Type: "District"},
+	{Code: "SC-16", Name: "English River", Type: "District"},
+	{Code: "SC-17", Name: "Mont Buxton", Type: "District"},
+	{Code: "SC-18", Name: "Mont Fleuri", Type: "District"},
+	{Code: "SC-19", Name: "Plaisance", Type: "District"},
+	{Code: "SC-20", Name: "Pointe Larue", Type: "District"},
+	{Code: "SC-21", Name: "Port Glaud", Type: "District"},
+	{Code: "SC-22", Name: "Saint Louis", Type: "District"},
+	{Code: "SC-23", Name: "Takamaka", Type: "District"},
+	{Code: "SC-24", Name: "Les Mamelles", Type: "District"},
+	{Code: "SC-25", Name: "Roche Caiman", Type: "District"},
+	{Code: "SC-26", Name: "Ile Perseverance I", Type: "District"},
+	{Code: "SC-27", Name: "Ile Perseverance II", Type: "District"},
+	{Code: "SD-DC", Name: "Central Darfur", Type: "State"},
+	{Code: "SD-DE", Name: "East Darfur", Type: "State"},
+	{Code: "SD-DN", Name: "North Darfur", Type: "State"},
+	{Code: "SD-DS", Name: "South Darfur", Type: "State"},
+	{Code: "SD-DW", Name: "West Darfur", Type: "State"},
+	{Code: "SD-GD", Name: "Gedaref", Type: "State"},
+	{Code: "SD-GK", Name: "West Kordofan", Type: "State"},
+	{Code: "SD-GZ", Name: "Gezira", Type: "State"},
+	{Code: "SD-KA", Name: "Kassala", Type: "State"},
+	{Code: "SD-KH", Name: "Khartoum", Type: "State"},
+	{Code: "SD-KN", Name: "North Kordofan", Type: "State"},
+	{Code: "SD-KS", Name: "South Kordofan", Type: "State"},
+	{Code: "SD-NB", Name: "Blue Nile", Type: "State"},
+	{Code: "SD-NO", Name: "Northern", Type: "State"},
+	{Code: "SD-NR", Name: "River Nile", Type: "State"},
+	{Code: "SD-NW", Name: "White Nile", Type: "State"},
+	{Code: "SD-RS", Name: "Red Sea", Type: "State"},
+	{Code: "SD-SI", Name: "Sennar", Type: "State"},
+	{Code: "SE-AB", Name: "Stockholms län [SE-01]", Type: "County"},
+	{Code: "SE-AC", Name: "Västerbottens län [SE-24]", Type: "County"},
+	{Code: "SE-BD", Name: "Norrbottens län [SE-25]", Type: "County"},
+	{Code: "SE-C", Name: "Uppsala län [SE-03]", Type: "County"},
+	{Code: "SE-D", Name: "Södermanlands län [SE-04]", Type: "County"},
+	{Code: "SE-E", Name: "Östergötlands län [SE-05]", Type: "County"},
+	{Code: "SE-F", Name: "Jönköpings län [SE-06]", Type: "County"},
+	{Code: "SE-G", Name: "Kronobergs län [SE-07]", Type: "County"},
+	{Code: "SE-H", Name: "Kalmar län [SE-08]", Type: "County"},
+	{Code: "SE-I", Name: "Gotlands län [SE-09]", Type: "County"},
+	{Code: "SE-K", Name: "Blekinge län [SE-10]", Type: "County"},
+	{Code: "SE-M", Name: "Skåne län [SE-12]", Type: "County"},
+	{Code: "SE-N", Name: "Hallands län [SE-13]", Type: "County"},
+	{Code: "SE-O", Name: "Västra Götalands län [SE-14]", Type: "County"},
+	{Code: "SE-S", Name: "Värmlands län [SE-17]", Type: "County"},
+	{Code: "SE-T", Name: "Örebro län [SE-18]", Type: "County"},
+	{Code: "SE-U", Name: "Västmanlands län [SE-19]", Type: "County"},
+	{Code: "SE-W", Name: "Dalarnas län [SE-20]", Type: "County"},
+	{Code: "SE-X", Name: "Gävleborgs län [SE-21]", Type: "County"},
+	{Code: "SE-Y", Name: "Västernorrlands län [SE-22]", Type: "County"},
+	{Code: "SE-Z", Name: "Jämtlands län [SE-23]", Type: "County"},
+	{Code: "SG-01", Name: "Central Singapore", Type: "District"},
+	{Code: "SG-02", Name: "North East", Type: "District"},
+	{Code: "SG-03", Name: "North West", Type: "District"},
+	{Code: "SG-04", Name: "South East", Type: "District"},
+	{Code: "SG-05", Name: "South West", Type: "District"},
+	{Code: "SH-AC", Name: "Ascension", Type: "Geographical entity"},
+	{Code: "SH-HL", Name: "Saint Helena", Type: "Geographical entity"},
+	{Code: "SH-TA", Name: "Tristan da Cunha", Type: "Geographical entity"},
+	{Code: "SI-001", Name: "Ajdovščina", Type: "Municipality"},
+	{Code: "SI-002", Name: "Beltinci", Type: "Municipality"},
+	{Code: "SI-003", Name: "Bled", Type: "Municipality"},
+	{Code: "SI-004", Name: "Bohinj", Type: "Municipality"},
+	{Code: "SI-005", Name: "Borovnica", Type: "Municipality"},
+	{Code: "SI-006", Name: "Bovec", Type: "Municipality"},
+	{Code: "SI-007", Name: "Brda", Type: "Municipality"},
+	{Code: "SI-008", Name: "Brezovica", Type: "Municipality"},
+	{Code: "SI-009", Name: "Brežice", Type: "Municipality"},
+	{Code: "SI-010", Name: "Tišina", Type: "Municipality"},
+	{Code: "SI-011", Name: "Celje", Type: "Municipality"},
+	{Code: "SI-012", Name: "Cerklje na Gorenjskem", Type: "Municipality"},
+	{Code: "SI-013", Name: "Cerknica", Type: "Municipality"},
+	{Code: "SI-014", Name: "Cerkno", Type: "Municipality"},
+	{Code: "SI-015", Name: "Črenšovci", Type: "Municipality"},
+	{Code: "SI-016", Name: "Črna na Koroškem", Type: "Municipality"},
+	{Code: "SI-017", Name: "Črnomelj", Type: "Municipality"},
+	{Code: "SI-018", Name: "Destrnik", Type: "Municipality"},
+	{Code: "SI-019", Name: "Divača", Type: "Municipality"},
+	{Code: "SI-020", Name: "Dobrepolje", Type: "Municipality"},
+	{Code: "SI-021", Name: "Dobrova-Polhov Gradec", Type: "Municipality"},
+	{Code: "SI-022", Name: "Dol pri Ljubljani", Type: "Municipality"},
+	{Code: "SI-023", Name: "Domžale", Type: "Municipality"},
+	{Code: "SI-024", Name: "Dornava", Type: "Municipality"},
+	{Code: "SI-025", Name: "Dravograd", Type: "Municipality"},
+	{Code: "SI-026", Name: "Duplek", Type: "Municipality"},
+	{Code: "SI-027", Name: "Gorenja vas-Poljane", Type: "Municipality"},
+	{Code: "SI-028", Name: "Gorišnica", Type: "Municipality"},
+	{Code: "SI-029", Name: "Gornja Radgona", Type: "Municipality"},
+	{Code: "SI-030", Name: "Gornji Grad", Type: "Municipality"},
+	{Code: "SI-031", Name: "Gornji Petrovci", Type: "Municipality"},
+	{Code: "SI-032", Name: "Grosuplje", Type: "Municipality"},
+	{Code: "SI-033", Name: "Šalovci", Type: "Municipality"},
+	{Code: "SI-034", Name: "Hrastnik", Type: "Municipality"},
+	{Code: "SI-035", Name: "Hrpelje-Kozina", Type: "Municipality"},
+	{Code: "SI-036", Name: "Idrija", Type: "Municipality"},
+	{Code: "SI-037", Name: "Ig", Type: "Municipality"},
+	{Code: "SI-038", Name: "Ilirska Bistrica", Type: "Municipality"},
+	{Code: "SI-039", Name: "Ivančna Gorica", Type: "Municipality"},
+	{Code: "SI-040", Name: "Izola", Type: "Municipality"},
+	{Code: "SI-041", Name: "Jesenice", Type: "Municipality"},
+	{Code: "SI-042", Name: "Juršinci", Type: "Municipality"},
+	{Code: "SI-043", Name: "Kamnik", Type: "Municipality"},
+	{Code: "SI-044", Name: "Kanal", Type: "Municipality"},
+	{Code: "SI-045", Name: "Kidričevo", Type: "Municipality"},
+	{Code: "SI-046", Name: "Kobarid", Type: "Municipality"},
+	{Code: "SI-047", Name: "Kobilje", Type: "Municipality"},
+	{Code: "SI-048", Name: "Kočevje", Type: "Municipality"},
+	{Code: "SI-049", Name: "Komen", Type: "Municipality"},
+	{Code: "SI-050", Name: "Koper", Type: "Municipality"},
+	{Code: "SI-051", Name: "Kozje", Type: "Municipality"},
+	{Code: "SI-052", Name: "Kranj", Type: "Municipality"},
+	{Code: "SI-053", Name: "Kranjska Gora", Type: "Municipality"},
+	{Code: "SI-054", Name: "Krško", Type: "Municipality"},
+	{Code: "SI-055", Name: "Kungota", Type: "Municipality"},
+	{Code: "SI-056", Name: "Kuzma", Type: "Municipality"},
+	{Code: "SI-057", Name: "Laško", Type: "Municipality"},
+	{Code: "SI-058", Name: "Lenart", Type: "Municipality"},
+	{Code: "SI-059", Name: "Lendava", Type: "Municipality"},
+	{Code: "SI-060", Name: "Litija", Type: "Municipality"},
+	{Code: "SI-061", Name: "Ljubljana", Type: "Municipality"},
+	{Code: "SI-062", Name: "Ljubno", Type: "Municipality"},
+	{Code: "SI-063", Name: "Ljutomer", Type: "Municipality"},
+	{Code: "SI-064", Name: "Logatec", Type: "Municipality"},
+	{Code: "SI-065", Name: "Loška dolina", Type: "Municipality"},
+	{Code: "SI-066", Name: "Loški Potok", Type: "Municipality"},
+	{Code: "SI-067", Name: "Luče", Type: "Municipality"},
+	{Code: "SI-068", Name: "Lukovica", Type: "Municipality"},
+	{Code: "SI-069", Name: "Majšperk", Type: "Municipality"},
+	{Code: "SI-070", Name: "Maribor", Type: "Municipality"},
+	{Code: "SI-071", Name: "Medvode", Type: "Municipality"},
+	{Code: "SI-072", Name: "Mengeš", Type: "Municipality"},
+	{Code: "SI-073", Name: "Metlika", Type: "Municipality"},
+	{Code: "SI-074", Name: "Mežica", Type: "Municipality"},
+	{Code: "SI-075", Name: "Miren-Kostanjevica", Type: "Municipality"},
+	{Code: "SI-076", Name: "Mislinja", Type: "Municipality"},
+	{Code: "SI-077", Name: "Moravče", Type: "Municipality"},
+	{Code: "SI-078", Name: "Moravske Toplice", Type: "Municipality"},
+	{Code: "SI-079", Name: "Mozirje", Type: "Municipality"},
+	{Code: "SI-080", Name: "Murska Sobota", Type: "Municipality"},
+	{Code: "SI-081", Name: "Muta", Type: "Municipality"},
+	{Code: "SI-082", Name: "Naklo", Type: "Municipality"},
+	{Code: "SI-083", Name: "Nazarje", Type: "Municipality"},
+	{Code: "SI-084", Name: "Nova Gorica", Type: "Municipality"},
+	{Code: "SI-085", Name: "Novo Mesto", Type: "Municipality"},
+	{Code: "SI-086", Name: "Odranci", Type: "Municipality"},
+	{Code: "SI-087", Name: "Ormož", Type: "Municipality"},
+	{Code: "SI-088", Name: "Osilnica", Type: "Municipality"},
+	{Code: "SI-089", Name: "Pesnica", Type: "Municipality"},
+	{Code: "SI-090", Name: "Piran", Type: "Municipality"},
+	{Code: "SI-091", Name: "Pivka", Type: "Municipality"},
+	{Code: "SI-092", Name: "Podčetrtek", Type: "Municipality"},
+	{Code: "SI-093", Name: "Podvelka", Type: "Municipality"},
+	{Code: "SI-094", Name: "Postojna", Type: "Municipality"},
+	{Code: "SI-095", Name: "Preddvor", Type: "Municipality"},
+	{Code: "SI-096", Name: "Ptuj", Type: "Municipality"},
+	{Code: "SI-097", Name: "Puconci", Type: "Municipality"},
+	{Code: "SI-098", Name: "Rače-Fram", Type: "Municipality"},
+	{Code: "SI-099", Name: "Radeče", Type: "Municipality"},
+	{Code: "SI-100", Name: "Radenci", Type: "Municipality"},
+	{Code: "SI-101", Name: "Radlje ob Dravi", Type: "Municipality"},
+	{Code: "SI-102", Name: "Radovljica", Type: "Municipality"},
+	{Code: "SI-103", Name: "Ravne na Koroškem", Type: "Municipality"},
+	{Code: "SI-104", Name: "Ribnica", Type: "Municipality"},
+	{Code: "SI-105", Name: "Rogašovci", Type: "Municipality"},
+	{Code: "SI-106", Name: "Rogaška Slatina", Type: "Municipality"},
+	{Code: "SI-107", Name: "Rogatec", Type: "Municipality"},
+	{Code: "SI-108", Name: "Ruše", Type: "Municipality"},
+	{Code: "SI-109", Name: "Semič", Type: "Municipality"},
+	{Code: "SI-110", Name: "Sevnica", Type: "Municipality"},
+	{Code: "SI-111", Name: "Sežana", Type: "Municipality"},
+	{Code: "SI-112", Name: "Slovenj Gradec", Type: "Municipality"},
+	{Code: "SI-113", Name: "Slovenska Bistrica", Type: "Municipality"},
+	{Code: "SI-114", Name: "Slovenske Konjice", Type: "Municipality"},
+	{Code: "SI-115", Name: "Starše", Type: "Municipality"},
+	{Code: "SI-116", Name: "Sveti Jurij ob Ščavnici", Type: "Municipality"},
+	{Code: "SI-117", Name: "Šenčur", Type: "Municipality"},
+	{Code: "SI-118", Name: "Šentilj", Type: "Municipality"},
+	{Code: "SI-119", Name: "Šentjernej", Type: "Municipality"},
+	{Code: "SI-120", Name: "Šentjur", Type: "Municipality"},
+	{Code: "SI-121", Name: "Škocjan", Type: "Municipality"},
+	{Code: "SI-122", Name: "Škofja Loka", Type: "Municipality"},
+	{Code: "SI-123", Name: "Škofljica", Type: "Municipality"},
+	{Code: "SI-124", Name: "Šmarje pri Jelšah", Type: "Municipality"},
+	{Code: "SI-125", Name: "Šmartno ob Paki", Type: "Municipality"},
+	{Code: "SI-126", Name: "Šoštanj", Type: "Municipality"},
+	{Code: "SI-127", Name: "Štore", Type: "Municipality"},
+	{Code: "SI-128", Name: "Tolmin", Type: "Municipality"},
+	{Code: "SI-129", Name: "Trbovlje", Type: "Municipality"},
+	{Code: "SI-130", Name: "Trebnje", Type: "Municipality"},
+	{Code: "SI-131", Name: "Tržič", Type: "Municipality"},
+	{Code: "SI-132", Name: "Turnišče", Type: "Municipality"},
+	{Code: "SI-133", Name: "Velenje", Type: "Municipality"},
+	{Code: "SI-134", Name: "Velike Lašče", Type: "Municipality"},
+	{Code: "SI-135", Name: "Videm", Type: "Municipality"},
+	{Code: "SI-136", Name: "Vipava", Type: "Municipality"},
+	{Code: "SI-137", Name: "Vitanje", Type: "Municipality"},
+	{Code: "SI-138", Name: "Vodice", Type: "Municipality"},
+	{Code: "SI-139", Name: "Vojnik", Type: "Municipality"},
+	{Code: "SI-140", Name: "Vrhnika", Type: "Municipality"},
+	{Code: "SI-141", Name: "Vuzenica", Type: "Municipality"},
+	{Code: "SI-142", Name: "Zagorje ob Savi", Type: "Municipality"},
+	{Code: "SI-143", Name: "Zavrč", Type: "Municipality"},
+	{Code: "SI-144", Name: "Zreče", Type: "Municipality"},
+	{Code: "SI-146", Name: "Železniki", Type: "Municipality"},
+	{Code: "SI-147", Name: "Žiri", Type: "Municipality"},
+	{Code: "SI-148", Name: "Benedikt", Type: "Municipality"},
+	{Code: "SI-149", Name: "Bistrica ob Sotli", Type: "Municipality"},
+	{Code: "SI-150", Name: "Bloke", Type: "Municipality"},
+	{Code: "SI-151", Name: "Braslovče", Type: "Municipality"},
+	{Code: "SI-152", Name: "Cankova", Type: "Municipality"},
+	{Code: "SI-153", Name: "Cerkvenjak", Type: "Municipality"},
+	{Code: "SI-154", Name: "Dobje", Type: "Municipality"},
+	{Code: "SI-155", Name: "Dobrna", Type: "Municipality"},
+	{Code: "SI-156", Name: "Dobrovnik", Type: "Municipality"},
+	{Code: "SI-157", Name: "Dolenjske Toplice", Type: "Municipality"},
+	{Code: "SI-158", Name: "Grad", Type: "Municipality"},
+	{Code: "SI-159", Name: "Hajdina", Type: "Municipality"},
+	{Code: "SI-160", Name: "Hoče-Slivnica", Type: "Municipality"},
+	{Code: "SI-161", Name: "Hodoš", Type: "Municipality"},
+	{Code: "SI-162", Name: "Horjul", Type: "Municipality"},
+	{Code: "SI-163", Name: "Jezersko", Type: "Municipality"},
+	{Code: "SI-164", Name: "Komenda", Type: "Municipality"},
+	{Code: "SI-165", Name: "Kostel", Type: "Municipality"},
+	{Code: "SI-166", Name: "Križevci", Type: "Municipality"},
+	{Code: "SI-167", Name: "Lovrenc na Pohorju", Type: "Municipality"},
+	{Code: "SI-168", Name: "Markovci", Type: "Municipality"},
+	{Code: "SI-169", Name: "Miklavž na Dravskem polju", Type: "Municipality"},
+	{Code: "SI-170", Name: "Mirna Peč", Type: "Municipality"},
+	{Code: "SI-171", Name: "Oplotnica", Type: "Municipality"},
+	{Code: "SI-172", Name: "Podlehnik", Type: "Municipality"},
+	{Code: "SI-173", Name: "Polzela", Type: "Municipality"},
+	{Code: "SI-174", Name: "Prebold", Type: "Municipality"},
+	{Code: "SI-175", Name: "Prevalje", Type: "Municipality"},
+	{Code: "SI-176", Name: "Razkrižje", Type: "Municipality"},
+	{Code: "SI-177", Name: "Ribnica na Pohorju", Type: "Municipality"},
+	{Code: "SI-178", Name: "Selnica ob Dravi", Type: "Municipality"},
+	{Code: "SI-179", Name: "Sodražica", Type: "Municipality"},
+	{Code: "SI-180", Name: "Solčava", Type: "Municipality"},
+	{Code: "SI-181", Name: "Sveta Ana", Type: "Municipality"},
+	{Code: "SI-182", Name: "Sveti Andraž v Slovenskih goricah", Type: "Municipality"},
+	{Code: "SI-183", Name: "Šempeter-Vrtojba", Type: "Municipality"},
+	{Code: "SI-184", Name: "Tabor", Type: "Municipality"},
+	{Code: "SI-185", Name: "Trnovska Vas", Type: "Municipality"},
+	{Code: "SI-186", Name: "Trzin", Type: "Municipality"},
+	{Code: "SI-187", Name: "Velika Polana", Type: "Municipality"},
+	{Code: "SI-188", Name: "Veržej", Type: "Municipality"},
+	{Code: "SI-189", Name: "Vransko", Type: "Municipality"},
+	{Code: "SI-190", Name: "Žalec", Type: "Municipality"},
+	{Code: "SI-191", Name: "Žetale", Type: "Municipality"},
+	{Code: "SI-192", Name: "Žirovnica", Type: "Municipality"},
+	{Code: "SI-193", Name: "Žužemberk", Type: "Municipality"},
+	{Code: "SI-194", Name: "Šmartno pri Litiji", Type: "Municipality"},
+	{Code: "SI-195", Name: "Apače", Type: "Municipality"},
+	{Code: "SI-196", Name: "Cirkulane", Type: "Municipality"},
+	{Code: "SI-197", Name: "Kosanjevica na Krki", Type: "Municipality"},
+	{Code: "SI-198", Name: "Makole", Type: "Municipality"},
+	{Code: "SI-199", Name: "Mokronog-Trebelno", Type: "Municipality"},
+	{Code: "SI-200", Name: "Poljčane", Type: "Municipality"},
+	{Code: "SI-201", Name: "Renče-Vogrsko", Type: "Municipality"},
+	{Code: "SI-202", Name: "Središče ob Dravi", Type: "Municipality"},
+	{Code: "SI-203", Name: "Straža", Type: "Municipality"},
+	{Code: "SI-204", Name: "Sveta Trojica v Slovenskih goricah", Type: "Municipality"},
+	{Code: "SI-205", Name: "Sveti Tomaž", Type: "Municipality"},
+	{Code: "SI-206", Name: "Šmarješke Toplice", Type: "Municipality"},
+	{Code: "SI-207", Name: "Gorje", Type: "Municipality"},
+	{Code: "SI-208", Name: "Log-Dragomer", Type: "Municipality"},
+	{Code: "SI-209", Name: "Rečica ob Savinji", Type: "Municipality"},
+	{Code: "SI-210", Name: "Sveti Jurij v Slovenskih goricah", Type: "Municipality"},
+	{Code: "SI-211", Name: "Šentrupert", Type: "Municipality"},
+	{Code: "SI-212", Name: "Mirna", Type: "Municipality"},
+	{Code: "SI-213", Name: "Ankaran", Type: "Municipality"},
+	{Code: "SK-BC", Name: "Banskobystrický kraj", Type: "Region"},
+	{Code: "SK-BL", Name: "Bratislavský kraj", Type: "Region"},
+	{Code: "SK-KI", Name: "Košický kraj", Type: "Region"},
+	{Code: "SK-NI", Name: "Nitriansky kraj", Type: "Region"},
+	{Code: "SK-PV", Name: "Prešovský kraj", Type: "Region"},
+	{Code: "SK-TA", Name: "Trnavský kraj", Type: "Region"},
+	{Code: "SK-TC", Name: "Trenčiansky kraj", Type: "Region"},
+	{Code: "SK-ZI", Name: "Žilinský kraj", Type: "Region"},
+	{Code: "SL-E", Name: "Eastern", Type: "Province"},
+	{Code: "SL-N", Name: "Northern", Type: "Province"},
+	{Code: "SL-NW", Name: "North Western", Type: "Province"},
+	{Code: "SL-S", Name: "Southern", Type: "Province"},
+	{Code: "SL-W", Name: "Western Area (Freetown)", Type: "Area"},
+	{Code: "SM-01", Name: "Acquaviva", Type: "Municipality"},
+	{Code: "SM-02", Name: "Chiesanuova", Type: "Municipality"},
+	{Code: "SM-03", Name: "Domagnano", Type: "Municipality"},
+	{Code: "SM-04", Name: "Faetano", Type: "Municipality"},
+	{Code: "SM-05", Name: "Fiorentino", Type: "Municipality"},
+	{Code: "SM-06", Name: "Borgo Maggiore", Type: "Municipality"},
+	{Code: "SM-07", Name: "Città di San Marino", Type: "Municipality"},
+	{Code: "SM-08", Name: "Montegiardino", Type: "Municipality"},
+	{Code: "SM-09", Name: "Serravalle", Type: "Municipality"},
+	{Code: "SN-DB", Name: "Diourbel", Type: "Region"},
+	{Code: "SN-DK", Name: "Dakar", Type: "Region"},
+	{Code: "SN-FK", Name: "Fatick", Type: "Region"},
+	{Code: "SN-KA", Name: "Kaffrine", Type: "Region"},
+	{Code: "SN-KD", Name: "Kolda", Type: "Region"},
+	{Code: "SN-KE", Name: "Kédougou", Type: "Region"},
+	{Code: "SN-KL", Name: "Kaolack", Type: "Region"},
+	{Code: "SN-LG", Name: "Louga", Type: "Region"},
+	{Code: "SN-MT", Name: "Matam", Type: "Region"},
+	{Code: "SN-SE", Name: "Sédhiou", Type: "Region"},
+	{Code: "SN-SL", Name: "Saint-Louis", Type: "Region"},
+	{Code: "SN-TC", Name: "Tambacounda", Type: "Region"},
+	{Code: "SN-TH", Name: "Thiès", Type: "Region"},
+	{Code: "SN-ZG", Name: "Ziguinchor", Type: "Region"},
+	{Code: "SO-AW", Name: "Awdal", Type: "Region"},
+	{Code: "SO-BK", Name: "Bakool", Type: "Region"},
+	{Code: "SO-BN", Name: "Banaadir", Type: "Region"},
+	{Code: "SO-BR", Name: "Bari", Type: "Region"},
+	{Code: "SO-BY", Name: "Bay", Type: "Region"},
+	{Code: "SO-GA", Name: "Galguduud", Type: "Region"},
+	{Code: "SO-GE", Name: "Gedo", Type: "Region"},
+	{Code: "SO-HI", Name: "Hiiraan", Type: "Region"},
+	{Code: "SO-JD", Name: "Jubbada Dhexe", Type: "Region"},
+	{Code: "SO-JH", Name: "Jubbada Hoose", Type: "Region"},
+	{Code: "SO-MU", Name: "Mudug", Type: "Region"},
+	{Code: "SO-NU", Name: "Nugaal", Type: "Region"},
+	{Code: "SO-SA", Name: "Sanaag", Type: "Region"},
+	{Code: "SO-SD", Name: "Shabeellaha Dhexe", Type: "Region"},
+	{Code: "SO-SH", Name: "Shabeellaha Hoose", Type: "Region"},
+	{Code: "SO-SO", Name: "Sool", Type: "Region"},
+	{Code: "SO-TO", Name: "Togdheer", Type: "Region"},
+	{Code: "SO-WO", Name: "Woqooyi Galbeed", Type: "Region"},
+	{Code: "SR-BR", Name: "Brokopondo", Type: "District"},
+	{Code: "SR-CM", Name: "Commewijne", Type: "District"},
+	{Code: "SR-CR", Name: "Coronie", Type: "District"},
+	{Code: "SR-MA", Name: "Marowijne", Type: "District"},
+	{Code: "SR-NI", Name: "Nickerie", Type: "District"},
+	{Code: "SR-PM", Name: "Paramaribo", Type: "District"},
+	{Code: "SR-PR", Name: "Para", Type: "District"},
+	{Code: "SR-SA", Name: "Saramacca", Type: "District"},
+	{Code: "SR-SI", Name: "Sipaliwini", Type: "District"},
+	{Code: "SR-WA", Name: "Wanica", Type: "District"},
+	{Code: "SS-BN", Name: "Northern Bahr el Ghazal", Type: "State"},
+	{Code: "SS-BW", Name: "Western Bahr el Ghazal", Type: "State"},
+	{Code: "SS-EC", Name: "Central Equatoria", Type: "State"},
+	{Code: "SS-EE", Name: "Eastern Equatoria", Type: "State"},
+	{Code: "SS-EW", Name: "Western Equatoria", Type: "State"},
+	{Code: "SS-JG", Name: "Jonglei", Type: "State"},
+	{Code: "SS-LK", Name: "Lakes", Type: "State"},
+	{Code: "SS-NU", Name: "Upper Nile", Type: "State"},
+	{Code: "SS-UY", Name: "Unity", Type: "State"},
+	{Code: "SS-WR", Name: "Warrap", Type: "State"},
+	{Code: "ST-01", Name: "Água Grande", Type: "District"},
+	{Code: "ST-02", Name: "Cantagalo", Type: "District"},
+	{Code: "ST-03", Name: "Caué", Type: "District"},
+	{Code: "ST-04", Name: "Lembá", Type: "District"},
+	{Code: "ST-05", Name: "Lobata", Type: "District"},
+	{Code: "ST-06", Name: "Mé-Zóchi", Type: "District"},
+	{Code: "ST-P", Name: "Príncipe", Type: "Autonomous region"},
+	{Code: "SV-AH", Name: "Ahuachapán", Type: "Department"},
+	{Code: "SV-CA", Name: "Cabañas", Type: "Department"},
+	{Code: "SV-CH", Name: "Chalatenango", Type: "Department"},
+	{Code: "SV-CU", Name: "Cuscatlán", Type: "Department"},
+	{Code: "SV-LI", Name: "La Libertad", Type: "Department"},
+	{Code: "SV-MO", Name: "Morazán", Type: "Department"},
+	{Code: "SV-PA", Name: "La Paz", Type: "Department"},
+	{Code: "SV-SA", Name: "Santa Ana", Type: "Department"},
+	{Code: "SV-SM", Name: "San Miguel", Type: "Department"},
+	{Code: "SV-SO", Name: "Sonsonate", Type: "Department"},
+	{Code: "SV-SS", Name: "San Salvador", Type: "Department"},
+	{Code: "SV-SV", Name: "San Vicente", Type: "Department"},
+	{Code: "SV-UN", Name: "La Unión", Type: "Department"},
+	{Code: "SV-US", Name: "Usulután", Type: "Department"},
+	{Code: "SY-DI", Name: "Dimashq", Type: "Province"},
+	{Code: "SY-DR", Name: "Dar'ā", Type: "Province"},
+	{Code: "SY-DY", Name: "Dayr az Zawr", Type: "Province"},
+	{Code: "SY-HA", Name: "Al Ḩasakah", Type: "Province"},
+	{Code: "SY-HI", Name: "Ḩimş", Type: "Province"},
+	{Code: "SY-HL", Name: "Ḩalab", Type: "Province"},
+	{Code: "SY-HM", Name: "Ḩamāh", Type: "Province"},
+	{Code: "SY-ID", Name: "Idlib", Type: "Province"},
+	{Code: "SY-LA", Name: "Al Lādhiqīyah", Type: "Province"},
+	{Code: "SY-QU", Name: "Al Qunayţirah", Type: "Province"},
+	{Code: "SY-RA", Name: "Ar Raqqah", Type: "Province"},
+	{Code: "SY-RD", Name: "Rīf Dimashq", Type: "Province"},
+	{Code: "SY-SU", Name: "As Suwaydā'", Type: "Province"},
+	{Code: "SY-TA", Name: "Ţarţūs", Type: "Province"},
+	{Code: "SZ-HH", Name: "Hhohho", Type: "Region"},
+	{Code: "SZ-LU", Name: "Lubombo", Type: "Region"},
+	{Code: "SZ-MA", Name: "Manzini", Type: "Region"},
+	{Code: "SZ-SH", Name: "Shiselweni", Type: "Region"},
+	{Code: "TD-BA", Name: "Al Baţḩā’", Type: "Province"},
+	{Code: "TD-BG", Name: "Bahr el Ghazal", Type: "Province"},
+	{Code: "TD-BO", Name: "Borkou", Type: "Province"},
+	{Code: "TD-CB", Name: "Chari-Baguirmi", Type: "Province"},
+	{Code: "TD-EE", Name: "Ennedi-Est", Type: "Province"},
+	{Code: "TD-EO", Name: "Ennedi-Ouest", Type: "Province"},
+	{Code: "TD-GR", Name: "Guéra", Type: "Province"},
+	{Code: "TD-HL", Name: "Hadjer Lamis", Type: "Province"},
+	{Code: "TD-KA", Name: "Kanem", Type: "Province"},
+	{Code: "TD-LC", Name: "Al Buḩayrah", Type: "Province"},
+	{Code: "TD-LO", Name: "Logone-Occidental", Type: "Province"},
+	{Code: "TD-LR", Name: "Logone-Oriental", Type: "Province"},
+	{Code: "TD-MA", Name: "Mandoul", Type: "Province"},
+	{Code: "TD-MC", Name: "Moyen-Chari", Type: "Province"},
+	{Code: "TD-ME", Name: "Mayo-Kebbi-Est", Type: "Province"},
+	{Code: "TD-MO", Name: "Mayo-Kebbi-Ouest", Type: "Province"},
+	{Code: "TD-ND", Name: "Madīnat Injamīnā", Type: "Province"},
+	{Code: "TD-OD", Name: "Ouaddaï", Type: "Province"},
+	{Code: "TD-SA", Name: "Salamat", Type: "Province"},
+	{Code: "TD-SI", Name: "Sila", Type: "Province"},
+	{Code: "TD-TA", Name: "Tandjilé", Type: "Province"},
+	{Code: "TD-TI", Name: "Tibastī", Type: "Province"},
+	{Code: "TD-WF", Name: "Wadi Fira", Type: "Province"},
+	{Code: "TG-C", Name: "Centrale", Type: "Region"},
+	{Code: "TG-K", Name: "Kara", Type: "Region"},
+	{Code: "TG-M", Name: "Maritime (Région)", Type: "Region"},
+	{Code: "TG-P", Name: "Plateaux", Type: "Region"},
+	{Code: "TG-S", Name: "Savanes", Type: "Region"},
+	{Code: "TH-10", Name: "Krung Thep Maha Nakhon", Type: "Metropolitan administration"},
+	{Code: "TH-11", Name: "Samut Prakan", Type: "Province"},
+	{Code: "TH-12", Name: "Nonthaburi", Type: "Province"},
+	{Code: "TH-13", Name: "Pathum Thani", Type: "Province"},
+	{Code: "TH-14", Name: "Phra Nakhon Si Ayutthaya", Type: "Province"},
+	{Code: "TH-15", Name: "Ang Thong", Type: "Province"},
+	{Code: "TH-16", Name: "Lop Buri", Type: "Province"},
+	{Code: "TH-17", Name: "Sing Buri", Type: "Province"},
+	{Code: "TH-18", Name: "Chai Nat", Type: "Province"},
+	{Code: "TH-19", Name: "Saraburi", Type: "Province"},
+	{Code: "TH-20", Name: "Chon Buri", Type: "Province"},
+	{Code: "TH-21", Name: "Rayong", Type: "Province"},
+	{Code: "TH-22", Name: "Chanthaburi", Type: "Province"},
+	{Code: "TH-23", Name: "Trat", Type: "Province"},
+	{Code: "TH-24", Name: "Chachoengsao", Type: "Province"},
+	{Code: "TH-25", Name: "Prachin Buri", Type: "Province"},
+	{Code: "TH-26", Name: "Nakhon Nayok", Type: "Province"},
+	{Code: "TH-27", Name: "Sa Kaeo", Type: "Province"},
+	{Code: "TH-30", Name: "Nakhon Ratchasima", Type: "Province"},
+	{Code: "TH-31", Name: "Buri Ram", Type: "Province"},
+	{Code: "TH-32", Name: "Surin", Type: "Province"},
+	{Code: "TH-33", Name: "Si Sa Ket", Type: "Province"},
+	{Code: "TH-34", Name: "Ubon Ratchathani", Type: "Province"},
+	{Code: "TH-35", Name: "Yasothon", Type: "Province"},
+	{Code: "TH-36", Name: "Chaiyaphum", Type: "Province"},
+	{Code: "TH-37", Name: "Amnat Charoen", Type: "Province"},
+	{Code: "TH-38", Name: "Bueng Kan", Type: "Province"},
+	{Code: "TH-39", Name: "Nong Bua Lam Phu", Type: "Province"},
+	{Code: "TH-40", Name: "Khon Kaen", Type: "Province"},
+	{Code: "TH-41", Name: "Udon Thani", Type: "Province"},
+	{Code: "TH-42", Name: "Loei", Type: "Province"},
+	{Code: "TH-43", Name: "Nong Khai", Type: "Province"},
+	{Code: "TH-44", Name: "Maha Sarakham", Type: "Province"},
+	{Code: "TH-45", Name: "Roi Et", Type: "Province"},
+	{Code: "TH-46", Name: "Kalasin", Type: "Province"},
+	{Code: "TH-47", Name: "Sakon Nakhon", Type: "Province"},
+	{Code: "TH-48", Name: "Nakhon Phanom", Type: "Province"},
+	{Code: "TH-49", Name: "Mukdahan", Type: "Province"},
+	{Code: "TH-50", Name: "Chiang Mai", Type: "Province"},
+	{Code: "TH-51", Name: "Lamphun", Type: "Province"},
+	{Code: "TH-52", Name: "Lampang", Type: "Province"},
+	{Code: "TH-53", Name: "Uttaradit", Type: "Province"},
+	{Code: "TH-54", Name: "Phrae", Type: "Province"},
+	{Code: "TH-55", Name: "Nan", Type: "Province"},
+	{Code: "TH-56", Name: "Phayao", Type: "Province"},
+	{Code: "TH-57", Name: "Chiang Rai", Type: "Province"},
+	{Code: "TH-58", Name: "Mae Hong Son", Type: "Province"},
+	{Code: "TH-60", Name: "Nakhon Sawan", Type: "Province"},
+	{Code: "TH-61", Name: "Uthai Thani", Type: "Province"},
+	{Code: "TH-62", Name: "Kamphaeng Phet", Type: "Province"},
+	{Code: "TH-63", Name: "Tak", Type: "Province"},
+	{Code: "TH-64", Name: "Sukhothai", Type: "Province"},
+	{Code: "TH-65", Name: "Phitsanulok", Type: "Province"},
+	{Code: "TH-66", Name: "Phichit", Type: "Province"},
+	{Code: "TH-67", Name: "Phetchabun", Type: "Province"},
+	{Code: "TH-70", Name: "Ratchaburi", Type: "Province"},
+	{Code: "TH-71", Name: "Kanchanaburi", Type: "Province"},
+	{Code: "TH-72", Name: "Suphan Buri", Type: "Province"},
+	{Code: "TH-73", Name: "Nakhon Pathom", Type: "Province"},
+	{Code: "TH-74", Name: "Samut Sakhon", Type: "Province"},
+	{Code: "TH-75", Name: "Samut Songkhram", Type: "Province"},
+	{Code: "TH-76", Name: "Phetchaburi", Type: "Province"},
+	{Code: "TH-77", Name: "Prachuap Khiri Khan", Type: "Province"},
+	{Code: "TH-80", Name: "Nakhon Si Thammarat", Type: "Province"},
+	{Code: "TH-81", Name: "Krabi", Type: "Province"},
+	{Code: "TH-82", Name: "Phangnga", Type: "Province"},
+	{Code: "TH-83", Name: "Phuket", Type: "Province"},
+	{Code: "TH-84", Name: "Surat Thani", Type: "Province"},
+	{Code: "TH-85", Name: "Ranong", Type: "Province"},
+	{Code: "TH-86", Name: "Chumphon", Type: "Province"},
+	{Code: "TH-90", Name: "Songkhla", Type: "Province"},
+	{Code: "TH-91", Name: "Satun", Type: "Province"},
+	{Code: "TH-92", Name: "Trang", Type: "Province"},
+	{Code: "TH-93", Name: "Phatthalung", Type: "Province"},
+	{Code: "TH-94", Name: "Pattani", Type: "Province"},
+	{Code: "TH-95", Name: "Yala", Type: "Province"},
+	{Code: "TH-96", Name: "Narathiwat", Type: "Province"},
+	{Code: "TH-S", Name: "Phatthaya", Type: "Special administrative city"},
+	{Code: "TJ-DU", Name: "Dushanbe", Type: "Capital territory"},
+	{Code: "TJ-GB", Name: "Kŭhistoni Badakhshon", Type: "Autonomous region"},
+	{Code: "TJ-KT", Name: "Khatlon", Type: "Region"},
+	{Code: "TJ-RA", Name: "nohiyahoi tobei jumhurí", Type: "Districts under republic administration"},
+	{Code: "TJ-SU", Name: "Sughd", Type: "Region"},
+	{Code: "TL-AL", Name: "Aileu", Type: "Municipality"},
+	{Code: "TL-AN", Name: "Ainaro", Type: "Municipality"},
+	{Code: "TL-BA", Name: "Baucau", Type: "Municipality"},
+	{Code: "TL-BO", Name: "Bobonaro", Type: "Municipality"},
+	{Code: "TL-CO", Name: "Cova Lima", Type: "Municipality"},
+	{Code: "TL-DI", Name: "Díli", Type: "Municipality"},
+	{Code: "TL-ER", Name: "Ermera", Type: "Municipality"},
+	{Code: "TL-LA", Name: "Lautein", Type: "Municipality"},
+	{Code: "TL-LI", Name: "Likisá", Type: "Municipality"},
+	{Code: "TL-MF", Name: "Manufahi", Type: "Municipality"},
+	{Code: "TL-MT", Name: "Manatuto", Type: "Municipality"},
+	{Code: "TL-OE", Name: "Oekusi-Ambenu", Type: "Special administrative region"},
+	{Code: "TL-VI", Name: "Vikeke", Type: "Municipality"},
+	{Code: "TM-A", Name: "Ahal", Type: "Region"},
+	{Code: "TM-B", Name: "Balkan", Type: "Region"},
+	{Code: "TM-D", Name: "Daşoguz", Type: "Region"},
+	{Code: "TM-L", Name: "Lebap", Type: "Region"},
+	{Code: "TM-M", Name: "Mary", Type: "Region"},
+	{Code: "TM-S", Name: "Aşgabat", Type: "City"},
+	{Code: "TN-11", Name: "Tunis", Type: "Governorate"},
+	{Code: "TN-12", Name: "L'Ariana", Type: "Governorate"},
+	{Code: "TN-13", Name: "Ben Arous", Type: "Governorate"},
+	{Code: "TN-14", Name: "La Manouba", Type: "Governorate"},
+	{Code: "TN-21", Name: "Nabeul", Type: "Governorate"},
+	{Code: "TN-22", Name: "Zaghouan", Type: "Governorate"},
+	{Code: "TN-23", Name: "Bizerte", Type: "Governorate"},
+	{Code: "TN-31", Name: "Béja", Type: "Governorate"},
+	{Code: "TN-32", Name: "Jendouba", Type: "Governorate"},
+	{Code: "TN-33", Name: "Le Kef", Type: "Governorate"},
+	{Code: "TN-34", Name: "Siliana", Type: "Governorate"},
+	{Code: "TN-41", Name: "Kairouan", Type: "Governorate"},
+	{Code: "TN-42", Name: "Kasserine", Type: "Governorate"},
+	{Code: "TN-43", Name: "Sidi Bouzid", Type: "Governorate"},
+	{Code: "TN-51", Name: "Sousse", Type: "Governorate"},
+	{Code: "TN-52", Name: "Monastir", Type: "Governorate"},
+	{Code: "TN-53", Name: "Mahdia", Type: "Governorate"},
+	{Code: "TN-61", Name: "Sfax", Type: "Governorate"},
+	{Code: "TN-71", Name: "Gafsa", Type: "Governorate"},
+	{Code: "TN-72", Name: "Tozeur", Type: "Governorate"},
+	{Code: "TN-73", Name: "Kébili", Type: "Governorate"},
+	{Code: "TN-81", Name: "Gabès", Type: "Governorate"},
+	{Code: "TN-82", Name: "Médenine", Type: "Governorate"},
+	{Code: "TN-83", Name: "Tataouine", Type: "Governorate"},
+	{Code: "TO-01", Name: "'Eua", Type: "Division"},
+	{Code: "TO-02", Name: "Ha'apai", Type: "Division"},
+	{Code: "TO-03", Name: "Niuas", Type: "Division"},
+	{Code: "TO-04", Name: "Tongatapu", Type: "Division"},
+	{Code: "TO-05", Name: "Vava'u", Type: "Division"},
+	{Code: "TR-01", Name: "Adana", Type: "Province"},
+	{Code: "TR-02", Name: "Adıyaman", Type: "Province"},
+	{Code: "TR-03", Name: "Afyonkarahisar", Type: "Province"},
+	{Code: "TR-04", Name: "Ağrı", Type: "Province"},
+	{Code: "TR-05", Name: "Amasya", Type: "Province"},
+	{Code: "TR-06", Name: "Ankara", Type: "Province"},
+	{Code: "TR-07", Name: "Antalya", Type: "Province"},
+	{Code: "TR-08", Name: "Artvin", Type: "Province"},
+	{Code: "TR-09", Name: "Aydın", Type: "Province"},
+	{Code: "TR-10", Name: "Balıkesir", Type: "Province"},
+	{Code: "TR-11", Name: "Bilecik", Type: "Province"},
+	{Code: "TR-12", Name: "Bingöl", Type: "Province"},
+	{Code: "TR-13", Name: "Bitlis", Type: "Province"},
+	{Code: "TR-14", Name: "Bolu", Type: "Province"},
+	{Code: "TR-15", Name: "Burdur", Type: "Province"},
+	{Code: "TR-16", Name: "Bursa", Type: "Province"},
+	{Code: "TR-17", Name: "Çanakkale", Type: "Province"},
+	{Code: "TR-18", Name: "Çankırı", Type: "Province"},
+	{Code: "TR-19", Name: "Çorum", Type: "Province"},
+	{Code: "TR-20", Name: "Denizli", Type: "Province"},
+	{Code: "TR-21", Name: "Diyarbakır", Type: "Province"},
+	{Code: "TR-22", Name: "Edirne", Type: "Province"},
+	{Code: "TR-23", Name: "Elazığ", Type: "Province"},
+	{Code: "TR-24", Name: "Erzincan", Type: "Province"},
+	{Code: "TR-25", Name: "Erzurum", Type: "Province"},
+	{Code: "TR-26", Name: "Eskişehir", Type: "Province"},
+	{Code: "TR-27", Name: "Gaziantep", Type: "Province"},
+	{Code: "TR-28", Name: "Giresun", Type: "Province"},
+	{Code: "TR-29", Name: "Gümüşhane", Type: "Province"},
+	{Code: "TR-30", Name: "Hakkâri", Type: "Province"},
+	{Code: "TR-31", Name: "Hatay", Type: "Province"},
+	{Code: "TR-32", Name: "Isparta", Type: "Province"},
+	{Code: "TR-33", Name: "Mersin", Type: "Province"},
+	{Code: "TR-34", Name: "İstanbul", Type: "Province"},
+	{Code: "TR-35", Name: "İzmir", Type: "Province"},
+	{Code: "TR-36", Name: "Kars", Type: "Province"},
+	{Code: "TR-37", Name: "Kastamonu", Type: "Province"},
+	{Code: "TR-38", Name: "Kayseri", Type: "Province"},
+	{Code: "TR-39", Name: "Kırklareli", Type: "Province"},
+	{Code: "TR-40", Name: "Kırşehir", Type: "Province"},
+	{Code: "TR-41", Name: "Kocaeli", Type: "Province"},
+	{Code: "TR-42", Name: "Konya", Type: "Province"},
+	{Code: "TR-43", Name: "Kütahya", Type: "Province"},
+	{Code: "TR-44", Name: "Malatya", Type: "Province"},
+	{Code: "TR-45", Name: "Manisa", Type: "Province"},
+	{Code: "TR-46", Name: "Kahramanmaraş", Type: "Province"},
+	{Code: "TR-47", Name: "Mardin", Type: "Province"},
+	{Code: "TR-48", Name: "Muğla", Type: "Province"},
+	{Code: "TR-49", Name: "Muş", Type: "Province"},
+	{Code: "TR-50", Name: "Nevşehir", Type: "Province"},
+	{Code: "TR-51", Name: "Niğde", Type: "Province"},
+	{Code: "TR-52", Name: "Ordu", Type: "Province"},
+	{Code: "TR-53", Name: "Rize", Type: "Province"},
+	{Code: "TR-54", Name: "Sakarya", Type: "Province"},
+	{Code: "TR-55", Name: "Samsun", Type: "Province"},
+	{Code: "TR-56", Name: "Siirt", Type: "Province"},
+	{Code: "TR-57", Name: "Sinop", Type: "Province"},
+	{Code: "TR-58", Name: "Sivas", Type: "Province"},
+	{Code: "TR-59", Name: "Tekirdağ", Type: "Province"},
+	{Code: "TR-60", Name: "Tokat", Type: "Province"},
+	{Code: "TR-61", Name: "Trabzon", Type: "Province"},
+	{Code: "TR-62", Name: "Tunceli", Type: "Province"},
+	{Code: "TR-63", Name: "Şanlıurfa", Type: "Province"},
+	{Code: "TR-64", Name: "Uşak", Type: "Province"},
+	{Code: "TR-65", Name: "Van", Type: "Province"},
+	{Code: "TR-66", Name: "Yozgat", Type: "Province"},
+	{Code: "TR-67", Name: "Zonguldak", Type: "Province"},
+	{Code: "TR-68", Name: "Aksaray", Type: "Province"},
+	{Code: "TR-69", Name: "Bayburt", Type: "Province"},
+	{Code: "TR-70", Name: "Karaman", Type: "Province"},
+	{Code: "TR-71", Name: "Kırıkkale", Type: "Province"},
+	{Code: "TR-72", Name: "Batman", Type: "Province"},
+	{Code: "TR-73", Name: "Şırnak", Type: "Province"},
+	{Code: "TR-74", Name: "Bartın", Type: "Province"},
+	{Code: "TR-75", Name: "Ardahan", Type: "Province"},
+	{Code: "TR-76", Name: "Iğdır", Type: "Province"},
+	{Code: "TR-77", Name: "Yalova", Type: "Province"},
+	{Code: "TR-78", Name: "Karabük", Type: "Province"},
+	{Code: "TR-79", Name: "Kilis", Type: "Province"},
+	{Code: "TR-80", Name: "Osmaniye", Type: "Province"},
+	{Code: "TR-81", Name: "Düzce", Type: "Province"},
+	{Code: "TT-ARI", Name: "Arima", Type: "Borough"},
+	{Code: "TT-CHA", Name: "Chaguanas", Type: "Borough"},
+	{Code: "TT-CTT", Name: "Couva-Tabaquite-Talparo", Type: "Region"},
+	{Code: "TT-DMN", Name: "Diego Martin", Type: "Region"},
+	{Code: "TT-MRC", Name: "Mayaro-Rio Claro", Type: "Region"},
+	{Code: "TT-PED", Name: "Penal-Debe", Type: "Region"},
+	{Code: "TT-POS", Name: "Port of Spain", Type: "City"},
+	{Code: "TT-PRT", Name: "Princes Town", Type: "Region"},
+	{Code: "TT-PTF", Name: "Point Fortin", Type: "Borough"},
+	{Code: "TT-SFO", Name: "San Fernando", Type: "City"},
+	{Code: "TT-SGE", Name: "Sangre Grande", Type: "Region"},
+	{Code: "TT-SIP", Name: "Siparia", Type: "Region"},
+	{Code: "TT-SJL", Name: "San Juan-Laventille", Type: "Region"},
+	{Code: "TT-TOB", Name: "Tobago", Type: "Ward"},
+	{Code: "TT-TUP", Name: "Tunapuna-Piarco", Type: "Region"},
+	{Code: "TV-FUN", Name: "Funafuti", Type: "Town council"},
+	{Code: "TV-NIT", Name: "Niutao", Type: "Island council"},
+	{Code: "TV-NKF", Name: "Nukufetau", Type: "Island council"},
+	{Code: "TV-NKL", Name: "Nukulaelae", Type: "Island council"},
+	{Code: "TV-NMA", Name: "Nanumea", Type: "Island council"},
+	{Code: "TV-NMG", Name: "Nanumaga", Type: "Island council"},
+	{Code: "TV-NUI", Name: "Nui", Type: "Island council"},
+	{Code: "TV-VAI", Name: "Vaitupu", Type: "Island council"},
+	{Code: "TW-CHA", Name: "Changhua", Type: "County"},
+	{Code: "TW-CYI", Name: "Chiayi", Type: "City"},
+	{Code: "TW-CYQ", Name: "Chiayi", Type: "County"},
+	{Code: "TW-HSQ", Name: "Hsinchu", Type: "County"},
+	{Code: "TW-HSZ", Name: "Hsinchu", Type: "City"},
+	{Code: "TW-HUA", Name: "Hualien", Type: "County"},
+	{Code: "TW-ILA", Name: "Yilan", Type: "County"},
+	{Code: "TW-KEE", Name: "Keelung", Type: "City"},
+	{Code: "TW-KHH", Name: "Kaohsiung", Type: "Special municipality"},
+	{Code: "TW-KIN", Name: "Kinmen", Type: "County"},
+	{Code: "TW-LIE", Name: "Lienchiang", Type: "County"},
+	{Code: "TW-MIA", Name: "Miaoli", Type: "County"},
+	{Code: "TW-NAN", Name: "Nantou", Type: "County"},
+	{Code: "TW-NWT", Name: "New Taipei", Type: "Special municipality"},
+	{Code: "TW-PEN", Name: "Penghu", Type: "County"},
+	{Code: "TW-PIF", Name: "Pingtung", Type: "County"},
+	{Code: "TW-TAO", Name: "Taoyuan", Type: "Special municipality"},
+	{Code: "TW-TNN", Name: "Tainan", Type: "Special municipality"},
+	{Code: "TW-TPE", Name: "Taipei", Type: "Special municipality"},
+	{Code: "TW-TTT", Name: "Taitung", Type: "County"},
+	{Code: "TW-TXG", Name: "Taichung", Type: "Special municipality"},
+	{Code: "TW-YUN", Name: "Yunlin", Type: "County"},
+	{Code: "TZ-01", Name: "Arusha", Type: "Region"},
+	{Code: "TZ-02", Name: "Dar es Salaam", Type: "Region"},
+	{Code: "TZ-03", Name: "Dodoma", Type: "Region"},
+	{Code: "TZ-04", Name: "Iringa", Type: "Region"},
+	{Code: "TZ-05", Name: "Kagera", Type: "Region"},
+	{Code: "TZ-06", Name: "Pemba North", Type: "Region"},
+	{Code: "TZ-07", Name: "Zanzibar North", Type: "Region"},
+	{Code: "TZ-08", Name: "Kigoma", Type: "Region"},
+	{Code: "TZ-09", Name: "Kilimanjaro", Type: "Region"},
+	{Code: "TZ-10", Name: "Pemba South", Type: "Region"},
+	{Code: "TZ-11", Name: "Zanzibar South", Type: "Region"},
+	{Code: "TZ-12", Name: "Lindi", Type: "Region"},
+	{Code: "TZ-13", Name: "Mara", Type: "Region"},
+	{Code: "TZ-14", Name: "Mbeya", Type: "Region"},
+	{Code: "TZ-15", Name: "Zanzibar West", Type: "Region"},
+	{Code: "TZ-16", Name: "Morogoro", Type: "Region"},
+	{Code: "TZ-17", Name: "Mtwara", Type: "Region"},
+	{Code: "TZ-18", Name: "Mwanza", Type: "Region"},
+	{Code: "TZ-19", Name: "Coast", Type: "Region"},
+	{Code: "TZ-20", Name: "Rukwa", Type: "Region"},
+	{Code: "TZ-21", Name: "Ruvuma", Type: "Region"},
+	{Code: "TZ-22", Name: "Shinyanga", Type: "Region"},
+	{Code: "TZ-23", Name: "Singida", Type: "Region"},
+	{Code: "TZ-24", Name: "Tabora", Type: "Region"},
+	{Code: "TZ-25", Name: "Tanga", Type: "Region"},
+	{Code: "TZ-26", Name: "Manyara", Type: "Region"},
+	{Code: "TZ-27", Name: "Geita", Type: "Region"},
+	{Code: "TZ-28", Name: "Katavi", Type: "Region"},
+	{Code: "TZ-29", Name: "Njombe", Type: "Region"},
+	{Code: "TZ-30", Name: "Simiyu", Type: "Region"},
+	{Code: "TZ-31", Name: "Songwe", Type: "Region"},
+	{Code: "UA-05", Name: "Vinnytska oblast", Type: "Region"},
+	{Code: "UA-07", Name: "Volynska oblast", Type: "Region"},
+	{Code: "UA-09", Name: "Luhanska oblast", Type: "Region"},
+	{Code: "UA-12", Name: "Dnipropetrovska oblast", Type: "Region"},
+	{Code: "UA-14", Name: "Donetska oblast", Type: "Region"},
+	{Code: "UA-18", Name: "Zhytomyrska oblast", Type: "Region"},
+	{Code: "UA-21", Name: "Zakarpatska oblast", Type: "Region"},
+	{Code: "UA-23", Name: "Zaporizka oblast", Type: "Region"},
+	{Code: "UA-26", Name: "Ivano-Frankivska oblast", Type: "Region"},
+	{Code: "UA-30", Name: "Kyiv", Type: "City"},
+	{Code: "UA-32", Name: "Kyivska oblast", Type: "Region"},
+	{Code: "UA-35", Name: "Kirovohradska oblast", Type: "Region"},
+	{Code: "UA-40", Name: "Sevastopol", Type: "City"},
+	{Code: "UA-43", Name: "Avtonomna Respublika Krym", Type: "Republic"},
+	{Code: "UA-46", Name: "Lvivska oblast", Type: "Region"},
+	{Code: "UA-48", Name: "Mykolaivska oblast", Type: "Region"},
+	{Code: "UA-51", Name: "Odeska oblast", Type: "Region"},
+	{Code: "UA-53", Name: "Poltavska oblast", Type: "Region"},
+	{Code: "UA-56", Name: "Rivnenska oblast", Type: "Region"},
+	{Code: "UA-59", Name: "Sumska oblast", Type: "Region"},
+	{Code: "UA-61", Name: "Ternopilska oblast", Type: "Region"},
+	{Code: "UA-63", Name: "Kharkivska oblast", Type: "Region"},
+	{Code: "UA-65", Name: "Khersonska oblast", Type: "Region"},
+	{Code: "UA-68", Name: "Khmelnytska oblast", Type: "Region"},
+	{Code: "UA-71", Name: "Cherkaska oblast", Type: "Region"},
+	{Code: "UA-74", Name: "Chernihivska oblast", Type: "Region"},
+	{Code: "UA-77", Name: "Chernivetska oblast", Type: "Region"},
+	{Code: "UG-101", Name: "Kalangala", Type: "District", Parent: "UG-C"},
+	{Code: "UG-102", Name: "Kampala", Type: "City", Parent: "UG-C"},
+	{Code: "UG-103", Name: "Kiboga", Type: "District", Parent: "UG-C"},
+	{Code: "UG-104", Name: "Luwero", Type: "District", Parent: "UG-C"},
+	{Code: "UG-105", Name: "Masaka", Type: "District", Parent: "UG-C"},
+	{Code: "UG-106", Name: "Mpigi", Type: "District", Parent: "UG-C"},
+	{Code: "UG-107", Name: "Mubende", Type: "District", Parent: "UG-C"},
+	{Code: "UG-108", Name: "Mukono", Type: "District", Parent: "UG-C"},
+	{Code: "UG-109", Name: "Nakasongola", Type: "District", Parent: "UG-C"},
+	{Code: "UG-110", Name: "Rakai", Type: "District", Parent: "UG-C"},
+	{Code: "UG-111", Name: "Sembabule", Type: "District", Parent: "UG-C"},
+	{Code: "UG-112", Name: "Kayunga", Type: "District", Parent: "UG-C"},
+	{Code: "UG-113", Name: "Wakiso", Type: "District", Parent: "UG-C"},
+	{Code: "UG-114", Name: "Lyantonde", Type: "District", Parent: "UG-C"},
+	{Code: "UG-115", Name: "Mityana", Type: "District", Parent: "UG-C"},
+	{Code: "UG-116", Name: "Nakaseke", Type: "District", Parent: "UG-C"},
+	{Code: "UG-117", Name: "Buikwe", Type: "District", Parent: "UG-C"},
+	{Code: "UG-118", Name: "Bukomansibi", Type: "District", Parent: "UG-C"},
+	{Code: "UG-119", Name: "Butambala", Type: "District", Parent: "UG-C"},
+	{Code: "UG-120", Name: "Buvuma", Type: "District", Parent: "UG-C"},
+	{Code: "UG-121", Name: "Gomba", Type: "District", Parent: "UG-C"},
+	{Code: "UG-122", Name: "Kalungu", Type: "District", Parent: "UG-C"},
+	{Code: "UG-123", Name: "Kyankwanzi", Type: "District", Parent: "UG-C"},
+	{Code: "UG-124", Name: "Lwengo", Type: "District", Parent: "UG-C"},
+	{Code: "UG-125", Name: "Kyotera", Type: "District", Parent: "UG-C"},
+	{Code: "UG-126", Name: "Kasanda", Type: "District", Parent: "UG-C"},
+	{Code: "UG-201", Name: "Bugiri", Type: "District", Parent: "UG-E"},
+	{Code: "UG-202", Name: "Busia", Type: "District", Parent: "UG-E"},
+	{Code: "UG-203", Name: "Iganga", Type: "District", Parent: "UG-E"},
+	{Code: "UG-204", Name: "Jinja", Type: "District", Parent: "UG-E"},
+	{Code: "UG-205", Name: "Kamuli", Type: "District", Parent: "UG-E"},
+	{Code: "UG-206", Name: "Kapchorwa", Type: "District", Parent: "UG-E"},
+	{Code: "UG-207", Name: "Katakwi", Type: "District", Parent: "UG-E"},
+	{Code: "UG-208", Name: "Kumi", Type: "District", Parent: "UG-E"},
+	{Code: "UG-209", Name: "Mbale", Type: "District", Parent: "UG-E"},
+	{Code: "UG-210", Name: "Pallisa", Type: "District", Parent: "UG-E"},
+	{Code: "UG-211", Name: "Soroti", Type: "District", Parent: "UG-E"},
+	{Code: "UG-212", Name: "Tororo", Type: "District", Parent: "UG-E"},
+	{Code: "UG-213", Name: "Kaberamaido", Type: "District", Parent: "UG-E"},
+	{Code: "UG-214", Name: "Mayuge", Type: "District", Parent: "UG-E"},
+	{Code: "UG-215", Name: "Sironko", Type: "District", Parent: "UG-E"},
+	{Code: "UG-216", Name: "Amuria", Type: "District", Parent: "UG-E"},
+	{Code: "UG-217", Name: "Budaka", Type: "District", Parent: "UG-E"},
+	{Code: "UG-218", Name: "Bududa", Type: "District", Parent: "UG-E"},
+	{Code: "UG-219", Name: "Bukedea", Type: "District", Parent: "UG-E"},
+	{Code: "UG-220", Name: "Bukwo", Type: "District", Parent: "UG-E"},
+	{Code: "UG-221", Name: "Butaleja", Type: "District", Parent: "UG-E"},
+	{Code: "UG-222", Name: "Kaliro", Type: "District", Parent: "UG-E"},
+	{Code: "UG-223", Name: "Manafwa", Type: "District", Parent: "UG-E"},
+	{Code: "UG-224", Name: "Namutumba", Type: "District", Parent: "UG-E"},
+	{Code: "UG-225", Name: "Bulambuli", Type: "District", Parent: "UG-E"},
+	{Code: "UG-226", Name: "Buyende", Type: "District", Parent: "UG-E"},
+	{Code: "UG-227", Name: "Kibuku", Type: "District", Parent: "UG-E"},
+	{Code: "UG-228", Name: "Kween", Type: "District", Parent: "UG-E"},
+	{Code: "UG-229", Name: "Luuka", Type: "District", Parent: "UG-E"},
+	{Code: "UG-230", Name: "Namayingo", Type: "District", Parent: "UG-E"},
+	{Code: "UG-231", Name: "Ngora", Type: "District", Parent: "UG-E"},
+	{Code: "UG-232", Name: "Serere", Type: "District", Parent: "UG-E"},
+	{Code: "UG-233", Name: "Butebo", Type: "District", Parent: "UG-E"},
+	{Code: "UG-234", Name: "Namisindwa", Type: "District", Parent: "UG-E"},
+	{Code: "UG-235", Name: "Bugweri", Type: "District", Parent: "UG-E"},
+	{Code: "UG-236", Name: "Kapelebyong", Type: "District", Parent: "UG-E"},
+	{Code: "UG-237", Name: "Kalaki", Type: "District", Parent: "UG-E"},
+	{Code: "UG-301", Name: "Adjumani", Type: "District", Parent: "UG-N"},
+	{Code: "UG-302", Name: "Apac", Type: "District", Parent: "UG-N"},
+	{Code: "UG-303", Name: "Arua", Type: "District", Parent: "UG-N"},
+	{Code: "UG-304", Name: "Gulu", Type: "District", Parent: "UG-N"},
+	{Code: "UG-305", Name: "Kitgum", Type: "District", Parent: "UG-N"},
+	{Code: "UG-306", Name: "Kotido", Type: "District", Parent: "UG-N"},
+	{Code: "UG-307", Name: "Lira", Type: "District", Parent: "UG-N"},
+	{Code: "UG-308", Name: "Moroto", Type: "District", Parent: "UG-N"},
+	{Code: "UG-309", Name: "Moyo", Type: "District", Parent: "UG-N"},
+	{Code: "UG-310", Name: "Nebbi", Type: "District", Parent: "UG-N"},
+	{Code: "UG-311", Name: "Nakapiripirit", Type: "District", Parent: "UG-N"},
+	{Code: "UG-312", Name: "Pader", Type: "District", Parent: "UG-N"},
+	{Code: "UG-313", Name: "Yumbe", Type: "District", Parent: "UG-N"},
+	{Code: "UG-314", Name: "Abim", Type: "District", Parent: "UG-N"},
+	{Code: "UG-315", Name: "Amolatar", Type: "District", Parent: "UG-N"},
+	{Code: "UG-316", Name: "Amuru", Type: "District", Parent: "UG-N"},
+	{Code: "UG-317", Name: "Dokolo", Type: "District", Parent: "UG-N"},
+	{Code: "UG-318", Name: "Kaabong", Type: "District", Parent: "UG-N"},
+	{Code: "UG-319", Name: "Koboko", Type: "District", Parent: "UG-N"},
+	{Code: "UG-320", Name: "Maracha", Type: "District", Parent: "UG-N"},
+	{Code: "UG-321", Name: "Oyam", Type: "District", Parent: "UG-N"},
+	{Code: "UG-322", Name: "Agago", Type: "District", Parent: "UG-N"},
+	{Code: "UG-323", Name: "Alebtong", Type: "District", Parent: "UG-N"},
+	{Code: "UG-324", Name: "Amudat", Type: "District", Parent: "UG-N"},
+	{Code: "UG-325", Name: "Kole", Type: "District", Parent: "UG-N"},
+	{Code: "UG-326", Name: "Lamwo", Type: "District", Parent: "UG-N"},
+	{Code: "UG-327", Name: "Napak", Type: "District", Parent: "UG-N"},
+	{Code: "UG-328", Name: "Nwoya", Type: "District", Parent: "UG-N"},
+	{Code: "UG-329", Name: "Otuke", Type: "District", Parent: "UG-N"},
+	{Code: "UG-330", Name: "Zombo", Type: "District", Parent: "UG-N"},
+	{Code: "UG-331", Name: "Omoro", Type: "District", Parent: "UG-N"},
+	{Code: "UG-332", Name: "Pakwach", Type: "District", Parent: "UG-N"},
+	{Code: "UG-333", Name: "Kwania", Type: "District", Parent: "UG-N"},
+	{Code: "UG-334", Name: "Nabilatuk", Type: "District", Parent: "UG-N"},
+	{Code: "UG-335", Name: "Karenga", Type: "District", Parent: "UG-N"},
+	{Code: "UG-336", Name: "Madi-Okollo", Type: "District", Parent: "UG-N"},
+	{Code: "UG-337", Name: "Obongi", Type: "District", Parent: "UG-N"},
+	{Code: "UG-401", Name: "Bundibugyo", Type: "District", Parent: "UG-W"},
+	{Code: "UG-402", Name: "Bushenyi", Type: "District", Parent: "UG-W"},
+	{Code: "UG-403", Name: "Hoima", Type: "District", Parent: "UG-W"},
+	{Code: "UG-404", Name: "Kabale", Type: "District", Parent: "UG-W"},
+	{Code: "UG-405", Name: "Kabarole", Type: "District", Parent: "UG-W"},
+	{Code: "UG-406", Name: "Kasese", Type: "District", Parent: "UG-W"},
+	{Code: "UG-407", Name: "Kibaale", Type: "District", Parent: "UG-W"},
+	{Code: "UG-408", Name: "Kisoro", Type: "District", Parent: "UG-W"},
+	{Code: "UG-409", Name: "Masindi", Type: "District", Parent: "UG-W"},
+	{Code: "UG-410", Name: "Mbarara", Type: "District", Parent: "UG-W"},
+	{Code: "UG-411", Name: "Ntungamo", Type: "District", Parent: "UG-W"},
+	{Code: "UG-412", Name: "Rukungiri", Type: "District", Parent: "UG-W"},
+	{Code: "UG-413", Name: "Kamwenge", Type: "District", Parent: "UG-W"},
+	{Code: "UG-414", Name: "Kanungu", Type: "District", Parent: "UG-W"},
+	{Code: "UG-415", Name: "Kyenjojo", Type: "District", Parent: "UG-W"},
+	{Code: "UG-416", Name: "Buliisa", Type: "District", Parent: "UG-W"},
+	{Code: "UG-417", Name: "Ibanda", Type: "District", Parent: "UG-W"},
+	{Code: "UG-418", Name: "Isingiro", Type: "District", Parent: "UG-W"},
+	{Code: "UG-419", Name: "Kiruhura", Type: "District", Parent: "UG-W"},
+	{Code: "UG-420", Name: "Buhweju", Type: "District", Parent: "UG-W"},
+	{Code: "UG-421", Name: "Kiryandongo", Type: "District", Parent: "UG-W"},
+	{Code: "UG-422", Name: "Kyegegwa", Type: "District", Parent: "UG-W"},
+	{Code: "UG-423", Name: "Mitooma", Type: "District", Parent: "UG-W"},
+	{Code: "UG-424", Name: "Ntoroko", Type: "District", Parent: "UG-W"},
+	{Code: "UG-425", Name: "Rubirizi", Type: "District", Parent: "UG-W"},
+	{Code: "UG-426", Name: "Sheema", Type: "District", Parent: "UG-W"},
+	{Code: "UG-427", Name: "Kagadi", Type: "District", Parent: "UG-W"},
+	{Code: "UG-428", Name: "Kakumiro", Type: "District", Parent: "UG-W"},
+	{Code: "UG-429", Name: "Rubanda", Type: "District", Parent: "UG-W"},
+	{Code: "UG-430", Name: "Bunyangabu", Type: "District", Parent: "UG-W"},
+	{Code: "UG-431", Name: "Rukiga", Type: "District", Parent: "UG-W"},
+	{Code: "UG-432", Name: "Kikuube", Type: "District", Parent: "UG-W"},
+	{Code: "UG-433", Name: "Kazo", Type: "District", Parent: "UG-W"},
+	{Code: "UG-434", Name: "Kitagwenda", Type: "District", Parent: "UG-W"},
+	{Code: "UG-435", Name: "Rwampara", Type: "District", Parent: "UG-W"},
+	{Code: "UG-C", Name: "Central", Type: "Geographical region"},
+	{Code: "UG-E", Name: "Eastern", Type: "Geographical region"},
+	{Code: "UG-N", Name: "Northern", Type: "Geographical region"},
+	{Code: "UG-W", Name: "Western", Type: "Geographical region"},
+	{Code: "UM-67", Name: "Johnston Atoll", Type: "Islands, groups of islands"},
+	{Code: "UM-71", Name: "Midway Islands", Type: "Islands, groups of islands"},
+	{Code: "UM-76", Name: "Navassa Island", Type: "Islands, groups of islands"},
+	{Code: "UM-79", Name: "Wake Island", Type: "Islands, groups of islands"},
+	{Code: "UM-81", Name: "Baker Island", Type: "Islands, groups of islands"},
+	{Code: "UM-84", Name: "Howland Island", Type: "Islands, groups of islands"},
+	{Code: "UM-86", Name: "Jarvis Island", Type: "Islands, groups of islands"},
+	{Code: "UM-89", Name: "Kingman Reef", Type: "Islands, groups of islands"},
+	{Code: "UM-95", Name: "Palmyra Atoll", Type: "Islands, groups of islands"},
+	{Code: "US-AK", Name: "Alaska", Type: "State"},
+	{Code: "US-AL", Name: "Alabama", Type: "State"},
+	{Code: "US-AR", Name: "Arkansas", Type: "State"},
+	{Code: "US-AS", Name: "American Samoa", Type: "Outlying area"},
+	{Code: "US-AZ", Name: "Arizona", Type: "State"},
+	{Code: "US-CA", Name: "California", Type: "State"},
+	{Code: "US-CO", Name: "Colorado", Type: "State"},
+	{Code: "US-CT", Name: "Connecticut", Type: "State"},
+	{Code: "US-DC", Name: "District of Columbia", Type: "District"},
+	{Code: "US-DE", Name: "Delaware", Type: "State"},
+	{Code: "US-FL", Name: "Florida", Type: "State"},
+	{Code: "US-GA", Name: "Georgia", Type: "State"},
+	{Code: "US-GU", Name: "Guam", Type: "Outlying area"},
+	{Code: "US-HI", Name: "Hawaii", Type: "State"},
+	{Code: "US-IA", Name: "Iowa", Type: "State"},
+	{Code: "US-ID", Name: "Idaho", Type: "State"},
+	{Code: "US-IL", Name: "Illinois", Type: "State"},
+	{Code: "US-IN", Name: "Indiana", Type: "State"},
+	{Code: "US-KS", Name: "Kansas", Type: "State"},
+	{Code: "US-KY", Name: "Kentucky", Type: "State"},
+	{Code: "US-LA", Name: "Louisiana", Type: "State"},
+	{Code: "US-MA", Name: "Massachusetts", Type: "State"},
+	{Code: "US-MD", Name: "Maryland", Type: "State"},
+	{Code: "US-ME", Name: "Maine", Type: "State"},
+	{Code: "US-MI", Name: "Michigan", Type: "State"},
+	{Code: "US-MN", Name: "Minnesota", Type: "State"},
+	{Code: "US-MO", Name: "Missouri", Type: "State"},
+	{Code: "US-MP", Name: "Northern Mariana Islands", Type: "Outlying area"},
+	{Code: "US-MS", Name: "Mississippi", Type: "State"},
+	{Code: "US-MT", Name: "Montana", Type: "State"},
+	{Code: "US-NC", Name: "North Carolina", Type: "State"},
+	{Code: "US-ND", Name: "North Dakota", Type: "State"},
+	{Code: "US-NE", Name: "Nebraska", Type: "State"},
+	{Code: "US-NH", Name: "New Hampshire", Type: "State"},
+	{Code: "US-NJ", Name: "New Jersey", Type: "State"},
+	{Code: "US-NM", Name: "New Mexico", Type: "State"},
+	{Code: "US-NV", Name: "Nevada", Type: "State"},
+	{Code: "US-NY", Name: "New York", Type: "State"},
+	{Code: "US-OH", Name: "Ohio", Type: "State"},
+	{Code: "US-OK", Name: "Oklahoma", Type: "State"},
+	{Code: "US-OR", Name: "Oregon", Type: "State"},
+	{Code: "US-PA", Name: "Pennsylvania", Type: "State"},
+	{Code: "US-PR", Name: "Puerto Rico", Type: "Outlying area"},
+	{Code: "US-RI", Name: "Rhode Island", Type: "State"},
+	{Code: "US-SC", Name: "South Carolina", Type: "State"},
+	{Code: "US-SD", Name: "South Dakota", Type: "State"},
+	{Code: "US-TN", Name: "Tennessee", Type: "State"},
+	{Code: "US-TX", Name: "Texas", Type: "State"},
+	{Code: "US-UM", Name: "United States Minor Outlying Islands", Type: "Outlying area"},
+	{Code: "US-UT", Name: "Utah", Type: "State"},
+	{Code: "US-VA", Name: "Virginia", Type: "State"},
+	{Code: "US-VI", Name: "Virgin Islands, U.S.", Type: "Outlying area"},
+	{Code: "US-VT", Name: "Vermont", Type: "State"},
+	{Code: "US-WA", Name: "Washington", Type: "State"},
+	{Code: "US-WI", Name: "Wisconsin", Type: "State"},
+	{Code: "US-WV", Name: "West Virginia", Type: "State"},
+	{Code: "US-WY", Name: "Wyoming", Type: "State"},
+	{Code: "UY-AR", Name: "Artigas", Type: "Department"},
+	{Code: "UY-CA", Name: "Canelones", Type: "Department"},
+	{Code: "UY-CL", Name: "Cerro Largo", Type: "Department"},
+	{Code: "UY-CO", Name: "Colonia", Type: "Department"},
+	{Code: "UY-DU", Name: "Durazno", Type: "Department"},
+	{Code: "UY-FD", Name: "Florida", Type: "Department"},
+	{Code: "UY-FS", Name: "Flores", Type: "Department"},
+	{Code: "UY-LA", Name: "Lavalleja", Type: "Department"},
+	{Code: "UY-MA", Name: "Maldonado", Type: "Department"},
+	{Code: "UY-MO", Name: "Montevideo", Type: "Department"},
+	{Code: "UY-PA", Name: "Paysandú", Type: "Department"},
+	{Code: "UY-RN", Name: "Río Negro", Type: "Department"},
+	{Code: "UY-RO", Name: "Rocha", Type: "Department"},
+	{Code: "UY-RV", Name: "Rivera", Type: "Department"},
+	{Code: "UY-SA", Name: "Salto", Type: "Department"},
+	{Code: "UY-SJ", Name: "San José", Type: "Department"},
+	{Code: "UY-SO", Name: "Soriano", Type: "Department"},
+	{Code: "UY-TA", Name: "Tacuarembó", Type: "Department"},
+	{Code: "UY-TT", Name: "Treinta y Tres", Type: "Department"},
+	{Code: "UZ-AN", Name: "Andijon", Type: "Region"},
+	{Code: "UZ-BU", Name: "Buxoro", Type: "Region"},
+	{Code: "UZ-FA", Name: "Farg‘ona", Type: "Region"},
+	{Code: "UZ-JI", Name: "Jizzax", Type: "Region"},
+	{Code: "UZ-NG", Name: "Namangan", Type: "Region"},
+	{Code: "UZ-NW", Name: "Navoiy", Type: "Region"},
+	{Code: "UZ-QA", Name: "Qashqadaryo", Type: "Region"},
+	{Code: "UZ-QR", Name: "Qoraqalpog‘iston Respublikasi", Type: "Republic"},
+	{Code: "UZ-SA", Name: "Samarqand", Type: "Region"},
+	{Code: "UZ-SI", Name: "Sirdaryo", Type: "Region"},
+	{Code: "UZ-SU", Name: "Surxondaryo", Type: "Region"},
+	{Code: "UZ-TK", Name: "Toshkent", Type: "City"},
+	{Code: "UZ-TO", Name: "Toshkent", Type: "Region"},
+	{Code: "UZ-XO", Name: "Xorazm", Type: "Region"},
+	{Code: "VC-01", Name: "Charlotte", Type: "Parish"},
+	{Code: "VC-02", Name: "Saint Andrew", Type: "Parish"},
+	{Code: "VC-03", Name: "Saint David", Type: "Parish"},
+	{Code: "VC-04", Name: "Saint George", Type: "Parish"},
+	{Code: "VC-05", Name: "Saint Patrick", Type: "Parish"},
+	{Code: "VC-06", Name: "Grenadines", Type: "Parish"},
+	{Code: "VE-A", Name: "Distrito Capital", Type: "Capital district"},
+	{Code: "VE-B", Name: "Anzoátegui", Type: "State"},
+	{Code: "VE-C", Name: "Apure", Type: "State"},
+	{Code: "VE-D", Name: "Aragua", Type: "State"},
+	{Code: "VE-E", Name: "Barinas", Type: "State"},
+	{Code: "VE-F", Name: "Bolívar", Type: "State"},
+	{Code: "VE-G", Name: "Carabobo", Type: "State"},
+	{Code: "VE-H", Name: "Cojedes", Type: "State"},
+	{Code: "VE-I", Name: "Falcón", Type: "State"},
+	{Code: "VE-J", Name: "Guárico", Type: "State"},
+	{Code: "VE-K", Name: "Lara", Type: "State"},
+	{Code: "VE-L", Name: "Mérida", Type: "State"},
+	{Code: "VE-M", Name: "Miranda", Type: "State"},
+	{Code: "VE-N", Name: "Monagas", Type: "State"},
+	{Code: "VE-O", Name: "Nueva Esparta", Type: "State"},
+	{Code: "VE-P", Name: "Portuguesa", Type: "State"},
+	{Code: "VE-R", Name: "Sucre", Type: "State"},
+	{Code: "VE-S", Name: "Táchira", Type: "State"},
+	{Code: "VE-T", Name: "Trujillo", Type: "State"},
+	{Code: "VE-U", Name: "Yaracuy", Type: "State"},
+	{Code: "VE-V", Name: "Zulia", Type: "State"},
+	{Code: "VE-W", Name: "Dependencias Federales", Type: "Federal dependency"},
+	{Code: "VE-X", Name: "La Guaira", Type: "State"},
+	{Code: "VE-Y", Name: "Delta Amacuro", Type: "State"},
+	{Code: "VE-Z", Name: "Amazonas", Type: "State"},
+	{Code: "VN-01", Name: "Lai Châu", Type: "Province"},
+	{Code: "VN-02", Name: "Lào Cai", Type: "Province"},
+	{Code: "VN-03", Name: "Hà Giang", Type: "Province"},
+	{Code: "VN-04", Name: "Cao Bằng", Type: "Province"},
+	{Code: "VN-05", Name: "Sơn La", Type: "Province"},
+	{Code: "VN-06", Name: "Yên Bái", Type: "Province"},
+	{Code: "VN-07", Name: "Tuyên Quang", Type: "Province"},
+	{Code: "VN-09", Name: "Lạng Sơn", Type: "Province"},
+	{Code: "VN-13", Name: "Quảng Ninh", Type: "Province"},
+	{Code: "VN-14", Name: "Hòa Bình", Type: "Province"},
+	{Code: "VN-18", Name: "Ninh Bình", Type: "Province"},
+	{Code: "VN-20", Name: "Thái Bình", Type: "Province"},
+	{Code: "VN-21", Name: "Thanh Hóa", Type: "Province"},
+	{Code: "VN-22", Name: "Nghệ An", Type: "Province"},
+	{Code: "VN-23", Name: "Hà Tĩnh", Type: "Province"},
+	{Code: "VN-24", Name: "Quảng Bình", Type: "Province"},
+	{Code: "VN-25", Name: "Quảng Trị", Type: "Province"},
+	{Code: "VN-26", Name: "Thừa Thiên-Huế", Type: "Province"},
+	{Code: "VN-27", Name: "Quảng Nam", Type: "Province"},
+	{Code: "VN-28", Name: "Kon Tum", Type: "Province"},
+	{Code: "VN-29", Name: "Quảng Ngãi", Type: "Province"},
+	{Code: "VN-30", Name: "Gia Lai", Type: "Province"},
+	{Code: "VN-31", Name: "Bình Định", Type: "Province"},
+	{Code: "VN-32", Name: "Phú Yên", Type: "Province"},
+	{Code: "VN-33", Name: "Đắk Lắk", Type: "Province"},
+	{Code: "VN-34", Name: "Khánh Hòa", Type: "Province"},
+	{Code: "VN-35", Name: "Lâm Đồng", Type: "Province"},
+	{Code: "VN-36", Name: "Ninh Thuận", Type: "Province"},
+	{Code: "VN-37", Name: "Tây Ninh", Type: "Province"},
+	{Code: "VN-39", Name: "Đồng Nai", Type: "Province"},
+	{Code: "VN-40", Name: "Bình Thuận", Type: "Province"},
+	{Code: "VN-41", Name: "Long An", Type: "Province"},
+	{Code: "VN-43", Name: "Bà Rịa - Vũng Tàu", Type: "Province"},
+	{Code: "VN-44", Name: "An Giang", Type: "Province"},
+	{Code: "VN-45", Name: "Đồng Tháp", Type: "Province"},
+	{Code: "VN-46", Name: "Tiền Giang", Type: "Province"},
+	{Code: "VN-47", Name: "Kiến Giang", Type: "Province"},
+	{Code: "VN-49", Name: "Vĩnh Long", Type: "Province"},
+	{Code: "VN-50", Name: "Bến Tre", Type: "Province"},
+	{Code: "VN-51", Name: "Trà Vinh", Type: "Province"},
+	{Code: "VN-52", Name: "Sóc Trăng", Type: "Province"},
+	{Code: "VN-53", Name: "Bắc Kạn", Type: "Province"},
+	{Code: "VN-54", Name: "Bắc Giang", Type: "Province"},
+	{Code: "VN-55", Name: "Bạc Liêu", Type: "Province"},
+	{Code: "VN-56", Name: "Bắc Ninh", Type: "Province"},
+	{Code: "VN-57", Name: "Bình Dương", Type: "Province"},
+	{Code: "VN-58", Name: "Bình Phước", Type: "Province"},
+	{Code: "VN-59", Name: "Cà Mau", Type: "Province"},
+	{Code: "VN-61", Name: "Hải Dương", Type: "Province"},
+	{Code: "VN-63", Name: "Hà Nam", Type: "Province"},
+	{Code: "VN-66", Name: "Hưng Yên", Type: "Province"},
+	{Code: "VN-67", Name: "Nam Định", Type: "Province"},
+	{Code: "VN-68", Name: "Phú Thọ", Type: "Province"},
+	{Code: "VN-69", Name: "Thái Nguyên", Type: "Province"},
+	{Code: "VN-70", Name: "Vĩnh Phúc", Type: "Province"},
+	{Code: "VN-71", Name: "Điện Biên", Type: "Province"},
+	{Code: "VN-72", Name: "Đắk Nông", Type: "Province"},
+	{Code: "VN-73", Name: "Hậu Giang", Type: "Province"},
+	{Code: "VN-CT", Name: "Cần Thơ", Type: "Municipality"},
+	{Code: "VN-DN", Name: "Đà Nẵng", Type: "Municipality"},
+	{Code: "VN-HN", Name: "Hà Nội", Type: "Municipality"},
+	{Code: "VN-HP", Name: "Hải Phòng", Type: "Municipality"},
+	{Code: "VN-SG", Name: "Hồ Chí Minh", Type: "Municipality"},
+	{Code: "VU-MAP", Name: "Malampa", Type: "Province"},
+	{Code: "VU-PAM", Name: "Pénama", Type: "Province"},
+	{Code: "VU-SAM", Name: "Sanma", Type: "Province"},
+	{Code: "VU-SEE", Name: "Shéfa", Type: "Province"},
+	{Code: "VU-TAE", Name: "Taféa", Type: "Province"},
+	{Code: "VU-TOB", Name: "Torba", Type: "Province"},
+	{Code: "WF-AL", Name: "Alo", Type: "Administrative precinct"},
+	{Code: "WF-SG", Name: "Sigave", Type: "Administrative precinct"},
+	{Code: "WF-UV", Name: "Uvea", Type: "Administrative precinct"},
+	{Code: "WS-AA", Name: "A'ana", Type: "District"},
+	{Code: "WS-AL", Name: "Aiga-i-le-Tai", Type: "District"},
+	{Code: "WS-AT", Name: "Atua", Type: "District"},
+	{Code: "WS-FA", Name: "Fa'asaleleaga", Type: "District"},
+	{Code: "WS-GE", Name: "Gaga'emauga", Type: "District"},
+	{Code: "WS-GI", Name: "Gagaifomauga", Type: "District"},
+	{Code: "WS-PA", Name: "Palauli", Type: "District"},
+	{Code: "WS-SA", Name: "Satupa'itea", Type: "District"},
+	{Code: "WS-TU", Name: "Tuamasaga", Type: "District"},
+	{Code: "WS-VF", Name: "Va'a-o-Fonoti", Type: "District"},
+	{Code: "WS-VS", Name: "Vaisigano", Type: "District"},
+	{Code: "YE-AB", Name: "Abyan", Type: "Governorate"},
+	{Code: "YE-AD", Name: "‘Adan", Type: "Governorate"},
+	{Code: "YE-AM", Name: "‘Amrān", Type: "Governorate"},
+	{Code: "YE-BA", Name: "Al Bayḑā’", Type: "Governorate"},
+	{Code: "YE-DA", Name: "Aḑ Ḑāli‘", Type: "Governorate"},
+	{Code: "YE-DH", Name: "Dhamār", Type: "Governorate"},
+	{Code: "YE-HD", Name: "Ḩaḑramawt", Type: "Governorate"},
+	{Code: "YE-HJ", Name: "Ḩajjah", Type: "Governorate"},
+	{Code: "YE-HU", Name: "Al Ḩudaydah", Type: "Governorate"},
+	{Code: "YE-IB", Name: "Ibb", Type: "Governorate"},
+	{Code: "YE-JA", Name: "Al Jawf", Type: "Governorate"},
+	{Code: "YE-LA", Name: "Laḩij", Type: "Governorate"},
+	{Code: "YE-MA", Name: "Ma’rib", Type: "Governorate"},
+	{Code: "YE-MR", Name: "Al Mahrah", Type: "Governorate"},
+	{Code: "YE-MW", Name: "Al Maḩwīt", Type: "Governorate"},
+	{Code: "YE-RA", Name: "Raymah", Type: "Governorate"},
+	{Code: "YE-SA", Name: "Amānat al ‘Āşimah [city]", Type: "Municipality"},
+	{Code: "YE-SD", Name: "Şāʻdah", Type: "Governorate"},
+	{Code: "YE-SH", Name: "Shabwah", Type: "Governorate"},
+	{Code: "YE-SN", Name: "Şanʻā’", Type: "Governorate"},
+	{Code: "YE-SU", Name: "Arkhabīl Suquţrá", Type: "Governorate"},
+	{Code: "YE-TA", Name: "Tāʻizz", Type: "Governorate"},
+	{Code: "ZA-EC", Name: "Eastern Cape", Type: "Province"},
+	{Code: "ZA-FS", Name: "Free State", Type: "Province"},
+	{Code: "ZA-GP", Name: "Gauteng", Type: "Province"},
+	{Code: "ZA-KZN", Name: "Kwazulu-Natal", Type: "Province"},
+	{Code: "ZA-LP", Name: "Limpopo", Type: "Province"},
+	{Code: "ZA-MP", Name: "Mpumalanga", Type: "Province"},
+	{Code: "ZA-NC", Name: "Northern Cape", Type: "Province"},
+	{Code: "ZA-NW", Name: "North-West", Type: "Province"},
+	{Code: "ZA-WC", Name: "Western Cape", Type: "Province"},
+	{Code: "ZM-01", Name: "Western", Type: "Province"},
+	{Code: "ZM-02", Name: "Central", Type: "Province"},
+	{Code: "ZM-03", Name: "Eastern", Type: "Province"},
+	{Code: "ZM-04", Name: "Luapula", Type: "Province"},
+	{Code: "ZM-05", Name: "Northern", Type: "Province"},
+	{Code: "ZM-06", Name: "North-Western", Type: "Province"},
+	{Code: "ZM-07", Name: "Southern", Type: "Province"},
+	{Code: "ZM-08", Name: "Copperbelt", Type: "Province"},
+	{Code: "ZM-09", Name: "Lusaka", Type: "Province"},
+	{Code: "ZM-10", Name: "Muchinga", Type: "Province"},
+	{Code: "ZW-BU", Name: "Bulawayo", Type: "Province"},
+	{Code: "ZW-HA", Name: "Harare", Type: "Province"},
+	{Code: "ZW-MA", Name: "Manicaland", Type: "Province"},
+	{Code: "ZW-MC", Name: "Mashonaland Central", Type: "Province"},
+	{Code: "ZW-ME", Name: "Mashonaland East", Type: "Province"},
+	{Code: "ZW-MI", Name: "Midlands", Type: "Province"},
+	{Code: "ZW-MN", Name: "Matabeleland North", Type: "Province"},
+	{Code: "ZW-MS", Name: "Matabeleland South", Type: "Province"},
+	{Code: "ZW-MV", Name: "Masvingo", Type: "Province"},
+	{Code: "ZW-MW", Name: "Mashonaland West", Type: "Province"},
+}
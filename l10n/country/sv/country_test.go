@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SV", ""}, testutil.List{{
+	testutil.Run(t, []string{"SV", "SLV"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1101",
+			"CP 1101",
 		},
 		Fail: []string{
-			//
+			"110",
+			"CP1101",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "SX", A3: "SXM", Num: "534",
+		Zip: country.NoZip,
 	})
 }
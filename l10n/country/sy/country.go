@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "SY", A3: "SYR", Num: "760",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:(?:\+?963)|0)?9[0-9]{8}$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"SZ", ""}, testutil.List{{
+	testutil.Run(t, []string{"SZ", "SWZ"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"H100",
+			"M200",
 		},
 		Fail: []string{
-			//
+			"A100",
+			"H10",
 		},
 	}})
 }
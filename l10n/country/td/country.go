@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TD", A3: "TCD", Num: "148",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TF", A3: "ATF", Num: "260",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TG", A3: "TGO", Num: "768",
+		Zip: country.NoZip,
 	})
 }
@@ -27,11 +27,15 @@ func Test(t *testing.T) {
 			"10250",
 			"72170",
 			"12140",
+			"10200",
+			"50000",
 		},
 		Fail: []string{
 			"T1025",
 			"T72170",
 			"12140TH",
+			"1020",
+			"102000",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"TJ", ""}, testutil.List{{
+	testutil.Run(t, []string{"TJ", "TJK"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"734000",
+			"735700",
 		},
 		Fail: []string{
-			//
+			"73400",
+			"7340000",
 		},
 	}})
 }
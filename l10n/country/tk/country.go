@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TK", A3: "TKL", Num: "772",
+		Zip: country.NoZip,
 	})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TL", A3: "TLS", Num: "626",
+		Zip: country.NoZip,
 	})
 }
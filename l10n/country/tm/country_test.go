@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"TM", ""}, testutil.List{{
+	testutil.Run(t, []string{"TM", "TKM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"744000",
+			"746100",
 		},
 		Fail: []string{
-			//
+			"74400",
+			"7440000",
 		},
 	}})
 }
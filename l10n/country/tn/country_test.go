@@ -25,10 +25,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1000",
+			"3000",
 		},
 		Fail: []string{
-			//
+			"100",
+			"10000",
 		},
 	}})
 }
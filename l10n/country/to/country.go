@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TO", A3: "TON", Num: "776",
+		Zip: country.NoZip,
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"TR", ""}, testutil.List{{
+	testutil.Run(t, []string{"TR", "TUR"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"06100",
+			"34000",
 		},
 		Fail: []string{
-			//
+			"0610",
+			"061000",
 		},
 	}})
 }
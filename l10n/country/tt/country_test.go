@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"TT", ""}, testutil.List{{
+	testutil.Run(t, []string{"TT", "TTO"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"120110",
+			"500234",
 		},
 		Fail: []string{
-			//
+			"12011",
+			"1201100",
 		},
 	}})
 }
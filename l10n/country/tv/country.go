@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "TV", A3: "TUV", Num: "798",
+		Zip: country.NoZip,
 	})
 }
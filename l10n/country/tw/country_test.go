@@ -26,10 +26,14 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"100",
+			"10001",
+			"100001",
 		},
 		Fail: []string{
-			//
+			"10",
+			"1000",
+			"1000011",
 		},
 	}})
 }
@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11101",
+			"14111",
 		},
 		Fail: []string{
-			//
+			"1110",
+			"111010",
 		},
 	}})
 }
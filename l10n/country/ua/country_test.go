@@ -32,9 +32,13 @@ func Test(t *testing.T) {
 			"65000",
 			"65080",
 			"01000",
+			"01001",
+			"79000",
 		},
 		Fail: []string{
 			//
+			"0100",
+			"010011",
 		},
 	}})
 }
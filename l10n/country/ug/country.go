@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "UG", A3: "UGA", Num: "800",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+?256|0)?7[0-9]{8}$`),
 	})
 }
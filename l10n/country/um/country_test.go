@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"UM", ""}, testutil.List{{
+	testutil.Run(t, []string{"UM", "UMI"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"96898",
 		},
 		Fail: []string{
-			//
+			"96899",
+			"9689",
 		},
 	}})
 }
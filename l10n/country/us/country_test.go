@@ -32,5 +32,45 @@ func Test(t *testing.T) {
 			"+2(267)362-8910",
 			"+3365520145",
 		},
+	}, {
+		Name: "Zip", Func: isvalid.Zip,
+		Pass: []string{
+			"10001",
+			"10001-1234",
+			"100011234",
+		},
+		Fail: []string{
+			"1000",
+			"10001-123",
+			"ABCDE",
+		},
+	}, {
+		Name: "ZipIn/CA", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "CA", cc) },
+		Pass: []string{
+			"90210",
+			"94105-1234",
+			"96199",
+		},
+		Fail: []string{
+			"10001",
+			"96200",
+			"9021",
+		},
+	}, {
+		Name: "ZipIn/US-NY", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "US-NY", cc) },
+		Pass: []string{
+			"10001",
+			"00501",
+			"14999",
+		},
+		Fail: []string{
+			"20001",
+			"90210",
+		},
+	}, {
+		Name: "ZipIn/XX", Func: func(v, cc string) bool { return isvalid.ZipIn(v, "XX", cc) },
+		Fail: []string{
+			"10001",
+		},
 	}})
 }
@@ -0,0 +1,79 @@
+package us
+
+import (
+	"github.com/frk/isvalid/l10n/country"
+)
+
+// The 3-digit zip code prefixes of the states, the District of Columbia, and
+// the outlying areas. The prefixes of the armed forces "states" AA, AE, and AP
+// are not included since those have no ISO 3166-2 code.
+var zipRanges = []country.ZipRange{
+	{From: "005", To: "005", Subdivision: "US-NY"},
+	{From: "006", To: "007", Subdivision: "US-PR"},
+	{From: "008", To: "008", Subdivision: "US-VI"},
+	{From: "009", To: "009", Subdivision: "US-PR"},
+	{From: "010", To: "027", Subdivision: "US-MA"},
+	{From: "028", To: "029", Subdivision: "US-RI"},
+	{From: "030", To: "038", Subdivision: "US-NH"},
+	{From: "039", To: "049", Subdivision: "US-ME"},
+	{From: "050", To: "054", Subdivision: "US-VT"},
+	{From: "055", To: "055", Subdivision: "US-MA"},
+	{From: "056", To: "059", Subdivision: "US-VT"},
+	{From: "060", To: "069", Subdivision: "US-CT"},
+	{From: "070", To: "089", Subdivision: "US-NJ"},
+	{From: "100", To: "149", Subdivision: "US-NY"},
+	{From: "150", To: "196", Subdivision: "US-PA"},
+	{From: "197", To: "199", Subdivision: "US-DE"},
+	{From: "200", To: "200", Subdivision: "US-DC"},
+	{From: "201", To: "201", Subdivision: "US-VA"},
+	{From: "202", To: "205", Subdivision: "US-DC"},
+	{From: "206", To: "219", Subdivision: "US-MD"},
+	{From: "220", To: "246", Subdivision: "US-VA"},
+	{From: "247", To: "268", Subdivision: "US-WV"},
+	{From: "270", To: "289", Subdivision: "US-NC"},
+	{From: "290", To: "299", Subdivision: "US-SC"},
+	{From: "300", To: "319", Subdivision: "US-GA"},
+	{From: "320", To: "339", Subdivision: "US-FL"},
+	{From: "341", To: "349", Subdivision: "US-FL"},
+	{From: "350", To: "369", Subdivision: "US-AL"},
+	{From: "370", To: "385", Subdivision: "US-TN"},
+	{From: "386", To: "397", Subdivision: "US-MS"},
+	{From: "398", To: "399", Subdivision: "US-GA"},
+	{From: "400", To: "427", Subdivision: "US-KY"},
+	{From: "430", To: "459", Subdivision: "US-OH"},
+	{From: "460", To: "479", Subdivision: "US-IN"},
+	{From: "480", To: "499", Subdivision: "US-MI"},
+	{From: "500", To: "528", Subdivision: "US-IA"},
+	{From: "530", To: "549", Subdivision: "US-WI"},
+	{From: "550", To: "567", Subdivision: "US-MN"},
+	{From: "569", To: "569", Subdivision: "US-DC"},
+	{From: "570", To: "577", Subdivision: "US-SD"},
+	{From: "580", To: "588", Subdivision: "US-ND"},
+	{From: "590", To: "599", Subdivision: "US-MT"},
+	{From: "600", To: "629", Subdivision: "US-IL"},
+	{From: "630", To: "658", Subdivision: "US-MO"},
+	{From: "660", To: "679", Subdivision: "US-KS"},
+	{From: "680", To: "693", Subdivision: "US-NE"},
+	{From: "700", To: "715", Subdivision: "US-LA"},
+	{From: "716", To: "729", Subdivision: "US-AR"},
+	{From: "730", To: "731", Subdivision: "US-OK"},
+	{From: "733", To: "733", Subdivision: "US-TX"},
+	{From: "734", To: "749", Subdivision: "US-OK"},
+	{From: "750", To: "799", Subdivision: "US-TX"},
+	{From: "800", To: "816", Subdivision: "US-CO"},
+	{From: "820", To: "831", Subdivision: "US-WY"},
+	{From: "832", To: "838", Subdivision: "US-ID"},
+	{From: "840", To: "847", Subdivision: "US-UT"},
+	{From: "850", To: "865", Subdivision: "US-AZ"},
+	{From: "870", To: "884", Subdivision: "US-NM"},
+	{From: "885", To: "885", Subdivision: "US-TX"},
+	{From: "889", To: "898", Subdivision: "US-NV"},
+	{From: "900", To: "961", Subdivision: "US-CA"},
+	{From: "967", To: "968", Subdivision: "US-HI"},
+	{From: "96799", To: "96799", Subdivision: "US-AS"},
+	{From: "96910", To: "96932", Subdivision: "US-GU"},
+	{From: "96950", To: "96952", Subdivision: "US-MP"},
+	{From: "970", To: "979", Subdivision: "US-OR"},
+	{From: "980", To: "994", Subdivision: "US-WA"},
+	{From: "995", To: "999", Subdivision: "US-AK"},
+}
@@ -27,10 +27,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"11000",
+			"11300",
 		},
 		Fail: []string{
-			//
+			"1100",
+			"110000",
 		},
 	}})
 }
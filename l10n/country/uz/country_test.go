@@ -33,10 +33,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"100000",
+			"140100",
 		},
 		Fail: []string{
-			//
+			"10000",
+			"1000000",
 		},
 	}})
 }
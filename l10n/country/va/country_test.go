@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"VA", ""}, testutil.List{{
+	testutil.Run(t, []string{"VA", "VAT"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,11 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"00120",
 		},
 		Fail: []string{
-			//
+			"00121",
+			"0012",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"VC", ""}, testutil.List{{
+	testutil.Run(t, []string{"VC", "VCT"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"VC0100",
+			"VC0300",
 		},
 		Fail: []string{
-			//
+			"0100",
+			"VC010",
 		},
 	}})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"VE", ""}, testutil.List{{
+	testutil.Run(t, []string{"VE", "VEN"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"1010",
+			"1010-A",
 		},
 		Fail: []string{
-			//
+			"101",
+			"1010-AB",
 		},
 	}})
 }
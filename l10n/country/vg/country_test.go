@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"VG", ""}, testutil.List{{
+	testutil.Run(t, []string{"VG", "VGB"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"VG1110",
+			"VG1160",
 		},
 		Fail: []string{
-			//
+			"VG1170",
+			"VG1100",
 		},
 	}})
 }
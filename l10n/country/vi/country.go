@@ -9,6 +9,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "VI", A3: "VIR", Num: "850",
-		Zip: regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+		Zip: regexp.MustCompile(`^008[0-5][0-9](?:-?[0-9]{4})?$`),
 	})
 }
@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"VI", ""}, testutil.List{{
+	testutil.Run(t, []string{"VI", "VIR"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"00802",
+			"00840-1234",
 		},
 		Fail: []string{
-			//
+			"96799",
+			"00900",
 		},
 	}})
 }
@@ -36,10 +36,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"100000",
+			"700000",
 		},
 		Fail: []string{
-			//
+			"10000",
+			"1000000",
 		},
 	}})
 }
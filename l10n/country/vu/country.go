@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "VU", A3: "VUT", Num: "548",
+		Zip: country.NoZip,
 	})
 }
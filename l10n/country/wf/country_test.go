@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"WF", ""}, testutil.List{{
+	testutil.Run(t, []string{"WF", "WLF"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"98600",
+			"98690",
 		},
 		Fail: []string{
-			//
+			"98691",
+			"98500",
 		},
 	}})
 }
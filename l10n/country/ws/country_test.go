@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"WS", ""}, testutil.List{{
+	testutil.Run(t, []string{"WS", "WSM"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"WS1382",
+			"WS1310",
 		},
 		Fail: []string{
-			//
+			"1382",
+			"WS138",
 		},
 	}})
 }
@@ -7,5 +7,6 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "YE", A3: "YEM", Num: "887",
+		Zip: country.NoZip,
 	})
 }
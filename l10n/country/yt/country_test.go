@@ -8,7 +8,7 @@ import (
 )
 
 func Test(t *testing.T) {
-	testutil.Run(t, []string{"YT", ""}, testutil.List{{
+	testutil.Run(t, []string{"YT", "MYT"}, testutil.List{{
 		Name: "Phone", Func: isvalid.Phone,
 		Pass: []string{
 			//
@@ -19,10 +19,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"97600",
+			"97690",
 		},
 		Fail: []string{
-			//
+			"97691",
+			"97500",
 		},
 	}})
 }
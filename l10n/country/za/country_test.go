@@ -33,10 +33,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"0001",
+			"8001",
 		},
 		Fail: []string{
-			//
+			"001",
+			"80011",
 		},
 	}})
 }
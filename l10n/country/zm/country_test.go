@@ -29,10 +29,12 @@ func Test(t *testing.T) {
 	}, {
 		Name: "Zip", Func: isvalid.Zip,
 		Pass: []string{
-			//
+			"10101",
+			"50100",
 		},
 		Fail: []string{
-			//
+			"1010",
+			"101010",
 		},
 	}})
 }
@@ -9,6 +9,7 @@ import (
 func init() {
 	country.Add(country.Country{
 		A2: "ZW", A3: "ZWE", Num: "716",
+		Zip:   country.NoZip,
 		Phone: regexp.MustCompile(`^(?:\+263)[0-9]{9}$`),
 	})
 }